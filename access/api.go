@@ -35,6 +35,7 @@ type API interface {
 	GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error)
 	GetAccountAtLatestBlock(ctx context.Context, address flow.Address) (*flow.Account, error)
 	GetAccountAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (*flow.Account, error)
+	GetAccountTransactions(ctx context.Context, address flow.Address, startHeight, endHeight uint64, cursor string, limit uint) ([]flow.AccountTransaction, string, error)
 
 	ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments [][]byte) ([]byte, error)
 	ExecuteScriptAtBlockHeight(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte) ([]byte, error)
@@ -42,11 +43,17 @@ type API interface {
 
 	GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64) ([]flow.BlockEvents, error)
 	GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier) ([]flow.BlockEvents, error)
+	GetEventsByFilter(ctx context.Context, filter flow.EventFilter, startHeight, endHeight uint64, cursor string, limit uint) ([]flow.BlockEvents, string, error)
 
 	GetLatestProtocolStateSnapshot(ctx context.Context) ([]byte, error)
+	GetProtocolStateSnapshotByBlockID(ctx context.Context, blockID flow.Identifier) ([]byte, error)
+	GetProtocolStateSnapshotByHeight(ctx context.Context, height uint64) ([]byte, error)
 
 	GetExecutionResultForBlockID(ctx context.Context, blockID flow.Identifier) (*flow.ExecutionResult, error)
 	GetExecutionResultByID(ctx context.Context, id flow.Identifier) (*flow.ExecutionResult, error)
+
+	GetSlashingEvidenceByID(ctx context.Context, id flow.Identifier) (*flow.SlashingEvidence, error)
+	GetSlashingEvidence(ctx context.Context, offenderID flow.Identifier) ([]*flow.SlashingEvidence, error)
 }
 
 // TODO: Combine this with flow.TransactionResult?
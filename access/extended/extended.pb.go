@@ -0,0 +1,1398 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: access/extended/extended.proto
+
+package extended
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetAccountTransactionsRequest queries a page of the transaction history of an account.
+type GetAccountTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                             // Address of the account
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"` // Lowest block height to include
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`       // Highest block height to include
+	Cursor      string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                               // Cursor returned with the previous page, empty for the first page
+	Limit       uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                // Maximum number of transactions to return, 0 for the server default
+}
+
+func (x *GetAccountTransactionsRequest) Reset() {
+	*x = GetAccountTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTransactionsRequest) ProtoMessage() {}
+
+func (x *GetAccountTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAccountTransactionsRequest) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *GetAccountTransactionsRequest) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetAccountTransactionsRequest) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *GetAccountTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetAccountTransactionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetAccountTransactionsResponse is a page of the transaction history of an account.
+type GetAccountTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*AccountTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextCursor   string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Cursor of the next page, empty if there are no more transactions
+}
+
+func (x *GetAccountTransactionsResponse) Reset() {
+	*x = GetAccountTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTransactionsResponse) ProtoMessage() {}
+
+func (x *GetAccountTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAccountTransactionsResponse) GetTransactions() []*AccountTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetAccountTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// AccountTransaction is a transaction an account was involved in.
+type AccountTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId    []byte   `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	TransactionIndex uint32   `protobuf:"varint,2,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
+	BlockId          []byte   `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	BlockHeight      uint64   `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Roles            []string `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"` // Roles of the account: payer, proposer, authorizer or event
+}
+
+func (x *AccountTransaction) Reset() {
+	*x = AccountTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTransaction) ProtoMessage() {}
+
+func (x *AccountTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTransaction.ProtoReflect.Descriptor instead.
+func (*AccountTransaction) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountTransaction) GetTransactionId() []byte {
+	if x != nil {
+		return x.TransactionId
+	}
+	return nil
+}
+
+func (x *AccountTransaction) GetTransactionIndex() uint32 {
+	if x != nil {
+		return x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetBlockId() []byte {
+	if x != nil {
+		return x.BlockId
+	}
+	return nil
+}
+
+func (x *AccountTransaction) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// GetSlashingEvidenceByIDRequest queries slashing evidence by its ID.
+type GetSlashingEvidenceByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSlashingEvidenceByIDRequest) Reset() {
+	*x = GetSlashingEvidenceByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlashingEvidenceByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlashingEvidenceByIDRequest) ProtoMessage() {}
+
+func (x *GetSlashingEvidenceByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlashingEvidenceByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetSlashingEvidenceByIDRequest) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSlashingEvidenceByIDRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// SlashingEvidenceResponse holds a single slashing evidence.
+type SlashingEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidence *SlashingEvidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *SlashingEvidenceResponse) Reset() {
+	*x = SlashingEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingEvidenceResponse) ProtoMessage() {}
+
+func (x *SlashingEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*SlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SlashingEvidenceResponse) GetEvidence() *SlashingEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// GetSlashingEvidenceRequest queries the slashing evidence against a node.
+type GetSlashingEvidenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OffenderId []byte `protobuf:"bytes,1,opt,name=offender_id,json=offenderId,proto3" json:"offender_id,omitempty"` // ID of the offending node, empty for all evidence
+}
+
+func (x *GetSlashingEvidenceRequest) Reset() {
+	*x = GetSlashingEvidenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlashingEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlashingEvidenceRequest) ProtoMessage() {}
+
+func (x *GetSlashingEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlashingEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*GetSlashingEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSlashingEvidenceRequest) GetOffenderId() []byte {
+	if x != nil {
+		return x.OffenderId
+	}
+	return nil
+}
+
+// GetSlashingEvidenceResponse holds the slashing evidence matching the query.
+type GetSlashingEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidence []*SlashingEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *GetSlashingEvidenceResponse) Reset() {
+	*x = GetSlashingEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlashingEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlashingEvidenceResponse) ProtoMessage() {}
+
+func (x *GetSlashingEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlashingEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*GetSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSlashingEvidenceResponse) GetEvidence() []*SlashingEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// SlashingEvidence is a self-contained proof that a consensus participant violated
+// the protocol. Exactly one of proposals, votes and timeouts holds the two conflicting
+// messages signed by the offender, depending on the type of the evidence.
+type SlashingEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         []byte              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string              `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                      // double_proposal, double_vote or double_timeout
+	ChainId    string              `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // Chain of the consensus instance in which the violation occurred
+	View       uint64              `protobuf:"varint,4,opt,name=view,proto3" json:"view,omitempty"`                     // View in which the violation occurred
+	OffenderId []byte              `protobuf:"bytes,5,opt,name=offender_id,json=offenderId,proto3" json:"offender_id,omitempty"`
+	Proposals  []*SlashingProposal `protobuf:"bytes,6,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Votes      []*SlashingVote     `protobuf:"bytes,7,rep,name=votes,proto3" json:"votes,omitempty"`
+	Timeouts   []*SlashingTimeout  `protobuf:"bytes,8,rep,name=timeouts,proto3" json:"timeouts,omitempty"`
+}
+
+func (x *SlashingEvidence) Reset() {
+	*x = SlashingEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingEvidence) ProtoMessage() {}
+
+func (x *SlashingEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingEvidence.ProtoReflect.Descriptor instead.
+func (*SlashingEvidence) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SlashingEvidence) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SlashingEvidence) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SlashingEvidence) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SlashingEvidence) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *SlashingEvidence) GetOffenderId() []byte {
+	if x != nil {
+		return x.OffenderId
+	}
+	return nil
+}
+
+func (x *SlashingEvidence) GetProposals() []*SlashingProposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+func (x *SlashingEvidence) GetVotes() []*SlashingVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *SlashingEvidence) GetTimeouts() []*SlashingTimeout {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+// SlashingProposal is a signed block header as included in slashing evidence.
+type SlashingProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId            string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ParentId           []byte                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Height             uint64                 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	PayloadHash        []byte                 `protobuf:"bytes,5,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	Timestamp          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	View               uint64                 `protobuf:"varint,7,opt,name=view,proto3" json:"view,omitempty"`
+	ParentVoterIds     [][]byte               `protobuf:"bytes,8,rep,name=parent_voter_ids,json=parentVoterIds,proto3" json:"parent_voter_ids,omitempty"`
+	ParentVoterSigData []byte                 `protobuf:"bytes,9,opt,name=parent_voter_sig_data,json=parentVoterSigData,proto3" json:"parent_voter_sig_data,omitempty"`
+	ProposerId         []byte                 `protobuf:"bytes,10,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"`
+	ProposerSigData    []byte                 `protobuf:"bytes,11,opt,name=proposer_sig_data,json=proposerSigData,proto3" json:"proposer_sig_data,omitempty"`
+	LastViewTc         *TimeoutCertificate    `protobuf:"bytes,12,opt,name=last_view_tc,json=lastViewTc,proto3" json:"last_view_tc,omitempty"`
+}
+
+func (x *SlashingProposal) Reset() {
+	*x = SlashingProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingProposal) ProtoMessage() {}
+
+func (x *SlashingProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingProposal.ProtoReflect.Descriptor instead.
+func (*SlashingProposal) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SlashingProposal) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SlashingProposal) GetParentId() []byte {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SlashingProposal) GetPayloadHash() []byte {
+	if x != nil {
+		return x.PayloadHash
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *SlashingProposal) GetParentVoterIds() [][]byte {
+	if x != nil {
+		return x.ParentVoterIds
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetParentVoterSigData() []byte {
+	if x != nil {
+		return x.ParentVoterSigData
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetProposerId() []byte {
+	if x != nil {
+		return x.ProposerId
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetProposerSigData() []byte {
+	if x != nil {
+		return x.ProposerSigData
+	}
+	return nil
+}
+
+func (x *SlashingProposal) GetLastViewTc() *TimeoutCertificate {
+	if x != nil {
+		return x.LastViewTc
+	}
+	return nil
+}
+
+// SlashingVote is a signed HotStuff vote as included in slashing evidence.
+type SlashingVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View     uint64 `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	BlockId  []byte `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	SignerId []byte `protobuf:"bytes,3,opt,name=signer_id,json=signerId,proto3" json:"signer_id,omitempty"`
+	SigData  []byte `protobuf:"bytes,4,opt,name=sig_data,json=sigData,proto3" json:"sig_data,omitempty"`
+}
+
+func (x *SlashingVote) Reset() {
+	*x = SlashingVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingVote) ProtoMessage() {}
+
+func (x *SlashingVote) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingVote.ProtoReflect.Descriptor instead.
+func (*SlashingVote) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SlashingVote) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *SlashingVote) GetBlockId() []byte {
+	if x != nil {
+		return x.BlockId
+	}
+	return nil
+}
+
+func (x *SlashingVote) GetSignerId() []byte {
+	if x != nil {
+		return x.SignerId
+	}
+	return nil
+}
+
+func (x *SlashingVote) GetSigData() []byte {
+	if x != nil {
+		return x.SigData
+	}
+	return nil
+}
+
+// SlashingTimeout is a signed HotStuff timeout as included in slashing evidence.
+type SlashingTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View       uint64              `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	NewestQc   *QuorumCertificate  `protobuf:"bytes,2,opt,name=newest_qc,json=newestQc,proto3" json:"newest_qc,omitempty"`
+	LastViewTc *TimeoutCertificate `protobuf:"bytes,3,opt,name=last_view_tc,json=lastViewTc,proto3" json:"last_view_tc,omitempty"`
+	SignerId   []byte              `protobuf:"bytes,4,opt,name=signer_id,json=signerId,proto3" json:"signer_id,omitempty"`
+	SigData    []byte              `protobuf:"bytes,5,opt,name=sig_data,json=sigData,proto3" json:"sig_data,omitempty"`
+}
+
+func (x *SlashingTimeout) Reset() {
+	*x = SlashingTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingTimeout) ProtoMessage() {}
+
+func (x *SlashingTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingTimeout.ProtoReflect.Descriptor instead.
+func (*SlashingTimeout) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SlashingTimeout) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *SlashingTimeout) GetNewestQc() *QuorumCertificate {
+	if x != nil {
+		return x.NewestQc
+	}
+	return nil
+}
+
+func (x *SlashingTimeout) GetLastViewTc() *TimeoutCertificate {
+	if x != nil {
+		return x.LastViewTc
+	}
+	return nil
+}
+
+func (x *SlashingTimeout) GetSignerId() []byte {
+	if x != nil {
+		return x.SignerId
+	}
+	return nil
+}
+
+func (x *SlashingTimeout) GetSigData() []byte {
+	if x != nil {
+		return x.SigData
+	}
+	return nil
+}
+
+// QuorumCertificate certifies a block with the aggregated votes of a super-majority.
+type QuorumCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View      uint64   `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	BlockId   []byte   `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	SignerIds [][]byte `protobuf:"bytes,3,rep,name=signer_ids,json=signerIds,proto3" json:"signer_ids,omitempty"`
+	SigData   []byte   `protobuf:"bytes,4,opt,name=sig_data,json=sigData,proto3" json:"sig_data,omitempty"`
+}
+
+func (x *QuorumCertificate) Reset() {
+	*x = QuorumCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuorumCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuorumCertificate) ProtoMessage() {}
+
+func (x *QuorumCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuorumCertificate.ProtoReflect.Descriptor instead.
+func (*QuorumCertificate) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuorumCertificate) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *QuorumCertificate) GetBlockId() []byte {
+	if x != nil {
+		return x.BlockId
+	}
+	return nil
+}
+
+func (x *QuorumCertificate) GetSignerIds() [][]byte {
+	if x != nil {
+		return x.SignerIds
+	}
+	return nil
+}
+
+func (x *QuorumCertificate) GetSigData() []byte {
+	if x != nil {
+		return x.SigData
+	}
+	return nil
+}
+
+// TimeoutCertificate certifies that a super-majority timed out in a view.
+type TimeoutCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View          uint64             `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	NewestQcViews []uint64           `protobuf:"varint,2,rep,packed,name=newest_qc_views,json=newestQcViews,proto3" json:"newest_qc_views,omitempty"`
+	NewestQc      *QuorumCertificate `protobuf:"bytes,3,opt,name=newest_qc,json=newestQc,proto3" json:"newest_qc,omitempty"`
+	SignerIds     [][]byte           `protobuf:"bytes,4,rep,name=signer_ids,json=signerIds,proto3" json:"signer_ids,omitempty"`
+	SigData       []byte             `protobuf:"bytes,5,opt,name=sig_data,json=sigData,proto3" json:"sig_data,omitempty"`
+}
+
+func (x *TimeoutCertificate) Reset() {
+	*x = TimeoutCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_access_extended_extended_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeoutCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeoutCertificate) ProtoMessage() {}
+
+func (x *TimeoutCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_access_extended_extended_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeoutCertificate.ProtoReflect.Descriptor instead.
+func (*TimeoutCertificate) Descriptor() ([]byte, []int) {
+	return file_access_extended_extended_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TimeoutCertificate) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *TimeoutCertificate) GetNewestQcViews() []uint64 {
+	if x != nil {
+		return x.NewestQcViews
+	}
+	return nil
+}
+
+func (x *TimeoutCertificate) GetNewestQc() *QuorumCertificate {
+	if x != nil {
+		return x.NewestQc
+	}
+	return nil
+}
+
+func (x *TimeoutCertificate) GetSignerIds() [][]byte {
+	if x != nil {
+		return x.SignerIds
+	}
+	return nil
+}
+
+func (x *TimeoutCertificate) GetSigData() []byte {
+	if x != nil {
+		return x.SigData
+	}
+	return nil
+}
+
+var File_access_extended_extended_proto protoreflect.FileDescriptor
+
+var file_access_extended_extended_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5e, 0x0a, 0x18, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x3d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xc9, 0x02, 0x0a, 0x10, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x44, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x41, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x22, 0xd9, 0x03, 0x0a, 0x10, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x12, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x53, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x4a, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x74, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x54, 0x63,
+	0x22, 0x75, 0x0a, 0x0c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x73, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0xef, 0x01, 0x0a, 0x0f, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x44, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x71, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x65, 0x73, 0x74, 0x51, 0x63, 0x12, 0x4a, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x69,
+	0x65, 0x77, 0x5f, 0x74, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x54,
+	0x63, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x7c, 0x0a, 0x11, 0x51, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x73, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd0, 0x01, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x71, 0x63, 0x5f,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x65, 0x77,
+	0x65, 0x73, 0x74, 0x51, 0x63, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x65, 0x73, 0x74, 0x5f, 0x71, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x51, 0x63,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x32, 0x96, 0x03, 0x0a, 0x11, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x50, 0x49,
+	0x12, 0x83, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x34, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30,
+	0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2d, 0x67, 0x6f,
+	0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_access_extended_extended_proto_rawDescOnce sync.Once
+	file_access_extended_extended_proto_rawDescData = file_access_extended_extended_proto_rawDesc
+)
+
+func file_access_extended_extended_proto_rawDescGZIP() []byte {
+	file_access_extended_extended_proto_rawDescOnce.Do(func() {
+		file_access_extended_extended_proto_rawDescData = protoimpl.X.CompressGZIP(file_access_extended_extended_proto_rawDescData)
+	})
+	return file_access_extended_extended_proto_rawDescData
+}
+
+var file_access_extended_extended_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_access_extended_extended_proto_goTypes = []interface{}{
+	(*GetAccountTransactionsRequest)(nil),  // 0: flow.access.extended.GetAccountTransactionsRequest
+	(*GetAccountTransactionsResponse)(nil), // 1: flow.access.extended.GetAccountTransactionsResponse
+	(*AccountTransaction)(nil),             // 2: flow.access.extended.AccountTransaction
+	(*GetSlashingEvidenceByIDRequest)(nil), // 3: flow.access.extended.GetSlashingEvidenceByIDRequest
+	(*SlashingEvidenceResponse)(nil),       // 4: flow.access.extended.SlashingEvidenceResponse
+	(*GetSlashingEvidenceRequest)(nil),     // 5: flow.access.extended.GetSlashingEvidenceRequest
+	(*GetSlashingEvidenceResponse)(nil),    // 6: flow.access.extended.GetSlashingEvidenceResponse
+	(*SlashingEvidence)(nil),               // 7: flow.access.extended.SlashingEvidence
+	(*SlashingProposal)(nil),               // 8: flow.access.extended.SlashingProposal
+	(*SlashingVote)(nil),                   // 9: flow.access.extended.SlashingVote
+	(*SlashingTimeout)(nil),                // 10: flow.access.extended.SlashingTimeout
+	(*QuorumCertificate)(nil),              // 11: flow.access.extended.QuorumCertificate
+	(*TimeoutCertificate)(nil),             // 12: flow.access.extended.TimeoutCertificate
+	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
+}
+var file_access_extended_extended_proto_depIdxs = []int32{
+	2,  // 0: flow.access.extended.GetAccountTransactionsResponse.transactions:type_name -> flow.access.extended.AccountTransaction
+	7,  // 1: flow.access.extended.SlashingEvidenceResponse.evidence:type_name -> flow.access.extended.SlashingEvidence
+	7,  // 2: flow.access.extended.GetSlashingEvidenceResponse.evidence:type_name -> flow.access.extended.SlashingEvidence
+	8,  // 3: flow.access.extended.SlashingEvidence.proposals:type_name -> flow.access.extended.SlashingProposal
+	9,  // 4: flow.access.extended.SlashingEvidence.votes:type_name -> flow.access.extended.SlashingVote
+	10, // 5: flow.access.extended.SlashingEvidence.timeouts:type_name -> flow.access.extended.SlashingTimeout
+	13, // 6: flow.access.extended.SlashingProposal.timestamp:type_name -> google.protobuf.Timestamp
+	12, // 7: flow.access.extended.SlashingProposal.last_view_tc:type_name -> flow.access.extended.TimeoutCertificate
+	11, // 8: flow.access.extended.SlashingTimeout.newest_qc:type_name -> flow.access.extended.QuorumCertificate
+	12, // 9: flow.access.extended.SlashingTimeout.last_view_tc:type_name -> flow.access.extended.TimeoutCertificate
+	11, // 10: flow.access.extended.TimeoutCertificate.newest_qc:type_name -> flow.access.extended.QuorumCertificate
+	0,  // 11: flow.access.extended.ExtendedAccessAPI.GetAccountTransactions:input_type -> flow.access.extended.GetAccountTransactionsRequest
+	3,  // 12: flow.access.extended.ExtendedAccessAPI.GetSlashingEvidenceByID:input_type -> flow.access.extended.GetSlashingEvidenceByIDRequest
+	5,  // 13: flow.access.extended.ExtendedAccessAPI.GetSlashingEvidence:input_type -> flow.access.extended.GetSlashingEvidenceRequest
+	1,  // 14: flow.access.extended.ExtendedAccessAPI.GetAccountTransactions:output_type -> flow.access.extended.GetAccountTransactionsResponse
+	4,  // 15: flow.access.extended.ExtendedAccessAPI.GetSlashingEvidenceByID:output_type -> flow.access.extended.SlashingEvidenceResponse
+	6,  // 16: flow.access.extended.ExtendedAccessAPI.GetSlashingEvidence:output_type -> flow.access.extended.GetSlashingEvidenceResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_access_extended_extended_proto_init() }
+func file_access_extended_extended_proto_init() {
+	if File_access_extended_extended_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_access_extended_extended_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashingEvidenceByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingEvidenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashingEvidenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashingEvidenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuorumCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_access_extended_extended_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeoutCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_access_extended_extended_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_access_extended_extended_proto_goTypes,
+		DependencyIndexes: file_access_extended_extended_proto_depIdxs,
+		MessageInfos:      file_access_extended_extended_proto_msgTypes,
+	}.Build()
+	File_access_extended_extended_proto = out.File
+	file_access_extended_extended_proto_rawDesc = nil
+	file_access_extended_extended_proto_goTypes = nil
+	file_access_extended_extended_proto_depIdxs = nil
+}
@@ -0,0 +1,188 @@
+package access
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/onflow/flow-go/access/extended"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ExtendedHandler serves the ExtendedAccessAPI, which exposes the queries answered from
+// the optional local indices of Access nodes.
+type ExtendedHandler struct {
+	extended.UnimplementedExtendedAccessAPIServer
+	api   API
+	chain flow.Chain
+}
+
+func NewExtendedHandler(api API, chain flow.Chain) *ExtendedHandler {
+	return &ExtendedHandler{
+		api:   api,
+		chain: chain,
+	}
+}
+
+// GetAccountTransactions returns a page of the sealed transactions involving an account.
+func (h *ExtendedHandler) GetAccountTransactions(
+	ctx context.Context,
+	req *extended.GetAccountTransactionsRequest,
+) (*extended.GetAccountTransactionsResponse, error) {
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, next, err := h.api.GetAccountTransactions(
+		ctx,
+		address,
+		req.GetStartHeight(),
+		req.GetEndHeight(),
+		req.GetCursor(),
+		uint(req.GetLimit()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*extended.AccountTransaction, len(txs))
+	for i, tx := range txs {
+		messages[i] = &extended.AccountTransaction{
+			TransactionId:    convert.IdentifierToMessage(tx.TransactionID),
+			TransactionIndex: tx.TransactionIndex,
+			BlockId:          convert.IdentifierToMessage(tx.BlockID),
+			BlockHeight:      tx.Height,
+			Roles:            tx.Roles.Names(),
+		}
+	}
+
+	return &extended.GetAccountTransactionsResponse{
+		Transactions: messages,
+		NextCursor:   next,
+	}, nil
+}
+
+// GetSlashingEvidenceByID returns the slashing evidence with the given ID.
+func (h *ExtendedHandler) GetSlashingEvidenceByID(
+	ctx context.Context,
+	req *extended.GetSlashingEvidenceByIDRequest,
+) (*extended.SlashingEvidenceResponse, error) {
+	id, err := convert.SlashingEvidenceID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	evidence, err := h.api.GetSlashingEvidenceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extended.SlashingEvidenceResponse{
+		Evidence: slashingEvidenceToMessage(evidence),
+	}, nil
+}
+
+// GetSlashingEvidence returns all slashing evidence against the requested offender, or all
+// slashing evidence known to the node if no offender is given.
+func (h *ExtendedHandler) GetSlashingEvidence(
+	ctx context.Context,
+	req *extended.GetSlashingEvidenceRequest,
+) (*extended.GetSlashingEvidenceResponse, error) {
+	offenderID := flow.ZeroID
+	if len(req.GetOffenderId()) > 0 {
+		offenderID = flow.HashToID(req.GetOffenderId())
+	}
+
+	evidence, err := h.api.GetSlashingEvidence(ctx, offenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*extended.SlashingEvidence, len(evidence))
+	for i, e := range evidence {
+		messages[i] = slashingEvidenceToMessage(e)
+	}
+
+	return &extended.GetSlashingEvidenceResponse{
+		Evidence: messages,
+	}, nil
+}
+
+func slashingEvidenceToMessage(e *flow.SlashingEvidence) *extended.SlashingEvidence {
+	proposals := make([]*extended.SlashingProposal, len(e.Proposals))
+	for i, header := range e.Proposals {
+		proposals[i] = &extended.SlashingProposal{
+			Id:                 convert.IdentifierToMessage(header.ID()),
+			ChainId:            header.ChainID.String(),
+			ParentId:           convert.IdentifierToMessage(header.ParentID),
+			Height:             header.Height,
+			PayloadHash:        convert.IdentifierToMessage(header.PayloadHash),
+			Timestamp:          timestamppb.New(header.Timestamp),
+			View:               header.View,
+			ParentVoterIds:     convert.IdentifiersToMessages(header.ParentVoterIDs),
+			ParentVoterSigData: header.ParentVoterSigData,
+			ProposerId:         convert.IdentifierToMessage(header.ProposerID),
+			ProposerSigData:    header.ProposerSigData,
+			LastViewTc:         timeoutCertificateToMessage(header.LastViewTC),
+		}
+	}
+
+	votes := make([]*extended.SlashingVote, len(e.Votes))
+	for i, vote := range e.Votes {
+		votes[i] = &extended.SlashingVote{
+			View:     vote.View,
+			BlockId:  convert.IdentifierToMessage(vote.BlockID),
+			SignerId: convert.IdentifierToMessage(vote.SignerID),
+			SigData:  vote.SigData,
+		}
+	}
+
+	timeouts := make([]*extended.SlashingTimeout, len(e.Timeouts))
+	for i, timeout := range e.Timeouts {
+		timeouts[i] = &extended.SlashingTimeout{
+			View:       timeout.View,
+			NewestQc:   quorumCertificateToMessage(timeout.NewestQC),
+			LastViewTc: timeoutCertificateToMessage(timeout.LastViewTC),
+			SignerId:   convert.IdentifierToMessage(timeout.SignerID),
+			SigData:    timeout.SigData,
+		}
+	}
+
+	return &extended.SlashingEvidence{
+		Id:         convert.IdentifierToMessage(e.ID()),
+		Type:       string(e.Type),
+		ChainId:    e.ChainID.String(),
+		View:       e.View,
+		OffenderId: convert.IdentifierToMessage(e.OffenderID),
+		Proposals:  proposals,
+		Votes:      votes,
+		Timeouts:   timeouts,
+	}
+}
+
+func quorumCertificateToMessage(qc *flow.QuorumCertificate) *extended.QuorumCertificate {
+	if qc == nil {
+		return nil
+	}
+	return &extended.QuorumCertificate{
+		View:      qc.View,
+		BlockId:   convert.IdentifierToMessage(qc.BlockID),
+		SignerIds: convert.IdentifiersToMessages(qc.SignerIDs),
+		SigData:   qc.SigData,
+	}
+}
+
+func timeoutCertificateToMessage(tc *flow.TimeoutCertificate) *extended.TimeoutCertificate {
+	if tc == nil {
+		return nil
+	}
+	return &extended.TimeoutCertificate{
+		View:          tc.View,
+		NewestQcViews: tc.NewestQCViews,
+		NewestQc:      quorumCertificateToMessage(tc.NewestQC),
+		SignerIds:     convert.IdentifiersToMessages(tc.SignerIDs),
+		SigData:       tc.SigData,
+	}
+}
@@ -0,0 +1,153 @@
+package access_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/access/extended"
+	accessmock "github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestExtendedHandler_GetAccountTransactions(t *testing.T) {
+	chain := flow.Testnet.Chain()
+	address := unittest.AddressFixture()
+
+	t.Run("returns page and cursor", func(t *testing.T) {
+		api := new(accessmock.API)
+		handler := access.NewExtendedHandler(api, chain)
+
+		tx := flow.AccountTransaction{
+			Address:          address,
+			BlockID:          unittest.IdentifierFixture(),
+			Height:           12,
+			TransactionID:    unittest.IdentifierFixture(),
+			TransactionIndex: 3,
+			Roles:            flow.TransactionRolePayer | flow.TransactionRoleAuthorizer,
+		}
+		api.On("GetAccountTransactions", context.Background(), address, uint64(10), uint64(20), "cursor", uint(5)).
+			Return([]flow.AccountTransaction{tx}, "next", nil).Once()
+
+		resp, err := handler.GetAccountTransactions(context.Background(), &extended.GetAccountTransactionsRequest{
+			Address:     address.Bytes(),
+			StartHeight: 10,
+			EndHeight:   20,
+			Cursor:      "cursor",
+			Limit:       5,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "next", resp.GetNextCursor())
+		require.Len(t, resp.GetTransactions(), 1)
+
+		msg := resp.GetTransactions()[0]
+		assert.Equal(t, tx.TransactionID[:], msg.GetTransactionId())
+		assert.Equal(t, tx.BlockID[:], msg.GetBlockId())
+		assert.Equal(t, tx.Height, msg.GetBlockHeight())
+		assert.Equal(t, tx.TransactionIndex, msg.GetTransactionIndex())
+		assert.Equal(t, []string{"payer", "authorizer"}, msg.GetRoles())
+		api.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid address", func(t *testing.T) {
+		api := new(accessmock.API)
+		handler := access.NewExtendedHandler(api, chain)
+
+		_, err := handler.GetAccountTransactions(context.Background(), &extended.GetAccountTransactionsRequest{
+			Address: []byte{1, 2, 3},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		api.AssertNotCalled(t, "GetAccountTransactions")
+	})
+}
+
+func TestExtendedHandler_GetSlashingEvidence(t *testing.T) {
+	chain := flow.Testnet.Chain()
+
+	t.Run("by id", func(t *testing.T) {
+		api := new(accessmock.API)
+		handler := access.NewExtendedHandler(api, chain)
+
+		evidence := unittest.DoubleTimeoutEvidenceFixture(flow.Testnet, unittest.IdentifierFixture())
+		id := evidence.ID()
+		api.On("GetSlashingEvidenceByID", context.Background(), id).Return(evidence, nil).Once()
+
+		resp, err := handler.GetSlashingEvidenceByID(context.Background(), &extended.GetSlashingEvidenceByIDRequest{
+			Id: id[:],
+		})
+		require.NoError(t, err)
+
+		msg := resp.GetEvidence()
+		assert.Equal(t, id[:], msg.GetId())
+		assert.Equal(t, string(flow.SlashingEvidenceDoubleTimeout), msg.GetType())
+		assert.Equal(t, evidence.ChainID.String(), msg.GetChainId())
+		assert.Equal(t, evidence.View, msg.GetView())
+		assert.Equal(t, evidence.OffenderID[:], msg.GetOffenderId())
+		require.Len(t, msg.GetTimeouts(), 2)
+		for i, timeout := range msg.GetTimeouts() {
+			assert.Equal(t, evidence.Timeouts[i].NewestQC.View, timeout.GetNewestQc().GetView())
+			assert.Equal(t, []byte(evidence.Timeouts[i].SigData), timeout.GetSigData())
+		}
+		assert.Empty(t, msg.GetProposals())
+		assert.Empty(t, msg.GetVotes())
+		api.AssertExpectations(t)
+	})
+
+	t.Run("rejects empty id", func(t *testing.T) {
+		api := new(accessmock.API)
+		handler := access.NewExtendedHandler(api, chain)
+
+		_, err := handler.GetSlashingEvidenceByID(context.Background(), &extended.GetSlashingEvidenceByIDRequest{})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		api.AssertNotCalled(t, "GetSlashingEvidenceByID")
+	})
+
+	t.Run("by offender", func(t *testing.T) {
+		api := new(accessmock.API)
+		handler := access.NewExtendedHandler(api, chain)
+
+		offenderID := unittest.IdentifierFixture()
+		evidence := unittest.DoubleProposalEvidenceFixture(flow.Testnet, offenderID)
+		api.On("GetSlashingEvidence", context.Background(), offenderID).
+			Return([]*flow.SlashingEvidence{evidence}, nil).Once()
+
+		resp, err := handler.GetSlashingEvidence(context.Background(), &extended.GetSlashingEvidenceRequest{
+			OffenderId: offenderID[:],
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetEvidence(), 1)
+
+		proposals := resp.GetEvidence()[0].GetProposals()
+		require.Len(t, proposals, 2)
+		for i, proposal := range proposals {
+			header := evidence.Proposals[i]
+			headerID := header.ID()
+			assert.Equal(t, headerID[:], proposal.GetId())
+			assert.Equal(t, header.View, proposal.GetView())
+			assert.Equal(t, header.Timestamp.UnixNano(), proposal.GetTimestamp().AsTime().UnixNano())
+			assert.Equal(t, header.ProposerSigData, proposal.GetProposerSigData())
+		}
+		api.AssertExpectations(t)
+	})
+
+	t.Run("all evidence", func(t *testing.T) {
+		api := new(accessmock.API)
+		handler := access.NewExtendedHandler(api, chain)
+
+		api.On("GetSlashingEvidence", context.Background(), flow.ZeroID).
+			Return([]*flow.SlashingEvidence{}, nil).Once()
+
+		resp, err := handler.GetSlashingEvidence(context.Background(), &extended.GetSlashingEvidenceRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetEvidence())
+		api.AssertExpectations(t)
+	})
+}
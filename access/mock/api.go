@@ -155,6 +155,36 @@ func (_m *API) GetAccountAtLatestBlock(ctx context.Context, address flow.Address
 	return r0, r1
 }
 
+// GetAccountTransactions provides a mock function with given fields: ctx, address, startHeight, endHeight, cursor, limit
+func (_m *API) GetAccountTransactions(ctx context.Context, address flow.Address, startHeight uint64, endHeight uint64, cursor string, limit uint) ([]flow.AccountTransaction, string, error) {
+	ret := _m.Called(ctx, address, startHeight, endHeight, cursor, limit)
+
+	var r0 []flow.AccountTransaction
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64, uint64, string, uint) []flow.AccountTransaction); ok {
+		r0 = rf(ctx, address, startHeight, endHeight, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flow.AccountTransaction)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, uint64, uint64, string, uint) string); ok {
+		r1 = rf(ctx, address, startHeight, endHeight, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, flow.Address, uint64, uint64, string, uint) error); ok {
+		r2 = rf(ctx, address, startHeight, endHeight, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetBlockByHeight provides a mock function with given fields: ctx, height
 func (_m *API) GetBlockByHeight(ctx context.Context, height uint64) (*flow.Block, error) {
 	ret := _m.Called(ctx, height)
@@ -270,6 +300,36 @@ func (_m *API) GetCollectionByID(ctx context.Context, id flow.Identifier) (*flow
 	return r0, r1
 }
 
+// GetEventsByFilter provides a mock function with given fields: ctx, filter, startHeight, endHeight, cursor, limit
+func (_m *API) GetEventsByFilter(ctx context.Context, filter flow.EventFilter, startHeight uint64, endHeight uint64, cursor string, limit uint) ([]flow.BlockEvents, string, error) {
+	ret := _m.Called(ctx, filter, startHeight, endHeight, cursor, limit)
+
+	var r0 []flow.BlockEvents
+	if rf, ok := ret.Get(0).(func(context.Context, flow.EventFilter, uint64, uint64, string, uint) []flow.BlockEvents); ok {
+		r0 = rf(ctx, filter, startHeight, endHeight, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flow.BlockEvents)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, flow.EventFilter, uint64, uint64, string, uint) string); ok {
+		r1 = rf(ctx, filter, startHeight, endHeight, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, flow.EventFilter, uint64, uint64, string, uint) error); ok {
+		r2 = rf(ctx, filter, startHeight, endHeight, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetEventsForBlockIDs provides a mock function with given fields: ctx, eventType, blockIDs
 func (_m *API) GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier) ([]flow.BlockEvents, error) {
 	ret := _m.Called(ctx, eventType, blockIDs)
@@ -445,6 +505,98 @@ func (_m *API) GetNetworkParameters(ctx context.Context) access.NetworkParameter
 	return r0
 }
 
+// GetProtocolStateSnapshotByBlockID provides a mock function with given fields: ctx, blockID
+func (_m *API) GetProtocolStateSnapshotByBlockID(ctx context.Context, blockID flow.Identifier) ([]byte, error) {
+	ret := _m.Called(ctx, blockID)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) []byte); ok {
+		r0 = rf(ctx, blockID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier) error); ok {
+		r1 = rf(ctx, blockID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProtocolStateSnapshotByHeight provides a mock function with given fields: ctx, height
+func (_m *API) GetProtocolStateSnapshotByHeight(ctx context.Context, height uint64) ([]byte, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []byte); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSlashingEvidence provides a mock function with given fields: ctx, offenderID
+func (_m *API) GetSlashingEvidence(ctx context.Context, offenderID flow.Identifier) ([]*flow.SlashingEvidence, error) {
+	ret := _m.Called(ctx, offenderID)
+
+	var r0 []*flow.SlashingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) []*flow.SlashingEvidence); ok {
+		r0 = rf(ctx, offenderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*flow.SlashingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier) error); ok {
+		r1 = rf(ctx, offenderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSlashingEvidenceByID provides a mock function with given fields: ctx, id
+func (_m *API) GetSlashingEvidenceByID(ctx context.Context, id flow.Identifier) (*flow.SlashingEvidence, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *flow.SlashingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) *flow.SlashingEvidence); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.SlashingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransaction provides a mock function with given fields: ctx, id
 func (_m *API) GetTransaction(ctx context.Context, id flow.Identifier) (*flow.TransactionBody, error) {
 	ret := _m.Called(ctx, id)
@@ -0,0 +1,123 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+var _ commands.AdminCommand = (*GetRootQCVoteCommand)(nil)
+
+type getRootQCVoteRequest struct {
+	epochCounter *uint64 // counter of the epoch the root QC is voted for, nil means the next epoch
+}
+
+// rootQCVoteReport is the response of the GetRootQCVoteCommand.
+type rootQCVoteReport struct {
+	EpochCounter       uint64
+	ClusterIndex       uint
+	Status             string
+	Attempts           uint
+	LastAttempt        string
+	LastError          string
+	ClusterSize        int
+	ClusterVoted       flow.IdentifierList
+	ClusterNotVoted    flow.IdentifierList
+	ClusterLastChecked string
+}
+
+// GetRootQCVoteCommand returns the status of this node's vote for the root QC
+// of its cluster in the given epoch, and the votes of its cluster members as
+// last observed in the contract.
+type GetRootQCVoteCommand struct {
+	state protocol.State
+	votes storage.RootQCVotes
+}
+
+func (g *GetRootQCVoteCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(*getRootQCVoteRequest)
+
+	var epochCounter uint64
+	if data.epochCounter != nil {
+		epochCounter = *data.epochCounter
+	} else {
+		// by default, we report the vote which is cast during the current epoch for the next epoch
+		currentCounter, err := g.state.Final().Epochs().Current().Counter()
+		if err != nil {
+			return nil, fmt.Errorf("could not get current epoch counter: %w", err)
+		}
+		epochCounter = currentCounter + 1
+	}
+
+	voteState, err := g.votes.ByEpochCounter(epochCounter)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("no root qc vote found for epoch %d", epochCounter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve root qc vote for epoch %d: %w", epochCounter, err)
+	}
+
+	notVoted := make(flow.IdentifierList, 0, len(voteState.ClusterMembers))
+	for _, nodeID := range voteState.ClusterMembers {
+		if !voteState.ClusterVoted.Contains(nodeID) {
+			notVoted = append(notVoted, nodeID)
+		}
+	}
+
+	report := &rootQCVoteReport{
+		EpochCounter:    voteState.EpochCounter,
+		ClusterIndex:    voteState.ClusterIndex,
+		Status:          voteState.Status.String(),
+		Attempts:        voteState.Attempts,
+		LastError:       voteState.LastError,
+		ClusterSize:     len(voteState.ClusterMembers),
+		ClusterVoted:    voteState.ClusterVoted,
+		ClusterNotVoted: notVoted,
+	}
+	if !voteState.LastAttempt.IsZero() {
+		report.LastAttempt = voteState.LastAttempt.String()
+	}
+	if !voteState.LastChecked.IsZero() {
+		report.ClusterLastChecked = voteState.LastChecked.String()
+	}
+
+	return commands.ConvertToMap(report)
+}
+
+func (g *GetRootQCVoteCommand) Validator(req *admin.CommandRequest) error {
+	data := &getRootQCVoteRequest{}
+	req.ValidatorData = data
+
+	if req.Data == nil {
+		return nil
+	}
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return errors.New("wrong input format: expected JSON")
+	}
+
+	if epoch, ok := input["epoch"]; ok {
+		epoch, ok := epoch.(float64)
+		if !ok || epoch < 0 || math.Trunc(epoch) != epoch {
+			return fmt.Errorf("invalid value for \"epoch\": expected a non-negative integer, but got: %v", input["epoch"])
+		}
+		epochCounter := uint64(epoch)
+		data.epochCounter = &epochCounter
+	}
+
+	return nil
+}
+
+func NewGetRootQCVoteCommand(state protocol.State, votes storage.RootQCVotes) commands.AdminCommand {
+	return &GetRootQCVoteCommand{
+		state: state,
+		votes: votes,
+	}
+}
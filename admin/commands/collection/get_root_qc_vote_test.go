@@ -0,0 +1,71 @@
+package collection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/model/flow"
+	protocolmock "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestGetRootQCVote(t *testing.T) {
+	t.Parallel()
+
+	members := unittest.IdentifierListFixture(3)
+	voteState := unittest.RootQCVoteStateFixture(2, members)
+
+	currentEpoch := new(protocolmock.Epoch)
+	currentEpoch.On("Counter").Return(uint64(1), nil)
+	epochs := new(protocolmock.EpochQuery)
+	epochs.On("Current").Return(currentEpoch)
+	snapshot := new(protocolmock.Snapshot)
+	snapshot.On("Epochs").Return(epochs)
+	state := new(protocolmock.State)
+	state.On("Final").Return(snapshot)
+
+	votes := new(storagemock.RootQCVotes)
+	votes.On("ByEpochCounter", uint64(2)).Return(voteState, nil)
+	votes.On("ByEpochCounter", uint64(3)).Return(nil, storage.ErrNotFound)
+
+	command := NewGetRootQCVoteCommand(state, votes)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("next epoch by default", func(t *testing.T) {
+		req := &admin.CommandRequest{}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		resultMap := result.(map[string]interface{})
+		require.Equal(t, float64(2), resultMap["EpochCounter"])
+		require.Equal(t, flow.RootQCVoteStatusVoted.String(), resultMap["Status"])
+		require.Equal(t, float64(2), resultMap["Attempts"])
+		require.Equal(t, float64(3), resultMap["ClusterSize"])
+		require.Len(t, resultMap["ClusterVoted"], 2)
+		require.Equal(t, []interface{}{members[2].String()}, resultMap["ClusterNotVoted"])
+		require.NotEmpty(t, resultMap["ClusterLastChecked"])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{"epoch": float64(3)},
+		}
+		require.NoError(t, command.Validator(req))
+		_, err := command.Handler(ctx, req)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for _, data := range []interface{}{"next", map[string]interface{}{"epoch": float64(-1)}, map[string]interface{}{"epoch": 1.5}, map[string]interface{}{"epoch": "1"}} {
+			require.Error(t, command.Validator(&admin.CommandRequest{Data: data}))
+		}
+	})
+}
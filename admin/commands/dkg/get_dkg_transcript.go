@@ -0,0 +1,108 @@
+package dkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+var _ commands.AdminCommand = (*GetDKGTranscriptCommand)(nil)
+
+type getDKGTranscriptRequest struct {
+	epochCounter *uint64 // counter of the epoch the DKG was run for, nil means the next epoch
+}
+
+// dkgTranscriptReport is the response of the GetDKGTranscriptCommand.
+type dkgTranscriptReport struct {
+	EpochCounter      uint64
+	EndState          string
+	MissingBroadcasts []int
+	Disqualified      []int
+	Transcript        *flow.DKGTranscriptSummary
+}
+
+// GetDKGTranscriptCommand returns the transcript summary of the DKG that this node ran for
+// the given epoch: phase transitions, broadcasts sent and received, complaints,
+// disqualifications and the submitted result hash.
+type GetDKGTranscriptCommand struct {
+	state    protocol.State
+	dkgState storage.DKGState
+}
+
+func (g *GetDKGTranscriptCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(*getDKGTranscriptRequest)
+
+	var epochCounter uint64
+	if data.epochCounter != nil {
+		epochCounter = *data.epochCounter
+	} else {
+		// by default, we report the DKG which is run during the current epoch for the next epoch
+		currentCounter, err := g.state.Final().Epochs().Current().Counter()
+		if err != nil {
+			return nil, fmt.Errorf("could not get current epoch counter: %w", err)
+		}
+		epochCounter = currentCounter + 1
+	}
+
+	summary, err := g.dkgState.RetrieveDKGTranscriptSummary(epochCounter)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("no dkg transcript found for epoch %d", epochCounter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve dkg transcript for epoch %d: %w", epochCounter, err)
+	}
+
+	// the end state is only set once the DKG is complete
+	endState, err := g.dkgState.GetDKGEndState(epochCounter)
+	if errors.Is(err, storage.ErrNotFound) {
+		endState = flow.DKGEndStateUnknown
+	} else if err != nil {
+		return nil, fmt.Errorf("could not retrieve dkg end state for epoch %d: %w", epochCounter, err)
+	}
+
+	return commands.ConvertToMap(&dkgTranscriptReport{
+		EpochCounter:      epochCounter,
+		EndState:          endState.String(),
+		MissingBroadcasts: summary.MissingBroadcasts(),
+		Disqualified:      summary.Disqualified(),
+		Transcript:        summary,
+	})
+}
+
+func (g *GetDKGTranscriptCommand) Validator(req *admin.CommandRequest) error {
+	data := &getDKGTranscriptRequest{}
+	req.ValidatorData = data
+
+	if req.Data == nil {
+		return nil
+	}
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return errors.New("wrong input format: expected JSON")
+	}
+
+	if epoch, ok := input["epoch"]; ok {
+		epoch, ok := epoch.(float64)
+		if !ok || epoch < 0 || math.Trunc(epoch) != epoch {
+			return fmt.Errorf("invalid value for \"epoch\": expected a non-negative integer, but got: %v", input["epoch"])
+		}
+		epochCounter := uint64(epoch)
+		data.epochCounter = &epochCounter
+	}
+
+	return nil
+}
+
+func NewGetDKGTranscriptCommand(state protocol.State, dkgState storage.DKGState) commands.AdminCommand {
+	return &GetDKGTranscriptCommand{
+		state:    state,
+		dkgState: dkgState,
+	}
+}
@@ -0,0 +1,89 @@
+package dkg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/model/flow"
+	protocolmock "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestGetDKGTranscript(t *testing.T) {
+	t.Parallel()
+
+	committee := unittest.IdentityListFixture(3)
+	summary := unittest.DKGTranscriptSummaryFixture(committee)
+
+	currentEpoch := new(protocolmock.Epoch)
+	currentEpoch.On("Counter").Return(uint64(1), nil)
+	epochs := new(protocolmock.EpochQuery)
+	epochs.On("Current").Return(currentEpoch)
+	snapshot := new(protocolmock.Snapshot)
+	snapshot.On("Epochs").Return(epochs)
+	state := new(protocolmock.State)
+	state.On("Final").Return(snapshot)
+
+	dkgState := new(storagemock.DKGState)
+	dkgState.On("RetrieveDKGTranscriptSummary", uint64(2)).Return(summary, nil)
+	dkgState.On("GetDKGEndState", uint64(2)).Return(flow.DKGEndStateSuccess, nil)
+	dkgState.On("RetrieveDKGTranscriptSummary", uint64(3)).Return(summary, nil)
+	dkgState.On("GetDKGEndState", uint64(3)).Return(flow.DKGEndStateUnknown, storage.ErrNotFound)
+	dkgState.On("RetrieveDKGTranscriptSummary", uint64(4)).Return(nil, storage.ErrNotFound)
+
+	command := NewGetDKGTranscriptCommand(state, dkgState)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("next epoch by default", func(t *testing.T) {
+		req := &admin.CommandRequest{}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		resultMap := result.(map[string]interface{})
+		require.Equal(t, float64(2), resultMap["EpochCounter"])
+		require.Equal(t, flow.DKGEndStateSuccess.String(), resultMap["EndState"])
+		require.Equal(t, []interface{}{float64(0)}, resultMap["MissingBroadcasts"])
+		require.Equal(t, []interface{}{float64(0)}, resultMap["Disqualified"])
+
+		transcript := resultMap["Transcript"].(map[string]interface{})
+		require.Equal(t, summary.DKGInstanceID, transcript["DKGInstanceID"])
+		require.Equal(t, summary.ResultHash.String(), transcript["ResultHash"])
+		require.Len(t, transcript["Participants"], len(committee))
+	})
+
+	t.Run("dkg in progress", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{"epoch": float64(3)},
+		}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		resultMap := result.(map[string]interface{})
+		require.Equal(t, float64(3), resultMap["EpochCounter"])
+		require.Equal(t, flow.DKGEndStateUnknown.String(), resultMap["EndState"])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{"epoch": float64(4)},
+		}
+		require.NoError(t, command.Validator(req))
+		_, err := command.Handler(ctx, req)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for _, data := range []interface{}{"next", map[string]interface{}{"epoch": float64(-1)}, map[string]interface{}{"epoch": 1.5}, map[string]interface{}{"epoch": "1"}} {
+			require.Error(t, command.Validator(&admin.CommandRequest{Data: data}))
+		}
+	})
+}
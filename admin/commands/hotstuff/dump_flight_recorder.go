@@ -0,0 +1,104 @@
+package hotstuff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+)
+
+var _ commands.AdminCommand = (*DumpFlightRecorderCommand)(nil)
+
+// FlightRecorderDumper provides a snapshot of the consensus events held by a flight recorder.
+type FlightRecorderDumper interface {
+	Dump() *flightrecorder.Dump
+}
+
+type dumpFlightRecorderRequest struct {
+	fromView  uint64 // lowest view of the events to return
+	toView    uint64 // highest view of the events to return
+	numEvents uint64 // max number of most recent events to return, zero means all
+}
+
+// DumpFlightRecorderCommand returns the consensus events held by the HotStuff flight recorder,
+// optionally restricted to a range of views and to the most recent events. The dumps of
+// several nodes can be merged into a timeline per view with the hotstuff-timeline util command.
+type DumpFlightRecorderCommand struct {
+	dumper FlightRecorderDumper
+}
+
+func (d *DumpFlightRecorderCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(*dumpFlightRecorderRequest)
+
+	dump := d.dumper.Dump()
+
+	events := make([]model.FlightEvent, 0, len(dump.Events))
+	for _, event := range dump.Events {
+		if event.View < data.fromView || event.View > data.toView {
+			continue
+		}
+		events = append(events, event)
+	}
+	if data.numEvents > 0 && uint64(len(events)) > data.numEvents {
+		events = events[uint64(len(events))-data.numEvents:]
+	}
+	dump.Events = events
+
+	return commands.ConvertToMap(dump)
+}
+
+func (d *DumpFlightRecorderCommand) Validator(req *admin.CommandRequest) error {
+	data := &dumpFlightRecorderRequest{
+		toView: math.MaxUint64,
+	}
+	req.ValidatorData = data
+
+	if req.Data == nil {
+		return nil
+	}
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return errors.New("wrong input format: expected JSON")
+	}
+
+	var err error
+	if data.fromView, err = parseUint(input, "from_view", 0); err != nil {
+		return err
+	}
+	if data.toView, err = parseUint(input, "to_view", math.MaxUint64); err != nil {
+		return err
+	}
+	if data.fromView > data.toView {
+		return fmt.Errorf("invalid view range: from_view (%d) is larger than to_view (%d)", data.fromView, data.toView)
+	}
+	if data.numEvents, err = parseUint(input, "n", 0); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseUint returns the non-negative integer value of the given field, or the default
+// value if the field isn't set.
+func parseUint(input map[string]interface{}, field string, defaultValue uint64) (uint64, error) {
+	value, ok := input[field]
+	if !ok {
+		return defaultValue, nil
+	}
+	n, ok := value.(float64)
+	if !ok || n < 0 || math.Trunc(n) != n {
+		return 0, fmt.Errorf("invalid value for %q: expected a non-negative integer, but got: %v", field, value)
+	}
+	return uint64(n), nil
+}
+
+func NewDumpFlightRecorderCommand(dumper FlightRecorderDumper) commands.AdminCommand {
+	return &DumpFlightRecorderCommand{
+		dumper: dumper,
+	}
+}
@@ -0,0 +1,82 @@
+package hotstuff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestDumpFlightRecorder(t *testing.T) {
+	t.Parallel()
+
+	nodeID := unittest.IdentifierFixture()
+	recorder, err := flightrecorder.New(zerolog.Nop(), nodeID, flow.Emulator, 100)
+	require.NoError(t, err)
+	for view := uint64(1); view <= 10; view++ {
+		recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: view})
+	}
+	command := NewDumpFlightRecorderCommand(recorder)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// views returns the views of the events in the command result
+	views := func(result interface{}) []float64 {
+		resultMap := result.(map[string]interface{})
+		require.Equal(t, nodeID.String(), resultMap["node_id"])
+		var views []float64
+		for _, event := range resultMap["events"].([]interface{}) {
+			views = append(views, event.(map[string]interface{})["view"].(float64))
+		}
+		return views
+	}
+
+	t.Run("all events", func(t *testing.T) {
+		req := &admin.CommandRequest{}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, views(result))
+	})
+
+	t.Run("view range", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{"from_view": float64(3), "to_view": float64(5)},
+		}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []float64{3, 4, 5}, views(result))
+	})
+
+	t.Run("most recent events", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{"from_view": float64(3), "n": float64(2)},
+		}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []float64{9, 10}, views(result))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		invalid := []interface{}{
+			"events",
+			map[string]interface{}{"n": -1},
+			map[string]interface{}{"n": 1.5},
+			map[string]interface{}{"from_view": "1"},
+			map[string]interface{}{"from_view": float64(5), "to_view": float64(4)},
+		}
+		for _, data := range invalid {
+			require.Error(t, command.Validator(&admin.CommandRequest{Data: data}), data)
+		}
+	})
+}
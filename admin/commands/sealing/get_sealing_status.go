@@ -0,0 +1,77 @@
+package sealing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
+)
+
+var _ commands.AdminCommand = (*GetSealingStatusCommand)(nil)
+
+// SealingStatusProvider provides the latest sealing status, or nil if it is not known yet.
+type SealingStatusProvider interface {
+	LatestStatus() *tracker.SealingStatus
+}
+
+type getSealingStatusRequest struct {
+	numBlocks uint64 // max number of unsealed blocks to return, zero means all
+}
+
+// GetSealingStatusCommand returns the sealing status of the finalized but unsealed blocks: which
+// chunks are missing approvals from which assigned verifiers, and which execution nodes have
+// committed receipts.
+type GetSealingStatusCommand struct {
+	provider SealingStatusProvider
+}
+
+func (g *GetSealingStatusCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(*getSealingStatusRequest)
+
+	status := g.provider.LatestStatus()
+	if status == nil {
+		return nil, errors.New("sealing status is not available yet")
+	}
+
+	if data.numBlocks > 0 && uint64(len(status.Blocks)) > data.numBlocks {
+		// the status is shared with other readers, so we truncate a shallow copy
+		truncated := *status
+		truncated.Blocks = status.Blocks[:data.numBlocks]
+		status = &truncated
+	}
+
+	return commands.ConvertToMap(status)
+}
+
+func (g *GetSealingStatusCommand) Validator(req *admin.CommandRequest) error {
+	data := &getSealingStatusRequest{}
+	req.ValidatorData = data
+
+	if req.Data == nil {
+		return nil
+	}
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return errors.New("wrong input format: expected JSON")
+	}
+
+	if n, ok := input["n"]; ok {
+		n, ok := n.(float64)
+		if !ok || n < 1 || math.Trunc(n) != n {
+			return fmt.Errorf("invalid value for \"n\": expected a positive integer, but got: %v", input["n"])
+		}
+		data.numBlocks = uint64(n)
+	}
+
+	return nil
+}
+
+func NewGetSealingStatusCommand(provider SealingStatusProvider) commands.AdminCommand {
+	return &GetSealingStatusCommand{
+		provider: provider,
+	}
+}
@@ -0,0 +1,82 @@
+package sealing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+type statusProvider struct {
+	status *tracker.SealingStatus
+}
+
+func (p *statusProvider) LatestStatus() *tracker.SealingStatus {
+	return p.status
+}
+
+func TestGetSealingStatus(t *testing.T) {
+	t.Parallel()
+
+	status := &tracker.SealingStatus{
+		FinalizedBlockID:     unittest.IdentifierFixture(),
+		FinalizedBlockHeight: 12,
+		SealedBlockHeight:    10,
+		UnsealedBlocks:       2,
+		Blocks: []*tracker.BlockSealingStatus{
+			{BlockID: unittest.IdentifierFixture(), Height: 11},
+			{BlockID: unittest.IdentifierFixture(), Height: 12},
+		},
+	}
+	provider := &statusProvider{}
+	command := NewGetSealingStatusCommand(provider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("not available", func(t *testing.T) {
+		req := &admin.CommandRequest{}
+		require.NoError(t, command.Validator(req))
+		_, err := command.Handler(ctx, req)
+		require.Error(t, err)
+	})
+
+	provider.status = status
+
+	t.Run("all blocks", func(t *testing.T) {
+		req := &admin.CommandRequest{}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		resultMap := result.(map[string]interface{})
+		require.Equal(t, status.FinalizedBlockID.String(), resultMap["FinalizedBlockID"])
+		require.Len(t, resultMap["Blocks"], 2)
+	})
+
+	t.Run("limited blocks", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{"n": float64(1)},
+		}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		blocks := result.(map[string]interface{})["Blocks"].([]interface{})
+		require.Len(t, blocks, 1)
+		require.Equal(t, float64(11), blocks[0].(map[string]interface{})["Height"])
+
+		// the retained status must not be modified
+		require.Len(t, status.Blocks, 2)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for _, data := range []interface{}{"all", map[string]interface{}{"n": float64(0)}, map[string]interface{}{"n": 1.5}} {
+			require.Error(t, command.Validator(&admin.CommandRequest{Data: data}))
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/backup"
+)
+
+var _ commands.AdminCommand = (*CreateBackupCommand)(nil)
+
+type createBackupRequest struct {
+	name  string
+	store backup.StoreConfig
+}
+
+// CreateBackupCommand takes a consistent online backup of the protocol database
+// and, on execution nodes, of the execution state. The backup is written to a
+// local directory, a GCP bucket or a S3 bucket, together with a manifest.
+type CreateBackupCommand struct {
+	log               zerolog.Logger
+	nodeID            flow.Identifier
+	role              flow.Role
+	db                *badger.DB
+	wal               func() *wal.DiskWAL
+	dataEncryptionKey []byte
+}
+
+func (c *CreateBackupCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(*createBackupRequest)
+
+	if c.db == nil {
+		return nil, errors.New("backups are only supported for protocol databases using the badger backend")
+	}
+
+	var diskWAL *wal.DiskWAL
+	if c.wal != nil {
+		diskWAL = c.wal()
+		if diskWAL == nil {
+			return nil, errors.New("execution state is not initialized yet")
+		}
+	}
+
+	store, err := backup.NewStore(ctx, data.store)
+	if err != nil {
+		return nil, fmt.Errorf("could not create backup store: %w", err)
+	}
+
+	name := data.name
+	if name == "" {
+		name = backup.DefaultName(c.role, c.nodeID, time.Now())
+	}
+
+	manifest, err := backup.Create(ctx, c.log, store, name, backup.Config{
+		NodeID:            c.nodeID,
+		Role:              c.role,
+		DB:                c.db,
+		WAL:               diskWAL,
+		DataEncryptionKey: c.dataEncryptionKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create backup %s: %w", name, err)
+	}
+
+	return commands.ConvertToMap(map[string]interface{}{
+		"name":     name,
+		"manifest": manifest,
+	})
+}
+
+func (c *CreateBackupCommand) Validator(req *admin.CommandRequest) error {
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return errors.New("wrong input format: expected JSON with one of \"dir\", \"gcs-bucket\" and \"s3-bucket\"")
+	}
+
+	data := &createBackupRequest{}
+	fields := map[string]*string{
+		"name":       &data.name,
+		"dir":        &data.store.Dir,
+		"gcs-bucket": &data.store.GCSBucket,
+		"s3-bucket":  &data.store.S3Bucket,
+	}
+	for field, value := range input {
+		target, ok := fields[field]
+		if !ok {
+			return fmt.Errorf("unknown field %q", field)
+		}
+		str, ok := value.(string)
+		if !ok || str == "" {
+			return fmt.Errorf("invalid value for %q: expected a non-empty string, but got: %v", field, value)
+		}
+		*target = str
+	}
+
+	locations := 0
+	for _, location := range []string{data.store.Dir, data.store.GCSBucket, data.store.S3Bucket} {
+		if location != "" {
+			locations++
+		}
+	}
+	if locations != 1 {
+		return errors.New("exactly one of \"dir\", \"gcs-bucket\" and \"s3-bucket\" is required")
+	}
+
+	if data.name != "" {
+		err := backup.ValidateName(data.name)
+		if err != nil {
+			return err
+		}
+	}
+
+	req.ValidatorData = data
+	return nil
+}
+
+// NewCreateBackupCommand returns a command backing up the protocol database.
+// The data encryption key, if set, encrypts the backup. The database is nil if
+// it doesn't use the badger backend, in which case backups are rejected.
+func NewCreateBackupCommand(log zerolog.Logger, nodeID flow.Identifier, role flow.Role, db *badger.DB, dataEncryptionKey []byte) commands.AdminCommand {
+	return &CreateBackupCommand{
+		log:               log.With().Str("admin_command", "create-backup").Logger(),
+		nodeID:            nodeID,
+		role:              role,
+		db:                db,
+		dataEncryptionKey: dataEncryptionKey,
+	}
+}
+
+// NewCreateExecutionBackupCommand returns a command backing up the protocol
+// database and the execution state. The WAL is initialized after admin
+// commands are created, so it is retrieved when a backup is taken.
+func NewCreateExecutionBackupCommand(log zerolog.Logger, nodeID flow.Identifier, db *badger.DB, diskWAL func() *wal.DiskWAL, dataEncryptionKey []byte) commands.AdminCommand {
+	command := NewCreateBackupCommand(log, nodeID, flow.RoleExecution, db, dataEncryptionKey).(*CreateBackupCommand)
+	command.wal = diskWAL
+	return command
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/backup"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestCreateBackup_Validator(t *testing.T) {
+	t.Parallel()
+
+	command := NewCreateBackupCommand(zerolog.Nop(), unittest.IdentifierFixture(), flow.RoleConsensus, nil, nil)
+
+	invalid := []interface{}{
+		nil,
+		map[string]interface{}{},
+		map[string]interface{}{"dir": "/backups", "s3-bucket": "backups"},
+		map[string]interface{}{"dir": 1},
+		map[string]interface{}{"dir": "/backups", "name": "../escape"},
+		map[string]interface{}{"dir": "/backups", "bucket": "backups"},
+	}
+	for _, data := range invalid {
+		assert.Error(t, command.Validator(&admin.CommandRequest{Data: data}), data)
+	}
+
+	req := &admin.CommandRequest{
+		Data: map[string]interface{}{
+			"gcs-bucket": "backups",
+			"name":       "consensus/latest",
+		},
+	}
+	require.NoError(t, command.Validator(req))
+	data := req.ValidatorData.(*createBackupRequest)
+	assert.Equal(t, "consensus/latest", data.name)
+	assert.Equal(t, backup.StoreConfig{GCSBucket: "backups"}, data.store)
+}
+
+func TestCreateBackup_Handler(t *testing.T) {
+	t.Parallel()
+
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		header := unittest.BlockHeaderFixture()
+		require.NoError(t, badgerkv.New(db).Update(func(tx kv.Transaction) error {
+			err := operation.IndexBlockHeight(header.Height, header.ID())(tx)
+			if err != nil {
+				return err
+			}
+			err = operation.InsertFinalizedHeight(header.Height)(tx)
+			if err != nil {
+				return err
+			}
+			return operation.InsertSealedHeight(header.Height)(tx)
+		}))
+
+		unittest.RunWithTempDir(t, func(dir string) {
+			nodeID := unittest.IdentifierFixture()
+			command := NewCreateBackupCommand(zerolog.Nop(), nodeID, flow.RoleAccess, db, nil)
+
+			req := &admin.CommandRequest{
+				Data: map[string]interface{}{
+					"dir":  dir,
+					"name": "access",
+				},
+			}
+			require.NoError(t, command.Validator(req))
+			result, err := command.Handler(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, "access", result.(map[string]interface{})["name"])
+			assert.FileExists(t, filepath.Join(dir, "access", backup.ManifestName))
+
+			manifest, err := backup.ReadManifest(context.Background(), backup.NewLocalStore(dir), "access")
+			require.NoError(t, err)
+			assert.Equal(t, nodeID, manifest.NodeID)
+			assert.Equal(t, header.ID(), manifest.FinalizedBlockID)
+		})
+	})
+}
+
+func TestCreateBackup_WALNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	command := NewCreateExecutionBackupCommand(zerolog.Nop(), unittest.IdentifierFixture(), nil, func() *wal.DiskWAL { return nil }, nil)
+
+	req := &admin.CommandRequest{
+		Data: map[string]interface{}{
+			"dir": t.TempDir(),
+		},
+	}
+	require.NoError(t, command.Validator(req))
+	_, err := command.Handler(context.Background(), req)
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+var _ commands.AdminCommand = (*ReadSlashingEvidenceCommand)(nil)
+
+type readSlashingEvidenceRequestType int
+
+const (
+	readSlashingEvidenceRequestAll readSlashingEvidenceRequestType = iota
+	readSlashingEvidenceRequestByID
+	readSlashingEvidenceRequestByOffender
+)
+
+type readSlashingEvidenceRequest struct {
+	requestType readSlashingEvidenceRequestType
+	value       flow.Identifier
+}
+
+// ReadSlashingEvidenceCommand returns the evidence of slashable protocol violations stored by
+// this node, either a single evidence by ID, all evidence against an offender, or all evidence.
+type ReadSlashingEvidenceCommand struct {
+	evidence storage.SlashingEvidence
+}
+
+func (r *ReadSlashingEvidenceCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(*readSlashingEvidenceRequest)
+
+	switch data.requestType {
+	case readSlashingEvidenceRequestByID:
+		evidence, err := r.evidence.ByID(data.value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get slashing evidence by ID: %w", err)
+		}
+		return commands.ConvertToMap(evidence)
+	case readSlashingEvidenceRequestByOffender:
+		evidence, err := r.evidence.ByOffender(data.value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get slashing evidence by offender: %w", err)
+		}
+		return commands.ConvertToInterfaceList(evidence)
+	default:
+		evidence, err := r.evidence.All()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get slashing evidence: %w", err)
+		}
+		return commands.ConvertToInterfaceList(evidence)
+	}
+}
+
+func (r *ReadSlashingEvidenceCommand) Validator(req *admin.CommandRequest) error {
+	data := &readSlashingEvidenceRequest{}
+	req.ValidatorData = data
+
+	if req.Data == nil {
+		return nil
+	}
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return ErrValidatorReqDataFormat
+	}
+
+	_, hasEvidence := input["evidence"]
+	_, hasOffender := input["offender"]
+	if hasEvidence && hasOffender {
+		return errors.New("at most one of \"evidence\" and \"offender\" fields may be given")
+	}
+
+	for field, requestType := range map[string]readSlashingEvidenceRequestType{
+		"evidence": readSlashingEvidenceRequestByID,
+		"offender": readSlashingEvidenceRequestByOffender,
+	} {
+		value, ok := input[field]
+		if !ok {
+			continue
+		}
+		errInvalidValue := fmt.Errorf("invalid value for %q: expected an ID represented as a 64 character long hex string, but got: %v", field, value)
+		str, ok := value.(string)
+		if !ok {
+			return errInvalidValue
+		}
+		id, err := flow.HexStringToIdentifier(str)
+		if err != nil {
+			return errInvalidValue
+		}
+		data.requestType = requestType
+		data.value = id
+	}
+
+	return nil
+}
+
+func NewReadSlashingEvidenceCommand(evidence storage.SlashingEvidence) commands.AdminCommand {
+	return &ReadSlashingEvidenceCommand{
+		evidence: evidence,
+	}
+}
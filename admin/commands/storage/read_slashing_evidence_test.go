@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestReadSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	offenderID := unittest.IdentifierFixture()
+	vote := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offenderID)
+	proposal := unittest.DoubleProposalEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+
+	evidence := new(storagemock.SlashingEvidence)
+	evidence.On("ByID", vote.ID()).Return(vote, nil)
+	evidence.On("ByID", proposal.ID()).Return(nil, storage.ErrNotFound)
+	evidence.On("ByOffender", offenderID).Return([]*flow.SlashingEvidence{vote}, nil)
+	evidence.On("All").Return([]*flow.SlashingEvidence{vote, proposal}, nil)
+
+	command := NewReadSlashingEvidenceCommand(evidence)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("by ID", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"evidence": vote.ID().String(),
+			},
+		}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		expected, err := commands.ConvertToMap(vote)
+		require.NoError(t, err)
+		assert.DeepEqual(t, result, expected)
+	})
+
+	t.Run("by unknown ID", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"evidence": proposal.ID().String(),
+			},
+		}
+		require.NoError(t, command.Validator(req))
+		_, err := command.Handler(ctx, req)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("by offender", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"offender": offenderID.String(),
+			},
+		}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		expected, err := commands.ConvertToInterfaceList([]*flow.SlashingEvidence{vote})
+		require.NoError(t, err)
+		assert.DeepEqual(t, result, expected)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		req := &admin.CommandRequest{}
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(ctx, req)
+		require.NoError(t, err)
+
+		expected, err := commands.ConvertToInterfaceList([]*flow.SlashingEvidence{vote, proposal})
+		require.NoError(t, err)
+		assert.DeepEqual(t, result, expected)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for _, data := range []interface{}{
+			"foo",
+			map[string]interface{}{"evidence": 1},
+			map[string]interface{}{"offender": "not an ID"},
+			map[string]interface{}{"evidence": vote.ID().String(), "offender": offenderID.String()},
+		} {
+			require.Error(t, command.Validator(&admin.CommandRequest{Data: data}))
+		}
+	})
+}
@@ -93,11 +93,16 @@ type AccessNodeConfig struct {
 	rpcConf                      rpc.Config
 	ExecutionNodeAddress         string // deprecated
 	HistoricalAccessRPCs         []access.AccessAPIClient
+	HistoricalSporks             []backend.HistoricalSpork
 	logTxTimeToFinalized         bool
 	logTxTimeToExecuted          bool
 	logTxTimeToFinalizedExecuted bool
 	retryEnabled                 bool
 	rpcMetricsEnabled            bool
+	indexEventsEnabled           bool
+	indexStartHeight             uint64
+	indexAccountTxEnabled        bool
+	indexAccountTxStartHeight    uint64
 	baseOptions                  []cmd.Option
 
 	PublicNetworkConfig PublicNetworkConfig
@@ -122,6 +127,7 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 			RESTListenAddr:            "",
 			CollectionAddr:            "",
 			HistoricalAccessAddrs:     "",
+			SporkDirectoryFile:        "",
 			CollectionClientTimeout:   3 * time.Second,
 			ExecutionClientTimeout:    3 * time.Second,
 			MaxHeightRange:            backend.DefaultMaxHeightRange,
@@ -135,6 +141,10 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 		pingEnabled:                  false,
 		retryEnabled:                 false,
 		rpcMetricsEnabled:            false,
+		indexEventsEnabled:           false,
+		indexStartHeight:             0,
+		indexAccountTxEnabled:        false,
+		indexAccountTxStartHeight:    0,
 		nodeInfoFile:                 "",
 		apiRatelimits:                nil,
 		apiBurstlimits:               nil,
@@ -293,7 +303,7 @@ func (builder *FlowAccessNodeBuilder) buildFollowerCore() *FlowAccessNodeBuilder
 func (builder *FlowAccessNodeBuilder) buildFollowerEngine() *FlowAccessNodeBuilder {
 	builder.Component("follower engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 		// initialize cleaner for DB
-		cleaner := storage.NewCleaner(node.Logger, node.DB, builder.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
+		cleaner := storage.NewCleaner(node.Logger, node.BadgerDB, builder.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
 		conCache := buffer.NewPendingBlocks()
 
 		followerEng, err := follower.New(
@@ -439,6 +449,7 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 		flags.StringVarP(&builder.rpcConf.CollectionAddr, "static-collection-ingress-addr", "", defaultConfig.rpcConf.CollectionAddr, "the address (of the collection node) to send transactions to")
 		flags.StringVarP(&builder.ExecutionNodeAddress, "script-addr", "s", defaultConfig.ExecutionNodeAddress, "the address (of the execution node) forward the script to")
 		flags.StringVarP(&builder.rpcConf.HistoricalAccessAddrs, "historical-access-addr", "", defaultConfig.rpcConf.HistoricalAccessAddrs, "comma separated rpc addresses for historical access nodes")
+		flags.StringVar(&builder.rpcConf.SporkDirectoryFile, "spork-directory-file", defaultConfig.rpcConf.SporkDirectoryFile, "path to a JSON file listing the root heights, root block IDs and access node addresses of previous sporks, to route calls for their blocks")
 		flags.DurationVar(&builder.rpcConf.CollectionClientTimeout, "collection-client-timeout", defaultConfig.rpcConf.CollectionClientTimeout, "grpc client timeout for a collection node")
 		flags.DurationVar(&builder.rpcConf.ExecutionClientTimeout, "execution-client-timeout", defaultConfig.rpcConf.ExecutionClientTimeout, "grpc client timeout for an execution node")
 		flags.UintVar(&builder.rpcConf.MaxHeightRange, "rpc-max-height-range", defaultConfig.rpcConf.MaxHeightRange, "maximum size for height range requests")
@@ -450,6 +461,10 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 		flags.BoolVar(&builder.pingEnabled, "ping-enabled", defaultConfig.pingEnabled, "whether to enable the ping process that pings all other peers and report the connectivity to metrics")
 		flags.BoolVar(&builder.retryEnabled, "retry-enabled", defaultConfig.retryEnabled, "whether to enable the retry mechanism at the access node level")
 		flags.BoolVar(&builder.rpcMetricsEnabled, "rpc-metrics-enabled", defaultConfig.rpcMetricsEnabled, "whether to enable the rpc metrics")
+		flags.BoolVar(&builder.indexEventsEnabled, "index-events-enabled", defaultConfig.indexEventsEnabled, "whether to index the events of sealed blocks to serve filtered and paginated event queries")
+		flags.Uint64Var(&builder.indexStartHeight, "index-start-height", defaultConfig.indexStartHeight, "height to start indexing from, defaults to the first block after the root block")
+		flags.BoolVar(&builder.indexAccountTxEnabled, "index-account-transactions-enabled", defaultConfig.indexAccountTxEnabled, "whether to index the accounts involved in the transactions of sealed blocks to serve account transaction history queries")
+		flags.Uint64Var(&builder.indexAccountTxStartHeight, "index-account-transactions-start-height", defaultConfig.indexAccountTxStartHeight, "height to start indexing account transactions from, defaults to the first block after the root block")
 		flags.StringVarP(&builder.nodeInfoFile, "node-info-file", "", defaultConfig.nodeInfoFile, "full path to a json file which provides more details about nodes when reporting its reachability metrics")
 		flags.StringToIntVar(&builder.apiRatelimits, "api-rate-limits", defaultConfig.apiRatelimits, "per second rate limits for Access API methods e.g. Ping=300,GetTransaction=500 etc.")
 		flags.StringToIntVar(&builder.apiBurstlimits, "api-burst-limits", defaultConfig.apiBurstlimits, "burst limits for Access API methods e.g. Ping=100,GetTransaction=100 etc.")
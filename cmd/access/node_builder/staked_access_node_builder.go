@@ -17,11 +17,13 @@ import (
 
 	"github.com/onflow/flow-go/cmd"
 	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/engine/access/indexer"
 	"github.com/onflow/flow-go/engine/access/ingestion"
 	pingeng "github.com/onflow/flow-go/engine/access/ping"
 	"github.com/onflow/flow-go/engine/access/rpc"
 	"github.com/onflow/flow-go/engine/access/rpc/backend"
 	"github.com/onflow/flow-go/engine/common/requester"
+	"github.com/onflow/flow-go/engine/common/slashing"
 	synceng "github.com/onflow/flow-go/engine/common/synchronization"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/flow/filter"
@@ -36,6 +38,7 @@ import (
 	"github.com/onflow/flow-go/network/p2p/unicast"
 	relaynet "github.com/onflow/flow-go/network/relay"
 	"github.com/onflow/flow-go/network/topology"
+	badgerstorage "github.com/onflow/flow-go/storage/badger"
 	"github.com/onflow/flow-go/utils/grpcutils"
 )
 
@@ -161,6 +164,37 @@ func (builder *StakedAccessNodeBuilder) Build() (cmd.Node, error) {
 			}
 			return nil
 		}).
+		Module("spork directory", func(node *cmd.NodeConfig) error {
+			if builder.rpcConf.SporkDirectoryFile == "" {
+				return nil
+			}
+
+			sporks, err := backend.ReadSporkConfigs(builder.rpcConf.SporkDirectoryFile)
+			if err != nil {
+				return err
+			}
+			for _, spork := range sporks {
+				node.Logger.Info().
+					Uint64("root_height", spork.RootHeight).
+					Hex("root_block_id", spork.RootBlockID[:]).
+					Str("access_node", spork.AccessAddress).
+					Msg("previous spork")
+
+				conn, err := grpc.Dial(
+					spork.AccessAddress,
+					grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(grpcutils.DefaultMaxMsgSize)),
+					grpc.WithInsecure()) //nolint:staticcheck
+				if err != nil {
+					return fmt.Errorf("could not connect to access node of spork with root height %d: %w", spork.RootHeight, err)
+				}
+				builder.HistoricalSporks = append(builder.HistoricalSporks, backend.HistoricalSpork{
+					RootHeight:  spork.RootHeight,
+					RootBlockID: spork.RootBlockID,
+					Client:      access.NewAccessAPIClient(conn),
+				})
+			}
+			return nil
+		}).
 		Module("transaction timing mempools", func(node *cmd.NodeConfig) error {
 			var err error
 			builder.TransactionTimings, err = stdmap.NewTransactionTimings(1500 * 300) // assume 1500 TPS * 300 seconds
@@ -222,6 +256,19 @@ func (builder *StakedAccessNodeBuilder) Build() (cmd.Node, error) {
 				builder.apiRatelimits,
 				builder.apiBurstlimits,
 			)
+
+			if len(builder.HistoricalSporks) > 0 {
+				root, err := node.State.Params().Root()
+				if err != nil {
+					return nil, fmt.Errorf("could not get root block: %w", err)
+				}
+				directory, err := backend.NewSporkDirectory(builder.HistoricalSporks, root.Height)
+				if err != nil {
+					return nil, fmt.Errorf("invalid spork directory: %w", err)
+				}
+				builder.RpcEng.WithSporkDirectory(directory)
+			}
+
 			return builder.RpcEng, nil
 		}).
 		Component("ingestion engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
@@ -256,6 +303,61 @@ func (builder *StakedAccessNodeBuilder) Build() (cmd.Node, error) {
 			// order for it to properly start and shut down, we should still return it as its own engine here, so it can
 			// be handled by the scaffold.
 			return builder.RequestEng, nil
+		}).
+		Component("indexer engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			if !builder.indexEventsEnabled {
+				return &module.NoopReadyDoneAware{}, nil
+			}
+
+			eventIndex := badgerstorage.NewEventIndex(node.DB)
+			progress := badgerstorage.NewConsumerProgress(node.DB, indexer.ProgressName)
+			builder.RpcEng.WithEventIndex(eventIndex, progress)
+
+			return indexer.New(
+				node.Logger,
+				node.State,
+				node.Storage.Headers,
+				node.Storage.Blocks,
+				builder.RpcEng.API(),
+				eventIndex,
+				progress,
+				builder.indexStartHeight,
+			)
+		}).
+		Component("account transaction indexer engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			if !builder.indexAccountTxEnabled {
+				return &module.NoopReadyDoneAware{}, nil
+			}
+
+			accountTransactions := badgerstorage.NewAccountTransactions(node.DB)
+			progress := badgerstorage.NewConsumerProgress(node.DB, indexer.AccountTransactionsProgressName)
+			builder.RpcEng.WithAccountTransactionIndex(accountTransactions, progress)
+
+			return indexer.NewAccountTransactions(
+				node.Logger,
+				node.State,
+				node.Storage.Headers,
+				node.Storage.Blocks,
+				node.Storage.Collections,
+				builder.RpcEng.API(),
+				accountTransactions,
+				progress,
+				builder.indexAccountTxStartHeight,
+			)
+		}).
+		Component("slashing evidence engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			// staked access nodes receive the slashing evidence collected by consensus and collection nodes
+			builder.RpcEng.WithSlashingEvidence(node.Storage.SlashingEvidence)
+
+			return slashing.New(
+				node.Logger,
+				node.Metrics.Engine,
+				node.Network,
+				node.Me,
+				node.State,
+				node.Storage.SlashingEvidence,
+				slashing.NewVerifier(node.State),
+			)
 		})
 
 	if builder.supportsUnstakedFollower {
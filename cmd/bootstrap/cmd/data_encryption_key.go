@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/bootstrap/utils"
+	model "github.com/onflow/flow-go/model/bootstrap"
+)
+
+// dataEncryptionKeyCmd adds a command to the bootstrap utility which generates an
+// AES-256 key for encrypting the protocol database and execution state, and
+// writes it to the default path.
+var dataEncryptionKeyCmd = &cobra.Command{
+	Use:   "data-encryption-key",
+	Short: "Generates encryption key for the protocol database and execution state and writes it to the default path within the bootstrap directory",
+	Long: "Generates encryption key for the protocol database and execution state. The key is used by nodes started with --data-encryption. " +
+		"To rotate the key of an existing node, move the existing key aside, generate a new key and re-encrypt the node's data with `util reencrypt`.",
+	Run: dataEncryptionKeyRun,
+}
+
+func init() {
+	rootCmd.AddCommand(dataEncryptionKeyCmd)
+}
+
+func dataEncryptionKeyRun(_ *cobra.Command, _ []string) {
+
+	// read nodeID written to boostrap dir by `bootstrap key`
+	nodeID, err := readNodeID()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not read node id")
+	}
+
+	dataEncryptionKeyPath := fmt.Sprintf(model.PathDataEncryptionKey, nodeID)
+	log = log.With().Str("path", dataEncryptionKeyPath).Logger()
+
+	// check if the key already exists
+	exists, err := pathExists(path.Join(flagOutdir, dataEncryptionKeyPath))
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not check if data encryption key already exists")
+	}
+	if exists {
+		log.Warn().Msg("data encryption key already exists, exiting...")
+		return
+	}
+
+	// same key format as for the secrets database
+	dataEncryptionKey, err := utils.GenerateSecretsDBEncryptionKey()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not generate data encryption key")
+	}
+	log.Info().Msg("generated data encryption key")
+
+	writeText(dataEncryptionKeyPath, dataEncryptionKey)
+}
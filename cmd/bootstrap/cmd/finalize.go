@@ -47,6 +47,12 @@ var (
 	flagNumViewsInStakingAuction    uint64
 	flagNumViewsInDKGPhase          uint64
 
+	// sealing parameters for the spork, which are part of the root protocol state
+	flagRequiredApprovalsForSealConstruction uint
+	flagRequiredApprovalsForSealVerification uint
+	flagEmergencySealingActive               bool
+	flagEmergencySealingThreshold            uint64
+
 	// this flag is used to seed the DKG, clustering and cluster QC generation
 	flagBootstrapRandomSeed []byte
 )
@@ -110,6 +116,12 @@ func addFinalizeCmdFlags() {
 	cmd.MarkFlagRequired(finalizeCmd, "epoch-dkg-phase-length")
 	cmd.MarkFlagRequired(finalizeCmd, "protocol-version")
 
+	// optional sealing parameters for the spork
+	finalizeCmd.Flags().UintVar(&flagRequiredApprovalsForSealConstruction, "required-construction-seal-approvals", flow.DefaultRequiredApprovalsForSealConstruction, "minimum number of approvals that are required to construct a seal")
+	finalizeCmd.Flags().UintVar(&flagRequiredApprovalsForSealVerification, "required-verification-seal-approvals", flow.DefaultRequiredApprovalsForSealVerification, "minimum number of approvals that are required to verify a seal")
+	finalizeCmd.Flags().BoolVar(&flagEmergencySealingActive, "emergency-sealing-active", flow.DefaultEmergencySealingActive, "(de)activation of emergency sealing")
+	finalizeCmd.Flags().Uint64Var(&flagEmergencySealingThreshold, "emergency-sealing-threshold", flow.DefaultEmergencySealingThreshold, "number of finalized blocks on top of the block incorporating a result, after which the result may be emergency sealed")
+
 	// optional parameters to influence various aspects of identity generation
 	finalizeCmd.Flags().UintVar(&flagCollectionClusters, "collection-clusters", 2, "number of collection clusters")
 
@@ -213,7 +225,13 @@ func finalize(cmd *cobra.Command, args []string) {
 
 	// construct serializable root protocol snapshot
 	log.Info().Msg("constructing root protocol snapshot")
-	snapshot, err := inmem.SnapshotFromBootstrapStateWithProtocolVersion(block, result, seal, rootQC, flagProtocolVersion)
+	sealingParams := flow.SealingParameters{
+		RequiredApprovalsForSealConstruction: flagRequiredApprovalsForSealConstruction,
+		RequiredApprovalsForSealVerification: flagRequiredApprovalsForSealVerification,
+		EmergencySealingActive:               flagEmergencySealingActive,
+		EmergencySealingThreshold:            flagEmergencySealingThreshold,
+	}
+	snapshot, err := inmem.SnapshotFromBootstrapStateWithParams(block, result, seal, rootQC, flagProtocolVersion, sealingParams)
 	if err != nil {
 		log.Fatal().Err(err).Msg("unable to generate root protocol snapshot")
 	}
@@ -14,6 +14,8 @@ import (
 	"github.com/onflow/flow-go-sdk/client"
 	sdkcrypto "github.com/onflow/flow-go-sdk/crypto"
 
+	"github.com/onflow/flow-go/admin/commands"
+	collectionCommands "github.com/onflow/flow-go/admin/commands/collection"
 	"github.com/onflow/flow-go/cmd"
 	"github.com/onflow/flow-go/consensus"
 	"github.com/onflow/flow-go/consensus/hotstuff/committees"
@@ -30,6 +32,7 @@ import (
 	"github.com/onflow/flow-go/engine/collection/rpc"
 	followereng "github.com/onflow/flow-go/engine/common/follower"
 	"github.com/onflow/flow-go/engine/common/provider"
+	"github.com/onflow/flow-go/engine/common/slashing"
 	consync "github.com/onflow/flow-go/engine/common/synchronization"
 	"github.com/onflow/flow-go/fvm/systemcontracts"
 	"github.com/onflow/flow-go/model/flow"
@@ -83,7 +86,9 @@ func main() {
 		ing               *ingest.Engine
 		mainChainSyncCore *synchronization.Core
 		followerEng       *followereng.Engine
+		slashingEng       *slashing.Engine
 		colMetrics        module.CollectionMetrics
+		rootQCVotes       *storagekv.RootQCVotes
 		err               error
 
 		// epoch qc contract client
@@ -167,6 +172,9 @@ func main() {
 
 	nodeBuilder.
 		PreInit(cmd.DynamicStartPreInit).
+		AdminCommand("get-root-qc-vote", func(config *cmd.NodeConfig) commands.AdminCommand {
+			return collectionCommands.NewGetRootQCVoteCommand(config.State, rootQCVotes)
+		}).
 		Module("mutable follower state", func(node *cmd.NodeConfig) error {
 			// For now, we only support state implementations from package badger.
 			// If we ever support different implementations, the following can be replaced by a type-aware factory
@@ -200,6 +208,10 @@ func main() {
 			err := node.Metrics.Mempool.Register(metrics.ResourceTransaction, pools.CombinedSize)
 			return err
 		}).
+		Module("root qc vote storage", func(node *cmd.NodeConfig) error {
+			rootQCVotes = storagekv.NewRootQCVotes(node.DB)
+			return nil
+		}).
 		Module("pending block cache", func(node *cmd.NodeConfig) error {
 			followerBuffer = buffer.NewPendingBlocks()
 			return nil
@@ -254,7 +266,7 @@ func main() {
 		Component("follower engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 
 			// initialize cleaner for DB
-			cleaner := storagekv.NewCleaner(node.Logger, node.DB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
+			cleaner := storagekv.NewCleaner(node.Logger, node.BadgerDB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
 
 			// create a finalizer that will handling updating the protocol
 			// state when the follower detects newly finalized blocks
@@ -389,6 +401,18 @@ func main() {
 			)
 			return push, err
 		}).
+		Component("slashing evidence engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			slashingEng, err = slashing.New(
+				node.Logger,
+				node.Metrics.Engine,
+				node.Network,
+				node.Me,
+				node.State,
+				node.Storage.SlashingEvidence,
+				slashing.NewVerifier(node.State),
+			)
+			return slashingEng, err
+		}).
 		// Epoch manager encapsulates and manages epoch-dependent engines as we
 		// transition between epochs
 		Component("epoch manager", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
@@ -470,6 +494,7 @@ func main() {
 				node.DB,
 				node.State,
 				createMetrics,
+				slashingEng,
 				opts...,
 			)
 			if err != nil {
@@ -511,6 +536,8 @@ func main() {
 				node.State,
 				pools,
 				rootQCVoter,
+				rootQCVotes,
+				metrics.NewRootQCVoteCollector(node.MetricsRegisterer),
 				factory,
 				heightEvents,
 			)
@@ -15,12 +15,18 @@ import (
 	"github.com/onflow/flow-go-sdk/client"
 	"github.com/onflow/flow-go-sdk/crypto"
 
+	"github.com/onflow/flow-go/admin/commands"
+	dkgCommands "github.com/onflow/flow-go/admin/commands/dkg"
+	hotstuffCommands "github.com/onflow/flow-go/admin/commands/hotstuff"
+	sealingCommands "github.com/onflow/flow-go/admin/commands/sealing"
 	"github.com/onflow/flow-go/cmd"
 	"github.com/onflow/flow-go/cmd/util/cmd/common"
 	"github.com/onflow/flow-go/consensus"
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/blockproducer"
 	"github.com/onflow/flow-go/consensus/hotstuff/committees"
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
+	"github.com/onflow/flow-go/consensus/hotstuff/notifications"
 	"github.com/onflow/flow-go/consensus/hotstuff/notifications/pubsub"
 	"github.com/onflow/flow-go/consensus/hotstuff/pacemaker/timeout"
 	"github.com/onflow/flow-go/consensus/hotstuff/persister"
@@ -30,6 +36,7 @@ import (
 	recovery "github.com/onflow/flow-go/consensus/recovery/protocol"
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/common/requester"
+	"github.com/onflow/flow-go/engine/common/slashing"
 	synceng "github.com/onflow/flow-go/engine/common/synchronization"
 	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
 	"github.com/onflow/flow-go/engine/consensus/compliance"
@@ -41,6 +48,7 @@ import (
 	"github.com/onflow/flow-go/fvm/systemcontracts"
 	"github.com/onflow/flow-go/model/bootstrap"
 	"github.com/onflow/flow-go/model/encodable"
+	"github.com/onflow/flow-go/model/encoding"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/flow/filter"
 	"github.com/onflow/flow-go/module"
@@ -84,11 +92,12 @@ func main() {
 		hotstuffTimeoutDecreaseFactor          float64
 		hotstuffTimeoutVoteAggregationFraction float64
 		blockRateDelay                         time.Duration
+		flightRecorderSize                     uint
+		flightRecorderFile                     string
 		chunkAlpha                             uint
-		requiredApprovalsForSealVerification   uint
-		requiredApprovalsForSealConstruction   uint
-		emergencySealing                       bool
 		dkgControllerConfig                    dkgmodule.ControllerConfig
+		dkgBroadcastTransportString            string
+		dkgBroadcastTransport                  dkgmodule.BroadcastTransport
 		startupTimeString                      string
 		startupTime                            time.Time
 
@@ -104,9 +113,11 @@ func main() {
 		guarantees              mempool.Guarantees
 		receipts                mempool.ExecutionTree
 		seals                   mempool.IncorporatedResultSeals
+		sealingTracker          *tracker.SealingTracker
 		pendingReceipts         mempool.PendingReceipts
 		prov                    *provider.Engine
 		receiptRequester        *requester.Engine
+		slashingEngine          *slashing.Engine
 		syncCore                *synchronization.Core
 		comp                    *compliance.Engine
 		conMetrics              module.ConsensusMetrics
@@ -118,6 +129,7 @@ func main() {
 		blockTimer              protocol.BlockTimer
 		finalizedHeader         *synceng.FinalizedHeaderCache
 		hotstuffModules         *consensus.HotstuffModules
+		flightRecorder          *flightrecorder.Recorder
 		dkgState                *bstorage.DKGState
 		safeBeaconKeys          *bstorage.SafeBeaconPrivateKeys
 	)
@@ -141,15 +153,15 @@ func main() {
 		flags.Float64Var(&hotstuffTimeoutDecreaseFactor, "hotstuff-timeout-decrease-factor", timeout.DefaultConfig.TimeoutDecrease, "multiplicative decrease of timeout value in case of progress")
 		flags.Float64Var(&hotstuffTimeoutVoteAggregationFraction, "hotstuff-timeout-vote-aggregation-fraction", 0.6, "additional fraction of replica timeout that the primary will wait for votes")
 		flags.DurationVar(&blockRateDelay, "block-rate-delay", 500*time.Millisecond, "the delay to broadcast block proposal in order to control block production rate")
+		flags.UintVar(&flightRecorderSize, "hotstuff-flight-recorder-size", 10000, "maximum number of consensus events held by the hotstuff flight recorder")
+		flags.StringVar(&flightRecorderFile, "hotstuff-flight-recorder-file", "", "file to which all consensus events recorded by the hotstuff flight recorder are appended, disabled if empty")
 		flags.UintVar(&chunkAlpha, "chunk-alpha", chmodule.DefaultChunkAssignmentAlpha, "number of verifiers that should be assigned to each chunk")
-		flags.UintVar(&requiredApprovalsForSealVerification, "required-verification-seal-approvals", validation.DefaultRequiredApprovalsForSealValidation, "minimum number of approvals that are required to verify a seal")
-		flags.UintVar(&requiredApprovalsForSealConstruction, "required-construction-seal-approvals", sealing.DefaultRequiredApprovalsForSealConstruction, "minimum number of approvals that are required to construct a seal")
-		flags.BoolVar(&emergencySealing, "emergency-sealing-active", sealing.DefaultEmergencySealingActive, "(de)activation of emergency sealing")
 		flags.BoolVar(&insecureAccessAPI, "insecure-access-api", false, "required if insecure GRPC connection should be used")
 		flags.StringSliceVar(&accessNodeIDS, "access-node-ids", []string{}, fmt.Sprintf("array of access node IDs sorted in priority order where the first ID in this array will get the first connection attempt and each subsequent ID after serves as a fallback. Minimum length %d. Use '*' for all IDs in protocol state.", common.DefaultAccessNodeIDSMinimum))
 		flags.DurationVar(&dkgControllerConfig.BaseStartDelay, "dkg-controller-base-start-delay", dkgmodule.DefaultBaseStartDelay, "used to define the range for jitter prior to DKG start (eg. 500µs) - the base value is scaled quadratically with the # of DKG participants")
 		flags.DurationVar(&dkgControllerConfig.BaseHandleFirstBroadcastDelay, "dkg-controller-base-handle-first-broadcast-delay", dkgmodule.DefaultBaseHandleFirstBroadcastDelay, "used to define the range for jitter prior to DKG handling the first broadcast messages (eg. 50ms) - the base value is scaled quadratically with the # of DKG participants")
 		flags.DurationVar(&dkgControllerConfig.HandleSubsequentBroadcastDelay, "dkg-controller-handle-subsequent-broadcast-delay", dkgmodule.DefaultHandleSubsequentBroadcastDelay, "used to define the constant delay introduced prior to DKG handling subsequent broadcast messages (eg. 2s)")
+		flags.StringVar(&dkgBroadcastTransportString, "dkg-broadcast-transport", dkgmodule.ContractBroadcast.String(), "transport used to disseminate DKG broadcast messages: 'contract' sends them to the DKG smart-contract, 'p2p' publishes them over the DKG gossip channel (the DKG result is always submitted to the smart-contract)")
 		flags.StringVar(&startupTimeString, "hotstuff-startup-time", cmd.NotSet, "specifies date and time (in ISO 8601 format) after which the consensus participant may enter the first view (e.g 1996-04-24T15:04:05-07:00)")
 	}).ValidateFlags(func() error {
 		nodeBuilder.Logger.Info().Str("startup_time_str", startupTimeString).Msg("got startup_time_str")
@@ -161,6 +173,10 @@ func main() {
 			startupTime = t
 			nodeBuilder.Logger.Info().Time("startup_time", startupTime).Msg("got startup_time")
 		}
+		dkgBroadcastTransport, err = dkgmodule.ParseBroadcastTransport(dkgBroadcastTransportString)
+		if err != nil {
+			return err
+		}
 		return nil
 	})
 
@@ -170,6 +186,15 @@ func main() {
 
 	nodeBuilder.
 		PreInit(cmd.DynamicStartPreInit).
+		AdminCommand("get-sealing-status", func(config *cmd.NodeConfig) commands.AdminCommand {
+			return sealingCommands.NewGetSealingStatusCommand(sealingTracker)
+		}).
+		AdminCommand("get-dkg-transcript", func(config *cmd.NodeConfig) commands.AdminCommand {
+			return dkgCommands.NewGetDKGTranscriptCommand(config.State, dkgState)
+		}).
+		AdminCommand("dump-hotstuff-events", func(config *cmd.NodeConfig) commands.AdminCommand {
+			return hotstuffCommands.NewDumpFlightRecorderCommand(flightRecorder)
+		}).
 		Module("consensus node metrics", func(node *cmd.NodeConfig) error {
 			conMetrics = metrics.NewConsensusCollector(node.Tracer, node.MetricsRegisterer)
 			return nil
@@ -190,11 +215,14 @@ func main() {
 				return fmt.Errorf("only implementations of type badger.State are currently supported but read-only state has type %T", node.State)
 			}
 
-			// We need to ensure `requiredApprovalsForSealVerification <= requiredApprovalsForSealConstruction <= chunkAlpha`
-			if requiredApprovalsForSealVerification > requiredApprovalsForSealConstruction {
-				return fmt.Errorf("invalid consensus parameters: requiredApprovalsForSealVerification > requiredApprovalsForSealConstruction")
+			// The sealing parameters are part of the protocol state, which guarantees that
+			// `requiredApprovalsForSealVerification <= requiredApprovalsForSealConstruction`.
+			// We need to ensure `requiredApprovalsForSealConstruction <= chunkAlpha`.
+			sealingParams, err := node.State.Final().SealingParameters()
+			if err != nil {
+				return fmt.Errorf("could not get sealing parameters: %w", err)
 			}
-			if requiredApprovalsForSealConstruction > chunkAlpha {
+			if sealingParams.RequiredApprovalsForSealConstruction > chunkAlpha {
 				return fmt.Errorf("invalid consensus parameters: requiredApprovalsForSealConstruction > chunkAlpha")
 			}
 
@@ -210,19 +238,14 @@ func main() {
 				node.Storage.Results,
 				node.Storage.Seals)
 
-			sealValidator, err := validation.NewSealValidator(
+			sealValidator := validation.NewSealValidator(
 				node.State,
 				node.Storage.Headers,
 				node.Storage.Index,
 				node.Storage.Results,
 				node.Storage.Seals,
 				chunkAssigner,
-				requiredApprovalsForSealConstruction,
-				requiredApprovalsForSealVerification,
 				conMetrics)
-			if err != nil {
-				return fmt.Errorf("could not instantiate seal validator: %w", err)
-			}
 
 			blockTimer, err = blocktimer.NewBlockTimer(minInterval, maxInterval)
 			if err != nil {
@@ -330,6 +353,10 @@ func main() {
 			err = node.Metrics.Mempool.Register(metrics.ResourcePendingIncorporatedSeal, seals.Size)
 			return nil
 		}).
+		Module("sealing tracker", func(node *cmd.NodeConfig) error {
+			sealingTracker = tracker.NewSealingTracker(node.Logger, conMetrics, node.Storage.Headers, node.Storage.Receipts, seals)
+			return nil
+		}).
 		Module("pending receipts mempool", func(node *cmd.NodeConfig) error {
 			pendingReceipts = stdmap.NewPendingReceipts(node.Storage.Headers, pendingReceiptsLimit)
 			return nil
@@ -338,6 +365,19 @@ func main() {
 			mainMetrics = metrics.NewHotstuffCollector(node.RootChainID)
 			return nil
 		}).
+		Module("hotstuff flight recorder", func(node *cmd.NodeConfig) error {
+			var recorderOpts []flightrecorder.Option
+			if flightRecorderFile != "" {
+				file, err := os.OpenFile(flightRecorderFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+				if err != nil {
+					return fmt.Errorf("could not open flight recorder file: %w", err)
+				}
+				nodeBuilder.ShutdownFunc(file.Close)
+				recorderOpts = append(recorderOpts, flightrecorder.WithStream(file))
+			}
+			flightRecorder, err = flightrecorder.New(node.Logger, node.NodeID, node.RootChainID, int(flightRecorderSize), recorderOpts...)
+			return err
+		}).
 		Module("sync core", func(node *cmd.NodeConfig) error {
 			syncCore, err = synchronization.New(node.Logger, node.SyncCoreConfig)
 			return err
@@ -386,11 +426,7 @@ func main() {
 		}).
 		Component("sealing engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 
-			sealingTracker := tracker.NewSealingTracker(node.Logger, node.Storage.Headers, node.Storage.Receipts, seals)
-
 			config := sealing.DefaultConfig()
-			config.EmergencySealingActive = emergencySealing
-			config.RequiredApprovalsForSealConstruction = requiredApprovalsForSealConstruction
 
 			e, err := sealing.NewEngine(
 				node.Logger,
@@ -504,6 +540,22 @@ func main() {
 
 			return ing, err
 		}).
+		Component("slashing evidence engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			slashingEngine, err = slashing.New(
+				node.Logger,
+				node.Metrics.Engine,
+				node.Network,
+				node.Me,
+				node.State,
+				node.Storage.SlashingEvidence,
+				slashing.NewVerifier(node.State),
+			)
+			return slashingEngine, err
+		}).
+		Component("hotstuff flight recorder", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			// the recorder streams the recorded events to the file on its own worker
+			return flightRecorder, nil
+		}).
 		Component("hotstuff modules", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 			// initialize the block finalizer
 			finalize := finalizer.NewFinalizer(
@@ -548,6 +600,12 @@ func main() {
 			)
 
 			notifier.AddConsumer(finalizationDistributor)
+			notifier.AddConsumer(notifications.NewSlashingViolationsConsumer(
+				node.Logger,
+				node.RootChainID,
+				node.Storage.Headers,
+				slashingEngine,
+			))
 
 			// initialize the persister
 			persist := persister.New(node.DB, node.RootChainID)
@@ -570,6 +628,7 @@ func main() {
 			}
 
 			qcDistributor := pubsub.NewQCCreatedDistributor()
+			tcDistributor := pubsub.NewTCCreatedDistributor()
 			validator := consensus.NewValidator(mainMetrics, committee, forks)
 			voteProcessorFactory := votecollector.NewCombinedVoteProcessorFactory(committee, qcDistributor.OnQcConstructedFromVotes)
 			lowestViewForVoteProcessing := finalizedBlock.View + 1
@@ -577,10 +636,16 @@ func main() {
 				lowestViewForVoteProcessing,
 				notifier,
 				voteProcessorFactory,
-				finalizationDistributor)
+				finalizationDistributor,
+				committee,
+				validator,
+				encoding.ConsensusTimeoutTag,
+				tcDistributor)
 			if err != nil {
 				return nil, fmt.Errorf("could not initialize vote aggregator: %w", err)
 			}
+			// record the votes and timeouts received from other replicas
+			aggregator = flightrecorder.NewVoteAggregator(aggregator, flightRecorder, node.Me.NodeID())
 
 			hotstuffModules = &consensus.HotstuffModules{
 				Notifier:                notifier,
@@ -588,6 +653,7 @@ func main() {
 				Signer:                  signer,
 				Persist:                 persist,
 				QCCreatedDistributor:    qcDistributor,
+				TCCreatedDistributor:    tcDistributor,
 				FinalizationDistributor: finalizationDistributor,
 				Forks:                   forks,
 				Validator:               validator,
@@ -630,6 +696,7 @@ func main() {
 				consensus.WithTimeoutIncreaseFactor(hotstuffTimeoutIncreaseFactor),
 				consensus.WithTimeoutDecreaseFactor(hotstuffTimeoutDecreaseFactor),
 				consensus.WithBlockRateDelay(blockRateDelay),
+				consensus.WithFlightRecorder(flightRecorder),
 			}
 
 			if !startupTime.IsZero() {
@@ -642,7 +709,7 @@ func main() {
 			}
 
 			// initialize the entity database accessors
-			cleaner := bstorage.NewCleaner(node.Logger, node.DB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
+			cleaner := bstorage.NewCleaner(node.Logger, node.BadgerDB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
 
 			// initialize the pending blocks cache
 			proposals := buffer.NewPendingBlocks()
@@ -733,7 +800,8 @@ func main() {
 			dkgBrokerTunnel = dkgmodule.NewBrokerTunnel()
 
 			// messagingEngine is a network engine that is used by nodes to
-			// exchange private DKG messages
+			// exchange private DKG messages, and broadcast messages when using
+			// the p2p broadcast transport
 			messagingEngine, err := dkgeng.NewMessagingEngine(
 				node.Logger,
 				node.Network,
@@ -767,10 +835,12 @@ func main() {
 				dkgState,
 				dkgmodule.NewControllerFactory(
 					node.Logger,
+					metrics.NewDKGCollector(node.MetricsRegisterer),
 					node.Me,
 					dkgContractClients,
 					dkgBrokerTunnel,
 					dkgControllerConfig,
+					dkgmodule.WithBroadcastTransport(dkgBroadcastTransport),
 				),
 				viewsObserver,
 			)
@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"path"
 	"path/filepath"
 	goruntime "runtime"
+	"syscall"
 	"time"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
@@ -25,6 +28,7 @@ import (
 
 	"github.com/onflow/flow-go/admin/commands"
 	stateSyncCommands "github.com/onflow/flow-go/admin/commands/state_synchronization"
+	storageCommands "github.com/onflow/flow-go/admin/commands/storage"
 	uploaderCommands "github.com/onflow/flow-go/admin/commands/uploader"
 	"github.com/onflow/flow-go/cmd"
 	"github.com/onflow/flow-go/consensus"
@@ -54,6 +58,8 @@ import (
 	"github.com/onflow/flow-go/fvm/systemcontracts"
 	"github.com/onflow/flow-go/ledger/common/pathfinder"
 	ledger "github.com/onflow/flow-go/ledger/complete"
+	mtrienode "github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/nodestore"
 	"github.com/onflow/flow-go/ledger/complete/wal"
 	bootstrapFilenames "github.com/onflow/flow-go/model/bootstrap"
 	"github.com/onflow/flow-go/model/encoding/cbor"
@@ -104,9 +110,13 @@ func main() {
 		collector                     module.ExecutionMetrics
 		executionDataServiceCollector module.ExecutionDataServiceMetrics
 		mTrieCacheSize                uint32
+		mTrieNodeStoreDir             string
+		mTrieNodeCacheSize            uint64
+		mTrieOffloadDepth             int
 		transactionResultsCacheSize   uint
 		checkpointDistance            uint
 		checkpointsToKeep             uint
+		checkpointMaxIncrements       uint
 		stateDeltasLimit              uint
 		cadenceExecutionCache         uint
 		cadenceTracing                bool
@@ -120,6 +130,7 @@ func main() {
 		pauseExecution                bool
 		checkAuthorizedAtBlock        func(blockID flow.Identifier) (bool, error)
 		diskWAL                       *wal.DiskWAL
+		walEncryption                 *wal.Encryption
 		scriptLogThreshold            time.Duration
 		scriptExecutionTimeLimit      time.Duration
 		chdpQueryTimeout              uint
@@ -134,6 +145,10 @@ func main() {
 		executionDataCIDCache         state_synchronization.ExecutionDataCIDCache
 		executionDataCIDCacheSize     uint = 100
 		edsDatastoreTTL               time.Duration
+		checkpointURL                 string
+		checkpointFetchTimeout        time.Duration
+		checkpointServerAddress       string
+		fetchedRootCheckpoint         bool
 	)
 
 	nodeBuilder := cmd.FlowNode(flow.RoleExecution.String())
@@ -147,8 +162,12 @@ func main() {
 			flags.StringVar(&triedir, "triedir", datadir, "directory to store the execution State")
 			flags.StringVar(&executionDataDir, "execution-data-dir", filepath.Join(homedir, ".flow", "execution_data_blobstore"), "directory to use for Execution Data blobstore")
 			flags.Uint32Var(&mTrieCacheSize, "mtrie-cache-size", 500, "cache size for MTrie")
+			flags.StringVar(&mTrieNodeStoreDir, "mtrie-node-store-dir", "", "directory of the on-disk store for offloaded MTrie nodes, MTries are held in memory if empty")
+			flags.Uint64Var(&mTrieNodeCacheSize, "mtrie-node-cache-size", 1<<30, "maximum size in bytes of the cache of MTrie nodes loaded from the node store")
+			flags.IntVar(&mTrieOffloadDepth, "mtrie-offload-depth", 0, "depth of the MTrie subtries offloaded to the node store, 0 to only offload register payloads")
 			flags.UintVar(&checkpointDistance, "checkpoint-distance", 20, "number of WAL segments between checkpoints")
 			flags.UintVar(&checkpointsToKeep, "checkpoints-to-keep", 5, "number of recent checkpoints to keep (0 to keep all)")
+			flags.UintVar(&checkpointMaxIncrements, "checkpoint-max-increments", 0, "number of incremental checkpoints between full checkpoints (0 to only create full checkpoints)")
 			flags.UintVar(&stateDeltasLimit, "state-deltas-limit", 100, "maximum number of state deltas in the memory pool")
 			flags.UintVar(&cadenceExecutionCache, "cadence-execution-cache", computation.DefaultProgramsCacheSize, "cache size for Cadence execution")
 			flags.BoolVar(&cadenceTracing, "cadence-tracing", false, "enables cadence runtime level tracing")
@@ -169,6 +188,9 @@ func main() {
 			flags.StringVar(&gcpBucketName, "gcp-bucket-name", "", "GCP Bucket name for block data uploader")
 			flags.StringVar(&s3BucketName, "s3-bucket-name", "", "S3 Bucket name for block data uploader")
 			flags.DurationVar(&edsDatastoreTTL, "execution-data-service-datastore-ttl", 0, "TTL for new blobs added to the execution data service blobstore")
+			flags.StringVar(&checkpointURL, "dynamic-startup-checkpoint-url", "", "base URL of an execution node checkpoint server or file server to fetch the root checkpoint from, if the bootstrap directory contains none")
+			flags.DurationVar(&checkpointFetchTimeout, "dynamic-startup-checkpoint-fetch-timeout", bootstrap.DefaultCheckpointFetchTimeout, "time limit for fetching the root checkpoint from the dynamic startup checkpoint URL")
+			flags.StringVar(&checkpointServerAddress, "checkpoint-server-address", "", "address to serve checkpoints of the execution state on, for execution nodes using dynamic startup (disabled if empty)")
 		}).
 		ValidateFlags(func() error {
 			if enableBlockDataUpload {
@@ -190,6 +212,12 @@ func main() {
 		AdminCommand("set-uploader-enabled", func(config *cmd.NodeConfig) commands.AdminCommand {
 			return uploaderCommands.NewToggleUploaderCommand()
 		}).
+		AdminCommand("create-backup", func(config *cmd.NodeConfig) commands.AdminCommand {
+			// the WAL is created by a component started after the admin server
+			return storageCommands.NewCreateExecutionBackupCommand(config.Logger, config.NodeID, config.BadgerDB, func() *wal.DiskWAL {
+				return diskWAL
+			}, config.DataEncryptionKey)
+		}).
 		Module("mutable follower state", func(node *cmd.NodeConfig) error {
 			// For now, we only support state implementations from package badger.
 			// If we ever support different implementations, the following can be replaced by a type-aware factory
@@ -311,7 +339,13 @@ func main() {
 			return nil
 		}).
 		Component("Write-Ahead Log", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
-			diskWAL, err = wal.NewDiskWAL(node.Logger.With().Str("subcomponent", "wal").Logger(), node.MetricsRegisterer, collector, triedir, int(mTrieCacheSize), pathfinder.PathByteSize, wal.SegmentSize)
+			if node.DataEncryptionKey != nil {
+				walEncryption, err = wal.NewEncryption(node.DataEncryptionKey)
+				if err != nil {
+					return nil, fmt.Errorf("could not create execution state encryption: %w", err)
+				}
+			}
+			diskWAL, err = wal.NewEncryptedDiskWAL(node.Logger.With().Str("subcomponent", "wal").Logger(), node.MetricsRegisterer, collector, triedir, int(mTrieCacheSize), pathfinder.PathByteSize, wal.SegmentSize, walEncryption)
 			return diskWAL, err
 		}).
 		Component("execution state ledger", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
@@ -328,16 +362,29 @@ func main() {
 			if !bootstrapped {
 				// when bootstrapping, the bootstrap folder must have a checkpoint file
 				// we need to cover this file to the trie folder to restore the trie to restore the execution state.
-				err = copyBootstrapState(node.BootstrapDir, triedir)
-				if err != nil {
-					return nil, fmt.Errorf("could not load bootstrap state from checkpoint file: %w", err)
+				// Without one, the checkpoint of the sealed state commitment is fetched, if configured.
+				if checkpointURL != "" && !hasBootstrapCheckpoint(node.BootstrapDir) {
+					// components are built before the node handles termination signals, abort the download on them
+					ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+					err = bootstrap.FetchRootCheckpoint(ctx, node.Logger, bootstrap.NewCheckpointClient(checkpointFetchTimeout), checkpointURL, node.RootSeal.FinalState, triedir)
+					stop()
+					if err != nil {
+						return nil, fmt.Errorf("could not fetch root checkpoint: %w", err)
+					}
+					fetchedRootCheckpoint = true
+				} else {
+					err = copyBootstrapState(node.BootstrapDir, triedir)
+					if err != nil {
+						return nil, fmt.Errorf("could not load bootstrap state from checkpoint file: %w", err)
+					}
 				}
 
-				// TODO: check that the checkpoint file contains the root block's statecommit hash
-
-				err = bootstrapper.BootstrapExecutionDatabase(node.DB, node.RootSeal.FinalState, node.RootBlock.Header)
-				if err != nil {
-					return nil, fmt.Errorf("could not bootstrap execution database: %w", err)
+				// the encrypted execution state doesn't accept plaintext checkpoints
+				if walEncryption != nil {
+					err = encryptBootstrapState(node.Logger, triedir, walEncryption)
+					if err != nil {
+						return nil, fmt.Errorf("could not encrypt bootstrap state: %w", err)
+					}
 				}
 			} else {
 				// if execution database has been bootstrapped, then the root statecommit must equal to the one
@@ -349,8 +396,49 @@ func main() {
 				}
 			}
 
-			ledgerStorage, err = ledger.NewLedger(diskWAL, int(mTrieCacheSize), collector, node.Logger.With().Str("subcomponent", "ledger").Logger(), ledger.DefaultPathFinderVersion)
-			return ledgerStorage, err
+			var nodeStorage *mtrienode.Storage
+			if mTrieNodeStoreDir != "" {
+				nodeStore, err := nodestore.Open(mTrieNodeStoreDir, node.DataEncryptionKey)
+				if err != nil {
+					return nil, fmt.Errorf("could not open mtrie node store: %w", err)
+				}
+				nodeBuilder.ShutdownFunc(nodeStore.Close)
+
+				nodeStorage, err = mtrienode.NewStorage(nodeStore, mTrieOffloadDepth, mTrieNodeCacheSize)
+				if err != nil {
+					return nil, fmt.Errorf("could not create mtrie node storage: %w", err)
+				}
+			}
+
+			ledgerStorage, err = ledger.NewLedgerWithNodeStorage(diskWAL, int(mTrieCacheSize), collector, node.Logger.With().Str("subcomponent", "ledger").Logger(), ledger.DefaultPathFinderVersion, nodeStorage)
+			if err != nil {
+				return nil, err
+			}
+
+			if !bootstrapped {
+				// the root checkpoint must contain the sealed state commitment of the root snapshot,
+				// the execution database is only bootstrapped once it is verified
+				err = bootstrap.VerifyRootCheckpoint(ledgerStorage, node.RootSeal.FinalState, triedir)
+				if err != nil {
+					if fetchedRootCheckpoint {
+						return nil, fmt.Errorf("invalid fetched root checkpoint: %w", err)
+					}
+					return nil, fmt.Errorf("invalid root checkpoint in bootstrap directory: %w", err)
+				}
+
+				err = bootstrapper.BootstrapExecutionDatabase(node.DB, node.RootSeal.FinalState, node.RootBlock.Header)
+				if err != nil {
+					return nil, fmt.Errorf("could not bootstrap execution database: %w", err)
+				}
+			}
+
+			return ledgerStorage, nil
+		}).
+		Component("checkpoint server", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			if checkpointServerAddress == "" {
+				return &module.NoopReadyDoneAware{}, nil
+			}
+			return bootstrap.NewCheckpointServer(node.Logger, checkpointServerAddress, ledgerStorage), nil
 		}).
 		Component("execution state ledger WAL compactor", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 
@@ -358,7 +446,7 @@ func main() {
 			if err != nil {
 				return nil, fmt.Errorf("cannot create checkpointer: %w", err)
 			}
-			compactor := wal.NewCompactor(checkpointer, 10*time.Second, checkpointDistance, checkpointsToKeep, node.Logger.With().Str("subcomponent", "checkpointer").Logger())
+			compactor := wal.NewIncrementalCompactor(checkpointer, 10*time.Second, checkpointDistance, checkpointsToKeep, checkpointMaxIncrements, node.Logger.With().Str("subcomponent", "checkpointer").Logger())
 
 			return compactor, nil
 		}).
@@ -628,7 +716,7 @@ func main() {
 		Component("follower engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 
 			// initialize cleaner for DB
-			cleaner := storage.NewCleaner(node.Logger, node.DB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
+			cleaner := storage.NewCleaner(node.Logger, node.BadgerDB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
 
 			// create a finalizer that handles updating the protocol
 			// state when the follower detects newly finalized blocks
@@ -776,6 +864,45 @@ func getContractEpochCounter(vm *fvm.VirtualMachine, vmCtx fvm.Context, view *de
 	return epochCounter, nil
 }
 
+// hasBootstrapCheckpoint returns true if the bootstrap folder contains a checkpoint
+// file, see copyBootstrapState.
+func hasBootstrapCheckpoint(dir string) bool {
+	for _, filename := range []string{bootstrapFilenames.FilenameWALRootCheckpoint, "00000000"} {
+		_, err := os.Stat(filepath.Join(dir, bootstrapFilenames.DirnameExecutionState, filename))
+		if err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// encryptBootstrapState encrypts the plaintext checkpoint file copied or fetched
+// into the execution state folder, see copyBootstrapState.
+func encryptBootstrapState(log zerolog.Logger, trie string, encryption *wal.Encryption) error {
+	for _, filename := range []string{bootstrapFilenames.FilenameWALRootCheckpoint, "00000000"} {
+		file, err := os.Open(filepath.Join(trie, filename))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		encrypted, err := wal.IsEncryptedFile(file)
+		_ = file.Close()
+		if err != nil {
+			return fmt.Errorf("could not read checkpoint file %s: %w", filename, err)
+		}
+		if encrypted {
+			continue
+		}
+		err = wal.ReencryptCheckpoint(log, trie, filename, nil, encryption)
+		if err != nil {
+			return fmt.Errorf("could not encrypt checkpoint file %s: %w", filename, err)
+		}
+	}
+	return nil
+}
+
 // copy the checkpoint files from the bootstrap folder to the execution state folder
 // Checkpoint file is required to restore the trie, and has to be placed in the execution
 // state folder.
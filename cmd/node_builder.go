@@ -28,10 +28,17 @@ import (
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/state/protocol/events"
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 const NotSet = "not set"
 
+// key-value backends of the protocol database
+const (
+	DBBackendBadger = "badger"
+	DBBackendPebble = "pebble"
+)
+
 type BuilderFunc func(nodeConfig *NodeConfig) error
 type ReadyDoneFactory func(node *NodeConfig) (module.ReadyDoneAware, error)
 
@@ -126,9 +133,12 @@ type BaseConfig struct {
 	DynamicStartupEpoch             string
 	DynamicStartupSleepInterval     time.Duration
 	datadir                         string
+	dbBackend                       string
 	secretsdir                      string
 	secretsDBEnabled                bool
 	InsecureSecretsDB               bool
+	dataEncryptionEnabled           bool
+	dataEncryptionKeyRotation       time.Duration
 	level                           string
 	metricsPort                     uint
 	BootstrapDir                    string
@@ -169,8 +179,10 @@ type NodeConfig struct {
 	Tracer            module.Tracer
 	MetricsRegisterer prometheus.Registerer
 	Metrics           Metrics
-	DB                *badger.DB
-	SecretsDB         *badger.DB
+	DB                kv.DB
+	BadgerDB          *badger.DB // the protocol database if it uses the badger backend, nil otherwise
+	SecretsDB         kv.DB
+	DataEncryptionKey []byte // encryption key for the protocol database and execution state, nil if disabled
 	Storage           Storage
 	ProtocolEvents    *events.Distributor
 	State             protocol.State
@@ -215,8 +227,10 @@ func DefaultBaseConfig() *BaseConfig {
 		BindAddr:                        NotSet,
 		BootstrapDir:                    "bootstrap",
 		datadir:                         datadir,
+		dbBackend:                       DBBackendBadger,
 		secretsdir:                      NotSet,
 		secretsDBEnabled:                true,
+		dataEncryptionKeyRotation:       10 * 24 * time.Hour, // badger default
 		level:                           "info",
 		PeerUpdateInterval:              p2p.DefaultPeerUpdateInterval,
 		UnicastMessageTimeout:           p2p.DefaultUnicastTimeout,
@@ -55,6 +55,9 @@ import (
 	"github.com/onflow/flow-go/storage"
 	bstorage "github.com/onflow/flow-go/storage/badger"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+	"github.com/onflow/flow-go/storage/kv/pebblekv"
 	sutil "github.com/onflow/flow-go/storage/util"
 	"github.com/onflow/flow-go/utils/debug"
 	"github.com/onflow/flow-go/utils/io"
@@ -113,6 +116,7 @@ func (fnb *FlowNodeBuilder) BaseFlags() {
 	fnb.flags.StringVar(&fnb.BaseConfig.BindAddr, "bind", defaultConfig.BindAddr, "address to bind on")
 	fnb.flags.StringVarP(&fnb.BaseConfig.BootstrapDir, "bootstrapdir", "b", defaultConfig.BootstrapDir, "path to the bootstrap directory")
 	fnb.flags.StringVarP(&fnb.BaseConfig.datadir, "datadir", "d", defaultConfig.datadir, "directory to store the public database (protocol state)")
+	fnb.flags.StringVar(&fnb.BaseConfig.dbBackend, "db-backend", defaultConfig.dbBackend, "key-value backend of the public database: badger or pebble")
 	fnb.flags.StringVar(&fnb.BaseConfig.secretsdir, "secretsdir", defaultConfig.secretsdir, "directory to store private database (secrets)")
 	fnb.flags.StringVarP(&fnb.BaseConfig.level, "loglevel", "l", defaultConfig.level, "level for logging output")
 	fnb.flags.DurationVar(&fnb.BaseConfig.PeerUpdateInterval, "peerupdate-interval", defaultConfig.PeerUpdateInterval, "how often to refresh the peer connections for the node")
@@ -154,6 +158,8 @@ func (fnb *FlowNodeBuilder) BaseFlags() {
 	fnb.flags.DurationVar(&fnb.BaseConfig.DynamicStartupSleepInterval, "dynamic-startup-sleep-interval", time.Minute, "the interval in which the node will check if it can start")
 
 	fnb.flags.BoolVar(&fnb.BaseConfig.InsecureSecretsDB, "insecure-secrets-db", false, "allow the node to start up without an secrets DB encryption key")
+	fnb.flags.BoolVar(&fnb.BaseConfig.dataEncryptionEnabled, "data-encryption", false, "encrypt the protocol database and execution state with the data encryption key in the bootstrap directory")
+	fnb.flags.DurationVar(&fnb.BaseConfig.dataEncryptionKeyRotation, "data-encryption-key-rotation", defaultConfig.dataEncryptionKeyRotation, "interval after which a new data key is generated for encrypting the protocol database")
 	fnb.flags.BoolVar(&fnb.BaseConfig.HeroCacheMetricsEnable, "herocache-metrics-collector", false, "enables herocache metrics collection")
 
 	// sync core flags
@@ -526,14 +532,40 @@ func (fnb *FlowNodeBuilder) initDB() {
 
 	// if a db has been passed in, use that instead of creating one
 	if fnb.BaseConfig.db != nil {
-		fnb.DB = fnb.BaseConfig.db
+		fnb.DB = badgerkv.New(fnb.BaseConfig.db)
+		fnb.BadgerDB = fnb.BaseConfig.db
 		return
 	}
 
+	if fnb.BaseConfig.dataEncryptionEnabled {
+		encryptionKey, err := loadDataEncryptionKey(fnb.BootstrapDir, fnb.NodeID)
+		fnb.MustNot(err).Msg("could not load data encryption key")
+		fnb.DataEncryptionKey = encryptionKey
+	}
+
 	// Pre-create DB path (Badger creates only one-level dirs)
 	err := os.MkdirAll(fnb.BaseConfig.datadir, 0700)
 	fnb.MustNot(err).Str("dir", fnb.BaseConfig.datadir).Msg("could not create datadir")
 
+	switch fnb.BaseConfig.dbBackend {
+	case DBBackendBadger:
+		fnb.initBadgerDB()
+	case DBBackendPebble:
+		fnb.initPebbleDB()
+	default:
+		fnb.Logger.Fatal().Str("backend", fnb.BaseConfig.dbBackend).Msg("unknown database backend")
+	}
+
+	fnb.ShutdownFunc(func() error {
+		if err := fnb.DB.Close(); err != nil {
+			return fmt.Errorf("error closing protocol database: %w", err)
+		}
+		return nil
+	})
+}
+
+func (fnb *FlowNodeBuilder) initBadgerDB() {
+
 	log := sutil.NewLogger(fnb.Logger)
 
 	// we initialize the database with options that allow us to keep the maximum
@@ -554,16 +586,33 @@ func (fnb *FlowNodeBuilder) initDB() {
 		WithValueLogFileSize(128 << 23).
 		WithValueLogMaxEntries(100000) // Default is 1000000
 
+	// the master key only encrypts the data keys, which are rotated regularly
+	// and encrypt the actual data
+	if fnb.DataEncryptionKey != nil {
+		fnb.Logger.Info().Msg("encrypting protocol database")
+		opts = opts.
+			WithEncryptionKey(fnb.DataEncryptionKey).
+			WithEncryptionKeyRotationDuration(fnb.BaseConfig.dataEncryptionKeyRotation)
+	}
+
 	publicDB, err := bstorage.InitPublic(opts)
 	fnb.MustNot(err).Msg("could not open public db")
-	fnb.DB = publicDB
+	fnb.DB = badgerkv.New(publicDB)
+	fnb.BadgerDB = publicDB
+}
 
-	fnb.ShutdownFunc(func() error {
-		if err := fnb.DB.Close(); err != nil {
-			return fmt.Errorf("error closing protocol database: %w", err)
-		}
-		return nil
-	})
+func (fnb *FlowNodeBuilder) initPebbleDB() {
+
+	// pebble has no encryption at rest
+	if fnb.DataEncryptionKey != nil {
+		fnb.Logger.Fatal().Msg("data encryption is not supported with the pebble backend")
+	}
+
+	publicDB, err := pebblekv.Open(fnb.BaseConfig.datadir)
+	fnb.MustNot(err).Msg("could not open public db")
+	err = publicDB.Update(operation.InsertPublicDBMarker)
+	fnb.MustNot(err).Msg("could not assert db type")
+	fnb.DB = publicDB
 }
 
 func (fnb *FlowNodeBuilder) initSecretsDB() {
@@ -607,7 +656,7 @@ func (fnb *FlowNodeBuilder) initSecretsDB() {
 
 	secretsDB, err := bstorage.InitSecret(opts)
 	fnb.MustNot(err).Msg("could not open secrets db")
-	fnb.SecretsDB = secretsDB
+	fnb.SecretsDB = badgerkv.New(secretsDB)
 
 	fnb.ShutdownFunc(func() error {
 		if err := fnb.SecretsDB.Close(); err != nil {
@@ -622,7 +671,7 @@ func (fnb *FlowNodeBuilder) initStorage() {
 	// in order to void long iterations with big keys when initializing with an
 	// already populated database, we bootstrap the initial maximum key size
 	// upon starting
-	err := operation.RetryOnConflict(fnb.DB.Update, func(tx *badger.Txn) error {
+	err := operation.RetryOnConflict(fnb.DB.Update, func(tx kv.Transaction) error {
 		return operation.InitMax(tx)
 	})
 	fnb.MustNot(err).Msg("could not initialize max tracker")
@@ -640,21 +689,23 @@ func (fnb *FlowNodeBuilder) initStorage() {
 	setups := bstorage.NewEpochSetups(fnb.Metrics.Cache, fnb.DB)
 	commits := bstorage.NewEpochCommits(fnb.Metrics.Cache, fnb.DB)
 	statuses := bstorage.NewEpochStatuses(fnb.Metrics.Cache, fnb.DB)
+	slashingEvidence := bstorage.NewSlashingEvidence(fnb.DB)
 
 	fnb.Storage = Storage{
-		Headers:      headers,
-		Guarantees:   guarantees,
-		Receipts:     receipts,
-		Results:      results,
-		Seals:        seals,
-		Index:        index,
-		Payloads:     payloads,
-		Blocks:       blocks,
-		Transactions: transactions,
-		Collections:  collections,
-		Setups:       setups,
-		EpochCommits: commits,
-		Statuses:     statuses,
+		Headers:          headers,
+		Guarantees:       guarantees,
+		Receipts:         receipts,
+		Results:          results,
+		Seals:            seals,
+		Index:            index,
+		Payloads:         payloads,
+		Blocks:           blocks,
+		Transactions:     transactions,
+		Collections:      collections,
+		Setups:           setups,
+		EpochCommits:     commits,
+		Statuses:         statuses,
+		SlashingEvidence: slashingEvidence,
 	}
 }
 
@@ -1129,9 +1180,20 @@ func (fnb *FlowNodeBuilder) RegisterDefaultAdminCommands() {
 		return storageCommands.NewReadResultsCommand(config.State, config.Storage.Results)
 	}).AdminCommand("read-seals", func(config *NodeConfig) commands.AdminCommand {
 		return storageCommands.NewReadSealsCommand(config.State, config.Storage.Seals, config.Storage.Index)
+	}).AdminCommand("read-slashing-evidence", func(config *NodeConfig) commands.AdminCommand {
+		return storageCommands.NewReadSlashingEvidenceCommand(config.Storage.SlashingEvidence)
 	}).AdminCommand("get-latest-identity", func(config *NodeConfig) commands.AdminCommand {
 		return common.NewGetIdentityCommand(config.IdentityProvider)
 	})
+
+	// roles with additional state to back up register their own backup command
+	if _, ok := fnb.adminCommands["create-backup"]; !ok {
+		fnb.AdminCommand("create-backup", func(config *NodeConfig) commands.AdminCommand {
+			role, err := flow.ParseRole(config.BaseConfig.NodeRole)
+			fnb.MustNot(err).Msg("invalid node role")
+			return storageCommands.NewCreateBackupCommand(config.Logger, config.NodeID, role, config.BadgerDB, config.DataEncryptionKey)
+		})
+	}
 }
 
 func (fnb *FlowNodeBuilder) Build() (Node, error) {
@@ -1267,6 +1329,17 @@ func LoadPrivateNodeInfo(dir string, myID flow.Identifier) (*bootstrap.NodeInfoP
 	return &info, err
 }
 
+// loadDataEncryptionKey loads the encryption key for the protocol database and
+// execution state. If the file does not exist, returns os.ErrNotExist.
+func loadDataEncryptionKey(dir string, myID flow.Identifier) ([]byte, error) {
+	path := filepath.Join(dir, fmt.Sprintf(bootstrap.PathDataEncryptionKey, myID))
+	data, err := io.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read data encryption key (path=%s): %w", path, err)
+	}
+	return data, nil
+}
+
 // loadSecretsEncryptionKey loads the encryption key for the secrets database.
 // If the file does not exist, returns os.ErrNotExist.
 func loadSecretsEncryptionKey(dir string, myID flow.Identifier) ([]byte, error) {
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/backup"
+	"github.com/onflow/flow-go/module/metrics"
+)
+
+var (
+	flagDatadir string
+	flagTriedir string
+	flagNodeID  string
+	flagRole    string
+)
+
+// forestCapacity is required to open the write-ahead log, which is not replayed
+// when backing it up.
+const forestCapacity = 1000
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Backs up the protocol database and execution state of a stopped node",
+	Long: "Backs up the protocol database (--datadir) and, for execution nodes, the execution state (--triedir) of a stopped node. " +
+		"The backup name defaults to <role>-<node ID>-<time>.",
+	Run: runCreate,
+}
+
+func init() {
+	createCmd.Flags().StringVar(&flagDatadir, "datadir", "/var/flow/data/protocol", "directory of the protocol database")
+	createCmd.Flags().StringVar(&flagTriedir, "triedir", "", "directory of the execution state, required for execution nodes")
+	createCmd.Flags().StringVar(&flagNodeID, "node-id", "", "ID of the node")
+	createCmd.Flags().StringVar(&flagRole, "role", "", "role of the node")
+	_ = createCmd.MarkFlagRequired("node-id")
+	_ = createCmd.MarkFlagRequired("role")
+}
+
+func runCreate(*cobra.Command, []string) {
+	ctx := context.Background()
+
+	nodeID, err := flow.HexStringToIdentifier(flagNodeID)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid node ID")
+	}
+	role, err := flow.ParseRole(flagRole)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid role")
+	}
+	if role == flow.RoleExecution && flagTriedir == "" {
+		log.Fatal().Msg("--triedir is required for execution nodes")
+	}
+
+	key := readDataEncryptionKey()
+	store := newStore(ctx)
+
+	db, err := badger.Open(badger.DefaultOptions(flagDatadir).WithLogger(nil).WithEncryptionKey(key))
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open protocol database")
+	}
+	defer db.Close()
+
+	var diskWAL *wal.DiskWAL
+	if role == flow.RoleExecution {
+		var encryption *wal.Encryption
+		if key != nil {
+			encryption, err = wal.NewEncryption(key)
+			if err != nil {
+				log.Fatal().Err(err).Msg("invalid data encryption key")
+			}
+		}
+		diskWAL, err = wal.NewEncryptedDiskWAL(log.Logger, nil, metrics.NewNoopCollector(), flagTriedir, forestCapacity, pathfinder.PathByteSize, wal.SegmentSize, encryption)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open execution state write-ahead log")
+		}
+		defer func() { <-diskWAL.Done() }()
+	}
+
+	name := flagName
+	if name == "" {
+		name = backup.DefaultName(role, nodeID, time.Now())
+	}
+
+	manifest, err := backup.Create(ctx, log.Logger, store, name, backup.Config{
+		NodeID:            nodeID,
+		Role:              role,
+		DB:                db,
+		WAL:               diskWAL,
+		DataEncryptionKey: key,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not create backup")
+	}
+
+	log.Info().
+		Str("name", name).
+		Uint64("finalized_height", manifest.FinalizedHeight).
+		Uint64("sealed_height", manifest.SealedHeight).
+		Int("files", len(manifest.Files)).
+		Msg("backup created")
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/module/backup"
+)
+
+var (
+	flagRestoreDatadir string
+	flagRestoreTriedir string
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restores a backup into empty directories",
+	Long: "Restores the backup --name into the empty protocol database (--datadir) and execution state (--triedir) directories. " +
+		"The manifest and all files are validated before they are used. " +
+		"Use the data encryption key the backup was taken with; the restored protocol database is encrypted with it.",
+	Run: runRestore,
+}
+
+func init() {
+	restoreCmd.Flags().StringVar(&flagRestoreDatadir, "datadir", "/var/flow/data/protocol", "directory to restore the protocol database to")
+	restoreCmd.Flags().StringVar(&flagRestoreTriedir, "triedir", "", "directory to restore the execution state to, required for execution node backups")
+}
+
+func runRestore(*cobra.Command, []string) {
+	ctx := context.Background()
+
+	if flagName == "" {
+		log.Fatal().Msg("--name is required")
+	}
+
+	manifest, err := backup.Restore(ctx, log.Logger, newStore(ctx), flagName, backup.RestoreConfig{
+		Datadir:           flagRestoreDatadir,
+		Triedir:           flagRestoreTriedir,
+		DataEncryptionKey: readDataEncryptionKey(),
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not restore backup")
+	}
+
+	log.Info().
+		Hex("node_id", manifest.NodeID[:]).
+		Str("role", manifest.Role.String()).
+		Time("created_at", manifest.CreatedAt).
+		Uint64("finalized_height", manifest.FinalizedHeight).
+		Uint64("sealed_height", manifest.SealedHeight).
+		Msg("backup restored")
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/module/backup"
+	"github.com/onflow/flow-go/utils/io"
+)
+
+var (
+	flagDir               string
+	flagGCSBucket         string
+	flagS3Bucket          string
+	flagName              string
+	flagDataEncryptionKey string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Create and restore backups of the protocol database and execution state",
+	Long: "Backups are written to a local directory (--dir), a GCP bucket (--gcs-bucket) or a S3 bucket (--s3-bucket), " +
+		"the objects of a backup are prefixed with the backup name. Running nodes are backed up with the create-backup admin command.",
+}
+
+var RootCmd = rootCmd
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&flagDir, "dir", "", "local directory of the backups")
+	rootCmd.PersistentFlags().StringVar(&flagGCSBucket, "gcs-bucket", "", "GCP bucket of the backups")
+	rootCmd.PersistentFlags().StringVar(&flagS3Bucket, "s3-bucket", "", "S3 bucket of the backups, the client is configured from the environment")
+	rootCmd.PersistentFlags().StringVar(&flagName, "name", "", "name of the backup")
+	rootCmd.PersistentFlags().StringVar(&flagDataEncryptionKey, "data-encryption-key", "", "path to the node's data encryption key, empty if the node's data is not encrypted")
+
+	rootCmd.AddCommand(createCmd)
+	rootCmd.AddCommand(restoreCmd)
+}
+
+func newStore(ctx context.Context) backup.Store {
+	store, err := backup.NewStore(ctx, backup.StoreConfig{
+		Dir:       flagDir,
+		GCSBucket: flagGCSBucket,
+		S3Bucket:  flagS3Bucket,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not create backup store")
+	}
+	return store
+}
+
+func readDataEncryptionKey() []byte {
+	if flagDataEncryptionKey == "" {
+		return nil
+	}
+	key, err := io.ReadFile(flagDataEncryptionKey)
+	if err != nil {
+		log.Fatal().Err(err).Str("path", flagDataEncryptionKey).Msg("could not read data encryption key")
+	}
+	return key
+}
@@ -8,28 +8,31 @@ import (
 	"github.com/onflow/flow-go/storage"
 	storagebadger "github.com/onflow/flow-go/storage/badger"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 )
 
-func InitStorage(datadir string) *badger.DB {
+func InitStorage(datadir string) kv.DB {
 	return InitStorageWithTruncate(datadir, false)
 }
 
-func InitStorageWithTruncate(datadir string, truncate bool) *badger.DB {
+func InitStorageWithTruncate(datadir string, truncate bool) kv.DB {
 	opts := badger.
 		DefaultOptions(datadir).
 		WithKeepL0InMemory(true).
 		WithLogger(nil).
 		WithTruncate(truncate)
 
-	db, err := badger.Open(opts)
+	bdb, err := badger.Open(opts)
 	if err != nil {
 		log.Fatal().Err(err).Msg("could not open key-value store")
 	}
+	db := badgerkv.New(bdb)
 
 	// in order to void long iterations with big keys when initializing with an
 	// already populated database, we bootstrap the initial maximum key size
 	// upon starting
-	err = operation.RetryOnConflict(db.Update, func(tx *badger.Txn) error {
+	err = operation.RetryOnConflict(db.Update, func(tx kv.Transaction) error {
 		return operation.InitMax(tx)
 	})
 	if err != nil {
@@ -39,7 +42,7 @@ func InitStorageWithTruncate(datadir string, truncate bool) *badger.DB {
 	return db
 }
 
-func InitStorages(db *badger.DB) *storage.All {
+func InitStorages(db kv.DB) *storage.All {
 	metrics := &metrics.NoopCollector{}
 
 	return storagebadger.InitAll(metrics, db)
@@ -96,6 +96,11 @@ func extractExecutionState(
 				RWF:   reportFileWriterFactory,
 			},
 			reporters.NewFungibleTokenTracker(log, reportFileWriterFactory, chain, []string{reporters.FlowTokenTypeID(chain)}),
+			&reporters.RegisterStatsReporter{
+				Log:              log,
+				RWF:              reportFileWriterFactory,
+				LargestRegisters: 100,
+			},
 		}
 	}
 
@@ -0,0 +1,211 @@
+package hotstuff_timeline
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+var (
+	flagOutput   string
+	flagFormat   string
+	flagFromView uint64
+	flagToView   uint64
+)
+
+// Cmd merges the events recorded by the HotStuff flight recorders of several nodes into a
+// single timeline per view.
+var Cmd = &cobra.Command{
+	Use:   "hotstuff-timeline [files...]",
+	Short: "Merges HotStuff flight recorder dumps of several nodes into a timeline per view",
+	Long: "Reads the consensus events recorded by the HotStuff flight recorders of several nodes and " +
+		"prints them as one timeline per view, ordered by time. Accepted inputs are the responses of the " +
+		"dump-hotstuff-events admin command and the files written with --hotstuff-flight-recorder-file. " +
+		"Events are ordered by the clocks of the recording nodes, so clock skew between nodes affects the order.",
+	Args: cobra.MinimumNArgs(1),
+	Run:  run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagOutput, "output", "",
+		"file to write the timeline to, defaults to stdout")
+
+	Cmd.Flags().StringVar(&flagFormat, "format", formatText,
+		"format of the timeline (text, json)")
+
+	Cmd.Flags().Uint64Var(&flagFromView, "from-view", 0,
+		"lowest view to include in the timeline")
+
+	Cmd.Flags().Uint64Var(&flagToView, "to-view", 0,
+		"highest view to include in the timeline, zero means no limit")
+}
+
+func run(_ *cobra.Command, args []string) {
+
+	if flagFormat != formatText && flagFormat != formatJSON {
+		log.Fatal().Str("format", flagFormat).Msg("unknown timeline format")
+	}
+
+	var events []model.FlightEvent
+	for _, path := range args {
+		fileEvents, err := readEventsFile(path)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("could not read flight recorder events")
+		}
+		log.Info().Str("path", path).Int("events", len(fileEvents)).Msg("read flight recorder events")
+		events = append(events, fileEvents...)
+	}
+
+	var timelines []*flightrecorder.ViewTimeline
+	for _, timeline := range flightrecorder.Timeline(events) {
+		if timeline.View < flagFromView || (flagToView > 0 && timeline.View > flagToView) {
+			continue
+		}
+		timelines = append(timelines, timeline)
+	}
+
+	out := os.Stdout
+	if flagOutput != "" {
+		file, err := os.Create(flagOutput)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", flagOutput).Msg("could not create output file")
+		}
+		defer file.Close()
+		out = file
+	}
+
+	w := bufio.NewWriter(out)
+	var err error
+	if flagFormat == formatJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(timelines)
+	} else {
+		err = writeText(w, timelines)
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not write timeline")
+	}
+}
+
+func readEventsFile(path string) ([]model.FlightEvent, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open file: %w", err)
+	}
+	defer file.Close()
+
+	return readEvents(file)
+}
+
+// readEvents reads all flight events from the given reader, which holds a sequence of JSON
+// objects. Each object is either a single event, as written to the flight recorder file, a
+// flight recorder dump, or the admin server response wrapping a dump.
+func readEvents(r io.Reader) ([]model.FlightEvent, error) {
+	var events []model.FlightEvent
+
+	decoder := json.NewDecoder(r)
+	for {
+		var object json.RawMessage
+		err := decoder.Decode(&object)
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not decode JSON: %w", err)
+		}
+
+		var probe struct {
+			Output json.RawMessage   `json:"output"`
+			Events []json.RawMessage `json:"events"`
+		}
+		err = json.Unmarshal(object, &probe)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode JSON object: %w", err)
+		}
+		// unwrap the admin server response
+		if probe.Output != nil {
+			object = probe.Output
+		}
+
+		if probe.Output != nil || probe.Events != nil {
+			var dump flightrecorder.Dump
+			err = json.Unmarshal(object, &dump)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode flight recorder dump: %w", err)
+			}
+			events = append(events, dump.Events...)
+			continue
+		}
+
+		var event model.FlightEvent
+		err = json.Unmarshal(object, &event)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode flight event: %w", err)
+		}
+		events = append(events, event)
+	}
+}
+
+// writeText writes the timelines in a human-readable format, one event per line.
+func writeText(w io.Writer, timelines []*flightrecorder.ViewTimeline) error {
+	for _, timeline := range timelines {
+		_, err := fmt.Fprintf(w, "=== %s view %d ===\n", timeline.ChainID, timeline.View)
+		if err != nil {
+			return err
+		}
+		for _, event := range timeline.Events {
+			_, err = fmt.Fprintln(w, formatEvent(event))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func formatEvent(event model.FlightEvent) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s node=%s %s", event.Time.Format(time.RFC3339Nano), event.NodeID, event.Type)
+	if event.CurView > 0 && event.CurView != event.View {
+		fmt.Fprintf(&b, " cur_view=%d", event.CurView)
+	}
+	if event.BlockID != flow.ZeroID {
+		fmt.Fprintf(&b, " block=%s", event.BlockID)
+	}
+	if event.OriginID != flow.ZeroID {
+		fmt.Fprintf(&b, " origin=%s", event.OriginID)
+	}
+	if event.RecipientID != flow.ZeroID {
+		fmt.Fprintf(&b, " recipient=%s", event.RecipientID)
+	}
+	if event.QCView > 0 {
+		fmt.Fprintf(&b, " qc_view=%d", event.QCView)
+	}
+	if event.Signers > 0 {
+		fmt.Fprintf(&b, " signers=%d", event.Signers)
+	}
+	if event.Details != "" {
+		fmt.Fprintf(&b, " details=%q", event.Details)
+	}
+	return b.String()
+}
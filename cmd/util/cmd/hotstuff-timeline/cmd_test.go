@@ -0,0 +1,63 @@
+package hotstuff_timeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestReadEvents checks that events are read from streamed events, dumps and admin responses.
+func TestReadEvents(t *testing.T) {
+	var stream bytes.Buffer
+	recorder, err := flightrecorder.New(zerolog.Nop(), unittest.IdentifierFixture(), flow.Emulator, 10, flightrecorder.WithStream(&stream))
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx, _ := irrecoverable.WithSignaler(ctx)
+	recorder.Start(signalerCtx)
+	unittest.RequireCloseBefore(t, recorder.Ready(), time.Second, "recorder should start")
+
+	recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: 1})
+	recorder.Record(model.FlightEvent{Type: model.FlightEventLocalTimeout, View: 1})
+
+	// stopping the recorder writes all queued events to the stream
+	cancel()
+	unittest.RequireCloseBefore(t, recorder.Done(), time.Second, "recorder should stop")
+
+	dump := recorder.Dump()
+	dumpJSON, err := json.Marshal(dump)
+	require.NoError(t, err)
+	responseJSON, err := json.Marshal(map[string]interface{}{"output": dump})
+	require.NoError(t, err)
+
+	for name, input := range map[string][]byte{
+		"stream":   stream.Bytes(),
+		"dump":     dumpJSON,
+		"response": responseJSON,
+	} {
+		t.Run(name, func(t *testing.T) {
+			events, err := readEvents(bytes.NewReader(input))
+			require.NoError(t, err)
+			require.Len(t, events, 2)
+			for i, event := range events {
+				assert.Equal(t, dump.Events[i].Type, event.Type)
+				assert.Equal(t, dump.Events[i].NodeID, event.NodeID)
+				assert.True(t, dump.Events[i].Time.Equal(event.Time))
+			}
+		})
+	}
+
+	_, err = readEvents(bytes.NewReader([]byte("{not json")))
+	require.Error(t, err)
+}
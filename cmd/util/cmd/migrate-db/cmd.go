@@ -0,0 +1,104 @@
+package migrate_db
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+	"github.com/onflow/flow-go/storage/kv/pebblekv"
+)
+
+const (
+	backendBadger = "badger"
+	backendPebble = "pebble"
+)
+
+var (
+	flagFromDir     string
+	flagFromBackend string
+	flagToDir       string
+	flagToBackend   string
+	flagBatchSize   int
+)
+
+// Cmd copies the protocol database of a stopped node into a new database
+// using another key-value backend.
+var Cmd = &cobra.Command{
+	Use:   "migrate-db",
+	Short: "Migrates the protocol database to another key-value backend",
+	Long: "Copies all keys of the protocol database in --from-dir into a new database in --to-dir. " +
+		"The node must be stopped. Start the node with --datadir set to --to-dir and --db-backend set to --to-backend afterwards. " +
+		"Encrypted badger databases are not supported, decrypt them with the reencrypt command first.",
+	Run: run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagFromDir, "from-dir", "", "directory of the database to migrate")
+	_ = Cmd.MarkFlagRequired("from-dir")
+	Cmd.Flags().StringVar(&flagFromBackend, "from-backend", backendBadger, "backend of the database to migrate: badger or pebble")
+	Cmd.Flags().StringVar(&flagToDir, "to-dir", "", "directory of the new database, must not exist or be empty")
+	_ = Cmd.MarkFlagRequired("to-dir")
+	Cmd.Flags().StringVar(&flagToBackend, "to-backend", backendPebble, "backend of the new database: badger or pebble")
+	Cmd.Flags().IntVar(&flagBatchSize, "batch-size", 10000, "number of keys written per write batch")
+}
+
+func run(*cobra.Command, []string) {
+
+	// both backends create missing databases
+	_, err := os.Stat(flagFromDir)
+	if err != nil {
+		log.Fatal().Err(err).Str("dir", flagFromDir).Msg("could not find source database")
+	}
+
+	entries, err := os.ReadDir(flagToDir)
+	if err != nil && !os.IsNotExist(err) {
+		log.Fatal().Err(err).Str("dir", flagToDir).Msg("could not read target directory")
+	}
+	if len(entries) > 0 {
+		log.Fatal().Str("dir", flagToDir).Msg("target directory is not empty")
+	}
+
+	src, err := open(flagFromBackend, flagFromDir)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open source database")
+	}
+	defer src.Close()
+
+	dst, err := open(flagToBackend, flagToDir)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open target database")
+	}
+	defer dst.Close()
+
+	log.Info().
+		Str("from", flagFromBackend).
+		Str("to", flagToBackend).
+		Msg("migrating protocol database")
+
+	count, err := kv.Copy(dst, src, flagBatchSize)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not migrate protocol database")
+	}
+
+	log.Info().Int("keys", count).Msg("protocol database migrated")
+}
+
+func open(backend string, dir string) (kv.DB, error) {
+	switch backend {
+	case backendBadger:
+		db, err := badger.Open(badger.DefaultOptions(dir).WithKeepL0InMemory(true).WithLogger(nil))
+		if err != nil {
+			return nil, fmt.Errorf("could not open badger database: %w", err)
+		}
+		return badgerkv.New(db), nil
+	case backendPebble:
+		return pebblekv.Open(dir)
+	default:
+		return nil, fmt.Errorf("unknown backend %q", backend)
+	}
+}
@@ -0,0 +1,281 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+
+	sdk "github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/epochs"
+)
+
+// Status is the outcome of a single pre-flight check.
+type Status string
+
+const (
+	StatusPass Status = "PASS"
+	StatusFail Status = "FAIL"
+	StatusSkip Status = "SKIP"
+)
+
+// Result is the result of a single pre-flight check.
+type Result struct {
+	Check   string
+	Status  Status
+	Message string
+}
+
+func pass(check string, format string, args ...interface{}) Result {
+	return Result{Check: check, Status: StatusPass, Message: fmt.Sprintf(format, args...)}
+}
+
+func fail(check string, format string, args ...interface{}) Result {
+	return Result{Check: check, Status: StatusFail, Message: fmt.Sprintf(format, args...)}
+}
+
+func skip(check string, format string, args ...interface{}) Result {
+	return Result{Check: check, Status: StatusSkip, Message: fmt.Sprintf(format, args...)}
+}
+
+// Report is the list of results of all pre-flight checks run for a node.
+type Report []Result
+
+// Passed returns true if none of the checks failed.
+func (r Report) Passed() bool {
+	for _, result := range r {
+		if result.Status == StatusFail {
+			return false
+		}
+	}
+	return true
+}
+
+// Write writes the report as a human-readable table to the given writer.
+func (r Report) Write(w io.Writer) error {
+	width := 0
+	for _, result := range r {
+		if len(result.Check) > width {
+			width = len(result.Check)
+		}
+	}
+	for _, result := range r {
+		_, err := fmt.Fprintf(w, "[%s] %-*s  %s\n", result.Status, width, result.Check, result.Message)
+		if err != nil {
+			return err
+		}
+	}
+
+	summary := "all pre-flight checks passed"
+	if !r.Passed() {
+		summary = "some pre-flight checks failed"
+	}
+	_, err := fmt.Fprintln(w, summary)
+	return err
+}
+
+// AccessAPI is the subset of the Access API used for the on-chain checks.
+// It is implemented by the Flow SDK client.
+type AccessAPI interface {
+	GetAccount(ctx context.Context, address sdk.Address, opts ...grpc.CallOption) (*sdk.Account, error)
+	ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments []cadence.Value, opts ...grpc.CallOption) (cadence.Value, error)
+}
+
+// CheckNodeID checks that the node ID written to the bootstrap directory
+// matches the node ID in the node's private info.
+func CheckNodeID(nodeID string, info *bootstrap.NodeInfoPriv) Result {
+	const check = "node id"
+
+	id, err := flow.HexStringToIdentifier(strings.TrimSpace(nodeID))
+	if err != nil {
+		return fail(check, "could not parse node id %q: %v", nodeID, err)
+	}
+	if id != info.NodeID {
+		return fail(check, "node id file (%x) does not match private node info (%x)", id, info.NodeID)
+	}
+	return pass(check, "%x", id)
+}
+
+// CheckIdentity checks the node's private info against its entry in the
+// identity table: role, staking and networking keys, and network address.
+func CheckIdentity(info *bootstrap.NodeInfoPriv, identities flow.IdentityList) []Result {
+
+	identity, ok := identities.ByNodeID(info.NodeID)
+	if !ok {
+		return []Result{fail("identity table", "node %x is not in the identity table", info.NodeID)}
+	}
+
+	results := []Result{pass("identity table", "node is in the identity table with weight %d", identity.Weight)}
+	if identity.Ejected {
+		results = append(results, fail("ejection", "node was ejected"))
+	}
+
+	if identity.Role != info.Role {
+		results = append(results, fail("role", "local role (%s) does not match identity table (%s)", info.Role, identity.Role))
+	} else {
+		results = append(results, pass("role", "%s", identity.Role))
+	}
+
+	results = append(results,
+		checkKeyPair("staking key", info.StakingPrivKey.PrivateKey, identity.StakingPubKey),
+		checkKeyPair("networking key", info.NetworkPrivKey.PrivateKey, identity.NetworkPubKey),
+	)
+
+	if identity.Address != info.Address {
+		results = append(results, fail("address registration", "local address (%s) does not match identity table (%s)", info.Address, identity.Address))
+	} else {
+		results = append(results, pass("address registration", "%s", identity.Address))
+	}
+
+	return results
+}
+
+// checkKeyPair checks that the given private key is the counterpart of the
+// public key in the identity table.
+func checkKeyPair(check string, privateKey crypto.PrivateKey, publicKey crypto.PublicKey) Result {
+	if privateKey == nil {
+		return fail(check, "private key is missing")
+	}
+	if publicKey == nil {
+		return fail(check, "public key is missing from the identity table")
+	}
+	if !privateKey.PublicKey().Equals(publicKey) {
+		return fail(check, "private key does not match public key %s in the identity table", publicKey)
+	}
+	return pass(check, "private key matches public key in the identity table")
+}
+
+// CheckAddressFormat checks that the node's public network address is of
+// the form host:port, and that it can be reached from other nodes.
+func CheckAddressFormat(address string) Result {
+	const check = "address format"
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fail(check, "address %q is not of the form host:port: %v", address, err)
+	}
+	if host == "" {
+		return fail(check, "address %q is missing a host", address)
+	}
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || portNum == 0 {
+		return fail(check, "address %q has an invalid port", address)
+	}
+	if host == "localhost" {
+		return fail(check, "address %q is not reachable from other nodes", address)
+	}
+	if ip := net.ParseIP(host); ip != nil && (ip.IsLoopback() || ip.IsUnspecified()) {
+		return fail(check, "address %q is not reachable from other nodes", address)
+	}
+	return pass(check, "%s", address)
+}
+
+// CheckSecretsEncryptionKey checks the encryption key of the secrets database.
+// The key is mandatory for consensus nodes and optional for all other roles.
+// Keys must be valid AES keys, as required by Badger.
+func CheckSecretsEncryptionKey(role flow.Role, key []byte, exists bool) Result {
+	const check = "secrets db encryption key"
+
+	if !exists {
+		if role == flow.RoleConsensus {
+			return fail(check, "encryption key is missing, it is required for consensus nodes")
+		}
+		return skip(check, "no encryption key, the secrets database will not be encrypted")
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return pass(check, "%d-byte encryption key", len(key))
+	default:
+		return fail(check, "encryption key has invalid length %d, expected 16, 24 or 32 bytes", len(key))
+	}
+}
+
+// CheckMachineAccount checks the local machine account configuration against
+// the on-chain account: keys, key weight and balance. It also checks that the
+// node is registered with the epoch smart contract it uses the machine account for.
+func CheckMachineAccount(
+	ctx context.Context,
+	log zerolog.Logger,
+	api AccessAPI,
+	role flow.Role,
+	nodeID flow.Identifier,
+	info *bootstrap.NodeMachineAccountInfo,
+	env templates.Environment,
+) []Result {
+
+	account, err := api.GetAccount(ctx, info.SDKAddress())
+	if err != nil {
+		return []Result{fail("machine account", "could not get machine account %s: %v", info.Address, err)}
+	}
+
+	var results []Result
+	err = epochs.CheckMachineAccountInfo(log, epochs.DefaultMachineAccountValidatorConfig(), role, *info, account)
+	if err != nil {
+		results = append(results, fail("machine account", "%v", err))
+	} else {
+		results = append(results, pass("machine account", "keys and balance (%s) are configured correctly", cadence.UFix64(account.Balance)))
+	}
+
+	results = append(results, checkMachineAccountKeyWeight(info, account))
+	results = append(results, checkContractRegistration(ctx, api, role, nodeID, env))
+
+	return results
+}
+
+// checkMachineAccountKeyWeight checks that the machine account key alone is
+// sufficient to sign transactions.
+func checkMachineAccountKeyWeight(info *bootstrap.NodeMachineAccountInfo, account *sdk.Account) Result {
+	const check = "machine account key weight"
+
+	if len(account.Keys) <= int(info.KeyIndex) {
+		return fail(check, "machine account has no key with index %d", info.KeyIndex)
+	}
+	key := account.Keys[info.KeyIndex]
+	if key.Revoked {
+		return fail(check, "machine account key %d is revoked", info.KeyIndex)
+	}
+	if key.Weight < sdk.AccountKeyWeightThreshold {
+		return fail(check, "machine account key %d has weight %d, expected at least %d", info.KeyIndex, key.Weight, sdk.AccountKeyWeightThreshold)
+	}
+	return pass(check, "%d", key.Weight)
+}
+
+// checkContractRegistration checks that the node is registered as a voter with
+// the cluster QC contract (collection nodes) or as a participant with the DKG
+// contract (consensus nodes).
+func checkContractRegistration(ctx context.Context, api AccessAPI, role flow.Role, nodeID flow.Identifier, env templates.Environment) Result {
+	const check = "contract registration"
+
+	var script []byte
+	switch role {
+	case flow.RoleCollection:
+		script = templates.GenerateGetVoterIsRegisteredScript(env)
+	case flow.RoleConsensus:
+		script = templates.GenerateGetDKGNodeIsRegisteredScript(env)
+	default:
+		return skip(check, "role %s does not participate in the epoch smart contracts", role)
+	}
+
+	value, err := api.ExecuteScriptAtLatestBlock(ctx, script, []cadence.Value{cadence.String(nodeID.String())})
+	if err != nil {
+		return fail(check, "could not check registration: %v", err)
+	}
+	registered, ok := value.(cadence.Bool)
+	if !ok {
+		return fail(check, "unexpected script result type %T", value)
+	}
+	if !registered {
+		return fail(check, "node is not registered with the epoch smart contracts")
+	}
+	return pass(check, "node is registered with the epoch smart contracts")
+}
@@ -0,0 +1,190 @@
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	sdk "github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/encodable"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// nodeFixture returns private node info with ECDSA keys and the matching
+// identity table entry.
+func nodeFixture(t *testing.T) (*bootstrap.NodeInfoPriv, *flow.Identity) {
+	stakingKey := unittest.KeyFixture(crypto.ECDSAP256)
+	networkKey := unittest.NetworkingPrivKeyFixture()
+	info := &bootstrap.NodeInfoPriv{
+		Role:           flow.RoleCollection,
+		Address:        "collection-1.example.com:3569",
+		NodeID:         unittest.IdentifierFixture(),
+		StakingPrivKey: encodable.StakingPrivKey{PrivateKey: stakingKey},
+		NetworkPrivKey: encodable.NetworkPrivKey{PrivateKey: networkKey},
+	}
+	identity := &flow.Identity{
+		NodeID:        info.NodeID,
+		Role:          info.Role,
+		Address:       info.Address,
+		Weight:        100,
+		StakingPubKey: stakingKey.PublicKey(),
+		NetworkPubKey: networkKey.PublicKey(),
+	}
+	return info, identity
+}
+
+func statuses(results []Result) map[string]Status {
+	out := make(map[string]Status, len(results))
+	for _, result := range results {
+		out[result.Check] = result.Status
+	}
+	return out
+}
+
+func TestCheckNodeID(t *testing.T) {
+	info, _ := nodeFixture(t)
+
+	assert.Equal(t, StatusPass, CheckNodeID(info.NodeID.String()+"\n", info).Status)
+	assert.Equal(t, StatusFail, CheckNodeID(unittest.IdentifierFixture().String(), info).Status)
+	assert.Equal(t, StatusFail, CheckNodeID("not-an-id", info).Status)
+}
+
+func TestCheckIdentity(t *testing.T) {
+
+	t.Run("valid", func(t *testing.T) {
+		info, identity := nodeFixture(t)
+		results := CheckIdentity(info, flow.IdentityList{identity})
+		for _, result := range results {
+			assert.Equal(t, StatusPass, result.Status, result.Check)
+		}
+	})
+
+	t.Run("not in identity table", func(t *testing.T) {
+		info, _ := nodeFixture(t)
+		results := CheckIdentity(info, flow.IdentityList{})
+		require.Len(t, results, 1)
+		assert.Equal(t, StatusFail, results[0].Status)
+	})
+
+	t.Run("mismatched keys, role and address", func(t *testing.T) {
+		info, identity := nodeFixture(t)
+		identity.Role = flow.RoleExecution
+		identity.Address = "other.example.com:3569"
+		identity.StakingPubKey = unittest.KeyFixture(crypto.ECDSAP256).PublicKey()
+		identity.NetworkPubKey = unittest.NetworkingPrivKeyFixture().PublicKey()
+		identity.Ejected = true
+
+		got := statuses(CheckIdentity(info, flow.IdentityList{identity}))
+		assert.Equal(t, StatusPass, got["identity table"])
+		assert.Equal(t, StatusFail, got["ejection"])
+		assert.Equal(t, StatusFail, got["role"])
+		assert.Equal(t, StatusFail, got["staking key"])
+		assert.Equal(t, StatusFail, got["networking key"])
+		assert.Equal(t, StatusFail, got["address registration"])
+	})
+}
+
+func TestCheckAddressFormat(t *testing.T) {
+	valid := []string{"collection-1.example.com:3569", "10.0.0.1:3569", "[2001:db8::1]:3569"}
+	for _, address := range valid {
+		assert.Equal(t, StatusPass, CheckAddressFormat(address).Status, address)
+	}
+
+	invalid := []string{"", "example.com", ":3569", "example.com:0", "example.com:65536", "example.com:port",
+		"localhost:3569", "127.0.0.1:3569", "0.0.0.0:3569", "[::1]:3569"}
+	for _, address := range invalid {
+		assert.Equal(t, StatusFail, CheckAddressFormat(address).Status, address)
+	}
+}
+
+func TestCheckSecretsEncryptionKey(t *testing.T) {
+	assert.Equal(t, StatusPass, CheckSecretsEncryptionKey(flow.RoleConsensus, make([]byte, 32), true).Status)
+	assert.Equal(t, StatusFail, CheckSecretsEncryptionKey(flow.RoleConsensus, make([]byte, 31), true).Status)
+	assert.Equal(t, StatusFail, CheckSecretsEncryptionKey(flow.RoleConsensus, nil, false).Status)
+	assert.Equal(t, StatusSkip, CheckSecretsEncryptionKey(flow.RoleCollection, nil, false).Status)
+}
+
+// accessAPI is a fake Access API returning a fixed account and script result.
+type accessAPI struct {
+	account    *sdk.Account
+	registered cadence.Value
+	err        error
+}
+
+func (a *accessAPI) GetAccount(context.Context, sdk.Address, ...grpc.CallOption) (*sdk.Account, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	return a.account, nil
+}
+
+func (a *accessAPI) ExecuteScriptAtLatestBlock(context.Context, []byte, []cadence.Value, ...grpc.CallOption) (cadence.Value, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	return a.registered, nil
+}
+
+func TestCheckContractRegistration(t *testing.T) {
+	nodeID := unittest.IdentifierFixture()
+	env := templates.Environment{}
+
+	api := &accessAPI{registered: cadence.NewBool(true)}
+	assert.Equal(t, StatusPass, checkContractRegistration(context.Background(), api, flow.RoleCollection, nodeID, env).Status)
+	assert.Equal(t, StatusPass, checkContractRegistration(context.Background(), api, flow.RoleConsensus, nodeID, env).Status)
+	assert.Equal(t, StatusSkip, checkContractRegistration(context.Background(), api, flow.RoleExecution, nodeID, env).Status)
+
+	api = &accessAPI{registered: cadence.NewBool(false)}
+	assert.Equal(t, StatusFail, checkContractRegistration(context.Background(), api, flow.RoleCollection, nodeID, env).Status)
+
+	api = &accessAPI{err: fmt.Errorf("unavailable")}
+	assert.Equal(t, StatusFail, checkContractRegistration(context.Background(), api, flow.RoleConsensus, nodeID, env).Status)
+}
+
+func TestCheckMachineAccountKeyWeight(t *testing.T) {
+	info := &bootstrap.NodeMachineAccountInfo{KeyIndex: 1}
+
+	account := &sdk.Account{Keys: []*sdk.AccountKey{
+		{Index: 0, Weight: sdk.AccountKeyWeightThreshold},
+		{Index: 1, Weight: sdk.AccountKeyWeightThreshold},
+	}}
+	assert.Equal(t, StatusPass, checkMachineAccountKeyWeight(info, account).Status)
+
+	account.Keys[1].Weight = sdk.AccountKeyWeightThreshold - 1
+	assert.Equal(t, StatusFail, checkMachineAccountKeyWeight(info, account).Status)
+
+	account.Keys[1].Weight = sdk.AccountKeyWeightThreshold
+	account.Keys[1].Revoked = true
+	assert.Equal(t, StatusFail, checkMachineAccountKeyWeight(info, account).Status)
+
+	account.Keys = account.Keys[:1]
+	assert.Equal(t, StatusFail, checkMachineAccountKeyWeight(info, account).Status)
+}
+
+func TestReport(t *testing.T) {
+	report := Report{pass("a", "ok"), skip("b", "skipped")}
+	assert.True(t, report.Passed())
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+	assert.Contains(t, buf.String(), "[PASS] a  ok")
+	assert.Contains(t, buf.String(), "all pre-flight checks passed")
+
+	report = append(report, fail("c", "broken"))
+	assert.False(t, report.Passed())
+
+	buf.Reset()
+	require.NoError(t, report.Write(&buf))
+	assert.Contains(t, buf.String(), "[FAIL] c  broken")
+	assert.Contains(t, buf.String(), "some pre-flight checks failed")
+}
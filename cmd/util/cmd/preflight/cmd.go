@@ -0,0 +1,204 @@
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/onflow/flow-go/cmd/util/cmd/common"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/fvm/systemcontracts"
+	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/flow/filter"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/state/protocol/inmem"
+	"github.com/onflow/flow-go/utils/io"
+)
+
+var (
+	flagBootDir          string
+	flagSnapshot         string
+	flagLatestSnapshot   bool
+	flagAccessAddress    string
+	flagAccessNodePubKey string
+	flagInsecure         bool
+)
+
+// Cmd runs pre-flight checks of a node's bootstrap directory against a root
+// or latest protocol state snapshot, and prints a pass/fail report.
+var Cmd = &cobra.Command{
+	Use:   "preflight",
+	Short: "Checks a node's bootstrap configuration before starting the node",
+	Long: "Loads the node's bootstrap directory and validates it against a root or latest protocol state snapshot: " +
+		"staking and networking keys, network address, secrets database encryption key and machine account. " +
+		"Machine account checks require an Access Node (--access-address).",
+	Run: run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagBootDir, "bootstrap-dir", "", "path to the node's bootstrap directory")
+	_ = Cmd.MarkFlagRequired("bootstrap-dir")
+
+	Cmd.Flags().StringVar(&flagSnapshot, "snapshot", "", "path to a protocol state snapshot (defaults to the root snapshot in the bootstrap directory)")
+	Cmd.Flags().BoolVar(&flagLatestSnapshot, "latest-snapshot", false, "check against the latest protocol state snapshot of the Access Node")
+	Cmd.Flags().StringVar(&flagAccessAddress, "access-address", "", "network address of an Access Node, required for machine account checks")
+	Cmd.Flags().StringVar(&flagAccessNodePubKey, "access-node-pubkey", "", "networking public key of the Access Node, required unless --insecure is set")
+	Cmd.Flags().BoolVar(&flagInsecure, "insecure", false, "connect to the Access Node without TLS")
+}
+
+func run(cmd *cobra.Command, _ []string) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var flowClient *client.Client
+	if flagAccessAddress != "" {
+		config, err := common.NewFlowClientConfig(flagAccessAddress, strings.TrimPrefix(flagAccessNodePubKey, "0x"), flow.ZeroID, flagInsecure)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid access node configuration")
+		}
+		flowClient, err = common.FlowClient(config)
+		if err != nil {
+			log.Fatal().Err(err).Str("address", flagAccessAddress).Msg("could not connect to access node")
+		}
+	} else if flagLatestSnapshot {
+		log.Fatal().Msg("--latest-snapshot requires --access-address")
+	}
+
+	report := runChecks(ctx, flowClient)
+
+	err := report.Write(cmd.OutOrStdout())
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not write report")
+	}
+	if !report.Passed() {
+		os.Exit(1)
+	}
+}
+
+// runChecks runs all pre-flight checks. On-chain checks are skipped if no
+// Access Node client is given.
+func runChecks(ctx context.Context, flowClient *client.Client) Report {
+
+	var report Report
+
+	nodeID, err := io.ReadFile(filepath.Join(flagBootDir, bootstrap.PathNodeID))
+	if err != nil {
+		return append(report, fail("node id", "could not read node id: %v", err))
+	}
+	id, err := flow.HexStringToIdentifier(strings.TrimSpace(string(nodeID)))
+	if err != nil {
+		return append(report, fail("node id", "could not parse node id %q: %v", nodeID, err))
+	}
+
+	var info bootstrap.NodeInfoPriv
+	err = readJSON(filepath.Join(flagBootDir, fmt.Sprintf(bootstrap.PathNodeInfoPriv, id)), &info)
+	if err != nil {
+		return append(report, fail("private node info", "could not read private node info: %v", err))
+	}
+	report = append(report, CheckNodeID(string(nodeID), &info))
+
+	snapshot, err := loadSnapshot(ctx, flowClient)
+	if err != nil {
+		return append(report, fail("snapshot", "could not load protocol state snapshot: %v", err))
+	}
+	identities, err := snapshot.Identities(filter.Any)
+	if err != nil {
+		return append(report, fail("snapshot", "could not get identity table: %v", err))
+	}
+	head, err := snapshot.Head()
+	if err != nil {
+		return append(report, fail("snapshot", "could not get snapshot head: %v", err))
+	}
+	report = append(report, pass("snapshot", "block %x at height %d on chain %s", head.ID(), head.Height, head.ChainID))
+
+	report = append(report, CheckIdentity(&info, identities)...)
+	report = append(report, CheckAddressFormat(info.Address))
+
+	key, err := io.ReadFile(filepath.Join(flagBootDir, fmt.Sprintf(bootstrap.PathSecretsEncryptionKey, id)))
+	exists := !errors.Is(err, os.ErrNotExist)
+	if err != nil && exists {
+		report = append(report, fail("secrets db encryption key", "could not read encryption key: %v", err))
+	} else {
+		report = append(report, CheckSecretsEncryptionKey(info.Role, key, exists))
+	}
+
+	return append(report, checkMachineAccount(ctx, flowClient, &info, head.ChainID)...)
+}
+
+// checkMachineAccount runs the machine account checks for collection and
+// consensus nodes.
+func checkMachineAccount(ctx context.Context, flowClient *client.Client, info *bootstrap.NodeInfoPriv, chainID flow.ChainID) []Result {
+	const check = "machine account"
+
+	if info.Role != flow.RoleCollection && info.Role != flow.RoleConsensus {
+		return []Result{skip(check, "role %s does not use a machine account", info.Role)}
+	}
+
+	var machineAccountInfo bootstrap.NodeMachineAccountInfo
+	err := readJSON(filepath.Join(flagBootDir, fmt.Sprintf(bootstrap.PathNodeMachineAccountInfoPriv, info.NodeID)), &machineAccountInfo)
+	if err != nil {
+		return []Result{fail(check, "could not read machine account info: %v", err)}
+	}
+	if flowClient == nil {
+		return []Result{skip(check, "no access node given (--access-address)")}
+	}
+
+	contracts, err := systemcontracts.SystemContractsForChain(chainID)
+	if err != nil {
+		return []Result{fail(check, "could not get epoch smart contracts: %v", err)}
+	}
+	env := templates.Environment{
+		QuorumCertificateAddress: contracts.ClusterQC.Address.Hex(),
+		DkgAddress:               contracts.DKG.Address.Hex(),
+	}
+
+	return CheckMachineAccount(ctx, log.Logger, flowClient, info.Role, info.NodeID, &machineAccountInfo, env)
+}
+
+// loadSnapshot loads the protocol state snapshot to check against: the latest
+// snapshot of the Access Node, the given snapshot file, or the root snapshot in
+// the bootstrap directory.
+func loadSnapshot(ctx context.Context, flowClient *client.Client) (protocol.Snapshot, error) {
+	if flagLatestSnapshot {
+		bz, err := flowClient.GetLatestProtocolStateSnapshot(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get latest snapshot from access node: %w", err)
+		}
+		return convert.BytesToInmemSnapshot(bz)
+	}
+
+	path := flagSnapshot
+	if path == "" {
+		path = filepath.Join(flagBootDir, bootstrap.PathRootProtocolStateSnapshot)
+	}
+	var snapshot inmem.EncodableSnapshot
+	err := readJSON(path, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return inmem.SnapshotFromEncodable(snapshot), nil
+}
+
+func readJSON(path string, target interface{}) error {
+	data, err := io.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read file %s: %w", path, err)
+	}
+	err = json.Unmarshal(data, target)
+	if err != nil {
+		return fmt.Errorf("could not decode file %s: %w", path, err)
+	}
+	return nil
+}
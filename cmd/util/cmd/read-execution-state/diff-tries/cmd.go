@@ -0,0 +1,98 @@
+package diff_tries
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/complete/mtrie"
+)
+
+var cmd = &cobra.Command{
+	Use:   "diff-tries",
+	Short: "Lists the registers which differ between two state commitments",
+	Run:   run,
+}
+
+var stateLoader func() *mtrie.Forest = nil
+var flagFrom string
+var flagTo string
+var flagSummary bool
+
+func Init(f func() *mtrie.Forest) *cobra.Command {
+	stateLoader = f
+
+	cmd.Flags().StringVar(&flagFrom, "from", "",
+		"State commitment of the older state (64 chars, hex-encoded)")
+	_ = cmd.MarkFlagRequired("from")
+
+	cmd.Flags().StringVar(&flagTo, "to", "",
+		"State commitment of the newer state (64 chars, hex-encoded)")
+	_ = cmd.MarkFlagRequired("to")
+
+	cmd.Flags().BoolVar(&flagSummary, "summary", false,
+		"only print the number of added, removed and updated registers")
+
+	return cmd
+}
+
+// registerChange is the JSON representation of a changed register.
+type registerChange struct {
+	Change string          `json:"change"`
+	Path   string          `json:"path"`
+	Old    *ledger.Payload `json:"old,omitempty"`
+	New    *ledger.Payload `json:"new,omitempty"`
+}
+
+func parseRootHash(flagName string, value string) ledger.RootHash {
+	rootHashBytes, err := hex.DecodeString(value)
+	if err != nil {
+		log.Fatal().Err(err).Str("flag", flagName).Msg("invalid flag, cannot decode")
+	}
+	rootHash, err := ledger.ToRootHash(rootHashBytes)
+	if err != nil {
+		log.Fatal().Err(err).Str("flag", flagName).Msg("invalid state commitment")
+	}
+	return rootHash
+}
+
+func run(*cobra.Command, []string) {
+	startTime := time.Now()
+
+	from := parseRootHash("from", flagFrom)
+	to := parseRootHash("to", flagTo)
+
+	forest := stateLoader()
+
+	counts := make(map[ledger.PayloadChangeType]int)
+	encoder := json.NewEncoder(os.Stdout)
+	err := forest.Diff(from, to, func(change ledger.PayloadChange) error {
+		counts[change.Type]++
+		if flagSummary {
+			return nil
+		}
+		return encoder.Encode(registerChange{
+			Change: change.Type.String(),
+			Path:   hex.EncodeToString(change.Path[:]),
+			Old:    change.Old,
+			New:    change.New,
+		})
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("error while comparing tries")
+	}
+
+	duration := time.Since(startTime)
+
+	log.Info().
+		Int("added", counts[ledger.PayloadAdded]).
+		Int("removed", counts[ledger.PayloadRemoved]).
+		Int("updated", counts[ledger.PayloadUpdated]).
+		Float64("total_time_s", duration.Seconds()).
+		Msg("finished")
+}
@@ -0,0 +1,98 @@
+package reencrypt
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	storagebadger "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/utils/io"
+)
+
+var (
+	flagDatadir string
+	flagTriedir string
+	flagOldKey  string
+	flagNewKey  string
+)
+
+// Cmd re-encrypts the protocol database and execution state of a stopped node.
+var Cmd = &cobra.Command{
+	Use:   "reencrypt",
+	Short: "Re-encrypts the protocol database and execution state with a new data encryption key",
+	Long: "Re-encrypts the protocol database (--datadir) and execution state (--triedir) of a stopped node. " +
+		"Omit --old-key to encrypt unencrypted data, omit --new-key to decrypt data. " +
+		"Back up both directories first, and replace the node's data encryption key with the new key afterwards.",
+	Run: run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagDatadir, "datadir", "", "directory of the protocol database")
+	Cmd.Flags().StringVar(&flagTriedir, "triedir", "", "directory of the execution state (execution nodes only)")
+	Cmd.Flags().StringVar(&flagOldKey, "old-key", "", "path to the current data encryption key, empty if the data is not encrypted")
+	Cmd.Flags().StringVar(&flagNewKey, "new-key", "", "path to the new data encryption key, empty to decrypt the data")
+}
+
+func run(*cobra.Command, []string) {
+
+	if flagDatadir == "" && flagTriedir == "" {
+		log.Fatal().Msg("at least one of --datadir and --triedir is required")
+	}
+
+	oldKey := readKey(flagOldKey)
+	newKey := readKey(flagNewKey)
+	if oldKey == nil && newKey == nil {
+		log.Fatal().Msg("at least one of --old-key and --new-key is required")
+	}
+
+	if flagDatadir != "" {
+		log.Info().Str("dir", flagDatadir).Msg("re-encrypting protocol database")
+		err := storagebadger.Reencrypt(flagDatadir, oldKey, newKey)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not re-encrypt protocol database")
+		}
+	}
+
+	if flagTriedir != "" {
+		// files already re-encrypted by a previous, interrupted run are
+		// encrypted with the new key
+		var from, to *wal.Encryption
+		var err error
+		switch {
+		case oldKey != nil && newKey != nil:
+			from, err = wal.NewEncryption(oldKey, newKey)
+		case oldKey != nil:
+			from, err = wal.NewEncryption(oldKey)
+		default:
+			from, err = wal.NewEncryption(newKey)
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid data encryption key")
+		}
+		if newKey != nil {
+			to, err = wal.NewEncryption(newKey)
+			if err != nil {
+				log.Fatal().Err(err).Msg("invalid data encryption key")
+			}
+		}
+
+		log.Info().Str("dir", flagTriedir).Msg("re-encrypting execution state")
+		err = wal.Reencrypt(log.Logger, flagTriedir, from, to)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not re-encrypt execution state")
+		}
+	}
+
+	log.Info().Msg("re-encryption complete, replace the node's data encryption key with the new key before starting the node")
+}
+
+func readKey(path string) []byte {
+	if path == "" {
+		return nil
+	}
+	key, err := io.ReadFile(path)
+	if err != nil {
+		log.Fatal().Err(err).Str("path", path).Msg("could not read data encryption key")
+	}
+	return key
+}
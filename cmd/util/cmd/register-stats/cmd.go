@@ -0,0 +1,99 @@
+package register_stats
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/reporters"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/utils/io"
+)
+
+var (
+	flagCheckpoint       string
+	flagEncryptionKey    string
+	flagOutputDir        string
+	flagFormat           string
+	flagLargestRegisters int
+	flagWorkerCount      int
+)
+
+// Cmd reports register-level statistics of the execution state in a checkpoint.
+var Cmd = &cobra.Command{
+	Use:   "register-stats",
+	Short: "Reports register counts and storage usage by account, domain and contract of a checkpoint",
+	Long: "Streams the registers of a checkpoint holding a single trie (such as a root checkpoint) and reports " +
+		"register counts and sizes by account and storage domain, contract code sizes, the largest registers, " +
+		"and accounts whose storage_used register doesn't match the size of their registers.",
+	Run: run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagCheckpoint, "checkpoint", "",
+		"checkpoint file to read")
+	_ = Cmd.MarkFlagRequired("checkpoint")
+
+	Cmd.Flags().StringVar(&flagEncryptionKey, "encryption-key", "",
+		"path to the data encryption key, empty if the checkpoint is not encrypted")
+
+	Cmd.Flags().StringVar(&flagOutputDir, "output-dir", "",
+		"directory to write the reports to")
+	_ = Cmd.MarkFlagRequired("output-dir")
+
+	Cmd.Flags().StringVar(&flagFormat, "format", string(reporters.ReportFormatJSON),
+		"format of the reports (json, csv)")
+
+	Cmd.Flags().IntVar(&flagLargestRegisters, "largest", 100,
+		"number of largest registers to report")
+
+	Cmd.Flags().IntVar(&flagWorkerCount, "workers", 0,
+		"number of goroutines aggregating registers, defaults to the number of CPUs")
+}
+
+func run(*cobra.Command, []string) {
+
+	format := reporters.ReportFormat(flagFormat)
+	if format != reporters.ReportFormatJSON && format != reporters.ReportFormatCSV {
+		log.Fatal().Str("format", flagFormat).Msg("unknown report format")
+	}
+
+	var encryption *wal.Encryption
+	if flagEncryptionKey != "" {
+		key, err := io.ReadFile(flagEncryptionKey)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", flagEncryptionKey).Msg("could not read data encryption key")
+		}
+		encryption, err = wal.NewEncryption(key)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid data encryption key")
+		}
+	}
+
+	err := os.MkdirAll(flagOutputDir, 0755)
+	if err != nil {
+		log.Fatal().Err(err).Str("dir", flagOutputDir).Msg("could not create output directory")
+	}
+
+	rwf := reporters.NewReportFileWriterFactoryWithFormat(flagOutputDir, format, log.Logger)
+	stats := reporters.NewRegisterStats(log.Logger, flagLargestRegisters, flagWorkerCount)
+
+	log.Info().Str("checkpoint", flagCheckpoint).Msg("reading registers")
+
+	rootHash, err := wal.ReadCheckpointPayloads(flagCheckpoint, &log.Logger, encryption, func(payload *ledger.Payload) error {
+		stats.Add(payload)
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not read checkpoint")
+	}
+
+	err = stats.Report(rwf)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not report register stats")
+	}
+
+	log.Info().Hex("root_hash", rootHash[:]).Str("output_dir", flagOutputDir).Msg("register stats reported")
+}
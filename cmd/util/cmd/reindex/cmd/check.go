@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/util/cmd/common"
+	"github.com/onflow/flow-go/storage/badger/integrity"
+)
+
+var (
+	flagRebuild bool
+)
+
+func init() {
+	rootCmd.AddCommand(checkCmd)
+
+	checkCmd.Flags().BoolVar(&flagRebuild, "rebuild", false, "rebuild derived indexes with problems")
+}
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "check the integrity of the protocol database",
+	Long: "Walks the finalized chain from the finalized block back to the root block, and checks that the height index, " +
+		"the payloads, seals and results, and the epoch statuses of the finalized blocks are consistent. " +
+		"Problems with derived indexes are reported as warnings and are fixed with --rebuild. " +
+		"Exits with an error if errors or critical problems were found.",
+	Run: func(cmd *cobra.Command, args []string) {
+		db := common.InitStorage(flagDatadir)
+		defer db.Close()
+
+		report, err := integrity.Check(log.Logger, db, flagRebuild)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not check protocol database")
+		}
+
+		for _, problem := range report.Problems {
+			level := zerolog.WarnLevel
+			if problem.Severity != integrity.SeverityWarning {
+				level = zerolog.ErrorLevel
+			}
+			log.WithLevel(level).
+				Str("severity", problem.Severity.String()).
+				Uint64("height", problem.Height).
+				Hex("block_id", problem.BlockID[:]).
+				Bool("rebuilt", problem.Rebuilt).
+				Msg(problem.Message)
+		}
+
+		errors := report.Count(integrity.SeverityError)
+		critical := report.Count(integrity.SeverityCritical)
+		log.Info().
+			Uint64("root_height", report.RootHeight).
+			Uint64("finalized_height", report.FinalizedHeight).
+			Uint64("sealed_height", report.SealedHeight).
+			Int("blocks", report.Blocks).
+			Int("problems", len(report.Problems)).
+			Int("warnings", report.Count(integrity.SeverityWarning)).
+			Int("errors", errors).
+			Int("critical", critical).
+			Msg("checked protocol database")
+
+		if errors > 0 || critical > 0 {
+			log.Fatal().Msg("protocol database is inconsistent")
+		}
+	},
+}
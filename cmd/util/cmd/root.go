@@ -9,16 +9,23 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	backup "github.com/onflow/flow-go/cmd/util/cmd/backup/cmd"
 	checkpoint_list_tries "github.com/onflow/flow-go/cmd/util/cmd/checkpoint-list-tries"
 	epochs "github.com/onflow/flow-go/cmd/util/cmd/epochs/cmd"
 	export "github.com/onflow/flow-go/cmd/util/cmd/exec-data-json-export"
 	edbs "github.com/onflow/flow-go/cmd/util/cmd/execution-data-blobstore/cmd"
 	extract "github.com/onflow/flow-go/cmd/util/cmd/execution-state-extract"
 	ledger_json_exporter "github.com/onflow/flow-go/cmd/util/cmd/export-json-execution-state"
+	hotstuff_timeline "github.com/onflow/flow-go/cmd/util/cmd/hotstuff-timeline"
+	migrate_db "github.com/onflow/flow-go/cmd/util/cmd/migrate-db"
+	"github.com/onflow/flow-go/cmd/util/cmd/preflight"
 	read_badger "github.com/onflow/flow-go/cmd/util/cmd/read-badger/cmd"
 	read_protocol_state "github.com/onflow/flow-go/cmd/util/cmd/read-protocol-state/cmd"
+	"github.com/onflow/flow-go/cmd/util/cmd/reencrypt"
+	register_stats "github.com/onflow/flow-go/cmd/util/cmd/register-stats"
 	index_er "github.com/onflow/flow-go/cmd/util/cmd/reindex/cmd"
 	truncate_database "github.com/onflow/flow-go/cmd/util/cmd/truncate-database"
+	wal "github.com/onflow/flow-go/cmd/util/cmd/wal/cmd"
 )
 
 var (
@@ -62,6 +69,13 @@ func addCommands() {
 	rootCmd.AddCommand(epochs.RootCmd)
 	rootCmd.AddCommand(edbs.RootCmd)
 	rootCmd.AddCommand(index_er.RootCmd)
+	rootCmd.AddCommand(preflight.Cmd)
+	rootCmd.AddCommand(reencrypt.Cmd)
+	rootCmd.AddCommand(backup.RootCmd)
+	rootCmd.AddCommand(register_stats.Cmd)
+	rootCmd.AddCommand(wal.RootCmd)
+	rootCmd.AddCommand(migrate_db.Cmd)
+	rootCmd.AddCommand(hotstuff_timeline.Cmd)
 }
 
 func initConfig() {
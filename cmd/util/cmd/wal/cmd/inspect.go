@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+)
+
+var flagPayloads bool
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "Print the decoded WAL records, with the root hashes of updates and deletes",
+	Run:   runInspect,
+}
+
+func init() {
+	inspectCmd.Flags().IntVar(&flagFrom, "from", -1, "first segment to inspect, -1 for the first segment of the WAL")
+	inspectCmd.Flags().IntVar(&flagTo, "to", -1, "last segment to inspect, -1 for the last segment of the WAL")
+	inspectCmd.Flags().BoolVar(&flagPayloads, "payloads", false, "print the paths and payloads of updates")
+}
+
+func runInspect(*cobra.Command, []string) {
+
+	verification, err := wal.VerifySegments(flagDir, flagFrom, flagTo, readEncryption(), func(record *wal.SegmentRecord) error {
+		prefix := fmt.Sprintf("%08d:%d", record.Segment, record.Offset)
+		if record.Encrypted {
+			prefix += " (encrypted)"
+		}
+
+		switch record.Operation {
+		case wal.WALUpdate:
+			fmt.Printf("%s update of trie with root hash (%s), %d paths\n", prefix, record.RootHash, len(record.Update.Paths))
+			if flagPayloads {
+				for i, path := range record.Update.Paths {
+					fmt.Printf("\tpath %s, %s\n", path, formatPayload(record.Update.Payloads[i]))
+				}
+			}
+		case wal.WALDelete:
+			fmt.Printf("%s remove trie with root hash (%s)\n", prefix, record.RootHash)
+		default:
+			fmt.Printf("%s unknown operation %d\n", prefix, record.Operation)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not inspect WAL segments")
+	}
+
+	if !logVerification(verification) {
+		log.Fatal().Msg("WAL verification failed")
+	}
+}
+
+func formatPayload(payload *ledger.Payload) string {
+	str := "key"
+	for _, part := range payload.Key.KeyParts {
+		str += fmt.Sprintf(" %d:%x", part.Type, part.Value)
+	}
+	return str + fmt.Sprintf(", value %d bytes", len(payload.Value))
+}
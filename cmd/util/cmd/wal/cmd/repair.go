@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+)
+
+var flagConfirm bool
+
+var repairCmd = &cobra.Command{
+	Use:   "repair",
+	Short: "Truncate the WAL right before its first corrupted record",
+	Long: "Verifies all WAL segments and discards the first corrupted record and everything after it: " +
+		"the corrupted segment is truncated and all later segments are removed. " +
+		"Back up the directory first. Without --confirm, only reports what would be discarded.",
+	Run: runRepair,
+}
+
+func init() {
+	repairCmd.Flags().BoolVar(&flagConfirm, "confirm", false, "truncate the WAL, rather than only reporting what would be discarded")
+}
+
+func runRepair(*cobra.Command, []string) {
+
+	verification, err := wal.VerifySegments(flagDir, -1, -1, readEncryption(), func(*wal.SegmentRecord) error {
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not verify WAL segments")
+	}
+
+	if logVerification(verification) {
+		log.Info().Msg("WAL is valid, nothing to repair")
+		return
+	}
+
+	if !flagConfirm {
+		log.Info().
+			Int("segment", verification.Corruption.Segment).
+			Int("removed_segments", verification.Last-verification.Corruption.Segment).
+			Msg("run with --confirm to truncate the WAL")
+		return
+	}
+
+	err = wal.RepairSegments(log.Logger, flagDir, verification)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not repair WAL")
+	}
+
+	// the repaired WAL must only contain the valid records
+	repaired, err := wal.VerifySegments(flagDir, -1, -1, readEncryption(), func(*wal.SegmentRecord) error {
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not verify repaired WAL segments")
+	}
+	if !logVerification(repaired) || repaired.Records != verification.Records {
+		log.Fatal().Int("records", repaired.Records).Msg("repaired WAL doesn't match the valid records")
+	}
+
+	log.Info().Msg("WAL repaired")
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/utils/io"
+)
+
+var (
+	flagDir           string
+	flagEncryptionKey string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Verify, repair and inspect the segments of an execution state write-ahead log (WAL)",
+	Long: "Verifies the checksums and encoding of the WAL records in the execution state directory (--dir), " +
+		"truncates the WAL right before the first corrupted record, or prints the decoded records. " +
+		"The WAL must not be in use by a running node.",
+}
+
+var RootCmd = rootCmd
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&flagDir, "dir", "", "execution state directory (where WAL segments are written)")
+	_ = rootCmd.MarkPersistentFlagRequired("dir")
+	rootCmd.PersistentFlags().StringVar(&flagEncryptionKey, "encryption-key", "", "path to the data encryption key, empty if the WAL is not encrypted")
+
+	rootCmd.AddCommand(verifyCmd)
+	rootCmd.AddCommand(repairCmd)
+	rootCmd.AddCommand(inspectCmd)
+}
+
+func readEncryption() *wal.Encryption {
+	if flagEncryptionKey == "" {
+		return nil
+	}
+	key, err := io.ReadFile(flagEncryptionKey)
+	if err != nil {
+		log.Fatal().Err(err).Str("path", flagEncryptionKey).Msg("could not read data encryption key")
+	}
+	encryption, err := wal.NewEncryption(key)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid data encryption key")
+	}
+	return encryption
+}
+
+// logVerification logs the result of verifying WAL segments, and returns
+// whether the segments are valid.
+func logVerification(verification *wal.SegmentsVerification) bool {
+	if verification.First < 0 {
+		log.Info().Msg("no WAL segments found")
+		return true
+	}
+
+	event := log.Info().
+		Int("first_segment", verification.First).
+		Int("last_segment", verification.Last).
+		Int("valid_records", verification.Records)
+	if verification.LastValid != nil {
+		event = event.
+			Int("last_valid_segment", verification.LastValid.Segment).
+			Int64("last_valid_offset", verification.LastValid.Offset)
+	}
+	event.Msg("WAL segments verified")
+
+	if verification.Corruption != nil {
+		log.Warn().
+			Err(verification.Corruption.Err).
+			Int("segment", verification.Corruption.Segment).
+			Int64("offset", verification.Corruption.Offset).
+			Msg("WAL is corrupted, records from here onwards are discarded by repair")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+)
+
+var (
+	flagFrom int
+	flagTo   int
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the checksums and encoding of all WAL records, and report the last valid record",
+	Run:   runVerify,
+}
+
+func init() {
+	verifyCmd.Flags().IntVar(&flagFrom, "from", -1, "first segment to verify, -1 for the first segment of the WAL")
+	verifyCmd.Flags().IntVar(&flagTo, "to", -1, "last segment to verify, -1 for the last segment of the WAL")
+}
+
+func runVerify(*cobra.Command, []string) {
+
+	verification, err := wal.VerifySegments(flagDir, flagFrom, flagTo, readEncryption(), func(*wal.SegmentRecord) error {
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not verify WAL segments")
+	}
+
+	if !logVerification(verification) {
+		log.Fatal().Msg("WAL verification failed")
+	}
+}
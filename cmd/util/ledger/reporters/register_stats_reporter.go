@@ -0,0 +1,472 @@
+package reporters
+
+import (
+	"container/heap"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	goRuntime "runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/migrations"
+	"github.com/onflow/flow-go/fvm/state"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const (
+	RegisterStatsOwnersReportPrefix              = "register_stats_owners"
+	RegisterStatsOwnerDomainsReportPrefix        = "register_stats_owner_domains"
+	RegisterStatsContractsReportPrefix           = "register_stats_contracts"
+	RegisterStatsLargestReportPrefix             = "register_stats_largest"
+	RegisterStatsDomainsReportPrefix             = "register_stats_domains"
+	RegisterStatsStorageUsedMismatchReportPrefix = "register_stats_storage_used_mismatches"
+)
+
+// storage domains of registers
+const (
+	DomainAccount      = "account"       // account metadata registers, such as storage_used
+	DomainContractCode = "contract_code" // contract code registers
+	DomainPublicKey    = "public_key"    // account public key registers
+	DomainSlab         = "slab"          // storage slabs of Cadence values
+	DomainOther        = "other"         // anything else
+)
+
+// cadenceDomains are the Cadence storage domains, whose registers hold the domain storage
+// maps, or (in the legacy storage format) values keyed by `<domain>\x1f<identifier>`.
+var cadenceDomains = []string{"storage", "public", "private", "contract"}
+
+// accountRegisterKeys are the keys of the account metadata registers.
+var accountRegisterKeys = map[string]struct{}{
+	state.KeyExists:         {},
+	state.KeyContractNames:  {},
+	state.KeyPublicKeyCount: {},
+	state.KeyStorageUsed:    {},
+	state.KeyAccountFrozen:  {},
+	state.KeyStorageIndex:   {},
+}
+
+// RegisterStatsReporter reports register-level statistics of the execution state:
+// register counts and sizes by owner and storage domain, contract code sizes, the
+// largest registers, and accounts whose storage_used register doesn't match the size
+// of their registers.
+type RegisterStatsReporter struct {
+	Log zerolog.Logger
+	RWF ReportWriterFactory
+	// LargestRegisters is the number of largest registers to report
+	LargestRegisters int
+	// WorkerCount is the number of goroutines aggregating registers, defaults to the number of CPUs
+	WorkerCount int
+}
+
+var _ ledger.Reporter = &RegisterStatsReporter{}
+
+func (r *RegisterStatsReporter) Name() string {
+	return "Register Stats Reporter"
+}
+
+func (r *RegisterStatsReporter) Report(payloads []ledger.Payload) error {
+	stats := NewRegisterStats(r.Log, r.LargestRegisters, r.WorkerCount)
+	for i := range payloads {
+		stats.Add(&payloads[i])
+	}
+	return stats.Report(r.RWF)
+}
+
+type registerStatsOwnerRecord struct {
+	Address       string `json:"address"`
+	RegisterCount uint64 `json:"registerCount"`
+	Size          uint64 `json:"size"`
+	StorageUsed   uint64 `json:"storageUsed"`
+	Contracts     int    `json:"contracts"`
+}
+
+type registerStatsOwnerDomainRecord struct {
+	Address       string `json:"address"`
+	Domain        string `json:"domain"`
+	RegisterCount uint64 `json:"registerCount"`
+	Size          uint64 `json:"size"`
+}
+
+type registerStatsContractRecord struct {
+	Address  string `json:"address"`
+	Contract string `json:"contract"`
+	Size     uint64 `json:"size"`
+}
+
+type registerStatsLargestRecord struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Domain  string `json:"domain"`
+	Size    uint64 `json:"size"`
+}
+
+type registerStatsDomainRecord struct {
+	Domain        string `json:"domain"`
+	RegisterCount uint64 `json:"registerCount"`
+	Size          uint64 `json:"size"`
+}
+
+type registerStatsStorageUsedMismatchRecord struct {
+	Address     string `json:"address"`
+	StorageUsed uint64 `json:"storageUsed"`
+	ActualSize  uint64 `json:"actualSize"`
+	Difference  int64  `json:"difference"`
+	Missing     bool   `json:"missing"`
+}
+
+// RegisterStats aggregates register-level statistics of registers streamed to it, in
+// memory proportional to the number of owners (and not to the number of registers).
+// Registers are aggregated by worker goroutines, each owning a shard of the owners.
+// Add must not be called concurrently, nor after Report.
+type RegisterStats struct {
+	log     zerolog.Logger
+	largest int
+	workers []*registerStatsWorker
+	wg      sync.WaitGroup
+}
+
+type registerStatsWorker struct {
+	log      zerolog.Logger
+	payloads chan *ledger.Payload
+	owners   map[string]*ownerStats
+	largest  largestRegisters
+	maxCount int
+	invalid  uint64 // registers with keys not in register ID format
+}
+
+type ownerStats struct {
+	registerCount  uint64
+	size           uint64 // sum of the register sizes, as accounted for by storage_used
+	domains        map[string]*domainStats
+	contracts      map[string]uint64
+	storageUsed    uint64
+	hasStorageUsed bool
+}
+
+type domainStats struct {
+	registerCount uint64
+	size          uint64
+}
+
+type largestRegister struct {
+	owner string
+	key   string
+	size  uint64
+}
+
+// largestRegisters is a min-heap of registers by size.
+type largestRegisters []largestRegister
+
+func (h largestRegisters) Len() int            { return len(h) }
+func (h largestRegisters) Less(i, j int) bool  { return h[i].size < h[j].size }
+func (h largestRegisters) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *largestRegisters) Push(x interface{}) { *h = append(*h, x.(largestRegister)) }
+func (h *largestRegisters) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// NewRegisterStats starts `workerCount` workers (the number of CPUs if 0) aggregating
+// registers, keeping track of the `largest` largest registers.
+func NewRegisterStats(log zerolog.Logger, largest int, workerCount int) *RegisterStats {
+	if workerCount <= 0 {
+		workerCount = goRuntime.NumCPU()
+	}
+
+	s := &RegisterStats{
+		log:     log,
+		largest: largest,
+		workers: make([]*registerStatsWorker, workerCount),
+	}
+	for i := range s.workers {
+		w := &registerStatsWorker{
+			log:      log,
+			payloads: make(chan *ledger.Payload, 1000),
+			owners:   make(map[string]*ownerStats),
+			maxCount: largest,
+		}
+		s.workers[i] = w
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for p := range w.payloads {
+				w.add(p)
+			}
+		}()
+	}
+	return s
+}
+
+// Add aggregates a register. The payload must not be modified afterwards.
+func (s *RegisterStats) Add(payload *ledger.Payload) {
+	// all registers of an owner are aggregated by the same worker
+	shard := 0
+	if len(payload.Key.KeyParts) > 0 {
+		h := fnv.New32a()
+		_, _ = h.Write(payload.Key.KeyParts[0].Value)
+		shard = int(h.Sum32() % uint32(len(s.workers)))
+	}
+	s.workers[shard].payloads <- payload
+}
+
+func (w *registerStatsWorker) add(payload *ledger.Payload) {
+	if payload.IsEmpty() {
+		return
+	}
+
+	id, err := migrations.KeyToRegisterID(payload.Key)
+	if err != nil {
+		w.invalid++
+		return
+	}
+
+	size := uint64(registerSize(id, payload.Value))
+	domain := registerDomain(id.Key)
+
+	owner, ok := w.owners[id.Owner]
+	if !ok {
+		owner = &ownerStats{
+			domains: make(map[string]*domainStats),
+		}
+		w.owners[id.Owner] = owner
+	}
+	owner.registerCount++
+	owner.size += size
+
+	d, ok := owner.domains[domain]
+	if !ok {
+		d = &domainStats{}
+		owner.domains[domain] = d
+	}
+	d.registerCount++
+	d.size += size
+
+	if strings.HasPrefix(id.Key, state.KeyCode+".") {
+		if owner.contracts == nil {
+			owner.contracts = make(map[string]uint64)
+		}
+		owner.contracts[strings.TrimPrefix(id.Key, state.KeyCode+".")] = size
+	}
+
+	if id.Key == state.KeyStorageUsed {
+		storageUsed, _, err := utils.ReadUint64(payload.Value)
+		if err != nil {
+			w.log.Warn().Err(err).Str("address", ownerString(id.Owner)).Msg("cannot decode storage used")
+		} else {
+			owner.storageUsed = storageUsed
+			owner.hasStorageUsed = true
+		}
+	}
+
+	if w.maxCount > 0 {
+		if len(w.largest) < w.maxCount {
+			heap.Push(&w.largest, largestRegister{owner: id.Owner, key: id.Key, size: size})
+		} else if w.largest[0].size < size {
+			w.largest[0] = largestRegister{owner: id.Owner, key: id.Key, size: size}
+			heap.Fix(&w.largest, 0)
+		}
+	}
+}
+
+// Report waits for all added registers to be aggregated, and writes the reports.
+func (s *RegisterStats) Report(rwf ReportWriterFactory) error {
+	for _, w := range s.workers {
+		close(w.payloads)
+	}
+	s.wg.Wait()
+
+	rwo := rwf.ReportWriter(RegisterStatsOwnersReportPrefix)
+	rwd := rwf.ReportWriter(RegisterStatsOwnerDomainsReportPrefix)
+	rwc := rwf.ReportWriter(RegisterStatsContractsReportPrefix)
+	rwl := rwf.ReportWriter(RegisterStatsLargestReportPrefix)
+	rwt := rwf.ReportWriter(RegisterStatsDomainsReportPrefix)
+	rwm := rwf.ReportWriter(RegisterStatsStorageUsedMismatchReportPrefix)
+	defer rwo.Close()
+	defer rwd.Close()
+	defer rwc.Close()
+	defer rwl.Close()
+	defer rwt.Close()
+	defer rwm.Close()
+
+	owners := make(map[string]*ownerStats)
+	var largest []largestRegister
+	var invalid uint64
+	for _, w := range s.workers {
+		// owners are sharded, so they're unique across workers
+		for owner, stats := range w.owners {
+			owners[owner] = stats
+		}
+		largest = append(largest, w.largest...)
+		invalid += w.invalid
+	}
+
+	addresses := make([]string, 0, len(owners))
+	for owner := range owners {
+		addresses = append(addresses, owner)
+	}
+	sort.Strings(addresses)
+
+	totals := make(map[string]*domainStats)
+	var registerCount, totalSize uint64
+	mismatches := 0
+	for _, owner := range addresses {
+		stats := owners[owner]
+		address := ownerString(owner)
+		registerCount += stats.registerCount
+		totalSize += stats.size
+
+		rwo.Write(registerStatsOwnerRecord{
+			Address:       address,
+			RegisterCount: stats.registerCount,
+			Size:          stats.size,
+			StorageUsed:   stats.storageUsed,
+			Contracts:     len(stats.contracts),
+		})
+
+		for _, domain := range sortedKeys(stats.domains) {
+			d := stats.domains[domain]
+			rwd.Write(registerStatsOwnerDomainRecord{
+				Address:       address,
+				Domain:        domain,
+				RegisterCount: d.registerCount,
+				Size:          d.size,
+			})
+
+			total, ok := totals[domain]
+			if !ok {
+				total = &domainStats{}
+				totals[domain] = total
+			}
+			total.registerCount += d.registerCount
+			total.size += d.size
+		}
+
+		contracts := make([]string, 0, len(stats.contracts))
+		for name := range stats.contracts {
+			contracts = append(contracts, name)
+		}
+		sort.Strings(contracts)
+		for _, name := range contracts {
+			rwc.Write(registerStatsContractRecord{
+				Address:  address,
+				Contract: name,
+				Size:     stats.contracts[name],
+			})
+		}
+
+		// only accounts are expected to track their storage used
+		if len(owner) != flow.AddressLength {
+			continue
+		}
+		if !stats.hasStorageUsed || stats.storageUsed != stats.size {
+			mismatches++
+			rwm.Write(registerStatsStorageUsedMismatchRecord{
+				Address:     address,
+				StorageUsed: stats.storageUsed,
+				ActualSize:  stats.size,
+				Difference:  int64(stats.storageUsed) - int64(stats.size),
+				Missing:     !stats.hasStorageUsed,
+			})
+		}
+	}
+
+	for _, domain := range sortedKeys(totals) {
+		rwt.Write(registerStatsDomainRecord{
+			Domain:        domain,
+			RegisterCount: totals[domain].registerCount,
+			Size:          totals[domain].size,
+		})
+	}
+
+	sort.Slice(largest, func(i, j int) bool {
+		return largest[i].size > largest[j].size
+	})
+	if len(largest) > s.largest {
+		largest = largest[:s.largest]
+	}
+	for _, r := range largest {
+		rwl.Write(registerStatsLargestRecord{
+			Address: ownerString(r.owner),
+			Key:     keyString(r.key),
+			Domain:  registerDomain(r.key),
+			Size:    r.size,
+		})
+	}
+
+	s.log.Info().
+		Int("owners", len(owners)).
+		Uint64("registers", registerCount).
+		Uint64("size", totalSize).
+		Uint64("invalid_registers", invalid).
+		Int("storage_used_mismatches", mismatches).
+		Msg("register stats reported")
+
+	if invalid > 0 {
+		return fmt.Errorf("found %d registers with keys not in register ID format", invalid)
+	}
+	return nil
+}
+
+// registerSize returns the size of the register, as accounted for by storage_used for accounts.
+func registerSize(id flow.RegisterID, value flow.RegisterValue) int {
+	if len(id.Owner) == flow.AddressLength {
+		return state.RegisterSize(flow.BytesToAddress([]byte(id.Owner)), len(id.Controller) > 0, id.Key, value)
+	}
+	return len(id.Owner) + len(id.Controller) + len(id.Key) + len(value)
+}
+
+// registerDomain returns the storage domain of the register with the given key.
+func registerDomain(key string) string {
+	if _, ok := accountRegisterKeys[key]; ok {
+		return DomainAccount
+	}
+	switch {
+	case strings.HasPrefix(key, "$"):
+		return DomainSlab
+	case key == state.KeyCode || strings.HasPrefix(key, state.KeyCode+"."):
+		return DomainContractCode
+	case strings.HasPrefix(key, "public_key_"):
+		return DomainPublicKey
+	}
+	for _, domain := range cadenceDomains {
+		if key == domain || strings.HasPrefix(key, domain+"\x1f") {
+			return domain
+		}
+	}
+	return DomainOther
+}
+
+// ownerString returns the address of an account, or the hex-encoded owner of other registers.
+func ownerString(owner string) string {
+	if len(owner) == flow.AddressLength {
+		return flow.BytesToAddress([]byte(owner)).Hex()
+	}
+	return hex.EncodeToString([]byte(owner))
+}
+
+// keyString returns a printable register key: slab keys hold the binary slab index.
+func keyString(key string) string {
+	if strings.HasPrefix(key, "$") {
+		return "$" + hex.EncodeToString([]byte(key[1:]))
+	}
+	return key
+}
+
+func sortedKeys(m map[string]*domainStats) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,159 @@
+package reporters_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/migrations"
+	"github.com/onflow/flow-go/cmd/util/ledger/reporters"
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/programs"
+	"github.com/onflow/flow-go/fvm/state"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestRegisterStatsReporter(t *testing.T) {
+	a := flow.HexToAddress("01")
+	b := flow.HexToAddress("02")
+
+	view := migrations.NewView(nil)
+	set := func(address flow.Address, key string, value []byte) {
+		require.NoError(t, view.Set(string(address.Bytes()), "", key, value))
+	}
+	set(a, state.KeyExists, []byte{1})
+	set(a, "code.Foo", make([]byte, 100))
+	set(a, "$\x00\x00\x00\x00\x00\x00\x00\x01", make([]byte, 1000))
+	set(a, "storage", make([]byte, 10))
+	// a's storage used is correct
+	size := uint64(0)
+	for _, key := range []string{state.KeyExists, "code.Foo", "$\x00\x00\x00\x00\x00\x00\x00\x01", "storage", state.KeyStorageUsed} {
+		value, err := view.Get(string(a.Bytes()), "", key)
+		require.NoError(t, err)
+		if key == state.KeyStorageUsed {
+			value = make([]byte, 8)
+		}
+		size += uint64(state.RegisterSize(a, false, key, value))
+	}
+	set(a, state.KeyStorageUsed, utils.Uint64ToBinary(size))
+	// b has no storage used
+	set(b, state.KeyExists, []byte{1})
+	set(b, "public_key_0", make([]byte, 50))
+
+	dir := t.TempDir()
+	log := zerolog.Nop()
+	rwf := reporters.NewReportFileWriterFactory(dir, log)
+
+	reporter := &reporters.RegisterStatsReporter{
+		Log:              log,
+		RWF:              rwf,
+		LargestRegisters: 2,
+		WorkerCount:      2,
+	}
+	err := reporter.Report(view.Payloads())
+	require.NoError(t, err)
+
+	var owners []map[string]interface{}
+	readReport(t, rwf.Filename(reporters.RegisterStatsOwnersReportPrefix), &owners)
+	require.Len(t, owners, 2)
+	require.Equal(t, a.Hex(), owners[0]["address"])
+	require.Equal(t, float64(5), owners[0]["registerCount"])
+	require.Equal(t, float64(size), owners[0]["size"])
+	require.Equal(t, float64(size), owners[0]["storageUsed"])
+	require.Equal(t, float64(1), owners[0]["contracts"])
+	require.Equal(t, b.Hex(), owners[1]["address"])
+	require.Equal(t, float64(2), owners[1]["registerCount"])
+
+	var domains []map[string]interface{}
+	readReport(t, rwf.Filename(reporters.RegisterStatsDomainsReportPrefix), &domains)
+	counts := make(map[string]float64)
+	for _, domain := range domains {
+		counts[domain["domain"].(string)] = domain["registerCount"].(float64)
+	}
+	require.Equal(t, map[string]float64{
+		reporters.DomainAccount:      3,
+		reporters.DomainContractCode: 1,
+		reporters.DomainPublicKey:    1,
+		reporters.DomainSlab:         1,
+		"storage":                    1,
+	}, counts)
+
+	var contracts []map[string]interface{}
+	readReport(t, rwf.Filename(reporters.RegisterStatsContractsReportPrefix), &contracts)
+	require.Len(t, contracts, 1)
+	require.Equal(t, "Foo", contracts[0]["contract"])
+
+	var largest []map[string]interface{}
+	readReport(t, rwf.Filename(reporters.RegisterStatsLargestReportPrefix), &largest)
+	require.Len(t, largest, 2)
+	require.Equal(t, "$0000000000000001", largest[0]["key"])
+	require.Equal(t, "code.Foo", largest[1]["key"])
+
+	var mismatches []map[string]interface{}
+	readReport(t, rwf.Filename(reporters.RegisterStatsStorageUsedMismatchReportPrefix), &mismatches)
+	require.Len(t, mismatches, 1)
+	require.Equal(t, b.Hex(), mismatches[0]["address"])
+	require.Equal(t, true, mismatches[0]["missing"])
+}
+
+func TestRegisterStatsReporter_Bootstrapped(t *testing.T) {
+	payloads := []ledger.Payload{}
+	chain := flow.Testnet.Chain()
+	view := migrations.NewView(payloads)
+
+	rt := fvm.NewInterpreterRuntime()
+	vm := fvm.NewVirtualMachine(rt)
+	ctx := fvm.NewContext(zerolog.Nop(), fvm.WithChain(chain))
+	bootstrapOptions := []fvm.BootstrapProcedureOption{
+		fvm.WithTransactionFee(fvm.DefaultTransactionFees),
+		fvm.WithAccountCreationFee(fvm.DefaultAccountCreationFee),
+		fvm.WithMinimumStorageReservation(fvm.DefaultMinimumStorageReservation),
+		fvm.WithStorageMBPerFLOW(fvm.DefaultStorageMBPerFLOW),
+		fvm.WithInitialTokenSupply(unittest.GenesisTokenSupply),
+	}
+	err := vm.Run(ctx, fvm.Bootstrap(unittest.ServiceAccountPublicKey, bootstrapOptions...), view, programs.NewEmptyPrograms())
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	log := zerolog.Nop()
+	rwf := reporters.NewReportFileWriterFactoryWithFormat(dir, reporters.ReportFormatCSV, log)
+
+	stats := reporters.NewRegisterStats(log, 10, 0)
+	payloads = view.Payloads()
+	for i := range payloads {
+		stats.Add(&payloads[i])
+	}
+	err = stats.Report(rwf)
+	require.NoError(t, err)
+
+	// the storage used of all bootstrapped accounts is correct
+	mismatches := readCSVReport(t, rwf.Filename(reporters.RegisterStatsStorageUsedMismatchReportPrefix))
+	require.Empty(t, mismatches)
+
+	largest := readCSVReport(t, rwf.Filename(reporters.RegisterStatsLargestReportPrefix))
+	require.Len(t, largest, 11)
+	require.Equal(t, []string{"address", "key", "domain", "size"}, largest[0])
+}
+
+func readReport(t *testing.T, filename string, records interface{}) {
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, records))
+}
+
+func readCSVReport(t *testing.T, filename string) [][]string {
+	f, err := os.Open(filename)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	return rows
+}
@@ -2,10 +2,13 @@ package reporters
 
 import (
 	"bufio"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path"
+	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,27 +20,46 @@ type ReportWriterFactory interface {
 	ReportWriter(dataNamespace string) ReportWriter
 }
 
+// ReportFormat is the file format of the reports.
+type ReportFormat string
+
+const (
+	// ReportFormatJSON writes reports as a json array of records
+	ReportFormatJSON ReportFormat = "json"
+	// ReportFormatCSV writes reports as csv, with a header row of the json field names of the records
+	ReportFormatCSV ReportFormat = "csv"
+)
+
 type ReportFileWriterFactory struct {
 	fileSuffix int32
 	outputDir  string
+	format     ReportFormat
 	log        zerolog.Logger
 }
 
 func NewReportFileWriterFactory(outputDir string, log zerolog.Logger) *ReportFileWriterFactory {
+	return NewReportFileWriterFactoryWithFormat(outputDir, ReportFormatJSON, log)
+}
+
+func NewReportFileWriterFactoryWithFormat(outputDir string, format ReportFormat, log zerolog.Logger) *ReportFileWriterFactory {
 	return &ReportFileWriterFactory{
 		fileSuffix: int32(time.Now().Unix()),
 		outputDir:  outputDir,
+		format:     format,
 		log:        log,
 	}
 }
 
 func (r *ReportFileWriterFactory) Filename(dataNamespace string) string {
-	return path.Join(r.outputDir, fmt.Sprintf("%s_%d.json", dataNamespace, r.fileSuffix))
+	return path.Join(r.outputDir, fmt.Sprintf("%s_%d.%s", dataNamespace, r.fileSuffix, r.format))
 }
 
 func (r *ReportFileWriterFactory) ReportWriter(dataNamespace string) ReportWriter {
 	fn := r.Filename(dataNamespace)
 
+	if r.format == ReportFormatCSV {
+		return NewReportCSVFileWriter(fn, r.log)
+	}
 	return NewReportFileWriter(fn, r.log)
 }
 
@@ -182,3 +204,137 @@ func (r *ReportFileWriter) Close() {
 
 	r.log.Info().Str("filename", r.fileName).Msg("Created report file")
 }
+
+var _ ReportWriter = &ReportCSVFileWriter{}
+
+// ReportCSVFileWriter writes records (structs, or pointers to structs) as csv rows.
+// The header row holds the json field names of the first record, and all records
+// are expected to be of the same type.
+type ReportCSVFileWriter struct {
+	f         *os.File
+	fileName  string
+	wg        *sync.WaitGroup
+	writeChan chan interface{}
+	writer    *csv.Writer
+	log       zerolog.Logger
+	faulty    bool
+	header    bool
+}
+
+func NewReportCSVFileWriter(fileName string, log zerolog.Logger) ReportWriter {
+	f, err := os.Create(fileName)
+	if err != nil {
+		log.Warn().Err(err).Msg("Error creating ReportCSVFileWriter, defaulting to ReportNilWriter")
+		return ReportNilWriter{}
+	}
+
+	fw := &ReportCSVFileWriter{
+		f:         f,
+		fileName:  fileName,
+		writer:    csv.NewWriter(f),
+		log:       log,
+		writeChan: make(chan interface{}, reportFileWriteBufferSize),
+		wg:        &sync.WaitGroup{},
+	}
+
+	fw.wg.Add(1)
+	go func() {
+
+		for d := range fw.writeChan {
+			fw.write(d)
+		}
+		fw.wg.Done()
+	}()
+
+	return fw
+}
+
+func (r *ReportCSVFileWriter) Write(dataPoint interface{}) {
+	r.writeChan <- dataPoint
+}
+
+func (r *ReportCSVFileWriter) write(dataPoint interface{}) {
+	if r.faulty {
+		return
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(dataPoint))
+	if v.Kind() != reflect.Struct {
+		r.log.Warn().Str("type", v.Kind().String()).Msg("Error converting data point to csv, not a struct")
+		r.faulty = true
+		return
+	}
+
+	if !r.header {
+		r.header = true
+		err := r.writer.Write(csvHeader(v.Type()))
+		if err != nil {
+			r.log.Warn().Err(err).Msg("Error Writing csv to file")
+			r.faulty = true
+			return
+		}
+	}
+
+	row := make([]string, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		if csvFieldName(v.Type().Field(i)) == "" {
+			continue
+		}
+		row = append(row, fmt.Sprint(v.Field(i).Interface()))
+	}
+	err := r.writer.Write(row)
+	if err != nil {
+		r.log.Warn().Err(err).Msg("Error Writing csv to file")
+		r.faulty = true
+	}
+}
+
+func (r *ReportCSVFileWriter) Close() {
+	close(r.writeChan)
+	r.wg.Wait()
+
+	r.writer.Flush()
+	err := r.writer.Error()
+	if err != nil {
+		r.log.Error().Err(err).Msg("Error closing flushing writer")
+		panic(err)
+	}
+
+	err = r.f.Close()
+	if err != nil {
+		r.log.Error().Err(err).Msg("Error closing report file")
+		panic(err)
+	}
+
+	r.log.Info().Str("filename", r.fileName).Msg("Created report file")
+}
+
+// csvHeader returns the column names of a record type.
+func csvHeader(t reflect.Type) []string {
+	header := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := csvFieldName(t.Field(i))
+		if name == "" {
+			continue
+		}
+		header = append(header, name)
+	}
+	return header
+}
+
+// csvFieldName returns the json name of an exported struct field, or an empty string
+// if the field is not written.
+func csvFieldName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		// unexported
+		return ""
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
@@ -74,3 +74,39 @@ func TestReportFileWriter(t *testing.T) {
 			"[{\"TestField\":\"something\"},{\"TestField\":\"something\"},{\"TestField\":\"something\"}]")
 	})
 }
+
+func TestReportCSVFileWriter(t *testing.T) {
+	dir := t.TempDir()
+
+	filename := path.Join(dir, "test.csv")
+	log := zerolog.Logger{}
+
+	requireFileContains := func(t *testing.T, expected string) {
+		dat, err := ioutil.ReadFile(filename)
+		require.NoError(t, err)
+
+		require.Equal(t, []byte(expected), dat)
+	}
+
+	type testData struct {
+		TestField string `json:"testField"`
+		Count     int
+		ignored   bool
+	}
+
+	t.Run("Open & Close - empty file", func(t *testing.T) {
+		rw := reporters.NewReportCSVFileWriter(filename, log)
+		rw.Close()
+
+		requireFileContains(t, "")
+	})
+	t.Run("Open & Write Many & Close - header and rows", func(t *testing.T) {
+		rw := reporters.NewReportCSVFileWriter(filename, log)
+		rw.Write(testData{TestField: "something0", Count: 0})
+		rw.Write(&testData{TestField: "something, else", Count: 1})
+
+		rw.Close()
+
+		requireFileContains(t, "testField,Count\nsomething0,0\n\"something, else\",1\n")
+	})
+}
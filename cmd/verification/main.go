@@ -52,8 +52,12 @@ func main() {
 		backoffMultiplier  float64       // base of exponent in exponential backoff multiplier for backing off requests for chunk data packs.
 		requestTargets     uint64        // maximum number of execution nodes a chunk data pack request is dispatched to.
 
-		blockWorkers uint64 // number of blocks processed in parallel.
-		chunkWorkers uint64 // number of chunks processed in parallel.
+		blockWorkers    uint64 // number of blocks processed in parallel.
+		chunkWorkers    uint64 // minimum number of chunks processed in parallel.
+		maxChunkWorkers uint64 // maximum number of chunks processed in parallel when chunks are piling up.
+
+		verifierWorkers  uint   // number of chunks verified in parallel.
+		verifierMaxBytes uint64 // maximum total size of chunk data packs verified in parallel.
 
 		chunkStatuses        *stdmap.ChunkStatuses     // used in fetcher engine
 		chunkRequests        *stdmap.ChunkRequests     // used in requester engine
@@ -87,7 +91,10 @@ func main() {
 		flags.Float64Var(&backoffMultiplier, "backoff-multiplier", vereq.DefaultBackoffMultiplier, "base of exponent in exponential backoff requesting mechanism")
 		flags.Uint64Var(&requestTargets, "request-targets", vereq.DefaultRequestTargets, "maximum number of execution nodes a chunk data pack request is dispatched to")
 		flags.Uint64Var(&blockWorkers, "block-workers", blockconsumer.DefaultBlockWorkers, "maximum number of blocks being processed in parallel")
-		flags.Uint64Var(&chunkWorkers, "chunk-workers", chunkconsumer.DefaultChunkWorkers, "maximum number of execution nodes a chunk data pack request is dispatched to")
+		flags.Uint64Var(&chunkWorkers, "chunk-workers", chunkconsumer.DefaultChunkWorkers, "minimum number of chunks being processed in parallel")
+		flags.Uint64Var(&maxChunkWorkers, "max-chunk-workers", chunkconsumer.DefaultMaxChunkWorkers, "maximum number of chunks being processed in parallel when chunks are piling up, set to chunk-workers for a fixed number of workers")
+		flags.UintVar(&verifierWorkers, "verifier-workers", verifier.DefaultMaxWorkers, "maximum number of chunks being verified in parallel")
+		flags.Uint64Var(&verifierMaxBytes, "verifier-max-in-flight-bytes", verifier.DefaultMaxInFlightBytes, "maximum total size of the chunk data packs of chunks being verified in parallel, 0 for no limit")
 
 	})
 
@@ -186,7 +193,9 @@ func main() {
 				node.State,
 				node.Me,
 				chunkVerifier,
-				approvalStorage)
+				approvalStorage,
+				verifierWorkers,
+				verifierMaxBytes)
 			return verifierEng, err
 		}).
 		Component("chunk consumer, requester, and fetcher engines", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
@@ -222,7 +231,8 @@ func main() {
 				processedChunkIndex,
 				chunkQueue,
 				fetcherEngine,
-				chunkWorkers)
+				chunkWorkers,
+				maxChunkWorkers)
 
 			err = node.Metrics.Mempool.Register(metrics.ResourceChunkConsumer, chunkConsumer.Size)
 			if err != nil {
@@ -281,7 +291,7 @@ func main() {
 		Component("follower engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 
 			// initialize cleaner for DB
-			cleaner := storage.NewCleaner(node.Logger, node.DB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
+			cleaner := storage.NewCleaner(node.Logger, node.BadgerDB, node.Metrics.CleanCollector, flow.DefaultValueLogGCFrequency)
 
 			// create a finalizer that handles updating the protocol
 			// state when the follower detects newly finalized blocks
@@ -16,19 +16,21 @@ type HotstuffModules struct {
 	Persist                 hotstuff.Persister              // last state of consensus participant
 	FinalizationDistributor *pubsub.FinalizationDistributor // observer for finalization events, used by compliance engine
 	QCCreatedDistributor    *pubsub.QCCreatedDistributor    // observer for qc created event, used by leader
+	TCCreatedDistributor    *pubsub.TCCreatedDistributor    // observer for tc created event, used by all replicas
 	Forks                   hotstuff.Forks                  // information about multiple forks
 	Validator               hotstuff.Validator              // validator of proposals & votes
 	Aggregator              hotstuff.VoteAggregator         // aggregator of votes, used by leader
 }
 
 type ParticipantConfig struct {
-	StartupTime                time.Time     // the time when consensus participant enters first view
-	TimeoutInitial             time.Duration // the initial timeout for the pacemaker
-	TimeoutMinimum             time.Duration // the minimum timeout for the pacemaker
-	TimeoutAggregationFraction float64       // the percentage part of the timeout period reserved for vote aggregation
-	TimeoutIncreaseFactor      float64       // the factor at which the timeout grows when timeouts occur
-	TimeoutDecreaseFactor      float64       // the factor at which the timeout grows when timeouts occur
-	BlockRateDelay             time.Duration // a delay to broadcast block proposal in order to control the block production rate
+	StartupTime                time.Time               // the time when consensus participant enters first view
+	TimeoutInitial             time.Duration           // the initial timeout for the pacemaker
+	TimeoutMinimum             time.Duration           // the minimum timeout for the pacemaker
+	TimeoutAggregationFraction float64                 // the percentage part of the timeout period reserved for vote aggregation
+	TimeoutIncreaseFactor      float64                 // the factor at which the timeout grows when timeouts occur
+	TimeoutDecreaseFactor      float64                 // the factor at which the timeout grows when timeouts occur
+	BlockRateDelay             time.Duration           // a delay to broadcast block proposal in order to control the block production rate
+	FlightRecorder             hotstuff.FlightRecorder // the recorder of consensus events, discards all events by default
 }
 
 type Option func(*ParticipantConfig)
@@ -74,3 +76,9 @@ func WithBlockRateDelay(delay time.Duration) Option {
 		cfg.BlockRateDelay = delay
 	}
 }
+
+func WithFlightRecorder(recorder hotstuff.FlightRecorder) Option {
+	return func(cfg *ParticipantConfig) {
+		cfg.FlightRecorder = recorder
+	}
+}
@@ -74,8 +74,15 @@ func (s *HotStuffFollowerSuite) SetupTest() {
 		},
 		nil,
 	)
+	s.committee.On("IdentitiesByEpoch", mock.Anything, mock.Anything).Return(
+		func(view uint64, selector flow.IdentityFilter) flow.IdentityList {
+			return identities.Filter(selector)
+		},
+		nil,
+	)
 	for _, identity := range identities {
 		s.committee.On("Identity", mock.Anything, identity.NodeID).Return(identity, nil)
+		s.committee.On("IdentityByEpoch", mock.Anything, identity.NodeID).Return(identity, nil)
 	}
 	s.committee.On("LeaderForView", mock.Anything).Return(
 		func(view uint64) flow.Identifier { return identities[int(view)%len(identities)].NodeID },
@@ -91,7 +98,8 @@ func (s *HotStuffFollowerSuite) SetupTest() {
 	// mock finalization updater
 	s.verifier = &mockhotstuff.Verifier{}
 	s.verifier.On("VerifyVote", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-	s.verifier.On("VerifyQC", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.verifier.On("VerifyQC", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.verifier.On("VerifyTC", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	// mock consumer for finalization notifications
 	s.notifier = &mockhotstuff.FinalizationConsumer{}
@@ -336,5 +344,22 @@ func (mc *MockConsensus) extendBlock(blockView uint64, parent *flow.Header) *flo
 	nextBlock.View = blockView
 	nextBlock.ProposerID = mc.identities[int(blockView)%len(mc.identities)].NodeID
 	nextBlock.ParentVoterIDs = mc.identities.NodeIDs()
+	// a block skipping views must include the TC for the previous view
+	if blockView > parent.View+1 {
+		newestQCViews := make([]uint64, len(mc.identities))
+		for i := range newestQCViews {
+			newestQCViews[i] = parent.View
+		}
+		nextBlock.LastViewTC = &flow.TimeoutCertificate{
+			View:          blockView - 1,
+			NewestQCViews: newestQCViews,
+			NewestQC: &flow.QuorumCertificate{
+				View:      parent.View,
+				BlockID:   parent.ID(),
+				SignerIDs: mc.identities.NodeIDs(),
+			},
+			SignerIDs: mc.identities.NodeIDs(),
+		}
+	}
 	return &nextBlock
 }
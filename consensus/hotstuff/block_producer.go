@@ -9,7 +9,8 @@ import (
 // and uses VoteCollectorFactory to create a disposable VoteCollector for producing the proposal vote.
 // BlockProducer assembles the new block proposal using the block payload, block header and the proposal vote.
 type BlockProducer interface {
-	// MakeBlockProposal builds a new HotStuff block proposal using the given view and
-	// the given quorum certificate for its parent.
-	MakeBlockProposal(qc *flow.QuorumCertificate, view uint64) (*model.Proposal, error)
+	// MakeBlockProposal builds a new HotStuff block proposal using the given view,
+	// the given quorum certificate for its parent and [optionally] a timeout certificate for last view(could be nil).
+	// The TC must be provided if and only if the view was entered through a TC, i.e. qc.View+1 != view.
+	MakeBlockProposal(qc *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate, view uint64) (*model.Proposal, error)
 }
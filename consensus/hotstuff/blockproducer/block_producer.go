@@ -28,7 +28,8 @@ func New(signer hotstuff.Signer, committee hotstuff.Committee, builder module.Bu
 }
 
 // MakeBlockProposal will build a proposal for the given view with the given QC
-func (bp *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, view uint64) (*model.Proposal, error) {
+// and the TC for the last view, which is nil if the QC is for the last view.
+func (bp *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate, view uint64) (*model.Proposal, error) {
 	// the custom functions allows us to set some custom fields on the block;
 	// in hotstuff, we use this for view number and signature-related fields
 	setHotstuffFields := func(header *flow.Header) error {
@@ -36,6 +37,7 @@ func (bp *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, view uint
 		header.ParentVoterIDs = qc.SignerIDs
 		header.ParentVoterSigData = qc.SigData
 		header.ProposerID = bp.committee.Self()
+		header.LastViewTC = lastViewTC
 
 		// turn the header into a block header proposal as known by hotstuff
 		block := model.Block{
@@ -43,6 +45,7 @@ func (bp *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, view uint
 			View:        view,
 			ProposerID:  header.ProposerID,
 			QC:          qc,
+			LastViewTC:  lastViewTC,
 			PayloadHash: header.PayloadHash,
 			Timestamp:   header.Timestamp,
 		}
@@ -32,6 +32,22 @@ type Committee interface {
 	//  * model.InvalidSignerError if participantID does NOT correspond to an authorized HotStuff participant at the specified block.
 	Identity(blockID flow.Identifier, participantID flow.Identifier) (*flow.Identity, error)
 
+	// IdentitiesByEpoch returns a IdentityList with legitimate HotStuff participants of the epoch
+	// containing the given view. It is used for certificates and messages which are not tied to
+	// a block, e.g. timeouts and TCs, or QCs whose block might be unknown. The list of participants
+	// is filtered by the provided selector and has the same properties as the list returned by Identities.
+	// ERROR conditions:
+	//  * model.ErrViewForUnknownEpoch if no epoch containing the given view is known
+	IdentitiesByEpoch(view uint64, selector flow.IdentityFilter) (flow.IdentityList, error)
+
+	// IdentityByEpoch returns the full Identity for specified HotStuff participant.
+	// The node must be a legitimate HotStuff participant with NON-ZERO WEIGHT in the epoch
+	// containing the given view.
+	// ERROR conditions:
+	//  * model.InvalidSignerError if participantID does NOT correspond to an authorized HotStuff participant in the epoch.
+	//  * model.ErrViewForUnknownEpoch if no epoch containing the given view is known
+	IdentityByEpoch(view uint64, participantID flow.Identifier) (*flow.Identity, error)
+
 	// LeaderForView returns the identity of the leader for a given view.
 	// CAUTION: per liveness requirement of HotStuff, the leader must be fork-independent.
 	//          Therefore, a node retains its proposer view slots even if it is slashed.
@@ -49,6 +65,11 @@ type Committee interface {
 
 	// DKG returns the DKG info for the given block.
 	DKG(blockID flow.Identifier) (DKG, error)
+
+	// DKGByEpoch returns the DKG info of the epoch containing the given view.
+	// ERROR conditions:
+	//  * model.ErrViewForUnknownEpoch if no epoch containing the given view is known
+	DKGByEpoch(view uint64) (DKG, error)
 }
 
 type DKG interface {
@@ -119,6 +119,35 @@ func (c *Cluster) Identity(blockID flow.Identifier, nodeID flow.Identifier) (*fl
 	return identity, nil
 }
 
+// IdentitiesByEpoch returns the initial members of the cluster, as cluster
+// committees are epoch-scoped. Returns model.ErrViewForUnknownEpoch if the view
+// is outside the cluster's lifecycle.
+func (c *Cluster) IdentitiesByEpoch(view uint64, selector flow.IdentityFilter) (flow.IdentityList, error) {
+	_, err := c.selection.LeaderForView(view)
+	if leader.IsInvalidViewError(err) {
+		return nil, fmt.Errorf("view %d is outside the cluster's lifecycle: %w", view, model.ErrViewForUnknownEpoch)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not check view %d against cluster's lifecycle: %w", view, err)
+	}
+	return c.initialClusterMembers.Filter(selector), nil
+}
+
+// IdentityByEpoch returns the identity of the given initial member of the
+// cluster. Returns
+//  * model.InvalidSignerError if the node is not a member of the cluster
+//  * model.ErrViewForUnknownEpoch if the view is outside the cluster's lifecycle
+func (c *Cluster) IdentityByEpoch(view uint64, nodeID flow.Identifier) (*flow.Identity, error) {
+	identities, err := c.IdentitiesByEpoch(view, filter.HasNodeID(nodeID))
+	if err != nil {
+		return nil, err
+	}
+	if len(identities) == 0 {
+		return nil, model.NewInvalidSignerErrorf("node %v is not an authorized hotstuff cluster member", nodeID)
+	}
+	return identities[0], nil
+}
+
 func (c *Cluster) LeaderForView(view uint64) (flow.Identifier, error) {
 	return c.selection.LeaderForView(view)
 }
@@ -130,3 +159,7 @@ func (c *Cluster) Self() flow.Identifier {
 func (c *Cluster) DKG(_ flow.Identifier) (hotstuff.DKG, error) {
 	panic("queried DKG of cluster committee")
 }
+
+func (c *Cluster) DKGByEpoch(_ uint64) (hotstuff.DKG, error) {
+	panic("queried DKG of cluster committee")
+}
@@ -23,6 +23,11 @@ type Consensus struct {
 	state   protocol.State                     // the protocol state
 	me      flow.Identifier                    // the node ID of this node
 	leaders map[uint64]*leader.LeaderSelection // pre-computed leader selection for each epoch
+
+	// TMP: EMERGENCY EPOCH CHAIN CONTINUATION [EECC]
+	fallback        *leader.LeaderSelection // fallback leader selection for views beyond the final view of an extended epoch
+	fallbackCounter uint64                  // counter of the epoch extended by the fallback leader selection
+	fallbackCapped  bool                    // whether the fallback ends before the first view of a committed recovery epoch
 }
 
 var _ hotstuff.Committee = (*Consensus)(nil)
@@ -65,6 +70,25 @@ func NewConsensusCommittee(state protocol.State, me flow.Identifier) (*Consensus
 		return nil, fmt.Errorf("could not add leader for previous epoch: %w", err)
 	}
 
+	// EECC - if the current epoch is a recovery epoch, the previous epoch has been
+	// extended until the recovery epoch began. Pre-compute the fallback leader
+	// selection for the views in between, so we know the leaders of all views
+	// since the beginning of the previous epoch.
+	previousFinalView, err := previous.FinalView()
+	if err != nil {
+		return nil, fmt.Errorf("could not get previous epoch final view: %w", err)
+	}
+	currentFirstView, err := current.FirstView()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current epoch first view: %w", err)
+	}
+	if previousFinalView+1 < currentFirstView {
+		_, err = com.prepareFallbackLeaderSelection(previous, currentFirstView)
+		if err != nil {
+			return nil, fmt.Errorf("could not add fallback leader for previous epoch: %w", err)
+		}
+	}
+
 	return com, nil
 }
 
@@ -90,6 +114,39 @@ func (c *Consensus) Identity(blockID flow.Identifier, nodeID flow.Identifier) (*
 	return identity, nil
 }
 
+// IdentitiesByEpoch returns the voting consensus committee members of the epoch
+// containing the given view, as specified by the epoch's initial identities.
+// Returns model.ErrViewForUnknownEpoch if no epoch containing the view is known.
+func (c *Consensus) IdentitiesByEpoch(view uint64, selector flow.IdentityFilter) (flow.IdentityList, error) {
+	epoch, err := c.epochForView(view)
+	if err != nil {
+		return nil, err
+	}
+	identities, err := epoch.InitialIdentities()
+	if err != nil {
+		return nil, fmt.Errorf("could not get initial identities of epoch for view %d: %w", view, err)
+	}
+	return identities.Filter(filter.And(
+		filter.IsVotingConsensusCommitteeMember,
+		selector,
+	)), nil
+}
+
+// IdentityByEpoch returns the identity of the given voting consensus committee
+// member of the epoch containing the given view. Returns
+//  * model.InvalidSignerError if the node is not a voting committee member of the epoch
+//  * model.ErrViewForUnknownEpoch if no epoch containing the view is known
+func (c *Consensus) IdentityByEpoch(view uint64, nodeID flow.Identifier) (*flow.Identity, error) {
+	identities, err := c.IdentitiesByEpoch(view, filter.HasNodeID(nodeID))
+	if err != nil {
+		return nil, err
+	}
+	if len(identities) == 0 {
+		return nil, model.NewInvalidSignerErrorf("node %v is not an authorized hotstuff voting participant at view %d", nodeID, view)
+	}
+	return identities[0], nil
+}
+
 // LeaderForView returns the node ID of the leader for the given view. Returns
 // the following errors:
 //  * epoch containing the requested view has not been set up (protocol.ErrNextEpochNotSetup)
@@ -140,62 +197,44 @@ func (c *Consensus) LeaderForView(view uint64) (flow.Identifier, error) {
 	// If we reach this code-path, it means we are about to propose or vote
 	// for the first block in the next epoch. If that epoch has not been
 	// committed or set up, rather than stopping consensus, this intervention
-	// will create a new fallback leader selection for the next epoch containing
-	// 6 months worth of views, so that consensus will have leaders specified
-	// for the duration of the current spork, without any epoch transitions.
+	// will create a new fallback leader selection for the views beyond the
+	// current epoch containing 6 months worth of views, so that consensus will
+	// have leaders specified for the duration of the current spork, without
+	// any epoch transitions.
+	//
+	// As the network may recover from EECC through an EpochRecover service event,
+	// the uncapped fallback leader selection is not pre-computed. Instead, we check
+	// for a committed recovery epoch every time we fall back.
 	//
 	_, err = next.DKG() // either of the following errors indicates that we have transitioned into EECC
 	if errors.Is(err, protocol.ErrEpochNotCommitted) || errors.Is(err, protocol.ErrNextEpochNotSetup) {
-		current := epochs.Current()
-
-		currentCounter, err := current.Counter()
-		if err != nil {
-			return flow.ZeroID, fmt.Errorf("could not get next epoch currentCounter: %w", err)
-		}
-		identities, err := current.InitialIdentities()
-		if err != nil {
-			return flow.ZeroID, fmt.Errorf("could not get epoch initial identities: %w", err)
-		}
-		// Get the random source
-		// CAUTION: this is re-using the same leader selection random source from the now-ending epoch
-		randomSeed, err := current.RandomSource()
-		if err != nil {
-			return flow.ZeroID, fmt.Errorf("could not get epoch seed: %w", err)
-		}
-		currentFinalView, err := current.FinalView()
-		if err != nil {
-			return flow.ZeroID, fmt.Errorf("could not get epoch first view: %w", err)
-		}
-
-		// we will inject a fallback leader selection in place of the next epoch
-		counter := currentCounter + 1
-		// the fallback leader selection begins after the final view of the current epoch
-		firstView := currentFinalView + 1
-
-		// create random number generator from the seed and customizer
-		rng, err := seed.PRGFromRandomSource(randomSeed, seed.ProtocolConsensusLeaderSelection)
-		if err != nil {
-			return flow.ZeroID, fmt.Errorf("could not create rng from seed: %w", err)
-		}
-
-		selection, err := leader.ComputeLeaderSelection(
-			firstView,
-			rng,
-			int(firstView+leader.EstimatedSixMonthOfViews), // the fallback epoch lasts until the next spork
-			identities.Filter(filter.IsVotingConsensusCommitteeMember),
-		)
+		selection, err := c.prepareFallbackLeaderSelection(epochs.Current(), 0)
 		if err != nil {
 			return flow.ZeroID, fmt.Errorf("could not compute epoch fallback leader selection: %w", err)
 		}
-		c.mu.Lock()
-		c.leaders[counter] = selection
-		c.mu.Unlock()
 		return selection.LeaderForView(view)
 	}
 	if err != nil {
 		return flow.ZeroID, fmt.Errorf("unexpected error in EECC logic while retrieving DKG data: %w", err)
 	}
 
+	// The next epoch has been committed. If it is a recovery epoch, committed by
+	// an EpochRecover service event, there may be a gap between the final view
+	// of the current epoch and the first view of the recovery epoch. Views in
+	// this gap are covered by the fallback leader selection, which ends right
+	// before the recovery epoch begins.
+	nextFirstView, err := next.FirstView()
+	if err != nil {
+		return flow.ZeroID, fmt.Errorf("could not get next epoch first view: %w", err)
+	}
+	if view < nextFirstView {
+		selection, err := c.prepareFallbackLeaderSelection(epochs.Current(), nextFirstView)
+		if err != nil {
+			return flow.ZeroID, fmt.Errorf("could not compute epoch fallback leader selection: %w", err)
+		}
+		return selection.LeaderForView(view)
+	}
+
 	// HAPPY PATH logic
 	selection, err := c.prepareLeaderSelection(next)
 	if err != nil {
@@ -213,6 +252,68 @@ func (c *Consensus) DKG(blockID flow.Identifier) (hotstuff.DKG, error) {
 	return c.state.AtBlockID(blockID).Epochs().Current().DKG()
 }
 
+// DKGByEpoch returns the DKG info of the epoch containing the given view.
+// Returns model.ErrViewForUnknownEpoch if no epoch containing the view is known.
+func (c *Consensus) DKGByEpoch(view uint64) (hotstuff.DKG, error) {
+	epoch, err := c.epochForView(view)
+	if err != nil {
+		return nil, err
+	}
+	return epoch.DKG()
+}
+
+// epochForView returns the committed epoch containing the given view, among the
+// previous, current and next epoch w.r.t. the finalized block.
+//
+// TMP: EMERGENCY EPOCH CHAIN CONTINUATION [EECC]
+// An epoch extends until the first view of the succeeding committed epoch, so
+// that views covered by the fallback leader selection are attributed to the
+// extended epoch, consistently with the epoch's DKG being used in EECC.
+//
+// Returns model.ErrViewForUnknownEpoch if the view is before the first view of
+// the oldest known epoch.
+func (c *Consensus) epochForView(view uint64) (protocol.Epoch, error) {
+	epochs := c.state.Final().Epochs()
+	next := epochs.Next()
+
+	// the next epoch only takes over once it has been committed
+	_, err := next.DKG()
+	if err == nil {
+		nextFirstView, err := next.FirstView()
+		if err != nil {
+			return nil, fmt.Errorf("could not get next epoch first view: %w", err)
+		}
+		if view >= nextFirstView {
+			return next, nil
+		}
+	} else if !errors.Is(err, protocol.ErrEpochNotCommitted) && !errors.Is(err, protocol.ErrNextEpochNotSetup) {
+		return nil, fmt.Errorf("could not get next epoch dkg: %w", err)
+	}
+
+	current := epochs.Current()
+	currentFirstView, err := current.FirstView()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current epoch first view: %w", err)
+	}
+	if view >= currentFirstView {
+		return current, nil
+	}
+
+	previous := epochs.Previous()
+	previousFirstView, err := previous.FirstView()
+	if errors.Is(err, protocol.ErrNoPreviousEpoch) {
+		return nil, fmt.Errorf("no epoch known for view %d: %w", view, model.ErrViewForUnknownEpoch)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get previous epoch first view: %w", err)
+	}
+	if view >= previousFirstView {
+		return previous, nil
+	}
+
+	return nil, fmt.Errorf("no epoch known for view %d: %w", view, model.ErrViewForUnknownEpoch)
+}
+
 // precomputedLeaderForView retrieves the leader from the precomputed
 // LeaderSelection in `c.leaders`
 // Error returns:
@@ -241,6 +342,18 @@ func (c *Consensus) precomputedLeaderForView(view uint64) (flow.Identifier, erro
 		return leaderID, nil
 	}
 
+	// EECC - only a capped fallback leader selection is final, as an uncapped
+	// fallback ends once a recovery epoch is committed
+	if c.fallback != nil && c.fallbackCapped {
+		leaderID, err := c.fallback.LeaderForView(view)
+		if err == nil {
+			return leaderID, nil
+		}
+		if !leader.IsInvalidViewError(err) {
+			return flow.ZeroID, fmt.Errorf("could not get fallback leader: %w", err)
+		}
+	}
+
 	return flow.ZeroID, errSelectionNotComputed
 }
 
@@ -289,3 +402,74 @@ func (c *Consensus) prepareLeaderSelection(epoch protocol.Epoch) (*leader.Leader
 
 	return selection, nil
 }
+
+// prepareFallbackLeaderSelection pre-computes and stores the fallback leader
+// selection for the views beyond the final view of the given epoch, which has
+// been extended by epoch emergency fallback. If the network is recovering from
+// EECC, recoveryFirstView is the first view of the committed recovery epoch and
+// the fallback ends right before it. Otherwise, recoveryFirstView is zero and
+// the fallback lasts until the next spork. Computing the same fallback leader
+// selection multiple times is a no-op.
+//
+// Returns the fallback leader selection for the given epoch.
+func (c *Consensus) prepareFallbackLeaderSelection(epoch protocol.Epoch, recoveryFirstView uint64) (*leader.LeaderSelection, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counter, err := epoch.Counter()
+	if err != nil {
+		return nil, fmt.Errorf("could not get counter for extended epoch: %w", err)
+	}
+	capped := recoveryFirstView > 0
+	// this is a no-op if we have already computed the fallback for this epoch
+	if c.fallback != nil && c.fallbackCounter == counter && (c.fallbackCapped || !capped) {
+		return c.fallback, nil
+	}
+
+	identities, err := epoch.InitialIdentities()
+	if err != nil {
+		return nil, fmt.Errorf("could not get epoch initial identities: %w", err)
+	}
+	// Get the random source
+	// CAUTION: this is re-using the same leader selection random source from the extended epoch
+	randomSeed, err := epoch.RandomSource()
+	if err != nil {
+		return nil, fmt.Errorf("could not get epoch seed: %w", err)
+	}
+	finalView, err := epoch.FinalView()
+	if err != nil {
+		return nil, fmt.Errorf("could not get epoch final view: %w", err)
+	}
+
+	// the fallback leader selection begins after the final view of the extended epoch
+	firstView := finalView + 1
+	// the fallback epoch lasts until the next spork, or until the recovery epoch begins
+	count := int(firstView + leader.EstimatedSixMonthOfViews)
+	if capped {
+		if recoveryFirstView <= firstView {
+			return nil, fmt.Errorf("recovery epoch first view (%d) must be after fallback first view (%d)", recoveryFirstView, firstView)
+		}
+		count = int(recoveryFirstView - firstView)
+	}
+
+	// create random number generator from the seed and customizer
+	rng, err := seed.PRGFromRandomSource(randomSeed, seed.ProtocolConsensusLeaderSelection)
+	if err != nil {
+		return nil, fmt.Errorf("could not create rng from seed: %w", err)
+	}
+
+	selection, err := leader.ComputeLeaderSelection(
+		firstView,
+		rng,
+		count,
+		identities.Filter(filter.IsVotingConsensusCommitteeMember),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute leader selection: %w", err)
+	}
+	c.fallback = selection
+	c.fallbackCounter = counter
+	c.fallbackCapped = capped
+
+	return selection, nil
+}
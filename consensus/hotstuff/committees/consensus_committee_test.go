@@ -10,6 +10,7 @@ import (
 
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/flow/filter"
 	"github.com/onflow/flow-go/state/protocol"
 	protocolmock "github.com/onflow/flow-go/state/protocol/mock"
 	"github.com/onflow/flow-go/state/protocol/seed"
@@ -203,6 +204,196 @@ func TestConsensus_LeaderForView(t *testing.T) {
 	})
 }
 
+// TestConsensus_LeaderForView_EpochRecover tests that LeaderForView returns
+// fallback leaders for views between the final view of an epoch extended by
+// emergency epoch chain continuation (EECC) and the first view of a recovery
+// epoch, and leaders of the recovery epoch afterward.
+func TestConsensus_LeaderForView_EpochRecover(t *testing.T) {
+
+	identities := unittest.IdentityListFixture(10)
+	recoveryIdentities := unittest.IdentityListFixture(10)
+	me := identities[0].NodeID
+
+	// the counter for the current epoch
+	epochCounter := uint64(2)
+
+	// create mocks
+	state := new(protocolmock.State)
+	snapshot := new(protocolmock.Snapshot)
+
+	prevEpoch := newMockEpoch(
+		epochCounter-1,
+		identities,
+		1,
+		100,
+		unittest.SeedFixture(seed.RandomSourceLength),
+	)
+	currEpoch := newMockEpoch(
+		epochCounter,
+		identities,
+		101,
+		200,
+		unittest.SeedFixture(seed.RandomSourceLength),
+	)
+
+	state.On("Final").Return(snapshot)
+	epochs := mocks.NewEpochQuery(t, epochCounter, prevEpoch, currEpoch)
+	snapshot.On("Epochs").Return(epochs)
+
+	committee, err := NewConsensusCommittee(state, me)
+	require.NoError(t, err)
+
+	// EECC - the next epoch has not been set up, we fall back to a leader
+	// selection continuing the current epoch
+	fallbackLeaders := make(map[uint64]flow.Identifier)
+	for view := uint64(201); view <= 400; view++ {
+		leaderID, err := committee.LeaderForView(view)
+		require.NoError(t, err)
+		_, exists := identities.ByNodeID(leaderID)
+		require.True(t, exists)
+		fallbackLeaders[view] = leaderID
+	}
+
+	// the recovery epoch begins with a gap after the final view of the current epoch
+	recoveryEpoch := newMockEpoch(
+		epochCounter+1,
+		recoveryIdentities,
+		301,
+		400,
+		unittest.SeedFixture(seed.RandomSourceLength),
+	)
+	epochs.Add(recoveryEpoch)
+
+	t.Run("views before recovery epoch should use fallback leaders", func(t *testing.T) {
+		for view := uint64(201); view <= 300; view++ {
+			leaderID, err := committee.LeaderForView(view)
+			require.NoError(t, err)
+			assert.Equal(t, fallbackLeaders[view], leaderID)
+		}
+	})
+
+	t.Run("views in recovery epoch should use recovery epoch leaders", func(t *testing.T) {
+		for view := uint64(301); view <= 400; view++ {
+			leaderID, err := committee.LeaderForView(view)
+			require.NoError(t, err)
+			_, exists := recoveryIdentities.ByNodeID(leaderID)
+			require.True(t, exists)
+		}
+	})
+
+	t.Run("views beyond recovery epoch should not use fallback leaders", func(t *testing.T) {
+		_, err := committee.LeaderForView(450)
+		assert.Error(t, err)
+	})
+
+	// after transitioning into the recovery epoch, a restarted committee should
+	// know the fallback leaders for the views between the previous and current epoch
+	t.Run("restart in recovery epoch", func(t *testing.T) {
+		epochs.Transition()
+
+		restarted, err := NewConsensusCommittee(state, me)
+		require.NoError(t, err)
+
+		for view := uint64(201); view <= 300; view++ {
+			leaderID, err := restarted.LeaderForView(view)
+			require.NoError(t, err)
+			assert.Equal(t, fallbackLeaders[view], leaderID)
+		}
+	})
+}
+
+// TestConsensus_IdentitiesByEpoch tests that by-view queries return the
+// committee of the epoch containing the view, where an epoch extended by
+// emergency epoch chain continuation (EECC) lasts until the next committed epoch.
+func TestConsensus_IdentitiesByEpoch(t *testing.T) {
+
+	prevIdentities := unittest.IdentityListFixture(10, unittest.WithRole(flow.RoleConsensus))
+	currIdentities := unittest.IdentityListFixture(10, unittest.WithRole(flow.RoleConsensus))
+	nextIdentities := unittest.IdentityListFixture(10, unittest.WithRole(flow.RoleConsensus))
+	me := currIdentities[0].NodeID
+
+	// the counter for the current epoch
+	epochCounter := uint64(2)
+
+	// create mocks
+	state := new(protocolmock.State)
+	snapshot := new(protocolmock.Snapshot)
+
+	prevEpoch := newMockEpoch(
+		epochCounter-1,
+		prevIdentities,
+		1,
+		100,
+		unittest.SeedFixture(seed.RandomSourceLength),
+	)
+	currEpoch := newMockEpoch(
+		epochCounter,
+		currIdentities,
+		101,
+		200,
+		unittest.SeedFixture(seed.RandomSourceLength),
+	)
+
+	state.On("Final").Return(snapshot)
+	epochs := mocks.NewEpochQuery(t, epochCounter, prevEpoch, currEpoch)
+	snapshot.On("Epochs").Return(epochs)
+
+	committee, err := NewConsensusCommittee(state, me)
+	require.NoError(t, err)
+
+	t.Run("next epoch not ready", func(t *testing.T) {
+		identities, err := committee.IdentitiesByEpoch(50, filter.Any)
+		require.NoError(t, err)
+		assert.Equal(t, prevIdentities, identities)
+
+		identities, err = committee.IdentitiesByEpoch(150, filter.Any)
+		require.NoError(t, err)
+		assert.Equal(t, currIdentities, identities)
+
+		// EECC - the current epoch is extended
+		identities, err = committee.IdentitiesByEpoch(250, filter.Any)
+		require.NoError(t, err)
+		assert.Equal(t, currIdentities, identities)
+	})
+
+	t.Run("view before previous epoch", func(t *testing.T) {
+		_, err := committee.IdentitiesByEpoch(0, filter.Any)
+		assert.True(t, errors.Is(err, model.ErrViewForUnknownEpoch))
+		_, err = committee.IdentityByEpoch(0, me)
+		assert.True(t, errors.Is(err, model.ErrViewForUnknownEpoch))
+	})
+
+	t.Run("identity by epoch", func(t *testing.T) {
+		identity, err := committee.IdentityByEpoch(150, me)
+		require.NoError(t, err)
+		assert.Equal(t, currIdentities[0], identity)
+
+		_, err = committee.IdentityByEpoch(50, me)
+		assert.True(t, model.IsInvalidSignerError(err))
+	})
+
+	// the next epoch is a recovery epoch, beginning with a gap after the final
+	// view of the current epoch
+	nextEpoch := newMockEpoch(
+		epochCounter+1,
+		nextIdentities,
+		301,
+		400,
+		unittest.SeedFixture(seed.RandomSourceLength),
+	)
+	epochs.Add(nextEpoch)
+
+	t.Run("next epoch ready", func(t *testing.T) {
+		identities, err := committee.IdentitiesByEpoch(250, filter.Any)
+		require.NoError(t, err)
+		assert.Equal(t, currIdentities, identities)
+
+		identities, err = committee.IdentitiesByEpoch(350, filter.Any)
+		require.NoError(t, err)
+		assert.Equal(t, nextIdentities, identities)
+	})
+}
+
 func TestRemoveOldEpochs(t *testing.T) {
 
 	identities := unittest.IdentityListFixture(10)
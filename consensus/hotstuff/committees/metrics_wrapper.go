@@ -42,6 +42,20 @@ func (w CommitteeMetricsWrapper) Identity(blockID flow.Identifier, participantID
 	return identity, err
 }
 
+func (w CommitteeMetricsWrapper) IdentitiesByEpoch(view uint64, selector flow.IdentityFilter) (flow.IdentityList, error) {
+	processStart := time.Now()
+	identities, err := w.committee.IdentitiesByEpoch(view, selector)
+	w.metrics.CommitteeProcessingDuration(time.Since(processStart))
+	return identities, err
+}
+
+func (w CommitteeMetricsWrapper) IdentityByEpoch(view uint64, participantID flow.Identifier) (*flow.Identity, error) {
+	processStart := time.Now()
+	identity, err := w.committee.IdentityByEpoch(view, participantID)
+	w.metrics.CommitteeProcessingDuration(time.Since(processStart))
+	return identity, err
+}
+
 func (w CommitteeMetricsWrapper) LeaderForView(view uint64) (flow.Identifier, error) {
 	processStart := time.Now()
 	id, err := w.committee.LeaderForView(view)
@@ -62,3 +76,10 @@ func (w CommitteeMetricsWrapper) DKG(blockID flow.Identifier) (hotstuff.DKG, err
 	w.metrics.CommitteeProcessingDuration(time.Since(processStart))
 	return dkg, err
 }
+
+func (w CommitteeMetricsWrapper) DKGByEpoch(view uint64) (hotstuff.DKG, error) {
+	processStart := time.Now()
+	dkg, err := w.committee.DKGByEpoch(view)
+	w.metrics.CommitteeProcessingDuration(time.Since(processStart))
+	return dkg, err
+}
@@ -52,6 +52,18 @@ func (s Static) Identity(_ flow.Identifier, participantID flow.Identifier) (*flo
 	return identity, nil
 }
 
+func (s Static) IdentitiesByEpoch(_ uint64, selector flow.IdentityFilter) (flow.IdentityList, error) {
+	return s.participants.Filter(selector), nil
+}
+
+func (s Static) IdentityByEpoch(_ uint64, participantID flow.Identifier) (*flow.Identity, error) {
+	identity, ok := s.participants.ByNodeID(participantID)
+	if !ok {
+		return nil, fmt.Errorf("unknown partipant")
+	}
+	return identity, nil
+}
+
 func (s Static) LeaderForView(_ uint64) (flow.Identifier, error) {
 	return flow.ZeroID, fmt.Errorf("invalid for static committee")
 }
@@ -64,6 +76,10 @@ func (s Static) DKG(_ flow.Identifier) (hotstuff.DKG, error) {
 	return s.dkg, nil
 }
 
+func (s Static) DKGByEpoch(_ uint64) (hotstuff.DKG, error) {
+	return s.dkg, nil
+}
+
 type staticDKG struct {
 	dkgParticipants map[flow.Identifier]flow.DKGParticipant
 	dkgGroupKey     crypto.PublicKey
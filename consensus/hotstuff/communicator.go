@@ -3,6 +3,7 @@ package hotstuff
 import (
 	"time"
 
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/model/flow"
 )
 
@@ -21,4 +22,8 @@ type Communicator interface {
 	// the consensus process.
 	// delay is to hold the proposal before broadcasting it. Useful to control the block production rate.
 	BroadcastProposalWithDelay(proposal *flow.Header, delay time.Duration) error
+
+	// BroadcastTimeout broadcasts the given timeout object to all actors of
+	// the consensus process.
+	BroadcastTimeout(timeout *model.TimeoutObject) error
 }
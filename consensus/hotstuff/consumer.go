@@ -83,6 +83,14 @@ type Consumer interface {
 	// and must handle repetition of the same events (with some processing overhead).
 	OnQcTriggeredViewChange(qc *flow.QuorumCertificate, newView uint64)
 
+	// OnTcTriggeredViewChange notifications are produced by PaceMaker when it moves to a new view
+	// based on processing a TC. The arguments specify the tc (first argument), which triggered
+	// the view change, and the newView to which the PaceMaker transitioned (second argument).
+	// Prerequisites:
+	// Implementation must be concurrency safe; Non-blocking;
+	// and must handle repetition of the same events (with some processing overhead).
+	OnTcTriggeredViewChange(tc *flow.TimeoutCertificate, newView uint64)
+
 	// OnProposingBlock notifications are produced by the EventHandler when the replica, as
 	// leader for the respective view, proposing a block.
 	// Prerequisites:
@@ -96,6 +104,13 @@ type Consumer interface {
 	// and must handle repetition of the same events (with some processing overhead).
 	OnVoting(vote *model.Vote)
 
+	// OnTimingOut notifications are produced by the EventHandler when the replica times out
+	// in the current view and broadcasts its timeout object.
+	// Prerequisites:
+	// Implementation must be concurrency safe; Non-blocking;
+	// and must handle repetition of the same events (with some processing overhead).
+	OnTimingOut(timeout *model.TimeoutObject)
+
 	// OnQcConstructedFromVotes notifications are produced by the VoteAggregator
 	// component, whenever it constructs a QC from votes.
 	// Prerequisites:
@@ -103,6 +118,13 @@ type Consumer interface {
 	// and must handle repetition of the same events (with some processing overhead).
 	OnQcConstructedFromVotes(curView uint64, qc *flow.QuorumCertificate)
 
+	// OnTcConstructedFromTimeouts notifications are produced by the VoteAggregator
+	// component, whenever it constructs a TC from timeouts.
+	// Prerequisites:
+	// Implementation must be concurrency safe; Non-blocking;
+	// and must handle repetition of the same events (with some processing overhead).
+	OnTcConstructedFromTimeouts(curView uint64, tc *flow.TimeoutCertificate)
+
 	// OnStartingTimeout notifications are produced by PaceMaker. Such a notification indicates that the
 	// PaceMaker is now waiting for the system to (receive and) process blocks or votes.
 	// The specific timeout type is contained in the TimerInfo.
@@ -153,6 +175,21 @@ type Consumer interface {
 	// Implementation must be concurrency safe; Non-blocking;
 	// and must handle repetition of the same events (with some processing overhead).
 	OnVoteForInvalidBlockDetected(vote *model.Vote, invalidProposal *model.Proposal)
+
+	// OnDoubleTimeoutDetected notifications are produced by the Vote Aggregation logic
+	// whenever a double timeout (same replica providing two different timeouts for the
+	// same view) was detected.
+	// Prerequisites:
+	// Implementation must be concurrency safe; Non-blocking;
+	// and must handle repetition of the same events (with some processing overhead).
+	OnDoubleTimeoutDetected(*model.TimeoutObject, *model.TimeoutObject)
+
+	// OnInvalidTimeoutDetected notifications are produced by the Vote Aggregation logic
+	// whenever an invalid timeout was detected.
+	// Prerequisites:
+	// Implementation must be concurrency safe; Non-blocking;
+	// and must handle repetition of the same events (with some processing overhead).
+	OnInvalidTimeoutDetected(*model.TimeoutObject)
 }
 
 // QCCreatedConsumer consumes outbound notifications produced by HotStuff and its components.
@@ -172,3 +209,21 @@ type QCCreatedConsumer interface {
 	// and must handle repetition of the same events (with some processing overhead).
 	OnQcConstructedFromVotes(*flow.QuorumCertificate)
 }
+
+// TCCreatedConsumer consumes outbound notifications produced by HotStuff and its components.
+// Notifications are consensus-internal state changes which are potentially relevant to
+// the larger node in which HotStuff is running. The notifications are emitted
+// in the order in which the HotStuff algorithm makes the respective steps.
+//
+// Implementations must:
+//   * be concurrency safe
+//   * be non-blocking
+//   * handle repetition of the same events (with some processing overhead).
+type TCCreatedConsumer interface {
+	// OnTcConstructedFromTimeouts notifications are produced by the VoteAggregator
+	// component, whenever it constructs a TC from timeouts.
+	// Prerequisites:
+	// Implementation must be concurrency safe; Non-blocking;
+	// and must handle repetition of the same events (with some processing overhead).
+	OnTcConstructedFromTimeouts(*flow.TimeoutCertificate)
+}
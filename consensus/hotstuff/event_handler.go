@@ -7,12 +7,15 @@ import (
 	"github.com/onflow/flow-go/model/flow"
 )
 
-// EventHandler runs a state machine to process proposals, QC and local timeouts.
+// EventHandler runs a state machine to process proposals, QC, TC and local timeouts.
 type EventHandler interface {
 
 	// OnQCConstructed processes a valid qc constructed by internal vote aggregator.
 	OnQCConstructed(qc *flow.QuorumCertificate) error
 
+	// OnTCConstructed processes a valid tc constructed by internal timeout aggregator.
+	OnTCConstructed(tc *flow.TimeoutCertificate) error
+
 	// OnReceiveProposal processes a block proposal received from another HotStuff
 	// consensus participant.
 	OnReceiveProposal(proposal *model.Proposal) error
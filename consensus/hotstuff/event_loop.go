@@ -5,11 +5,15 @@ import (
 	"github.com/onflow/flow-go/module"
 )
 
-// EventLoop performs buffer and processing of incoming proposals and QCs.
+// EventLoop performs buffer and processing of incoming proposals, QCs and TCs.
 type EventLoop interface {
 	module.HotStuff
 
 	// SubmitTrustedQC accepts QC for processing. QC will be dispatched on worker thread.
 	// CAUTION: QC is trusted (_not_ validated again), as it's built by ourselves.
 	SubmitTrustedQC(qc *flow.QuorumCertificate)
+
+	// SubmitTrustedTC accepts TC for processing. TC will be dispatched on worker thread.
+	// CAUTION: TC is trusted (_not_ validated again), as it's built by ourselves.
+	SubmitTrustedTC(tc *flow.TimeoutCertificate)
 }
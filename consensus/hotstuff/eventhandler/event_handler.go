@@ -28,12 +28,15 @@ type EventHandler struct {
 	voter          hotstuff.Voter
 	validator      hotstuff.Validator
 	notifier       hotstuff.Consumer
+	recorder       hotstuff.FlightRecorder
 	ownProposal    flow.Identifier
 }
 
 var _ hotstuff.EventHandler = (*EventHandler)(nil)
 
-// NewEventHandler creates an EventHandler instance with initial components.
+// NewEventHandler creates an EventHandler instance with initial components. View changes,
+// the outcome of processing proposals and the proposals, votes and timeouts produced by
+// this replica are recorded to the given flight recorder.
 func NewEventHandler(
 	log zerolog.Logger,
 	paceMaker hotstuff.PaceMaker,
@@ -46,6 +49,7 @@ func NewEventHandler(
 	voter hotstuff.Voter,
 	validator hotstuff.Validator,
 	notifier hotstuff.Consumer,
+	recorder hotstuff.FlightRecorder,
 ) (*EventHandler, error) {
 	e := &EventHandler{
 		log:            log.With().Str("hotstuff", "participant").Logger(),
@@ -59,6 +63,7 @@ func NewEventHandler(
 		committee:      committee,
 		voteAggregator: voteAggregator,
 		notifier:       notifier,
+		recorder:       recorder,
 		ownProposal:    flow.ZeroID,
 	}
 	return e, nil
@@ -88,6 +93,30 @@ func (e *EventHandler) OnQCConstructed(qc *flow.QuorumCertificate) error {
 	return e.processQC(qc)
 }
 
+// OnTCConstructed processes constructed TC by our timeout aggregator
+func (e *EventHandler) OnTCConstructed(tc *flow.TimeoutCertificate) error {
+	curView := e.paceMaker.CurView()
+
+	log := e.log.With().
+		Uint64("cur_view", curView).
+		Uint64("tc_view", tc.View).
+		Uint64("tc_newest_qc_view", tc.NewestQC.View).
+		Logger()
+
+	e.notifier.OnTcConstructedFromTimeouts(curView, tc)
+	defer e.notifier.OnEventProcessed()
+
+	log.Debug().Msg("received constructed TC")
+
+	// ignore stale tc
+	if tc.View < e.forks.FinalizedView() {
+		log.Debug().Msg("stale tc")
+		return nil
+	}
+
+	return e.processTC(tc)
+}
+
 // OnReceiveProposal processes the block when a block proposal is received.
 // It is assumed that the block proposal is incorporated. (its parent can be found
 // in the forks)
@@ -111,6 +140,7 @@ func (e *EventHandler) OnReceiveProposal(proposal *model.Proposal) error {
 	// ignore stale proposals
 	if block.View < e.forks.FinalizedView() {
 		log.Debug().Msg("stale proposal")
+		e.recordProposal(proposal, curView, "stale")
 		return nil
 	}
 
@@ -123,6 +153,7 @@ func (e *EventHandler) OnReceiveProposal(proposal *model.Proposal) error {
 			perr := e.voteAggregator.InvalidBlock(proposal)
 			if mempool.IsDecreasingPruningHeightError(perr) {
 				log.Warn().Err(err).Msgf("invalid block proposal, but vote aggregator has pruned this height: %v", perr)
+				e.recordProposal(proposal, curView, fmt.Sprintf("invalid: %v", err))
 				return nil
 			}
 
@@ -132,6 +163,7 @@ func (e *EventHandler) OnReceiveProposal(proposal *model.Proposal) error {
 			}
 
 			log.Warn().Err(err).Msg("invalid block proposal")
+			e.recordProposal(proposal, curView, fmt.Sprintf("invalid: %v", err))
 			return nil
 		}
 
@@ -161,10 +193,24 @@ func (e *EventHandler) OnReceiveProposal(proposal *model.Proposal) error {
 	if err != nil {
 		return fmt.Errorf("cannot add block to fork (%x): %w", block.BlockID, err)
 	}
+	e.recordProposal(proposal, curView, "")
 
-	// if the block is not for the current view, then process the QC
+	// the block's QC and TC might allow us to advance to a newer view. The QC has already
+	// been added to Forks together with the block.
+	_, viewChangedByQC := e.paceMaker.ProcessQC(block.QC)
+	viewChangedByTC, err := e.processTCWithoutViewChange(block.LastViewTC)
+	if err != nil {
+		return fmt.Errorf("could not process TC included in block (%x): %w", block.BlockID, err)
+	}
+	if viewChangedByQC || viewChangedByTC {
+		log.Debug().Msg("proposal triggered view change, starting new view now")
+		// starting the new view also processes the block, if it is for the new view
+		return e.startNewView()
+	}
+
+	// if the block is not for the current view, there is nothing more to do
 	if block.View != curView {
-		return e.processQC(block.QC)
+		return nil
 	}
 
 	// if the block is for the current view, then check whether to vote for this block
@@ -183,29 +229,56 @@ func (e *EventHandler) TimeoutChannel() <-chan time.Time {
 }
 
 // OnLocalTimeout is called when the timeout event created by pacemaker looped through the
-// event loop.
+// event loop. The replica does not leave the current view on a local timeout. Instead, it
+// broadcasts a timeout object for the current view, so that the committee can build a TC,
+// which then moves all replicas to the next view.
 func (e *EventHandler) OnLocalTimeout() error {
 
 	curView := e.paceMaker.CurView()
-	newView := e.paceMaker.OnTimeout()
+	newestQC := e.paceMaker.NewestQC()
+	// the TC for the last view is only needed if we don't have a QC for the last view
+	var lastViewTC *flow.TimeoutCertificate
+	if newestQC.View+1 != curView {
+		lastViewTC = e.paceMaker.LastViewTC()
+	}
+	e.paceMaker.OnTimeout()
 	defer e.notifier.OnEventProcessed()
 
 	log := e.log.With().
 		Uint64("cur_view", curView).
-		Uint64("new_view", newView.View).
+		Uint64("newest_qc_view", newestQC.View).
 		Logger()
-	// 	notifications about time-outs and view-changes are generated by PaceMaker; no need to send a notification here
+	// 	notifications about time-outs are generated by PaceMaker; no need to send a notification here
 	log.Debug().Msg("timeout received from event loop")
-
-	if curView == newView.View {
-		return fmt.Errorf("OnLocalTimeout should guarantee that the pacemaker should go to next view, but didn't: (curView: %v, newView: %v)", curView, newView.View)
+	e.recorder.Record(model.FlightEvent{
+		Type:    model.FlightEventLocalTimeout,
+		View:    curView,
+		CurView: curView,
+		QCView:  newestQC.View,
+	})
+
+	timeout, err := e.voter.ProduceTimeout(curView, newestQC, lastViewTC)
+	if err != nil {
+		if !model.IsNoTimeoutError(err) {
+			return fmt.Errorf("could not produce timeout: %w", err)
+		}
+		log.Debug().Err(err).Msg("should not produce timeout for this view")
+		return nil
 	}
 
-	// current view has changed, go to new view
-	err := e.startNewView()
+	// contribute our own timeout to the TC and send it to all other replicas
+	e.notifier.OnTimingOut(timeout)
+	e.voteAggregator.AddTimeout(timeout)
+	err = e.communicator.BroadcastTimeout(timeout)
 	if err != nil {
-		return fmt.Errorf("could not start new view: %w", err)
+		log.Warn().Err(err).Msg("could not forward timeout")
 	}
+	e.recorder.Record(model.FlightEvent{
+		Type:    model.FlightEventTimeoutProduced,
+		View:    timeout.View,
+		CurView: curView,
+		QCView:  timeout.NewestQC.View,
+	})
 
 	log.Debug().Msg("local timeout processed")
 
@@ -227,7 +300,20 @@ func (e *EventHandler) startNewView() error {
 
 	curView := e.paceMaker.CurView()
 
-	err := e.persist.PutStarted(curView)
+	// persist the certificates which justify the current view before the view itself,
+	// so that the pacemaker can be initialized with the started view after a restart
+	err := e.persist.PutNewestQC(e.paceMaker.NewestQC())
+	if err != nil {
+		return fmt.Errorf("could not persist newest QC: %w", err)
+	}
+	if tc := e.paceMaker.LastViewTC(); tc != nil {
+		err = e.persist.PutNewestTC(tc)
+		if err != nil {
+			return fmt.Errorf("could not persist last view TC: %w", err)
+		}
+	}
+
+	err = e.persist.PutStarted(curView)
 	if err != nil {
 		return fmt.Errorf("could not persist current view: %w", err)
 	}
@@ -244,6 +330,13 @@ func (e *EventHandler) startNewView() error {
 		Uint64("finalized_view", e.forks.FinalizedView()).
 		Msg("entering new view")
 	e.notifier.OnEnteringView(curView, currentLeader)
+	e.recorder.Record(model.FlightEvent{
+		Type:     model.FlightEventViewEntered,
+		View:     curView,
+		CurView:  curView,
+		OriginID: currentLeader,
+		QCView:   e.paceMaker.NewestQC().View,
+	})
 
 	if e.committee.Self() == currentLeader {
 		log.Debug().Msg("generating block proposal as leader")
@@ -255,7 +348,28 @@ func (e *EventHandler) startNewView() error {
 			return fmt.Errorf("can not make fork choice for view %v: %w", curView, err)
 		}
 
-		proposal, err := e.blockProducer.MakeBlockProposal(qc, curView)
+		// If we entered the current view through a TC, the proposal must include the TC
+		// and must extend a QC which is at least as new as the newest QC in the TC.
+		var lastViewTC *flow.TimeoutCertificate
+		if qc.View+1 != curView {
+			lastViewTC = e.paceMaker.LastViewTC()
+			if lastViewTC == nil || lastViewTC.View+1 != curView {
+				// This should never happen: we only enter a view through a QC or a TC for the previous view,
+				// and Forks knows every QC which moved the pacemaker to a new view.
+				return fmt.Errorf("entered view %d without QC or TC for the previous view (newest QC view %d)", curView, qc.View)
+			}
+			if qc.View < lastViewTC.NewestQC.View {
+				// we don't know the block certified by the newest QC in the TC yet; we can't
+				// build a valid proposal and skip proposing for this view
+				log.Warn().
+					Uint64("qc_view", qc.View).
+					Uint64("tc_newest_qc_view", lastViewTC.NewestQC.View).
+					Msg("skip proposing as the block certified by the TC's newest QC is unknown")
+				return nil
+			}
+		}
+
+		proposal, err := e.blockProducer.MakeBlockProposal(qc, lastViewTC, curView)
 		if err != nil {
 			return fmt.Errorf("can not make block proposal for curView %v: %w", curView, err)
 		}
@@ -283,6 +397,13 @@ func (e *EventHandler) startNewView() error {
 		if err != nil {
 			log.Warn().Err(err).Msg("could not forward proposal")
 		}
+		e.recorder.Record(model.FlightEvent{
+			Type:    model.FlightEventProposalProduced,
+			View:    block.View,
+			CurView: curView,
+			BlockID: block.BlockID,
+			QCView:  qc.View,
+		})
 		// mark our own proposals to avoid double validation
 		e.ownProposal = proposal.Block.BlockID
 
@@ -325,7 +446,8 @@ func (e *EventHandler) startNewView() error {
 // processBlockForCurrentView processes the block for the current view.
 // It is called AFTER the block has been stored or found in Forks
 // It checks whether to vote for this block.
-// It might trigger a view change to go to a different view, which might re-enter this function.
+// Voting does not trigger a view change: the replica stays in the current view until it
+// learns a QC or TC for the current view.
 func (e *EventHandler) processBlockForCurrentView(block *model.Block) error {
 	// sanity check that block is really for the current view:
 	curView := e.paceMaker.CurView()
@@ -345,21 +467,6 @@ func (e *EventHandler) processBlockForCurrentView(block *model.Block) error {
 		return fmt.Errorf("unexpected error in voting logic: %w", err)
 	}
 
-	// Inform PaceMaker that we've processed a block for the current view. We expect
-	// a view change if an only if we are _not_ the next leader. We perform a sanity
-	// check here, because a wrong view change can have disastrous consequences.
-	isSelfNextLeader := e.committee.Self() == nextLeader
-	_, viewChanged := e.paceMaker.UpdateCurViewWithBlock(block, isSelfNextLeader)
-	if viewChanged == isSelfNextLeader {
-		if isSelfNextLeader {
-			return fmt.Errorf("I am primary for next view (%v) and should be collecting votes, but pacemaker triggered already view change", curView+1)
-		}
-		return fmt.Errorf("pacemaker should trigger a view change to net view (%v), but didn't", curView+1)
-	}
-
-	if viewChanged {
-		return e.startNewView()
-	}
 	return nil
 }
 
@@ -388,6 +495,15 @@ func (e *EventHandler) ownVote(block *model.Block, curView uint64, nextLeader fl
 	// The following code is only reached, if this replica has produced a vote.
 	// Send the vote to the next leader (or directly process it, if I am the next leader).
 	e.notifier.OnVoting(ownVote)
+	e.recorder.Record(model.FlightEvent{
+		Type:        model.FlightEventVoteProduced,
+		View:        ownVote.View,
+		CurView:     curView,
+		BlockID:     ownVote.BlockID,
+		OriginID:    block.ProposerID,
+		RecipientID: nextLeader,
+		QCView:      block.QC.View,
+	})
 	log.Debug().Msg("forwarding vote to compliance engine")
 	if e.committee.Self() == nextLeader { // I am the next leader
 		e.voteAggregator.AddVote(ownVote)
@@ -415,7 +531,7 @@ func (e *EventHandler) processQC(qc *flow.QuorumCertificate) error {
 		return fmt.Errorf("cannot add QC to forks: %w", err)
 	}
 
-	_, viewChanged := e.paceMaker.UpdateCurViewWithQC(qc)
+	_, viewChanged := e.paceMaker.ProcessQC(qc)
 	if !viewChanged {
 		log.Debug().Msg("QC didn't trigger view change, nothing to do")
 		return nil
@@ -425,3 +541,52 @@ func (e *EventHandler) processQC(qc *flow.QuorumCertificate) error {
 	// current view has changed, go to new view
 	return e.startNewView()
 }
+
+// processTC stores the TC's newest QC, if possible, and checks whether the TC will trigger a view change.
+// If triggered, then go to the new view.
+func (e *EventHandler) processTC(tc *flow.TimeoutCertificate) error {
+	viewChanged, err := e.processTCWithoutViewChange(tc)
+	if err != nil {
+		return err
+	}
+	if !viewChanged {
+		e.log.Debug().Uint64("tc_view", tc.View).Msg("TC didn't trigger view change, nothing to do")
+		return nil
+	}
+	e.log.Debug().Uint64("tc_view", tc.View).Msg("TC triggered view change, starting new view now")
+
+	// current view has changed, go to new view
+	return e.startNewView()
+}
+
+// processTCWithoutViewChange passes the TC to the PaceMaker and returns whether the
+// PaceMaker changed the view. A nil TC is a no-op. The newest QC contained in the TC is
+// added to Forks, if the certified block is known, so that the fork choice can extend it.
+// The caller is responsible for starting the new view.
+func (e *EventHandler) processTCWithoutViewChange(tc *flow.TimeoutCertificate) (bool, error) {
+	if tc == nil {
+		return false, nil
+	}
+	if _, found := e.forks.GetBlock(tc.NewestQC.BlockID); found {
+		err := e.forks.AddQC(tc.NewestQC)
+		if err != nil {
+			return false, fmt.Errorf("cannot add TC's newest QC to forks: %w", err)
+		}
+	}
+	_, viewChanged := e.paceMaker.ProcessTC(tc)
+	return viewChanged, nil
+}
+
+// recordProposal records the outcome of processing the proposal to the flight recorder.
+// The details are empty, if the proposal was added to Forks.
+func (e *EventHandler) recordProposal(proposal *model.Proposal, curView uint64, details string) {
+	e.recorder.Record(model.FlightEvent{
+		Type:     model.FlightEventProposalProcessed,
+		View:     proposal.Block.View,
+		CurView:  curView,
+		BlockID:  proposal.Block.BlockID,
+		OriginID: proposal.Block.ProposerID,
+		QCView:   proposal.Block.QC.View,
+		Details:  details,
+	})
+}
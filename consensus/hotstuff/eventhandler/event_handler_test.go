@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
+	"github.com/onflow/flow-go/consensus/hotstuff/helper"
 	"github.com/onflow/flow-go/consensus/hotstuff/mocks"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/consensus/hotstuff/notifications"
@@ -35,36 +37,34 @@ type TestPaceMaker struct {
 	t require.TestingT
 }
 
-func NewTestPaceMaker(t require.TestingT, startView uint64, timeoutController *timeout.Controller, notifier hotstuff.Consumer) *TestPaceMaker {
-	p, err := pacemaker.New(startView, timeoutController, notifier)
+func NewTestPaceMaker(t require.TestingT, newestQC *flow.QuorumCertificate, timeoutController *timeout.Controller, notifier hotstuff.Consumer) *TestPaceMaker {
+	p, err := pacemaker.New(newestQC, nil, timeoutController, notifier)
 	if err != nil {
 		panic(err)
 	}
 	return &TestPaceMaker{p, t}
 }
 
-func (p *TestPaceMaker) UpdateCurViewWithQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool) {
+func (p *TestPaceMaker) ProcessQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool) {
 	oldView := p.CurView()
-	newView, changed := p.PaceMaker.UpdateCurViewWithQC(qc)
-	log.Info().Msgf("pacemaker.UpdateCurViewWithQC old view: %v, new view: %v\n", oldView, p.CurView())
+	newView, changed := p.PaceMaker.ProcessQC(qc)
+	log.Info().Msgf("pacemaker.ProcessQC old view: %v, new view: %v\n", oldView, p.CurView())
 	return newView, changed
 }
 
-func (p *TestPaceMaker) UpdateCurViewWithBlock(block *model.Block, isLeaderForNextView bool) (*model.NewViewEvent, bool) {
+func (p *TestPaceMaker) ProcessTC(tc *flow.TimeoutCertificate) (*model.NewViewEvent, bool) {
 	oldView := p.CurView()
-	newView, changed := p.PaceMaker.UpdateCurViewWithBlock(block, isLeaderForNextView)
-	log.Info().Msgf("pacemaker.UpdateCurViewWithBlock old view: %v, new view: %v\n", oldView, p.CurView())
+	newView, changed := p.PaceMaker.ProcessTC(tc)
+	log.Info().Msgf("pacemaker.ProcessTC old view: %v, new view: %v\n", oldView, p.CurView())
 	return newView, changed
 }
 
-func (p *TestPaceMaker) OnTimeout() *model.NewViewEvent {
-	oldView := p.CurView()
-	newView := p.PaceMaker.OnTimeout()
-	log.Info().Msgf("pacemaker.OnTimeout old view: %v, new view: %v\n", oldView, p.CurView())
-	return newView
+func (p *TestPaceMaker) OnTimeout() {
+	p.PaceMaker.OnTimeout()
+	log.Info().Msgf("pacemaker.OnTimeout view: %v\n", p.CurView())
 }
 
-// using a real pacemaker for testing event handler
+// using a real pacemaker for testing event handler, starting in the view after the given view
 func initPaceMaker(t require.TestingT, view uint64) hotstuff.PaceMaker {
 	notifier := &mocks.Consumer{}
 	tc, err := timeout.NewConfig(
@@ -77,9 +77,10 @@ func initPaceMaker(t require.TestingT, view uint64) hotstuff.PaceMaker {
 	if err != nil {
 		t.FailNow()
 	}
-	pm := NewTestPaceMaker(t, view, timeout.NewController(tc), notifier)
+	pm := NewTestPaceMaker(t, createQC(createBlock(view-1)), timeout.NewController(tc), notifier)
 	notifier.On("OnStartingTimeout", mock.Anything).Return()
 	notifier.On("OnQcTriggeredViewChange", mock.Anything, mock.Anything).Return()
+	notifier.On("OnTcTriggeredViewChange", mock.Anything, mock.Anything).Return()
 	notifier.On("OnReachedTimeout", mock.Anything).Return()
 	pm.Start()
 	return pm
@@ -149,18 +150,21 @@ func (c *Committee) Self() flow.Identifier {
 	return flow.Identifier{0x01}
 }
 
-// The Voter mock will not vote for any block unless the block's ID exists in votable field's key
+// The Voter mock will not vote for any block unless the block's ID exists in votable field's key.
+// It will time out in any view, unless the view exists in the notTimeoutable field's key.
 type Voter struct {
-	votable       map[flow.Identifier]struct{}
-	lastVotedView uint64
-	t             require.TestingT
+	votable        map[flow.Identifier]struct{}
+	notTimeoutable map[uint64]struct{}
+	lastVotedView  uint64
+	t              require.TestingT
 }
 
 func NewVoter(t require.TestingT, lastVotedView uint64) *Voter {
 	return &Voter{
-		votable:       make(map[flow.Identifier]struct{}),
-		lastVotedView: lastVotedView,
-		t:             t,
+		votable:        make(map[flow.Identifier]struct{}),
+		notTimeoutable: make(map[uint64]struct{}),
+		lastVotedView:  lastVotedView,
+		t:              t,
 	}
 }
 
@@ -173,6 +177,20 @@ func (v *Voter) ProduceVoteIfVotable(block *model.Block, curView uint64) (*model
 	return createVote(block), nil
 }
 
+// voter will produce a timeout for any view, unless the view exists in notTimeoutable map
+func (v *Voter) ProduceTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	_, ok := v.notTimeoutable[curView]
+	if ok {
+		return nil, model.NoTimeoutError{Msg: "not a committee member"}
+	}
+	return &model.TimeoutObject{
+		View:       curView,
+		NewestQC:   newestQC,
+		LastViewTC: lastViewTC,
+		SignerID:   flow.ZeroID,
+	}, nil
+}
+
 // Forks mock allows to customize the Add QC and AddBlock function by specifying the addQC and addBlock callbacks
 type Forks struct {
 	mocks.Forks
@@ -266,8 +284,10 @@ func (f *Forks) MakeForkChoice(curView uint64) (*flow.QuorumCertificate, *model.
 // BlockProducer mock will always make a valid block
 type BlockProducer struct{}
 
-func (b *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, view uint64) (*model.Proposal, error) {
-	return createProposal(view, qc.View), nil
+func (b *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate, view uint64) (*model.Proposal, error) {
+	proposal := createProposal(view, qc.View)
+	proposal.Block.LastViewTC = lastViewTC
+	return proposal, nil
 }
 
 // BlacklistValidator is Validator mock that consider all proposals are valid unless the proposal's BlockID exists
@@ -329,6 +349,7 @@ type EventHandlerSuite struct {
 	voter          *Voter
 	validator      *BlacklistValidator
 	notifier       hotstuff.Consumer
+	recorder       *flightrecorder.Recorder
 
 	initView    uint64
 	endView     uint64
@@ -344,15 +365,21 @@ func (es *EventHandlerSuite) SetupTest() {
 	es.forks = NewForks(es.T(), finalized)
 	es.persist = &mocks.Persister{}
 	es.persist.On("PutStarted", mock.Anything).Return(nil)
+	es.persist.On("PutNewestQC", mock.Anything).Return(nil)
+	es.persist.On("PutNewestTC", mock.Anything).Return(nil)
 	es.blockProducer = &BlockProducer{}
 	es.communicator = &mocks.Communicator{}
 	es.communicator.On("BroadcastProposalWithDelay", mock.Anything, mock.Anything).Return(nil)
 	es.communicator.On("SendVote", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	es.communicator.On("BroadcastTimeout", mock.Anything).Return(nil)
 	es.committee = NewCommittee()
 	es.voteAggregator = &mocks.VoteAggregator{}
 	es.voter = NewVoter(es.T(), finalized)
 	es.validator = NewBlacklistValidator(es.T())
 	es.notifier = &notifications.NoopConsumer{}
+	recorder, err := flightrecorder.New(zerolog.Nop(), flow.ZeroID, flow.Emulator, 1000)
+	require.NoError(es.T(), err)
+	es.recorder = recorder
 
 	eventhandler, err := NewEventHandler(
 		zerolog.New(os.Stderr),
@@ -365,14 +392,15 @@ func (es *EventHandlerSuite) SetupTest() {
 		es.voteAggregator,
 		es.voter,
 		es.validator,
-		es.notifier)
+		es.notifier,
+		es.recorder)
 	require.NoError(es.T(), err)
 
 	es.eventhandler = eventhandler
 
 	es.initView = curView
 	es.endView = curView
-	// voting block is a block for the current view, which will trigger view change
+	// voting block is a block for the current view, a QC for it will trigger view change
 	es.votingBlock = createBlockWithQC(es.paceMaker.CurView(), es.paceMaker.CurView()-1)
 	es.qc = &flow.QuorumCertificate{
 		BlockID:   es.votingBlock.BlockID,
@@ -389,6 +417,17 @@ func (es *EventHandlerSuite) markInvalidProposal(blockID flow.Identifier) {
 	es.validator.invalidProposals[blockID] = struct{}{}
 }
 
+// recorded returns the events of the given type recorded to the flight recorder
+func (es *EventHandlerSuite) recorded(eventType model.FlightEventType) []model.FlightEvent {
+	var events []model.FlightEvent
+	for _, event := range es.recorder.Dump().Events {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
 // a QC for current view triggered view change
 func (es *EventHandlerSuite) TestQCBuiltViewChanged() {
 	// voting block exists
@@ -577,9 +616,7 @@ func (es *EventHandlerSuite) TestInNewView_NotLeader_HasBlock_NotSafeNode_NotNex
 
 	// I'm not the next leader
 	// no vote for this block
-	// goes to the next view
-	es.endView++
-	// not leader for next view
+	// stays in the new view until a QC or TC for it is known
 
 	err := es.eventhandler.OnQCConstructed(qc)
 	require.NoError(es.T(), err, "if a vote can trigger a QC to be built,"+
@@ -597,6 +634,13 @@ func (es *EventHandlerSuite) TestOnReceiveProposal_InvalidProposal_NoViewChange(
 	err := es.eventhandler.OnReceiveProposal(proposal)
 	require.NoError(es.T(), err)
 	require.Equal(es.T(), es.initView, es.paceMaker.CurView(), "incorrect view change")
+
+	// the invalid proposal is recorded together with the reason for dropping it
+	processed := es.recorded(model.FlightEventProposalProcessed)
+	require.Len(es.T(), processed, 1)
+	require.Equal(es.T(), proposal.Block.BlockID, processed[0].BlockID)
+	require.Equal(es.T(), proposal.Block.ProposerID, processed[0].OriginID)
+	require.Contains(es.T(), processed[0].Details, "invalid")
 }
 
 // received a valid proposal that has older view, and cannot build qc from votes for this block,
@@ -636,8 +680,7 @@ func (es *EventHandlerSuite) TestOnReceiveProposal_NewerThanCurView_CannotBuildQ
 	// block 7 triggered view change
 	es.endView++
 
-	// not leader of view 7, go to view 8
-	es.endView++
+	// not leader of view 7, no vote for block 7, stays in view 7
 	err := es.eventhandler.OnReceiveProposal(proposal)
 	require.NoError(es.T(), err)
 	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
@@ -654,8 +697,7 @@ func (es *EventHandlerSuite) TestOnReceiveProposal_NewerThanCurView_CanBuildQCFr
 
 	// trigged view change
 	es.endView++
-	// the proposal is for next view, has block for next view, no vote, trigger view change
-	es.endView++
+	// the proposal is for next view, has block for next view, no vote, no view change
 
 	err := es.eventhandler.OnReceiveProposal(proposal)
 	require.NoError(es.T(), err)
@@ -673,8 +715,7 @@ func (es *EventHandlerSuite) TestOnReceiveProposal_QCNewerThanCurView_CannotBuil
 	// block 8 triggered view change
 	es.endView = es.endView + 2
 
-	// not leader of view 8, go to view 9
-	es.endView++
+	// not leader of view 8, no vote for block 8, stays in view 8
 	err := es.eventhandler.OnReceiveProposal(proposal)
 	require.NoError(es.T(), err)
 	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
@@ -745,21 +786,174 @@ func (es *EventHandlerSuite) TestOnReceiveProposal_Unverifiable() {
 	es.voteAggregator.AssertCalled(es.T(), "AddBlock", proposal)
 }
 
+// a local timeout doesn't trigger view change, but produces and broadcasts a timeout object
 func (es *EventHandlerSuite) TestOnTimeout() {
+	es.voteAggregator.On("AddTimeout", mock.Anything).Return().Once()
+
+	err := es.eventhandler.OnLocalTimeout()
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+
+	es.communicator.AssertNumberOfCalls(es.T(), "BroadcastTimeout", 1)
+	timeout := es.communicator.Calls[len(es.communicator.Calls)-1].Arguments[0].(*model.TimeoutObject)
+	require.Equal(es.T(), es.initView, timeout.View)
+	require.Equal(es.T(), es.initView-1, timeout.NewestQC.View)
+	require.Nil(es.T(), timeout.LastViewTC)
+	es.voteAggregator.AssertCalled(es.T(), "AddTimeout", timeout)
+
+	// both the local timeout and the produced timeout object are recorded
+	localTimeouts := es.recorded(model.FlightEventLocalTimeout)
+	require.Len(es.T(), localTimeouts, 1)
+	require.Equal(es.T(), es.initView, localTimeouts[0].View)
+	produced := es.recorded(model.FlightEventTimeoutProduced)
+	require.Len(es.T(), produced, 1)
+	require.Equal(es.T(), es.initView, produced[0].View)
+	require.Equal(es.T(), es.initView-1, produced[0].QCView)
+}
+
+// a local timeout while not being allowed to time out, doesn't broadcast a timeout object
+func (es *EventHandlerSuite) TestOnTimeout_NoTimeout() {
+	es.voter.notTimeoutable[es.initView] = struct{}{}
+
 	err := es.eventhandler.OnLocalTimeout()
-	// timeout will trigger viewchange
-	es.endView++
 	require.NoError(es.T(), err)
 	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+	es.communicator.AssertNotCalled(es.T(), "BroadcastTimeout", mock.Anything)
+	es.voteAggregator.AssertNotCalled(es.T(), "AddTimeout", mock.Anything)
 }
 
+// repeated local timeouts re-broadcast the timeout object, but don't trigger view change
 func (es *EventHandlerSuite) Test100Timeout() {
+	es.voteAggregator.On("AddTimeout", mock.Anything).Return().Times(100)
 	for i := 0; i < 100; i++ {
 		err := es.eventhandler.OnLocalTimeout()
-		es.endView++
 		require.NoError(es.T(), err)
 	}
 	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+	es.communicator.AssertNumberOfCalls(es.T(), "BroadcastTimeout", 100)
+	es.voteAggregator.AssertExpectations(es.T())
+}
+
+// a TC for the current view triggers view change
+func (es *EventHandlerSuite) TestOnTCConstructed_ViewChanged() {
+	tc := helper.MakeTC(helper.WithTCView(es.initView), helper.WithTCNewestQC(createQC(createBlock(es.initView-1))))
+
+	// view change by the tc
+	es.endView++
+
+	err := es.eventhandler.OnTCConstructed(tc)
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+	require.Equal(es.T(), tc, es.paceMaker.LastViewTC())
+}
+
+// entering a new view persists the newest certificates before the started view
+func (es *EventHandlerSuite) TestOnTCConstructed_PersistsCertificates() {
+	tc := helper.MakeTC(helper.WithTCView(es.initView), helper.WithTCNewestQC(createQC(createBlock(es.initView-1))))
+
+	es.endView++
+
+	err := es.eventhandler.OnTCConstructed(tc)
+	require.NoError(es.T(), err)
+	es.persist.AssertCalled(es.T(), "PutNewestQC", es.paceMaker.NewestQC())
+	es.persist.AssertCalled(es.T(), "PutNewestTC", tc)
+	es.persist.AssertCalled(es.T(), "PutStarted", es.endView)
+}
+
+// a TC for a past view doesn't trigger view change
+func (es *EventHandlerSuite) TestOnTCConstructed_OldTC_NoViewChange() {
+	tc := helper.MakeTC(helper.WithTCView(es.initView-1), helper.WithTCNewestQC(createQC(createBlock(es.initView-2))))
+
+	err := es.eventhandler.OnTCConstructed(tc)
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+	require.Nil(es.T(), es.paceMaker.LastViewTC())
+}
+
+// a TC whose newest QC certifies a known block adds the QC to forks
+func (es *EventHandlerSuite) TestOnTCConstructed_AddsNewestQCToForks() {
+	parent := createBlockWithQC(es.initView, es.initView-1)
+	es.forks.blocks[parent.BlockID] = parent
+	newestQC := createQC(parent)
+	tc := helper.MakeTC(helper.WithTCView(es.initView+1), helper.WithTCNewestQC(newestQC))
+
+	// view change by the tc
+	es.endView += 2
+
+	err := es.eventhandler.OnTCConstructed(tc)
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+	require.Equal(es.T(), newestQC, es.forks.qc)
+	require.Equal(es.T(), newestQC, es.paceMaker.NewestQC())
+}
+
+// as the leader of the view after a TC, the proposal includes the TC
+func (es *EventHandlerSuite) TestOnTCConstructed_IsLeader_ProposalWithTC() {
+	// the block certified by the TC's newest QC is known
+	parent := createBlock(es.initView - 1)
+	es.forks.blocks[parent.BlockID] = parent
+	tc := helper.MakeTC(helper.WithTCView(es.initView), helper.WithTCNewestQC(createQC(parent)))
+
+	// I'm the leader of the view after the TC
+	es.committee.leaders[es.initView+1] = struct{}{}
+	es.endView++
+
+	err := es.eventhandler.OnTCConstructed(tc)
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+
+	lastCall := es.communicator.Calls[len(es.communicator.Calls)-1]
+	require.Equal(es.T(), "BroadcastProposalWithDelay", lastCall.Method)
+	header, ok := lastCall.Arguments[0].(*flow.Header)
+	require.True(es.T(), ok)
+	require.Equal(es.T(), es.endView, header.View)
+	require.Equal(es.T(), parent.BlockID, header.ParentID)
+	require.Equal(es.T(), tc, header.LastViewTC)
+}
+
+// as the leader of the view after a TC, don't propose if the block certified by the TC's newest QC is unknown
+func (es *EventHandlerSuite) TestOnTCConstructed_IsLeader_UnknownNewestQC_NoProposal() {
+	// the block certified by the TC's newest QC is unknown, forks only knows an older QC
+	es.forks.blocks[es.forks.qc.BlockID] = createBlock(es.forks.qc.View)
+	tc := helper.MakeTC(helper.WithTCView(es.initView), helper.WithTCNewestQC(createQC(createBlock(es.initView-1))))
+
+	// I'm the leader of the view after the TC
+	es.committee.leaders[es.initView+1] = struct{}{}
+	es.endView++
+
+	err := es.eventhandler.OnTCConstructed(tc)
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+	es.communicator.AssertNotCalled(es.T(), "BroadcastProposalWithDelay", mock.Anything, mock.Anything)
+}
+
+// received a valid proposal for a future view, whose TC for the last view triggers view change
+func (es *EventHandlerSuite) TestOnReceiveProposal_WithLastViewTC_ViewChange() {
+	proposal := createProposal(es.initView+1, es.initView-1)
+	tc := helper.MakeTC(helper.WithTCView(es.initView), helper.WithTCNewestQC(proposal.Block.QC))
+	proposal.Block.LastViewTC = tc
+	es.voteAggregator.On("AddBlock", proposal).Return(nil).Once()
+	es.voter.votable[proposal.Block.BlockID] = struct{}{}
+
+	// the TC triggers view change to the proposal's view
+	es.endView++
+
+	err := es.eventhandler.OnReceiveProposal(proposal)
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+	require.Equal(es.T(), tc, es.paceMaker.LastViewTC())
+	// the proposal is for the new view, we vote for it
+	es.communicator.AssertCalled(es.T(), "SendVote", proposal.Block.BlockID, proposal.Block.View, mock.Anything, mock.Anything)
+	es.voteAggregator.AssertExpectations(es.T())
+
+	// the view change and the vote are recorded
+	entered := es.recorded(model.FlightEventViewEntered)
+	require.Len(es.T(), entered, 1)
+	require.Equal(es.T(), es.endView, entered[0].View)
+	votes := es.recorded(model.FlightEventVoteProduced)
+	require.Len(es.T(), votes, 1)
+	require.Equal(es.T(), proposal.Block.BlockID, votes[0].BlockID)
+	require.Equal(es.T(), proposal.Block.ProposerID, votes[0].OriginID)
 }
 
 // a leader builds 100 blocks one after another
@@ -809,7 +1003,8 @@ func (es *EventHandlerSuite) TestFollowerFollows100Blocks() {
 		// as a follower, I receive these propsals
 		err := es.eventhandler.OnReceiveProposal(proposal)
 		require.NoError(es.T(), err)
-		es.endView++
+		// each proposal's QC moves the follower to the proposal's view
+		es.endView = proposal.Block.View
 	}
 	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
 	require.Equal(es.T(), 100, len(es.forks.blocks))
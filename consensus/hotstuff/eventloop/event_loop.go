@@ -19,29 +19,35 @@ import (
 // EventLoop buffers all incoming events to the hotstuff EventHandler, and feeds EventHandler one event at a time.
 type EventLoop struct {
 	*component.ComponentManager
-	log                zerolog.Logger
-	eventHandler       hotstuff.EventHandler
-	metrics            module.HotstuffMetrics
-	proposals          chan *model.Proposal
-	quorumCertificates chan *flow.QuorumCertificate
-	startTime          time.Time
+	log                 zerolog.Logger
+	eventHandler        hotstuff.EventHandler
+	recorder            hotstuff.FlightRecorder
+	metrics             module.HotstuffMetrics
+	proposals           chan *model.Proposal
+	quorumCertificates  chan *flow.QuorumCertificate
+	timeoutCertificates chan *flow.TimeoutCertificate
+	startTime           time.Time
 }
 
 var _ hotstuff.EventLoop = (*EventLoop)(nil)
 var _ component.Component = (*EventLoop)(nil)
 
-// NewEventLoop creates an instance of EventLoop.
-func NewEventLoop(log zerolog.Logger, metrics module.HotstuffMetrics, eventHandler hotstuff.EventHandler, startTime time.Time) (*EventLoop, error) {
+// NewEventLoop creates an instance of EventLoop. The arrival of proposals, QCs and TCs is
+// recorded to the given flight recorder.
+func NewEventLoop(log zerolog.Logger, metrics module.HotstuffMetrics, eventHandler hotstuff.EventHandler, recorder hotstuff.FlightRecorder, startTime time.Time) (*EventLoop, error) {
 	proposals := make(chan *model.Proposal)
 	quorumCertificates := make(chan *flow.QuorumCertificate, 1)
+	timeoutCertificates := make(chan *flow.TimeoutCertificate, 1)
 
 	el := &EventLoop{
-		log:                log,
-		eventHandler:       eventHandler,
-		metrics:            metrics,
-		proposals:          proposals,
-		quorumCertificates: quorumCertificates,
-		startTime:          startTime,
+		log:                 log,
+		eventHandler:        eventHandler,
+		recorder:            recorder,
+		metrics:             metrics,
+		proposals:           proposals,
+		quorumCertificates:  quorumCertificates,
+		timeoutCertificates: timeoutCertificates,
+		startTime:           startTime,
 	}
 
 	componentBuilder := component.NewComponentManagerBuilder()
@@ -121,7 +127,7 @@ func (el *EventLoop) loop(ctx context.Context) error {
 
 		idleStart := time.Now()
 
-		// select for block headers/QCs here
+		// select for block headers/QCs/TCs here
 		select {
 
 		// same as before
@@ -184,6 +190,23 @@ func (el *EventLoop) loop(ctx context.Context) error {
 			if err != nil {
 				return fmt.Errorf("could not process QC: %w", err)
 			}
+
+		// if we have a new TC, process it
+		case tc := <-el.timeoutCertificates:
+			// measure how long the event loop was idle waiting for an
+			// incoming event
+			el.metrics.HotStuffIdleDuration(time.Since(idleStart))
+
+			processStart := time.Now()
+
+			err := el.eventHandler.OnTCConstructed(tc)
+
+			// measure how long it takes for a TC to be processed
+			el.metrics.HotStuffBusyDuration(time.Since(processStart), metrics.HotstuffEventTypeOnTC)
+
+			if err != nil {
+				return fmt.Errorf("could not process TC: %w", err)
+			}
 		}
 	}
 }
@@ -193,6 +216,14 @@ func (el *EventLoop) SubmitProposal(proposalHeader *flow.Header, parentView uint
 	received := time.Now()
 
 	proposal := model.ProposalFromFlow(proposalHeader, parentView)
+	el.recorder.Record(model.FlightEvent{
+		Time:     received,
+		Type:     model.FlightEventProposalReceived,
+		View:     proposal.Block.View,
+		BlockID:  proposal.Block.BlockID,
+		OriginID: proposal.Block.ProposerID,
+		QCView:   proposal.Block.QC.View,
+	})
 
 	select {
 	case el.proposals <- proposal:
@@ -208,6 +239,14 @@ func (el *EventLoop) SubmitProposal(proposalHeader *flow.Header, parentView uint
 // SubmitTrustedQC pushes the received QC to the quorumCertificates channel
 func (el *EventLoop) SubmitTrustedQC(qc *flow.QuorumCertificate) {
 	received := time.Now()
+	el.recorder.Record(model.FlightEvent{
+		Time:    received,
+		Type:    model.FlightEventQCReceived,
+		View:    qc.View,
+		BlockID: qc.BlockID,
+		QCView:  qc.View,
+		Signers: len(qc.SignerIDs),
+	})
 
 	select {
 	case el.quorumCertificates <- qc:
@@ -219,3 +258,26 @@ func (el *EventLoop) SubmitTrustedQC(qc *flow.QuorumCertificate) {
 	// received to event handler commencing the processing of the qc
 	el.metrics.HotStuffWaitDuration(time.Since(received), metrics.HotstuffEventTypeOnQC)
 }
+
+// SubmitTrustedTC pushes the received TC to the timeoutCertificates channel
+func (el *EventLoop) SubmitTrustedTC(tc *flow.TimeoutCertificate) {
+	received := time.Now()
+	el.recorder.Record(model.FlightEvent{
+		Time:    received,
+		Type:    model.FlightEventTCReceived,
+		View:    tc.View,
+		BlockID: tc.NewestQC.BlockID,
+		QCView:  tc.NewestQC.View,
+		Signers: len(tc.SignerIDs),
+	})
+
+	select {
+	case el.timeoutCertificates <- tc:
+	case <-el.ComponentManager.ShutdownSignal():
+		return
+	}
+
+	// the wait duration is measured as how long it takes from a tc being
+	// received to event handler commencing the processing of the tc
+	el.metrics.HotStuffWaitDuration(time.Since(received), metrics.HotstuffEventTypeOnTC)
+}
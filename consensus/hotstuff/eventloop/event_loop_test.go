@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/atomic"
 
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
+	"github.com/onflow/flow-go/consensus/hotstuff/helper"
 	"github.com/onflow/flow-go/consensus/hotstuff/mocks"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/module/irrecoverable"
@@ -29,8 +31,9 @@ func TestEventLoop(t *testing.T) {
 type EventLoopTestSuite struct {
 	suite.Suite
 
-	eh     *mocks.EventHandlerV2
-	cancel context.CancelFunc
+	eh       *mocks.EventHandlerV2
+	recorder *mocks.FlightRecorder
+	cancel   context.CancelFunc
 
 	eventLoop *EventLoop
 }
@@ -40,10 +43,11 @@ func (s *EventLoopTestSuite) SetupTest() {
 	s.eh.On("Start").Return(nil).Maybe()
 	s.eh.On("TimeoutChannel").Return(time.NewTimer(10 * time.Second).C).Maybe()
 	s.eh.On("OnLocalTimeout").Return(nil).Maybe()
+	s.recorder = &mocks.FlightRecorder{}
 
 	log := zerolog.New(ioutil.Discard)
 
-	eventLoop, err := NewEventLoop(log, metrics.NewNoopCollector(), s.eh, time.Time{})
+	eventLoop, err := NewEventLoop(log, metrics.NewNoopCollector(), s.eh, s.recorder, time.Time{})
 	require.NoError(s.T(), err)
 	s.eventLoop = eventLoop
 
@@ -78,9 +82,16 @@ func (s *EventLoopTestSuite) Test_SubmitProposal() {
 	s.eh.On("OnReceiveProposal", expectedProposal).Run(func(args mock.Arguments) {
 		processed.Store(true)
 	}).Return(nil).Once()
+	s.recorder.On("Record", mock.MatchedBy(func(event model.FlightEvent) bool {
+		return event.Type == model.FlightEventProposalReceived &&
+			event.View == proposal.View &&
+			event.BlockID == proposal.ID() &&
+			event.OriginID == proposal.ProposerID
+	})).Once()
 	s.eventLoop.SubmitProposal(&proposal, proposal.View-1)
 	require.Eventually(s.T(), processed.Load, time.Millisecond*100, time.Millisecond*10)
 	s.eh.AssertExpectations(s.T())
+	s.recorder.AssertExpectations(s.T())
 }
 
 // Test_SubmitQC tests that submitted QC is eventually sent to event handler for processing
@@ -90,9 +101,34 @@ func (s *EventLoopTestSuite) Test_SubmitQC() {
 	s.eh.On("OnQCConstructed", qc).Run(func(args mock.Arguments) {
 		processed.Store(true)
 	}).Return(nil).Once()
+	s.recorder.On("Record", mock.MatchedBy(func(event model.FlightEvent) bool {
+		return event.Type == model.FlightEventQCReceived &&
+			event.View == qc.View &&
+			event.BlockID == qc.BlockID &&
+			event.Signers == len(qc.SignerIDs)
+	})).Once()
 	s.eventLoop.SubmitTrustedQC(qc)
 	require.Eventually(s.T(), processed.Load, time.Millisecond*100, time.Millisecond*10)
 	s.eh.AssertExpectations(s.T())
+	s.recorder.AssertExpectations(s.T())
+}
+
+// Test_SubmitTC tests that submitted TC is eventually sent to event handler for processing
+func (s *EventLoopTestSuite) Test_SubmitTC() {
+	tc := helper.MakeTC()
+	processed := atomic.NewBool(false)
+	s.eh.On("OnTCConstructed", tc).Run(func(args mock.Arguments) {
+		processed.Store(true)
+	}).Return(nil).Once()
+	s.recorder.On("Record", mock.MatchedBy(func(event model.FlightEvent) bool {
+		return event.Type == model.FlightEventTCReceived &&
+			event.View == tc.View &&
+			event.QCView == tc.NewestQC.View
+	})).Once()
+	s.eventLoop.SubmitTrustedTC(tc)
+	require.Eventually(s.T(), processed.Load, time.Millisecond*100, time.Millisecond*10)
+	s.eh.AssertExpectations(s.T())
+	s.recorder.AssertExpectations(s.T())
 }
 
 // TestEventLoop_Timeout tests that event loop delivers timeout events to event handler under pressure
@@ -102,6 +138,7 @@ func TestEventLoop_Timeout(t *testing.T) {
 	eh.On("Start").Return(nil).Once()
 	eh.On("TimeoutChannel").Return(time.NewTimer(100 * time.Millisecond).C)
 	eh.On("OnQCConstructed", mock.Anything).Return(nil).Maybe()
+	eh.On("OnTCConstructed", mock.Anything).Return(nil).Maybe()
 	eh.On("OnReceiveProposal", mock.Anything).Return(nil).Maybe()
 	eh.On("OnLocalTimeout").Run(func(args mock.Arguments) {
 		processed.Store(true)
@@ -109,7 +146,7 @@ func TestEventLoop_Timeout(t *testing.T) {
 
 	log := zerolog.New(ioutil.Discard)
 
-	eventLoop, err := NewEventLoop(log, metrics.NewNoopCollector(), eh, time.Time{})
+	eventLoop, err := NewEventLoop(log, metrics.NewNoopCollector(), eh, flightrecorder.NewNoopRecorder(), time.Time{})
 	require.NoError(t, err)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -161,7 +198,7 @@ func TestReadyDoneWithStartTime(t *testing.T) {
 
 	startTimeDuration := 2 * time.Second
 	startTime := time.Now().Add(startTimeDuration)
-	eventLoop, err := NewEventLoop(log, metrics, eh, startTime)
+	eventLoop, err := NewEventLoop(log, metrics, eh, flightrecorder.NewNoopRecorder(), startTime)
 	require.NoError(t, err)
 
 	done := make(chan struct{})
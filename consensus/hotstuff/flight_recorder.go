@@ -0,0 +1,16 @@
+package hotstuff
+
+import (
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+)
+
+// FlightRecorder records the consensus events seen by the EventLoop and the EventHandler,
+// so that the progress of consensus can be reconstructed when consensus stalls.
+// Implementations must be concurrency safe and must not block, as they are called on the
+// hot path of the event loop.
+type FlightRecorder interface {
+
+	// Record adds the event to the recorder. The recorder sets the time and the
+	// node and chain ID of the event, if they are not set.
+	Record(event model.FlightEvent)
+}
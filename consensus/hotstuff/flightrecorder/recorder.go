@@ -0,0 +1,226 @@
+package flightrecorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+)
+
+// defaultStreamQueueCapacity is the maximum number of events waiting to be streamed.
+const defaultStreamQueueCapacity = 10000
+
+// Dump is a snapshot of the events held by a Recorder.
+type Dump struct {
+	NodeID   flow.Identifier     `json:"node_id"`
+	ChainID  flow.ChainID        `json:"chain_id"`
+	Capacity int                 `json:"capacity"` // max number of events held by the recorder
+	Recorded uint64              `json:"recorded"` // total number of events recorded, including evicted ones
+	Dropped  uint64              `json:"dropped"`  // total number of events not streamed as the stream fell behind
+	Events   []model.FlightEvent `json:"events"`   // held events in chronological order
+}
+
+// Recorder is the consensus flight recorder. It holds the most recent consensus events in a
+// bounded ring buffer, which can be dumped at any time, e.g. through an admin command.
+// Optionally, every event is also streamed to a writer as a line of JSON, so that the
+// events outlive the ring buffer and the process. Streaming happens on a separate worker
+// of the recorder component, so that slow writes never block the caller of Record.
+type Recorder struct {
+	*component.ComponentManager
+	mu        sync.Mutex
+	log       zerolog.Logger
+	nodeID    flow.Identifier
+	chainID   flow.ChainID
+	events    []model.FlightEvent // ring buffer
+	next      int                 // index of the slot the next event is written to
+	recorded  uint64              // total number of recorded events
+	stream    io.Writer           // nil if events are not streamed
+	queue     chan model.FlightEvent
+	streaming *atomic.Bool   // false once streaming has failed
+	dropped   *atomic.Uint64 // total number of events not streamed as the queue was full
+}
+
+var _ hotstuff.FlightRecorder = (*Recorder)(nil)
+var _ component.Component = (*Recorder)(nil)
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithStream streams every recorded event to the given writer, one JSON object per line.
+// Events are buffered and written asynchronously once the recorder has been started. If
+// the writer falls behind, events are dropped from the stream but still recorded.
+func WithStream(w io.Writer) Option {
+	return func(r *Recorder) {
+		r.stream = w
+	}
+}
+
+// New creates a recorder holding up to capacity events of the given node and chain.
+func New(log zerolog.Logger, nodeID flow.Identifier, chainID flow.ChainID, capacity int, opts ...Option) (*Recorder, error) {
+	if capacity < 1 {
+		return nil, fmt.Errorf("flight recorder capacity must be positive, got %d", capacity)
+	}
+
+	r := &Recorder{
+		log:       log.With().Str("component", "hotstuff_flight_recorder").Logger(),
+		nodeID:    nodeID,
+		chainID:   chainID,
+		events:    make([]model.FlightEvent, 0, capacity),
+		streaming: atomic.NewBool(false),
+		dropped:   atomic.NewUint64(0),
+	}
+	for _, apply := range opts {
+		apply(r)
+	}
+	if r.stream != nil {
+		r.queue = make(chan model.FlightEvent, defaultStreamQueueCapacity)
+		r.streaming.Store(true)
+	}
+
+	r.ComponentManager = component.NewComponentManagerBuilder().
+		AddWorker(r.streamingLoop).
+		Build()
+
+	return r, nil
+}
+
+// Record adds the event to the ring buffer, evicting the oldest event if the buffer is full,
+// and streams it if configured. The time and the node and chain ID are set, if missing.
+func (r *Recorder) Record(event model.FlightEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	event.Time = event.Time.UTC()
+	if event.NodeID == flow.ZeroID {
+		event.NodeID = r.nodeID
+	}
+	if event.ChainID == "" {
+		event.ChainID = r.chainID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.events) < cap(r.events) {
+		r.events = append(r.events, event)
+	} else {
+		r.events[r.next] = event
+	}
+	r.next = (r.next + 1) % cap(r.events)
+	r.recorded++
+
+	if !r.streaming.Load() {
+		return
+	}
+	// queue the event without blocking, the events are queued in the same order as
+	// they are added to the ring buffer, as we hold the lock
+	select {
+	case r.queue <- event:
+	default:
+		r.dropped.Inc()
+	}
+}
+
+// streamingLoop writes the queued events to the stream until the recorder is shut down,
+// at which point the remaining queued events are written. The writes are buffered and
+// flushed whenever the queue is empty.
+func (r *Recorder) streamingLoop(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+
+	if r.stream == nil {
+		<-ctx.Done()
+		return
+	}
+
+	writer := bufio.NewWriter(r.stream)
+	encoder := json.NewEncoder(writer)
+	var reported uint64 // number of dropped events already logged
+	write := func(event model.FlightEvent) bool {
+		err := encoder.Encode(event)
+		if err == nil && len(r.queue) == 0 {
+			err = writer.Flush()
+		}
+		if err != nil {
+			// we don't want a broken stream to affect consensus, so we stop streaming and
+			// keep recording to the ring buffer only
+			r.log.Error().Err(err).Msg("could not stream flight event, streaming disabled")
+			r.streaming.Store(false)
+			return false
+		}
+		if dropped := r.dropped.Load(); dropped > reported {
+			r.log.Warn().
+				Uint64("dropped", dropped-reported).
+				Uint64("total_dropped", dropped).
+				Msg("flight event stream fell behind, events were not streamed")
+			reported = dropped
+		}
+		return true
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			// write the events queued before the shutdown
+			for {
+				select {
+				case event := <-r.queue:
+					if !write(event) {
+						return
+					}
+				default:
+					return
+				}
+			}
+		case event := <-r.queue:
+			if !write(event) {
+				return
+			}
+		}
+	}
+}
+
+// Dump returns a copy of the held events in chronological order.
+func (r *Recorder) Dump() *Dump {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make([]model.FlightEvent, 0, len(r.events))
+	if len(r.events) < cap(r.events) {
+		events = append(events, r.events...)
+	} else {
+		// the buffer is full, so the oldest event is the one to be overwritten next
+		events = append(events, r.events[r.next:]...)
+		events = append(events, r.events[:r.next]...)
+	}
+
+	return &Dump{
+		NodeID:   r.nodeID,
+		ChainID:  r.chainID,
+		Capacity: cap(r.events),
+		Recorded: r.recorded,
+		Dropped:  r.dropped.Load(),
+		Events:   events,
+	}
+}
+
+// NoopRecorder is a flight recorder which discards all events.
+type NoopRecorder struct{}
+
+var _ hotstuff.FlightRecorder = (*NoopRecorder)(nil)
+
+func NewNoopRecorder() *NoopRecorder {
+	return &NoopRecorder{}
+}
+
+func (*NoopRecorder) Record(model.FlightEvent) {}
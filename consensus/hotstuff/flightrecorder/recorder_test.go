@@ -0,0 +1,163 @@
+package flightrecorder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRecorder_RingBuffer checks that the recorder holds the most recent events in
+// chronological order and evicts the oldest ones.
+func TestRecorder_RingBuffer(t *testing.T) {
+	nodeID := unittest.IdentifierFixture()
+	recorder, err := New(zerolog.Nop(), nodeID, flow.Emulator, 3)
+	require.NoError(t, err)
+
+	dump := recorder.Dump()
+	assert.Empty(t, dump.Events)
+	assert.Equal(t, 3, dump.Capacity)
+
+	for view := uint64(1); view <= 2; view++ {
+		recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: view})
+	}
+	dump = recorder.Dump()
+	require.Len(t, dump.Events, 2)
+	assert.Equal(t, uint64(1), dump.Events[0].View)
+	assert.Equal(t, uint64(2), dump.Events[1].View)
+
+	for view := uint64(3); view <= 7; view++ {
+		recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: view})
+	}
+	dump = recorder.Dump()
+	assert.Equal(t, nodeID, dump.NodeID)
+	assert.Equal(t, flow.Emulator, dump.ChainID)
+	assert.Equal(t, uint64(7), dump.Recorded)
+	require.Len(t, dump.Events, 3)
+	for i, event := range dump.Events {
+		assert.Equal(t, uint64(5+i), event.View)
+		assert.Equal(t, nodeID, event.NodeID)
+		assert.Equal(t, flow.Emulator, event.ChainID)
+		assert.False(t, event.Time.IsZero())
+	}
+
+	// a dump is a copy, which isn't affected by later events
+	recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: 8})
+	assert.Equal(t, uint64(5), dump.Events[0].View)
+}
+
+// TestRecorder_InvalidCapacity checks that a recorder can't be created without capacity.
+func TestRecorder_InvalidCapacity(t *testing.T) {
+	_, err := New(zerolog.Nop(), unittest.IdentifierFixture(), flow.Emulator, 0)
+	require.Error(t, err)
+}
+
+// TestRecorder_Stream checks that every recorded event is streamed as a line of JSON.
+func TestRecorder_Stream(t *testing.T) {
+	var buf bytes.Buffer
+	recorder, err := New(zerolog.Nop(), unittest.IdentifierFixture(), flow.Emulator, 1, WithStream(&buf))
+	require.NoError(t, err)
+	cancel := startRecorder(t, recorder)
+
+	blockID := unittest.IdentifierFixture()
+	recorder.Record(model.FlightEvent{Type: model.FlightEventProposalReceived, View: 10, BlockID: blockID})
+	recorder.Record(model.FlightEvent{Type: model.FlightEventLocalTimeout, View: 11})
+
+	// events queued before the shutdown are written when the recorder stops
+	cancel()
+	unittest.RequireCloseBefore(t, recorder.Done(), time.Second, "recorder should stop")
+
+	var streamed []model.FlightEvent
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var event model.FlightEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		streamed = append(streamed, event)
+	}
+	require.Len(t, streamed, 2)
+	assert.Equal(t, model.FlightEventProposalReceived, streamed[0].Type)
+	assert.Equal(t, blockID, streamed[0].BlockID)
+	assert.Equal(t, model.FlightEventLocalTimeout, streamed[1].Type)
+
+	// only the ring buffer is bounded
+	assert.Len(t, recorder.Dump().Events, 1)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+// TestRecorder_StreamFailure checks that a failing stream doesn't prevent recording.
+func TestRecorder_StreamFailure(t *testing.T) {
+	recorder, err := New(zerolog.Nop(), unittest.IdentifierFixture(), flow.Emulator, 2, WithStream(failingWriter{}))
+	require.NoError(t, err)
+	cancel := startRecorder(t, recorder)
+	defer cancel()
+
+	recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: 1})
+	require.Eventually(t, func() bool {
+		return !recorder.streaming.Load()
+	}, time.Second, 10*time.Millisecond, "streaming should be disabled")
+
+	recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: 2})
+	assert.Len(t, recorder.Dump().Events, 2)
+}
+
+type blockingWriter struct {
+	unblock chan struct{}
+}
+
+func (w blockingWriter) Write(p []byte) (int, error) {
+	<-w.unblock
+	return len(p), nil
+}
+
+// TestRecorder_SlowStream checks that a slow stream doesn't block recording: events which
+// don't fit into the stream queue are dropped from the stream, but still recorded.
+func TestRecorder_SlowStream(t *testing.T) {
+	writer := blockingWriter{unblock: make(chan struct{})}
+	recorder, err := New(zerolog.Nop(), unittest.IdentifierFixture(), flow.Emulator, 2, WithStream(writer))
+	require.NoError(t, err)
+	cancel := startRecorder(t, recorder)
+
+	recorded := make(chan struct{})
+	go func() {
+		for view := uint64(1); view <= 2*defaultStreamQueueCapacity; view++ {
+			recorder.Record(model.FlightEvent{Type: model.FlightEventViewEntered, View: view})
+		}
+		close(recorded)
+	}()
+	unittest.RequireCloseBefore(t, recorded, time.Second, "recording should not block on the stream")
+	dump := recorder.Dump()
+	assert.Equal(t, uint64(2*defaultStreamQueueCapacity), dump.Recorded)
+	assert.Greater(t, dump.Dropped, uint64(0))
+
+	// the dropped events are counted even after the stream caught up
+	close(writer.unblock)
+	cancel()
+	unittest.RequireCloseBefore(t, recorder.Done(), time.Second, "recorder should stop")
+	assert.Equal(t, dump.Dropped, recorder.Dump().Dropped)
+}
+
+// startRecorder starts the recorder and returns the function to shut it down.
+func startRecorder(t *testing.T, recorder *Recorder) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx, _ := irrecoverable.WithSignaler(ctx)
+	recorder.Start(signalerCtx)
+	unittest.RequireCloseBefore(t, recorder.Ready(), time.Second, "recorder should start")
+	return cancel
+}
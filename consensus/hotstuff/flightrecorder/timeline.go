@@ -0,0 +1,64 @@
+package flightrecorder
+
+import (
+	"sort"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ViewTimeline holds the events of all replicas for a single view of a chain.
+type ViewTimeline struct {
+	ChainID flow.ChainID        `json:"chain_id"`
+	View    uint64              `json:"view"`
+	Events  []model.FlightEvent `json:"events"` // events ordered by time
+}
+
+// Timeline merges the events recorded by several replicas into one timeline per view,
+// ordered by chain and view. Within a view, events are ordered by their time. As the
+// times are taken from the clocks of the individual replicas, the order of events of
+// different replicas is only as accurate as the clocks are synchronized.
+// Events, which are contained more than once in the input (e.g. in a dump and in the
+// stream of the same replica), are only included once.
+func Timeline(events []model.FlightEvent) []*ViewTimeline {
+
+	type viewKey struct {
+		chainID flow.ChainID
+		view    uint64
+	}
+
+	seen := make(map[model.FlightEvent]struct{}, len(events))
+	byView := make(map[viewKey]*ViewTimeline)
+	for _, event := range events {
+		// normalize the time, so that equal events compare equal regardless of how they were decoded
+		event.Time = event.Time.UTC()
+		if _, duplicate := seen[event]; duplicate {
+			continue
+		}
+		seen[event] = struct{}{}
+
+		key := viewKey{chainID: event.ChainID, view: event.View}
+		timeline, ok := byView[key]
+		if !ok {
+			timeline = &ViewTimeline{ChainID: event.ChainID, View: event.View}
+			byView[key] = timeline
+		}
+		timeline.Events = append(timeline.Events, event)
+	}
+
+	timelines := make([]*ViewTimeline, 0, len(byView))
+	for _, timeline := range byView {
+		sort.SliceStable(timeline.Events, func(i, j int) bool {
+			return timeline.Events[i].Time.Before(timeline.Events[j].Time)
+		})
+		timelines = append(timelines, timeline)
+	}
+	sort.Slice(timelines, func(i, j int) bool {
+		if timelines[i].ChainID != timelines[j].ChainID {
+			return timelines[i].ChainID < timelines[j].ChainID
+		}
+		return timelines[i].View < timelines[j].View
+	})
+
+	return timelines
+}
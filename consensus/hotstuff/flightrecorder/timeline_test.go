@@ -0,0 +1,63 @@
+package flightrecorder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestTimeline checks that the events of several replicas are merged into one timeline per
+// view, ordered by time, and that duplicate events are dropped.
+func TestTimeline(t *testing.T) {
+	node1 := unittest.IdentifierFixture()
+	node2 := unittest.IdentifierFixture()
+	start := time.Now().UTC()
+
+	at := func(offset int, nodeID flow.Identifier, chainID flow.ChainID, view uint64, eventType model.FlightEventType) model.FlightEvent {
+		return model.FlightEvent{
+			Time:    start.Add(time.Duration(offset) * time.Millisecond),
+			NodeID:  nodeID,
+			ChainID: chainID,
+			View:    view,
+			Type:    eventType,
+		}
+	}
+
+	dump1 := []model.FlightEvent{
+		at(0, node1, flow.Emulator, 5, model.FlightEventViewEntered),
+		at(30, node1, flow.Emulator, 5, model.FlightEventVoteProduced),
+		at(40, node1, flow.Emulator, 6, model.FlightEventViewEntered),
+	}
+	dump2 := []model.FlightEvent{
+		at(10, node2, flow.Emulator, 5, model.FlightEventViewEntered),
+		at(20, node2, flow.Emulator, 5, model.FlightEventProposalReceived),
+		at(5, node2, flow.Localnet, 5, model.FlightEventViewEntered),
+	}
+	// the stream of node 1 overlaps with its dump
+	stream1 := dump1[1:]
+
+	var events []model.FlightEvent
+	events = append(events, dump1...)
+	events = append(events, dump2...)
+	events = append(events, stream1...)
+
+	timelines := Timeline(events)
+	require.Len(t, timelines, 3)
+
+	assert.Equal(t, flow.Emulator, timelines[0].ChainID)
+	assert.Equal(t, uint64(5), timelines[0].View)
+	assert.Equal(t, []model.FlightEvent{dump1[0], dump2[0], dump2[1], dump1[1]}, timelines[0].Events)
+
+	assert.Equal(t, flow.Emulator, timelines[1].ChainID)
+	assert.Equal(t, uint64(6), timelines[1].View)
+	assert.Equal(t, []model.FlightEvent{dump1[2]}, timelines[1].Events)
+
+	assert.Equal(t, flow.Localnet, timelines[2].ChainID)
+	assert.Equal(t, []model.FlightEvent{dump2[2]}, timelines[2].Events)
+}
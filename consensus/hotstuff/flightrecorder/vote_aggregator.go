@@ -0,0 +1,59 @@
+package flightrecorder
+
+import (
+	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// VoteAggregator records the votes and timeout objects received from other replicas in the
+// flight recorder, before passing them on to the wrapped vote aggregator. Our own votes and
+// timeouts are recorded by the event handler when they are produced.
+type VoteAggregator struct {
+	hotstuff.VoteAggregator
+	recorder hotstuff.FlightRecorder
+	localID  flow.Identifier
+}
+
+var _ hotstuff.VoteAggregator = (*VoteAggregator)(nil)
+
+// NewVoteAggregator wraps the given vote aggregator, so that the votes and timeouts
+// of all replicas other than localID are recorded.
+func NewVoteAggregator(aggregator hotstuff.VoteAggregator, recorder hotstuff.FlightRecorder, localID flow.Identifier) *VoteAggregator {
+	return &VoteAggregator{
+		VoteAggregator: aggregator,
+		recorder:       recorder,
+		localID:        localID,
+	}
+}
+
+// AddVote records the vote, unless it is our own, and passes it on to the vote aggregator.
+func (a *VoteAggregator) AddVote(vote *model.Vote) {
+	if vote.SignerID != a.localID {
+		a.recorder.Record(model.FlightEvent{
+			Type:     model.FlightEventVoteReceived,
+			View:     vote.View,
+			BlockID:  vote.BlockID,
+			OriginID: vote.SignerID,
+		})
+	}
+	a.VoteAggregator.AddVote(vote)
+}
+
+// AddTimeout records the timeout object, unless it is our own, and passes it on to the
+// vote aggregator.
+func (a *VoteAggregator) AddTimeout(timeout *model.TimeoutObject) {
+	if timeout.SignerID != a.localID {
+		event := model.FlightEvent{
+			Type:     model.FlightEventTimeoutReceived,
+			View:     timeout.View,
+			OriginID: timeout.SignerID,
+		}
+		// the timeout is not validated yet, so the QC might be missing
+		if timeout.NewestQC != nil {
+			event.QCView = timeout.NewestQC.View
+		}
+		a.recorder.Record(event)
+	}
+	a.VoteAggregator.AddTimeout(timeout)
+}
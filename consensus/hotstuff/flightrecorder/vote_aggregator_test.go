@@ -0,0 +1,59 @@
+package flightrecorder
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/helper"
+	"github.com/onflow/flow-go/consensus/hotstuff/mocks"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestVoteAggregator_RecordsReceivedMessages checks that the votes and timeouts of other replicas
+// are recorded with their origin, while our own ones are not, and that all of them are passed on
+// to the wrapped vote aggregator.
+func TestVoteAggregator_RecordsReceivedMessages(t *testing.T) {
+	localID := unittest.IdentifierFixture()
+	peerID := unittest.IdentifierFixture()
+
+	recorder, err := New(zerolog.Nop(), localID, flow.Emulator, 10)
+	require.NoError(t, err)
+	wrapped := &mocks.VoteAggregator{}
+	aggregator := NewVoteAggregator(wrapped, recorder, localID)
+
+	peerVote := &model.Vote{View: 10, BlockID: unittest.IdentifierFixture(), SignerID: peerID}
+	ownVote := &model.Vote{View: 10, BlockID: unittest.IdentifierFixture(), SignerID: localID}
+	peerTimeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectSignerID(peerID), helper.WithTimeoutObjectView(11))
+	ownTimeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectSignerID(localID), helper.WithTimeoutObjectView(11))
+	for _, vote := range []*model.Vote{peerVote, ownVote} {
+		wrapped.On("AddVote", vote).Once()
+	}
+	for _, timeout := range []*model.TimeoutObject{peerTimeout, ownTimeout} {
+		wrapped.On("AddTimeout", timeout).Once()
+	}
+
+	aggregator.AddVote(peerVote)
+	aggregator.AddVote(ownVote)
+	aggregator.AddTimeout(peerTimeout)
+	aggregator.AddTimeout(ownTimeout)
+	wrapped.AssertExpectations(t)
+
+	events := recorder.Dump().Events
+	require.Len(t, events, 2)
+
+	assert.Equal(t, model.FlightEventVoteReceived, events[0].Type)
+	assert.Equal(t, peerVote.View, events[0].View)
+	assert.Equal(t, peerVote.BlockID, events[0].BlockID)
+	assert.Equal(t, peerID, events[0].OriginID)
+	assert.Equal(t, localID, events[0].NodeID)
+
+	assert.Equal(t, model.FlightEventTimeoutReceived, events[1].Type)
+	assert.Equal(t, peerTimeout.View, events[1].View)
+	assert.Equal(t, peerTimeout.NewestQC.View, events[1].QCView)
+	assert.Equal(t, peerID, events[1].OriginID)
+}
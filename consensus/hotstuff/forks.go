@@ -51,6 +51,10 @@ type Forks interface {
 	// Note that tracking the view of the newest qc is for safety purposes
 	// and _independent_ of the fork-choice rule.
 	MakeForkChoice(curView uint64) (*flow.QuorumCertificate, *model.Block, error)
+
+	// NewestQC returns the QC with the largest view that Forks has processed. This is the QC
+	// which the fork choice rule selects for building the next block.
+	NewestQC() *flow.QuorumCertificate
 }
 
 // ForksReader only reads the forks' state
@@ -22,4 +22,7 @@ type ForkChoice interface {
 	// should result in the PaceMaker being in view v+1 or larger. Hence, given
 	// that the current View is curView, all QCs should have view < curView
 	MakeForkChoice(curView uint64) (*flow.QuorumCertificate, *model.Block, error)
+
+	// NewestQC returns the QC with the largest view that the ForkChoice has processed.
+	NewestQC() *flow.QuorumCertificate
 }
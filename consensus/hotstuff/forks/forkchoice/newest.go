@@ -78,6 +78,12 @@ func (fc *NewestForkChoice) MakeForkChoice(curView uint64) (*flow.QuorumCertific
 	return choice.QC, choice.Block, nil
 }
 
+// NewestQC returns the QC with the largest view number seen, which is the QC
+// that MakeForkChoice selects.
+func (fc *NewestForkChoice) NewestQC() *flow.QuorumCertificate {
+	return fc.preferredParent.QC
+}
+
 // AddQC updates `preferredParent` according to the fork-choice rule.
 // Currently, we implement 'Chained HotStuff Protocol' where the fork-choice
 // rule is: "build on newest QC"
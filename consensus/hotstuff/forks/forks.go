@@ -2,6 +2,7 @@ package forks
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
@@ -9,7 +10,11 @@ import (
 )
 
 // Forks implements the hotstuff.Reactor API
+// Forks is concurrency safe: besides the EventLoop, the timeout collectors of the
+// VoteAggregator read Forks when validating timeouts. Notifications and the finalization
+// callback are emitted while holding the lock, hence they must not call back into Forks.
 type Forks struct {
+	lock       sync.RWMutex
 	finalizer  Finalizer
 	forkchoice ForkChoice
 }
@@ -26,26 +31,36 @@ func New(finalizer Finalizer, forkchoice ForkChoice) *Forks {
 
 // GetBlocksForView returns all the blocks for a certain view.
 func (f *Forks) GetBlocksForView(view uint64) []*model.Block {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
 	return f.finalizer.GetBlocksForView(view)
 }
 
 // GetBlock returns the block for the given block ID
 func (f *Forks) GetBlock(id flow.Identifier) (*model.Block, bool) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
 	return f.finalizer.GetBlock(id)
 }
 
 // FinalizedBlock returns the latest finalized block
 func (f *Forks) FinalizedBlock() *model.Block {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
 	return f.finalizer.FinalizedBlock()
 }
 
 // FinalizedView returns the view of the latest finalized block
 func (f *Forks) FinalizedView() uint64 {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
 	return f.finalizer.FinalizedBlock().View
 }
 
 // IsSafeBlock returns whether a block is safe to vote for.
 func (f *Forks) IsSafeBlock(block *model.Block) bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
 	if err := f.finalizer.VerifyBlock(block); err != nil {
 		return false
 	}
@@ -55,6 +70,8 @@ func (f *Forks) IsSafeBlock(block *model.Block) bool {
 // AddBlock passes the block to the finalizer for finalization and
 // gives the QC to forkchoice for updating the preferred parent block
 func (f *Forks) AddBlock(block *model.Block) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	if err := f.finalizer.VerifyBlock(block); err != nil {
 		// technically, this not strictly required. However, we leave this as a sanity check for now
 		return fmt.Errorf("cannot add invalid block to Forks: %w", err)
@@ -70,16 +87,27 @@ func (f *Forks) AddBlock(block *model.Block) error {
 	if block.View <= f.finalizer.FinalizedBlock().View {
 		return nil
 	}
-	return f.AddQC(block.QC)
+	return f.forkchoice.AddQC(block.QC)
 }
 
 // MakeForkChoice returns the block to build new block proposal from for the current view.
 // the QC is the QC that points to that block.
 func (f *Forks) MakeForkChoice(curView uint64) (*flow.QuorumCertificate, *model.Block, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
 	return f.forkchoice.MakeForkChoice(curView)
 }
 
+// NewestQC returns the QC with the largest view that Forks has processed.
+func (f *Forks) NewestQC() *flow.QuorumCertificate {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.forkchoice.NewestQC()
+}
+
 // AddQC gives the QC to the forkchoice for updating the preferred parent block
 func (f *Forks) AddQC(qc *flow.QuorumCertificate) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	return f.forkchoice.AddQC(qc) // forkchoice ensures that block referenced by qc is known
 }
@@ -1,10 +1,14 @@
 package helper
 
 import (
+	"sync"
+
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/atomic"
 
+	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/mocks"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
@@ -44,3 +48,52 @@ func MakeRandomBeaconReconstructor(minRequiredShares int) *mocks.RandomBeaconRec
 	rbRector.On("Reconstruct").Return(unittest.SignatureFixture(), nil).Maybe()
 	return rbRector
 }
+
+// MakeTimeoutSignatureAggregator creates a TimeoutSignatureAggregator mock for the given view, which
+// accepts any signature and accounts each signer with the given weight.
+func MakeTimeoutSignatureAggregator(view uint64, sigWeight uint64) *mocks.TimeoutSignatureAggregator {
+	aggregator := &mocks.TimeoutSignatureAggregator{}
+	var lock sync.Mutex
+	signers := make(map[flow.Identifier]uint64)
+	totalWeight := func() uint64 {
+		return uint64(len(signers)) * sigWeight
+	}
+	aggregator.On("View").Return(view).Maybe()
+	aggregator.On("VerifyAndAdd", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(signerID flow.Identifier, sig crypto.Signature, newestQCView uint64) uint64 {
+			lock.Lock()
+			defer lock.Unlock()
+			if _, found := signers[signerID]; !found {
+				signers[signerID] = newestQCView
+			}
+			return totalWeight()
+		},
+		func(signerID flow.Identifier, sig crypto.Signature, newestQCView uint64) error {
+			lock.Lock()
+			defer lock.Unlock()
+			if qcView, found := signers[signerID]; found && qcView != newestQCView {
+				return model.NewDuplicatedSignerErrorf("signer %v already added", signerID)
+			}
+			return nil
+		}).Maybe()
+	aggregator.On("TotalWeight").Return(func() uint64 {
+		lock.Lock()
+		defer lock.Unlock()
+		return totalWeight()
+	}).Maybe()
+	aggregator.On("Aggregate").Return(
+		func() []hotstuff.TimeoutSignerInfo {
+			lock.Lock()
+			defer lock.Unlock()
+			signersInfo := make([]hotstuff.TimeoutSignerInfo, 0, len(signers))
+			for signerID, newestQCView := range signers {
+				signersInfo = append(signersInfo, hotstuff.TimeoutSignerInfo{NewestQCView: newestQCView, Signer: signerID})
+			}
+			return signersInfo
+		},
+		func() crypto.Signature {
+			return unittest.SignatureFixture()
+		},
+		nil).Maybe()
+	return aggregator
+}
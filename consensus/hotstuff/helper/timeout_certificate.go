@@ -0,0 +1,93 @@
+package helper
+
+import (
+	"math/rand"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func MakeTC(options ...func(*flow.TimeoutCertificate)) *flow.TimeoutCertificate {
+	qc := MakeQC()
+	signers := unittest.IdentityListFixture(7).NodeIDs()
+	newestQCViews := make([]uint64, 0, len(signers))
+	for range signers {
+		newestQCViews = append(newestQCViews, qc.View)
+	}
+	tc := flow.TimeoutCertificate{
+		View:          rand.Uint64(),
+		NewestQC:      qc,
+		NewestQCViews: newestQCViews,
+		SignerIDs:     signers,
+		SigData:       unittest.SignatureFixture(),
+	}
+	for _, option := range options {
+		option(&tc)
+	}
+	return &tc
+}
+
+func WithTCNewestQC(qc *flow.QuorumCertificate) func(*flow.TimeoutCertificate) {
+	return func(tc *flow.TimeoutCertificate) {
+		tc.NewestQC = qc
+		for i := range tc.NewestQCViews {
+			tc.NewestQCViews[i] = qc.View
+		}
+	}
+}
+
+func WithTCSigners(signerIDs []flow.Identifier) func(*flow.TimeoutCertificate) {
+	return func(tc *flow.TimeoutCertificate) {
+		tc.SignerIDs = signerIDs
+		tc.NewestQCViews = make([]uint64, 0, len(signerIDs))
+		for range signerIDs {
+			tc.NewestQCViews = append(tc.NewestQCViews, tc.NewestQC.View)
+		}
+	}
+}
+
+func WithTCView(view uint64) func(*flow.TimeoutCertificate) {
+	return func(tc *flow.TimeoutCertificate) {
+		tc.View = view
+	}
+}
+
+func TimeoutObjectFixture(opts ...func(*model.TimeoutObject)) *model.TimeoutObject {
+	timeout := &model.TimeoutObject{
+		View:     rand.Uint64(),
+		NewestQC: MakeQC(),
+		SignerID: unittest.IdentifierFixture(),
+		SigData:  unittest.SignatureFixture(),
+	}
+
+	for _, opt := range opts {
+		opt(timeout)
+	}
+
+	return timeout
+}
+
+func WithTimeoutObjectView(view uint64) func(*model.TimeoutObject) {
+	return func(timeout *model.TimeoutObject) {
+		timeout.View = view
+	}
+}
+
+func WithTimeoutNewestQC(newestQC *flow.QuorumCertificate) func(*model.TimeoutObject) {
+	return func(timeout *model.TimeoutObject) {
+		timeout.NewestQC = newestQC
+	}
+}
+
+func WithTimeoutLastViewTC(lastViewTC *flow.TimeoutCertificate) func(*model.TimeoutObject) {
+	return func(timeout *model.TimeoutObject) {
+		timeout.LastViewTC = lastViewTC
+	}
+}
+
+func WithTimeoutObjectSignerID(signerID flow.Identifier) func(*model.TimeoutObject) {
+	return func(timeout *model.TimeoutObject) {
+		timeout.SignerID = signerID
+	}
+}
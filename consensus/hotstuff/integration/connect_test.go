@@ -97,6 +97,34 @@ func Connect(instances []*Instance) {
 				// submit the vote to the receiving event loop (non-blocking)
 				receiver.queue <- vote
 
+				return nil
+			},
+		)
+		sender.communicator.On("BroadcastTimeout", mock.Anything).Return(
+			func(timeout *model.TimeoutObject) error {
+
+				// check if we should block the outgoing timeout
+				if sender.timeoutOut(timeout) {
+					return nil
+				}
+
+				// iterate through potential receivers
+				for _, receiver := range instances {
+
+					// we should skip ourselves always
+					if receiver.localID == sender.localID {
+						continue
+					}
+
+					// check if we should block the incoming timeout
+					if receiver.timeoutIn(timeout) {
+						continue
+					}
+
+					// submit the timeout to the receiving event loop (non-blocking)
+					receiver.queue <- timeout
+				}
+
 				return nil
 			},
 		)
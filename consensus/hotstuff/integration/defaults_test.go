@@ -18,10 +18,6 @@ func DefaultRoot() *flow.Header {
 	return header
 }
 
-func DefaultStart() uint64 {
-	return 1
-}
-
 func DefaultPruned() uint64 {
 	return 0
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/blockproducer"
 	"github.com/onflow/flow-go/consensus/hotstuff/eventhandler"
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
 	"github.com/onflow/flow-go/consensus/hotstuff/forks"
 	"github.com/onflow/flow-go/consensus/hotstuff/forks/finalizer"
 	"github.com/onflow/flow-go/consensus/hotstuff/forks/forkchoice"
@@ -24,6 +25,7 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff/pacemaker"
 	"github.com/onflow/flow-go/consensus/hotstuff/pacemaker/timeout"
 	hsig "github.com/onflow/flow-go/consensus/hotstuff/signature"
+	"github.com/onflow/flow-go/consensus/hotstuff/timeoutcollector"
 	"github.com/onflow/flow-go/consensus/hotstuff/validator"
 	"github.com/onflow/flow-go/consensus/hotstuff/voteaggregator"
 	"github.com/onflow/flow-go/consensus/hotstuff/votecollector"
@@ -44,6 +46,8 @@ type Instance struct {
 	blockVoteOut VoteFilter
 	blockPropIn  ProposalFilter
 	blockPropOut ProposalFilter
+	timeoutIn    TimeoutObjectFilter
+	timeoutOut   TimeoutObjectFilter
 	stop         Condition
 
 	// instance data
@@ -88,6 +92,8 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 		OutgoingVotes:     BlockNoVotes,
 		IncomingProposals: BlockNoProposals,
 		OutgoingProposals: BlockNoProposals,
+		IncomingTimeouts:  BlockNoTimeouts,
+		OutgoingTimeouts:  BlockNoTimeouts,
 		StopCondition:     RightAway,
 	}
 
@@ -118,6 +124,8 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 		blockVoteOut: cfg.OutgoingVotes,
 		blockPropIn:  cfg.IncomingProposals,
 		blockPropOut: cfg.OutgoingProposals,
+		timeoutIn:    cfg.IncomingTimeouts,
+		timeoutOut:   cfg.OutgoingTimeouts,
 		stop:         cfg.StopCondition,
 
 		// instance data
@@ -145,8 +153,15 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 		},
 		nil,
 	)
+	in.committee.On("IdentitiesByEpoch", mock.Anything, mock.Anything).Return(
+		func(view uint64, selector flow.IdentityFilter) flow.IdentityList {
+			return in.participants.Filter(selector)
+		},
+		nil,
+	)
 	for _, participant := range in.participants {
 		in.committee.On("Identity", mock.Anything, participant.NodeID).Return(participant, nil)
+		in.committee.On("IdentityByEpoch", mock.Anything, participant.NodeID).Return(participant, nil)
 	}
 	in.committee.On("Self").Return(in.localID)
 	in.committee.On("LeaderForView", mock.Anything).Return(
@@ -189,6 +204,8 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 
 	// check on stop condition, stop the tests as soon as entering a certain view
 	in.persist.On("PutStarted", mock.Anything).Return(nil)
+	in.persist.On("PutNewestQC", mock.Anything).Return(nil)
+	in.persist.On("PutNewestTC", mock.Anything).Return(nil)
 	in.persist.On("PutVoted", mock.Anything).Return(nil)
 
 	// program the hotstuff signer behaviour
@@ -214,6 +231,19 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 		},
 		nil,
 	)
+	in.signer.On("CreateTimeout", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) *model.TimeoutObject {
+			timeout := &model.TimeoutObject{
+				View:       curView,
+				NewestQC:   newestQC,
+				LastViewTC: lastViewTC,
+				SignerID:   in.localID,
+				SigData:    unittest.RandomBytes(hsig.SigLen),
+			}
+			return timeout
+		},
+		nil,
+	)
 	in.signer.On("CreateQC", mock.Anything).Return(
 		func(votes []*model.Vote) *flow.QuorumCertificate {
 			voterIDs := make([]flow.Identifier, 0, len(votes))
@@ -233,7 +263,8 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 
 	// program the hotstuff verifier behaviour
 	in.verifier.On("VerifyVote", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-	in.verifier.On("VerifyQC", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	in.verifier.On("VerifyQC", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	in.verifier.On("VerifyTC", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	// program the hotstuff communicator behaviour
 	in.communicator.On("BroadcastProposalWithDelay", mock.Anything, mock.Anything).Return(
@@ -262,6 +293,7 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 		},
 	)
 	in.communicator.On("SendVote", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	in.communicator.On("BroadcastTimeout", mock.Anything).Return(nil)
 
 	// program the finalizer module behaviour
 	in.finalizer.On("MakeFinal", mock.Anything).Return(
@@ -284,13 +316,6 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 				in.finalizer.Calls = nil
 			}
 
-			// check on stop condition
-			// TODO: we can remove that once the single instance stop
-			// recursively calling into itself
-			if in.stop(&in) {
-				return errStopCondition
-			}
-
 			return nil
 		},
 	)
@@ -307,11 +332,6 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 		Logger()
 	notifier := notifications.NewLogConsumer(log)
 
-	// initialize the pacemaker
-	controller := timeout.NewController(cfg.Timeouts)
-	in.pacemaker, err = pacemaker.New(DefaultStart(), controller, notifier)
-	require.NoError(t, err)
-
 	// initialize the block producer
 	in.producer, err = blockproducer.New(in.signer, in.committee, in.builder)
 	require.NoError(t, err)
@@ -324,6 +344,12 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 		SignerIDs: in.participants.NodeIDs(),
 	}
 	rootBlockQC := &forks.BlockQC{Block: rootBlock, QC: rootQC}
+
+	// initialize the pacemaker, it starts in the view after the root block
+	controller := timeout.NewController(cfg.Timeouts)
+	in.pacemaker, err = pacemaker.New(rootQC, nil, controller, notifier)
+	require.NoError(t, err)
+
 	forkalizer, err := finalizer.New(rootBlockQC, in.finalizer, notifier)
 	require.NoError(t, err)
 
@@ -367,15 +393,26 @@ func NewInstance(t require.TestingT, options ...Option) *Instance {
 	createCollectorFactoryMethod := votecollector.NewStateMachineFactory(log, notifier, voteProcessorFactory.Create)
 	voteCollectors := voteaggregator.NewVoteCollectors(log, DefaultPruned(), workerpool.New(2), createCollectorFactoryMethod)
 
+	onTCCreated := func(tc *flow.TimeoutCertificate) {
+		in.queue <- tc
+	}
+
+	// timeout collectors aggregate timeouts with a mocked signature aggregator
+	createTimeoutCollectorFactoryMethod := func(view uint64) (hotstuff.TimeoutCollector, error) {
+		aggregator := helper.MakeTimeoutSignatureAggregator(view, weight)
+		return timeoutcollector.NewTimeoutCollector(log, view, in.validator, aggregator, minRequiredWeight, onTCCreated)
+	}
+	timeoutCollectors := voteaggregator.NewTimeoutCollectors(log, DefaultPruned(), createTimeoutCollectorFactoryMethod)
+
 	// initialize the vote aggregator
-	in.aggregator, err = voteaggregator.NewVoteAggregator(log, notifier, DefaultPruned(), voteCollectors)
+	in.aggregator, err = voteaggregator.NewVoteAggregator(log, notifier, DefaultPruned(), voteCollectors, timeoutCollectors)
 	require.NoError(t, err)
 
 	// initialize the voter
 	in.voter = voter.New(in.signer, in.forks, in.persist, in.committee, DefaultVoted())
 
 	// initialize the event handler
-	in.handler, err = eventhandler.NewEventHandler(log, in.pacemaker, in.producer, in.forks, in.persist, in.communicator, in.committee, in.aggregator, in.voter, in.validator, notifier)
+	in.handler, err = eventhandler.NewEventHandler(log, in.pacemaker, in.producer, in.forks, in.persist, in.communicator, in.committee, in.aggregator, in.voter, in.validator, notifier, flightrecorder.NewNoopRecorder())
 	require.NoError(t, err)
 
 	return &in
@@ -441,6 +478,13 @@ func (in *Instance) Run() error {
 				if err != nil {
 					return fmt.Errorf("could not process created qc: %w", err)
 				}
+			case *model.TimeoutObject:
+				in.aggregator.AddTimeout(m)
+			case *flow.TimeoutCertificate:
+				err := in.handler.OnTCConstructed(m)
+				if err != nil {
+					return fmt.Errorf("could not process created tc: %w", err)
+				}
 			}
 		}
 
@@ -19,6 +19,10 @@ const safeTimeout = 2 * time.Second
 
 const safeDecreaseFactor = 0.85
 
+// a pacemaker timeout for tests with crashed leaders, where replicas have to
+// time out regularly to make progress.
+const crashedLeaderTimeout = 500 * time.Millisecond
+
 func TestSingleInstance(t *testing.T) {
 
 	// set up a single instance to run
@@ -164,3 +168,75 @@ func TestSevenInstances(t *testing.T) {
 		assert.Equal(t, finalizedViews, FinalizedViews(instances[i]), "instance %d should have same finalized view as first instance")
 	}
 }
+
+// TestCrashedLeader tests that the committee makes progress, if one of the leaders has crashed.
+// Whenever the crashed replica is the leader, the other replicas time out and enter the next
+// view through a timeout certificate.
+func TestCrashedLeader(t *testing.T) {
+	numPass := 4
+	numCrashed := 1
+
+	// every fifth view has a crashed leader; finalization requires a direct 2-chain
+	// plus a 1-chain, i.e. four consecutive views with honest leaders
+	finalView := uint64(30)
+
+	// generate the five hotstuff participants
+	participants := unittest.IdentityListFixture(numPass + numCrashed)
+	instances := make([]*Instance, 0, numPass+numCrashed)
+	root := DefaultRoot()
+	// replicas have to wait for the full timeout in every view with the crashed leader,
+	// hence we use a shorter timeout than for the other tests
+	timeouts, err := timeout.NewConfig(crashedLeaderTimeout, crashedLeaderTimeout, 0.5, 1.5, safeDecreaseFactor, 0)
+	require.NoError(t, err)
+
+	// set up four instances that work fully
+	for n := 0; n < numPass; n++ {
+		in := NewInstance(t,
+			WithRoot(root),
+			WithParticipants(participants),
+			WithLocalID(participants[n].NodeID),
+			WithTimeouts(timeouts),
+			WithStopCondition(ViewFinalized(finalView)),
+		)
+		instances = append(instances, in)
+	}
+
+	// set up one instance which has crashed: it doesn't send any messages
+	for n := numPass; n < numPass+numCrashed; n++ {
+		in := NewInstance(t,
+			WithRoot(root),
+			WithParticipants(participants),
+			WithLocalID(participants[n].NodeID),
+			WithTimeouts(timeouts),
+			WithStopCondition(ViewFinalized(finalView)),
+			WithOutgoingVotes(BlockAllVotes),
+			WithOutgoingProposals(BlockAllProposals),
+			WithOutgoingTimeouts(BlockAllTimeouts),
+		)
+		instances = append(instances, in)
+	}
+
+	// connect the communicators of the instances together
+	Connect(instances)
+
+	// start all five instances and wait for them to wrap up
+	var wg sync.WaitGroup
+	for _, in := range instances {
+		wg.Add(1)
+		go func(in *Instance) {
+			err := in.Run()
+			require.True(t, errors.Is(err, errStopCondition), "should run until stop condition")
+			wg.Done()
+		}(in)
+	}
+	wg.Wait()
+
+	// check that all working instances have the same finalized block
+	ref := instances[0]
+	assert.LessOrEqual(t, finalView, ref.forks.FinalizedBlock().View, "expect instance 0 should made enough progress, but didn't")
+	finalizedViews := FinalizedViews(ref)
+	for i := 1; i < numPass; i++ {
+		assert.Equal(t, ref.forks.FinalizedBlock(), instances[i].forks.FinalizedBlock(), "instance %d should have same finalized block as first instance")
+		assert.Equal(t, finalizedViews, FinalizedViews(instances[i]), "instance %d should have same finalized view as first instance")
+	}
+}
@@ -20,6 +20,8 @@ type Config struct {
 	OutgoingVotes     VoteFilter
 	IncomingProposals ProposalFilter
 	OutgoingProposals ProposalFilter
+	IncomingTimeouts  TimeoutObjectFilter
+	OutgoingTimeouts  TimeoutObjectFilter
 	StopCondition     Condition
 }
 
@@ -71,6 +73,18 @@ func WithOutgoingProposals(Filter ProposalFilter) Option {
 	}
 }
 
+func WithIncomingTimeouts(Filter TimeoutObjectFilter) Option {
+	return func(cfg *Config) {
+		cfg.IncomingTimeouts = Filter
+	}
+}
+
+func WithOutgoingTimeouts(Filter TimeoutObjectFilter) Option {
+	return func(cfg *Config) {
+		cfg.OutgoingTimeouts = Filter
+	}
+}
+
 func WithStopCondition(stop Condition) Option {
 	return func(cfg *Config) {
 		cfg.StopCondition = stop
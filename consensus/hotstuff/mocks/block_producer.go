@@ -15,13 +15,13 @@ type BlockProducer struct {
 	mock.Mock
 }
 
-// MakeBlockProposal provides a mock function with given fields: qc, view
-func (_m *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, view uint64) (*model.Proposal, error) {
-	ret := _m.Called(qc, view)
+// MakeBlockProposal provides a mock function with given fields: qc, lastViewTC, view
+func (_m *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate, view uint64) (*model.Proposal, error) {
+	ret := _m.Called(qc, lastViewTC, view)
 
 	var r0 *model.Proposal
-	if rf, ok := ret.Get(0).(func(*flow.QuorumCertificate, uint64) *model.Proposal); ok {
-		r0 = rf(qc, view)
+	if rf, ok := ret.Get(0).(func(*flow.QuorumCertificate, *flow.TimeoutCertificate, uint64) *model.Proposal); ok {
+		r0 = rf(qc, lastViewTC, view)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Proposal)
@@ -29,8 +29,8 @@ func (_m *BlockProducer) MakeBlockProposal(qc *flow.QuorumCertificate, view uint
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*flow.QuorumCertificate, uint64) error); ok {
-		r1 = rf(qc, view)
+	if rf, ok := ret.Get(1).(func(*flow.QuorumCertificate, *flow.TimeoutCertificate, uint64) error); ok {
+		r1 = rf(qc, lastViewTC, view)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,6 +37,29 @@ func (_m *Committee) DKG(blockID flow.Identifier) (hotstuff.DKG, error) {
 	return r0, r1
 }
 
+// DKGByEpoch provides a mock function with given fields: view
+func (_m *Committee) DKGByEpoch(view uint64) (hotstuff.DKG, error) {
+	ret := _m.Called(view)
+
+	var r0 hotstuff.DKG
+	if rf, ok := ret.Get(0).(func(uint64) hotstuff.DKG); ok {
+		r0 = rf(view)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(hotstuff.DKG)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Identities provides a mock function with given fields: blockID, selector
 func (_m *Committee) Identities(blockID flow.Identifier, selector flow.IdentityFilter) (flow.IdentityList, error) {
 	ret := _m.Called(blockID, selector)
@@ -60,6 +83,29 @@ func (_m *Committee) Identities(blockID flow.Identifier, selector flow.IdentityF
 	return r0, r1
 }
 
+// IdentitiesByEpoch provides a mock function with given fields: view, selector
+func (_m *Committee) IdentitiesByEpoch(view uint64, selector flow.IdentityFilter) (flow.IdentityList, error) {
+	ret := _m.Called(view, selector)
+
+	var r0 flow.IdentityList
+	if rf, ok := ret.Get(0).(func(uint64, flow.IdentityFilter) flow.IdentityList); ok {
+		r0 = rf(view, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(flow.IdentityList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, flow.IdentityFilter) error); ok {
+		r1 = rf(view, selector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Identity provides a mock function with given fields: blockID, participantID
 func (_m *Committee) Identity(blockID flow.Identifier, participantID flow.Identifier) (*flow.Identity, error) {
 	ret := _m.Called(blockID, participantID)
@@ -83,6 +129,29 @@ func (_m *Committee) Identity(blockID flow.Identifier, participantID flow.Identi
 	return r0, r1
 }
 
+// IdentityByEpoch provides a mock function with given fields: view, participantID
+func (_m *Committee) IdentityByEpoch(view uint64, participantID flow.Identifier) (*flow.Identity, error) {
+	ret := _m.Called(view, participantID)
+
+	var r0 *flow.Identity
+	if rf, ok := ret.Get(0).(func(uint64, flow.Identifier) *flow.Identity); ok {
+		r0 = rf(view, participantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.Identity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, flow.Identifier) error); ok {
+		r1 = rf(view, participantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LeaderForView provides a mock function with given fields: view
 func (_m *Committee) LeaderForView(view uint64) (flow.Identifier, error) {
 	ret := _m.Called(view)
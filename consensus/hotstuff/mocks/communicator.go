@@ -5,6 +5,8 @@ package mocks
 import (
 	flow "github.com/onflow/flow-go/model/flow"
 
+	model "github.com/onflow/flow-go/consensus/hotstuff/model"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -43,6 +45,20 @@ func (_m *Communicator) BroadcastProposalWithDelay(proposal *flow.Header, delay
 	return r0
 }
 
+// BroadcastTimeout provides a mock function with given fields: timeout
+func (_m *Communicator) BroadcastTimeout(timeout *model.TimeoutObject) error {
+	ret := _m.Called(timeout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.TimeoutObject) error); ok {
+		r0 = rf(timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendVote provides a mock function with given fields: blockID, view, sigData, recipientID
 func (_m *Communicator) SendVote(blockID flow.Identifier, view uint64, sigData []byte, recipientID flow.Identifier) error {
 	ret := _m.Called(blockID, view, sigData, recipientID)
@@ -25,6 +25,11 @@ func (_m *Consumer) OnDoubleProposeDetected(_a0 *model.Block, _a1 *model.Block)
 	_m.Called(_a0, _a1)
 }
 
+// OnDoubleTimeoutDetected provides a mock function with given fields: _a0, _a1
+func (_m *Consumer) OnDoubleTimeoutDetected(_a0 *model.TimeoutObject, _a1 *model.TimeoutObject) {
+	_m.Called(_a0, _a1)
+}
+
 // OnDoubleVotingDetected provides a mock function with given fields: _a0, _a1
 func (_m *Consumer) OnDoubleVotingDetected(_a0 *model.Vote, _a1 *model.Vote) {
 	_m.Called(_a0, _a1)
@@ -50,6 +55,11 @@ func (_m *Consumer) OnForkChoiceGenerated(_a0 uint64, _a1 *flow.QuorumCertificat
 	_m.Called(_a0, _a1)
 }
 
+// OnInvalidTimeoutDetected provides a mock function with given fields: _a0
+func (_m *Consumer) OnInvalidTimeoutDetected(_a0 *model.TimeoutObject) {
+	_m.Called(_a0)
+}
+
 // OnInvalidVoteDetected provides a mock function with given fields: _a0
 func (_m *Consumer) OnInvalidVoteDetected(_a0 *model.Vote) {
 	_m.Called(_a0)
@@ -95,6 +105,21 @@ func (_m *Consumer) OnStartingTimeout(_a0 *model.TimerInfo) {
 	_m.Called(_a0)
 }
 
+// OnTcConstructedFromTimeouts provides a mock function with given fields: curView, tc
+func (_m *Consumer) OnTcConstructedFromTimeouts(curView uint64, tc *flow.TimeoutCertificate) {
+	_m.Called(curView, tc)
+}
+
+// OnTcTriggeredViewChange provides a mock function with given fields: tc, newView
+func (_m *Consumer) OnTcTriggeredViewChange(tc *flow.TimeoutCertificate, newView uint64) {
+	_m.Called(tc, newView)
+}
+
+// OnTimingOut provides a mock function with given fields: timeout
+func (_m *Consumer) OnTimingOut(timeout *model.TimeoutObject) {
+	_m.Called(timeout)
+}
+
 // OnVoteForInvalidBlockDetected provides a mock function with given fields: vote, invalidProposal
 func (_m *Consumer) OnVoteForInvalidBlockDetected(vote *model.Vote, invalidProposal *model.Proposal) {
 	_m.Called(vote, invalidProposal)
@@ -61,3 +61,8 @@ func (_m *EventLoop) SubmitProposal(proposal *flow.Header, parentView uint64) {
 func (_m *EventLoop) SubmitTrustedQC(qc *flow.QuorumCertificate) {
 	_m.Called(qc)
 }
+
+// SubmitTrustedTC provides a mock function with given fields: tc
+func (_m *EventLoop) SubmitTrustedTC(tc *flow.TimeoutCertificate) {
+	_m.Called(tc)
+}
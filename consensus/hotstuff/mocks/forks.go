@@ -157,3 +157,19 @@ func (_m *Forks) MakeForkChoice(curView uint64) (*flow.QuorumCertificate, *model
 
 	return r0, r1, r2
 }
+
+// NewestQC provides a mock function with given fields:
+func (_m *Forks) NewestQC() *flow.QuorumCertificate {
+	ret := _m.Called()
+
+	var r0 *flow.QuorumCertificate
+	if rf, ok := ret.Get(0).(func() *flow.QuorumCertificate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.QuorumCertificate)
+		}
+	}
+
+	return r0
+}
@@ -45,50 +45,50 @@ func (_m *PaceMaker) CurView() uint64 {
 	return r0
 }
 
-// OnTimeout provides a mock function with given fields:
-func (_m *PaceMaker) OnTimeout() *model.NewViewEvent {
+// LastViewTC provides a mock function with given fields:
+func (_m *PaceMaker) LastViewTC() *flow.TimeoutCertificate {
 	ret := _m.Called()
 
-	var r0 *model.NewViewEvent
-	if rf, ok := ret.Get(0).(func() *model.NewViewEvent); ok {
+	var r0 *flow.TimeoutCertificate
+	if rf, ok := ret.Get(0).(func() *flow.TimeoutCertificate); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.NewViewEvent)
+			r0 = ret.Get(0).(*flow.TimeoutCertificate)
 		}
 	}
 
 	return r0
 }
 
-// Start provides a mock function with given fields:
-func (_m *PaceMaker) Start() {
-	_m.Called()
-}
-
-// TimeoutChannel provides a mock function with given fields:
-func (_m *PaceMaker) TimeoutChannel() <-chan time.Time {
+// NewestQC provides a mock function with given fields:
+func (_m *PaceMaker) NewestQC() *flow.QuorumCertificate {
 	ret := _m.Called()
 
-	var r0 <-chan time.Time
-	if rf, ok := ret.Get(0).(func() <-chan time.Time); ok {
+	var r0 *flow.QuorumCertificate
+	if rf, ok := ret.Get(0).(func() *flow.QuorumCertificate); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(<-chan time.Time)
+			r0 = ret.Get(0).(*flow.QuorumCertificate)
 		}
 	}
 
 	return r0
 }
 
-// UpdateCurViewWithBlock provides a mock function with given fields: block, isLeaderForNextView
-func (_m *PaceMaker) UpdateCurViewWithBlock(block *model.Block, isLeaderForNextView bool) (*model.NewViewEvent, bool) {
-	ret := _m.Called(block, isLeaderForNextView)
+// OnTimeout provides a mock function with given fields:
+func (_m *PaceMaker) OnTimeout() {
+	_m.Called()
+}
+
+// ProcessQC provides a mock function with given fields: qc
+func (_m *PaceMaker) ProcessQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool) {
+	ret := _m.Called(qc)
 
 	var r0 *model.NewViewEvent
-	if rf, ok := ret.Get(0).(func(*model.Block, bool) *model.NewViewEvent); ok {
-		r0 = rf(block, isLeaderForNextView)
+	if rf, ok := ret.Get(0).(func(*flow.QuorumCertificate) *model.NewViewEvent); ok {
+		r0 = rf(qc)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.NewViewEvent)
@@ -96,8 +96,8 @@ func (_m *PaceMaker) UpdateCurViewWithBlock(block *model.Block, isLeaderForNextV
 	}
 
 	var r1 bool
-	if rf, ok := ret.Get(1).(func(*model.Block, bool) bool); ok {
-		r1 = rf(block, isLeaderForNextView)
+	if rf, ok := ret.Get(1).(func(*flow.QuorumCertificate) bool); ok {
+		r1 = rf(qc)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
@@ -105,13 +105,13 @@ func (_m *PaceMaker) UpdateCurViewWithBlock(block *model.Block, isLeaderForNextV
 	return r0, r1
 }
 
-// UpdateCurViewWithQC provides a mock function with given fields: qc
-func (_m *PaceMaker) UpdateCurViewWithQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool) {
-	ret := _m.Called(qc)
+// ProcessTC provides a mock function with given fields: tc
+func (_m *PaceMaker) ProcessTC(tc *flow.TimeoutCertificate) (*model.NewViewEvent, bool) {
+	ret := _m.Called(tc)
 
 	var r0 *model.NewViewEvent
-	if rf, ok := ret.Get(0).(func(*flow.QuorumCertificate) *model.NewViewEvent); ok {
-		r0 = rf(qc)
+	if rf, ok := ret.Get(0).(func(*flow.TimeoutCertificate) *model.NewViewEvent); ok {
+		r0 = rf(tc)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.NewViewEvent)
@@ -119,11 +119,32 @@ func (_m *PaceMaker) UpdateCurViewWithQC(qc *flow.QuorumCertificate) (*model.New
 	}
 
 	var r1 bool
-	if rf, ok := ret.Get(1).(func(*flow.QuorumCertificate) bool); ok {
-		r1 = rf(qc)
+	if rf, ok := ret.Get(1).(func(*flow.TimeoutCertificate) bool); ok {
+		r1 = rf(tc)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
 
 	return r0, r1
 }
+
+// Start provides a mock function with given fields:
+func (_m *PaceMaker) Start() {
+	_m.Called()
+}
+
+// TimeoutChannel provides a mock function with given fields:
+func (_m *PaceMaker) TimeoutChannel() <-chan time.Time {
+	ret := _m.Called()
+
+	var r0 <-chan time.Time
+	if rf, ok := ret.Get(0).(func() <-chan time.Time); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan time.Time)
+		}
+	}
+
+	return r0
+}
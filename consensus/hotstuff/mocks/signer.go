@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	flow "github.com/onflow/flow-go/model/flow"
+
 	model "github.com/onflow/flow-go/consensus/hotstuff/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -35,6 +37,29 @@ func (_m *Signer) CreateProposal(block *model.Block) (*model.Proposal, error) {
 	return r0, r1
 }
 
+// CreateTimeout provides a mock function with given fields: curView, newestQC, lastViewTC
+func (_m *Signer) CreateTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	ret := _m.Called(curView, newestQC, lastViewTC)
+
+	var r0 *model.TimeoutObject
+	if rf, ok := ret.Get(0).(func(uint64, *flow.QuorumCertificate, *flow.TimeoutCertificate) *model.TimeoutObject); ok {
+		r0 = rf(curView, newestQC, lastViewTC)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeoutObject)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, *flow.QuorumCertificate, *flow.TimeoutCertificate) error); ok {
+		r1 = rf(curView, newestQC, lastViewTC)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVote provides a mock function with given fields: block
 func (_m *Signer) CreateVote(block *model.Block) (*model.Vote, error) {
 	ret := _m.Called(block)
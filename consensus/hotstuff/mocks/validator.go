@@ -43,6 +43,34 @@ func (_m *Validator) ValidateQC(qc *flow.QuorumCertificate, block *model.Block)
 	return r0
 }
 
+// ValidateTC provides a mock function with given fields: tc
+func (_m *Validator) ValidateTC(tc *flow.TimeoutCertificate) error {
+	ret := _m.Called(tc)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flow.TimeoutCertificate) error); ok {
+		r0 = rf(tc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ValidateTimeout provides a mock function with given fields: timeout
+func (_m *Validator) ValidateTimeout(timeout *model.TimeoutObject) error {
+	ret := _m.Called(timeout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.TimeoutObject) error); ok {
+		r0 = rf(timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ValidateVote provides a mock function with given fields: vote, block
 func (_m *Validator) ValidateVote(vote *model.Vote, block *model.Block) (*flow.Identity, error) {
 	ret := _m.Called(vote, block)
@@ -15,13 +15,27 @@ type Verifier struct {
 	mock.Mock
 }
 
-// VerifyQC provides a mock function with given fields: voters, sigData, block
-func (_m *Verifier) VerifyQC(voters flow.IdentityList, sigData []byte, block *model.Block) error {
-	ret := _m.Called(voters, sigData, block)
+// VerifyQC provides a mock function with given fields: voters, sigData, view, blockID
+func (_m *Verifier) VerifyQC(voters flow.IdentityList, sigData []byte, view uint64, blockID flow.Identifier) error {
+	ret := _m.Called(voters, sigData, view, blockID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(flow.IdentityList, []byte, *model.Block) error); ok {
-		r0 = rf(voters, sigData, block)
+	if rf, ok := ret.Get(0).(func(flow.IdentityList, []byte, uint64, flow.Identifier) error); ok {
+		r0 = rf(voters, sigData, view, blockID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyTC provides a mock function with given fields: signers, sigData, view, newestQCViews
+func (_m *Verifier) VerifyTC(signers flow.IdentityList, sigData []byte, view uint64, newestQCViews []uint64) error {
+	ret := _m.Called(signers, sigData, view, newestQCViews)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(flow.IdentityList, []byte, uint64, []uint64) error); ok {
+		r0 = rf(signers, sigData, view, newestQCViews)
 	} else {
 		r0 = ret.Error(0)
 	}
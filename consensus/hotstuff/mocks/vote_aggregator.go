@@ -28,6 +28,11 @@ func (_m *VoteAggregator) AddBlock(block *model.Proposal) error {
 	return r0
 }
 
+// AddTimeout provides a mock function with given fields: timeout
+func (_m *VoteAggregator) AddTimeout(timeout *model.TimeoutObject) {
+	_m.Called(timeout)
+}
+
 // AddVote provides a mock function with given fields: vote
 func (_m *VoteAggregator) AddVote(vote *model.Vote) {
 	_m.Called(vote)
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/onflow/flow-go/consensus/hotstuff/model"
+	flow "github.com/onflow/flow-go/model/flow"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/onflow/flow-go/consensus/hotstuff/model"
 )
 
 // Voter is an autogenerated mock type for the Voter type
@@ -12,6 +15,29 @@ type Voter struct {
 	mock.Mock
 }
 
+// ProduceTimeout provides a mock function with given fields: curView, newestQC, lastViewTC
+func (_m *Voter) ProduceTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	ret := _m.Called(curView, newestQC, lastViewTC)
+
+	var r0 *model.TimeoutObject
+	if rf, ok := ret.Get(0).(func(uint64, *flow.QuorumCertificate, *flow.TimeoutCertificate) *model.TimeoutObject); ok {
+		r0 = rf(curView, newestQC, lastViewTC)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeoutObject)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, *flow.QuorumCertificate, *flow.TimeoutCertificate) error); ok {
+		r1 = rf(curView, newestQC, lastViewTC)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ProduceVoteIfVotable provides a mock function with given fields: block, curView
 func (_m *Voter) ProduceVoteIfVotable(block *model.Block, curView uint64) (*model.Vote, error) {
 	ret := _m.Called(block, curView)
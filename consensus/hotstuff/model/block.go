@@ -15,6 +15,7 @@ type Block struct {
 	QC          *flow.QuorumCertificate
 	PayloadHash flow.Identifier
 	Timestamp   time.Time
+	LastViewTC  *flow.TimeoutCertificate // only set if the proposer entered the block's view through a TC
 }
 
 // BlockFromFlow converts a flow header to a hotstuff block.
@@ -34,6 +35,7 @@ func BlockFromFlow(header *flow.Header, parentView uint64) *Block {
 		ProposerID:  header.ProposerID,
 		PayloadHash: header.PayloadHash,
 		Timestamp:   header.Timestamp,
+		LastViewTC:  header.LastViewTC,
 	}
 
 	return &block
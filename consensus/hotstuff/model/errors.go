@@ -11,6 +11,9 @@ var (
 	ErrUnverifiableBlock = errors.New("block proposal can't be verified, because its view is above the finalized view, but its QC is below the finalized view")
 	ErrInvalidFormat     = errors.New("invalid signature format")
 	ErrInvalidSignature  = errors.New("invalid signature")
+	// ErrViewForUnknownEpoch is returned when a by-view query is made with a view
+	// outside all cached epochs.
+	ErrViewForUnknownEpoch = errors.New("by-view query for unknown epoch")
 )
 
 // NoVoteError contains the reason of why the voter didn't vote for a block proposal.
@@ -26,6 +29,19 @@ func IsNoVoteError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// NoTimeoutError contains the reason of why the voter didn't produce a timeout.
+type NoTimeoutError struct {
+	Msg string
+}
+
+func (e NoTimeoutError) Error() string { return e.Msg }
+
+// IsNoTimeoutError returns whether an error is NoTimeoutError
+func IsNoTimeoutError(err error) bool {
+	var e NoTimeoutError
+	return errors.As(err, &e)
+}
+
 // ConfigurationError indicates that a constructor or component was initialized with
 // invalid or inconsistent parameters.
 type ConfigurationError struct {
@@ -115,6 +131,102 @@ func (e InvalidVoteError) Unwrap() error {
 	return e.Err
 }
 
+// InvalidQCError indicates that the quorum certificate for block `BlockID` is invalid
+type InvalidQCError struct {
+	BlockID flow.Identifier
+	View    uint64
+	Err     error
+}
+
+func NewInvalidQCErrorf(qc *flow.QuorumCertificate, msg string, args ...interface{}) error {
+	return InvalidQCError{
+		BlockID: qc.BlockID,
+		View:    qc.View,
+		Err:     fmt.Errorf(msg, args...),
+	}
+}
+
+func (e InvalidQCError) Error() string {
+	return fmt.Sprintf("invalid QC for block %x at view %d: %s", e.BlockID, e.View, e.Err.Error())
+}
+
+// IsInvalidQCError returns whether an error is InvalidQCError
+func IsInvalidQCError(err error) bool {
+	var e InvalidQCError
+	return errors.As(err, &e)
+}
+
+func (e InvalidQCError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidTCError indicates that the timeout certificate for view `View` is invalid
+type InvalidTCError struct {
+	ID   flow.Identifier
+	View uint64
+	Err  error
+}
+
+func NewInvalidTCErrorf(tc *flow.TimeoutCertificate, msg string, args ...interface{}) error {
+	return InvalidTCError{
+		ID:   tc.ID(),
+		View: tc.View,
+		Err:  fmt.Errorf(msg, args...),
+	}
+}
+
+func (e InvalidTCError) Error() string {
+	return fmt.Sprintf("invalid timeout certificate %x for view %d: %s", e.ID, e.View, e.Err.Error())
+}
+
+// IsInvalidTCError returns whether an error is InvalidTCError
+func IsInvalidTCError(err error) bool {
+	var e InvalidTCError
+	return errors.As(err, &e)
+}
+
+func (e InvalidTCError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidTimeoutError indicates that the timeout object with identifier `TimeoutID` is invalid
+type InvalidTimeoutError struct {
+	Timeout *TimeoutObject
+	Err     error
+}
+
+func NewInvalidTimeoutErrorf(timeout *TimeoutObject, msg string, args ...interface{}) error {
+	return InvalidTimeoutError{
+		Timeout: timeout,
+		Err:     fmt.Errorf(msg, args...),
+	}
+}
+
+func (e InvalidTimeoutError) Error() string {
+	return fmt.Sprintf("invalid timeout %x for view %d: %s", e.Timeout.ID(), e.Timeout.View, e.Err.Error())
+}
+
+// IsInvalidTimeoutError returns whether an error is InvalidTimeoutError
+func IsInvalidTimeoutError(err error) bool {
+	var e InvalidTimeoutError
+	return errors.As(err, &e)
+}
+
+// AsInvalidTimeoutError determines whether the given error is a InvalidTimeoutError
+// (potentially wrapped). It follows the same semantics as a checked type cast.
+func AsInvalidTimeoutError(err error) (*InvalidTimeoutError, bool) {
+	var e InvalidTimeoutError
+	ok := errors.As(err, &e)
+	if ok {
+		return &e, true
+	}
+	return nil, false
+}
+
+func (e InvalidTimeoutError) Unwrap() error {
+	return e.Err
+}
+
 // ByzantineThresholdExceededError is raised if HotStuff detects malicious conditions which
 // prove a Byzantine threshold of consensus replicas has been exceeded.
 // Per definition, the byzantine threshold is exceeded if there are byzantine consensus
@@ -168,6 +280,47 @@ func NewDoubleVoteErrorf(firstVote, conflictingVote *Vote, msg string, args ...i
 	}
 }
 
+// DoubleTimeoutError indicates that a consensus replica has provided two different
+// timeout objects for the same view.
+type DoubleTimeoutError struct {
+	FirstTimeout       *TimeoutObject
+	ConflictingTimeout *TimeoutObject
+	err                error
+}
+
+func (e DoubleTimeoutError) Error() string {
+	return e.err.Error()
+}
+
+// IsDoubleTimeoutError returns whether an error is DoubleTimeoutError
+func IsDoubleTimeoutError(err error) bool {
+	var e DoubleTimeoutError
+	return errors.As(err, &e)
+}
+
+// AsDoubleTimeoutError determines whether the given error is a DoubleTimeoutError
+// (potentially wrapped). It follows the same semantics as a checked type cast.
+func AsDoubleTimeoutError(err error) (*DoubleTimeoutError, bool) {
+	var e DoubleTimeoutError
+	ok := errors.As(err, &e)
+	if ok {
+		return &e, true
+	}
+	return nil, false
+}
+
+func (e DoubleTimeoutError) Unwrap() error {
+	return e.err
+}
+
+func NewDoubleTimeoutErrorf(firstTimeout, conflictingTimeout *TimeoutObject, msg string, args ...interface{}) error {
+	return DoubleTimeoutError{
+		FirstTimeout:       firstTimeout,
+		ConflictingTimeout: conflictingTimeout,
+		err:                fmt.Errorf(msg, args...),
+	}
+}
+
 // DuplicatedSignerError indicates that a signature from the same node ID has already been added
 type DuplicatedSignerError struct {
 	err error
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// FlightEventType describes which consensus event a FlightEvent records.
+type FlightEventType string
+
+const (
+	// FlightEventProposalReceived is recorded when a block proposal is submitted to the event loop.
+	FlightEventProposalReceived FlightEventType = "proposal_received"
+	// FlightEventProposalProcessed is recorded when the event handler has processed a block proposal.
+	// The details hold the reason, if the proposal was dropped (stale, invalid).
+	FlightEventProposalProcessed FlightEventType = "proposal_processed"
+	// FlightEventQCReceived is recorded when a QC constructed by the vote aggregator is submitted to the event loop.
+	FlightEventQCReceived FlightEventType = "qc_received"
+	// FlightEventTCReceived is recorded when a TC constructed by the timeout aggregator is submitted to the event loop.
+	FlightEventTCReceived FlightEventType = "tc_received"
+	// FlightEventVoteReceived is recorded when a vote of another replica is submitted to the vote aggregator.
+	FlightEventVoteReceived FlightEventType = "vote_received"
+	// FlightEventTimeoutReceived is recorded when a timeout object of another replica is submitted to the vote aggregator.
+	FlightEventTimeoutReceived FlightEventType = "timeout_received"
+	// FlightEventLocalTimeout is recorded when the pacemaker's timeout for the current view fires.
+	FlightEventLocalTimeout FlightEventType = "local_timeout"
+	// FlightEventViewEntered is recorded when the replica enters a new view.
+	FlightEventViewEntered FlightEventType = "view_entered"
+	// FlightEventProposalProduced is recorded when the replica proposes a block as leader.
+	FlightEventProposalProduced FlightEventType = "proposal_produced"
+	// FlightEventVoteProduced is recorded when the replica votes for a block.
+	FlightEventVoteProduced FlightEventType = "vote_produced"
+	// FlightEventTimeoutProduced is recorded when the replica broadcasts a timeout object.
+	FlightEventTimeoutProduced FlightEventType = "timeout_produced"
+)
+
+// FlightEvent is a single entry of the consensus flight recorder. It captures what a replica
+// saw and did, so that the progress of consensus can be reconstructed after the fact, by
+// merging the events of several replicas into one timeline per view.
+type FlightEvent struct {
+	Time        time.Time       `json:"time"`
+	NodeID      flow.Identifier `json:"node_id"`  // replica which recorded the event
+	ChainID     flow.ChainID    `json:"chain_id"` // chain the replica runs consensus for
+	Type        FlightEventType `json:"type"`
+	View        uint64          `json:"view"`               // view of the block, certificate or timeout the event refers to
+	CurView     uint64          `json:"cur_view,omitempty"` // replica's current view, if known when recording
+	BlockID     flow.Identifier `json:"block_id"`           // block the event refers to, zero if none
+	OriginID    flow.Identifier `json:"origin_id"`          // proposer, leader or replica the event originates from, zero if none
+	RecipientID flow.Identifier `json:"recipient_id"`       // node our own message was sent to, zero if none
+	QCView      uint64          `json:"qc_view,omitempty"`  // view of the (newest) QC included in the event
+	Signers     int             `json:"signers,omitempty"`  // number of signers of a certificate
+	Details     string          `json:"details,omitempty"`  // free-form annotation, e.g. why a proposal was dropped
+}
@@ -48,6 +48,7 @@ func ProposalToFlow(proposal *Proposal) *flow.Header {
 		ParentVoterSigData: block.QC.SigData,
 		ProposerID:         block.ProposerID,
 		ProposerSigData:    proposal.SigData,
+		LastViewTC:         block.LastViewTC,
 	}
 
 	return &header
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// TimeoutObject is the HotStuff algorithm's concept of a timeout. A replica that
+// times out in a view broadcasts a signed TimeoutObject to all other replicas.
+// Timeout objects from a super-majority of replicas for the same view are
+// aggregated into a flow.TimeoutCertificate, which allows replicas to enter
+// the next view without a QC for the current view.
+type TimeoutObject struct {
+	// View is the view that the replica timed out in.
+	View uint64
+	// NewestQC is the newest QC known to the replica when it timed out.
+	NewestQC *flow.QuorumCertificate
+	// LastViewTC is the timeout certificate for View-1. It is only set if
+	// NewestQC is not for View-1, i.e. the replica entered View through a TC.
+	LastViewTC *flow.TimeoutCertificate
+	// SignerID is the ID of the replica that timed out.
+	SignerID flow.Identifier
+	// SigData is the replica's staking signature over (View, NewestQC.View).
+	SigData []byte
+}
+
+// ID returns the identifier for the timeout object.
+func (t *TimeoutObject) ID() flow.Identifier {
+	return flow.MakeID(t)
+}
@@ -77,6 +77,14 @@ func (lc *LogConsumer) OnQcTriggeredViewChange(qc *flow.QuorumCertificate, newVi
 		Msg("QC triggered view change")
 }
 
+func (lc *LogConsumer) OnTcTriggeredViewChange(tc *flow.TimeoutCertificate, newView uint64) {
+	lc.log.Debug().
+		Uint64("tc_view", tc.View).
+		Uint64("newest_qc_view", tc.NewestQC.View).
+		Uint64("new_view", newView).
+		Msg("TC triggered view change")
+}
+
 func (lc *LogConsumer) OnProposingBlock(block *model.Proposal) {
 	lc.logBasicBlockData(lc.log.Debug(), block.Block).
 		Msg("proposing block")
@@ -89,6 +97,14 @@ func (lc *LogConsumer) OnVoting(vote *model.Vote) {
 		Msg("voting for block")
 }
 
+func (lc *LogConsumer) OnTimingOut(timeout *model.TimeoutObject) {
+	lc.log.Debug().
+		Uint64("timeout_view", timeout.View).
+		Uint64("newest_qc_view", timeout.NewestQC.View).
+		Bool("has_last_view_tc", timeout.LastViewTC != nil).
+		Msg("timing out")
+}
+
 func (lc *LogConsumer) OnQcConstructedFromVotes(curView uint64, qc *flow.QuorumCertificate) {
 	lc.log.Debug().
 		Uint64("cur_view", curView).
@@ -97,6 +113,14 @@ func (lc *LogConsumer) OnQcConstructedFromVotes(curView uint64, qc *flow.QuorumC
 		Msg("QC constructed from votes")
 }
 
+func (lc *LogConsumer) OnTcConstructedFromTimeouts(curView uint64, tc *flow.TimeoutCertificate) {
+	lc.log.Debug().
+		Uint64("cur_view", curView).
+		Uint64("tc_view", tc.View).
+		Uint64("newest_qc_view", tc.NewestQC.View).
+		Msg("TC constructed from timeouts")
+}
+
 func (lc *LogConsumer) OnStartingTimeout(info *model.TimerInfo) {
 	lc.log.Debug().
 		Uint64("timeout_view", info.View).
@@ -154,6 +178,23 @@ func (lc *LogConsumer) OnVoteForInvalidBlockDetected(vote *model.Vote, proposal
 		Msg("vote for invalid proposal detected")
 }
 
+func (lc *LogConsumer) OnDoubleTimeoutDetected(timeout *model.TimeoutObject, alt *model.TimeoutObject) {
+	lc.log.Warn().
+		Uint64("timeout_view", timeout.View).
+		Hex("signer_id", timeout.SignerID[:]).
+		Hex("timeout_id", logging.ID(timeout.ID())).
+		Hex("alt_id", logging.ID(alt.ID())).
+		Msg("double timeout detected")
+}
+
+func (lc *LogConsumer) OnInvalidTimeoutDetected(timeout *model.TimeoutObject) {
+	lc.log.Warn().
+		Uint64("timeout_view", timeout.View).
+		Hex("signer_id", timeout.SignerID[:]).
+		Hex("timeout_id", logging.ID(timeout.ID())).
+		Msg("invalid timeout detected")
+}
+
 func (lc *LogConsumer) logBasicBlockData(loggerEvent *zerolog.Event, block *model.Block) *zerolog.Event {
 	loggerEvent.
 		Uint64("block_view", block.View).
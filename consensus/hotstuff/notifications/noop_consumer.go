@@ -33,12 +33,18 @@ func (*NoopConsumer) OnEnteringView(uint64, flow.Identifier) {}
 
 func (c *NoopConsumer) OnQcTriggeredViewChange(*flow.QuorumCertificate, uint64) {}
 
+func (c *NoopConsumer) OnTcTriggeredViewChange(*flow.TimeoutCertificate, uint64) {}
+
 func (c *NoopConsumer) OnProposingBlock(*model.Proposal) {}
 
 func (c *NoopConsumer) OnVoting(*model.Vote) {}
 
+func (c *NoopConsumer) OnTimingOut(*model.TimeoutObject) {}
+
 func (c *NoopConsumer) OnQcConstructedFromVotes(curView uint64, qc *flow.QuorumCertificate) {}
 
+func (c *NoopConsumer) OnTcConstructedFromTimeouts(curView uint64, tc *flow.TimeoutCertificate) {}
+
 func (*NoopConsumer) OnStartingTimeout(*model.TimerInfo) {}
 
 func (*NoopConsumer) OnReachedTimeout(*model.TimerInfo) {}
@@ -52,3 +58,7 @@ func (*NoopConsumer) OnDoubleVotingDetected(*model.Vote, *model.Vote) {}
 func (*NoopConsumer) OnInvalidVoteDetected(*model.Vote) {}
 
 func (*NoopConsumer) OnVoteForInvalidBlockDetected(*model.Vote, *model.Proposal) {}
+
+func (*NoopConsumer) OnDoubleTimeoutDetected(*model.TimeoutObject, *model.TimeoutObject) {}
+
+func (*NoopConsumer) OnInvalidTimeoutDetected(*model.TimeoutObject) {}
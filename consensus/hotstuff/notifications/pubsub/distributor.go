@@ -69,6 +69,14 @@ func (p *Distributor) OnQcTriggeredViewChange(qc *flow.QuorumCertificate, newVie
 	}
 }
 
+func (p *Distributor) OnTcTriggeredViewChange(tc *flow.TimeoutCertificate, newView uint64) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, subscriber := range p.subscribers {
+		subscriber.OnTcTriggeredViewChange(tc, newView)
+	}
+}
+
 func (p *Distributor) OnProposingBlock(proposal *model.Proposal) {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -85,6 +93,14 @@ func (p *Distributor) OnVoting(vote *model.Vote) {
 	}
 }
 
+func (p *Distributor) OnTimingOut(timeout *model.TimeoutObject) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, subscriber := range p.subscribers {
+		subscriber.OnTimingOut(timeout)
+	}
+}
+
 func (p *Distributor) OnQcConstructedFromVotes(curView uint64, qc *flow.QuorumCertificate) {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -93,6 +109,14 @@ func (p *Distributor) OnQcConstructedFromVotes(curView uint64, qc *flow.QuorumCe
 	}
 }
 
+func (p *Distributor) OnTcConstructedFromTimeouts(curView uint64, tc *flow.TimeoutCertificate) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, subscriber := range p.subscribers {
+		subscriber.OnTcConstructedFromTimeouts(curView, tc)
+	}
+}
+
 func (p *Distributor) OnStartingTimeout(timerInfo *model.TimerInfo) {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -172,3 +196,19 @@ func (p *Distributor) OnVoteForInvalidBlockDetected(vote *model.Vote, invalidPro
 		subscriber.OnVoteForInvalidBlockDetected(vote, invalidProposal)
 	}
 }
+
+func (p *Distributor) OnDoubleTimeoutDetected(timeout1, timeout2 *model.TimeoutObject) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, subscriber := range p.subscribers {
+		subscriber.OnDoubleTimeoutDetected(timeout1, timeout2)
+	}
+}
+
+func (p *Distributor) OnInvalidTimeoutDetected(timeout *model.TimeoutObject) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, subscriber := range p.subscribers {
+		subscriber.OnInvalidTimeoutDetected(timeout)
+	}
+}
@@ -87,13 +87,20 @@ func (p *FinalizationDistributor) OnEnteringView(uint64, flow.Identifier) {}
 
 func (p *FinalizationDistributor) OnQcTriggeredViewChange(*flow.QuorumCertificate, uint64) {}
 
+func (p *FinalizationDistributor) OnTcTriggeredViewChange(*flow.TimeoutCertificate, uint64) {}
+
 func (p *FinalizationDistributor) OnProposingBlock(*model.Proposal) {}
 
 func (p *FinalizationDistributor) OnVoting(*model.Vote) {}
 
+func (p *FinalizationDistributor) OnTimingOut(*model.TimeoutObject) {}
+
 func (p *FinalizationDistributor) OnQcConstructedFromVotes(curView uint64, qc *flow.QuorumCertificate) {
 }
 
+func (p *FinalizationDistributor) OnTcConstructedFromTimeouts(curView uint64, tc *flow.TimeoutCertificate) {
+}
+
 func (p *FinalizationDistributor) OnStartingTimeout(*model.TimerInfo) {}
 
 func (p *FinalizationDistributor) OnReachedTimeout(*model.TimerInfo) {}
@@ -107,3 +114,8 @@ func (p *FinalizationDistributor) OnDoubleVotingDetected(*model.Vote, *model.Vot
 func (p *FinalizationDistributor) OnInvalidVoteDetected(*model.Vote) {}
 
 func (p *FinalizationDistributor) OnVoteForInvalidBlockDetected(*model.Vote, *model.Proposal) {}
+
+func (p *FinalizationDistributor) OnDoubleTimeoutDetected(*model.TimeoutObject, *model.TimeoutObject) {
+}
+
+func (p *FinalizationDistributor) OnInvalidTimeoutDetected(*model.TimeoutObject) {}
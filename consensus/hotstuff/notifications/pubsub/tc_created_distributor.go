@@ -0,0 +1,41 @@
+package pubsub
+
+import (
+	"sync"
+
+	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+type OnTCCreatedConsumer = func(tc *flow.TimeoutCertificate)
+
+// TCCreatedDistributor subscribes for tc created event from hotstuff and distributes it to subscribers
+// Objects are concurrency safe.
+// NOTE: it can be refactored to work without lock since usually we never subscribe after startup. Mostly
+// list of observers is static.
+type TCCreatedDistributor struct {
+	tcCreatedConsumers []OnTCCreatedConsumer
+	lock               sync.RWMutex
+}
+
+var _ hotstuff.TCCreatedConsumer = (*TCCreatedDistributor)(nil)
+
+func NewTCCreatedDistributor() *TCCreatedDistributor {
+	return &TCCreatedDistributor{
+		tcCreatedConsumers: make([]OnTCCreatedConsumer, 0),
+	}
+}
+
+func (d *TCCreatedDistributor) AddConsumer(consumer OnTCCreatedConsumer) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.tcCreatedConsumers = append(d.tcCreatedConsumers, consumer)
+}
+
+func (d *TCCreatedDistributor) OnTcConstructedFromTimeouts(tc *flow.TimeoutCertificate) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	for _, consumer := range d.tcCreatedConsumers {
+		consumer(tc)
+	}
+}
@@ -4,18 +4,38 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/storage"
 )
 
 // SlashingViolationsConsumer is an implementation of the notifications consumer that logs a
-// message for any slashable offences.
+// message for any slashable offences. Double proposals, double votes and double timeouts are
+// additionally turned into slashing evidence, which holds both conflicting signed messages and
+// is handed to the evidence collector. Invalid votes and timeouts are only logged, as a single
+// message with an invalid signature does not prove that its signer misbehaved.
 type SlashingViolationsConsumer struct {
 	NoopConsumer
-	log zerolog.Logger
+	log       zerolog.Logger
+	chainID   flow.ChainID
+	headers   storage.Headers
+	collector module.SlashingEvidenceCollector
 }
 
-func NewSlashingViolationsConsumer(log zerolog.Logger) *SlashingViolationsConsumer {
+// NewSlashingViolationsConsumer creates a consumer for the slashable offences detected by the
+// HotStuff instance running on the given chain. The headers are used to retrieve the conflicting
+// proposals, which have been stored before they were passed to HotStuff.
+func NewSlashingViolationsConsumer(
+	log zerolog.Logger,
+	chainID flow.ChainID,
+	headers storage.Headers,
+	collector module.SlashingEvidenceCollector,
+) *SlashingViolationsConsumer {
 	return &SlashingViolationsConsumer{
-		log: log,
+		log:       log,
+		chainID:   chainID,
+		headers:   headers,
+		collector: collector,
 	}
 }
 
@@ -26,6 +46,14 @@ func (c *SlashingViolationsConsumer) OnDoubleVotingDetected(vote1 *model.Vote, v
 		Hex("voted_block_id1", vote1.BlockID[:]).
 		Hex("voted_block_id2", vote2.BlockID[:]).
 		Msg("OnDoubleVotingDetected")
+
+	c.collector.AddEvidence(&flow.SlashingEvidence{
+		Type:       flow.SlashingEvidenceDoubleVote,
+		ChainID:    c.chainID,
+		View:       vote1.View,
+		OffenderID: vote1.SignerID,
+		Votes:      []*flow.SlashingVote{slashingVote(vote1), slashingVote(vote2)},
+	})
 }
 
 func (c *SlashingViolationsConsumer) OnInvalidVoteDetected(vote *model.Vote) {
@@ -45,6 +73,30 @@ func (c *SlashingViolationsConsumer) OnVoteForInvalidBlockDetected(vote *model.V
 		Msg("OnVoteForInvalidBlockDetected")
 }
 
+func (c *SlashingViolationsConsumer) OnDoubleTimeoutDetected(timeout1 *model.TimeoutObject, timeout2 *model.TimeoutObject) {
+	c.log.Warn().
+		Uint64("timeout_view", timeout1.View).
+		Hex("signer_id", timeout1.SignerID[:]).
+		Uint64("newest_qc_view1", timeout1.NewestQC.View).
+		Uint64("newest_qc_view2", timeout2.NewestQC.View).
+		Msg("OnDoubleTimeoutDetected")
+
+	c.collector.AddEvidence(&flow.SlashingEvidence{
+		Type:       flow.SlashingEvidenceDoubleTimeout,
+		ChainID:    c.chainID,
+		View:       timeout1.View,
+		OffenderID: timeout1.SignerID,
+		Timeouts:   []*flow.SlashingTimeout{slashingTimeout(timeout1), slashingTimeout(timeout2)},
+	})
+}
+
+func (c *SlashingViolationsConsumer) OnInvalidTimeoutDetected(timeout *model.TimeoutObject) {
+	c.log.Warn().
+		Uint64("timeout_view", timeout.View).
+		Hex("signer_id", timeout.SignerID[:]).
+		Msg("OnInvalidTimeoutDetected")
+}
+
 func (c *SlashingViolationsConsumer) OnDoubleProposeDetected(block1 *model.Block, block2 *model.Block) {
 	c.log.Warn().
 		Hex("proposer_id", block1.ProposerID[:]).
@@ -52,4 +104,46 @@ func (c *SlashingViolationsConsumer) OnDoubleProposeDetected(block1 *model.Block
 		Hex("block_id1", block1.BlockID[:]).
 		Hex("block_id2", block2.BlockID[:]).
 		Msg("OnDoubleProposeDetected")
+
+	// the evidence has to include the full signed headers, rather than the HotStuff blocks
+	proposals := make([]*flow.Header, 0, 2)
+	for _, block := range []*model.Block{block1, block2} {
+		header, err := c.headers.ByBlockID(block.BlockID)
+		if err != nil {
+			c.log.Error().Err(err).
+				Hex("block_id", block.BlockID[:]).
+				Msg("could not retrieve double proposal, skipping slashing evidence")
+			return
+		}
+		proposals = append(proposals, header)
+	}
+
+	c.collector.AddEvidence(&flow.SlashingEvidence{
+		Type:       flow.SlashingEvidenceDoubleProposal,
+		ChainID:    c.chainID,
+		View:       block1.View,
+		OffenderID: block1.ProposerID,
+		Proposals:  proposals,
+	})
+}
+
+// slashingVote converts a HotStuff vote into the vote included in slashing evidence.
+func slashingVote(vote *model.Vote) *flow.SlashingVote {
+	return &flow.SlashingVote{
+		View:     vote.View,
+		BlockID:  vote.BlockID,
+		SignerID: vote.SignerID,
+		SigData:  vote.SigData,
+	}
+}
+
+// slashingTimeout converts a HotStuff timeout into the timeout included in slashing evidence.
+func slashingTimeout(timeout *model.TimeoutObject) *flow.SlashingTimeout {
+	return &flow.SlashingTimeout{
+		View:       timeout.View,
+		NewestQC:   timeout.NewestQC,
+		LastViewTC: timeout.LastViewTC,
+		SignerID:   timeout.SignerID,
+		SigData:    timeout.SigData,
+	}
 }
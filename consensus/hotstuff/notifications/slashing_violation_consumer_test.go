@@ -0,0 +1,109 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/helper"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+	modulemock "github.com/onflow/flow-go/module/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestSlashingViolationsConsumer_DoubleVote checks that a double vote is turned into well-formed evidence.
+func TestSlashingViolationsConsumer_DoubleVote(t *testing.T) {
+	collector := &modulemock.SlashingEvidenceCollector{}
+	consumer := NewSlashingViolationsConsumer(zerolog.Nop(), flow.Emulator, &storagemock.Headers{}, collector)
+
+	vote1 := unittest.VoteFixture()
+	vote2 := unittest.VoteFixture(func(vote *model.Vote) {
+		vote.View = vote1.View
+		vote.SignerID = vote1.SignerID
+	})
+
+	collector.On("AddEvidence", mock.Anything).Run(func(args mock.Arguments) {
+		evidence := args.Get(0).(*flow.SlashingEvidence)
+		require.NoError(t, evidence.Validate())
+		assert.Equal(t, flow.SlashingEvidenceDoubleVote, evidence.Type)
+		assert.Equal(t, flow.Emulator, evidence.ChainID)
+		assert.Equal(t, vote1.View, evidence.View)
+		assert.Equal(t, vote1.SignerID, evidence.OffenderID)
+		assert.Equal(t, vote1.SigData, evidence.Votes[0].SigData)
+		assert.Equal(t, vote2.SigData, evidence.Votes[1].SigData)
+	}).Once()
+
+	consumer.OnDoubleVotingDetected(vote1, vote2)
+	collector.AssertExpectations(t)
+}
+
+// TestSlashingViolationsConsumer_DoubleTimeout checks that a double timeout is turned into well-formed evidence.
+func TestSlashingViolationsConsumer_DoubleTimeout(t *testing.T) {
+	collector := &modulemock.SlashingEvidenceCollector{}
+	consumer := NewSlashingViolationsConsumer(zerolog.Nop(), flow.Emulator, &storagemock.Headers{}, collector)
+
+	timeout1 := helper.TimeoutObjectFixture()
+	timeout2 := helper.TimeoutObjectFixture(
+		helper.WithTimeoutObjectView(timeout1.View),
+		helper.WithTimeoutObjectSignerID(timeout1.SignerID),
+	)
+
+	collector.On("AddEvidence", mock.Anything).Run(func(args mock.Arguments) {
+		evidence := args.Get(0).(*flow.SlashingEvidence)
+		require.NoError(t, evidence.Validate())
+		assert.Equal(t, flow.SlashingEvidenceDoubleTimeout, evidence.Type)
+		assert.Equal(t, timeout1.View, evidence.View)
+		assert.Equal(t, timeout1.SignerID, evidence.OffenderID)
+		assert.Equal(t, timeout1.NewestQC, evidence.Timeouts[0].NewestQC)
+		assert.Equal(t, timeout2.NewestQC, evidence.Timeouts[1].NewestQC)
+	}).Once()
+
+	consumer.OnDoubleTimeoutDetected(timeout1, timeout2)
+	collector.AssertExpectations(t)
+}
+
+// TestSlashingViolationsConsumer_DoubleProposal checks that a double proposal is turned into
+// evidence holding the full headers of both proposals.
+func TestSlashingViolationsConsumer_DoubleProposal(t *testing.T) {
+	collector := &modulemock.SlashingEvidenceCollector{}
+	headers := &storagemock.Headers{}
+	consumer := NewSlashingViolationsConsumer(zerolog.Nop(), flow.Emulator, headers, collector)
+
+	header1 := unittest.BlockHeaderFixtureOnChain(flow.Emulator)
+	header2 := header1
+	header2.PayloadHash = unittest.IdentifierFixture()
+	headers.On("ByBlockID", header1.ID()).Return(&header1, nil)
+	headers.On("ByBlockID", header2.ID()).Return(&header2, nil)
+
+	collector.On("AddEvidence", mock.Anything).Run(func(args mock.Arguments) {
+		evidence := args.Get(0).(*flow.SlashingEvidence)
+		require.NoError(t, evidence.Validate())
+		assert.Equal(t, flow.SlashingEvidenceDoubleProposal, evidence.Type)
+		assert.Equal(t, header1.ProposerID, evidence.OffenderID)
+		assert.Equal(t, []*flow.Header{&header1, &header2}, evidence.Proposals)
+	}).Once()
+
+	consumer.OnDoubleProposeDetected(model.BlockFromFlow(&header1, 0), model.BlockFromFlow(&header2, 0))
+	collector.AssertExpectations(t)
+
+	// without the stored proposals, no evidence can be built
+	unknown := helper.MakeBlock()
+	headers.On("ByBlockID", unknown.BlockID).Return(nil, storage.ErrNotFound)
+	consumer.OnDoubleProposeDetected(model.BlockFromFlow(&header1, 0), unknown)
+	collector.AssertNumberOfCalls(t, "AddEvidence", 1)
+}
+
+// TestSlashingViolationsConsumer_InvalidVote checks that invalid votes are not turned into evidence.
+func TestSlashingViolationsConsumer_InvalidVote(t *testing.T) {
+	collector := &modulemock.SlashingEvidenceCollector{}
+	consumer := NewSlashingViolationsConsumer(zerolog.Nop(), flow.Emulator, &storagemock.Headers{}, collector)
+
+	consumer.OnInvalidVoteDetected(unittest.VoteFixture())
+	collector.AssertNotCalled(t, "AddEvidence", mock.Anything)
+}
@@ -124,6 +124,14 @@ func (t *TelemetryConsumer) OnQcTriggeredViewChange(qc *flow.QuorumCertificate,
 		Msg("OnQcTriggeredViewChange")
 }
 
+func (t *TelemetryConsumer) OnTcTriggeredViewChange(tc *flow.TimeoutCertificate, newView uint64) {
+	t.pathHandler.NextStep().
+		Uint64("tc_view", tc.View).
+		Uint64("next_view", newView).
+		Uint64("newest_qc_view", tc.NewestQC.View).
+		Msg("OnTcTriggeredViewChange")
+}
+
 func (t *TelemetryConsumer) OnProposingBlock(proposal *model.Proposal) {
 	block := proposal.Block
 	step := t.pathHandler.NextStep()
@@ -148,6 +156,14 @@ func (t *TelemetryConsumer) OnVoting(vote *model.Vote) {
 		Msg("OnVoting")
 }
 
+func (t *TelemetryConsumer) OnTimingOut(timeout *model.TimeoutObject) {
+	t.pathHandler.NextStep().
+		Uint64("timeout_view", timeout.View).
+		Uint64("newest_qc_view", timeout.NewestQC.View).
+		Hex("signer_id", timeout.SignerID[:]).
+		Msg("OnTimingOut")
+}
+
 func (t *TelemetryConsumer) OnForkChoiceGenerated(current_view uint64, qc *flow.QuorumCertificate) {
 	t.pathHandler.NextStep().
 		Uint64("block_view", current_view).
@@ -165,6 +181,15 @@ func (t *TelemetryConsumer) OnQcConstructedFromVotes(curView uint64, qc *flow.Qu
 		Msg("OnQcConstructedFromVotes")
 }
 
+func (t *TelemetryConsumer) OnTcConstructedFromTimeouts(curView uint64, tc *flow.TimeoutCertificate) {
+	t.pathHandler.StartNextPath(curView)
+	t.pathHandler.NextStep().
+		Uint64("curView", curView).
+		Uint64("tc_view", tc.View).
+		Uint64("newest_qc_view", tc.NewestQC.View).
+		Msg("OnTcConstructedFromTimeouts")
+}
+
 func (t *TelemetryConsumer) OnQcIncorporated(qc *flow.QuorumCertificate) {
 	t.pathHandler.NextStep().
 		Uint64("qc_block_view", qc.View).
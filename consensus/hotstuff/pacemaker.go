@@ -8,6 +8,9 @@ import (
 )
 
 // PaceMaker for HotStuff. The component is passive in that it only reacts to method calls.
+// The PaceMaker only changes views on certified evidence: it enters view V+1 when it learns
+// a QC for view V (the committee made progress) or a TC for view V (a super-majority of the
+// committee timed out). A local timeout does not change the view.
 // The PaceMaker does not perform state transitions on its own. Timeouts are emitted through
 // channels. Each timeout has its own dedicated channel, which is garbage collected after the
 // respective state has been passed. It is the EventHandler's responsibility to pick up
@@ -40,29 +43,34 @@ type PaceMaker interface {
 	// CurView returns the current view.
 	CurView() uint64
 
-	// UpdateCurViewWithQC will check if the given QC will allow PaceMaker to fast
+	// NewestQC returns the QC with the largest view, which the PaceMaker has processed.
+	NewestQC() *flow.QuorumCertificate
+
+	// LastViewTC returns the TC for the last view. It is nil if the current view was
+	// entered through a QC rather than a TC.
+	LastViewTC() *flow.TimeoutCertificate
+
+	// ProcessQC will check if the given QC will allow PaceMaker to fast
 	// forward to QC.view+1. If PaceMaker incremented the current View, a NewViewEvent will be returned.
-	UpdateCurViewWithQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool)
+	// The QC is expected to be valid.
+	ProcessQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool)
 
-	// UpdateCurViewWithBlock will check if the given block will allow PaceMaker to fast forward
-	// to the BlockProposal's view. If yes, the PaceMaker will update it's internal value for
-	// CurView and return a NewViewEvent.
-	//
-	// The parameter `nextPrimary` indicates to the PaceMaker whether or not this replica is the
-	// primary for the NEXT view taking block.view as reference.
-	// True corresponds to this replica being the next primary.
-	UpdateCurViewWithBlock(block *model.Block, isLeaderForNextView bool) (*model.NewViewEvent, bool)
+	// ProcessTC will check if the given TC will allow PaceMaker to fast
+	// forward to TC.view+1. If PaceMaker incremented the current View, a NewViewEvent will be returned.
+	// A nil TC is accepted and is a no-op. The TC is expected to be valid.
+	ProcessTC(tc *flow.TimeoutCertificate) (*model.NewViewEvent, bool)
 
 	// TimeoutChannel returns the timeout channel for the CURRENTLY ACTIVE timeout.
 	// Each time the pace maker starts a new timeout, this channel is replaced.
 	TimeoutChannel() <-chan time.Time
 
 	// OnTimeout is called when a timeout, which was previously created by the PaceMaker, has
-	// looped through the event loop. When used correctly, OnTimeout will always return
-	// a NewViewEvent.
+	// looped through the event loop. The PaceMaker does _not_ change the view on a local
+	// timeout. Instead, it restarts the timeout for the current view, so that the replica
+	// periodically re-broadcasts its timeout object until it learns a QC or TC for the view.
 	// It is the responsibility of the calling code to ensure that NO STALE timeouts are
 	// delivered to the PaceMaker.
-	OnTimeout() *model.NewViewEvent
+	OnTimeout()
 
 	// Start starts the PaceMaker (i.e. the timeout for the configured starting value for view).
 	Start()
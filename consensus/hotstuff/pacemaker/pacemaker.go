@@ -12,27 +12,47 @@ import (
 	"github.com/onflow/flow-go/model/flow"
 )
 
-// NitroPaceMaker implements the hotstuff.PaceMaker
+// ActivePaceMaker implements the hotstuff.PaceMaker
 // Its an aggressive pacemaker with exponential increase on timeout as well as
 // exponential decrease on progress. Progress is defined as entering view V
-// for which the replica knows a QC with V = QC.view + 1
-type NitroPaceMaker struct {
+// for which the replica knows a QC with V = QC.view + 1.
+// The pacemaker only changes views on certified evidence, i.e. a QC or a TC for
+// the current (or a later) view. On a local timeout, the replica remains in its
+// current view until a TC for the view has been constructed.
+type ActivePaceMaker struct {
 	currentView    uint64
+	newestQC       *flow.QuorumCertificate
+	lastViewTC     *flow.TimeoutCertificate
 	timeoutControl *timeout.Controller
 	notifier       hotstuff.Consumer
 	started        *atomic.Bool
 }
 
-// New creates a new NitroPaceMaker instance
-// startView is the view for the pacemaker to start from
+var _ hotstuff.PaceMaker = (*ActivePaceMaker)(nil)
+
+// New creates a new ActivePaceMaker instance
+// newestQC is the newest QC known to the replica, lastViewTC is an optional TC for
+// the view newestQC.View+1 or later. The pacemaker starts in the view that is justified
+// by the newest of both certificates.
 // timeoutController controls the timeout trigger.
 // notifier provides callbacks for pacemaker events.
-func New(startView uint64, timeoutController *timeout.Controller, notifier hotstuff.Consumer) (*NitroPaceMaker, error) {
-	if startView < 1 {
-		return nil, model.NewConfigurationErrorf("Please start PaceMaker with view > 0. (View 0 is reserved for genesis block, which has no proposer)")
+func New(newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate, timeoutController *timeout.Controller, notifier hotstuff.Consumer) (*ActivePaceMaker, error) {
+	if newestQC == nil {
+		return nil, model.NewConfigurationErrorf("PaceMaker requires the newest QC to determine its starting view")
+	}
+	startView := newestQC.View + 1
+	if lastViewTC != nil {
+		if lastViewTC.View < newestQC.View {
+			// the TC is older than the QC and does not justify any view
+			lastViewTC = nil
+		} else {
+			startView = lastViewTC.View + 1
+		}
 	}
-	pm := NitroPaceMaker{
+	pm := ActivePaceMaker{
 		currentView:    startView,
+		newestQC:       newestQC,
+		lastViewTC:     lastViewTC,
 		timeoutControl: timeoutController,
 		notifier:       notifier,
 		started:        atomic.NewBool(false),
@@ -42,9 +62,9 @@ func New(startView uint64, timeoutController *timeout.Controller, notifier hotst
 
 // gotoView updates the current view to newView. Currently, the calling code
 // ensures that the view number is STRICTLY monotonously increasing. The method
-// gotoView panics as a last resort if FlowPaceMaker is modified to violate this condition.
+// gotoView panics as a last resort if ActivePaceMaker is modified to violate this condition.
 // Hence, gotoView will _always_ return a NewViewEvent for an _increased_ view number.
-func (p *NitroPaceMaker) gotoView(newView uint64) *model.NewViewEvent {
+func (p *ActivePaceMaker) gotoView(newView uint64) *model.NewViewEvent {
 	if newView <= p.currentView {
 		// This should never happen: in the current implementation, it is trivially apparent that
 		// newView is _always_ larger than currentView. This check is to protect the code from
@@ -59,21 +79,40 @@ func (p *NitroPaceMaker) gotoView(newView uint64) *model.NewViewEvent {
 }
 
 // CurView returns the current view
-func (p *NitroPaceMaker) CurView() uint64 {
+func (p *ActivePaceMaker) CurView() uint64 {
 	return p.currentView
 }
 
+// NewestQC returns the QC with the largest view, which the pacemaker has processed.
+func (p *ActivePaceMaker) NewestQC() *flow.QuorumCertificate {
+	return p.newestQC
+}
+
+// LastViewTC returns the TC for the last view. It is nil if the current view was
+// entered through a QC.
+func (p *ActivePaceMaker) LastViewTC() *flow.TimeoutCertificate {
+	return p.lastViewTC
+}
+
 // TimeoutChannel returns the timeout channel for current active timeout.
 // Note the returned timeout channel returns only one timeout, which is the current
 // timeout.
 // To get the timeout for the next timeout, you need to call TimeoutChannel() again.
-func (p *NitroPaceMaker) TimeoutChannel() <-chan time.Time {
+func (p *ActivePaceMaker) TimeoutChannel() <-chan time.Time {
 	return p.timeoutControl.Channel()
 }
 
-// UpdateCurViewWithQC notifies the pacemaker with a new QC, which might allow pacemaker to
+// updateNewestQC records the QC if it is newer than the newest QC known so far.
+func (p *ActivePaceMaker) updateNewestQC(qc *flow.QuorumCertificate) {
+	if p.newestQC == nil || qc.View > p.newestQC.View {
+		p.newestQC = qc
+	}
+}
+
+// ProcessQC notifies the pacemaker with a new QC, which might allow pacemaker to
 // fast forward its view.
-func (p *NitroPaceMaker) UpdateCurViewWithQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool) {
+func (p *ActivePaceMaker) ProcessQC(qc *flow.QuorumCertificate) (*model.NewViewEvent, bool) {
+	p.updateNewestQC(qc)
 	if qc.View < p.currentView {
 		return nil, false
 	}
@@ -84,66 +123,47 @@ func (p *NitroPaceMaker) UpdateCurViewWithQC(qc *flow.QuorumCertificate) (*model
 	p.timeoutControl.OnProgressBeforeTimeout()
 
 	newView := qc.View + 1
+	p.lastViewTC = nil
 	p.notifier.OnQcTriggeredViewChange(qc, newView)
 	return p.gotoView(newView), true
 }
 
-// UpdateCurViewWithBlock indicates the pacermaker that the block for the current view has received.
-// and isLeaderForNextView indicates whether or not this replica is the primary for the NEXT view.
-func (p *NitroPaceMaker) UpdateCurViewWithBlock(block *model.Block, isLeaderForNextView bool) (*model.NewViewEvent, bool) {
-	// use block's QC to fast-forward if possible
-	newViewOnQc, newViewOccurredOnQc := p.UpdateCurViewWithQC(block.QC)
-	if block.View != p.currentView {
-		return newViewOnQc, newViewOccurredOnQc
-	}
-	// block is for current view
-
-	if p.timeoutControl.TimerInfo().Mode != model.ReplicaTimeout {
-		// i.e. we are already on timeout.VoteCollectionTimeout.
-		// This edge case can occur as follows:
-		// * we previously already have processed a block for the current view
-		//   and started the vote collection phase
-		// In this case, we do NOT want to RE-start the vote collection timer
-		// if we get a second block for the current View.
+// ProcessTC notifies the pacemaker with a new TC, which might allow pacemaker to
+// fast forward its view. A nil TC, or a malformed TC without a newest QC, is a no-op.
+func (p *ActivePaceMaker) ProcessTC(tc *flow.TimeoutCertificate) (*model.NewViewEvent, bool) {
+	if tc == nil || tc.NewestQC == nil {
 		return nil, false
 	}
-	newViewOnBlock, newViewOccurredOnBlock := p.actOnBlockForCurView(block, isLeaderForNextView)
-	if !newViewOccurredOnBlock { // if processing current block didn't lead to NewView event,
-		// the initial processing of the block's QC still might have changes the view:
-		return newViewOnQc, newViewOccurredOnQc
-	}
-	// processing current block created NewView event, which is always newer than any potential newView event from processing the block's QC
-	return newViewOnBlock, newViewOccurredOnBlock
-}
-
-func (p *NitroPaceMaker) actOnBlockForCurView(block *model.Block, isLeaderForNextView bool) (*model.NewViewEvent, bool) {
-	if isLeaderForNextView {
-		timerInfo := p.timeoutControl.StartTimeout(model.VoteCollectionTimeout, p.currentView)
-		p.notifier.OnStartingTimeout(timerInfo)
+	p.updateNewestQC(tc.NewestQC)
+	if tc.View < p.currentView {
 		return nil, false
 	}
-	if block.QC.View+1 == p.currentView {
-		// only decrease timeout if block has been build on a quorum from the previous view;
-		// otherwise, the committee is still not synchronized (as the qc is from a view _prior_ to the previous one)
-		p.timeoutControl.OnProgressBeforeTimeout()
-	}
-	return p.gotoView(p.currentView + 1), true
+	// tc.view = p.currentView + k for k ≥ 0
+	// 2/3 of replicas have timed out in view p.currentView + k and abandoned it
+	// => replica can skip ahead to view tc.view + 1
+	newView := tc.View + 1
+	p.lastViewTC = tc
+	p.notifier.OnTcTriggeredViewChange(tc, newView)
+	return p.gotoView(newView), true
 }
 
 // OnTimeout notifies the pacemaker that the timeout event has looped through the event loop.
-// It always trigger a view change, and the new view will be returned as NewViewEvent
-func (p *NitroPaceMaker) OnTimeout() *model.NewViewEvent {
+// It does not change the view. Instead, the timeout for the current view is restarted
+// (with an increased duration), so that the replica re-broadcasts its timeout object
+// until a QC or TC for the current view is known.
+func (p *ActivePaceMaker) OnTimeout() {
 	p.emitTimeoutNotifications(p.timeoutControl.TimerInfo())
 	p.timeoutControl.OnTimeout()
-	return p.gotoView(p.currentView + 1)
+	timerInfo := p.timeoutControl.StartTimeout(model.ReplicaTimeout, p.currentView)
+	p.notifier.OnStartingTimeout(timerInfo)
 }
 
-func (p *NitroPaceMaker) emitTimeoutNotifications(timeout *model.TimerInfo) {
+func (p *ActivePaceMaker) emitTimeoutNotifications(timeout *model.TimerInfo) {
 	p.notifier.OnReachedTimeout(timeout)
 }
 
 // Start starts the pacemaker
-func (p *NitroPaceMaker) Start() {
+func (p *ActivePaceMaker) Start() {
 	if p.started.Swap(true) {
 		return
 	}
@@ -152,6 +172,6 @@ func (p *NitroPaceMaker) Start() {
 }
 
 // BlockRateDelay returns the delay for broadcasting its own proposals.
-func (p *NitroPaceMaker) BlockRateDelay() time.Duration {
+func (p *ActivePaceMaker) BlockRateDelay() time.Duration {
 	return p.timeoutControl.BlockRateDelay()
 }
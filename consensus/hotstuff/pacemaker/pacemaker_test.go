@@ -1,7 +1,6 @@
 package pacemaker
 
 import (
-	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -51,7 +50,7 @@ func initPaceMaker(t *testing.T, view uint64) (hotstuff.PaceMaker, *mocks.Consum
 	if err != nil {
 		t.Fail()
 	}
-	pm, err := New(view, timeout.NewController(tc), notifier)
+	pm, err := New(QC(view-1), nil, timeout.NewController(tc), notifier)
 	if err != nil {
 		t.Fail()
 	}
@@ -64,8 +63,36 @@ func QC(view uint64) *flow.QuorumCertificate {
 	return &flow.QuorumCertificate{View: view}
 }
 
-func makeBlock(qcView, blockView uint64) *model.Block {
-	return &model.Block{View: blockView, QC: QC(qcView)}
+func TC(view uint64, newestQCView uint64) *flow.TimeoutCertificate {
+	return &flow.TimeoutCertificate{View: view, NewestQC: QC(newestQCView)}
+}
+
+// Test_New tests that the PaceMaker starts in the view which is justified by the
+// newest QC, or by the TC for the last view, if it is newer.
+func Test_New(t *testing.T) {
+	controller := timeout.NewController(timeout.DefaultConfig)
+	notifier := &mocks.Consumer{}
+
+	pm, err := New(QC(5), nil, controller, notifier)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), pm.CurView())
+	assert.Equal(t, QC(5), pm.NewestQC())
+	assert.Nil(t, pm.LastViewTC())
+
+	tc := TC(8, 5)
+	pm, err = New(QC(5), tc, controller, notifier)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), pm.CurView())
+	assert.Equal(t, tc, pm.LastViewTC())
+
+	// TC older than the newest QC is ignored
+	pm, err = New(QC(5), TC(4, 3), controller, notifier)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), pm.CurView())
+	assert.Nil(t, pm.LastViewTC())
+
+	_, err = New(nil, nil, controller, notifier)
+	require.True(t, model.IsConfigurationError(err))
 }
 
 // Test_SkipIncreaseViewThroughQC tests that PaceMaker increases View when receiving QC,
@@ -76,217 +103,113 @@ func Test_SkipIncreaseViewThroughQC(t *testing.T) {
 	qc := QC(3)
 	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
 	notifier.On("OnQcTriggeredViewChange", qc, uint64(4)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithQC(qc)
+	nve, nveOccurred := pm.ProcessQC(qc)
 	notifier.AssertExpectations(t)
 	assert.Equal(t, uint64(4), pm.CurView())
 	assert.True(t, nveOccurred && nve.View == 4)
+	assert.Equal(t, qc, pm.NewestQC())
 
 	qc = QC(12)
 	notifier.On("OnStartingTimeout", expectedTimerInfo(13, model.ReplicaTimeout)).Return().Once()
 	notifier.On("OnQcTriggeredViewChange", qc, uint64(13)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithQC(qc)
+	nve, nveOccurred = pm.ProcessQC(qc)
 	assert.True(t, nveOccurred && nve.View == 13)
 
 	notifier.AssertExpectations(t)
 	assert.Equal(t, uint64(13), pm.CurView())
+	assert.Equal(t, qc, pm.NewestQC())
 }
 
-// Test_IgnoreOldBlocks tests that PaceMaker ignores old blocks
+// Test_IgnoreOldQC tests that PaceMaker ignores old QCs
 func Test_IgnoreOldQC(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 3)
-	nve, nveOccurred := pm.UpdateCurViewWithQC(QC(2))
+	nve, nveOccurred := pm.ProcessQC(QC(1))
 	assert.True(t, !nveOccurred && nve == nil)
 	notifier.AssertExpectations(t)
 	assert.Equal(t, uint64(3), pm.CurView())
+	assert.Equal(t, QC(2), pm.NewestQC())
 }
 
-// Test_SkipViewThroughBlock tests that PaceMaker skips View when receiving Block containing QC with larger View Number
-func Test_SkipViewThroughBlock(t *testing.T) {
+// Test_SkipViewThroughTC tests that PaceMaker increases View when receiving TC,
+// if applicable, by skipping views
+func Test_SkipViewThroughTC(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 3)
 
-	block := makeBlock(5, 9)
-	notifier.On("OnStartingTimeout", expectedTimerInfo(6, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(6)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(block, true)
+	tc := TC(3, 2)
+	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
+	notifier.On("OnTcTriggeredViewChange", tc, uint64(4)).Return().Once()
+	nve, nveOccurred := pm.ProcessTC(tc)
 	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(6), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 6)
+	assert.Equal(t, uint64(4), pm.CurView())
+	assert.True(t, nveOccurred && nve.View == 4)
+	assert.Equal(t, tc, pm.LastViewTC())
 
-	block = makeBlock(22, 25)
-	notifier.On("OnStartingTimeout", expectedTimerInfo(23, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(23)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, false)
-	assert.True(t, nveOccurred && nve.View == 23)
+	// TC carrying a QC newer than the newest QC known so far
+	tc = TC(12, 10)
+	notifier.On("OnStartingTimeout", expectedTimerInfo(13, model.ReplicaTimeout)).Return().Once()
+	notifier.On("OnTcTriggeredViewChange", tc, uint64(13)).Return().Once()
+	nve, nveOccurred = pm.ProcessTC(tc)
+	assert.True(t, nveOccurred && nve.View == 13)
 
 	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(23), pm.CurView())
+	assert.Equal(t, uint64(13), pm.CurView())
+	assert.Equal(t, tc, pm.LastViewTC())
+	assert.Equal(t, tc.NewestQC, pm.NewestQC())
 }
 
-// Test_HandlesSkipViewAttack verifies that PaceMaker skips views based on QC.view
-// but NOT based on block.View to avoid vulnerability against Fast-Forward Attack
-func Test_HandlesSkipViewAttack(t *testing.T) {
+// Test_IgnoreOldTC tests that PaceMaker ignores old TCs and nil TCs, while still
+// learning a newer QC from an old TC.
+func Test_IgnoreOldTC(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 3)
 
-	block := makeBlock(5, 9)
-	notifier.On("OnStartingTimeout", expectedTimerInfo(6, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(6)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(block, true)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(6), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 6)
-
-	block = makeBlock(14, 23)
-	notifier.On("OnStartingTimeout", expectedTimerInfo(15, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(15)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, false)
-	assert.True(t, nveOccurred && nve.View == 15)
+	nve, nveOccurred := pm.ProcessTC(nil)
+	assert.True(t, !nveOccurred && nve == nil)
 
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(15), pm.CurView())
-}
+	// old TC with old QC
+	nve, nveOccurred = pm.ProcessTC(TC(1, 0))
+	assert.True(t, !nveOccurred && nve == nil)
+	assert.Equal(t, QC(2), pm.NewestQC())
 
-// Test_IgnoreOldBlocks tests that PaceMaker ignores old blocks
-func Test_IgnoreOldBlocks(t *testing.T) {
-	pm, notifier := initPaceMaker(t, 3)
-	pm.UpdateCurViewWithBlock(makeBlock(1, 2), false)
-	pm.UpdateCurViewWithBlock(makeBlock(1, 2), true)
 	notifier.AssertExpectations(t)
 	assert.Equal(t, uint64(3), pm.CurView())
+	assert.Nil(t, pm.LastViewTC())
 }
 
-// Test_ProcessBlockForCurrentView tests that PaceMaker processes the block for the current view correctly
-func Test_ProcessBlockForCurrentView(t *testing.T) {
+// Test_IgnoreTCWithoutNewestQC tests that PaceMaker ignores a malformed TC without a newest QC,
+// even if it is for the current view.
+func Test_IgnoreTCWithoutNewestQC(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 3)
-	notifier.On("OnStartingTimeout", expectedTimerInfo(3, model.VoteCollectionTimeout)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(makeBlock(1, 3), true)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(3), pm.CurView())
+
+	nve, nveOccurred := pm.ProcessTC(&flow.TimeoutCertificate{View: 3})
 	assert.True(t, !nveOccurred && nve == nil)
 
-	pm, notifier = initPaceMaker(t, 3)
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(makeBlock(1, 3), false)
 	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(4), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 4)
+	assert.Equal(t, uint64(3), pm.CurView())
+	assert.Equal(t, QC(2), pm.NewestQC())
+	assert.Nil(t, pm.LastViewTC())
 }
 
-// Test_FutureBlockWithQcForCurrentView tests that PaceMaker processes the block with
-//    block.qc.view = curView
-//    block.view = curView +1
-// correctly. Specifically, it should induce a view change to the block.view, which
-// enables processing the block right away, i.e. switch to block.view + 1
-func Test_FutureBlockWithQcForCurrentView(t *testing.T) {
-	block := makeBlock(3, 4)
-
-	// NOT Primary for the Block's view
+// Test_QCResetsLastViewTC tests that entering a view through a QC resets the TC for the last view.
+func Test_QCResetsLastViewTC(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 3)
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(5, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(4)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(block, false)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(5), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 5)
 
-	// PRIMARY for the Block's view
-	pm, notifier = initPaceMaker(t, 3)
+	tc := TC(3, 2)
 	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.VoteCollectionTimeout)).Return().Once()
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(4)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, true)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(4), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 4)
-}
-
-// Test_FutureBlockWithQcForCurrentView tests that PaceMaker processes the block with
-//    block.qc.view > curView
-//    block.view = block.qc.view +1
-// correctly. Specifically, it should induce a view change to block.view, which
-// enables processing the block right away, i.e. switch to block.view + 1
-func Test_FutureBlockWithQcForFutureView(t *testing.T) {
-	block := makeBlock(13, 14)
+	notifier.On("OnTcTriggeredViewChange", tc, uint64(4)).Return().Once()
+	pm.ProcessTC(tc)
+	assert.Equal(t, tc, pm.LastViewTC())
 
-	pm, notifier := initPaceMaker(t, 3)
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(14)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(14, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(15, model.ReplicaTimeout)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(block, false)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(15), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 15)
-
-	pm, notifier = initPaceMaker(t, 3)
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(14)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(14, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(14, model.VoteCollectionTimeout)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, true)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(14), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 14)
-}
-
-// Test_FutureBlockWithQcForCurrentView tests that PaceMaker processes the block with
-//    block.qc.view > curView
-//    block.view > block.qc.view +1
-// correctly. Specifically, it should induce a view change to the block.qc.view +1,
-// which is not sufficient to process the block. I.e. we expect view change to block.qc.view +1
-// enables processing the block right away.
-func Test_FutureBlockWithQcForFutureFutureView(t *testing.T) {
-	block := makeBlock(13, 17)
-
-	pm, notifier := initPaceMaker(t, 3)
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(14)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(14, model.ReplicaTimeout)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(block, false)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(14), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 14)
-
-	pm, notifier = initPaceMaker(t, 3)
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(14)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(14, model.ReplicaTimeout)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, true)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(14), pm.CurView())
-	assert.True(t, nveOccurred && nve.View == 14)
-}
-
-// Test_IgnoreBlockDuplicates tests that PaceMaker ignores duplicate blocks
-func Test_IgnoreBlockDuplicates(t *testing.T) {
-	block := makeBlock(3, 4)
-
-	// NOT Primary for the Block's view
-	pm, notifier := initPaceMaker(t, 3)
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(4)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
+	qc := QC(4)
 	notifier.On("OnStartingTimeout", expectedTimerInfo(5, model.ReplicaTimeout)).Return().Once()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(block, false)
-	assert.True(t, nveOccurred && nve.View == 5)
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, false)
-	assert.True(t, !nveOccurred && nve == nil)
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, false)
-	assert.True(t, !nveOccurred && nve == nil)
+	notifier.On("OnQcTriggeredViewChange", qc, uint64(5)).Return().Once()
+	pm.ProcessQC(qc)
 	notifier.AssertExpectations(t)
 	assert.Equal(t, uint64(5), pm.CurView())
-
-	// PRIMARY for the Block's view
-	pm, notifier = initPaceMaker(t, 3)
-	notifier.On("OnQcTriggeredViewChange", block.QC, uint64(4)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.VoteCollectionTimeout)).Return().Once()
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, true)
-	assert.True(t, nveOccurred && nve.View == 4)
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, true)
-	assert.True(t, !nveOccurred && nve == nil)
-	nve, nveOccurred = pm.UpdateCurViewWithBlock(block, true)
-	assert.True(t, !nveOccurred && nve == nil)
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(4), pm.CurView())
+	assert.Nil(t, pm.LastViewTC())
 }
 
-// Test_ReplicaTimeout tests that replica timeout fires as expected
+// Test_ReplicaTimeout tests that replica timeout fires as expected and that the
+// PaceMaker remains in its current view when processing its local timeout
 func Test_ReplicaTimeout(t *testing.T) {
 	start := time.Now()
 	pm, notifier := initPaceMaker(t, 3) // initPaceMaker also calls Start() on PaceMaker
@@ -298,7 +221,6 @@ func Test_ReplicaTimeout(t *testing.T) {
 		t.Fail() // to prevent test from hanging
 	}
 	actualTimeout := float64(time.Since(start).Milliseconds()) // in millisecond
-	fmt.Println(actualTimeout)
 	assert.GreaterOrEqual(t, actualTimeout, startRepTimeout, "the actual timeout should be greater or equal to the init timeout")
 	// While the timeout event has been put in the channel,
 	// PaceMaker should NOT react on it without the timeout event being processed by the EventHandler
@@ -306,19 +228,16 @@ func Test_ReplicaTimeout(t *testing.T) {
 
 	// here the, the Event loop would now call EventHandler.OnTimeout() -> PaceMaker.OnTimeout()
 	notifier.On("OnReachedTimeout", expectedTimeoutInfo(3, model.ReplicaTimeout)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
-	viewChange := pm.OnTimeout()
-	if viewChange == nil {
-		assert.Fail(t, "Expecting ViewChange event as result of timeout")
-	}
+	notifier.On("OnStartingTimeout", expectedTimerInfo(3, model.ReplicaTimeout)).Return().Once()
+	pm.OnTimeout()
 
 	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(4), pm.CurView())
+	assert.Equal(t, uint64(3), pm.CurView())
 }
 
 // Test_ViewChangeWithProgress tests that the PaceMaker respects the definition of Progress:
 // Progress is defined as entering view V for which the replica knows a QC with V = QC.view + 1
-// For this test, we feed it with a block for the current view containing a QC from the last view.
+// For this test, we feed it with a QC for the current view.
 // Hence, the PaceMaker should decrease the timeout (because the committee is synchronized).
 func Test_ViewChangeWithProgress(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 5) // initPaceMaker also calls Start() on PaceMaker
@@ -326,8 +245,9 @@ func Test_ViewChangeWithProgress(t *testing.T) {
 	// The pace maker should transition into view 6
 	// and decrease its timeout value by the following pacemaker call, as progress is made
 	notifier.On("OnStartingTimeout", expectedTimerInfo(6, model.ReplicaTimeout)).Return().Once()
+	notifier.On("OnQcTriggeredViewChange", mock.Anything, uint64(6)).Return().Once()
 	start := time.Now()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(makeBlock(4, 5), false)
+	nve, nveOccurred := pm.ProcessQC(QC(5))
 	assert.True(t, nveOccurred && nve.View == 6)
 	notifier.AssertExpectations(t)
 
@@ -339,14 +259,14 @@ func Test_ViewChangeWithProgress(t *testing.T) {
 	}
 
 	actualTimeout := float64(time.Since(start).Milliseconds()) // in millisecond
-	expectedVoteCollectionTimeout := startRepTimeout * multiplicativeDecrease
-	assert.True(t, math.Abs(actualTimeout-expectedVoteCollectionTimeout) < 0.1*expectedVoteCollectionTimeout)
+	expectedReplicaTimeout := startRepTimeout * multiplicativeDecrease
+	assert.True(t, math.Abs(actualTimeout-expectedReplicaTimeout) < 0.1*expectedReplicaTimeout)
 	assert.Equal(t, uint64(6), pm.CurView())
 }
 
 // Test_ViewChangeWithoutProgress tests that the PaceMaker respects the definition of Progress:
 // Progress is defined as entering view V for which the replica knows a QC with V = QC.view + 1
-// For this test, we feed it with a block for the current view, but containing an old QC.
+// For this test, we feed it with a TC for the current view.
 // Hence, the PaceMaker should NOT decrease the timeout (because the committee is still not synchronized)
 func Test_ViewChangeWithoutProgress(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 5) // initPaceMaker also calls Start() on PaceMaker
@@ -354,8 +274,9 @@ func Test_ViewChangeWithoutProgress(t *testing.T) {
 	// while the pace maker should transition into view 6
 	// we are not expecting a change of timeout value by the following pacemaker call, because no progress is made
 	notifier.On("OnStartingTimeout", expectedTimerInfo(6, model.ReplicaTimeout)).Return().Once()
+	notifier.On("OnTcTriggeredViewChange", mock.Anything, uint64(6)).Return().Once()
 	start := time.Now()
-	nve, nveOccurred := pm.UpdateCurViewWithBlock(makeBlock(3, 5), false)
+	nve, nveOccurred := pm.ProcessTC(TC(5, 4))
 	assert.True(t, nveOccurred && nve.View == 6)
 	notifier.AssertExpectations(t)
 
@@ -367,8 +288,8 @@ func Test_ViewChangeWithoutProgress(t *testing.T) {
 	}
 
 	actualTimeout := float64(time.Since(start).Milliseconds()) // in millisecond
-	expectedVoteCollectionTimeout := startRepTimeout
-	assert.True(t, math.Abs(actualTimeout-expectedVoteCollectionTimeout) < 0.1*expectedVoteCollectionTimeout)
+	expectedReplicaTimeout := startRepTimeout
+	assert.True(t, math.Abs(actualTimeout-expectedReplicaTimeout) < 0.1*expectedReplicaTimeout)
 	assert.Equal(t, uint64(6), pm.CurView())
 }
 
@@ -377,6 +298,8 @@ func Test_ReplicaTimeoutAgain(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 3) // initPaceMaker also calls Start() on PaceMaker
 	notifier.On("OnReachedTimeout", mock.Anything)
 	notifier.On("OnStartingTimeout", mock.Anything)
+	notifier.On("OnQcTriggeredViewChange", mock.Anything, mock.Anything)
+	notifier.On("OnTcTriggeredViewChange", mock.Anything, mock.Anything)
 
 	// wait until the timeout is hit for the first time
 	select {
@@ -392,8 +315,8 @@ func Test_ReplicaTimeoutAgain(t *testing.T) {
 	// reset timer
 	start = time.Now()
 
-	// trigger view change and restart the pacemaker timer. The next timeout should take 1.5 longer
-	_ = pm.OnTimeout()
+	// restart the pacemaker timer for the same view. The next timeout should take 1.5 longer
+	pm.OnTimeout()
 
 	// wait until the timeout is hit again
 	select {
@@ -401,20 +324,24 @@ func Test_ReplicaTimeoutAgain(t *testing.T) {
 	case <-time.After(time.Duration(3) * time.Duration(startRepTimeout) * time.Millisecond):
 	}
 
-	nv := pm.OnTimeout()
+	pm.OnTimeout()
 
 	// calculate the actual timeout duration that has been waited again
 	actualTimeout = float64(time.Since(start).Milliseconds()) // in millisecond
 	assert.GreaterOrEqual(t, actualTimeout, 1.5*startRepTimeout, "the actual timeout should be greater or equal to the timeout")
 	assert.Less(t, actualTimeout, 2*startRepTimeout, "the actual timeout was too long")
 
-	var changed bool
+	// the TC for the current view brings the replica to the next view without decreasing the timeout
+	_, changed := pm.ProcessTC(TC(pm.CurView(), pm.NewestQC().View))
+	require.True(t, changed)
+
+	var nv *model.NewViewEvent
 	// timeout reduce linearly
 	select {
 	case <-pm.TimeoutChannel():
 		break
 	case <-time.After(time.Duration(0.1 * startRepTimeout)):
-		nv, changed = pm.UpdateCurViewWithBlock(makeBlock(nv.View-1, nv.View), false)
+		nv, changed = pm.ProcessQC(QC(pm.CurView()))
 		break
 	}
 
@@ -425,13 +352,13 @@ func Test_ReplicaTimeoutAgain(t *testing.T) {
 	case <-pm.TimeoutChannel():
 		break
 	case <-time.After(time.Duration(0.1 * startRepTimeout)):
-		// start the timer before the UpdateCurViewWithBlock is called, which starts the internal timer.
+		// start the timer before the ProcessQC is called, which starts the internal timer.
 		// This is required to make the tests more accurate, because when it's running on CI,
 		// there might be some delay for time.Now() gets called, which impact the accuracy of the actual timeout
 		// measurement
 
 		start = time.Now()
-		_, changed = pm.UpdateCurViewWithBlock(makeBlock(nv.View-1, nv.View), false)
+		_, changed = pm.ProcessQC(QC(nv.View))
 		break
 	}
 
@@ -445,42 +372,7 @@ func Test_ReplicaTimeoutAgain(t *testing.T) {
 
 	actualTimeout = float64(time.Since(start).Microseconds()) * 0.001 // in millisecond
 	// the actual timeout should be startRepTimeout * 1.5 *1.5 * multiplicativeDecrease * multiplicativeDecrease
-	// because it hits timeout twice and then received blocks for current view twice
+	// because it hits timeout twice and then received QCs for current view twice
 	assert.GreaterOrEqual(t, actualTimeout, 1.5*1.5*startRepTimeout*multiplicativeDecrease*multiplicativeDecrease, "the actual timeout should be greater or equal to the timeout")
 	assert.Less(t, actualTimeout, 1.5*1.5*startRepTimeout*multiplicativeDecrease, "the actual timeout is too long")
 }
-
-// Test_VoteTimeout tests that vote timeout fires as expected
-func Test_VoteTimeout(t *testing.T) {
-	pm, notifier := initPaceMaker(t, 3) // initPaceMaker also calls Start() on PaceMaker
-	start := time.Now()
-
-	notifier.On("OnStartingTimeout", expectedTimerInfo(3, model.VoteCollectionTimeout)).Return().Once()
-	// we are not expecting a change of timeout value by the following pacemaker call, because no view change happens
-	pm.UpdateCurViewWithBlock(makeBlock(2, 3), true)
-	notifier.AssertExpectations(t)
-
-	// the pacemaker should now be running the vote collection timeout:
-	expectedVoteCollectionTimeout := startRepTimeout * voteTimeoutFraction
-	select {
-	case <-pm.TimeoutChannel():
-		break // testing path: corresponds to EventLoop picking up timeout from channel
-	case <-time.After(2 * time.Duration(expectedVoteCollectionTimeout) * time.Millisecond):
-		t.Fail() // to prevent test from hanging
-	}
-	duration := float64(time.Since(start).Milliseconds()) // in millisecond
-	assert.True(t, math.Abs(duration-expectedVoteCollectionTimeout) < 0.1*expectedVoteCollectionTimeout)
-	// While the timeout event has been put in the channel,
-	// PaceMaker should NOT react on it without the timeout event being processed by the EventHandler
-	assert.Equal(t, uint64(3), pm.CurView())
-
-	// here the, the Event loop would now call EventHandler.OnTimeout() -> PaceMaker.OnTimeout()
-	notifier.On("OnReachedTimeout", expectedTimeoutInfo(3, model.VoteCollectionTimeout)).Return().Once()
-	notifier.On("OnStartingTimeout", expectedTimerInfo(4, model.ReplicaTimeout)).Return().Once()
-	viewChange := pm.OnTimeout()
-	if viewChange == nil {
-		assert.Fail(t, "Expecting ViewChange event as result of timeout")
-	}
-	notifier.AssertExpectations(t)
-	assert.Equal(t, uint64(4), pm.CurView())
-}
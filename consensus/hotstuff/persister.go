@@ -1,5 +1,9 @@
 package hotstuff
 
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
 // Persister is responsible for persisting state we need to bootstrap after a
 // restart or crash.
 type Persister interface {
@@ -10,9 +14,23 @@ type Persister interface {
 	// GetVoted will retrieve the last voted view.
 	GetVoted() (uint64, error)
 
+	// GetNewestQC will retrieve the newest QC known to the pacemaker, which is
+	// nil if no QC has been persisted yet.
+	GetNewestQC() (*flow.QuorumCertificate, error)
+
+	// GetNewestTC will retrieve the newest TC known to the pacemaker, which is
+	// nil if no TC has been persisted yet.
+	GetNewestTC() (*flow.TimeoutCertificate, error)
+
 	// PutStarted persists the last started view.
 	PutStarted(view uint64) error
 
 	// PutVoted persists the last voted view.
 	PutVoted(view uint64) error
+
+	// PutNewestQC persists the newest QC known to the pacemaker.
+	PutNewestQC(qc *flow.QuorumCertificate) error
+
+	// PutNewestTC persists the newest TC known to the pacemaker.
+	PutNewestTC(tc *flow.TimeoutCertificate) error
 }
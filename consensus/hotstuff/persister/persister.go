@@ -1,21 +1,23 @@
 package persister
 
 import (
-	"github.com/dgraph-io/badger/v2"
+	"errors"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // Persister can persist relevant information for hotstuff.
 type Persister struct {
-	db      *badger.DB
+	db      kv.DB
 	chainID flow.ChainID
 }
 
 // New creates a nev persister using the injected stores to persist
 // relevant hotstuff data.
-func New(db *badger.DB, chainID flow.ChainID) *Persister {
+func New(db kv.DB, chainID flow.ChainID) *Persister {
 	p := &Persister{
 		db:      db,
 		chainID: chainID,
@@ -37,6 +39,34 @@ func (p *Persister) GetVoted() (uint64, error) {
 	return view, err
 }
 
+// GetNewestQC returns the last persisted newest QC, or nil if no QC has been
+// persisted yet, e.g. before the node was upgraded to persist it.
+func (p *Persister) GetNewestQC() (*flow.QuorumCertificate, error) {
+	var qc flow.QuorumCertificate
+	err := p.db.View(operation.RetrieveNewestQC(p.chainID, &qc))
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &qc, nil
+}
+
+// GetNewestTC returns the last persisted newest TC, or nil if no TC has been
+// persisted yet.
+func (p *Persister) GetNewestTC() (*flow.TimeoutCertificate, error) {
+	var tc flow.TimeoutCertificate
+	err := p.db.View(operation.RetrieveNewestTC(p.chainID, &tc))
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tc, nil
+}
+
 // PutStarted persists the view when we start it in hotstuff.
 func (p *Persister) PutStarted(view uint64) error {
 	return operation.RetryOnConflict(p.db.Update, operation.UpdateStartedView(p.chainID, view))
@@ -46,3 +76,25 @@ func (p *Persister) PutStarted(view uint64) error {
 func (p *Persister) PutVoted(view uint64) error {
 	return operation.RetryOnConflict(p.db.Update, operation.UpdateVotedView(p.chainID, view))
 }
+
+// PutNewestQC persists the newest QC known to the pacemaker.
+func (p *Persister) PutNewestQC(qc *flow.QuorumCertificate) error {
+	return operation.RetryOnConflict(p.db.Update, func(tx kv.Transaction) error {
+		err := operation.UpdateNewestQC(p.chainID, qc)(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			return operation.InsertNewestQC(p.chainID, qc)(tx)
+		}
+		return err
+	})
+}
+
+// PutNewestTC persists the newest TC known to the pacemaker.
+func (p *Persister) PutNewestTC(tc *flow.TimeoutCertificate) error {
+	return operation.RetryOnConflict(p.db.Update, func(tx kv.Transaction) error {
+		err := operation.UpdateNewestTC(p.chainID, tc)(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			return operation.InsertNewestTC(p.chainID, tc)(tx)
+		}
+		return err
+	})
+}
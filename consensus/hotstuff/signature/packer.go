@@ -89,12 +89,12 @@ func (p *ConsensusSigDataPacker) Pack(blockID flow.Identifier, sig *hotstuff.Blo
 }
 
 // Unpack de-serializes the provided signature data.
-// blockID is the block that the aggregated sig is signed for
+// view is the view of the block that the aggregated sig is signed for
 // sig is the aggregated signature data
 // It returns:
 //  - (sigData, nil) if successfully unpacked the signature data
 //  - (nil, model.ErrInvalidFormat) if failed to unpack the signature data
-func (p *ConsensusSigDataPacker) Unpack(blockID flow.Identifier, signerIDs []flow.Identifier, sigData []byte) (*hotstuff.BlockSignatureData, error) {
+func (p *ConsensusSigDataPacker) Unpack(view uint64, signerIDs []flow.Identifier, sigData []byte) (*hotstuff.BlockSignatureData, error) {
 	// decode into typed data
 	data, err := p.Decode(sigData)
 	if err != nil {
@@ -107,14 +107,15 @@ func (p *ConsensusSigDataPacker) Unpack(blockID flow.Identifier, signerIDs []flo
 		return nil, fmt.Errorf("failed to deserialize sig types from bytes: %w", err)
 	}
 
-	// read all the possible signer IDs at the given block
-	consensus, err := p.committees.Identities(blockID, filter.Any)
+	// read all the possible signer IDs in the epoch of the given view, the signed
+	// block might not be known, e.g. for the newest QC of a timeout certificate
+	consensus, err := p.committees.IdentitiesByEpoch(view, filter.Any)
 	if err != nil {
-		return nil, fmt.Errorf("could not find consensus committees by block id(%v): %w", blockID, err)
+		return nil, fmt.Errorf("could not find consensus committees by view (%d): %w", view, err)
 	}
 
 	// lookup is a map from node identifier to node identity
-	// it is used to check the given signerIDs are all valid signers at the given block
+	// it is used to check the given signerIDs are all valid signers in the epoch
 	lookup := consensus.Lookup()
 
 	// read each signer's signerID and sig type from two different slices
@@ -126,8 +127,8 @@ func (p *ConsensusSigDataPacker) Unpack(blockID flow.Identifier, signerIDs []flo
 		signerID := signerIDs[i]
 		_, ok := lookup[signerID]
 		if !ok {
-			return nil, fmt.Errorf("unknown signer ID (%v) at the given block (%v): %w",
-				signerID, blockID, model.ErrInvalidFormat)
+			return nil, fmt.Errorf("unknown signer ID (%v) at the given view (%d): %w",
+				signerID, view, model.ErrInvalidFormat)
 		}
 
 		if sigType == hotstuff.SigTypeStaking {
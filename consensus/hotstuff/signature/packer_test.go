@@ -3,6 +3,7 @@ package signature
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -24,6 +25,12 @@ func newPacker(identities flow.IdentityList) *ConsensusSigDataPacker {
 		},
 		nil,
 	)
+	committee.On("IdentitiesByEpoch", mock.Anything, mock.Anything).Return(
+		func(view uint64, selector flow.IdentityFilter) flow.IdentityList {
+			return identities.Filter(selector)
+		},
+		nil,
+	)
 
 	return NewConsensusSigDataPacker(committee)
 }
@@ -57,6 +64,7 @@ func TestPackUnpack(t *testing.T) {
 
 	// prepare data for testing
 	blockID := unittest.IdentifierFixture()
+	view := rand.Uint64()
 	blockSigData := makeBlockSigData(committee)
 
 	// create packer with the committee
@@ -66,7 +74,7 @@ func TestPackUnpack(t *testing.T) {
 	signerIDs, sig, err := packer.Pack(blockID, blockSigData)
 	require.NoError(t, err)
 
-	unpacked, err := packer.Unpack(blockID, signerIDs, sig)
+	unpacked, err := packer.Unpack(view, signerIDs, sig)
 	require.NoError(t, err)
 
 	// check that the unpack data match with the original data
@@ -91,6 +99,7 @@ func TestPackUnpackManyNodes(t *testing.T) {
 
 	// prepare data for testing
 	blockID := unittest.IdentifierFixture()
+	view := rand.Uint64()
 	blockSigData := makeBlockSigData(committee)
 	stakingSigners := make([]flow.Identifier, 0)
 	for i := 0; i < 60; i++ {
@@ -110,7 +119,7 @@ func TestPackUnpackManyNodes(t *testing.T) {
 	signerIDs, sig, err := packer.Pack(blockID, blockSigData)
 	require.NoError(t, err)
 
-	unpacked, err := packer.Unpack(blockID, signerIDs, sig)
+	unpacked, err := packer.Unpack(view, signerIDs, sig)
 	require.NoError(t, err)
 
 	// check that the unpack data match with the original data
@@ -134,6 +143,7 @@ func TestFailToDecode(t *testing.T) {
 
 	// prepare data for testing
 	blockID := unittest.IdentifierFixture()
+	view := rand.Uint64()
 	blockSigData := makeBlockSigData(committee)
 
 	// create packer with the committee
@@ -145,7 +155,7 @@ func TestFailToDecode(t *testing.T) {
 	// prepare invalid data by modifying the valid data
 	invalidSigData := sig[1:]
 
-	_, err = packer.Unpack(blockID, signerIDs, invalidSigData)
+	_, err = packer.Unpack(view, signerIDs, invalidSigData)
 
 	require.Error(t, err)
 	require.True(t, errors.Is(err, model.ErrInvalidFormat))
@@ -158,6 +168,7 @@ func TestMismatchSignerIDs(t *testing.T) {
 
 	// prepare data for testing
 	blockID := unittest.IdentifierFixture()
+	view := rand.Uint64()
 	blockSigData := makeBlockSigData(committee)
 
 	// create packer with the committee
@@ -170,7 +181,7 @@ func TestMismatchSignerIDs(t *testing.T) {
 	// remove the first signer
 	invalidSignerIDs := signerIDs[1:]
 
-	_, err = packer.Unpack(blockID, invalidSignerIDs, sig)
+	_, err = packer.Unpack(view, invalidSignerIDs, sig)
 
 	require.Error(t, err)
 	require.True(t, errors.Is(err, model.ErrInvalidFormat))
@@ -178,7 +189,7 @@ func TestMismatchSignerIDs(t *testing.T) {
 	// prepare invalid signerIDs by modifying the valid signerIDs
 	// adding one more signer
 	invalidSignerIDs = append(signerIDs, unittest.IdentifierFixture())
-	misPacked, err := packer.Unpack(blockID, invalidSignerIDs, sig)
+	misPacked, err := packer.Unpack(view, invalidSignerIDs, sig)
 
 	require.Error(t, err, fmt.Sprintf("packed signers: %v", misPacked))
 	require.True(t, errors.Is(err, model.ErrInvalidFormat))
@@ -191,6 +202,7 @@ func TestInvalidSigType(t *testing.T) {
 
 	// prepare data for testing
 	blockID := unittest.IdentifierFixture()
+	view := rand.Uint64()
 	blockSigData := makeBlockSigData(committee)
 
 	// create packer with the committee
@@ -207,7 +219,7 @@ func TestInvalidSigType(t *testing.T) {
 	encoded, err := packer.Encode(data)
 	require.NoError(t, err)
 
-	_, err = packer.Unpack(blockID, signerIDs, encoded)
+	_, err = packer.Unpack(view, signerIDs, encoded)
 
 	require.True(t, errors.Is(err, model.ErrInvalidFormat))
 }
@@ -432,6 +444,7 @@ func TestPackUnpackWithoutRBAggregatedSig(t *testing.T) {
 
 	// prepare data for testing
 	blockID := unittest.IdentifierFixture()
+	view := rand.Uint64()
 
 	blockSigData := &hotstuff.BlockSignatureData{
 		StakingSigners:               committee,
@@ -448,7 +461,7 @@ func TestPackUnpackWithoutRBAggregatedSig(t *testing.T) {
 	signerIDs, sig, err := packer.Pack(blockID, blockSigData)
 	require.NoError(t, err)
 
-	unpacked, err := packer.Unpack(blockID, signerIDs, sig)
+	unpacked, err := packer.Unpack(view, signerIDs, sig)
 	require.NoError(t, err)
 
 	// check that the unpack data match with the original data
@@ -2,9 +2,10 @@ package hotstuff
 
 import (
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
 )
 
-// Signer is responsible for creating votes, proposals for a given block.
+// Signer is responsible for creating votes, proposals for a given block and timeouts for a given view.
 type Signer interface {
 	// CreateProposal creates a proposal for the given block. No error returns
 	// are expected during normal operations (incl. presence of byz. actors).
@@ -13,4 +14,10 @@ type Signer interface {
 	// CreateVote creates a vote for the given block. No error returns are
 	// expected during normal operations (incl. presence of byz. actors).
 	CreateVote(block *model.Block) (*model.Vote, error)
+
+	// CreateTimeout creates a timeout object for the given view, signing the view together
+	// with the view of the newest QC. `lastViewTC` is the TC for the previous view, which
+	// must be provided if `newestQC` is not for the previous view. No error returns are
+	// expected during normal operations (incl. presence of byz. actors).
+	CreateTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error)
 }
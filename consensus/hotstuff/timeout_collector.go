@@ -0,0 +1,45 @@
+package hotstuff
+
+import (
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+)
+
+// TimeoutCollector collects all timeout objects for a specified view. On the happy path, it
+// generates a TimeoutCertificate when enough timeouts have been collected. It is tailored to
+// timeouts of a single view, i.e. timeouts for other views are rejected.
+// Implementations must be concurrency safe.
+type TimeoutCollector interface {
+	// AddTimeout adds a timeout object to the collector and aggregates it, once it has been
+	// validated. When enough timeouts have been collected, the TC is built and published.
+	// Repeated additions of the same timeout are a no-op.
+	// Expected error returns during normal operations:
+	//  * model.DoubleTimeoutError if the signer already provided a different timeout for this view
+	//  * model.InvalidTimeoutError if the timeout is invalid
+	AddTimeout(timeout *model.TimeoutObject) error
+
+	// View returns the view that this instance is collecting timeouts for.
+	// This method is useful when adding the newly created timeout collector to timeout collectors map.
+	View() uint64
+}
+
+// TimeoutCollectors is an interface which allows VoteAggregator to interact with timeout collectors
+// structured by view.
+// Implementations of this interface are responsible for creating `TimeoutCollector`s and pruning of
+// stale and outdated collectors by view.
+type TimeoutCollectors interface {
+	// GetOrCreateCollector retrieves the hotstuff.TimeoutCollector for the specified
+	// view or creates one if none exists.
+	// It returns:
+	//  -  (collector, true, nil) if no collector can be found by the view, and a new collector was created.
+	//  -  (collector, false, nil) if the collector can be found by the view
+	//  -  (nil, false, error) if running into any exception creating the timeout collector
+	// Expected error returns during normal operations:
+	//  * mempool.DecreasingPruningHeightError if view is below the pruning threshold
+	GetOrCreateCollector(view uint64) (collector TimeoutCollector, created bool, err error)
+
+	// PruneUpToView prunes the timeout collectors with views _below_ the given value, i.e.
+	// we only retain and process whose view is equal or larger than `lowestRetainedView`.
+	// If `lowestRetainedView` is smaller than the previous value, the previous value is
+	// kept and the method call is a NoOp.
+	PruneUpToView(lowestRetainedView uint64)
+}
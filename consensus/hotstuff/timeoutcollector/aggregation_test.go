@@ -0,0 +1,124 @@
+package timeoutcollector
+
+import (
+	"crypto/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/consensus/hotstuff/verification"
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/crypto/hash"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// createAggregationData creates a new TimeoutSignatureAggregator for the given view together
+// with the identities of all signers and their private staking keys.
+func createAggregationData(t *testing.T, view uint64, signersNumber int) (*TimeoutSignatureAggregator, flow.IdentityList, []crypto.PrivateKey) {
+	ids := make(flow.IdentityList, 0, signersNumber)
+	sks := make([]crypto.PrivateKey, 0, signersNumber)
+	seed := make([]byte, crypto.KeyGenSeedMinLenBLSBLS12381)
+	for i := 0; i < signersNumber; i++ {
+		_, err := rand.Read(seed)
+		require.NoError(t, err)
+		sk, err := crypto.GeneratePrivateKey(crypto.BLSBLS12381, seed)
+		require.NoError(t, err)
+		identity := unittest.IdentityFixture(unittest.WithStakingPubKey(sk.PublicKey()))
+		ids = append(ids, identity)
+		sks = append(sks, sk)
+	}
+	aggregator, err := NewTimeoutSignatureAggregator(view, ids, "random_tag")
+	require.NoError(t, err)
+	return aggregator, ids, sks
+}
+
+func TestTimeoutSignatureAggregator(t *testing.T) {
+	view := uint64(100)
+	signersNum := 20
+	hasher := crypto.NewBLSKMAC("random_tag")
+
+	// constructor edge cases
+	t.Run("constructor", func(t *testing.T) {
+		// empty signers
+		_, err := NewTimeoutSignatureAggregator(view, flow.IdentityList{}, "random_tag")
+		require.True(t, model.IsConfigurationError(err))
+	})
+
+	// happy path: signatures over different messages are verified, collected and aggregated
+	t.Run("happy path and thread safety", func(t *testing.T) {
+		aggregator, ids, sks := createAggregationData(t, view, signersNum)
+		var wg sync.WaitGroup
+		expectedWeight := uint64(0)
+		for i, sk := range sks {
+			newestQCView := view - 1 - uint64(i%3)
+			sig, err := sk.Sign(verification.MakeTimeoutMessage(view, newestQCView), hasher)
+			require.NoError(t, err)
+			wg.Add(1)
+			go func(signerID flow.Identifier, sig crypto.Signature, newestQCView uint64) {
+				defer wg.Done()
+				_, err := aggregator.VerifyAndAdd(signerID, sig, newestQCView)
+				assert.NoError(t, err)
+			}(ids[i].NodeID, sig, newestQCView)
+			expectedWeight += ids[i].Weight
+		}
+		wg.Wait()
+		require.Equal(t, expectedWeight, aggregator.TotalWeight())
+
+		signersInfo, aggSig, err := aggregator.Aggregate()
+		require.NoError(t, err)
+		require.Len(t, signersInfo, signersNum)
+
+		pks := make([]crypto.PublicKey, 0, len(signersInfo))
+		messages := make([][]byte, 0, len(signersInfo))
+		hashers := make([]hash.Hasher, 0, len(signersInfo))
+		for _, info := range signersInfo {
+			identity, ok := ids.ByNodeID(info.Signer)
+			require.True(t, ok)
+			pks = append(pks, identity.StakingPubKey)
+			messages = append(messages, verification.MakeTimeoutMessage(view, info.NewestQCView))
+			hashers = append(hashers, hasher)
+		}
+		valid, err := crypto.VerifyBLSSignatureManyMessages(pks, aggSig, messages, hashers)
+		require.NoError(t, err)
+		require.True(t, valid)
+	})
+
+	// sentinel errors for invalid and duplicated signatures
+	t.Run("invalid inputs", func(t *testing.T) {
+		aggregator, ids, sks := createAggregationData(t, view, signersNum)
+		sig, err := sks[0].Sign(verification.MakeTimeoutMessage(view, view-1), hasher)
+		require.NoError(t, err)
+
+		// invalid signer
+		_, err = aggregator.VerifyAndAdd(unittest.IdentifierFixture(), sig, view-1)
+		require.True(t, model.IsInvalidSignerError(err))
+
+		// signature over a different newest QC view
+		_, err = aggregator.VerifyAndAdd(ids[0].NodeID, sig, view-2)
+		require.ErrorIs(t, err, model.ErrInvalidSignature)
+
+		// signature from another signer
+		_, err = aggregator.VerifyAndAdd(ids[1].NodeID, sig, view-1)
+		require.ErrorIs(t, err, model.ErrInvalidSignature)
+		require.Equal(t, uint64(0), aggregator.TotalWeight())
+
+		// duplicated signer
+		weight, err := aggregator.VerifyAndAdd(ids[0].NodeID, sig, view-1)
+		require.NoError(t, err)
+		require.Equal(t, ids[0].Weight, weight)
+		_, err = aggregator.VerifyAndAdd(ids[0].NodeID, sig, view-1)
+		require.True(t, model.IsDuplicatedSignerError(err))
+		require.Equal(t, ids[0].Weight, aggregator.TotalWeight())
+	})
+
+	// aggregating without signatures
+	t.Run("aggregating empty set", func(t *testing.T) {
+		aggregator, _, _ := createAggregationData(t, view, signersNum)
+		_, _, err := aggregator.Aggregate()
+		require.True(t, model.IsInsufficientSignaturesError(err))
+	})
+}
@@ -0,0 +1,39 @@
+package timeoutcollector
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/voteaggregator"
+	"github.com/onflow/flow-go/model/flow/filter"
+)
+
+// NewFactoryMethod returns a factory method for creating timeout collectors for the
+// main consensus and for collector clusters. Timeouts are not tied to a block, hence
+// the timeout collector for a view aggregates timeouts from the consensus committee of
+// the epoch containing the view. The domain separation tag `dsTag` must match the tag that
+// replicas sign their timeouts with.
+func NewFactoryMethod(
+	log zerolog.Logger,
+	committee hotstuff.Committee,
+	validator hotstuff.Validator,
+	dsTag string,
+	onTCCreated OnTCCreated,
+) voteaggregator.NewTimeoutCollectorFactoryMethod {
+	return func(view uint64) (hotstuff.TimeoutCollector, error) {
+		allParticipants, err := committee.IdentitiesByEpoch(view, filter.Any)
+		if err != nil {
+			return nil, fmt.Errorf("could not get consensus participants at view %d: %w", view, err)
+		}
+
+		aggregator, err := NewTimeoutSignatureAggregator(view, allParticipants, dsTag)
+		if err != nil {
+			return nil, fmt.Errorf("could not create timeout signature aggregator for view %d: %w", view, err)
+		}
+
+		minRequiredWeight := hotstuff.ComputeWeightThresholdForBuildingQC(allParticipants.TotalWeight())
+		return NewTimeoutCollector(log, view, validator, aggregator, minRequiredWeight, onTCCreated)
+	}
+}
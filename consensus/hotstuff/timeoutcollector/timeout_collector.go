@@ -0,0 +1,168 @@
+package timeoutcollector
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// OnTCCreated is a callback which will be used by TimeoutCollector to submit a TC when it's able to create it
+type OnTCCreated func(*flow.TimeoutCertificate)
+
+// TimeoutCollector implements hotstuff.TimeoutCollector. It collects the timeout objects for a
+// single view, validates and aggregates them, and builds a TC once timeouts from replicas with
+// a super-majority of weight have been collected. Exactly one TC is built per view.
+// This structure is concurrently safe.
+type TimeoutCollector struct {
+	log         zerolog.Logger
+	view        uint64
+	validator   hotstuff.Validator
+	aggregator  hotstuff.TimeoutSignatureAggregator
+	minRequired uint64      // minimum weight required for building a TC
+	onTCCreated OnTCCreated // callback for submitting the built TC
+	lock        sync.Mutex
+	timeouts    map[flow.Identifier]*model.TimeoutObject // valid timeouts by signer ID
+	tcCreated   bool                                     // whether the TC for the view has been built
+}
+
+var _ hotstuff.TimeoutCollector = (*TimeoutCollector)(nil)
+
+// NewTimeoutCollector creates a new TimeoutCollector for the given view. The aggregator
+// must aggregate signatures for the same view; `minRequiredWeight` is the weight of
+// timeouts that is minimally required to build a TC.
+func NewTimeoutCollector(
+	log zerolog.Logger,
+	view uint64,
+	validator hotstuff.Validator,
+	aggregator hotstuff.TimeoutSignatureAggregator,
+	minRequiredWeight uint64,
+	onTCCreated OnTCCreated,
+) (*TimeoutCollector, error) {
+	if aggregator.View() != view {
+		return nil, model.NewConfigurationErrorf("aggregator for view %d cannot be used for timeout collector at view %d", aggregator.View(), view)
+	}
+	return &TimeoutCollector{
+		log:         log.With().Str("hotstuff", "TimeoutCollector").Uint64("view", view).Logger(),
+		view:        view,
+		validator:   validator,
+		aggregator:  aggregator,
+		minRequired: minRequiredWeight,
+		onTCCreated: onTCCreated,
+		timeouts:    make(map[flow.Identifier]*model.TimeoutObject),
+	}, nil
+}
+
+// View returns the view that this instance is collecting timeouts for.
+func (c *TimeoutCollector) View() uint64 {
+	return c.view
+}
+
+// AddTimeout validates and aggregates the given timeout. Once timeouts with a super-majority
+// of weight are collected, the TC is built and submitted through the `onTCCreated` callback.
+// Repeated additions of the same timeout are a no-op.
+// Expected error returns during normal operations:
+//  * model.DoubleTimeoutError if the signer already provided a different timeout for this view
+//  * model.InvalidTimeoutError if the timeout is invalid
+func (c *TimeoutCollector) AddTimeout(timeout *model.TimeoutObject) error {
+	if timeout.View != c.view {
+		return fmt.Errorf("timeout for view %d cannot be added to collector for view %d", timeout.View, c.view)
+	}
+
+	// fast path for repeated timeouts, which are common, as replicas re-broadcast their
+	// timeout when their timer fires again
+	if c.isCached(timeout) {
+		return nil
+	}
+
+	err := c.validator.ValidateTimeout(timeout)
+	if err != nil {
+		return fmt.Errorf("could not validate timeout %x: %w", timeout.ID(), err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	totalWeight, err := c.aggregator.VerifyAndAdd(timeout.SignerID, timeout.SigData, timeout.NewestQC.View)
+	if err != nil {
+		switch {
+		case model.IsDuplicatedSignerError(err):
+			// the signature is only reported as duplicated after it was verified
+			first := c.timeouts[timeout.SignerID]
+			if first.ID() == timeout.ID() {
+				return nil
+			}
+			return model.NewDoubleTimeoutErrorf(first, timeout, "detected double timeout from %x at view %d", timeout.SignerID, timeout.View)
+		case model.IsInvalidSignerError(err) || errors.Is(err, model.ErrInvalidSignature):
+			return model.NewInvalidTimeoutErrorf(timeout, "invalid timeout signature: %w", err)
+		default:
+			return fmt.Errorf("could not add signature of timeout %x: %w", timeout.ID(), err)
+		}
+	}
+	c.timeouts[timeout.SignerID] = timeout
+
+	if c.tcCreated || totalWeight < c.minRequired {
+		return nil
+	}
+
+	tc, err := c.buildTC()
+	if err != nil {
+		return fmt.Errorf("could not build TC for view %d: %w", c.view, err)
+	}
+	c.tcCreated = true
+
+	c.log.Info().
+		Uint64("newest_qc_view", tc.NewestQC.View).
+		Int("signers", len(tc.SignerIDs)).
+		Msg("timeout certificate has been created")
+
+	c.onTCCreated(tc)
+	return nil
+}
+
+// isCached returns true if exactly the same timeout has been added before.
+func (c *TimeoutCollector) isCached(timeout *model.TimeoutObject) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	cached, found := c.timeouts[timeout.SignerID]
+	return found && cached.ID() == timeout.ID()
+}
+
+// buildTC aggregates the collected timeouts into a TC. The TC's newest QC is the
+// newest QC of all aggregated timeouts.
+// CAUTION: must be called while holding the lock.
+func (c *TimeoutCollector) buildTC() (*flow.TimeoutCertificate, error) {
+	signersInfo, aggregatedSig, err := c.aggregator.Aggregate()
+	if err != nil {
+		return nil, fmt.Errorf("could not aggregate timeout signatures: %w", err)
+	}
+
+	signerIDs := make([]flow.Identifier, 0, len(signersInfo))
+	newestQCViews := make([]uint64, 0, len(signersInfo))
+	var newestQC *flow.QuorumCertificate
+	for _, info := range signersInfo {
+		timeout, found := c.timeouts[info.Signer]
+		if !found {
+			return nil, fmt.Errorf("aggregated signature of %x without timeout", info.Signer)
+		}
+		if newestQC == nil || timeout.NewestQC.View > newestQC.View {
+			newestQC = timeout.NewestQC
+		}
+		signerIDs = append(signerIDs, info.Signer)
+		newestQCViews = append(newestQCViews, info.NewestQCView)
+	}
+
+	tc := &flow.TimeoutCertificate{
+		View:          c.view,
+		NewestQCViews: newestQCViews,
+		NewestQC:      newestQC,
+		SignerIDs:     signerIDs,
+		SigData:       aggregatedSig,
+	}
+	return tc, nil
+}
@@ -0,0 +1,237 @@
+package timeoutcollector
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/helper"
+	"github.com/onflow/flow-go/consensus/hotstuff/mocks"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestTimeoutCollector(t *testing.T) {
+	suite.Run(t, new(TimeoutCollectorTestSuite))
+}
+
+// TimeoutCollectorTestSuite is a test suite for testing TimeoutCollector. It stores mocked
+// state internally for testing behavior.
+type TimeoutCollectorTestSuite struct {
+	suite.Suite
+
+	view              uint64
+	minRequiredWeight uint64
+	validator         *mocks.Validator
+	aggregator        *mocks.TimeoutSignatureAggregator
+	onTCCreatedState  mock.Mock
+	collector         *TimeoutCollector
+
+	lock        sync.Mutex
+	totalWeight uint64
+}
+
+func (s *TimeoutCollectorTestSuite) SetupTest() {
+	var err error
+	s.view = 1000
+	s.minRequiredWeight = 3000
+	s.totalWeight = 0
+	s.validator = &mocks.Validator{}
+	s.aggregator = &mocks.TimeoutSignatureAggregator{}
+	s.onTCCreatedState = mock.Mock{}
+
+	s.aggregator.On("View").Return(s.view).Maybe()
+	s.validator.On("ValidateTimeout", mock.Anything).Return(nil).Maybe()
+	s.onTCCreatedState.On("onTCCreated", mock.Anything).Return(nil).Maybe()
+
+	s.collector, err = NewTimeoutCollector(unittest.Logger(), s.view, s.validator, s.aggregator, s.minRequiredWeight, s.onTCCreated)
+	require.NoError(s.T(), err)
+}
+
+// onTCCreated is a special function that registers call in mocked state.
+func (s *TimeoutCollectorTestSuite) onTCCreated(tc *flow.TimeoutCertificate) {
+	s.onTCCreatedState.Called(tc)
+}
+
+// mockAggregatorWeight mocks the aggregator to accept the signature of the given timeout
+// and to accumulate `weight` for it.
+func (s *TimeoutCollectorTestSuite) mockAggregatorWeight(timeout *model.TimeoutObject, weight uint64) {
+	s.aggregator.On("VerifyAndAdd", timeout.SignerID, crypto.Signature(timeout.SigData), timeout.NewestQC.View).Return(
+		func(flow.Identifier, crypto.Signature, uint64) uint64 {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			s.totalWeight += weight
+			return s.totalWeight
+		}, nil).Once()
+}
+
+// TestView tests that View returns the view which was passed to the constructor.
+func (s *TimeoutCollectorTestSuite) TestView() {
+	require.Equal(s.T(), s.view, s.collector.View())
+}
+
+// TestNewTimeoutCollector_ViewMismatch tests that constructing a collector with an aggregator
+// for a different view results in a configuration error.
+func (s *TimeoutCollectorTestSuite) TestNewTimeoutCollector_ViewMismatch() {
+	_, err := NewTimeoutCollector(unittest.Logger(), s.view+1, s.validator, s.aggregator, s.minRequiredWeight, s.onTCCreated)
+	require.True(s.T(), model.IsConfigurationError(err))
+}
+
+// TestAddTimeout_HappyPath tests that timeouts are validated, their signatures are aggregated
+// and exactly one TC is created once enough weight has been collected.
+func (s *TimeoutCollectorTestSuite) TestAddTimeout_HappyPath() {
+	signersInfo := make([]hotstuff.TimeoutSignerInfo, 0, 4)
+	var newestQC *flow.QuorumCertificate
+	var timeouts []*model.TimeoutObject
+	for i := 0; i < 4; i++ {
+		qc := helper.MakeQC(helper.WithQCView(s.view - 10 + uint64(i)))
+		timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view), helper.WithTimeoutNewestQC(qc))
+		s.mockAggregatorWeight(timeout, 1000)
+		signersInfo = append(signersInfo, hotstuff.TimeoutSignerInfo{NewestQCView: qc.View, Signer: timeout.SignerID})
+		newestQC = qc
+		timeouts = append(timeouts, timeout)
+	}
+	aggregatedSig := unittest.SignatureFixture()
+	s.aggregator.On("Aggregate").Return(signersInfo[:3], aggregatedSig, nil).Once()
+
+	for _, timeout := range timeouts {
+		err := s.collector.AddTimeout(timeout)
+		require.NoError(s.T(), err)
+	}
+
+	s.onTCCreatedState.AssertNumberOfCalls(s.T(), "onTCCreated", 1)
+	tc := s.onTCCreatedState.Calls[0].Arguments.Get(0).(*flow.TimeoutCertificate)
+	require.Equal(s.T(), s.view, tc.View)
+	require.Equal(s.T(), []flow.Identifier{timeouts[0].SignerID, timeouts[1].SignerID, timeouts[2].SignerID}, tc.SignerIDs)
+	require.Equal(s.T(), []uint64{signersInfo[0].NewestQCView, signersInfo[1].NewestQCView, signersInfo[2].NewestQCView}, tc.NewestQCViews)
+	require.Equal(s.T(), timeouts[2].NewestQC, tc.NewestQC)
+	require.NotEqual(s.T(), newestQC, tc.NewestQC) // the 4th timeout was added after the TC was built
+	require.Equal(s.T(), []byte(aggregatedSig), tc.SigData)
+	s.aggregator.AssertExpectations(s.T())
+}
+
+// TestAddTimeout_IncompatibleView tests that timeouts for other views are rejected.
+func (s *TimeoutCollectorTestSuite) TestAddTimeout_IncompatibleView() {
+	timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view + 1))
+	err := s.collector.AddTimeout(timeout)
+	require.Error(s.T(), err)
+	require.False(s.T(), model.IsInvalidTimeoutError(err))
+	s.validator.AssertNotCalled(s.T(), "ValidateTimeout", mock.Anything)
+	s.aggregator.AssertNotCalled(s.T(), "VerifyAndAdd", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestAddTimeout_RepeatedTimeout tests that adding the same timeout twice is a no-op.
+func (s *TimeoutCollectorTestSuite) TestAddTimeout_RepeatedTimeout() {
+	timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view))
+	s.mockAggregatorWeight(timeout, 1000)
+
+	require.NoError(s.T(), s.collector.AddTimeout(timeout))
+	require.NoError(s.T(), s.collector.AddTimeout(timeout))
+
+	s.validator.AssertNumberOfCalls(s.T(), "ValidateTimeout", 1)
+	s.aggregator.AssertNumberOfCalls(s.T(), "VerifyAndAdd", 1)
+}
+
+// TestAddTimeout_DoubleTimeout tests that a different timeout from the same signer for the
+// same view is reported as model.DoubleTimeoutError.
+func (s *TimeoutCollectorTestSuite) TestAddTimeout_DoubleTimeout() {
+	timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view))
+	s.mockAggregatorWeight(timeout, 1000)
+	require.NoError(s.T(), s.collector.AddTimeout(timeout))
+
+	conflicting := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view), helper.WithTimeoutObjectSignerID(timeout.SignerID))
+	s.aggregator.On("VerifyAndAdd", conflicting.SignerID, crypto.Signature(conflicting.SigData), conflicting.NewestQC.View).
+		Return(uint64(1000), model.NewDuplicatedSignerErrorf("")).Once()
+
+	err := s.collector.AddTimeout(conflicting)
+	doubleTimeoutErr, ok := model.AsDoubleTimeoutError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), timeout, doubleTimeoutErr.FirstTimeout)
+	require.Equal(s.T(), conflicting, doubleTimeoutErr.ConflictingTimeout)
+}
+
+// TestAddTimeout_InvalidTimeout tests that sentinel errors from validation and signature
+// aggregation are reported as model.InvalidTimeoutError, while exceptions are propagated.
+func (s *TimeoutCollectorTestSuite) TestAddTimeout_InvalidTimeout() {
+	s.Run("invalid-timeout", func() {
+		validator := &mocks.Validator{}
+		collector, err := NewTimeoutCollector(unittest.Logger(), s.view, validator, s.aggregator, s.minRequiredWeight, s.onTCCreated)
+		require.NoError(s.T(), err)
+
+		timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view))
+		validator.On("ValidateTimeout", timeout).Return(model.NewInvalidTimeoutErrorf(timeout, "")).Once()
+		err = collector.AddTimeout(timeout)
+		require.True(s.T(), model.IsInvalidTimeoutError(err))
+	})
+	s.Run("invalid-signer", func() {
+		timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view))
+		s.aggregator.On("VerifyAndAdd", timeout.SignerID, crypto.Signature(timeout.SigData), timeout.NewestQC.View).
+			Return(uint64(0), model.NewInvalidSignerErrorf("")).Once()
+		err := s.collector.AddTimeout(timeout)
+		require.True(s.T(), model.IsInvalidTimeoutError(err))
+		require.True(s.T(), model.IsInvalidSignerError(err))
+	})
+	s.Run("invalid-signature", func() {
+		timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view))
+		s.aggregator.On("VerifyAndAdd", timeout.SignerID, crypto.Signature(timeout.SigData), timeout.NewestQC.View).
+			Return(uint64(0), fmt.Errorf("bad signature: %w", model.ErrInvalidSignature)).Once()
+		err := s.collector.AddTimeout(timeout)
+		require.True(s.T(), model.IsInvalidTimeoutError(err))
+		require.ErrorIs(s.T(), err, model.ErrInvalidSignature)
+	})
+	s.Run("exception", func() {
+		exception := errors.New("unexpected-exception")
+		timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view))
+		s.aggregator.On("VerifyAndAdd", timeout.SignerID, crypto.Signature(timeout.SigData), timeout.NewestQC.View).
+			Return(uint64(0), exception).Once()
+		err := s.collector.AddTimeout(timeout)
+		require.ErrorIs(s.T(), err, exception)
+		require.False(s.T(), model.IsInvalidTimeoutError(err))
+	})
+	s.onTCCreatedState.AssertNotCalled(s.T(), "onTCCreated", mock.Anything)
+}
+
+// TestAddTimeout_ConcurrentIsSafe tests that concurrently adding timeouts is safe and that
+// exactly one TC is built.
+func (s *TimeoutCollectorTestSuite) TestAddTimeout_ConcurrentIsSafe() {
+	numberOfTimeouts := 10
+	timeouts := make([]*model.TimeoutObject, 0, numberOfTimeouts)
+	for i := 0; i < numberOfTimeouts; i++ {
+		timeout := helper.TimeoutObjectFixture(helper.WithTimeoutObjectView(s.view))
+		s.mockAggregatorWeight(timeout, 1000)
+		timeouts = append(timeouts, timeout)
+	}
+	// the aggregator is called while the collector holds its lock, hence we can
+	// report the signers of all timeouts which have been added up to this point
+	s.aggregator.On("Aggregate").Return(
+		func() []hotstuff.TimeoutSignerInfo {
+			signersInfo := make([]hotstuff.TimeoutSignerInfo, 0, len(s.collector.timeouts))
+			for signerID, timeout := range s.collector.timeouts {
+				signersInfo = append(signersInfo, hotstuff.TimeoutSignerInfo{NewestQCView: timeout.NewestQC.View, Signer: signerID})
+			}
+			return signersInfo
+		},
+		func() crypto.Signature { return unittest.SignatureFixture() },
+		nil).Once()
+
+	var wg sync.WaitGroup
+	wg.Add(numberOfTimeouts)
+	for _, timeout := range timeouts {
+		go func(timeout *model.TimeoutObject) {
+			defer wg.Done()
+			err := s.collector.AddTimeout(timeout)
+			require.NoError(s.T(), err)
+		}(timeout)
+	}
+	wg.Wait()
+
+	s.onTCCreatedState.AssertNumberOfCalls(s.T(), "onTCCreated", 1)
+}
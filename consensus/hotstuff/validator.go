@@ -5,7 +5,7 @@ import (
 	"github.com/onflow/flow-go/model/flow"
 )
 
-// Validator provides functions to validate QC, proposals and votes.
+// Validator provides functions to validate QC, TC, proposals, votes and timeouts.
 type Validator interface {
 
 	// ValidateQC checks the validity of a QC for a given block.
@@ -13,6 +13,11 @@ type Validator interface {
 	//  * model.InvalidBlockError if the QC is invalid
 	ValidateQC(qc *flow.QuorumCertificate, block *model.Block) error
 
+	// ValidateTC checks the validity of a TC.
+	// During normal operations, the following error returns are expected:
+	//  * model.InvalidTCError if the TC is invalid
+	ValidateTC(tc *flow.TimeoutCertificate) error
+
 	// ValidateProposal checks the validity of a proposal.
 	// During normal operations, the following error returns are expected:
 	//  * model.InvalidBlockError if the block is invalid
@@ -23,4 +28,10 @@ type Validator interface {
 	// the following errors are expected:
 	//  * model.InvalidVoteError for invalid votes
 	ValidateVote(vote *model.Vote, block *model.Block) (*flow.Identity, error)
+
+	// ValidateTimeout checks the validity of a timeout object, except for the
+	// signature, which is verified when the timeout is aggregated.
+	// During normal operations, the following errors are expected:
+	//  * model.InvalidTimeoutError for invalid timeouts
+	ValidateTimeout(timeout *model.TimeoutObject) error
 }
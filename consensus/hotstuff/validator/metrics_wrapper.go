@@ -33,6 +33,13 @@ func (w ValidatorMetricsWrapper) ValidateQC(qc *flow.QuorumCertificate, block *m
 	return err
 }
 
+func (w ValidatorMetricsWrapper) ValidateTC(tc *flow.TimeoutCertificate) error {
+	processStart := time.Now()
+	err := w.validator.ValidateTC(tc)
+	w.metrics.ValidatorProcessingDuration(time.Since(processStart))
+	return err
+}
+
 func (w ValidatorMetricsWrapper) ValidateProposal(proposal *model.Proposal) error {
 	processStart := time.Now()
 	err := w.validator.ValidateProposal(proposal)
@@ -46,3 +53,10 @@ func (w ValidatorMetricsWrapper) ValidateVote(vote *model.Vote, block *model.Blo
 	w.metrics.ValidatorProcessingDuration(time.Since(processStart))
 	return identity, err
 }
+
+func (w ValidatorMetricsWrapper) ValidateTimeout(timeout *model.TimeoutObject) error {
+	processStart := time.Now()
+	err := w.validator.ValidateTimeout(timeout)
+	w.metrics.ValidatorProcessingDuration(time.Since(processStart))
+	return err
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/onflow/flow-go/model/flow/filter"
 )
 
-// Validator is responsible for validating QC, Block and Vote
+// Validator is responsible for validating QC, TC, Block, Vote and Timeout
 type Validator struct {
 	committee hotstuff.Committee
 	forks     hotstuff.ForksReader
@@ -44,25 +44,53 @@ func (v *Validator) ValidateQC(qc *flow.QuorumCertificate, block *model.Block) e
 		return newInvalidBlockError(block, fmt.Errorf("qc's View %d doesn't match referenced block's View %d", qc.View, block.View))
 	}
 
-	// Retrieve full Identities of all legitimate consensus participants and the Identities of the qc's signers
+	// Retrieve full Identities of all legitimate consensus participants
 	// IdentityList returned by hotstuff.Committee contains only legitimate consensus participants for the specified block (must have positive weight)
 	allParticipants, err := v.committee.Identities(block.BlockID, filter.Any)
 	if err != nil {
 		return fmt.Errorf("could not get consensus participants for block %s: %w", block.BlockID, err)
 	}
+
+	err = v.verifyQC(qc, allParticipants)
+	if model.IsInvalidQCError(err) {
+		return newInvalidBlockError(block, err)
+	}
+	return err
+}
+
+// validateNewestQC validates the newest QC included in a timeout or TC. The block
+// the QC points to might be unknown, hence the signers are checked against the
+// consensus committee of the epoch containing the QC's view.
+// Returns model.InvalidQCError if the QC is invalid.
+func (v *Validator) validateNewestQC(qc *flow.QuorumCertificate) error {
+	allParticipants, err := v.committee.IdentitiesByEpoch(qc.View, filter.Any)
+	if errors.Is(err, model.ErrViewForUnknownEpoch) {
+		return model.NewInvalidQCErrorf(qc, "no epoch known for QC's view: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("could not get consensus participants at view %d: %w", qc.View, err)
+	}
+	return v.verifyQC(qc, allParticipants)
+}
+
+// verifyQC checks that the QC's signers are a super-majority of the given
+// consensus participants and that the QC's aggregated signature is valid.
+// Returns model.InvalidQCError if the QC is invalid.
+func (v *Validator) verifyQC(qc *flow.QuorumCertificate, allParticipants flow.IdentityList) error {
 	signers := allParticipants.Filter(filter.HasNodeID(qc.SignerIDs...)) // resulting IdentityList contains no duplicates
 	if len(signers) != len(qc.SignerIDs) {
-		return newInvalidBlockError(block, model.NewInvalidSignerErrorf("some qc signers are duplicated or invalid consensus participants at block %x", block.BlockID))
+		return model.NewInvalidQCErrorf(qc, "some qc signers are duplicated or invalid consensus participants: %w",
+			model.NewInvalidSignerErrorf("invalid signers of QC for block %x", qc.BlockID))
 	}
 
 	// determine whether signers reach minimally required weight threshold for consensus
 	threshold := hotstuff.ComputeWeightThresholdForBuildingQC(allParticipants.TotalWeight()) // compute required weight threshold
 	if signers.TotalWeight() < threshold {
-		return newInvalidBlockError(block, fmt.Errorf("qc signers have insufficient weight of %d (required=%d)", signers.TotalWeight(), threshold))
+		return model.NewInvalidQCErrorf(qc, "qc signers have insufficient weight of %d (required=%d)", signers.TotalWeight(), threshold)
 	}
 
 	// verify whether the signature bytes are valid for the QC in the context of the protocol state
-	err = v.verifier.VerifyQC(signers, qc.SigData, block)
+	err := v.verifier.VerifyQC(signers, qc.SigData, qc.View, qc.BlockID)
 	if err != nil {
 		// Theoretically, `VerifyQC` could also return a `model.InvalidSignerError`. However,
 		// for the time being, we assume that _every_ HotStuff participant is also a member of
@@ -71,9 +99,9 @@ func (v *Validator) ValidateQC(qc *flow.QuorumCertificate, block *model.Block) e
 		//       we expect `model.InvalidSignerError` here during normal operations.
 		switch {
 		case errors.Is(err, model.ErrInvalidFormat):
-			return newInvalidBlockError(block, fmt.Errorf("QC's  signature data has an invalid structure: %w", err))
+			return model.NewInvalidQCErrorf(qc, "QC's  signature data has an invalid structure: %w", err)
 		case errors.Is(err, model.ErrInvalidSignature):
-			return newInvalidBlockError(block, fmt.Errorf("QC contains invalid signature(s): %w", err))
+			return model.NewInvalidQCErrorf(qc, "QC contains invalid signature(s): %w", err)
 		default:
 			return fmt.Errorf("cannot verify qc's aggregated signature (qc.BlockID: %x): %w", qc.BlockID, err)
 		}
@@ -82,6 +110,85 @@ func (v *Validator) ValidateQC(qc *flow.QuorumCertificate, block *model.Block) e
 	return nil
 }
 
+// ValidateTC validates the TC
+// Timeout certificates are not tied to a block, hence the signers are checked
+// against the consensus committee of the epoch containing the TC's view. The
+// TC's newest QC is always fully validated, against the consensus committee of
+// the epoch containing the QC's view, as the block it points to might be unknown.
+func (v *Validator) ValidateTC(tc *flow.TimeoutCertificate) error {
+	newestQC := tc.NewestQC
+	if newestQC == nil {
+		return model.NewInvalidTCErrorf(tc, "TC has no newest QC")
+	}
+	if newestQC.View >= tc.View {
+		return model.NewInvalidTCErrorf(tc, "TC's newest QC (view %d) is not older than the TC", newestQC.View)
+	}
+	if len(tc.NewestQCViews) != len(tc.SignerIDs) {
+		return model.NewInvalidTCErrorf(tc, "TC has %d newest QC views for %d signers", len(tc.NewestQCViews), len(tc.SignerIDs))
+	}
+
+	// the newest QC must be the newest QC of all signers
+	var maxView uint64
+	for _, view := range tc.NewestQCViews {
+		if view >= tc.View {
+			return model.NewInvalidTCErrorf(tc, "TC includes newest QC view %d, which is not older than the TC", view)
+		}
+		if view > maxView {
+			maxView = view
+		}
+	}
+	if newestQC.View != maxView {
+		return model.NewInvalidTCErrorf(tc, "TC's newest QC has view %d, but the newest QC view of all signers is %d", newestQC.View, maxView)
+	}
+
+	// Retrieve full Identities of all legitimate consensus participants and the Identities of the tc's signers
+	allParticipants, err := v.committee.IdentitiesByEpoch(tc.View, filter.Any)
+	if errors.Is(err, model.ErrViewForUnknownEpoch) {
+		return model.NewInvalidTCErrorf(tc, "no epoch known for TC's view: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("could not get consensus participants at view %d: %w", tc.View, err)
+	}
+	signers := allParticipants.Filter(filter.HasNodeID(tc.SignerIDs...)) // resulting IdentityList contains no duplicates
+	if len(signers) != len(tc.SignerIDs) {
+		return model.NewInvalidTCErrorf(tc, "some tc signers are duplicated or invalid consensus participants at view %d", tc.View)
+	}
+
+	// determine whether signers reach minimally required weight threshold for consensus
+	threshold := hotstuff.ComputeWeightThresholdForBuildingQC(allParticipants.TotalWeight()) // compute required weight threshold
+	if signers.TotalWeight() < threshold {
+		return model.NewInvalidTCErrorf(tc, "tc signers have insufficient weight of %d (required=%d)", signers.TotalWeight(), threshold)
+	}
+
+	// the newest QC views have to be in the same order as the signer IDs, whereas
+	// the filtered signers have the order of the committee
+	signers = signers.Sort(orderBySignerIDs(tc.SignerIDs))
+
+	// verify whether the signature bytes are valid for the TC
+	err = v.verifier.VerifyTC(signers, tc.SigData, tc.View, tc.NewestQCViews)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidFormat):
+			return model.NewInvalidTCErrorf(tc, "TC's signature data has an invalid structure: %w", err)
+		case errors.Is(err, model.ErrInvalidSignature):
+			return model.NewInvalidTCErrorf(tc, "TC contains invalid signature(s): %w", err)
+		default:
+			return fmt.Errorf("cannot verify tc's aggregated signature (tc.View: %d): %w", tc.View, err)
+		}
+	}
+
+	// validate the newest QC - keep the most expensive the last to check
+	err = v.validateNewestQC(newestQC)
+	if model.IsInvalidQCError(err) {
+		return model.NewInvalidTCErrorf(tc, "invalid newest QC: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("could not validate newest QC of tc (tc.View: %d): %w", tc.View, err)
+	}
+
+	return nil
+}
+
 // ValidateProposal validates the block proposal
 // A block is considered as valid if it's a valid extension of existing forks.
 // Note it doesn't check if it's conflicting with finalized block
@@ -124,10 +231,48 @@ func (v *Validator) ValidateProposal(proposal *model.Proposal) error {
 		return model.ErrUnverifiableBlock
 	}
 
+	// a block must either extend a QC for the previous view, or include
+	// a TC for the previous view, which proves that the view was skipped
+	err = v.validateLastViewTC(block)
+	if err != nil {
+		return err
+	}
+
 	// validate QC - keep the most expensive the last to check
 	return v.ValidateQC(qc, parent)
 }
 
+// validateLastViewTC checks that the block includes a valid TC for the previous view,
+// if and only if the block's QC is not for the previous view.
+func (v *Validator) validateLastViewTC(block *model.Block) error {
+	tc := block.LastViewTC
+	if block.QC.View+1 == block.View {
+		if tc != nil {
+			return newInvalidBlockError(block, fmt.Errorf("block extends QC for previous view %d, but includes TC for view %d", block.QC.View, tc.View))
+		}
+		return nil
+	}
+
+	if tc == nil {
+		return newInvalidBlockError(block, fmt.Errorf("block's QC (view %d) is not for the previous view and no TC is included", block.QC.View))
+	}
+	if tc.View+1 != block.View {
+		return newInvalidBlockError(block, fmt.Errorf("block includes TC for view %d, which is not the previous view", tc.View))
+	}
+	if tc.NewestQC != nil && block.QC.View < tc.NewestQC.View {
+		return newInvalidBlockError(block, fmt.Errorf("block's QC (view %d) is older than the newest QC of the included TC (view %d)", block.QC.View, tc.NewestQC.View))
+	}
+
+	err := v.ValidateTC(tc)
+	if model.IsInvalidTCError(err) {
+		return newInvalidBlockError(block, fmt.Errorf("invalid TC for previous view: %w", err))
+	}
+	if err != nil {
+		return fmt.Errorf("could not validate TC of block %x: %w", block.BlockID, err)
+	}
+	return nil
+}
+
 // ValidateVote validates the vote and returns the identity of the voter who signed
 // vote - the vote to be validated
 // block - the voting block. Assuming the block has been validated.
@@ -167,6 +312,82 @@ func (v *Validator) ValidateVote(vote *model.Vote, block *model.Block) (*flow.Id
 	return voter, nil
 }
 
+// ValidateTimeout validates the timeout object, except for its signature, which is
+// verified when the timeout is aggregated. Like TCs, timeouts are checked against
+// the consensus committee of the epoch containing the timeout's view.
+func (v *Validator) ValidateTimeout(timeout *model.TimeoutObject) error {
+	newestQC := timeout.NewestQC
+	if newestQC == nil {
+		return model.NewInvalidTimeoutErrorf(timeout, "timeout has no newest QC")
+	}
+	if newestQC.View >= timeout.View {
+		return model.NewInvalidTimeoutErrorf(timeout, "timeout's newest QC (view %d) is not older than the timeout", newestQC.View)
+	}
+
+	// a replica can only be in a view if it has either seen a QC or a TC for the previous view
+	tc := timeout.LastViewTC
+	if newestQC.View+1 == timeout.View {
+		if tc != nil {
+			return model.NewInvalidTimeoutErrorf(timeout, "timeout has QC for previous view %d, but includes TC for view %d", newestQC.View, tc.View)
+		}
+	} else {
+		if tc == nil {
+			return model.NewInvalidTimeoutErrorf(timeout, "timeout's newest QC (view %d) is not for the previous view and no TC is included", newestQC.View)
+		}
+		if tc.View+1 != timeout.View {
+			return model.NewInvalidTimeoutErrorf(timeout, "timeout includes TC for view %d, which is not the previous view", tc.View)
+		}
+		if tc.NewestQC != nil && newestQC.View < tc.NewestQC.View {
+			return model.NewInvalidTimeoutErrorf(timeout, "timeout's newest QC (view %d) is older than the newest QC of the included TC (view %d)", newestQC.View, tc.NewestQC.View)
+		}
+	}
+
+	// the signer must be an authorized consensus participant
+	_, err := v.committee.IdentityByEpoch(timeout.View, timeout.SignerID)
+	if model.IsInvalidSignerError(err) {
+		return model.NewInvalidTimeoutErrorf(timeout, "invalid signer: %w", err)
+	}
+	if errors.Is(err, model.ErrViewForUnknownEpoch) {
+		return model.NewInvalidTimeoutErrorf(timeout, "no epoch known for timeout's view: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("error retrieving signer Identity at view %d: %w", timeout.View, err)
+	}
+
+	// validate the newest QC, which is fully verified, as its block might be unknown
+	err = v.validateNewestQC(newestQC)
+	if model.IsInvalidQCError(err) {
+		return model.NewInvalidTimeoutErrorf(timeout, "invalid newest QC: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("could not validate newest QC of timeout (%x): %w", timeout.ID(), err)
+	}
+
+	if tc != nil {
+		err = v.ValidateTC(tc)
+		if model.IsInvalidTCError(err) {
+			return model.NewInvalidTimeoutErrorf(timeout, "invalid TC for previous view: %w", err)
+		}
+		if err != nil {
+			return fmt.Errorf("could not validate TC of timeout (%x): %w", timeout.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// orderBySignerIDs returns an identity order, which sorts identities in the
+// order of the given signer IDs.
+func orderBySignerIDs(signerIDs []flow.Identifier) flow.IdentityOrder {
+	indices := make(map[flow.Identifier]int, len(signerIDs))
+	for i, signerID := range signerIDs {
+		indices[signerID] = i
+	}
+	return func(id1 *flow.Identity, id2 *flow.Identity) bool {
+		return indices[id1.NodeID] < indices[id2.NodeID]
+	}
+}
+
 func newInvalidBlockError(block *model.Block, err error) error {
 	return model.InvalidBlockError{
 		BlockID: block.BlockID,
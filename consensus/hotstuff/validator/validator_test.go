@@ -83,7 +83,7 @@ func (ps *ProposalSuite) SetupTest() {
 
 	// set up the mocked verifier
 	ps.verifier = &mocks.Verifier{}
-	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(nil)
+	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(nil)
 	ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(nil)
 
 	// set up the validator with the mocked dependencies
@@ -100,7 +100,7 @@ func (ps *ProposalSuite) TestProposalSignatureError() {
 
 	// change the verifier to error on signature validation with unspecific error
 	*ps.verifier = mocks.Verifier{}
-	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(nil)
+	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(nil)
 	ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(errors.New("dummy error"))
 
 	// check that validation now fails
@@ -115,7 +115,7 @@ func (ps *ProposalSuite) TestProposalSignatureInvalidFormat() {
 
 	// change the verifier to fail signature validation with ErrInvalidFormat error
 	*ps.verifier = mocks.Verifier{}
-	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(nil)
+	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(nil)
 	ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(fmt.Errorf("%w", model.ErrInvalidFormat))
 
 	// check that validation now fails
@@ -130,7 +130,7 @@ func (ps *ProposalSuite) TestProposalSignatureInvalid() {
 
 	// change the verifier to fail signature validation
 	*ps.verifier = mocks.Verifier{}
-	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(nil)
+	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(nil)
 	ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(model.ErrInvalidSignature)
 
 	// check that validation now fails
@@ -211,7 +211,7 @@ func (ps *ProposalSuite) TestProposalMissingParentLower() {
 func (ps *ProposalSuite) TestProposalQCInvalid() {
 	ps.Run("invalid signature", func() {
 		*ps.verifier = mocks.Verifier{}
-		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(
+		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(
 			fmt.Errorf("invalid qc: %w", model.ErrInvalidSignature))
 		ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(nil)
 
@@ -222,7 +222,7 @@ func (ps *ProposalSuite) TestProposalQCInvalid() {
 
 	ps.Run("invalid format", func() {
 		*ps.verifier = mocks.Verifier{}
-		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(
+		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(
 			fmt.Errorf("invalid qc: %w", model.ErrInvalidFormat))
 		ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(nil)
 
@@ -238,7 +238,7 @@ func (ps *ProposalSuite) TestProposalQCInvalid() {
 	//       we expect `model.InvalidSignerError` here during normal operations.
 	ps.Run("invalid signer", func() {
 		*ps.verifier = mocks.Verifier{}
-		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(
+		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(
 			fmt.Errorf("invalid qc: %w", model.NewInvalidSignerErrorf("")))
 		ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(nil)
 
@@ -251,7 +251,7 @@ func (ps *ProposalSuite) TestProposalQCInvalid() {
 	ps.Run("unknown exception", func() {
 		exception := errors.New("exception")
 		*ps.verifier = mocks.Verifier{}
-		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(exception)
+		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(exception)
 		ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(nil)
 
 		// check that validation fails and the failure case is recognized as an invalid block
@@ -265,7 +265,7 @@ func (ps *ProposalSuite) TestProposalQCError() {
 
 	// change verifier to fail on QC validation
 	*ps.verifier = mocks.Verifier{}
-	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(fmt.Errorf("some exception"))
+	ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent.View, ps.parent.BlockID).Return(fmt.Errorf("some exception"))
 	ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(nil)
 
 	// check that validation fails now
@@ -428,7 +428,7 @@ func (qs *QCSuite) SetupTest() {
 
 	// set up the mocked verifier to verify the QC correctly
 	qs.verifier = &mocks.Verifier{}
-	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block).Return(nil)
+	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block.View, qs.block.BlockID).Return(nil)
 
 	// set up the validator with the mocked dependencies
 	qs.validator = New(qs.committee, nil, qs.verifier)
@@ -483,7 +483,7 @@ func (qs *QCSuite) TestQCSignatureError() {
 
 	// set up the verifier to fail QC verification
 	*qs.verifier = mocks.Verifier{}
-	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block).Return(errors.New("dummy error"))
+	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block.View, qs.block.BlockID).Return(errors.New("dummy error"))
 
 	// verifier should escalate unspecific internal error to surrounding logic, but NOT as ErrorInvalidBlock
 	err := qs.validator.ValidateQC(qs.qc, qs.block)
@@ -495,7 +495,7 @@ func (qs *QCSuite) TestQCSignatureInvalid() {
 
 	// change the verifier to fail the QC signature
 	*qs.verifier = mocks.Verifier{}
-	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block).Return(fmt.Errorf("invalid qc: %w", model.ErrInvalidSignature))
+	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block.View, qs.block.BlockID).Return(fmt.Errorf("invalid qc: %w", model.ErrInvalidSignature))
 
 	// the QC should no longer be validation
 	err := qs.validator.ValidateQC(qs.qc, qs.block)
@@ -506,9 +506,189 @@ func (qs *QCSuite) TestQCSignatureInvalidFormat() {
 
 	// change the verifier to fail the QC signature
 	*qs.verifier = mocks.Verifier{}
-	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block).Return(fmt.Errorf("%w", model.ErrInvalidFormat))
+	qs.verifier.On("VerifyQC", qs.signers, qs.qc.SigData, qs.block.View, qs.block.BlockID).Return(fmt.Errorf("%w", model.ErrInvalidFormat))
 
 	// the QC should no longer be validation
 	err := qs.validator.ValidateQC(qs.qc, qs.block)
 	assert.True(qs.T(), model.IsInvalidBlockError(err), "if the signature has an invalid format, an ErrorInvalidBlock error should be raised")
 }
+
+func TestValidateTC(t *testing.T) {
+	suite.Run(t, new(TCSuite))
+}
+
+type TCSuite struct {
+	suite.Suite
+	participants flow.IdentityList
+	signers      flow.IdentityList
+	newestQC     *flow.QuorumCertificate
+	tc           *flow.TimeoutCertificate
+	committee    *mocks.Committee
+	verifier     *mocks.Verifier
+	validator    *Validator
+}
+
+func (ts *TCSuite) SetupTest() {
+
+	// create a list of 10 nodes with 1-weight each
+	ts.participants = unittest.IdentityListFixture(10,
+		unittest.WithRole(flow.RoleConsensus),
+		unittest.WithWeight(1),
+	)
+
+	// signers are a qualified majority at 7
+	ts.signers = ts.participants[:7]
+
+	// the newest QC points to a block, which is unknown to the validator
+	view := uint64(rand.Uint32() + 2)
+	ts.newestQC = helper.MakeQC(helper.WithQCView(view-2), helper.WithQCSigners(ts.signers.NodeIDs()))
+	ts.tc = helper.MakeTC(
+		helper.WithTCView(view),
+		helper.WithTCNewestQC(ts.newestQC),
+		helper.WithTCSigners(ts.signers.NodeIDs()),
+	)
+
+	// return the participants of the epoch containing the requested view
+	ts.committee = &mocks.Committee{}
+	ts.committee.On("IdentitiesByEpoch", mock.Anything, mock.Anything).Return(
+		func(view uint64, selector flow.IdentityFilter) flow.IdentityList {
+			return ts.participants.Filter(selector)
+		},
+		nil,
+	)
+
+	// set up the mocked verifier to verify the TC and its newest QC correctly
+	ts.verifier = &mocks.Verifier{}
+	ts.verifier.On("VerifyTC", ts.signers, ts.tc.SigData, ts.tc.View, ts.tc.NewestQCViews).Return(nil)
+	ts.verifier.On("VerifyQC", ts.signers, ts.newestQC.SigData, ts.newestQC.View, ts.newestQC.BlockID).Return(nil)
+
+	// forks without any expectations, as TCs must be validated without the protocol state at any block
+	ts.validator = New(ts.committee, &mocks.Forks{}, ts.verifier)
+}
+
+// TestTCOK tests that a valid TC is accepted, where the signers of the TC and its
+// newest QC are checked against the committee of the epoch containing their views.
+func (ts *TCSuite) TestTCOK() {
+	err := ts.validator.ValidateTC(ts.tc)
+	assert.NoError(ts.T(), err, "a valid TC should be accepted")
+
+	ts.committee.AssertCalled(ts.T(), "IdentitiesByEpoch", ts.tc.View, mock.Anything)
+	ts.committee.AssertCalled(ts.T(), "IdentitiesByEpoch", ts.newestQC.View, mock.Anything)
+	ts.verifier.AssertExpectations(ts.T())
+}
+
+// TestTCInvalidSigners tests that a TC is rejected if some signers are not
+// committee members of the epoch containing the TC's view.
+func (ts *TCSuite) TestTCInvalidSigners() {
+	ts.participants = ts.participants[1:] // remove participant[0] from the list of valid consensus participants
+	err := ts.validator.ValidateTC(ts.tc)
+	assert.True(ts.T(), model.IsInvalidTCError(err), "if some signers are invalid consensus participants, an InvalidTCError should be raised")
+}
+
+// TestTCUnknownEpoch tests that a TC is rejected if no epoch containing its view is known.
+func (ts *TCSuite) TestTCUnknownEpoch() {
+	*ts.committee = mocks.Committee{}
+	ts.committee.On("IdentitiesByEpoch", mock.Anything, mock.Anything).Return(nil, model.ErrViewForUnknownEpoch)
+
+	err := ts.validator.ValidateTC(ts.tc)
+	assert.True(ts.T(), model.IsInvalidTCError(err), "a TC for an unknown epoch should be rejected")
+}
+
+// TestTCNewestQCInvalidSignature tests that the newest QC of a TC is fully verified,
+// even though the block it points to is unknown.
+func (ts *TCSuite) TestTCNewestQCInvalidSignature() {
+	*ts.verifier = mocks.Verifier{}
+	ts.verifier.On("VerifyTC", ts.signers, ts.tc.SigData, ts.tc.View, ts.tc.NewestQCViews).Return(nil)
+	ts.verifier.On("VerifyQC", ts.signers, ts.newestQC.SigData, ts.newestQC.View, ts.newestQC.BlockID).Return(fmt.Errorf("invalid qc: %w", model.ErrInvalidSignature))
+
+	err := ts.validator.ValidateTC(ts.tc)
+	assert.True(ts.T(), model.IsInvalidTCError(err), "a TC with an invalid newest QC should be rejected")
+}
+
+// TestTCNewestQCSignatureError tests that unexpected errors while verifying the
+// newest QC are escalated, but not as InvalidTCError.
+func (ts *TCSuite) TestTCNewestQCSignatureError() {
+	*ts.verifier = mocks.Verifier{}
+	ts.verifier.On("VerifyTC", ts.signers, ts.tc.SigData, ts.tc.View, ts.tc.NewestQCViews).Return(nil)
+	ts.verifier.On("VerifyQC", ts.signers, ts.newestQC.SigData, ts.newestQC.View, ts.newestQC.BlockID).Return(errors.New("dummy error"))
+
+	err := ts.validator.ValidateTC(ts.tc)
+	assert.Error(ts.T(), err, "unspecific sig verification error should be escalated to surrounding logic")
+	assert.False(ts.T(), model.IsInvalidTCError(err), "unspecific internal errors should not result in InvalidTCError")
+}
+
+func TestValidateTimeout(t *testing.T) {
+	suite.Run(t, new(TimeoutSuite))
+}
+
+type TimeoutSuite struct {
+	suite.Suite
+	participants flow.IdentityList
+	signers      flow.IdentityList
+	timeout      *model.TimeoutObject
+	committee    *mocks.Committee
+	verifier     *mocks.Verifier
+	validator    *Validator
+}
+
+func (ts *TimeoutSuite) SetupTest() {
+
+	// create a list of 10 nodes with 1-weight each
+	ts.participants = unittest.IdentityListFixture(10,
+		unittest.WithRole(flow.RoleConsensus),
+		unittest.WithWeight(1),
+	)
+	ts.signers = ts.participants[:7]
+
+	// the timeout's newest QC, for the previous view, points to a block unknown to the validator
+	view := uint64(rand.Uint32() + 1)
+	newestQC := helper.MakeQC(helper.WithQCView(view-1), helper.WithQCSigners(ts.signers.NodeIDs()))
+	ts.timeout = helper.TimeoutObjectFixture(
+		helper.WithTimeoutObjectView(view),
+		helper.WithTimeoutNewestQC(newestQC),
+		helper.WithTimeoutObjectSignerID(ts.participants[0].NodeID),
+	)
+
+	ts.committee = &mocks.Committee{}
+	ts.committee.On("IdentitiesByEpoch", mock.Anything, mock.Anything).Return(
+		func(view uint64, selector flow.IdentityFilter) flow.IdentityList {
+			return ts.participants.Filter(selector)
+		},
+		nil,
+	)
+	ts.committee.On("IdentityByEpoch", view, ts.participants[0].NodeID).Return(ts.participants[0], nil)
+
+	ts.verifier = &mocks.Verifier{}
+	ts.verifier.On("VerifyQC", ts.signers, newestQC.SigData, newestQC.View, newestQC.BlockID).Return(nil)
+
+	// forks without any expectations, as timeouts must be validated without the protocol state at any block
+	ts.validator = New(ts.committee, &mocks.Forks{}, ts.verifier)
+}
+
+// TestTimeoutOK tests that a valid timeout is accepted, and its newest QC is fully verified.
+func (ts *TimeoutSuite) TestTimeoutOK() {
+	err := ts.validator.ValidateTimeout(ts.timeout)
+	assert.NoError(ts.T(), err, "a valid timeout should be accepted")
+	ts.verifier.AssertExpectations(ts.T())
+}
+
+// TestTimeoutInvalidSigner tests that a timeout is rejected if its signer is not a
+// committee member of the epoch containing the timeout's view.
+func (ts *TimeoutSuite) TestTimeoutInvalidSigner() {
+	*ts.committee = mocks.Committee{}
+	ts.committee.On("IdentityByEpoch", ts.timeout.View, ts.timeout.SignerID).Return(nil, model.NewInvalidSignerErrorf(""))
+
+	err := ts.validator.ValidateTimeout(ts.timeout)
+	assert.True(ts.T(), model.IsInvalidTimeoutError(err), "a timeout from a non-committee member should be rejected")
+}
+
+// TestTimeoutNewestQCInvalidSignature tests that a timeout is rejected if its newest
+// QC has an invalid signature, even though the block the QC points to is unknown.
+func (ts *TimeoutSuite) TestTimeoutNewestQCInvalidSignature() {
+	newestQC := ts.timeout.NewestQC
+	*ts.verifier = mocks.Verifier{}
+	ts.verifier.On("VerifyQC", ts.signers, newestQC.SigData, newestQC.View, newestQC.BlockID).Return(fmt.Errorf("invalid qc: %w", model.ErrInvalidSignature))
+
+	err := ts.validator.ValidateTimeout(ts.timeout)
+	assert.True(ts.T(), model.IsInvalidTimeoutError(err), "a timeout with an invalid newest QC should be rejected")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/crypto/hash"
 	"github.com/onflow/flow-go/model/encoding"
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 )
 
@@ -25,6 +26,7 @@ import (
 type CombinedSigner struct {
 	staking        module.Local
 	stakingHasher  hash.Hasher
+	timeoutHasher  hash.Hasher
 	beaconKeyStore module.RandomBeaconKeyStore
 	beaconHasher   hash.Hasher
 }
@@ -41,6 +43,7 @@ func NewCombinedSigner(
 	sc := &CombinedSigner{
 		staking:        staking,
 		stakingHasher:  crypto.NewBLSKMAC(encoding.ConsensusVoteTag),
+		timeoutHasher:  crypto.NewBLSKMAC(encoding.ConsensusTimeoutTag),
 		beaconKeyStore: beaconKeyStore,
 		beaconHasher:   crypto.NewBLSKMAC(encoding.RandomBeaconTag),
 	}
@@ -90,6 +93,15 @@ func (c *CombinedSigner) CreateVote(block *model.Block) (*model.Vote, error) {
 	return vote, nil
 }
 
+// CreateTimeout will create a timeout object with a staking signature for the given view.
+func (c *CombinedSigner) CreateTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	timeout, err := createTimeout(c.staking, c.timeoutHasher, curView, newestQC, lastViewTC)
+	if err != nil {
+		return nil, fmt.Errorf("could not create timeout: %w", err)
+	}
+	return timeout, nil
+}
+
 // genSigData generates the signature data for our local node for the given block.
 // It returns:
 //  - (stakingSig, nil) if there is no random beacon private key.
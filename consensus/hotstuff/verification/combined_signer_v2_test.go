@@ -194,9 +194,9 @@ func Test_VerifyQC(t *testing.T) {
 	block := model.BlockFromFlow(&header, header.View-1)
 	sigData := unittest.QCSigDataFixture()
 
-	err := verifier.VerifyQC([]*flow.Identity{}, sigData, block)
+	err := verifier.VerifyQC([]*flow.Identity{}, sigData, block.View, block.BlockID)
 	require.ErrorIs(t, err, model.ErrInvalidFormat)
 
-	err = verifier.VerifyQC(nil, sigData, block)
+	err = verifier.VerifyQC(nil, sigData, block.View, block.BlockID)
 	require.ErrorIs(t, err, model.ErrInvalidFormat)
 }
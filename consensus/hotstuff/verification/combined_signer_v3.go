@@ -10,6 +10,7 @@ import (
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/crypto/hash"
 	"github.com/onflow/flow-go/model/encoding"
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 )
 
@@ -26,6 +27,7 @@ import (
 type CombinedSignerV3 struct {
 	staking        module.Local
 	stakingHasher  hash.Hasher
+	timeoutHasher  hash.Hasher
 	beaconKeyStore module.RandomBeaconKeyStore
 	beaconHasher   hash.Hasher
 }
@@ -42,6 +44,7 @@ func NewCombinedSignerV3(
 	sc := &CombinedSignerV3{
 		staking:        staking,
 		stakingHasher:  crypto.NewBLSKMAC(encoding.ConsensusVoteTag),
+		timeoutHasher:  crypto.NewBLSKMAC(encoding.ConsensusTimeoutTag),
 		beaconKeyStore: beaconKeyStore,
 		beaconHasher:   crypto.NewBLSKMAC(encoding.RandomBeaconTag),
 	}
@@ -91,6 +94,15 @@ func (c *CombinedSignerV3) CreateVote(block *model.Block) (*model.Vote, error) {
 	return vote, nil
 }
 
+// CreateTimeout will create a timeout object with a staking signature for the given view.
+func (c *CombinedSignerV3) CreateTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	timeout, err := createTimeout(c.staking, c.timeoutHasher, curView, newestQC, lastViewTC)
+	if err != nil {
+		return nil, fmt.Errorf("could not create timeout: %w", err)
+	}
+	return timeout, nil
+}
+
 // genSigData generates the signature data for our local node for the given block.
 func (c *CombinedSignerV3) genSigData(block *model.Block) ([]byte, error) {
 
@@ -163,7 +163,7 @@ func Test_VerifyQCV3(t *testing.T) {
 	dkg.On("GroupKey").Return(privGroupKey.PublicKey(), nil)
 	dkg.On("Size").Return(uint(20))
 	committee := &mocks.Committee{}
-	committee.On("DKG", mock.Anything).Return(dkg, nil)
+	committee.On("DKGByEpoch", block.View).Return(dkg, nil)
 
 	// generate 17 BLS keys as stubs for staking keys and use them to generate an aggregated staking sig
 	privStakingKeys, aggStakingSig := generateAggregatedSignature(t, 17, msg, encoding.ConsensusVoteTag)
@@ -187,10 +187,10 @@ func Test_VerifyQCV3(t *testing.T) {
 	// first, we check that our testing setup works for a correct QC
 	t.Run("valid QC", func(t *testing.T) {
 		packer := &mocks.Packer{}
-		packer.On("Unpack", block.BlockID, mock.Anything, packedSigData).Return(&unpackedSigData, nil)
+		packer.On("Unpack", block.View, mock.Anything, packedSigData).Return(&unpackedSigData, nil)
 
 		verifier := NewCombinedVerifierV3(committee, packer)
-		err := verifier.VerifyQC(allSigners, packedSigData, block)
+		err := verifier.VerifyQC(allSigners, packedSigData, block.View, block.BlockID)
 		require.NoError(t, err)
 	})
 
@@ -205,9 +205,9 @@ func Test_VerifyQCV3(t *testing.T) {
 		sd.AggregatedStakingSig = []byte{}
 
 		packer := &mocks.Packer{}
-		packer.On("Unpack", block.BlockID, mock.Anything, packedSigData).Return(&sd, nil)
+		packer.On("Unpack", block.View, mock.Anything, packedSigData).Return(&sd, nil)
 		verifier := NewCombinedVerifierV3(committee, packer)
-		err := verifier.VerifyQC(allSigners, packedSigData, block)
+		err := verifier.VerifyQC(allSigners, packedSigData, block.View, block.BlockID)
 		require.NoError(t, err)
 	})
 
@@ -220,9 +220,9 @@ func Test_VerifyQCV3(t *testing.T) {
 		sd.StakingSigners = []flow.Identifier{}
 
 		packer := &mocks.Packer{}
-		packer.On("Unpack", block.BlockID, mock.Anything, packedSigData).Return(&sd, nil)
+		packer.On("Unpack", block.View, mock.Anything, packedSigData).Return(&sd, nil)
 		verifier := NewCombinedVerifierV3(committee, packer)
-		err := verifier.VerifyQC(allSigners, packedSigData, block)
+		err := verifier.VerifyQC(allSigners, packedSigData, block.View, block.BlockID)
 		require.ErrorIs(t, err, model.ErrInvalidFormat)
 	})
 
@@ -233,9 +233,9 @@ func Test_VerifyQCV3(t *testing.T) {
 		sd.RandomBeaconSigners = []flow.Identifier{}
 
 		packer := &mocks.Packer{}
-		packer.On("Unpack", block.BlockID, mock.Anything, packedSigData).Return(&sd, nil)
+		packer.On("Unpack", block.View, mock.Anything, packedSigData).Return(&sd, nil)
 		verifier := NewCombinedVerifierV3(committee, packer)
-		err := verifier.VerifyQC(allSigners, packedSigData, block)
+		err := verifier.VerifyQC(allSigners, packedSigData, block.View, block.BlockID)
 		require.ErrorIs(t, err, model.ErrInvalidFormat)
 	})
 
@@ -249,9 +249,9 @@ func Test_VerifyQCV3(t *testing.T) {
 		sd.AggregatedRandomBeaconSig = aggregatedSignature(t, privRbKeyShares[:5], msg, encoding.RandomBeaconTag)
 
 		packer := &mocks.Packer{}
-		packer.On("Unpack", block.BlockID, mock.Anything, packedSigData).Return(&sd, nil)
+		packer.On("Unpack", block.View, mock.Anything, packedSigData).Return(&sd, nil)
 		verifier := NewCombinedVerifierV3(committee, packer)
-		err := verifier.VerifyQC(allSigners, packedSigData, block)
+		err := verifier.VerifyQC(allSigners, packedSigData, block.View, block.BlockID)
 		require.ErrorIs(t, err, model.ErrInvalidFormat)
 	})
 
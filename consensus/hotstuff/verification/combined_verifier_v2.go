@@ -24,6 +24,7 @@ import (
 type CombinedVerifier struct {
 	committee     hotstuff.Committee
 	stakingHasher hash.Hasher
+	timeoutHasher hash.Hasher
 	beaconHasher  hash.Hasher
 	packer        hotstuff.Packer
 }
@@ -38,6 +39,7 @@ func NewCombinedVerifier(committee hotstuff.Committee, packer hotstuff.Packer) *
 	return &CombinedVerifier{
 		committee:     committee,
 		stakingHasher: crypto.NewBLSKMAC(encoding.ConsensusVoteTag),
+		timeoutHasher: crypto.NewBLSKMAC(encoding.ConsensusTimeoutTag),
 		beaconHasher:  crypto.NewBLSKMAC(encoding.RandomBeaconTag),
 		packer:        packer,
 	}
@@ -105,28 +107,29 @@ func (c *CombinedVerifier) VerifyVote(signer *flow.Identity, sigData []byte, blo
 }
 
 // VerifyQC checks the cryptographic validity of the QC's `sigData` for the
-// given block. It is the responsibility of the calling code to ensure
-// that all `voters` are authorized, without duplicates. Return values:
+// block with the given view and ID. It is the responsibility of the calling
+// code to ensure that all `voters` are authorized, without duplicates.
+// Return values:
 //  - nil if `sigData` is cryptographically valid
 //  - model.ErrInvalidFormat if `sigData` has an incompatible format
 //  - model.ErrInvalidSignature if a signature is invalid
 //  - error if running into any unexpected exception (i.e. fatal error)
-func (c *CombinedVerifier) VerifyQC(signers flow.IdentityList, sigData []byte, block *model.Block) error {
+func (c *CombinedVerifier) VerifyQC(signers flow.IdentityList, sigData []byte, view uint64, blockID flow.Identifier) error {
 	if len(signers) == 0 {
 		return fmt.Errorf("empty list of signers: %w", model.ErrInvalidFormat)
 	}
-	dkg, err := c.committee.DKG(block.BlockID)
+	dkg, err := c.committee.DKGByEpoch(view)
 	if err != nil {
 		return fmt.Errorf("could not get dkg data: %w", err)
 	}
 
 	// unpack sig data using packer
-	blockSigData, err := c.packer.Unpack(block.BlockID, signers.NodeIDs(), sigData)
+	blockSigData, err := c.packer.Unpack(view, signers.NodeIDs(), sigData)
 	if err != nil {
 		return fmt.Errorf("could not split signature: %w", err)
 	}
 
-	msg := MakeVoteMessage(block.View, block.BlockID)
+	msg := MakeVoteMessage(view, blockID)
 
 	// verify the beacon signature first since it is faster to verify (no public key aggregation needed)
 	beaconValid, err := dkg.GroupKey().Verify(blockSigData.ReconstructedRandomBeaconSig, msg, c.beaconHasher)
@@ -134,7 +137,7 @@ func (c *CombinedVerifier) VerifyQC(signers flow.IdentityList, sigData []byte, b
 		return fmt.Errorf("internal error while verifying beacon signature: %w", err)
 	}
 	if !beaconValid {
-		return fmt.Errorf("invalid reconstructed random beacon sig for block (%x): %w", block.BlockID, model.ErrInvalidSignature)
+		return fmt.Errorf("invalid reconstructed random beacon sig for block (%x): %w", blockID, model.ErrInvalidSignature)
 	}
 
 	// aggregate public staking keys of all signers (more costly)
@@ -152,17 +155,30 @@ func (c *CombinedVerifier) VerifyQC(signers flow.IdentityList, sigData []byte, b
 		// By checking `len(signers) == 0` upfront , we can rule out case (i) as a source of error.
 		// Hence, if we encounter an error here, we know it is case (ii). Thereby, we can clearly
 		// distinguish a faulty _external_ input from an _internal_ uncovered edge-case.
-		return fmt.Errorf("could not compute aggregated key for block %x: %w", block.BlockID, err)
+		return fmt.Errorf("could not compute aggregated key for block %x: %w", blockID, err)
 	}
 
 	// verify aggregated signature with aggregated keys from last step
 	stakingValid, err := aggregatedKey.Verify(blockSigData.AggregatedStakingSig, msg, c.stakingHasher)
 	if err != nil {
-		return fmt.Errorf("internal error while verifying staking signature for block %x: %w", block.BlockID, err)
+		return fmt.Errorf("internal error while verifying staking signature for block %x: %w", blockID, err)
 	}
 	if !stakingValid {
-		return fmt.Errorf("invalid aggregated staking sig for block %v: %w", block.BlockID, model.ErrInvalidSignature)
+		return fmt.Errorf("invalid aggregated staking sig for block %v: %w", blockID, model.ErrInvalidSignature)
 	}
 
 	return nil
 }
+
+// VerifyTC checks the cryptographic validity of the TC's `sigData` for the
+// given view. It is the responsibility of the calling code to ensure that all
+// `signers` are authorized, without duplicates. Return values:
+//  - nil if `sigData` is cryptographically valid
+//  - model.ErrInvalidFormat if `sigData` has an incompatible format
+//  - model.ErrInvalidSignature if a signature is invalid
+//  - unexpected errors should be treated as symptoms of bugs or uncovered
+//	  edge cases in the logic (i.e. as fatal)
+// The TC's `sigData` is the aggregated staking signature of all signers.
+func (c *CombinedVerifier) VerifyTC(signers flow.IdentityList, sigData []byte, view uint64, newestQCViews []uint64) error {
+	return verifyTCSignatureManyMessages(signers, sigData, view, newestQCViews, c.timeoutHasher)
+}
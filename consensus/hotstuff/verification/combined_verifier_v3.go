@@ -25,6 +25,7 @@ import (
 type CombinedVerifierV3 struct {
 	committee     hotstuff.Committee
 	stakingHasher hash.Hasher
+	timeoutHasher hash.Hasher
 	beaconHasher  hash.Hasher
 	packer        hotstuff.Packer
 }
@@ -38,6 +39,7 @@ func NewCombinedVerifierV3(committee hotstuff.Committee, packer hotstuff.Packer)
 	return &CombinedVerifierV3{
 		committee:     committee,
 		stakingHasher: crypto.NewBLSKMAC(encoding.ConsensusVoteTag),
+		timeoutHasher: crypto.NewBLSKMAC(encoding.ConsensusTimeoutTag),
 		beaconHasher:  crypto.NewBLSKMAC(encoding.RandomBeaconTag),
 		packer:        packer,
 	}
@@ -104,8 +106,9 @@ func (c *CombinedVerifierV3) VerifyVote(signer *flow.Identity, sigData []byte, b
 }
 
 // VerifyQC checks the cryptographic validity of the QC's `sigData` for the
-// given block. It is the responsibility of the calling code to ensure
-// that all `voters` are authorized, without duplicates. Return values:
+// block with the given view and ID. It is the responsibility of the calling
+// code to ensure that all `voters` are authorized, without duplicates.
+// Return values:
 //  - nil if `sigData` is cryptographically valid
 //  - model.ErrInvalidFormat if `sigData` has an incompatible format
 //  - model.ErrInvalidSignature if a signature is invalid
@@ -113,20 +116,20 @@ func (c *CombinedVerifierV3) VerifyVote(signer *flow.Identity, sigData []byte, b
 //  - error if running into any unexpected exception (i.e. fatal error)
 // This implementation already support the cases, where the DKG committee is a
 // _strict subset_ of the full consensus committee.
-func (c *CombinedVerifierV3) VerifyQC(signers flow.IdentityList, sigData []byte, block *model.Block) error {
+func (c *CombinedVerifierV3) VerifyQC(signers flow.IdentityList, sigData []byte, view uint64, blockID flow.Identifier) error {
 	signerIdentities := signers.Lookup()
-	dkg, err := c.committee.DKG(block.BlockID)
+	dkg, err := c.committee.DKGByEpoch(view)
 	if err != nil {
 		return fmt.Errorf("could not get dkg data: %w", err)
 	}
 
 	// unpack sig data using packer
-	blockSigData, err := c.packer.Unpack(block.BlockID, signers.NodeIDs(), sigData)
+	blockSigData, err := c.packer.Unpack(view, signers.NodeIDs(), sigData)
 	if err != nil {
 		return fmt.Errorf("could not split signature: %w", err)
 	}
 
-	msg := MakeVoteMessage(block.View, block.BlockID)
+	msg := MakeVoteMessage(view, blockID)
 
 	// STEP 1: verify random beacon group key
 	// We do this first, since it is faster to check (no public key aggregation needed).
@@ -135,7 +138,7 @@ func (c *CombinedVerifierV3) VerifyQC(signers flow.IdentityList, sigData []byte,
 		return fmt.Errorf("internal error while verifying beacon signature: %w", err)
 	}
 	if !beaconValid {
-		return fmt.Errorf("invalid reconstructed random beacon sig for block (%x): %w", block.BlockID, model.ErrInvalidSignature)
+		return fmt.Errorf("invalid reconstructed random beacon sig for block (%x): %w", blockID, model.ErrInvalidSignature)
 	}
 
 	// verify the aggregated staking and beacon signatures next (more costly)
@@ -151,7 +154,7 @@ func (c *CombinedVerifierV3) VerifyQC(signers flow.IdentityList, sigData []byte,
 			return fmt.Errorf("internal error while verifying aggregated signature: %w", err)
 		}
 		if !valid {
-			return fmt.Errorf("invalid aggregated sig for block %v: %w", block.BlockID, model.ErrInvalidSignature)
+			return fmt.Errorf("invalid aggregated sig for block %v: %w", blockID, model.ErrInvalidSignature)
 		}
 		return nil
 	}
@@ -191,7 +194,7 @@ func (c *CombinedVerifierV3) VerifyQC(signers flow.IdentityList, sigData []byte,
 	// Our previous threshold check also guarantees that `beaconPubKeys` is not empty.
 	err = verifyAggregatedSignature(beaconPubKeys, blockSigData.AggregatedRandomBeaconSig, c.beaconHasher)
 	if err != nil {
-		return fmt.Errorf("verifying aggregated random beacon sig shares failed for block %v: %w", block.BlockID, err)
+		return fmt.Errorf("verifying aggregated random beacon sig shares failed for block %v: %w", blockID, err)
 	}
 
 	// STEP 3: validating the aggregated staking signatures
@@ -201,7 +204,7 @@ func (c *CombinedVerifierV3) VerifyQC(signers flow.IdentityList, sigData []byte,
 	numStakingSigners := len(blockSigData.StakingSigners)
 	if numStakingSigners == 0 {
 		if len(blockSigData.AggregatedStakingSig) > 0 {
-			return fmt.Errorf("all replicas signed with random beacon keys, but QC has aggregated staking sig for block %v: %w", block.BlockID, model.ErrInvalidFormat)
+			return fmt.Errorf("all replicas signed with random beacon keys, but QC has aggregated staking sig for block %v: %w", blockID, model.ErrInvalidFormat)
 		}
 		// no aggregated staking sig to verify
 		return nil
@@ -219,9 +222,22 @@ func (c *CombinedVerifierV3) VerifyQC(signers flow.IdentityList, sigData []byte,
 	}
 	err = verifyAggregatedSignature(stakingPubKeys, blockSigData.AggregatedStakingSig, c.stakingHasher)
 	if err != nil {
-		return fmt.Errorf("verifying aggregated staking sig failed for block %v: %w", block.BlockID, err)
+		return fmt.Errorf("verifying aggregated staking sig failed for block %v: %w", blockID, err)
 
 	}
 
 	return nil
 }
+
+// VerifyTC checks the cryptographic validity of the TC's `sigData` for the
+// given view. It is the responsibility of the calling code to ensure that all
+// `signers` are authorized, without duplicates. Return values:
+//  - nil if `sigData` is cryptographically valid
+//  - model.ErrInvalidFormat if `sigData` has an incompatible format
+//  - model.ErrInvalidSignature if a signature is invalid
+//  - unexpected errors should be treated as symptoms of bugs or uncovered
+//	  edge cases in the logic (i.e. as fatal)
+// The TC's `sigData` is the aggregated staking signature of all signers.
+func (c *CombinedVerifierV3) VerifyTC(signers flow.IdentityList, sigData []byte, view uint64, newestQCViews []uint64) error {
+	return verifyTCSignatureManyMessages(signers, sigData, view, newestQCViews, c.timeoutHasher)
+}
@@ -1,7 +1,13 @@
 package verification
 
 import (
+	"fmt"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/crypto/hash"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
 )
 
 // MakeVoteMessage generates the message we have to sign in order to be able
@@ -19,3 +25,71 @@ func MakeVoteMessage(view uint64, blockID flow.Identifier) []byte {
 	})
 	return msg[:]
 }
+
+// MakeTimeoutMessage generates the message we have to sign in order to be able
+// to contribute to a timeout certificate. Each replica signs its own pair of
+// view and the view of the newest QC it knows; this allows to verify the
+// aggregated signature of a TC without the full timeout objects.
+func MakeTimeoutMessage(view uint64, newestQCView uint64) []byte {
+	msg := flow.MakeID(struct {
+		View         uint64
+		NewestQCView uint64
+	}{
+		View:         view,
+		NewestQCView: newestQCView,
+	})
+	return msg[:]
+}
+
+// verifyTCSignatureManyMessages checks the aggregated staking signature of a TC, where each signer
+// signed its own timeout message for `view` and the respective entry of `newestQCViews`.
+// It is the responsibility of the calling code to ensure that all `signers` are authorized,
+// without duplicates. Return values:
+//  - nil if `sigData` is cryptographically valid
+//  - model.ErrInvalidFormat if the number of signers and views do not match
+//  - model.ErrInvalidSignature if the signature is invalid
+//  - unexpected errors should be treated as symptoms of bugs or uncovered
+//	  edge cases in the logic (i.e. as fatal)
+func verifyTCSignatureManyMessages(signers flow.IdentityList, sigData []byte, view uint64, newestQCViews []uint64, hasher hash.Hasher) error {
+	if len(signers) == 0 {
+		return fmt.Errorf("empty list of signers: %w", model.ErrInvalidFormat)
+	}
+	if len(signers) != len(newestQCViews) {
+		return fmt.Errorf("%d signers but %d newest QC views: %w", len(signers), len(newestQCViews), model.ErrInvalidFormat)
+	}
+
+	messages := make([][]byte, 0, len(signers))
+	hashers := make([]hash.Hasher, 0, len(signers))
+	for _, newestQCView := range newestQCViews {
+		messages = append(messages, MakeTimeoutMessage(view, newestQCView))
+		hashers = append(hashers, hasher)
+	}
+
+	valid, err := crypto.VerifyBLSSignatureManyMessages(signers.PublicStakingKeys(), sigData, messages, hashers)
+	if err != nil {
+		return fmt.Errorf("internal error while verifying aggregated timeout signature: %w", err)
+	}
+	if !valid {
+		return fmt.Errorf("invalid aggregated timeout signature for view %d: %w", view, model.ErrInvalidSignature)
+	}
+	return nil
+}
+
+// createTimeout creates a timeout object for `curView`, which is signed with the
+// staking key of the local node.
+func createTimeout(me module.Local, hasher hash.Hasher, curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	msg := MakeTimeoutMessage(curView, newestQC.View)
+	sig, err := me.Sign(msg, hasher)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate staking signature for timeout at view %d: %w", curView, err)
+	}
+
+	timeout := &model.TimeoutObject{
+		View:       curView,
+		NewestQC:   newestQC,
+		LastViewTC: lastViewTC,
+		SignerID:   me.NodeID(),
+		SigData:    sig,
+	}
+	return timeout, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 )
 
@@ -55,6 +56,13 @@ func (w SignerMetricsWrapper) CreateVote(block *model.Block) (*model.Vote, error
 	return vote, err
 }
 
+func (w SignerMetricsWrapper) CreateTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	processStart := time.Now()
+	timeout, err := w.signer.CreateTimeout(curView, newestQC, lastViewTC)
+	w.metrics.SignerProcessingDuration(time.Since(processStart))
+	return timeout, err
+}
+
 // func (w SignerMetricsWrapper) CreateQC(votes []*model.Vote) (*flow.QuorumCertificate, error) {
 // 	processStart := time.Now()
 // 	qc, err := w.signer.CreateQC(votes)
@@ -18,6 +18,7 @@ import (
 type StakingSigner struct {
 	me            module.Local
 	stakingHasher hash.Hasher
+	timeoutHasher hash.Hasher
 	signerID      flow.Identifier
 }
 
@@ -30,6 +31,7 @@ func NewStakingSigner(
 	sc := &StakingSigner{
 		me:            me,
 		stakingHasher: crypto.NewBLSKMAC(encoding.CollectorVoteTag),
+		timeoutHasher: crypto.NewBLSKMAC(encoding.CollectorTimeoutTag),
 		signerID:      me.NodeID(),
 	}
 	return sc
@@ -78,6 +80,15 @@ func (c *StakingSigner) CreateVote(block *model.Block) (*model.Vote, error) {
 	return vote, nil
 }
 
+// CreateTimeout will create a timeout object with a staking signature for the given view.
+func (c *StakingSigner) CreateTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	timeout, err := createTimeout(c.me, c.timeoutHasher, curView, newestQC, lastViewTC)
+	if err != nil {
+		return nil, fmt.Errorf("could not create timeout: %w", err)
+	}
+	return timeout, nil
+}
+
 // genSigData generates the signature data for our local node for the given block.
 // It returns:
 //  - (stakingSig, nil) signature signed with staking key.  The sig is 48 bytes long
@@ -114,9 +114,9 @@ func TestStakingSigner_VerifyQC(t *testing.T) {
 	sigData := unittest.RandomBytes(127)
 
 	verifier := NewStakingVerifier()
-	err := verifier.VerifyQC([]*flow.Identity{}, sigData, block)
+	err := verifier.VerifyQC([]*flow.Identity{}, sigData, block.View, block.BlockID)
 	require.ErrorIs(t, err, model.ErrInvalidFormat)
 
-	err = verifier.VerifyQC(nil, sigData, block)
+	err = verifier.VerifyQC(nil, sigData, block.View, block.BlockID)
 	require.ErrorIs(t, err, model.ErrInvalidFormat)
 }
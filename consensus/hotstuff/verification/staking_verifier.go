@@ -18,6 +18,7 @@ import (
 // verifying operation. It's used primarily with collection cluster where hotstuff without beacon signers is used.
 type StakingVerifier struct {
 	stakingHasher hash.Hasher
+	timeoutHasher hash.Hasher
 }
 
 var _ hotstuff.Verifier = (*StakingVerifier)(nil)
@@ -26,6 +27,7 @@ var _ hotstuff.Verifier = (*StakingVerifier)(nil)
 func NewStakingVerifier() *StakingVerifier {
 	return &StakingVerifier{
 		stakingHasher: crypto.NewBLSKMAC(encoding.CollectorVoteTag),
+		timeoutHasher: crypto.NewBLSKMAC(encoding.CollectorTimeoutTag),
 	}
 }
 
@@ -55,19 +57,20 @@ func (v *StakingVerifier) VerifyVote(signer *flow.Identity, sigData []byte, bloc
 }
 
 // VerifyQC checks the cryptographic validity of the QC's `sigData` for the
-// given block. It is the responsibility of the calling code to ensure
-// that all `voters` are authorized, without duplicates. Return values:
+// block with the given view and ID. It is the responsibility of the calling
+// code to ensure that all `voters` are authorized, without duplicates.
+// Return values:
 //  - nil if `sigData` is cryptographically valid
 //  - model.ErrInvalidFormat if `sigData` has an incompatible format
 //  - model.ErrInvalidSignature if a signature is invalid
 //  - unexpected errors should be treated as symptoms of bugs or uncovered
 //	  edge cases in the logic (i.e. as fatal)
 // In the single verification case, `sigData` represents a single signature (`crypto.Signature`).
-func (v *StakingVerifier) VerifyQC(signers flow.IdentityList, sigData []byte, block *model.Block) error {
+func (v *StakingVerifier) VerifyQC(signers flow.IdentityList, sigData []byte, view uint64, blockID flow.Identifier) error {
 	if len(signers) == 0 {
 		return fmt.Errorf("empty list of signers: %w", model.ErrInvalidFormat)
 	}
-	msg := MakeVoteMessage(block.View, block.BlockID)
+	msg := MakeVoteMessage(view, blockID)
 
 	// verify the aggregated staking signature
 	// TODO: to be replaced by module/signature.PublicKeyAggregator in V2
@@ -92,7 +95,20 @@ func (v *StakingVerifier) VerifyQC(signers flow.IdentityList, sigData []byte, bl
 	}
 
 	if !stakingValid {
-		return fmt.Errorf("invalid aggregated staking sig for block %v: %w", block.BlockID, model.ErrInvalidSignature)
+		return fmt.Errorf("invalid aggregated staking sig for block %v: %w", blockID, model.ErrInvalidSignature)
 	}
 	return nil
 }
+
+// VerifyTC checks the cryptographic validity of the TC's `sigData` for the
+// given view. It is the responsibility of the calling code to ensure that all
+// `signers` are authorized, without duplicates. Return values:
+//  - nil if `sigData` is cryptographically valid
+//  - model.ErrInvalidFormat if `sigData` has an incompatible format
+//  - model.ErrInvalidSignature if a signature is invalid
+//  - unexpected errors should be treated as symptoms of bugs or uncovered
+//	  edge cases in the logic (i.e. as fatal)
+// The TC's `sigData` is the aggregated staking signature of all signers.
+func (v *StakingVerifier) VerifyTC(signers flow.IdentityList, sigData []byte, view uint64, newestQCViews []uint64) error {
+	return verifyTCSignatureManyMessages(signers, sigData, view, newestQCViews, v.timeoutHasher)
+}
@@ -6,7 +6,8 @@ import (
 )
 
 // Verifier is the component responsible for the cryptographic integrity of
-// votes, proposals and QC's against the block they are signing.
+// votes, proposals and QC's against the block they are signing, as well as
+// of TC's against the view they are timing out.
 // Overall, there are two criteria for the validity of a vote and QC:
 //  (1) the signer ID(s) must correspond to authorized consensus participants
 //  (2) the signature must be cryptographically valid.
@@ -36,8 +37,10 @@ type Verifier interface {
 	VerifyVote(voter *flow.Identity, sigData []byte, block *model.Block) error
 
 	// VerifyQC checks the cryptographic validity of a QC's `SigData` w.r.t. the
-	// given block. It is the responsibility of the calling code to ensure that
-	// all `voters` are authorized, without duplicates.
+	// block with the given view and ID. The block itself is not required, so that
+	// QCs for unknown blocks can be verified, e.g. the newest QC of a TC. It is
+	// the responsibility of the calling code to ensure that all `voters` are
+	// authorized, without duplicates.
 	// Return values:
 	//  * nil if `sigData` is cryptographically valid
 	//  * model.ErrInvalidFormat if `sigData` has an incompatible format
@@ -48,5 +51,19 @@ type Verifier interface {
 	//    being authorized, an InvalidSignerError is returned.
 	//  * unexpected errors should be treated as symptoms of bugs or uncovered
 	//	  edge cases in the logic (i.e. as fatal)
-	VerifyQC(voters flow.IdentityList, sigData []byte, block *model.Block) error
+	VerifyQC(voters flow.IdentityList, sigData []byte, view uint64, blockID flow.Identifier) error
+
+	// VerifyTC checks the cryptographic validity of a TC's `SigData` w.r.t. the
+	// given view. Each signer signed the view together with the view of its newest
+	// QC, which is given by the entry in `newestQCViews` with the same index. It is
+	// the responsibility of the calling code to ensure that all `signers` are
+	// authorized, without duplicates.
+	// Return values:
+	//  * nil if `sigData` is cryptographically valid
+	//  * model.ErrInvalidFormat if `sigData` has an incompatible format, or the
+	//    number of signers and views do not match
+	//  * model.ErrInvalidSignature if a signature is invalid
+	//  * unexpected errors should be treated as symptoms of bugs or uncovered
+	//	  edge cases in the logic (i.e. as fatal)
+	VerifyTC(signers flow.IdentityList, sigData []byte, view uint64, newestQCViews []uint64) error
 }
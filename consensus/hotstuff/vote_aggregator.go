@@ -22,10 +22,10 @@ type BlockSigner interface {
 	CreateVote(*model.Block) (*model.Vote, error)
 }
 
-// VoteAggregator verifies and aggregates votes to build QC.
-// When enough votes have been collected, it builds a QC and send it to the EventLoop
-// VoteAggregator also detects protocol violation, including invalid votes, double voting etc, and
-// notifies a HotStuff consumer for slashing.
+// VoteAggregator verifies and aggregates votes to build QC, and timeouts to build TC.
+// When enough votes (timeouts) have been collected, it builds a QC (TC) and send it to the EventLoop
+// VoteAggregator also detects protocol violation, including invalid votes or timeouts, double voting
+// etc, and notifies a HotStuff consumer for slashing.
 type VoteAggregator interface {
 	module.ReadyDoneAware
 	module.Startable
@@ -36,6 +36,10 @@ type VoteAggregator interface {
 	// This method can be called concurrently, votes will be queued and processed asynchronously.
 	AddVote(vote *model.Vote)
 
+	// AddTimeout verifies and aggregates a timeout object.
+	// This method can be called concurrently, timeouts will be queued and processed asynchronously.
+	AddTimeout(timeout *model.TimeoutObject)
+
 	// AddBlock notifies the VoteAggregator that it should start processing votes for the given block.
 	// AddBlock is a _synchronous_ call (logic is executed by the calling go routine). It also verifies
 	// validity of the proposer's vote for its own block.
@@ -50,7 +54,7 @@ type VoteAggregator interface {
 	// * mempool.DecreasingPruningHeightError if proposal's view has already been pruned
 	InvalidBlock(block *model.Proposal) error
 
-	// PruneUpToView deletes all votes _below_ to the given view, as well as
+	// PruneUpToView deletes all votes and timeouts _below_ to the given view, as well as
 	// related indices. We only retain and process whose view is equal or larger
 	// than `lowestRetainedView`. If `lowestRetainedView` is smaller than the
 	// previous value, the previous value is kept and the method call is a NoOp.
@@ -23,7 +23,11 @@ const defaultVoteAggregatorWorkers = 8
 // defaultVoteQueueCapacity maximum capacity of buffering unprocessed votes
 const defaultVoteQueueCapacity = 1000
 
-// VoteAggregator stores the votes and aggregates them into a QC when enough votes have been collected
+// defaultTimeoutQueueCapacity maximum capacity of buffering unprocessed timeouts
+const defaultTimeoutQueueCapacity = 1000
+
+// VoteAggregator stores the votes and aggregates them into a QC when enough votes have been collected.
+// Likewise, it stores timeouts and aggregates them into a TC when enough timeouts have been collected.
 // VoteAggregator is designed in a way that it can aggregate votes for collection & consensus clusters
 // that is why implementation relies on dependency injection.
 type VoteAggregator struct {
@@ -32,10 +36,13 @@ type VoteAggregator struct {
 	notifier                   hotstuff.Consumer
 	lowestRetainedView         counters.StrictMonotonousCounter // lowest view, for which we still process votes
 	collectors                 hotstuff.VoteCollectors
+	timeoutCollectors          hotstuff.TimeoutCollectors
 	queuedVotesNotifier        engine.Notifier
+	queuedTimeoutsNotifier     engine.Notifier
 	finalizationEventsNotifier engine.Notifier
 	finalizedView              counters.StrictMonotonousCounter // cache the last finalized view to queue up the pruning work, and unblock the caller who's delivering the finalization event.
 	queuedVotes                *fifoqueue.FifoQueue
+	queuedTimeouts             *fifoqueue.FifoQueue
 }
 
 var _ hotstuff.VoteAggregator = (*VoteAggregator)(nil)
@@ -49,6 +56,7 @@ func NewVoteAggregator(
 	notifier hotstuff.Consumer,
 	lowestRetainedView uint64,
 	collectors hotstuff.VoteCollectors,
+	timeoutCollectors hotstuff.TimeoutCollectors,
 ) (*VoteAggregator, error) {
 
 	queuedVotes, err := fifoqueue.NewFifoQueue(fifoqueue.WithCapacity(defaultVoteQueueCapacity))
@@ -56,14 +64,22 @@ func NewVoteAggregator(
 		return nil, fmt.Errorf("could not initialize votes queue")
 	}
 
+	queuedTimeouts, err := fifoqueue.NewFifoQueue(fifoqueue.WithCapacity(defaultTimeoutQueueCapacity))
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize timeouts queue")
+	}
+
 	aggregator := &VoteAggregator{
 		log:                        log,
 		notifier:                   notifier,
 		lowestRetainedView:         counters.NewMonotonousCounter(lowestRetainedView),
 		finalizedView:              counters.NewMonotonousCounter(lowestRetainedView),
 		collectors:                 collectors,
+		timeoutCollectors:          timeoutCollectors,
 		queuedVotes:                queuedVotes,
+		queuedTimeouts:             queuedTimeouts,
 		queuedVotesNotifier:        engine.NewNotifier(),
+		queuedTimeoutsNotifier:     engine.NewNotifier(),
 		finalizationEventsNotifier: engine.NewNotifier(),
 	}
 
@@ -71,10 +87,10 @@ func NewVoteAggregator(
 	componentBuilder := component.NewComponentManagerBuilder()
 	var wg sync.WaitGroup
 	wg.Add(defaultVoteAggregatorWorkers)
-	for i := 0; i < defaultVoteAggregatorWorkers; i++ { // manager for worker routines that process inbound votes
+	for i := 0; i < defaultVoteAggregatorWorkers; i++ { // manager for worker routines that process inbound votes and timeouts
 		componentBuilder.AddWorker(func(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
 			ready()
-			aggregator.queuedVotesProcessingLoop(ctx)
+			aggregator.queuedMessagesProcessingLoop(ctx)
 			wg.Done()
 		})
 	}
@@ -106,18 +122,25 @@ func NewVoteAggregator(
 	return aggregator, nil
 }
 
-func (va *VoteAggregator) queuedVotesProcessingLoop(ctx irrecoverable.SignalerContext) {
-	notifier := va.queuedVotesNotifier.Channel()
+func (va *VoteAggregator) queuedMessagesProcessingLoop(ctx irrecoverable.SignalerContext) {
+	votesNotifier := va.queuedVotesNotifier.Channel()
+	timeoutsNotifier := va.queuedTimeoutsNotifier.Channel()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-notifier:
+		case <-votesNotifier:
 			err := va.processQueuedVoteEvents(ctx)
 			if err != nil {
 				ctx.Throw(fmt.Errorf("internal error processing queued vote events: %w", err))
 				return
 			}
+		case <-timeoutsNotifier:
+			err := va.processQueuedTimeoutEvents(ctx)
+			if err != nil {
+				ctx.Throw(fmt.Errorf("internal error processing queued timeout events: %w", err))
+				return
+			}
 		}
 	}
 }
@@ -184,6 +207,71 @@ func (va *VoteAggregator) processQueuedVote(vote *model.Vote) error {
 	return nil
 }
 
+func (va *VoteAggregator) processQueuedTimeoutEvents(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		msg, ok := va.queuedTimeouts.Pop()
+		if ok {
+			timeout := msg.(*model.TimeoutObject)
+			err := va.processQueuedTimeout(timeout)
+			if err != nil {
+				return fmt.Errorf("could not process pending timeout %v: %w", timeout.ID(), err)
+			}
+
+			va.log.Info().
+				Uint64("view", timeout.View).
+				Hex("signer", timeout.SignerID[:]).
+				Str("timeout_id", timeout.ID().String()).
+				Msg("timeout has been processed successfully")
+
+			continue
+		}
+
+		// when there is no more messages in the queue, back to the loop to wait
+		// for the next incoming message to arrive.
+		return nil
+	}
+}
+
+// processQueuedTimeout performs actual processing of queued timeouts, this method is called from multiple
+// concurrent goroutines.
+func (va *VoteAggregator) processQueuedTimeout(timeout *model.TimeoutObject) error {
+	collector, created, err := va.timeoutCollectors.GetOrCreateCollector(timeout.View)
+	if created {
+		va.log.Info().Uint64("view", timeout.View).Msg("timeout collector is created by processing timeout")
+	}
+
+	if err != nil {
+		// ignore if our routine is outdated and some other one has pruned collectors
+		if mempool.IsDecreasingPruningHeightError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get timeout collector for view %d: %w",
+			timeout.View, err)
+	}
+	err = collector.AddTimeout(timeout)
+	if err != nil {
+		if doubleTimeoutErr, ok := model.AsDoubleTimeoutError(err); ok {
+			va.notifier.OnDoubleTimeoutDetected(doubleTimeoutErr.FirstTimeout, doubleTimeoutErr.ConflictingTimeout)
+			return nil
+		}
+		if invalidTimeoutErr, ok := model.AsInvalidTimeoutError(err); ok {
+			va.notifier.OnInvalidTimeoutDetected(invalidTimeoutErr.Timeout)
+			return nil
+		}
+
+		return fmt.Errorf("could not process timeout for view %d: %w",
+			timeout.View, err)
+	}
+
+	return nil
+}
+
 // AddVote checks if vote is stale and appends vote into processing queue
 // actual vote processing will be called in other dispatching goroutine.
 func (va *VoteAggregator) AddVote(vote *model.Vote) {
@@ -207,6 +295,28 @@ func (va *VoteAggregator) AddVote(vote *model.Vote) {
 	}
 }
 
+// AddTimeout checks if timeout is stale and appends timeout into processing queue
+// actual timeout processing will be called in other dispatching goroutine.
+func (va *VoteAggregator) AddTimeout(timeout *model.TimeoutObject) {
+	// drop stale timeouts
+	if timeout.View < va.lowestRetainedView.Value() {
+
+		va.log.Info().
+			Uint64("view", timeout.View).
+			Hex("signer", timeout.SignerID[:]).
+			Str("timeout_id", timeout.ID().String()).
+			Msg("drop stale timeouts")
+
+		return
+	}
+
+	// It's ok to silently drop timeouts in case our processing pipeline is full.
+	// It means that we are probably catching up.
+	if ok := va.queuedTimeouts.Push(timeout); ok {
+		va.queuedTimeoutsNotifier.Notify()
+	}
+}
+
 // AddBlock notifies the VoteAggregator about a known block so that it can start processing
 // pending votes whose block was unknown.
 // It also verifies the proposer vote of a block, and return whether the proposer signature is valid.
@@ -265,13 +375,14 @@ func (va *VoteAggregator) InvalidBlock(proposal *model.Proposal) error {
 	return nil
 }
 
-// PruneUpToView deletes all votes _below_ to the given view, as well as
+// PruneUpToView deletes all votes and timeouts _below_ to the given view, as well as
 // related indices. We only retain and process whose view is equal or larger
 // than `lowestRetainedView`. If `lowestRetainedView` is smaller than the
 // previous value, the previous value is kept and the method call is a NoOp.
 func (va *VoteAggregator) PruneUpToView(lowestRetainedView uint64) {
 	if va.lowestRetainedView.Set(lowestRetainedView) {
 		va.collectors.PruneUpToView(lowestRetainedView)
+		va.timeoutCollectors.PruneUpToView(lowestRetainedView)
 	}
 }
 
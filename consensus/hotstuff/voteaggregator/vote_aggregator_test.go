@@ -25,15 +25,17 @@ func TestVoteAggregator(t *testing.T) {
 type VoteAggregatorTestSuite struct {
 	suite.Suite
 
-	aggregator     *VoteAggregator
-	collectors     *mocks.VoteCollectors
-	consumer       *mocks.Consumer
-	stopAggregator context.CancelFunc
+	aggregator        *VoteAggregator
+	collectors        *mocks.VoteCollectors
+	timeoutCollectors *mocks.TimeoutCollectors
+	consumer          *mocks.Consumer
+	stopAggregator    context.CancelFunc
 }
 
 func (s *VoteAggregatorTestSuite) SetupTest() {
 	var err error
 	s.collectors = &mocks.VoteCollectors{}
+	s.timeoutCollectors = &mocks.TimeoutCollectors{}
 	s.consumer = &mocks.Consumer{}
 
 	ready := func() <-chan struct{} {
@@ -52,7 +54,7 @@ func (s *VoteAggregatorTestSuite) SetupTest() {
 	s.collectors.On("Ready").Return(ready).Once()
 	s.collectors.On("Done").Return(done).Once()
 
-	s.aggregator, err = NewVoteAggregator(unittest.Logger(), s.consumer, 0, s.collectors)
+	s.aggregator, err = NewVoteAggregator(unittest.Logger(), s.consumer, 0, s.collectors, s.timeoutCollectors)
 	require.NoError(s.T(), err)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -72,9 +74,50 @@ func (s *VoteAggregatorTestSuite) TearDownTest() {
 func (s *VoteAggregatorTestSuite) TestOnFinalizedBlock() {
 	finalizedBlock := unittest.BlockHeaderFixture(unittest.HeaderWithView(100))
 	s.collectors.On("PruneUpToView", finalizedBlock.View).Once()
+	s.timeoutCollectors.On("PruneUpToView", finalizedBlock.View).Once()
 	s.aggregator.OnFinalizedBlock(model.BlockFromFlow(&finalizedBlock, finalizedBlock.View-1))
 	require.Eventually(s.T(),
 		func() bool {
-			return s.collectors.AssertCalled(s.T(), "PruneUpToView", finalizedBlock.View)
+			return s.collectors.AssertCalled(s.T(), "PruneUpToView", finalizedBlock.View) &&
+				s.timeoutCollectors.AssertCalled(s.T(), "PruneUpToView", finalizedBlock.View)
 		}, time.Second, time.Millisecond*20)
 }
+
+// TestAddTimeout_DoubleTimeout tests that a double timeout detected by the timeout collector
+// is reported to the notifier.
+func (s *VoteAggregatorTestSuite) TestAddTimeout_DoubleTimeout() {
+	first := &model.TimeoutObject{View: 100, SignerID: unittest.IdentifierFixture(), SigData: unittest.SignatureFixture()}
+	conflicting := &model.TimeoutObject{View: 100, SignerID: first.SignerID, SigData: unittest.SignatureFixture()}
+
+	collector := &mocks.TimeoutCollector{}
+	collector.On("AddTimeout", conflicting).Return(model.NewDoubleTimeoutErrorf(first, conflicting, "")).Once()
+	s.timeoutCollectors.On("GetOrCreateCollector", conflicting.View).Return(collector, true, nil).Once()
+
+	done := make(chan struct{})
+	s.consumer.On("OnDoubleTimeoutDetected", first, conflicting).Run(func(_ mock.Arguments) {
+		close(done)
+	}).Once()
+
+	s.aggregator.AddTimeout(conflicting)
+	unittest.AssertClosesBefore(s.T(), done, time.Second)
+	collector.AssertExpectations(s.T())
+}
+
+// TestAddTimeout_InvalidTimeout tests that an invalid timeout detected by the timeout collector
+// is reported to the notifier.
+func (s *VoteAggregatorTestSuite) TestAddTimeout_InvalidTimeout() {
+	timeout := &model.TimeoutObject{View: 100, SignerID: unittest.IdentifierFixture(), SigData: unittest.SignatureFixture()}
+
+	collector := &mocks.TimeoutCollector{}
+	collector.On("AddTimeout", timeout).Return(model.NewInvalidTimeoutErrorf(timeout, "")).Once()
+	s.timeoutCollectors.On("GetOrCreateCollector", timeout.View).Return(collector, true, nil).Once()
+
+	done := make(chan struct{})
+	s.consumer.On("OnInvalidTimeoutDetected", timeout).Run(func(_ mock.Arguments) {
+		close(done)
+	}).Once()
+
+	s.aggregator.AddTimeout(timeout)
+	unittest.AssertClosesBefore(s.T(), done, time.Second)
+	collector.AssertExpectations(s.T())
+}
@@ -982,6 +982,8 @@ func TestCombinedVoteProcessorV3_BuildVerifyQC(t *testing.T) {
 	committee := &mockhotstuff.Committee{}
 	committee.On("Identities", block.BlockID, mock.Anything).Return(allIdentities, nil)
 	committee.On("DKG", block.BlockID).Return(inmemDKG, nil)
+	committee.On("IdentitiesByEpoch", block.View, mock.Anything).Return(allIdentities, nil)
+	committee.On("DKGByEpoch", block.View).Return(inmemDKG, nil)
 
 	votes := make([]*model.Vote, 0, len(allIdentities))
 
@@ -2,9 +2,11 @@ package hotstuff
 
 import (
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
 )
 
-// Voter produces votes for the given block according to voting rules.
+// Voter produces votes for the given block according to voting rules,
+// and timeouts for views in which the replica gives up waiting for progress.
 type Voter interface {
 	// ProduceVoteIfVotable takes a block and current view, and decides whether to vote for the block.
 	// Returns:
@@ -14,4 +16,14 @@ type Voter interface {
 	//    This is a sentinel error and _expected_ during normal operation.
 	// All other errors are unexpected and potential symptoms of uncovered edge cases or corrupted internal state (fatal).
 	ProduceVoteIfVotable(block *model.Block, curView uint64) (*model.Vote, error)
+
+	// ProduceTimeout produces a timeout object for the current view, which carries the newest QC
+	// known to the replica and, if the current view was entered through a TC, the TC for the last view.
+	// After timing out, the voter does _not_ vote for any block with the same (or lower) view.
+	// Returns:
+	//  * (timeout, nil): On success.
+	//  * (nil, model.NoTimeoutError): If the replica is not a committee member and must not time out.
+	//    This is a sentinel error and _expected_ during normal operation.
+	// All other errors are unexpected and potential symptoms of uncovered edge cases or corrupted internal state (fatal).
+	ProduceTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
 )
 
 // Voter produces votes for the given block
@@ -12,8 +13,9 @@ type Voter struct {
 	signer        hotstuff.Signer
 	forks         hotstuff.ForksReader
 	persist       hotstuff.Persister
-	committee     hotstuff.Committee // only produce votes when we are valid committee members
-	lastVotedView uint64             // need to keep track of the last view we voted for so we don't double vote accidentally
+	committee     hotstuff.Committee   // only produce votes when we are valid committee members
+	lastVotedView uint64               // need to keep track of the last view we voted for (or timed out in) so we don't double vote accidentally
+	lastTimeout   *model.TimeoutObject // the last timeout we produced, re-used when timing out repeatedly in the same view
 }
 
 // New creates a new Voter instance
@@ -41,7 +43,8 @@ func New(
 // Returns:
 //  * (vote, nil): On the _first_ block for the current view that is safe to vote for.
 //    Subsequently, voter does _not_ vote for any other block with the same (or lower) view.
-//  * (nil, model.NoVoteError): If the voter decides that it does not want to vote for the given block.
+//  * (nil, model.NoVoteError): If the voter decides that it does not want to vote for the given block,
+//    e.g. because it already voted or timed out in the current view.
 //    This is a sentinel error and _expected_ during normal operation.
 // All other errors are unexpected and potential symptoms of uncovered edge cases or corrupted internal state (fatal).
 func (v *Voter) ProduceVoteIfVotable(block *model.Block, curView uint64) (*model.Vote, error) {
@@ -50,7 +53,9 @@ func (v *Voter) ProduceVoteIfVotable(block *model.Block, curView uint64) (*model
 		return nil, fmt.Errorf("expecting block for current view %d, but block's view is %d", curView, block.View)
 	}
 	if curView <= v.lastVotedView {
-		return nil, fmt.Errorf("current view (%d) must be larger than the last voted view (%d)", curView, v.lastVotedView)
+		// we have already voted or timed out in the current view, e.g. because we received
+		// the proposal only after our local timeout fired
+		return nil, model.NoVoteError{Msg: fmt.Sprintf("current view (%d) must be larger than the last voted view (%d)", curView, v.lastVotedView)}
 	}
 
 	// Do not produce a vote for blocks where we are not a valid committee member.
@@ -83,3 +88,54 @@ func (v *Voter) ProduceVoteIfVotable(block *model.Block, curView uint64) (*model
 
 	return vote, nil
 }
+
+// ProduceTimeout produces a timeout object for the current view. The timeout carries the
+// newest QC known to the replica and the TC for the last view (nil if the current view was
+// entered through a QC). After timing out, the voter will not vote in the current view anymore,
+// which is persisted the same way as a vote. When timing out repeatedly in the same view, the
+// voter returns the timeout it produced first, so that re-broadcasting the timeout never results
+// in conflicting timeouts for the same view.
+// Returns:
+//  * (timeout, nil): On success.
+//  * (nil, model.NoTimeoutError): If the replica is not a committee member, or has already voted
+//    or timed out in a newer view, and must not time out.
+//    This is a sentinel error and _expected_ during normal operation.
+// All other errors are unexpected and potential symptoms of uncovered edge cases or corrupted internal state (fatal).
+func (v *Voter) ProduceTimeout(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) (*model.TimeoutObject, error) {
+	// A replica must not time out in a view below the view it last voted or timed out in,
+	// e.g. if the pacemaker was initialized with an outdated view after a restart.
+	if curView < v.lastVotedView {
+		return nil, model.NoTimeoutError{Msg: fmt.Sprintf("current view (%d) is smaller than the last voted view (%d)", curView, v.lastVotedView)}
+	}
+	if v.lastTimeout != nil && v.lastTimeout.View == curView {
+		return v.lastTimeout, nil
+	}
+
+	// Do not produce a timeout if we are not a valid committee member. Timeouts are
+	// validated against the committee of the epoch containing the timeout's view.
+	_, err := v.committee.IdentityByEpoch(curView, v.committee.Self())
+	if model.IsInvalidSignerError(err) {
+		return nil, model.NoTimeoutError{Msg: fmt.Sprintf("not a committee member at view %d", curView)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get self identity: %w", err)
+	}
+
+	timeout, err := v.signer.CreateTimeout(curView, newestQC, lastViewTC)
+	if err != nil {
+		return nil, fmt.Errorf("could not create timeout for view %d: %w", curView, err)
+	}
+	v.lastTimeout = timeout
+
+	// timeout for the current view has been produced, update lastVotedView
+	// to prevent from voting in the same view after timing out
+	if curView > v.lastVotedView {
+		v.lastVotedView = curView
+		err = v.persist.PutVoted(curView)
+		if err != nil {
+			return nil, fmt.Errorf("could not persist last voted: %w", err)
+		}
+	}
+
+	return timeout, nil
+}
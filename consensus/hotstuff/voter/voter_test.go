@@ -9,6 +9,7 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff/helper"
 	"github.com/onflow/flow-go/consensus/hotstuff/mocks"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -21,6 +22,15 @@ func TestProduceVote(t *testing.T) {
 	t.Run("should not vote for block with its view below the last voted view", testBelowLastVotedView)
 	t.Run("should not vote for the same view again", testVotingAgain)
 	t.Run("should not vote while not a committee member", testVotingWhileNonCommitteeMember)
+	t.Run("should not vote after timing out in the same view", testVotingAfterTimeout)
+}
+
+func TestProduceTimeout(t *testing.T) {
+	t.Run("should produce timeout", testTimeoutOK)
+	t.Run("should produce timeout after voting in the same view", testTimeoutAfterVoting)
+	t.Run("should produce the same timeout when timing out again", testTimeoutAgain)
+	t.Run("should not produce timeout below the last voted view", testTimeoutBelowLastVotedView)
+	t.Run("should not produce timeout while not a committee member", testTimeoutWhileNonCommitteeMember)
 }
 
 func createVoter(blockView uint64, lastVotedView uint64, isBlockSafe, isCommitteeMember bool) (*model.Block, *model.Vote, *Voter) {
@@ -35,14 +45,24 @@ func createVoter(blockView uint64, lastVotedView uint64, isBlockSafe, isCommitte
 
 	signer := &mocks.Signer{}
 	signer.On("CreateVote", mock.Anything).Return(expectVote, nil)
+	signer.On("CreateTimeout", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(curView uint64, newestQC *flow.QuorumCertificate, lastViewTC *flow.TimeoutCertificate) *model.TimeoutObject {
+			return helper.TimeoutObjectFixture(
+				helper.WithTimeoutObjectView(curView),
+				helper.WithTimeoutNewestQC(newestQC),
+				helper.WithTimeoutLastViewTC(lastViewTC),
+			)
+		}, nil)
 
 	committee := &mocks.Committee{}
 	me := unittest.IdentityFixture()
 	committee.On("Self").Return(me.NodeID, nil)
 	if isCommitteeMember {
 		committee.On("Identity", mock.Anything, me.NodeID).Return(me, nil)
+		committee.On("IdentityByEpoch", mock.Anything, me.NodeID).Return(me, nil)
 	} else {
 		committee.On("Identity", mock.Anything, me.NodeID).Return(nil, model.NewInvalidSignerErrorf(""))
+		committee.On("IdentityByEpoch", mock.Anything, me.NodeID).Return(nil, model.NewInvalidSignerErrorf(""))
 	}
 
 	voter := New(signer, forks, persist, committee, lastVotedView)
@@ -111,7 +131,7 @@ func testEqualLastVotedView(t *testing.T) {
 	_, err := voter.ProduceVoteIfVotable(block, curView)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "must be larger than the last voted view")
-	require.False(t, model.IsNoVoteError(err))
+	require.True(t, model.IsNoVoteError(err))
 }
 
 func testBelowLastVotedView(t *testing.T) {
@@ -124,7 +144,7 @@ func testBelowLastVotedView(t *testing.T) {
 	_, err := voter.ProduceVoteIfVotable(block, curView)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "must be larger than the last voted view")
-	require.False(t, model.IsNoVoteError(err))
+	require.True(t, model.IsNoVoteError(err))
 }
 
 func testVotingAgain(t *testing.T) {
@@ -141,7 +161,7 @@ func testVotingAgain(t *testing.T) {
 	_, err = voter.ProduceVoteIfVotable(block, curView)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "must be larger than the last voted view")
-	require.False(t, model.IsNoVoteError(err))
+	require.True(t, model.IsNoVoteError(err))
 }
 
 func testVotingWhileNonCommitteeMember(t *testing.T) {
@@ -157,6 +177,92 @@ func testVotingWhileNonCommitteeMember(t *testing.T) {
 	require.True(t, model.IsNoVoteError(err))
 }
 
+func testVotingAfterTimeout(t *testing.T) {
+	blockView, curView, lastVotedView, isBlockSafe, isCommitteeMember := uint64(3), uint64(3), uint64(2), true, true
+
+	// create voter
+	block, _, voter := createVoter(blockView, lastVotedView, isBlockSafe, isCommitteeMember)
+
+	// time out in the current view
+	_, err := voter.ProduceTimeout(curView, helper.MakeQC(helper.WithQCView(curView-1)), nil)
+	require.NoError(t, err)
+
+	// produce vote for the same view after timing out
+	_, err = voter.ProduceVoteIfVotable(block, curView)
+	require.Error(t, err)
+	require.True(t, model.IsNoVoteError(err))
+}
+
+func testTimeoutOK(t *testing.T) {
+	blockView, curView, lastVotedView, isBlockSafe, isCommitteeMember := uint64(3), uint64(5), uint64(2), true, true
+
+	// create voter
+	_, _, voter := createVoter(blockView, lastVotedView, isBlockSafe, isCommitteeMember)
+
+	newestQC := helper.MakeQC(helper.WithQCView(curView - 2))
+	lastViewTC := helper.MakeTC(helper.WithTCView(curView-1), helper.WithTCNewestQC(newestQC))
+	timeout, err := voter.ProduceTimeout(curView, newestQC, lastViewTC)
+	require.NoError(t, err)
+	require.Equal(t, curView, timeout.View)
+	require.Equal(t, newestQC, timeout.NewestQC)
+	require.Equal(t, lastViewTC, timeout.LastViewTC)
+	require.Equal(t, curView, voter.lastVotedView)
+}
+
+func testTimeoutAfterVoting(t *testing.T) {
+	blockView, curView, lastVotedView, isBlockSafe, isCommitteeMember := uint64(3), uint64(3), uint64(2), true, true
+
+	// create voter
+	block, _, voter := createVoter(blockView, lastVotedView, isBlockSafe, isCommitteeMember)
+
+	// vote in the current view
+	_, err := voter.ProduceVoteIfVotable(block, curView)
+	require.NoError(t, err)
+
+	// time out in the same view, e.g. because no QC was formed
+	timeout, err := voter.ProduceTimeout(curView, block.QC, nil)
+	require.NoError(t, err)
+	require.Equal(t, curView, timeout.View)
+}
+
+func testTimeoutAgain(t *testing.T) {
+	blockView, curView, lastVotedView, isBlockSafe, isCommitteeMember := uint64(3), uint64(5), uint64(2), true, true
+
+	// create voter
+	_, _, voter := createVoter(blockView, lastVotedView, isBlockSafe, isCommitteeMember)
+
+	timeout, err := voter.ProduceTimeout(curView, helper.MakeQC(helper.WithQCView(curView-1)), nil)
+	require.NoError(t, err)
+
+	// timing out again in the same view with a different newest QC must not produce a conflicting timeout
+	repeated, err := voter.ProduceTimeout(curView, helper.MakeQC(helper.WithQCView(curView-1)), nil)
+	require.NoError(t, err)
+	require.Equal(t, timeout, repeated)
+}
+
+func testTimeoutBelowLastVotedView(t *testing.T) {
+	blockView, curView, lastVotedView, isBlockSafe, isCommitteeMember := uint64(3), uint64(3), uint64(4), true, true
+
+	// create voter
+	_, _, voter := createVoter(blockView, lastVotedView, isBlockSafe, isCommitteeMember)
+
+	_, err := voter.ProduceTimeout(curView, helper.MakeQC(helper.WithQCView(curView-1)), nil)
+	require.Error(t, err)
+	require.True(t, model.IsNoTimeoutError(err))
+	require.Equal(t, lastVotedView, voter.lastVotedView)
+}
+
+func testTimeoutWhileNonCommitteeMember(t *testing.T) {
+	blockView, curView, lastVotedView, isBlockSafe, isCommitteeMember := uint64(3), uint64(3), uint64(2), true, false
+
+	// create voter
+	_, _, voter := createVoter(blockView, lastVotedView, isBlockSafe, isCommitteeMember)
+
+	_, err := voter.ProduceTimeout(curView, helper.MakeQC(helper.WithQCView(curView-1)), nil)
+	require.Error(t, err)
+	require.True(t, model.IsNoTimeoutError(err))
+}
+
 func makeVote(block *model.Block) *model.Vote {
 	return &model.Vote{
 		BlockID: block.BlockID,
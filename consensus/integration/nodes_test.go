@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/gammazero/workerpool"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/mock"
@@ -22,6 +21,7 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff/notifications/pubsub"
 	"github.com/onflow/flow-go/consensus/hotstuff/persister"
 	hsig "github.com/onflow/flow-go/consensus/hotstuff/signature"
+	"github.com/onflow/flow-go/consensus/hotstuff/timeoutcollector"
 	"github.com/onflow/flow-go/consensus/hotstuff/verification"
 	"github.com/onflow/flow-go/consensus/hotstuff/voteaggregator"
 	"github.com/onflow/flow-go/consensus/hotstuff/votecollector"
@@ -29,6 +29,7 @@ import (
 	synceng "github.com/onflow/flow-go/engine/common/synchronization"
 	"github.com/onflow/flow-go/engine/consensus/compliance"
 	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/encoding"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/flow/filter"
 	"github.com/onflow/flow-go/model/flow/order"
@@ -52,6 +53,7 @@ import (
 	"github.com/onflow/flow-go/state/protocol/inmem"
 	"github.com/onflow/flow-go/state/protocol/util"
 	storage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	storagemock "github.com/onflow/flow-go/storage/mock"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -129,7 +131,7 @@ func (p *ConsensusParticipants) Update(epochCounter uint64, data *run.Participan
 }
 
 type Node struct {
-	db         *badger.DB
+	db         kv.DB
 	dbDir      string
 	index      int
 	log        zerolog.Logger
@@ -449,6 +451,7 @@ func createNode(
 	require.NoError(t, err)
 
 	qcDistributor := pubsub.NewQCCreatedDistributor()
+	tcDistributor := pubsub.NewTCCreatedDistributor()
 
 	forks, err := consensus.NewForks(rootHeader, headersDB, final, notifier, rootHeader, rootQC)
 	require.NoError(t, err)
@@ -487,7 +490,10 @@ func createNode(
 	createCollectorFactoryMethod := votecollector.NewStateMachineFactory(log, notifier, voteProcessorFactory.Create)
 	voteCollectors := voteaggregator.NewVoteCollectors(log, started, workerpool.New(2), createCollectorFactoryMethod)
 
-	aggregator, err := voteaggregator.NewVoteAggregator(log, notifier, started, voteCollectors)
+	createTimeoutCollectorFactoryMethod := timeoutcollector.NewFactoryMethod(log, committee, validator, encoding.ConsensusTimeoutTag, tcDistributor.OnTcConstructedFromTimeouts)
+	timeoutCollectors := voteaggregator.NewTimeoutCollectors(log, started, createTimeoutCollectorFactoryMethod)
+
+	aggregator, err := voteaggregator.NewVoteAggregator(log, notifier, started, voteCollectors, timeoutCollectors)
 	require.NoError(t, err)
 
 	hotstuffModules := &consensus.HotstuffModules{
@@ -498,6 +504,7 @@ func createNode(
 		Signer:               signer,
 		Persist:              persist,
 		QCCreatedDistributor: qcDistributor,
+		TCCreatedDistributor: tcDistributor,
 		Aggregator:           aggregator,
 	}
 
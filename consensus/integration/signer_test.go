@@ -43,6 +43,6 @@ func (*Signer) VerifyVote(voterID *flow.Identity, sigData []byte, block *model.B
 	return nil
 }
 
-func (*Signer) VerifyQC(voters flow.IdentityList, sigData []byte, block *model.Block) error {
+func (*Signer) VerifyQC(voters flow.IdentityList, sigData []byte, view uint64, blockID flow.Identifier) error {
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff/blockproducer"
 	"github.com/onflow/flow-go/consensus/hotstuff/eventhandler"
 	"github.com/onflow/flow-go/consensus/hotstuff/eventloop"
+	"github.com/onflow/flow-go/consensus/hotstuff/flightrecorder"
 	"github.com/onflow/flow-go/consensus/hotstuff/forks"
 	"github.com/onflow/flow-go/consensus/hotstuff/forks/finalizer"
 	"github.com/onflow/flow-go/consensus/hotstuff/forks/forkchoice"
@@ -47,6 +48,7 @@ func NewParticipant(
 		TimeoutIncreaseFactor:      defTimeout.TimeoutIncrease,
 		TimeoutDecreaseFactor:      defTimeout.TimeoutDecrease,
 		BlockRateDelay:             time.Duration(defTimeout.BlockRateDelayMS) * time.Millisecond,
+		FlightRecorder:             flightrecorder.NewNoopRecorder(),
 	}
 
 	// apply the configuration options
@@ -54,12 +56,6 @@ func NewParticipant(
 		option(&cfg)
 	}
 
-	// get the last view we started
-	started, err := modules.Persist.GetStarted()
-	if err != nil {
-		return nil, fmt.Errorf("could not recover last started: %w", err)
-	}
-
 	// get the last view we voted
 	voted, err := modules.Persist.GetVoted()
 	if err != nil {
@@ -88,13 +84,32 @@ func NewParticipant(
 		return nil, fmt.Errorf("could not initialize timeout config: %w", err)
 	}
 
-	// initialize the pacemaker
+	// recover the newest certificates known to the pacemaker before the restart
+	newestQC, newestTC, err := recoverCertificates(modules)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover pacemaker certificates: %w", err)
+	}
+
+	// initialize the pacemaker, it enters the view justified by the newest recovered certificate
 	controller := timeout.NewController(timeoutConfig)
-	pacemaker, err := pacemaker.New(started+1, controller, modules.Notifier)
+	pacemaker, err := pacemaker.New(newestQC, newestTC, controller, modules.Notifier)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize flow pacemaker: %w", err)
 	}
 
+	// the persisted certificates are written before the started view, so the
+	// pacemaker should never start below the last started view
+	started, err := modules.Persist.GetStarted()
+	if err != nil {
+		return nil, fmt.Errorf("could not recover last started view: %w", err)
+	}
+	if pacemaker.CurView() < started {
+		log.Warn().
+			Uint64("cur_view", pacemaker.CurView()).
+			Uint64("started_view", started).
+			Msg("pacemaker starts below the last started view")
+	}
+
 	// initialize block producer
 	producer, err := blockproducer.New(modules.Signer, modules.Committee, builder)
 	if err != nil {
@@ -117,19 +132,21 @@ func NewParticipant(
 		voter,
 		modules.Validator,
 		modules.Notifier,
+		cfg.FlightRecorder,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize event handler: %w", err)
 	}
 
 	// initialize and return the event loop
-	loop, err := eventloop.NewEventLoop(log, metrics, eventHandler, cfg.StartupTime)
+	loop, err := eventloop.NewEventLoop(log, metrics, eventHandler, cfg.FlightRecorder, cfg.StartupTime)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize event loop: %w", err)
 	}
 
 	// add observer, event loop needs to receive events from distributor
 	modules.QCCreatedDistributor.AddConsumer(loop.SubmitTrustedQC)
+	modules.TCCreatedDistributor.AddConsumer(loop.SubmitTrustedTC)
 
 	return loop, nil
 }
@@ -238,3 +255,22 @@ func makeRootBlockQC(header *flow.Header, qc *flow.QuorumCertificate) *forks.Blo
 		Block: rootBlock,
 	}
 }
+
+// recoverCertificates returns the newest QC and TC to initialize the pacemaker with.
+// The persisted QC is used if it is newer than the newest QC known to Forks.
+// The returned TC is nil if no TC was persisted.
+func recoverCertificates(modules *HotstuffModules) (*flow.QuorumCertificate, *flow.TimeoutCertificate, error) {
+	newestQC := modules.Forks.NewestQC()
+	persistedQC, err := modules.Persist.GetNewestQC()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not recover newest QC: %w", err)
+	}
+	if persistedQC != nil && persistedQC.View > newestQC.View {
+		newestQC = persistedQC
+	}
+	newestTC, err := modules.Persist.GetNewestTC()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not recover newest TC: %w", err)
+	}
+	return newestQC, newestTC, nil
+}
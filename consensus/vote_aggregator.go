@@ -8,24 +8,34 @@ import (
 
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/notifications/pubsub"
+	"github.com/onflow/flow-go/consensus/hotstuff/timeoutcollector"
 	"github.com/onflow/flow-go/consensus/hotstuff/voteaggregator"
 	"github.com/onflow/flow-go/consensus/hotstuff/votecollector"
 )
 
-// NewVoteAggregator creates new VoteAggregator and recover the Forks' state with all pending block
+// NewVoteAggregator creates new VoteAggregator and recover the Forks' state with all pending block.
+// Besides votes, the aggregator collects timeouts signed with the domain separation tag `timeoutDSTag`
+// and publishes the constructed TCs through the given tcDistributor.
 func NewVoteAggregator(
 	log zerolog.Logger,
 	lowestRetainedView uint64,
 	notifier hotstuff.Consumer,
 	voteProcessorFactory hotstuff.VoteProcessorFactory,
 	distributor *pubsub.FinalizationDistributor,
+	committee hotstuff.Committee,
+	validator hotstuff.Validator,
+	timeoutDSTag string,
+	tcDistributor *pubsub.TCCreatedDistributor,
 ) (hotstuff.VoteAggregator, error) {
 
 	createCollectorFactoryMethod := votecollector.NewStateMachineFactory(log, notifier, voteProcessorFactory.Create)
 	voteCollectors := voteaggregator.NewVoteCollectors(log, lowestRetainedView, workerpool.New(4), createCollectorFactoryMethod)
 
+	createTimeoutCollectorFactoryMethod := timeoutcollector.NewFactoryMethod(log, committee, validator, timeoutDSTag, tcDistributor.OnTcConstructedFromTimeouts)
+	timeoutCollectors := voteaggregator.NewTimeoutCollectors(log, lowestRetainedView, createTimeoutCollectorFactoryMethod)
+
 	// initialize the vote aggregator
-	aggregator, err := voteaggregator.NewVoteAggregator(log, notifier, lowestRetainedView, voteCollectors)
+	aggregator, err := voteaggregator.NewVoteAggregator(log, notifier, lowestRetainedView, voteCollectors, timeoutCollectors)
 	if err != nil {
 		return nil, fmt.Errorf("could not create vote aggregator: %w", err)
 	}
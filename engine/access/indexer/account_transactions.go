@@ -0,0 +1,163 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+// AccountTransactionsProgressName is the name under which the account transaction indexer persists
+// the last indexed height.
+const AccountTransactionsProgressName = "access_account_transactions_indexer"
+
+// NewAccountTransactions creates a new indexer engine for the account transaction index. For every
+// transaction of a sealed block, it indexes the payer, the proposer, the authorizers and all accounts
+// whose address is a field value of an event emitted by the transaction. Indexing starts at the given
+// start height, or at the first block after the root block if the start height is not above the root
+// height. If the indexer has run before, it resumes from the last indexed height instead.
+func NewAccountTransactions(
+	log zerolog.Logger,
+	state protocol.State,
+	headers storage.Headers,
+	blocks storage.Blocks,
+	collections storage.Collections,
+	results TransactionResultsProvider,
+	txs storage.AccountTransactions,
+	progress storage.ConsumerProgress,
+	startHeight uint64,
+) (*Engine, error) {
+
+	e, err := newEngine(log.With().Str("index", "account_transactions").Logger(), state, headers, blocks, results, progress, startHeight)
+	if err != nil {
+		return nil, err
+	}
+	e.index = func(ctx context.Context, header *flow.Header) error {
+		return e.indexAccountTransactions(ctx, collections, txs, header)
+	}
+
+	return e, nil
+}
+
+// indexAccountTransactions indexes the accounts involved in each transaction of the given block.
+func (e *Engine) indexAccountTransactions(
+	ctx context.Context,
+	collections storage.Collections,
+	index storage.AccountTransactions,
+	header *flow.Header,
+) error {
+
+	blockID := header.ID()
+	block, err := e.blocks.ByID(blockID)
+	if err != nil {
+		return fmt.Errorf("could not get block: %w", err)
+	}
+
+	results, err := e.results.GetTransactionResultsByBlockID(ctx, blockID)
+	if err != nil {
+		return fmt.Errorf("could not get transaction results: %w", err)
+	}
+	events := make(map[flow.Identifier][]flow.Event, len(results))
+	for _, result := range results {
+		events[result.TransactionID] = append(events[result.TransactionID], result.Events...)
+	}
+
+	var entries []flow.AccountTransaction
+	txIndex := uint32(0)
+	for _, guarantee := range block.Payload.Guarantees {
+		collection, err := collections.ByID(guarantee.CollectionID)
+		if err != nil {
+			return fmt.Errorf("could not get collection %x: %w", guarantee.CollectionID, err)
+		}
+
+		for _, tx := range collection.Transactions {
+			roles := make(map[flow.Address]flow.TransactionRoles)
+			roles[tx.Payer] |= flow.TransactionRolePayer
+			roles[tx.ProposalKey.Address] |= flow.TransactionRoleProposer
+			for _, authorizer := range tx.Authorizers {
+				roles[authorizer] |= flow.TransactionRoleAuthorizer
+			}
+
+			txID := tx.ID()
+			for _, event := range events[txID] {
+				addresses, err := eventAddresses(event)
+				if err != nil {
+					// a malformed payload must not halt indexing, the event is skipped
+					e.log.Warn().Err(err).
+						Hex("tx_id", txID[:]).
+						Uint32("event_index", event.EventIndex).
+						Msg("could not decode event payload")
+					continue
+				}
+				for _, address := range addresses {
+					roles[address] |= flow.TransactionRoleEvent
+				}
+			}
+
+			for address, role := range roles {
+				entries = append(entries, flow.AccountTransaction{
+					Address:          address,
+					BlockID:          blockID,
+					Height:           header.Height,
+					TransactionID:    txID,
+					TransactionIndex: txIndex,
+					Roles:            role,
+				})
+			}
+			txIndex++
+		}
+	}
+
+	err = index.Store(entries)
+	if err != nil {
+		return fmt.Errorf("could not index account transactions: %w", err)
+	}
+
+	e.log.Debug().
+		Uint64("height", header.Height).
+		Uint32("transactions", txIndex).
+		Int("entries", len(entries)).
+		Msg("block indexed")
+
+	return nil
+}
+
+// eventAddresses returns the addresses contained in the field values of the JSON-CDC encoded event.
+func eventAddresses(event flow.Event) ([]flow.Address, error) {
+	value, err := jsoncdc.Decode(event.Payload)
+	if err != nil {
+		return nil, err
+	}
+	decoded, ok := value.(cadence.Event)
+	if !ok {
+		return nil, fmt.Errorf("payload is not an event")
+	}
+
+	var addresses []flow.Address
+	var collect func(value cadence.Value)
+	collect = func(value cadence.Value) {
+		switch v := value.(type) {
+		case cadence.Address:
+			addresses = append(addresses, flow.Address(v))
+		case cadence.Optional:
+			if v.Value != nil {
+				collect(v.Value)
+			}
+		case cadence.Array:
+			for _, element := range v.Values {
+				collect(element)
+			}
+		}
+	}
+	for _, field := range decoded.Fields {
+		collect(field)
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,110 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/model/flow"
+	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	storage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// staticResults returns fixed transaction results for every block.
+type staticResults []*access.TransactionResult
+
+func (r staticResults) GetTransactionResultsByBlockID(context.Context, flow.Identifier) ([]*access.TransactionResult, error) {
+	return r, nil
+}
+
+func TestIndexAccountTransactions(t *testing.T) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
+		payer := unittest.RandomAddressFixture()
+		authorizer := unittest.RandomAddressFixture()
+		recipient := unittest.RandomAddressFixture()
+
+		tx := unittest.TransactionBodyFixture(func(tx *flow.TransactionBody) {
+			tx.Payer = payer
+			tx.ProposalKey.Address = payer
+			tx.Authorizers = []flow.Address{authorizer}
+		})
+		collection := flow.Collection{Transactions: []*flow.TransactionBody{&tx}}
+		guarantee := unittest.CollectionGuaranteeFixture(func(g *flow.CollectionGuarantee) {
+			g.CollectionID = collection.ID()
+		})
+
+		root := unittest.BlockHeaderFixture()
+		block := unittest.BlockWithParentFixture(&root)
+		block.Payload.Guarantees = []*flow.CollectionGuarantee{guarantee}
+		header := block.Header
+
+		// the transaction emits a deposit event to the recipient
+		value := cadence.NewEvent([]cadence.Value{
+			cadence.NewOptional(cadence.Address(recipient)),
+		}).WithType(&cadence.EventType{
+			Location:            common.AddressLocation{Address: common.Address{0x1}, Name: "FlowToken"},
+			QualifiedIdentifier: "FlowToken.TokensDeposited",
+			Fields:              []cadence.Field{{Identifier: "to", Type: cadence.OptionalType{Type: cadence.AddressType{}}}},
+		})
+		payload, err := jsoncdc.Encode(value)
+		require.NoError(t, err)
+		event := unittest.EventFixture("A.0000000000000001.FlowToken.TokensDeposited", 0, 0, tx.ID(), 0)
+		event.Payload = payload
+		results := staticResults{{BlockID: header.ID(), TransactionID: tx.ID(), Events: []flow.Event{event}}}
+
+		headers := new(storagemock.Headers)
+		headers.On("ByHeight", header.Height).Return(header, nil)
+		blocks := new(storagemock.Blocks)
+		blocks.On("ByID", header.ID()).Return(block, nil)
+		blocks.On("GetLastFullBlockHeight").Return(header.Height, nil)
+		collections := new(storagemock.Collections)
+		collections.On("ByID", collection.ID()).Return(&collection, nil)
+
+		snapshot := new(protocol.Snapshot)
+		snapshot.On("Head").Return(header, nil)
+		params := new(protocol.Params)
+		params.On("Root").Return(&root, nil)
+		state := new(protocol.State)
+		state.On("Params").Return(params)
+		state.On("Sealed").Return(snapshot)
+
+		index := storage.NewAccountTransactions(db)
+		progress := storage.NewConsumerProgress(db, AccountTransactionsProgressName)
+
+		e, err := NewAccountTransactions(zerolog.Nop(), state, headers, blocks, collections, results, index, progress, 0)
+		require.NoError(t, err)
+
+		e.indexSealedBlocks()
+
+		processed, err := progress.ProcessedIndex()
+		require.NoError(t, err)
+		assert.Equal(t, header.Height, processed)
+
+		expectedRoles := map[flow.Address]flow.TransactionRoles{
+			payer:      flow.TransactionRolePayer | flow.TransactionRoleProposer,
+			authorizer: flow.TransactionRoleAuthorizer,
+			recipient:  flow.TransactionRoleEvent,
+		}
+		for address, roles := range expectedRoles {
+			txs, err := index.ByAddress(address, flow.TransactionPosition{}, header.Height, 10)
+			require.NoError(t, err)
+			require.Len(t, txs, 1)
+			assert.Equal(t, tx.ID(), txs[0].TransactionID)
+			assert.Equal(t, header.ID(), txs[0].BlockID)
+			assert.Equal(t, roles, txs[0].Roles)
+		}
+
+		blocks.AssertExpectations(t)
+		collections.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,207 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+// ProgressName is the name under which the indexer persists the last indexed height.
+const ProgressName = "access_indexer"
+
+// time between checks for newly sealed blocks to index
+const indexInterval = time.Second
+
+// time to wait for the transaction results of a single block
+const resultsTimeout = 10 * time.Second
+
+// TransactionResultsProvider provides the transaction results, including the emitted events,
+// of all transactions in a block. It is implemented by the Access API backend.
+type TransactionResultsProvider interface {
+	GetTransactionResultsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*access.TransactionResult, error)
+}
+
+// Engine maintains a secondary index of an Access node. It follows the sealed chain and, for every
+// sealed block whose collections have been received, retrieves the transaction results from the
+// execution nodes and indexes the block.
+type Engine struct {
+	unit     *engine.Unit
+	log      zerolog.Logger
+	state    protocol.State
+	headers  storage.Headers
+	blocks   storage.Blocks
+	results  TransactionResultsProvider
+	progress storage.ConsumerProgress
+	index    func(ctx context.Context, header *flow.Header) error
+}
+
+// New creates a new indexer engine for the event index. Indexing starts at the given start height,
+// or at the first block after the root block if the start height is not above the root height. If
+// the indexer has run before, it resumes from the last indexed height instead.
+func New(
+	log zerolog.Logger,
+	state protocol.State,
+	headers storage.Headers,
+	blocks storage.Blocks,
+	results TransactionResultsProvider,
+	events storage.EventIndex,
+	progress storage.ConsumerProgress,
+	startHeight uint64,
+) (*Engine, error) {
+
+	e, err := newEngine(log.With().Str("index", "events").Logger(), state, headers, blocks, results, progress, startHeight)
+	if err != nil {
+		return nil, err
+	}
+	e.index = func(ctx context.Context, header *flow.Header) error {
+		return e.indexEvents(ctx, events, header)
+	}
+
+	return e, nil
+}
+
+// newEngine creates an indexer engine without an index function, initializing the indexing progress.
+func newEngine(
+	log zerolog.Logger,
+	state protocol.State,
+	headers storage.Headers,
+	blocks storage.Blocks,
+	results TransactionResultsProvider,
+	progress storage.ConsumerProgress,
+	startHeight uint64,
+) (*Engine, error) {
+
+	root, err := state.Params().Root()
+	if err != nil {
+		return nil, fmt.Errorf("could not get root block: %w", err)
+	}
+
+	// the processed index is the last indexed height, the root block itself has no transactions
+	processed := root.Height
+	if startHeight > root.Height {
+		processed = startHeight - 1
+	}
+	err = progress.InitProcessedIndex(processed)
+	if err != nil && !errors.Is(err, storage.ErrAlreadyExists) {
+		return nil, fmt.Errorf("could not initialize indexer progress: %w", err)
+	}
+
+	e := &Engine{
+		unit:     engine.NewUnit(),
+		log:      log.With().Str("engine", "indexer").Logger(),
+		state:    state,
+		headers:  headers,
+		blocks:   blocks,
+		results:  results,
+		progress: progress,
+	}
+
+	return e, nil
+}
+
+// Ready returns a ready channel that is closed once the engine has fully started.
+func (e *Engine) Ready() <-chan struct{} {
+	e.unit.LaunchPeriodically(e.indexSealedBlocks, indexInterval, time.Duration(0))
+	return e.unit.Ready()
+}
+
+// Done returns a done channel that is closed once the engine has fully stopped.
+func (e *Engine) Done() <-chan struct{} {
+	return e.unit.Done()
+}
+
+// indexSealedBlocks indexes all blocks after the last indexed height, up to the highest block which
+// is sealed and for which all collections have been received.
+func (e *Engine) indexSealedBlocks() {
+	processed, err := e.progress.ProcessedIndex()
+	if err != nil {
+		e.log.Error().Err(err).Msg("could not get last indexed height")
+		return
+	}
+
+	sealed, err := e.state.Sealed().Head()
+	if err != nil {
+		e.log.Error().Err(err).Msg("could not get last sealed block")
+		return
+	}
+	limit := sealed.Height
+
+	full, err := e.blocks.GetLastFullBlockHeight()
+	if errors.Is(err, storage.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		e.log.Error().Err(err).Msg("could not get last full block height")
+		return
+	}
+	if full < limit {
+		limit = full
+	}
+
+	for height := processed + 1; height <= limit; height++ {
+		select {
+		case <-e.unit.Quit():
+			return
+		default:
+		}
+
+		err := e.indexHeight(height)
+		if err != nil {
+			e.log.Warn().Err(err).Uint64("height", height).Msg("could not index block, will retry")
+			return
+		}
+
+		err = e.progress.SetProcessedIndex(height)
+		if err != nil {
+			e.log.Error().Err(err).Uint64("height", height).Msg("could not update last indexed height")
+			return
+		}
+	}
+}
+
+// indexHeight indexes the finalized and sealed block at the given height.
+func (e *Engine) indexHeight(height uint64) error {
+	header, err := e.headers.ByHeight(height)
+	if err != nil {
+		return fmt.Errorf("could not get header: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(e.unit.Ctx(), resultsTimeout)
+	defer cancel()
+
+	return e.index(ctx, header)
+}
+
+// indexEvents indexes all events emitted by the transactions of the given block.
+func (e *Engine) indexEvents(ctx context.Context, index storage.EventIndex, header *flow.Header) error {
+	results, err := e.results.GetTransactionResultsByBlockID(ctx, header.ID())
+	if err != nil {
+		return fmt.Errorf("could not get transaction results: %w", err)
+	}
+
+	var events []flow.Event
+	for _, result := range results {
+		events = append(events, result.Events...)
+	}
+
+	err = index.Store(header, events)
+	if err != nil {
+		return fmt.Errorf("could not index events: %w", err)
+	}
+
+	e.log.Debug().
+		Uint64("height", header.Height).
+		Int("events", len(events)).
+		Msg("block indexed")
+
+	return nil
+}
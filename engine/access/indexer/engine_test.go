@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/model/flow"
+	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	storage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// resultsProvider returns a single transaction result with one event per block.
+type resultsProvider struct {
+	eventType flow.EventType
+	requested []flow.Identifier
+}
+
+func (p *resultsProvider) GetTransactionResultsByBlockID(_ context.Context, blockID flow.Identifier) ([]*access.TransactionResult, error) {
+	p.requested = append(p.requested, blockID)
+	txID := unittest.IdentifierFixture()
+	return []*access.TransactionResult{{
+		BlockID:       blockID,
+		TransactionID: txID,
+		Events:        []flow.Event{unittest.EventFixture(p.eventType, 0, 0, txID, 0)},
+	}}, nil
+}
+
+func TestIndexSealedBlocks(t *testing.T) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
+		root := unittest.BlockHeaderFixture()
+		root.Height = 100
+
+		headersByHeight := make(map[uint64]*flow.Header)
+		headers := new(storagemock.Headers)
+		headers.On("ByHeight", mock.Anything).Return(
+			func(height uint64) *flow.Header {
+				header := unittest.BlockHeaderWithParentFixture(&root)
+				header.Height = height
+				headersByHeight[height] = &header
+				return &header
+			},
+			nil,
+		)
+
+		sealed := unittest.BlockHeaderFixture()
+		sealed.Height = 110
+		snapshot := new(protocol.Snapshot)
+		snapshot.On("Head").Return(&sealed, nil)
+		params := new(protocol.Params)
+		params.On("Root").Return(&root, nil)
+		state := new(protocol.State)
+		state.On("Params").Return(params)
+		state.On("Sealed").Return(snapshot)
+
+		// collections have only been received up to height 108
+		blocks := new(storagemock.Blocks)
+		blocks.On("GetLastFullBlockHeight").Return(uint64(108), nil)
+
+		provider := &resultsProvider{eventType: flow.EventAccountCreated}
+		index := storage.NewEventIndex(db)
+		progress := storage.NewConsumerProgress(db, ProgressName)
+
+		e, err := New(zerolog.Nop(), state, headers, blocks, provider, index, progress, 105)
+		require.NoError(t, err)
+
+		e.indexSealedBlocks()
+
+		processed, err := progress.ProcessedIndex()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(108), processed)
+		assert.Len(t, provider.requested, 4)
+
+		locators, err := index.ByTypes([]flow.EventType{flow.EventAccountCreated}, flow.EventPosition{}, 200, 100)
+		require.NoError(t, err)
+		require.Len(t, locators, 4)
+		assert.Equal(t, uint64(105), locators[0].Height)
+		assert.Equal(t, headersByHeight[105].ID(), locators[0].BlockID)
+
+		// restarting the indexer resumes from the last indexed height
+		_, err = New(zerolog.Nop(), state, headers, blocks, provider, index, progress, 0)
+		require.NoError(t, err)
+		processed, err = progress.ProcessedIndex()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(108), processed)
+	})
+}
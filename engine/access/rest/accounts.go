@@ -31,3 +31,21 @@ func GetAccount(r *request.Request, backend access.API, link models.LinkGenerato
 	err = response.Build(account, link, r.ExpandFields)
 	return response, err
 }
+
+// GetAccountTransactions handler retrieves the transactions involving the account from the account
+// transaction index of the node and returns the response
+func GetAccountTransactions(r *request.Request, backend access.API, link models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetAccountTransactionsRequest()
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+
+	txs, next, err := backend.GetAccountTransactions(r.Context(), req.Address, req.StartHeight, req.EndHeight, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.AccountTransactionsPage
+	err = response.Build(txs, next, link)
+	return response, err
+}
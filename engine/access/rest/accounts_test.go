@@ -13,6 +13,7 @@ import (
 
 	"github.com/onflow/flow-go/access/mock"
 	"github.com/onflow/flow-go/engine/access/rest/middleware"
+	"github.com/onflow/flow-go/engine/access/rest/request"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -127,6 +128,70 @@ func TestGetAccount(t *testing.T) {
 	})
 }
 
+func TestGetAccountTransactions(t *testing.T) {
+	backend := &mock.API{}
+	address := unittest.AddressFixture()
+
+	txURL := func(query string) string {
+		return fmt.Sprintf("/v1/accounts/%s/transactions%s", address, query)
+	}
+
+	t.Run("get page of transactions", func(t *testing.T) {
+		tx := flow.AccountTransaction{
+			Address:          address,
+			BlockID:          unittest.IdentifierFixture(),
+			Height:           12,
+			TransactionID:    unittest.IdentifierFixture(),
+			TransactionIndex: 1,
+			Roles:            flow.TransactionRolePayer | flow.TransactionRoleAuthorizer,
+		}
+		cursor := tx.Position().Cursor()
+
+		backend.Mock.
+			On("GetAccountTransactions", mocktestify.Anything, address, uint64(10), request.SealedHeight, "", uint(1)).
+			Return([]flow.AccountTransaction{tx}, cursor, nil)
+
+		req, err := http.NewRequest("GET", txURL("?start_height=10&limit=1"), nil)
+		require.NoError(t, err)
+
+		expected := fmt.Sprintf(`{
+			"transactions":[{
+				"transaction_id":"%s",
+				"transaction_index":"1",
+				"block_id":"%s",
+				"block_height":"12",
+				"roles":["payer","authorizer"],
+				"_links":{"_self":"/v1/transactions/%s"}
+			}],
+			"next_cursor":"%s"
+		}`, tx.TransactionID, tx.BlockID, tx.TransactionID, cursor)
+
+		assertOKResponse(t, req, expected, backend)
+		mocktestify.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			url string
+			out string
+		}{
+			{"/v1/accounts/123/transactions", `{"code":400, "message":"invalid address"}`},
+			{txURL("?start_height=20&end_height=10"), `{"code":400, "message":"start height must be less than or equal to end height"}`},
+			{txURL("?cursor=abcd"), `{"code":400, "message":"invalid cursor: invalid cursor length (2)"}`},
+			{txURL("?limit=0"), `{"code":400, "message":"invalid limit: must be a positive number"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr, err := executeRequest(req, backend)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
 func expectedExpandedResponse(account *flow.Account) string {
 	return fmt.Sprintf(`{
 			  "address":"%s",
@@ -7,6 +7,7 @@ import (
 	"github.com/onflow/flow-go/engine/access/rest/request"
 
 	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/model/flow"
 )
 
 const blockQueryParam = "block_ids"
@@ -54,3 +55,24 @@ func GetEvents(r *request.Request, backend access.API, _ models.LinkGenerator) (
 	blocksEvents.Build(events)
 	return blocksEvents, nil
 }
+
+// SearchEvents of the given types, in the provided block range, from the event index of the node.
+func SearchEvents(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.SearchEventsRequest()
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+
+	filter := flow.EventFilter{
+		Types:  req.Types,
+		Fields: req.Fields,
+	}
+	events, next, err := backend.GetEventsByFilter(r.Context(), filter, req.StartHeight, req.EndHeight, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var page models.EventsPage
+	page.Build(events, next)
+	return page, nil
+}
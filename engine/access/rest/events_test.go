@@ -216,3 +216,90 @@ func testBlockEventResponse(events []flow.BlockEvents) string {
 
 	return fmt.Sprintf(`[%s]`, strings.Join(res, ","))
 }
+
+func TestSearchEvents(t *testing.T) {
+	backend := &mock.API{}
+
+	header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(10))
+	events := []flow.BlockEvents{unittest.BlockEventsFixture(header, 2)}
+	cursor := flow.EventPosition{Height: 10, EventIndex: 1}.Cursor()
+
+	filter := flow.EventFilter{
+		Types:  []flow.EventType{"A.179b6b1cb6755e31.Foo.Bar", "A.179b6b1cb6755e31.*"},
+		Fields: map[string]string{"amount": "10.0"},
+	}
+	backend.Mock.
+		On("GetEventsByFilter", mocks.Anything, filter, uint64(5), uint64(20), "", uint(2)).
+		Return(events, cursor, nil)
+
+	testVectors := []testVector{
+		{
+			description:      "Search events by types and fields",
+			request:          searchEventsReq(t, "A.179b6b1cb6755e31.Foo.Bar,A.179b6b1cb6755e31.*", "5", "20", "amount:10.0", "", "2"),
+			expectedStatus:   http.StatusOK,
+			expectedResponse: fmt.Sprintf(`{"block_events":%s,"next_cursor":"%s"}`, testBlockEventResponse(events), cursor),
+		},
+		{
+			description:      "Search invalid - missing event type",
+			request:          searchEventsReq(t, "", "5", "20", "", "", ""),
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: `{"code":400,"message":"at least one event type must be provided"}`,
+		},
+		{
+			description:      "Search invalid - invalid wildcard",
+			request:          searchEventsReq(t, "A.179b6b1cb6755e31.Foo*", "5", "20", "", "", ""),
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: `{"code":400,"message":"invalid event type format: A.179b6b1cb6755e31.Foo*"}`,
+		},
+		{
+			description:      "Search invalid - missing start height",
+			request:          searchEventsReq(t, "flow.*", "", "20", "", "", ""),
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: `{"code":400,"message":"start height must be provided as a number"}`,
+		},
+		{
+			description:      "Search invalid - invalid cursor",
+			request:          searchEventsReq(t, "flow.*", "5", "20", "", "foo", ""),
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: `{"code":400,"message":"invalid cursor: could not decode cursor: encoding/hex: invalid byte: U+006F 'o'"}`,
+		},
+		{
+			description:      "Search invalid - limit too big",
+			request:          searchEventsReq(t, "flow.*", "5", "20", "", "", "5000"),
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: `{"code":400,"message":"limit 5000 exceeds maximum allowed of 1000"}`,
+		},
+	}
+
+	for _, test := range testVectors {
+		t.Run(test.description, func(t *testing.T) {
+			assertResponse(t, test.request, test.expectedStatus, test.expectedResponse, backend)
+		})
+	}
+}
+
+func searchEventsReq(t *testing.T, types string, start string, end string, fields string, cursor string, limit string) *http.Request {
+	u, _ := url.Parse("/v1/events/search")
+	q := u.Query()
+
+	params := map[string]string{
+		eventTypeQuery:        types,
+		startHeightQueryParam: start,
+		endHeightQueryParam:   end,
+		"fields":              fields,
+		"cursor":              cursor,
+		"limit":               limit,
+	}
+	for name, value := range params {
+		if value != "" {
+			q.Add(name, value)
+		}
+	}
+
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	require.NoError(t, err)
+
+	return req
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func (a *AccountTransaction) Build(tx flow.AccountTransaction, link LinkGenerator) error {
+	a.TransactionId = tx.TransactionID.String()
+	a.TransactionIndex = util.FromUint64(uint64(tx.TransactionIndex))
+	a.BlockId = tx.BlockID.String()
+	a.BlockHeight = util.FromUint64(tx.Height)
+	a.Roles = tx.Roles.Names()
+
+	var self Links
+	err := self.Build(link.TransactionLink(tx.TransactionID))
+	if err != nil {
+		return err
+	}
+	a.Links = &self
+
+	return nil
+}
+
+func (p *AccountTransactionsPage) Build(txs []flow.AccountTransaction, next string, link LinkGenerator) error {
+	transactions := make([]AccountTransaction, len(txs))
+	for i, tx := range txs {
+		err := transactions[i].Build(tx, link)
+		if err != nil {
+			return err
+		}
+	}
+	p.Transactions = transactions
+	p.NextCursor = next
+	return nil
+}
@@ -53,3 +53,10 @@ func (b *BlocksEvents) Build(blocksEvents []flow.BlockEvents) {
 
 	*b = evs
 }
+
+func (p *EventsPage) Build(blocksEvents []flow.BlockEvents, next string) {
+	var events BlocksEvents
+	events.Build(blocksEvents)
+	p.BlockEvents = events
+	p.NextCursor = next
+}
@@ -25,6 +25,7 @@ type LinkGenerator interface {
 	ExecutionResultLink(id flow.Identifier) (string, error)
 	AccountLink(address string) (string, error)
 	CollectionLink(id flow.Identifier) (string, error)
+	SlashingEvidenceLink(id flow.Identifier) (string, error)
 }
 
 type LinkFunc func(id flow.Identifier) (string, error)
@@ -61,6 +62,10 @@ func (generator *LinkGeneratorImpl) CollectionLink(id flow.Identifier) (string,
 	return generator.linkForID("getCollectionByID", id)
 }
 
+func (generator *LinkGeneratorImpl) SlashingEvidenceLink(id flow.Identifier) (string, error) {
+	return generator.linkForID("getSlashingEvidenceByID", id)
+}
+
 func (generator *LinkGeneratorImpl) AccountLink(address string) (string, error) {
 	return generator.link("getAccount", "address", address)
 }
@@ -0,0 +1,16 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type QuorumCertificate struct {
+	View      string   `json:"view"`
+	BlockId   string   `json:"block_id"`
+	SignerIds []string `json:"signer_ids"`
+	Signature string   `json:"signature"`
+}
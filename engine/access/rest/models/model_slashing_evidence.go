@@ -0,0 +1,21 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type SlashingEvidence struct {
+	Id         string             `json:"id"`
+	Type_      string             `json:"type"`
+	ChainId    string             `json:"chain_id"`
+	View       string             `json:"view"`
+	OffenderId string             `json:"offender_id"`
+	Proposals  []SlashingProposal `json:"proposals,omitempty"`
+	Votes      []SlashingVote     `json:"votes,omitempty"`
+	Timeouts   []SlashingTimeout  `json:"timeouts,omitempty"`
+	Links      *Links             `json:"_links,omitempty"`
+}
@@ -0,0 +1,28 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+import (
+	"time"
+)
+
+type SlashingProposal struct {
+	Id                   string              `json:"id"`
+	ChainId              string              `json:"chain_id"`
+	ParentId             string              `json:"parent_id"`
+	Height               string              `json:"height"`
+	PayloadHash          string              `json:"payload_hash"`
+	Timestamp            time.Time           `json:"timestamp"`
+	View                 string              `json:"view"`
+	ParentVoterIds       []string            `json:"parent_voter_ids"`
+	ParentVoterSignature string              `json:"parent_voter_signature"`
+	ProposerId           string              `json:"proposer_id"`
+	ProposerSignature    string              `json:"proposer_signature"`
+	LastViewTc           *TimeoutCertificate `json:"last_view_tc,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type SlashingTimeout struct {
+	View       string              `json:"view"`
+	NewestQc   *QuorumCertificate  `json:"newest_qc"`
+	LastViewTc *TimeoutCertificate `json:"last_view_tc,omitempty"`
+	SignerId   string              `json:"signer_id"`
+	Signature  string              `json:"signature"`
+}
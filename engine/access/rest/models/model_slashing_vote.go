@@ -0,0 +1,16 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type SlashingVote struct {
+	View      string `json:"view"`
+	BlockId   string `json:"block_id"`
+	SignerId  string `json:"signer_id"`
+	Signature string `json:"signature"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type TimeoutCertificate struct {
+	View          string             `json:"view"`
+	NewestQcViews []string           `json:"newest_qc_views"`
+	NewestQc      *QuorumCertificate `json:"newest_qc"`
+	SignerIds     []string           `json:"signer_ids"`
+	Signature     string             `json:"signature"`
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func (s *SlashingEvidence) Build(evidence *flow.SlashingEvidence, link LinkGenerator) error {
+	self, err := SelfLink(evidence.ID(), link.SlashingEvidenceLink)
+	if err != nil {
+		return err
+	}
+
+	s.Id = evidence.ID().String()
+	s.Type_ = string(evidence.Type)
+	s.ChainId = evidence.ChainID.String()
+	s.View = util.FromUint64(evidence.View)
+	s.OffenderId = evidence.OffenderID.String()
+	s.Links = self
+
+	s.Proposals = make([]SlashingProposal, len(evidence.Proposals))
+	for i, header := range evidence.Proposals {
+		s.Proposals[i].Build(header)
+	}
+	s.Votes = make([]SlashingVote, len(evidence.Votes))
+	for i, vote := range evidence.Votes {
+		s.Votes[i].Build(vote)
+	}
+	s.Timeouts = make([]SlashingTimeout, len(evidence.Timeouts))
+	for i, timeout := range evidence.Timeouts {
+		s.Timeouts[i].Build(timeout)
+	}
+
+	return nil
+}
+
+func (p *SlashingProposal) Build(header *flow.Header) {
+	p.Id = header.ID().String()
+	p.ChainId = header.ChainID.String()
+	p.ParentId = header.ParentID.String()
+	p.Height = util.FromUint64(header.Height)
+	p.PayloadHash = header.PayloadHash.String()
+	p.Timestamp = header.Timestamp
+	p.View = util.FromUint64(header.View)
+	p.ParentVoterIds = identifiers(header.ParentVoterIDs)
+	p.ParentVoterSignature = util.ToBase64(header.ParentVoterSigData)
+	p.ProposerId = header.ProposerID.String()
+	p.ProposerSignature = util.ToBase64(header.ProposerSigData)
+	if header.LastViewTC != nil {
+		var tc TimeoutCertificate
+		tc.Build(header.LastViewTC)
+		p.LastViewTc = &tc
+	}
+}
+
+func (v *SlashingVote) Build(vote *flow.SlashingVote) {
+	v.View = util.FromUint64(vote.View)
+	v.BlockId = vote.BlockID.String()
+	v.SignerId = vote.SignerID.String()
+	v.Signature = util.ToBase64(vote.SigData)
+}
+
+func (t *SlashingTimeout) Build(timeout *flow.SlashingTimeout) {
+	t.View = util.FromUint64(timeout.View)
+	t.SignerId = timeout.SignerID.String()
+	t.Signature = util.ToBase64(timeout.SigData)
+	if timeout.NewestQC != nil {
+		var qc QuorumCertificate
+		qc.Build(timeout.NewestQC)
+		t.NewestQc = &qc
+	}
+	if timeout.LastViewTC != nil {
+		var tc TimeoutCertificate
+		tc.Build(timeout.LastViewTC)
+		t.LastViewTc = &tc
+	}
+}
+
+func (q *QuorumCertificate) Build(qc *flow.QuorumCertificate) {
+	q.View = util.FromUint64(qc.View)
+	q.BlockId = qc.BlockID.String()
+	q.SignerIds = identifiers(qc.SignerIDs)
+	q.Signature = util.ToBase64(qc.SigData)
+}
+
+func (t *TimeoutCertificate) Build(tc *flow.TimeoutCertificate) {
+	t.View = util.FromUint64(tc.View)
+	t.NewestQcViews = make([]string, len(tc.NewestQCViews))
+	for i, view := range tc.NewestQCViews {
+		t.NewestQcViews[i] = util.FromUint64(view)
+	}
+	if tc.NewestQC != nil {
+		var qc QuorumCertificate
+		qc.Build(tc.NewestQC)
+		t.NewestQc = &qc
+	}
+	t.SignerIds = identifiers(tc.SignerIDs)
+	t.Signature = util.ToBase64(tc.SigData)
+}
+
+func identifiers(ids []flow.Identifier) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// GetProtocolStateSnapshotByHeight gets the protocol state snapshot at the finalized block with
+// the given height, or the latest valid snapshot if no height or the final height is requested.
+func GetProtocolStateSnapshotByHeight(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetProtocolStateSnapshotRequest()
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+
+	var snapshot []byte
+	switch req.Height {
+	case request.FinalHeight:
+		snapshot, err = backend.GetLatestProtocolStateSnapshot(r.Context())
+	case request.SealedHeight:
+		var header *flow.Header
+		header, err = backend.GetLatestBlockHeader(r.Context(), true)
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err = backend.GetProtocolStateSnapshotByHeight(r.Context(), header.Height)
+	default:
+		snapshot, err = backend.GetProtocolStateSnapshotByHeight(r.Context(), req.Height)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(snapshot), nil
+}
+
+// GetProtocolStateSnapshotByID gets the protocol state snapshot at the finalized block with the given ID.
+func GetProtocolStateSnapshotByID(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetProtocolStateSnapshotByIDRequest()
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+
+	snapshot, err := backend.GetProtocolStateSnapshotByBlockID(r.Context(), req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(snapshot), nil
+}
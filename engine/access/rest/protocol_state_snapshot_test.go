@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mocks "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func getProtocolStateSnapshotReq(height string) *http.Request {
+	u := "/v1/protocol_state_snapshots"
+	if height != "" {
+		u = fmt.Sprintf("%s?%s=%s", u, heightQueryParam, height)
+	}
+	req, _ := http.NewRequest("GET", u, nil)
+	return req
+}
+
+func getProtocolStateSnapshotByIDReq(id string) *http.Request {
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/v1/protocol_state_snapshots/%s", id), nil)
+	return req
+}
+
+func TestGetProtocolStateSnapshot(t *testing.T) {
+	snapshot := []byte(`{"Head":{"Height":100}}`)
+
+	t.Run("get latest", func(t *testing.T) {
+		backend := &mock.API{}
+		backend.Mock.
+			On("GetLatestProtocolStateSnapshot", mocks.Anything).
+			Return(snapshot, nil).
+			Twice()
+
+		assertOKResponse(t, getProtocolStateSnapshotReq(""), string(snapshot), backend)
+		assertOKResponse(t, getProtocolStateSnapshotReq(finalHeightQueryParam), string(snapshot), backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get sealed", func(t *testing.T) {
+		backend := &mock.API{}
+		header := unittest.BlockHeaderFixture()
+		backend.Mock.
+			On("GetLatestBlockHeader", mocks.Anything, true).
+			Return(&header, nil).
+			Once()
+		backend.Mock.
+			On("GetProtocolStateSnapshotByHeight", mocks.Anything, header.Height).
+			Return(snapshot, nil).
+			Once()
+
+		assertOKResponse(t, getProtocolStateSnapshotReq(sealedHeightQueryParam), string(snapshot), backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get by height", func(t *testing.T) {
+		backend := &mock.API{}
+		backend.Mock.
+			On("GetProtocolStateSnapshotByHeight", mocks.Anything, uint64(100)).
+			Return(snapshot, nil).
+			Once()
+
+		assertOKResponse(t, getProtocolStateSnapshotReq("100"), string(snapshot), backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get by height out of range", func(t *testing.T) {
+		backend := &mock.API{}
+		backend.Mock.
+			On("GetProtocolStateSnapshotByHeight", mocks.Anything, uint64(1)).
+			Return(nil, status.Error(codes.InvalidArgument, "height 1 is below the spork root block height 10")).
+			Once()
+
+		assertResponse(t, getProtocolStateSnapshotReq("1"), http.StatusBadRequest, `{"code":400,"message":"Invalid Flow argument: height 1 is below the spork root block height 10"}`, backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get by invalid height", func(t *testing.T) {
+		backend := &mock.API{}
+		assertResponse(t, getProtocolStateSnapshotReq("foo"), http.StatusBadRequest, `{"code":400,"message":"invalid height format"}`, backend)
+	})
+
+	t.Run("get by ID", func(t *testing.T) {
+		backend := &mock.API{}
+		id := unittest.IdentifierFixture()
+		backend.Mock.
+			On("GetProtocolStateSnapshotByBlockID", mocks.Anything, id).
+			Return(snapshot, nil).
+			Once()
+
+		assertOKResponse(t, getProtocolStateSnapshotByIDReq(id.String()), string(snapshot), backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get by ID not found", func(t *testing.T) {
+		backend := &mock.API{}
+		id := unittest.IdentifierFixture()
+		backend.Mock.
+			On("GetProtocolStateSnapshotByBlockID", mocks.Anything, id).
+			Return(nil, status.Error(codes.NotFound, "block not found")).
+			Once()
+
+		assertResponse(t, getProtocolStateSnapshotByIDReq(id.String()), http.StatusNotFound, `{"code":404,"message":"Flow resource not found: block not found"}`, backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+}
@@ -0,0 +1,85 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// MaxAccountTransactionsPageSize is the maximum number of account transactions which can be requested per page.
+const MaxAccountTransactionsPageSize = 1000
+
+type GetAccountTransactions struct {
+	Address     flow.Address
+	StartHeight uint64
+	EndHeight   uint64
+	Cursor      string
+	Limit       uint
+}
+
+func (g *GetAccountTransactions) Build(r *Request) error {
+	return g.Parse(
+		r.GetVar(addressVar),
+		r.GetQueryParam(startHeightQuery),
+		r.GetQueryParam(endHeightQuery),
+		r.GetQueryParam(cursorQuery),
+		r.GetQueryParam(limitQuery),
+	)
+}
+
+func (g *GetAccountTransactions) Parse(
+	rawAddress string,
+	rawStart string,
+	rawEnd string,
+	rawCursor string,
+	rawLimit string,
+) error {
+	var address Address
+	err := address.Parse(rawAddress)
+	if err != nil {
+		return err
+	}
+	g.Address = address.Flow()
+
+	var height Height
+	err = height.Parse(rawStart)
+	if err != nil {
+		return fmt.Errorf("invalid start height: %w", err)
+	}
+	g.StartHeight = height.Flow()
+	// the start height defaults to the first indexed height
+	if g.StartHeight == EmptyHeight {
+		g.StartHeight = 0
+	}
+	if g.StartHeight == SealedHeight || g.StartHeight == FinalHeight {
+		return fmt.Errorf("start height must be provided as a number")
+	}
+
+	err = height.Parse(rawEnd)
+	if err != nil {
+		return fmt.Errorf("invalid end height: %w", err)
+	}
+	g.EndHeight = height.Flow()
+	// the end height defaults to the last indexed height, which is never beyond the sealed height
+	if g.EndHeight == EmptyHeight || g.EndHeight == FinalHeight {
+		g.EndHeight = SealedHeight
+	}
+	if g.StartHeight > g.EndHeight {
+		return fmt.Errorf("start height must be less than or equal to end height")
+	}
+
+	if rawCursor != "" {
+		_, err := flow.ParseTransactionCursor(rawCursor)
+		if err != nil {
+			return fmt.Errorf("invalid cursor: %w", err)
+		}
+	}
+	g.Cursor = rawCursor
+
+	g.Limit, err = parseLimit(rawLimit, MaxAccountTransactionsPageSize)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package request
+
+type GetProtocolStateSnapshot struct {
+	Height uint64
+}
+
+func (g *GetProtocolStateSnapshot) Build(r *Request) error {
+	return g.Parse(
+		r.GetQueryParam(heightQuery),
+	)
+}
+
+func (g *GetProtocolStateSnapshot) Parse(rawHeight string) error {
+	var height Height
+	err := height.Parse(rawHeight)
+	if err != nil {
+		return err
+	}
+
+	g.Height = height.Flow()
+
+	// default to the latest valid snapshot
+	if g.Height == EmptyHeight {
+		g.Height = FinalHeight
+	}
+
+	return nil
+}
+
+type GetProtocolStateSnapshotByID struct {
+	GetByIDRequest
+}
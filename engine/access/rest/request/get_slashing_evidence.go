@@ -0,0 +1,32 @@
+package request
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const offenderIDQuery = "offender_id"
+
+type GetSlashingEvidence struct {
+	OffenderID flow.Identifier // flow.ZeroID if evidence against all offenders is requested
+}
+
+func (g *GetSlashingEvidence) Build(r *Request) error {
+	return g.Parse(
+		r.GetQueryParam(offenderIDQuery),
+	)
+}
+
+func (g *GetSlashingEvidence) Parse(rawOffenderID string) error {
+	var offenderID ID
+	err := offenderID.Parse(rawOffenderID)
+	if err != nil {
+		return err
+	}
+	g.OffenderID = offenderID.Flow()
+
+	return nil
+}
+
+type GetSlashingEvidenceByID struct {
+	GetByIDRequest
+}
@@ -54,6 +54,12 @@ func (rd *Request) GetAccountRequest() (GetAccount, error) {
 	return req, err
 }
 
+func (rd *Request) GetAccountTransactionsRequest() (GetAccountTransactions, error) {
+	var req GetAccountTransactions
+	err := req.Build(rd)
+	return req, err
+}
+
 func (rd *Request) GetExecutionResultByBlockIDsRequest() (GetExecutionResultByBlockIDs, error) {
 	var req GetExecutionResultByBlockIDs
 	err := req.Build(rd)
@@ -66,6 +72,30 @@ func (rd *Request) GetExecutionResultRequest() (GetExecutionResult, error) {
 	return req, err
 }
 
+func (rd *Request) GetProtocolStateSnapshotRequest() (GetProtocolStateSnapshot, error) {
+	var req GetProtocolStateSnapshot
+	err := req.Build(rd)
+	return req, err
+}
+
+func (rd *Request) GetProtocolStateSnapshotByIDRequest() (GetProtocolStateSnapshotByID, error) {
+	var req GetProtocolStateSnapshotByID
+	err := req.Build(rd)
+	return req, err
+}
+
+func (rd *Request) GetSlashingEvidenceRequest() (GetSlashingEvidence, error) {
+	var req GetSlashingEvidence
+	err := req.Build(rd)
+	return req, err
+}
+
+func (rd *Request) GetSlashingEvidenceByIDRequest() (GetSlashingEvidenceByID, error) {
+	var req GetSlashingEvidenceByID
+	err := req.Build(rd)
+	return req, err
+}
+
 func (rd *Request) GetTransactionRequest() (GetTransaction, error) {
 	var req GetTransaction
 	err := req.Build(rd)
@@ -84,6 +114,12 @@ func (rd *Request) GetEventsRequest() (GetEvents, error) {
 	return req, err
 }
 
+func (rd *Request) SearchEventsRequest() (SearchEvents, error) {
+	var req SearchEvents
+	err := req.Build(rd)
+	return req, err
+}
+
 func (rd *Request) CreateTransactionRequest() (CreateTransaction, error) {
 	var req CreateTransaction
 	err := req.Build(rd)
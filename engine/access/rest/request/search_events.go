@@ -0,0 +1,123 @@
+package request
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const eventFieldsQuery = "fields"
+const cursorQuery = "cursor"
+const limitQuery = "limit"
+
+// MaxEventsPageSize is the maximum number of events which can be requested per page.
+const MaxEventsPageSize = 1000
+
+// event types which can be searched: exact contract and core events, or a wildcard over all events
+// of an account, a contract, or the core events
+var searchableEventType = regexp.MustCompile(`^([A-Z]\.[a-f0-9]{16}\.(\w+\.(\w+|\*)|\*)|flow\.(\w+|\*))$`)
+
+type SearchEvents struct {
+	StartHeight uint64
+	EndHeight   uint64
+	Types       []flow.EventType
+	Fields      map[string]string
+	Cursor      string
+	Limit       uint
+}
+
+func (s *SearchEvents) Build(r *Request) error {
+	return s.Parse(
+		r.GetQueryParams(eventTypeQuery),
+		r.GetQueryParam(startHeightQuery),
+		r.GetQueryParam(endHeightQuery),
+		r.GetQueryParams(eventFieldsQuery),
+		r.GetQueryParam(cursorQuery),
+		r.GetQueryParam(limitQuery),
+	)
+}
+
+func (s *SearchEvents) Parse(
+	rawTypes []string,
+	rawStart string,
+	rawEnd string,
+	rawFields []string,
+	rawCursor string,
+	rawLimit string,
+) error {
+	if len(rawTypes) == 0 {
+		return fmt.Errorf("at least one event type must be provided")
+	}
+	s.Types = make([]flow.EventType, 0, len(rawTypes))
+	for _, rawType := range rawTypes {
+		if !searchableEventType.MatchString(rawType) {
+			return fmt.Errorf("invalid event type format: %s", rawType)
+		}
+		s.Types = append(s.Types, flow.EventType(rawType))
+	}
+
+	var height Height
+	err := height.Parse(rawStart)
+	if err != nil {
+		return fmt.Errorf("invalid start height: %w", err)
+	}
+	s.StartHeight = height.Flow()
+	if s.StartHeight == EmptyHeight || s.StartHeight == SealedHeight || s.StartHeight == FinalHeight {
+		return fmt.Errorf("start height must be provided as a number")
+	}
+
+	err = height.Parse(rawEnd)
+	if err != nil {
+		return fmt.Errorf("invalid end height: %w", err)
+	}
+	s.EndHeight = height.Flow()
+	// the end height defaults to the last indexed height, which is never beyond the sealed height
+	if s.EndHeight == EmptyHeight || s.EndHeight == FinalHeight {
+		s.EndHeight = SealedHeight
+	}
+	if s.StartHeight > s.EndHeight {
+		return fmt.Errorf("start height must be less than or equal to end height")
+	}
+
+	s.Fields = make(map[string]string, len(rawFields))
+	for _, rawField := range rawFields {
+		parts := strings.SplitN(rawField, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid field filter format, expected name:value: %s", rawField)
+		}
+		s.Fields[parts[0]] = parts[1]
+	}
+
+	if rawCursor != "" {
+		_, err := flow.ParseEventCursor(rawCursor)
+		if err != nil {
+			return fmt.Errorf("invalid cursor: %w", err)
+		}
+	}
+	s.Cursor = rawCursor
+
+	s.Limit, err = parseLimit(rawLimit, MaxEventsPageSize)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseLimit parses the page size of a paginated request, defaulting to the maximum page size.
+func parseLimit(rawLimit string, max uint) (uint, error) {
+	if rawLimit == "" {
+		return max, nil
+	}
+	limit, err := strconv.ParseUint(rawLimit, 10, 32)
+	if err != nil || limit == 0 {
+		return 0, fmt.Errorf("invalid limit: must be a positive number")
+	}
+	if uint(limit) > max {
+		return 0, fmt.Errorf("limit %d exceeds maximum allowed of %d", limit, max)
+	}
+	return uint(limit), nil
+}
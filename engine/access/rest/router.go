@@ -96,9 +96,39 @@ var Routes = []route{{
 	Pattern: "/accounts/{address}",
 	Name:    "getAccount",
 	Handler: GetAccount,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/transactions",
+	Name:    "getAccountTransactions",
+	Handler: GetAccountTransactions,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/events",
 	Name:    "getEvents",
 	Handler: GetEvents,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/events/search",
+	Name:    "searchEvents",
+	Handler: SearchEvents,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/protocol_state_snapshots",
+	Name:    "getProtocolStateSnapshotByHeight",
+	Handler: GetProtocolStateSnapshotByHeight,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/protocol_state_snapshots/{id}",
+	Name:    "getProtocolStateSnapshotByID",
+	Handler: GetProtocolStateSnapshotByID,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/slashing_evidence",
+	Name:    "getSlashingEvidence",
+	Handler: GetSlashingEvidence,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/slashing_evidence/{id}",
+	Name:    "getSlashingEvidenceByID",
+	Handler: GetSlashingEvidenceByID,
 }}
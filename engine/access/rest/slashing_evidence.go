@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+)
+
+// GetSlashingEvidence gets all slashing evidence against the offender given by the optional
+// offender ID query parameter, or all slashing evidence if no offender is specified.
+func GetSlashingEvidence(r *request.Request, backend access.API, link models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetSlashingEvidenceRequest()
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+
+	evidence, err := backend.GetSlashingEvidence(r.Context(), req.OffenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]models.SlashingEvidence, len(evidence))
+	for i, e := range evidence {
+		err = response[i].Build(e, link)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// GetSlashingEvidenceByID gets slashing evidence by its ID.
+func GetSlashingEvidenceByID(r *request.Request, backend access.API, link models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetSlashingEvidenceByIDRequest()
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+
+	evidence, err := backend.GetSlashingEvidenceByID(r.Context(), req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.SlashingEvidence
+	err = response.Build(evidence, link)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	mocks "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func getSlashingEvidenceReq(id string, offenderID string) *http.Request {
+	endpoint := "/v1/slashing_evidence"
+
+	u := endpoint
+	if id != "" {
+		u = fmt.Sprintf("%s/%s", endpoint, id)
+	} else if offenderID != "" {
+		p, _ := url.Parse(endpoint)
+		q := p.Query()
+		q.Add("offender_id", offenderID)
+		p.RawQuery = q.Encode()
+		u = p.String()
+	}
+
+	req, _ := http.NewRequest("GET", u, nil)
+	return req
+}
+
+func TestGetSlashingEvidenceByID(t *testing.T) {
+
+	t.Run("get by ID", func(t *testing.T) {
+		backend := &mock.API{}
+		evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+		backend.Mock.
+			On("GetSlashingEvidenceByID", mocks.Anything, evidence.ID()).
+			Return(evidence, nil).
+			Once()
+
+		req := getSlashingEvidenceReq(evidence.ID().String(), "")
+		assertOKResponse(t, req, slashingEvidenceExpectedStr(evidence), backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get by ID not found", func(t *testing.T) {
+		backend := &mock.API{}
+		id := unittest.IdentifierFixture()
+		backend.Mock.
+			On("GetSlashingEvidenceByID", mocks.Anything, id).
+			Return(nil, status.Error(codes.NotFound, "evidence not found")).
+			Once()
+
+		req := getSlashingEvidenceReq(id.String(), "")
+		assertResponse(t, req, http.StatusNotFound, `{"code":404,"message":"Flow resource not found: evidence not found"}`, backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get by invalid ID", func(t *testing.T) {
+		backend := &mock.API{}
+		req := getSlashingEvidenceReq("invalid", "")
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400,"message":"invalid ID format"}`, backend)
+	})
+}
+
+func TestGetSlashingEvidence(t *testing.T) {
+
+	t.Run("get by offender", func(t *testing.T) {
+		backend := &mock.API{}
+		offenderID := unittest.IdentifierFixture()
+		evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offenderID)
+		backend.Mock.
+			On("GetSlashingEvidence", mocks.Anything, offenderID).
+			Return([]*flow.SlashingEvidence{evidence}, nil).
+			Once()
+
+		req := getSlashingEvidenceReq("", offenderID.String())
+		assertOKResponse(t, req, fmt.Sprintf("[%s]", slashingEvidenceExpectedStr(evidence)), backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get all", func(t *testing.T) {
+		backend := &mock.API{}
+		evidence := []*flow.SlashingEvidence{
+			unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture()),
+			unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture()),
+		}
+		backend.Mock.
+			On("GetSlashingEvidence", mocks.Anything, flow.ZeroID).
+			Return(evidence, nil).
+			Once()
+
+		req := getSlashingEvidenceReq("", "")
+		expected := fmt.Sprintf("[%s,%s]", slashingEvidenceExpectedStr(evidence[0]), slashingEvidenceExpectedStr(evidence[1]))
+		assertOKResponse(t, req, expected, backend)
+		mocks.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get by invalid offender", func(t *testing.T) {
+		backend := &mock.API{}
+		req := getSlashingEvidenceReq("", "invalid")
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400,"message":"invalid ID format"}`, backend)
+	})
+}
+
+// slashingEvidenceExpectedStr returns the expected response for double vote evidence.
+func slashingEvidenceExpectedStr(evidence *flow.SlashingEvidence) string {
+	votes := make([]string, len(evidence.Votes))
+	for i, vote := range evidence.Votes {
+		votes[i] = fmt.Sprintf(`{
+				"view": "%d",
+				"block_id": "%s",
+				"signer_id": "%s",
+				"signature": "%s"
+			}`, vote.View, vote.BlockID, vote.SignerID, util.ToBase64(vote.SigData))
+	}
+	return fmt.Sprintf(`{
+			"id": "%s",
+			"type": "%s",
+			"chain_id": "%s",
+			"view": "%d",
+			"offender_id": "%s",
+			"votes": [%s],
+			"_links": {
+				"_self": "/v1/slashing_evidence/%s"
+			}
+		}`, evidence.ID(), evidence.Type, evidence.ChainID, evidence.View, evidence.OffenderID,
+		strings.Join(votes, ","), evidence.ID())
+}
@@ -52,6 +52,7 @@ var SnapshotHistoryLimitErr = fmt.Errorf("reached the snapshot history limit")
 // Block details related calls are handled by backendBlockDetails.
 // Event related calls are handled by backendEvents.
 // Account related calls are handled by backendAccounts.
+// Slashing evidence related calls are handled by backendSlashing.
 //
 // All remaining calls are handled by the base Backend in this file.
 type Backend struct {
@@ -62,6 +63,7 @@ type Backend struct {
 	backendBlockDetails
 	backendAccounts
 	backendExecutionResults
+	backendSlashing
 
 	state                protocol.State
 	chainID              flow.ChainID
@@ -69,6 +71,7 @@ type Backend struct {
 	executionReceipts    storage.ExecutionReceipts
 	connFactory          ConnectionFactory
 	snapshotHistoryLimit int
+	sporks               *SporkDirectory // optional, nil unless previous sporks are configured
 }
 
 func New(
@@ -171,6 +174,26 @@ func New(
 	return b
 }
 
+// WithEventIndex enables serving filtered event queries from the given event index, which is
+// populated up to the processed height of the given progress by the event indexer.
+func (b *Backend) WithEventIndex(index storage.EventIndex, progress storage.ConsumerProgress) {
+	b.backendEvents.eventIndex = index
+	b.backendEvents.eventIndexProgress = progress
+}
+
+// WithAccountTransactionIndex enables serving account transaction history queries from the given index,
+// which is populated up to the processed height of the given progress by the account transaction indexer.
+func (b *Backend) WithAccountTransactionIndex(index storage.AccountTransactions, progress storage.ConsumerProgress) {
+	b.backendAccounts.accountTransactions = index
+	b.backendAccounts.accountTransactionsProgress = progress
+}
+
+// WithSlashingEvidence enables serving slashing evidence queries from the given storage, which is
+// populated with the evidence pushed to the node by consensus and collection nodes.
+func (b *Backend) WithSlashingEvidence(evidence storage.SlashingEvidence) {
+	b.backendSlashing.slashingEvidence = evidence
+}
+
 func identifierList(ids []string) (flow.IdentifierList, error) {
 	idList := make(flow.IdentifierList, len(ids))
 	for i, idStr := range ids {
@@ -214,7 +237,8 @@ func (b *Backend) Ping(ctx context.Context) error {
 	return nil
 }
 
-func (b *Backend) GetCollectionByID(_ context.Context, colID flow.Identifier) (*flow.LightCollection, error) {
+// getCollectionByID retrieves the collection from the local collection storage.
+func (b *Backend) getCollectionByID(_ context.Context, colID flow.Identifier) (*flow.LightCollection, error) {
 	// retrieve the collection from the collection storage
 	col, err := b.collections.LightByID(colID)
 	if err != nil {
@@ -247,6 +271,87 @@ func (b *Backend) GetLatestProtocolStateSnapshot(_ context.Context) ([]byte, err
 	return convert.SnapshotToBytes(validSnapshot)
 }
 
+// GetProtocolStateSnapshotByBlockID returns the snapshot of the protocol state at the given
+// finalized block. The snapshot is only returned if its sealing segment does not span an epoch
+// or epoch phase transition, otherwise an InvalidArgument error names the height of the closest
+// valid snapshot below it.
+func (b *Backend) GetProtocolStateSnapshotByBlockID(_ context.Context, blockID flow.Identifier) ([]byte, error) {
+	header, err := b.state.AtBlockID(blockID).Head()
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to get block %v: %v", blockID, err)
+	}
+
+	finalized, err := b.state.AtHeight(header.Height).Head()
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.InvalidArgument, "block %v at height %d is not finalized", blockID, header.Height)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get finalized block at height %d: %v", header.Height, err)
+	}
+	if finalized.ID() != blockID {
+		return nil, status.Errorf(codes.InvalidArgument, "block %v is not finalized, block %v was finalized at height %d", blockID, finalized.ID(), header.Height)
+	}
+
+	return b.getSnapshotAtFinalizedHeight(header.Height)
+}
+
+// GetProtocolStateSnapshotByHeight returns the snapshot of the protocol state at the finalized
+// block with the given height. The height must be between the height of the spork root block
+// and the latest finalized height, and the same guarantees as for GetProtocolStateSnapshotByBlockID apply.
+func (b *Backend) GetProtocolStateSnapshotByHeight(_ context.Context, height uint64) ([]byte, error) {
+	return b.getSnapshotAtFinalizedHeight(height)
+}
+
+// getSnapshotAtFinalizedHeight returns the encoded snapshot at the finalized block with the given
+// height, if the height is queryable and the sealing segment of the snapshot is valid.
+func (b *Backend) getSnapshotAtFinalizedHeight(height uint64) ([]byte, error) {
+	root, err := b.state.Params().Root()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get spork root block: %v", err)
+	}
+	if height < root.Height {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is below the spork root block height %d, query an access node of a previous spork", height, root.Height)
+	}
+
+	final, err := b.state.Final().Head()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get latest finalized block: %v", err)
+	}
+	if height > final.Height {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is above the latest finalized height %d", height, final.Height)
+	}
+
+	validSnapshot, err := b.getValidSnapshot(b.state.AtHeight(height), 0)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get valid snapshot at height %d: %v", height, err)
+	}
+
+	head, err := validSnapshot.Head()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get head of valid snapshot: %v", err)
+	}
+	// the caller asked for a specific block, so we do not silently return a
+	// snapshot at a different block
+	if head.Height != height {
+		return nil, status.Errorf(codes.InvalidArgument, "sealing segment of the snapshot at height %d spans an epoch or epoch phase transition, the closest valid snapshot is at height %d", height, head.Height)
+	}
+
+	segment, err := validSnapshot.SealingSegment()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get sealing segment at height %d: %v", height, err)
+	}
+	err = segment.Validate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid sealing segment at height %d: %v", height, err)
+	}
+
+	data, err := convert.SnapshotToBytes(validSnapshot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert snapshot at height %d: %v", height, err)
+	}
+	return data, nil
+}
+
 // getValidSnapshot will return a valid snapshot that has a sealing segment which
 // 1. does not contain any blocks that span an epoch transition
 // 2. does not contain any blocks that span an epoch phase transition
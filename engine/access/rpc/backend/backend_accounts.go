@@ -16,12 +16,18 @@ import (
 	"github.com/onflow/flow-go/storage"
 )
 
+// DefaultMaxAccountTransactionsPageSize is the default maximum number of transactions returned per page
+// of an account transaction history query.
+const DefaultMaxAccountTransactionsPageSize = 1000
+
 type backendAccounts struct {
-	state             protocol.State
-	headers           storage.Headers
-	executionReceipts storage.ExecutionReceipts
-	connFactory       ConnectionFactory
-	log               zerolog.Logger
+	state                       protocol.State
+	headers                     storage.Headers
+	executionReceipts           storage.ExecutionReceipts
+	connFactory                 ConnectionFactory
+	log                         zerolog.Logger
+	accountTransactions         storage.AccountTransactions // optional, nil unless the node maintains an account transaction index
+	accountTransactionsProgress storage.ConsumerProgress    // the last height indexed into the account transaction index
 }
 
 func (b *backendAccounts) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
@@ -71,6 +77,67 @@ func (b *backendAccounts) GetAccountAtBlockHeight(
 	return account, nil
 }
 
+// GetAccountTransactions retrieves the sealed transactions involving the given account from the local
+// account transaction index, for blocks between the start and end height (inclusive). Results are ordered
+// by their position in the chain and paginated: at most limit transactions are returned, together with a
+// cursor to continue the query from. The returned cursor is empty once all transactions have been returned.
+func (b *backendAccounts) GetAccountTransactions(
+	ctx context.Context,
+	address flow.Address,
+	startHeight, endHeight uint64,
+	cursor string,
+	limit uint,
+) ([]flow.AccountTransaction, string, error) {
+
+	if b.accountTransactions == nil {
+		return nil, "", status.Error(codes.Unimplemented, "account transaction index is not enabled on this node")
+	}
+	if endHeight < startHeight {
+		return nil, "", status.Error(codes.InvalidArgument, "invalid start or end height")
+	}
+	if limit == 0 || limit > DefaultMaxAccountTransactionsPageSize {
+		limit = DefaultMaxAccountTransactionsPageSize
+	}
+
+	// the index can only answer queries up to the last indexed height
+	indexedHeight, err := b.accountTransactionsProgress.ProcessedIndex()
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to get last indexed height: %v", err)
+	}
+	if indexedHeight < startHeight {
+		return nil, "", status.Errorf(codes.OutOfRange,
+			"start height %d is greater than the last indexed block height %d", startHeight, indexedHeight)
+	}
+	if indexedHeight < endHeight {
+		endHeight = indexedHeight
+	}
+
+	from := flow.TransactionPosition{Height: startHeight}
+	if cursor != "" {
+		position, err := flow.ParseTransactionCursor(cursor)
+		if err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		if !position.Less(from) {
+			from = position.Next()
+		}
+	}
+
+	// request one more transaction than the limit, to know whether there is a next page
+	txs, err := b.accountTransactions.ByAddress(address, from, endHeight, limit+1)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to query account transaction index: %v", err)
+	}
+
+	next := ""
+	if uint(len(txs)) > limit {
+		txs = txs[:limit]
+		next = txs[limit-1].Position().Cursor()
+	}
+
+	return txs, next, nil
+}
+
 func (b *backendAccounts) getAccountAtBlockID(
 	ctx context.Context,
 	address flow.Address,
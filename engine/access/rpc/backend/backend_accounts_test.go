@@ -0,0 +1,93 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/model/flow"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestGetAccountTransactions(t *testing.T) {
+	ctx := context.Background()
+	address := unittest.AddressFixture()
+
+	// two transactions per height 1..3
+	var txs []flow.AccountTransaction
+	for height := uint64(1); height <= 3; height++ {
+		for index := uint32(0); index < 2; index++ {
+			txs = append(txs, flow.AccountTransaction{
+				Address:          address,
+				BlockID:          unittest.IdentifierFixture(),
+				Height:           height,
+				TransactionID:    unittest.IdentifierFixture(),
+				TransactionIndex: index,
+				Roles:            flow.TransactionRoleAuthorizer,
+			})
+		}
+	}
+
+	index := new(storagemock.AccountTransactions)
+	index.On("ByAddress", address, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ flow.Address, from flow.TransactionPosition, endHeight uint64, limit uint) []flow.AccountTransaction {
+			var result []flow.AccountTransaction
+			for _, tx := range txs {
+				if uint(len(result)) < limit && !tx.Position().Less(from) && tx.Height <= endHeight {
+					result = append(result, tx)
+				}
+			}
+			return result
+		},
+		nil,
+	)
+
+	progress := new(storagemock.ConsumerProgress)
+	progress.On("ProcessedIndex").Return(uint64(3), nil)
+
+	backend := backendAccounts{
+		accountTransactions:         index,
+		accountTransactionsProgress: progress,
+	}
+
+	t.Run("paginates through all transactions", func(t *testing.T) {
+		var all []flow.AccountTransaction
+		cursor := ""
+		pages := 0
+		for {
+			result, next, err := backend.GetAccountTransactions(ctx, address, 1, 100, cursor, 4)
+			require.NoError(t, err)
+			all = append(all, result...)
+			pages++
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		assert.Equal(t, txs, all)
+		assert.Equal(t, 2, pages)
+	})
+
+	t.Run("exact page size has no next page", func(t *testing.T) {
+		result, next, err := backend.GetAccountTransactions(ctx, address, 2, 3, "", 4)
+		require.NoError(t, err)
+		assert.Equal(t, txs[2:], result)
+		assert.Empty(t, next)
+	})
+
+	t.Run("start height beyond indexed height", func(t *testing.T) {
+		_, _, err := backend.GetAccountTransactions(ctx, address, 4, 10, "", 10)
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+
+	t.Run("index not enabled", func(t *testing.T) {
+		_, _, err := (&backendAccounts{}).GetAccountTransactions(ctx, address, 1, 10, "", 10)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
 	execproto "github.com/onflow/flow/protobuf/go/flow/execution"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
@@ -18,13 +20,18 @@ import (
 	"github.com/onflow/flow-go/storage"
 )
 
+// DefaultMaxEventsPageSize is the default maximum number of events returned per page of an indexed event query.
+const DefaultMaxEventsPageSize = 1000
+
 type backendEvents struct {
-	headers           storage.Headers
-	executionReceipts storage.ExecutionReceipts
-	state             protocol.State
-	connFactory       ConnectionFactory
-	log               zerolog.Logger
-	maxHeightRange    uint
+	headers            storage.Headers
+	executionReceipts  storage.ExecutionReceipts
+	state              protocol.State
+	connFactory        ConnectionFactory
+	log                zerolog.Logger
+	maxHeightRange     uint
+	eventIndex         storage.EventIndex       // optional, nil unless the node maintains an event index
+	eventIndexProgress storage.ConsumerProgress // the last height indexed into the event index
 }
 
 // GetEventsForHeightRange retrieves events for all sealed blocks between the start block height and
@@ -102,6 +109,156 @@ func (b *backendEvents) GetEventsForBlockIDs(
 	return b.getBlockEventsFromExecutionNode(ctx, blockHeaders, eventType)
 }
 
+// GetEventsByFilter retrieves events matching the filter from the local event index, for sealed blocks
+// between the start and end height (inclusive). Results are ordered by their position in the chain and
+// paginated: at most limit events are returned, together with a cursor to continue the query from.
+// The returned cursor is empty once all matching events have been returned.
+func (b *backendEvents) GetEventsByFilter(
+	ctx context.Context,
+	filter flow.EventFilter,
+	startHeight, endHeight uint64,
+	cursor string,
+	limit uint,
+) ([]flow.BlockEvents, string, error) {
+
+	if b.eventIndex == nil {
+		return nil, "", status.Error(codes.Unimplemented, "event index is not enabled on this node")
+	}
+	if len(filter.Types) == 0 {
+		return nil, "", status.Error(codes.InvalidArgument, "at least one event type must be provided")
+	}
+	if endHeight < startHeight {
+		return nil, "", status.Error(codes.InvalidArgument, "invalid start or end height")
+	}
+	if limit == 0 || limit > DefaultMaxEventsPageSize {
+		limit = DefaultMaxEventsPageSize
+	}
+
+	// the index can only answer queries up to the last indexed height
+	indexedHeight, err := b.eventIndexProgress.ProcessedIndex()
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to get last indexed height: %v", err)
+	}
+	if indexedHeight < startHeight {
+		return nil, "", status.Errorf(codes.OutOfRange,
+			"start height %d is greater than the last indexed block height %d", startHeight, indexedHeight)
+	}
+	if indexedHeight < endHeight {
+		endHeight = indexedHeight
+	}
+
+	from := flow.EventPosition{Height: startHeight}
+	if cursor != "" {
+		position, err := flow.ParseEventCursor(cursor)
+		if err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		if !position.Less(from) {
+			from = position.Next()
+		}
+	}
+
+	var matched []flow.Event
+	var heights []uint64
+	var blockIDs []flow.Identifier
+	next := ""
+	for uint(len(matched)) < limit {
+		locators, err := b.eventIndex.ByTypes(filter.Types, from, endHeight, limit)
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "failed to query event index: %v", err)
+		}
+
+		for _, locator := range locators {
+			from = locator.Position().Next()
+
+			event, err := b.eventIndex.ByLocator(locator)
+			if err != nil {
+				return nil, "", status.Errorf(codes.Internal, "failed to get indexed event: %v", err)
+			}
+			ok, err := matchesEventFields(event, filter.Fields)
+			if err != nil {
+				return nil, "", status.Errorf(codes.Internal, "failed to decode event payload: %v", err)
+			}
+			if !ok {
+				continue
+			}
+
+			matched = append(matched, *event)
+			heights = append(heights, locator.Height)
+			blockIDs = append(blockIDs, locator.BlockID)
+			if uint(len(matched)) == limit {
+				next = locator.Position().Cursor()
+				break
+			}
+		}
+
+		// stop once the page is full, or once the index returned fewer locators than
+		// requested, which means there are no further matching events
+		if next != "" || uint(len(locators)) < limit {
+			break
+		}
+	}
+
+	// group the matched events by block, preserving their order
+	var results []flow.BlockEvents
+	for i, event := range matched {
+		if i == 0 || blockIDs[i] != blockIDs[i-1] {
+			header, err := b.headers.ByBlockID(blockIDs[i])
+			if err != nil {
+				return nil, "", status.Errorf(codes.Internal, "failed to get block header: %v", err)
+			}
+			results = append(results, flow.BlockEvents{
+				BlockID:        blockIDs[i],
+				BlockHeight:    heights[i],
+				BlockTimestamp: header.Timestamp,
+			})
+		}
+		last := &results[len(results)-1]
+		last.Events = append(last.Events, event)
+	}
+
+	return results, next, nil
+}
+
+// matchesEventFields returns true if the decoded JSON-CDC payload of the event contains all of the given
+// fields with the given values. Values are compared by their string representation, with strings compared
+// unquoted.
+func matchesEventFields(event *flow.Event, fields map[string]string) (bool, error) {
+	if len(fields) == 0 {
+		return true, nil
+	}
+
+	value, err := jsoncdc.Decode(event.Payload)
+	if err != nil {
+		return false, err
+	}
+	decoded, ok := value.(cadence.Event)
+	if !ok || decoded.EventType == nil {
+		return false, fmt.Errorf("payload of event %s is not an event", event.ID())
+	}
+
+	values := make(map[string]string, len(decoded.Fields))
+	for i, field := range decoded.EventType.Fields {
+		if i >= len(decoded.Fields) {
+			break
+		}
+		fieldValue := decoded.Fields[i]
+		if str, ok := fieldValue.(cadence.String); ok {
+			values[field.Identifier] = string(str)
+			continue
+		}
+		values[field.Identifier] = fieldValue.String()
+	}
+
+	for name, expected := range fields {
+		actual, ok := values[name]
+		if !ok || actual != expected {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (b *backendEvents) getBlockEventsFromExecutionNode(
 	ctx context.Context,
 	blockHeaders []*flow.Header,
@@ -0,0 +1,138 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/model/flow"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// depositEvent creates a TokensDeposited event with a JSON-CDC encoded payload.
+func depositEvent(t *testing.T, eventType flow.EventType, txIndex uint32, to string) flow.Event {
+	value := cadence.NewEvent([]cadence.Value{
+		cadence.String(to),
+		cadence.NewUInt64(10),
+	}).WithType(&cadence.EventType{
+		Location:            common.AddressLocation{Address: common.Address{0x1}, Name: "FlowToken"},
+		QualifiedIdentifier: "FlowToken.TokensDeposited",
+		Fields: []cadence.Field{
+			{Identifier: "to", Type: cadence.StringType{}},
+			{Identifier: "amount", Type: cadence.UInt64Type{}},
+		},
+	})
+	payload, err := jsoncdc.Encode(value)
+	require.NoError(t, err)
+
+	event := unittest.EventFixture(eventType, txIndex, 0, unittest.IdentifierFixture(), 0)
+	event.Payload = payload
+	return event
+}
+
+func TestGetEventsByFilter(t *testing.T) {
+	ctx := context.Background()
+	eventType := flow.EventType("A.0000000000000001.FlowToken.TokensDeposited")
+
+	// one block per height 1..3, with two deposit events each
+	headers := new(storagemock.Headers)
+	index := new(storagemock.EventIndex)
+	var locators []flow.EventLocator
+	for height := uint64(1); height <= 3; height++ {
+		header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(height))
+		headers.On("ByBlockID", header.ID()).Return(&header, nil)
+		for i, to := range []string{"alice", "bob"} {
+			event := depositEvent(t, eventType, uint32(i), to)
+			locator := flow.EventLocator{
+				Type:             eventType,
+				BlockID:          header.ID(),
+				Height:           height,
+				TransactionID:    event.TransactionID,
+				TransactionIndex: event.TransactionIndex,
+				EventIndex:       event.EventIndex,
+			}
+			locators = append(locators, locator)
+			index.On("ByLocator", locator).Return(&event, nil)
+		}
+	}
+	index.On("ByTypes", []flow.EventType{eventType}, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ []flow.EventType, from flow.EventPosition, endHeight uint64, limit uint) []flow.EventLocator {
+			var result []flow.EventLocator
+			for _, locator := range locators {
+				if uint(len(result)) < limit && !locator.Position().Less(from) && locator.Height <= endHeight {
+					result = append(result, locator)
+				}
+			}
+			return result
+		},
+		nil,
+	)
+
+	progress := new(storagemock.ConsumerProgress)
+	progress.On("ProcessedIndex").Return(uint64(3), nil)
+
+	backend := backendEvents{
+		headers:            headers,
+		log:                zerolog.Nop(),
+		eventIndex:         index,
+		eventIndexProgress: progress,
+	}
+
+	t.Run("paginates through all events", func(t *testing.T) {
+		filter := flow.EventFilter{Types: []flow.EventType{eventType}}
+		var all []flow.Event
+		cursor := ""
+		pages := 0
+		for {
+			results, next, err := backend.GetEventsByFilter(ctx, filter, 1, 100, cursor, 4)
+			require.NoError(t, err)
+			for _, result := range results {
+				all = append(all, result.Events...)
+			}
+			pages++
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		assert.Len(t, all, 6)
+		assert.Equal(t, 2, pages)
+	})
+
+	t.Run("filters by event fields", func(t *testing.T) {
+		filter := flow.EventFilter{
+			Types:  []flow.EventType{eventType},
+			Fields: map[string]string{"to": "bob", "amount": "10"},
+		}
+		results, next, err := backend.GetEventsByFilter(ctx, filter, 2, 3, "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, next)
+		require.Len(t, results, 2)
+		for _, result := range results {
+			require.Len(t, result.Events, 1)
+			assert.Equal(t, uint32(1), result.Events[0].TransactionIndex)
+		}
+	})
+
+	t.Run("start height beyond indexed height", func(t *testing.T) {
+		filter := flow.EventFilter{Types: []flow.EventType{eventType}}
+		_, _, err := backend.GetEventsByFilter(ctx, filter, 4, 10, "", 10)
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+
+	t.Run("index not enabled", func(t *testing.T) {
+		filter := flow.EventFilter{Types: []flow.EventType{eventType}}
+		_, _, err := (&backendEvents{}).GetEventsByFilter(ctx, filter, 1, 10, "", 10)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
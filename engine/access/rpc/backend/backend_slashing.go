@@ -0,0 +1,52 @@
+package backend
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+type backendSlashing struct {
+	slashingEvidence storage.SlashingEvidence // optional, nil unless the node receives slashing evidence
+}
+
+// GetSlashingEvidenceByID returns the slashing evidence with the given ID.
+func (b *backendSlashing) GetSlashingEvidenceByID(ctx context.Context, id flow.Identifier) (*flow.SlashingEvidence, error) {
+	if b.slashingEvidence == nil {
+		return nil, status.Error(codes.Unimplemented, "slashing evidence is not available on this node")
+	}
+
+	evidence, err := b.slashingEvidence.ByID(id)
+	if err != nil {
+		return nil, convertStorageError(err)
+	}
+
+	return evidence, nil
+}
+
+// GetSlashingEvidence returns all slashing evidence against the given offender, or all slashing
+// evidence known to the node if the offender is flow.ZeroID.
+func (b *backendSlashing) GetSlashingEvidence(ctx context.Context, offenderID flow.Identifier) ([]*flow.SlashingEvidence, error) {
+	if b.slashingEvidence == nil {
+		return nil, status.Error(codes.Unimplemented, "slashing evidence is not available on this node")
+	}
+
+	var (
+		evidence []*flow.SlashingEvidence
+		err      error
+	)
+	if offenderID == flow.ZeroID {
+		evidence, err = b.slashingEvidence.All()
+	} else {
+		evidence, err = b.slashingEvidence.ByOffender(offenderID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve slashing evidence: %v", err)
+	}
+
+	return evidence, nil
+}
@@ -0,0 +1,72 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestGetSlashingEvidence(t *testing.T) {
+	ctx := context.Background()
+	offenderID := unittest.IdentifierFixture()
+	vote := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offenderID)
+	timeout := unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+	unknownID := unittest.IdentifierFixture()
+
+	evidence := new(storagemock.SlashingEvidence)
+	evidence.On("ByID", vote.ID()).Return(vote, nil)
+	evidence.On("ByID", unknownID).Return(nil, storage.ErrNotFound)
+	evidence.On("ByOffender", offenderID).Return([]*flow.SlashingEvidence{vote}, nil)
+	evidence.On("All").Return([]*flow.SlashingEvidence{vote, timeout}, nil)
+
+	backend := backendSlashing{
+		slashingEvidence: evidence,
+	}
+
+	t.Run("by ID", func(t *testing.T) {
+		result, err := backend.GetSlashingEvidenceByID(ctx, vote.ID())
+		require.NoError(t, err)
+		assert.Equal(t, vote, result)
+	})
+
+	t.Run("by unknown ID", func(t *testing.T) {
+		_, err := backend.GetSlashingEvidenceByID(ctx, unknownID)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("by offender", func(t *testing.T) {
+		result, err := backend.GetSlashingEvidence(ctx, offenderID)
+		require.NoError(t, err)
+		assert.Equal(t, []*flow.SlashingEvidence{vote}, result)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		result, err := backend.GetSlashingEvidence(ctx, flow.ZeroID)
+		require.NoError(t, err)
+		assert.Equal(t, []*flow.SlashingEvidence{vote, timeout}, result)
+	})
+
+	t.Run("storage failure", func(t *testing.T) {
+		failing := new(storagemock.SlashingEvidence)
+		failing.On("All").Return(nil, fmt.Errorf("exception"))
+		_, err := (&backendSlashing{slashingEvidence: failing}).GetSlashingEvidence(ctx, flow.ZeroID)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("not available", func(t *testing.T) {
+		_, err := (&backendSlashing{}).GetSlashingEvidenceByID(ctx, vote.ID())
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		_, err = (&backendSlashing{}).GetSlashingEvidence(ctx, flow.ZeroID)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	accessproto "github.com/onflow/flow/protobuf/go/flow/access"
 	entitiesproto "github.com/onflow/flow/protobuf/go/flow/entities"
 	execproto "github.com/onflow/flow/protobuf/go/flow/execution"
@@ -29,6 +28,7 @@ import (
 	"github.com/onflow/flow-go/module/metrics"
 	protocol "github.com/onflow/flow-go/state/protocol/mock"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 	storagemock "github.com/onflow/flow-go/storage/mock"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -160,7 +160,7 @@ func (suite *Suite) TestGetLatestFinalizedBlockHeader() {
 func (suite *Suite) TestGetLatestProtocolStateSnapshot_NoTransitionSpan() {
 	identities := unittest.CompleteIdentitySet()
 	rootSnapshot := unittest.RootSnapshotFixture(identities)
-	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		epochBuilder := unittest.NewEpochBuilder(suite.T(), state)
 		// build epoch 1
 		// blocks in current state
@@ -222,7 +222,7 @@ func (suite *Suite) TestGetLatestProtocolStateSnapshot_NoTransitionSpan() {
 func (suite *Suite) TestGetLatestProtocolStateSnapshot_TransitionSpans() {
 	identities := unittest.CompleteIdentitySet()
 	rootSnapshot := unittest.RootSnapshotFixture(identities)
-	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		epochBuilder := unittest.NewEpochBuilder(suite.T(), state)
 
 		// building 2 epochs allows us to take a snapshot at a point in time where
@@ -293,7 +293,7 @@ func (suite *Suite) TestGetLatestProtocolStateSnapshot_TransitionSpans() {
 func (suite *Suite) TestGetLatestProtocolStateSnapshot_PhaseTransitionSpan() {
 	identities := unittest.CompleteIdentitySet()
 	rootSnapshot := unittest.RootSnapshotFixture(identities)
-	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		epochBuilder := unittest.NewEpochBuilder(suite.T(), state)
 		// build epoch 1
 		// blocks in current state
@@ -356,7 +356,7 @@ func (suite *Suite) TestGetLatestProtocolStateSnapshot_PhaseTransitionSpan() {
 func (suite *Suite) TestGetLatestProtocolStateSnapshot_EpochTransitionSpan() {
 	identities := unittest.CompleteIdentitySet()
 	rootSnapshot := unittest.RootSnapshotFixture(identities)
-	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		epochBuilder := unittest.NewEpochBuilder(suite.T(), state)
 		// build epoch 1
 		// blocks in current state
@@ -431,7 +431,7 @@ func (suite *Suite) TestGetLatestProtocolStateSnapshot_EpochTransitionSpan() {
 func (suite *Suite) TestGetLatestProtocolStateSnapshot_HistoryLimit() {
 	identities := unittest.CompleteIdentitySet()
 	rootSnapshot := unittest.RootSnapshotFixture(identities)
-	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		epochBuilder := unittest.NewEpochBuilder(suite.T(), state).BuildEpoch().CompleteEpoch()
 
 		// get heights of each phase in built epochs
@@ -479,6 +479,87 @@ func (suite *Suite) TestGetLatestProtocolStateSnapshot_HistoryLimit() {
 	})
 }
 
+// TestGetProtocolStateSnapshotByHeight tests our GetProtocolStateSnapshotByHeight and
+// GetProtocolStateSnapshotByBlockID RPC endpoints, which return the snapshot at the requested
+// finalized block only if its sealing segment does not span an epoch or epoch phase transition.
+func (suite *Suite) TestGetProtocolStateSnapshotByHeight() {
+	identities := unittest.CompleteIdentitySet()
+	rootSnapshot := unittest.RootSnapshotFixture(identities)
+	util.RunWithFullProtocolState(suite.T(), rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
+		epochBuilder := unittest.NewEpochBuilder(suite.T(), state)
+		// build epoch 1
+		// blocks in current state
+		// P <- A(S_P-1) <- B(S_P) <- C(S_A) <- D(S_B) |setup| <- E(S_C) <- F(S_D) |commit| <- G(S_E)
+		epochBuilder.
+			BuildEpoch().
+			CompleteEpoch()
+
+		epoch1, ok := epochBuilder.EpochHeights(1)
+		require.True(suite.T(), ok)
+
+		backend := New(
+			state,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			suite.chainID,
+			metrics.NewNoopCollector(),
+			nil,
+			false,
+			100,
+			nil,
+			nil,
+			suite.log,
+			DefaultSnapshotHistoryLimit,
+		)
+
+		suite.Run("valid sealing segment", func() {
+			// the sealing segment of block D is B <- C <- D
+			snap := state.AtHeight(epoch1.Range()[3])
+			expected, err := convert.SnapshotToBytes(snap)
+			suite.Require().NoError(err)
+
+			bytes, err := backend.GetProtocolStateSnapshotByHeight(context.Background(), epoch1.Range()[3])
+			suite.Require().NoError(err)
+			suite.Require().Equal(expected, bytes)
+
+			head, err := snap.Head()
+			suite.Require().NoError(err)
+			bytes, err = backend.GetProtocolStateSnapshotByBlockID(context.Background(), head.ID())
+			suite.Require().NoError(err)
+			suite.Require().Equal(expected, bytes)
+		})
+
+		suite.Run("sealing segment spans phase transition", func() {
+			// the sealing segment of block E is C(S_A) <- D(S_B) |setup| <- E(S_C)
+			_, err := backend.GetProtocolStateSnapshotByHeight(context.Background(), epoch1.Range()[4])
+			suite.Require().Error(err)
+			suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+			suite.Require().Contains(err.Error(), fmt.Sprintf("the closest valid snapshot is at height %d", epoch1.Range()[3]))
+		})
+
+		suite.Run("height above finalized height", func() {
+			final, err := state.Final().Head()
+			suite.Require().NoError(err)
+
+			_, err = backend.GetProtocolStateSnapshotByHeight(context.Background(), final.Height+1)
+			suite.Require().Error(err)
+			suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+		})
+
+		suite.Run("unknown block", func() {
+			_, err := backend.GetProtocolStateSnapshotByBlockID(context.Background(), unittest.IdentifierFixture())
+			suite.Require().Error(err)
+			suite.Require().Equal(codes.NotFound, status.Code(err))
+		})
+	})
+}
+
 func (suite *Suite) TestGetLatestSealedBlockHeader() {
 	// setup the mocks
 	suite.state.On("Sealed").Return(suite.snapshot, nil).Maybe()
@@ -0,0 +1,409 @@
+package backend
+
+import (
+	"context"
+	"errors"
+
+	accessproto "github.com/onflow/flow/protobuf/go/flow/access"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// The calls below shadow the calls of the sub-backends. If a spork directory is configured, calls
+// for heights below the root block of the current spork are forwarded to the access node of the
+// spork serving the height, and calls for blocks unknown to this node are forwarded to the access
+// nodes of previous sporks, newest first. Otherwise, calls are handled by the sub-backends.
+
+// WithSporkDirectory enables routing calls for blocks of previous sporks to the access nodes of
+// these sporks. If no historical access nodes were configured, transactions are also looked up
+// on the access nodes of the spork directory.
+func (b *Backend) WithSporkDirectory(directory *SporkDirectory) {
+	b.sporks = directory
+	if len(b.backendTransactions.previousAccessNodes) == 0 {
+		b.backendTransactions.previousAccessNodes = directory.Clients()
+	}
+}
+
+func (b *Backend) GetBlockHeaderByHeight(ctx context.Context, height uint64) (*flow.Header, error) {
+	spork, err := b.sporkForHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendBlockHeaders.GetBlockHeaderByHeight(ctx, height)
+	}
+
+	resp, err := spork.Client.GetBlockHeaderByHeight(ctx, &accessproto.GetBlockHeaderByHeightRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	return convert.MessageToBlockHeader(resp.GetBlock())
+}
+
+func (b *Backend) GetBlockHeaderByID(ctx context.Context, id flow.Identifier) (*flow.Header, error) {
+	header, err := b.backendBlockHeaders.GetBlockHeaderByID(ctx, id)
+	if b.sporks == nil || status.Code(err) != codes.NotFound {
+		return header, err
+	}
+
+	header, _, err = b.historicalHeaderByID(ctx, id)
+	return header, err
+}
+
+func (b *Backend) GetBlockByHeight(ctx context.Context, height uint64) (*flow.Block, error) {
+	spork, err := b.sporkForHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendBlockDetails.GetBlockByHeight(ctx, height)
+	}
+
+	resp, err := spork.Client.GetBlockByHeight(ctx, &accessproto.GetBlockByHeightRequest{Height: height, FullBlockResponse: true})
+	if err != nil {
+		return nil, err
+	}
+	return convert.MessageToBlock(resp.GetBlock())
+}
+
+func (b *Backend) GetBlockByID(ctx context.Context, id flow.Identifier) (*flow.Block, error) {
+	block, err := b.backendBlockDetails.GetBlockByID(ctx, id)
+	if b.sporks == nil || status.Code(err) != codes.NotFound {
+		return block, err
+	}
+
+	_, spork, err := b.historicalHeaderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := spork.Client.GetBlockByID(ctx, &accessproto.GetBlockByIDRequest{Id: id[:], FullBlockResponse: true})
+	if err != nil {
+		return nil, err
+	}
+	return convert.MessageToBlock(resp.GetBlock())
+}
+
+func (b *Backend) GetCollectionByID(ctx context.Context, colID flow.Identifier) (*flow.LightCollection, error) {
+	col, err := b.getCollectionByID(ctx, colID)
+	if b.sporks == nil || status.Code(err) != codes.NotFound {
+		return col, err
+	}
+
+	for _, spork := range b.sporks.Newest() {
+		resp, err := spork.Client.GetCollectionByID(ctx, &accessproto.GetCollectionByIDRequest{Id: colID[:]})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &flow.LightCollection{
+			Transactions: convert.MessagesToIdentifiers(resp.GetCollection().GetTransactionIds()),
+		}, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "collection %v not found in the current or any previous spork", colID)
+}
+
+func (b *Backend) GetTransactionsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*flow.TransactionBody, error) {
+	spork, err := b.sporkForBlockID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendTransactions.GetTransactionsByBlockID(ctx, blockID)
+	}
+
+	resp, err := spork.Client.GetTransactionsByBlockID(ctx, &accessproto.GetTransactionsByBlockIDRequest{BlockId: blockID[:]})
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]*flow.TransactionBody, 0, len(resp.GetTransactions()))
+	for _, message := range resp.GetTransactions() {
+		tx, err := convert.MessageToTransaction(message, b.chainID.Chain())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert transaction from spork with root height %d: %v", spork.RootHeight, err)
+		}
+		transactions = append(transactions, &tx)
+	}
+	return transactions, nil
+}
+
+func (b *Backend) GetTransactionResultsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*access.TransactionResult, error) {
+	spork, err := b.sporkForBlockID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendTransactions.GetTransactionResultsByBlockID(ctx, blockID)
+	}
+
+	resp, err := spork.Client.GetTransactionResultsByBlockID(ctx, &accessproto.GetTransactionsByBlockIDRequest{BlockId: blockID[:]})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*access.TransactionResult, 0, len(resp.GetTransactionResults()))
+	for _, result := range resp.GetTransactionResults() {
+		results = append(results, access.MessageToTransactionResult(result))
+	}
+	return results, nil
+}
+
+func (b *Backend) GetTransactionResultByIndex(ctx context.Context, blockID flow.Identifier, index uint32) (*access.TransactionResult, error) {
+	spork, err := b.sporkForBlockID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendTransactions.GetTransactionResultByIndex(ctx, blockID, index)
+	}
+
+	resp, err := spork.Client.GetTransactionResultByIndex(ctx, &accessproto.GetTransactionByIndexRequest{BlockId: blockID[:], Index: index})
+	if err != nil {
+		return nil, err
+	}
+	return access.MessageToTransactionResult(resp), nil
+}
+
+func (b *Backend) GetAccountAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (*flow.Account, error) {
+	spork, err := b.sporkForHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendAccounts.GetAccountAtBlockHeight(ctx, address, height)
+	}
+
+	resp, err := spork.Client.GetAccountAtBlockHeight(ctx, &accessproto.GetAccountAtBlockHeightRequest{Address: address.Bytes(), BlockHeight: height})
+	if err != nil {
+		return nil, err
+	}
+	return convert.MessageToAccount(resp.GetAccount())
+}
+
+func (b *Backend) ExecuteScriptAtBlockID(ctx context.Context, blockID flow.Identifier, script []byte, arguments [][]byte) ([]byte, error) {
+	spork, err := b.sporkForBlockID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendScripts.ExecuteScriptAtBlockID(ctx, blockID, script, arguments)
+	}
+
+	resp, err := spork.Client.ExecuteScriptAtBlockID(ctx, &accessproto.ExecuteScriptAtBlockIDRequest{BlockId: blockID[:], Script: script, Arguments: arguments})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetValue(), nil
+}
+
+func (b *Backend) ExecuteScriptAtBlockHeight(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte) ([]byte, error) {
+	spork, err := b.sporkForHeight(blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	if spork == nil {
+		return b.backendScripts.ExecuteScriptAtBlockHeight(ctx, blockHeight, script, arguments)
+	}
+
+	resp, err := spork.Client.ExecuteScriptAtBlockHeight(ctx, &accessproto.ExecuteScriptAtBlockHeightRequest{BlockHeight: blockHeight, Script: script, Arguments: arguments})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetValue(), nil
+}
+
+// GetEventsForHeightRange retrieves events for all sealed blocks between the start block height and
+// the end block height (inclusive) that have the given type. Ranges spanning several sporks are split
+// and the events of each spork are merged, in increasing order of heights.
+func (b *Backend) GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64) ([]flow.BlockEvents, error) {
+	if b.sporks == nil || !b.sporks.IsHistorical(startHeight) {
+		return b.backendEvents.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+	}
+
+	if endHeight < startHeight {
+		return nil, status.Error(codes.InvalidArgument, "invalid start or end height")
+	}
+	rangeSize := endHeight - startHeight + 1 // range is inclusive on both ends
+	if rangeSize > uint64(b.backendEvents.maxHeightRange) {
+		return nil, status.Errorf(codes.InvalidArgument, "requested block range (%d) exceeded maximum (%d)", rangeSize, b.backendEvents.maxHeightRange)
+	}
+
+	ranges, err := b.sporks.Split(startHeight, endHeight)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to get events: %v", err)
+	}
+
+	results := make([]flow.BlockEvents, 0, rangeSize)
+	for _, heights := range ranges {
+		if heights.Spork == nil {
+			events, err := b.backendEvents.GetEventsForHeightRange(ctx, eventType, heights.StartHeight, heights.EndHeight)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, events...)
+			continue
+		}
+
+		resp, err := heights.Spork.Client.GetEventsForHeightRange(ctx, &accessproto.GetEventsForHeightRangeRequest{
+			Type:        eventType,
+			StartHeight: heights.StartHeight,
+			EndHeight:   heights.EndHeight,
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, messagesToBlockEvents(resp.GetResults())...)
+	}
+
+	return results, nil
+}
+
+// GetEventsForBlockIDs retrieves events for all the specified block IDs that have the given type. The
+// events of blocks of previous sporks are retrieved from the access nodes of these sporks, and results
+// are returned in the order of the given block IDs.
+func (b *Backend) GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier) ([]flow.BlockEvents, error) {
+	if b.sporks == nil {
+		return b.backendEvents.GetEventsForBlockIDs(ctx, eventType, blockIDs)
+	}
+
+	if uint(len(blockIDs)) > b.backendEvents.maxHeightRange {
+		return nil, status.Errorf(codes.InvalidArgument, "requested block range (%d) exceeded maximum (%d)", len(blockIDs), b.backendEvents.maxHeightRange)
+	}
+
+	// group the blocks by the spork they belong to, nil is the current spork
+	var sporks []*HistoricalSpork
+	groups := make(map[*HistoricalSpork][]flow.Identifier)
+	for _, blockID := range blockIDs {
+		spork, err := b.sporkForBlockID(ctx, blockID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := groups[spork]; !ok {
+			sporks = append(sporks, spork)
+		}
+		groups[spork] = append(groups[spork], blockID)
+	}
+
+	eventsByBlockID := make(map[flow.Identifier]flow.BlockEvents, len(blockIDs))
+	for _, spork := range sporks {
+		var events []flow.BlockEvents
+		if spork == nil {
+			var err error
+			events, err = b.backendEvents.GetEventsForBlockIDs(ctx, eventType, groups[spork])
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			resp, err := spork.Client.GetEventsForBlockIDs(ctx, &accessproto.GetEventsForBlockIDsRequest{
+				Type:     eventType,
+				BlockIds: convert.IdentifiersToMessages(groups[spork]),
+			})
+			if err != nil {
+				return nil, err
+			}
+			events = messagesToBlockEvents(resp.GetResults())
+		}
+		for _, blockEvents := range events {
+			eventsByBlockID[blockEvents.BlockID] = blockEvents
+		}
+	}
+
+	results := make([]flow.BlockEvents, 0, len(blockIDs))
+	for _, blockID := range blockIDs {
+		blockEvents, ok := eventsByBlockID[blockID]
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "failed to get events: no events returned for block %v", blockID)
+		}
+		results = append(results, blockEvents)
+	}
+	return results, nil
+}
+
+func (b *Backend) GetExecutionResultForBlockID(ctx context.Context, blockID flow.Identifier) (*flow.ExecutionResult, error) {
+	result, err := b.backendExecutionResults.GetExecutionResultForBlockID(ctx, blockID)
+	if b.sporks == nil || status.Code(err) != codes.NotFound {
+		return result, err
+	}
+
+	_, spork, err := b.historicalHeaderByID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := spork.Client.GetExecutionResultForBlockID(ctx, &accessproto.GetExecutionResultForBlockIDRequest{BlockId: blockID[:]})
+	if err != nil {
+		return nil, err
+	}
+	return convert.MessageToExecutionResult(resp.GetExecutionResult())
+}
+
+// sporkForHeight returns the previous spork serving the given height, or nil if the height is
+// served by the current spork or no spork directory is configured. A NotFound error is returned
+// if the height is below the root of the oldest known spork.
+func (b *Backend) sporkForHeight(height uint64) (*HistoricalSpork, error) {
+	if b.sporks == nil || !b.sporks.IsHistorical(height) {
+		return nil, nil
+	}
+	spork, ok := b.sporks.ByHeight(height)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "height %d is below the root height of the oldest known spork", height)
+	}
+	return spork, nil
+}
+
+// sporkForBlockID returns the previous spork the given block belongs to, or nil if the block is
+// known to this node or no spork directory is configured.
+func (b *Backend) sporkForBlockID(ctx context.Context, blockID flow.Identifier) (*HistoricalSpork, error) {
+	if b.sporks == nil {
+		return nil, nil
+	}
+
+	_, err := b.backendBlockHeaders.headers.ByBlockID(blockID)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, convertStorageError(err)
+	}
+
+	_, spork, err := b.historicalHeaderByID(ctx, blockID)
+	return spork, err
+}
+
+// historicalHeaderByID looks up the block with the given ID in the previous sporks, newest first,
+// and returns its header together with the spork it belongs to.
+func (b *Backend) historicalHeaderByID(ctx context.Context, blockID flow.Identifier) (*flow.Header, *HistoricalSpork, error) {
+	for _, spork := range b.sporks.Newest() {
+		resp, err := spork.Client.GetBlockHeaderByID(ctx, &accessproto.GetBlockHeaderByIDRequest{Id: blockID[:]})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		header, err := convert.MessageToBlockHeader(resp.GetBlock())
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to convert block header from spork with root height %d: %v", spork.RootHeight, err)
+		}
+		return header, spork, nil
+	}
+	return nil, nil, status.Errorf(codes.NotFound, "block %v not found in the current or any previous spork", blockID)
+}
+
+// messagesToBlockEvents converts the events returned by the access node of a previous spork.
+func messagesToBlockEvents(results []*accessproto.EventsResponse_Result) []flow.BlockEvents {
+	blockEvents := make([]flow.BlockEvents, len(results))
+	for i, result := range results {
+		blockEvents[i] = flow.BlockEvents{
+			BlockID:        convert.MessageToIdentifier(result.GetBlockId()),
+			BlockHeight:    result.GetBlockHeight(),
+			BlockTimestamp: result.GetBlockTimestamp().AsTime(),
+			Events:         convert.MessagesToEvents(result.GetEvents()),
+		}
+	}
+	return blockEvents
+}
@@ -3,15 +3,19 @@ package backend
 import (
 	"context"
 
+	"github.com/stretchr/testify/mock"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	accessproto "github.com/onflow/flow/protobuf/go/flow/access"
 	"github.com/onflow/flow/protobuf/go/flow/entities"
 
+	access "github.com/onflow/flow-go/engine/access/mock"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -126,3 +130,204 @@ func (suite *Suite) TestHistoricalTransaction() {
 
 	suite.assertAllExpectations()
 }
+
+// newSporkDirectoryBackend returns a backend with two previous sporks, starting at heights 10
+// and 50, before the current spork starting at height 100.
+func (suite *Suite) newSporkDirectoryBackend(previous, last *access.AccessAPIClient) *Backend {
+	backend := New(suite.state,
+		nil,
+		nil,
+		suite.blocks,
+		suite.headers,
+		suite.collections,
+		suite.transactions,
+		suite.receipts,
+		suite.results,
+		suite.chainID,
+		metrics.NewNoopCollector(),
+		nil,
+		false,
+		DefaultMaxHeightRange,
+		nil,
+		nil,
+		suite.log,
+		DefaultSnapshotHistoryLimit,
+	)
+
+	directory, err := NewSporkDirectory([]HistoricalSpork{
+		{RootHeight: 10, Client: previous},
+		{RootHeight: 50, Client: last},
+	}, 100)
+	suite.Require().NoError(err)
+	backend.WithSporkDirectory(directory)
+
+	return backend
+}
+
+// TestHistoricalBlockHeaderByHeight tests that headers below the root of the current spork are
+// retrieved from the spork serving their height
+func (suite *Suite) TestHistoricalBlockHeaderByHeight() {
+	ctx := context.Background()
+	previous := new(access.AccessAPIClient)
+	backend := suite.newSporkDirectoryBackend(previous, suite.historicalAccessClient)
+
+	header := unittest.BlockHeaderFixture()
+	header.Height = 20
+	msg, err := convert.BlockHeaderToMessage(&header)
+	suite.Require().NoError(err)
+	previous.
+		On("GetBlockHeaderByHeight", ctx, &accessproto.GetBlockHeaderByHeightRequest{Height: 20}).
+		Return(&accessproto.BlockHeaderResponse{Block: msg}, nil).
+		Once()
+
+	result, err := backend.GetBlockHeaderByHeight(ctx, 20)
+	suite.checkResponse(result, err)
+	suite.Assert().Equal(header.ID(), result.ID())
+
+	// heights below the oldest known spork are not found
+	_, err = backend.GetBlockHeaderByHeight(ctx, 5)
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	// heights of the current spork are served locally
+	current := unittest.BlockHeaderFixture()
+	suite.headers.On("ByHeight", uint64(100)).Return(&current, nil).Once()
+	result, err = backend.GetBlockHeaderByHeight(ctx, 100)
+	suite.checkResponse(result, err)
+	suite.Assert().Equal(current.ID(), result.ID())
+
+	previous.AssertExpectations(suite.T())
+	suite.assertAllExpectations()
+}
+
+// TestHistoricalBlockByID tests that blocks unknown to the node are looked up in previous sporks,
+// newest first
+func (suite *Suite) TestHistoricalBlockByID() {
+	ctx := context.Background()
+	previous := new(access.AccessAPIClient)
+	backend := suite.newSporkDirectoryBackend(previous, suite.historicalAccessClient)
+
+	block := unittest.BlockFixture()
+	blockID := block.ID()
+	header, err := convert.BlockHeaderToMessage(block.Header)
+	suite.Require().NoError(err)
+	msg, err := convert.BlockToMessage(&block)
+	suite.Require().NoError(err)
+
+	suite.blocks.On("ByID", blockID).Return(nil, storage.ErrNotFound).Once()
+	suite.historicalAccessClient.
+		On("GetBlockHeaderByID", ctx, &accessproto.GetBlockHeaderByIDRequest{Id: blockID[:]}).
+		Return(nil, status.Error(codes.NotFound, "not found")).
+		Once()
+	previous.
+		On("GetBlockHeaderByID", ctx, &accessproto.GetBlockHeaderByIDRequest{Id: blockID[:]}).
+		Return(&accessproto.BlockHeaderResponse{Block: header}, nil).
+		Once()
+	previous.
+		On("GetBlockByID", ctx, &accessproto.GetBlockByIDRequest{Id: blockID[:], FullBlockResponse: true}).
+		Return(&accessproto.BlockResponse{Block: msg}, nil).
+		Once()
+
+	result, err := backend.GetBlockByID(ctx, blockID)
+	suite.checkResponse(result, err)
+	suite.Assert().Equal(blockID, result.ID())
+
+	previous.AssertExpectations(suite.T())
+	suite.assertAllExpectations()
+}
+
+// TestHistoricalEventsForHeightRange tests that event ranges spanning several sporks are split, and
+// that the events of each spork are merged in increasing order of heights
+func (suite *Suite) TestHistoricalEventsForHeightRange() {
+	ctx := context.Background()
+	previous := new(access.AccessAPIClient)
+	backend := suite.newSporkDirectoryBackend(previous, suite.historicalAccessClient)
+
+	eventsAt := func(height uint64) *accessproto.EventsResponse_Result {
+		return &accessproto.EventsResponse_Result{
+			BlockId:     convert.IdentifierToMessage(unittest.IdentifierFixture()),
+			BlockHeight: height,
+			Events:      []*entities.Event{convert.EventToMessage(unittest.EventFixture(flow.EventAccountCreated, 0, 0, unittest.IdentifierFixture(), 0))},
+		}
+	}
+
+	previous.
+		On("GetEventsForHeightRange", ctx, &accessproto.GetEventsForHeightRangeRequest{Type: string(flow.EventAccountCreated), StartHeight: 45, EndHeight: 49}).
+		Return(&accessproto.EventsResponse{Results: []*accessproto.EventsResponse_Result{eventsAt(45), eventsAt(49)}}, nil).
+		Once()
+	suite.historicalAccessClient.
+		On("GetEventsForHeightRange", ctx, &accessproto.GetEventsForHeightRangeRequest{Type: string(flow.EventAccountCreated), StartHeight: 50, EndHeight: 55}).
+		Return(&accessproto.EventsResponse{Results: []*accessproto.EventsResponse_Result{eventsAt(50)}}, nil).
+		Once()
+
+	results, err := backend.GetEventsForHeightRange(ctx, string(flow.EventAccountCreated), 45, 55)
+	suite.checkResponse(results, err)
+	suite.Require().Len(results, 3)
+	for i, height := range []uint64{45, 49, 50} {
+		suite.Assert().Equal(height, results[i].BlockHeight)
+		suite.Assert().Len(results[i].Events, 1)
+	}
+
+	// the maximum range applies to the whole range
+	_, err = backend.GetEventsForHeightRange(ctx, string(flow.EventAccountCreated), 10, 10+DefaultMaxHeightRange)
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	previous.AssertExpectations(suite.T())
+	suite.assertAllExpectations()
+}
+
+// TestHistoricalEventsForBlockIDs tests that the events of blocks of different sporks are retrieved
+// from the access nodes of these sporks, and returned in the order of the requested block IDs
+func (suite *Suite) TestHistoricalEventsForBlockIDs() {
+	ctx := context.Background()
+	previous := new(access.AccessAPIClient)
+	backend := suite.newSporkDirectoryBackend(previous, suite.historicalAccessClient)
+
+	previousHeader := unittest.BlockHeaderFixture()
+	previousHeader.Height = 20
+	lastHeader := unittest.BlockHeaderFixture()
+	lastHeader.Height = 60
+	previousID, lastID := previousHeader.ID(), lastHeader.ID()
+
+	for _, header := range []*flow.Header{&previousHeader, &lastHeader} {
+		suite.headers.On("ByBlockID", header.ID()).Return(nil, storage.ErrNotFound).Once()
+	}
+	lastMsg, err := convert.BlockHeaderToMessage(&lastHeader)
+	suite.Require().NoError(err)
+	previousMsg, err := convert.BlockHeaderToMessage(&previousHeader)
+	suite.Require().NoError(err)
+	suite.historicalAccessClient.
+		On("GetBlockHeaderByID", ctx, &accessproto.GetBlockHeaderByIDRequest{Id: lastID[:]}).
+		Return(&accessproto.BlockHeaderResponse{Block: lastMsg}, nil).
+		Once()
+	suite.historicalAccessClient.
+		On("GetBlockHeaderByID", ctx, &accessproto.GetBlockHeaderByIDRequest{Id: previousID[:]}).
+		Return(nil, status.Error(codes.NotFound, "not found")).
+		Once()
+	previous.
+		On("GetBlockHeaderByID", ctx, &accessproto.GetBlockHeaderByIDRequest{Id: previousID[:]}).
+		Return(&accessproto.BlockHeaderResponse{Block: previousMsg}, nil).
+		Once()
+
+	eventType := string(flow.EventAccountCreated)
+	suite.historicalAccessClient.
+		On("GetEventsForBlockIDs", ctx, mock.Anything).
+		Return(&accessproto.EventsResponse{Results: []*accessproto.EventsResponse_Result{
+			{BlockId: lastID[:], BlockHeight: lastHeader.Height},
+		}}, nil).
+		Once()
+	previous.
+		On("GetEventsForBlockIDs", ctx, &accessproto.GetEventsForBlockIDsRequest{Type: eventType, BlockIds: [][]byte{previousID[:]}}).
+		Return(&accessproto.EventsResponse{Results: []*accessproto.EventsResponse_Result{
+			{BlockId: previousID[:], BlockHeight: previousHeader.Height},
+		}}, nil).
+		Once()
+
+	results, err := backend.GetEventsForBlockIDs(ctx, eventType, []flow.Identifier{lastID, previousID})
+	suite.checkResponse(results, err)
+	suite.Require().Len(results, 2)
+	suite.Assert().Equal(lastID, results[0].BlockID)
+	suite.Assert().Equal(previousID, results[1].BlockID)
+
+	previous.AssertExpectations(suite.T())
+	suite.assertAllExpectations()
+}
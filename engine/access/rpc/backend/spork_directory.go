@@ -0,0 +1,171 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	accessproto "github.com/onflow/flow/protobuf/go/flow/access"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// SporkConfig describes a previous spork in a spork directory file.
+type SporkConfig struct {
+	RootHeight    uint64          `json:"root_height"`
+	RootBlockID   flow.Identifier `json:"root_block_id"`
+	AccessAddress string          `json:"access_address"`
+}
+
+// ReadSporkConfigs reads the list of previous sporks from a JSON spork directory file.
+func ReadSporkConfigs(path string) ([]SporkConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read spork directory file: %w", err)
+	}
+
+	var configs []SporkConfig
+	err = json.Unmarshal(data, &configs)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode spork directory file: %w", err)
+	}
+
+	for _, config := range configs {
+		if config.AccessAddress == "" {
+			return nil, fmt.Errorf("spork with root height %d has no access address", config.RootHeight)
+		}
+	}
+
+	return configs, nil
+}
+
+// HistoricalSpork is a previous spork together with an access node serving its blocks.
+type HistoricalSpork struct {
+	RootHeight  uint64
+	RootBlockID flow.Identifier
+	// EndHeight is the height of the last block served by the spork, it is set by NewSporkDirectory.
+	EndHeight uint64
+	Client    accessproto.AccessAPIClient
+}
+
+// HeightRange is a range of heights (inclusive) served by a single spork.
+type HeightRange struct {
+	// Spork is the previous spork serving the range, or nil if the range is served by the current spork.
+	Spork       *HistoricalSpork
+	StartHeight uint64
+	EndHeight   uint64
+}
+
+// SporkDirectory maps height ranges to the sporks serving them. Each previous spork serves the
+// blocks from its root block up to the block below the root block of the next spork, the current
+// spork serves the blocks from its own root block onwards.
+type SporkDirectory struct {
+	sporks     []*HistoricalSpork // ordered by root height, oldest first
+	rootHeight uint64             // root height of the current spork
+}
+
+// NewSporkDirectory creates a spork directory for the given previous sporks and the root height of
+// the current spork. Sporks must not share root heights, and must all start below the current spork.
+func NewSporkDirectory(sporks []HistoricalSpork, rootHeight uint64) (*SporkDirectory, error) {
+	ordered := make([]*HistoricalSpork, 0, len(sporks))
+	for i := range sporks {
+		spork := sporks[i]
+		if spork.Client == nil {
+			return nil, fmt.Errorf("spork with root height %d has no access node client", spork.RootHeight)
+		}
+		if spork.RootHeight >= rootHeight {
+			return nil, fmt.Errorf("spork with root height %d does not start below the current spork root height %d", spork.RootHeight, rootHeight)
+		}
+		ordered = append(ordered, &spork)
+	}
+
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].RootHeight < ordered[j].RootHeight
+	})
+	for i, spork := range ordered {
+		next := rootHeight
+		if i+1 < len(ordered) {
+			next = ordered[i+1].RootHeight
+		}
+		if next == spork.RootHeight {
+			return nil, fmt.Errorf("duplicate spork with root height %d", spork.RootHeight)
+		}
+		spork.EndHeight = next - 1
+	}
+
+	return &SporkDirectory{
+		sporks:     ordered,
+		rootHeight: rootHeight,
+	}, nil
+}
+
+// RootHeight returns the root height of the current spork.
+func (d *SporkDirectory) RootHeight() uint64 {
+	return d.rootHeight
+}
+
+// IsHistorical returns true if the given height is below the root height of the current spork.
+func (d *SporkDirectory) IsHistorical(height uint64) bool {
+	return height < d.rootHeight
+}
+
+// ByHeight returns the previous spork serving the given height, and false if no known
+// previous spork serves it.
+func (d *SporkDirectory) ByHeight(height uint64) (*HistoricalSpork, bool) {
+	if !d.IsHistorical(height) {
+		return nil, false
+	}
+	// find the last spork with a root height not above the given height
+	i := sort.Search(len(d.sporks), func(i int) bool {
+		return d.sporks[i].RootHeight > height
+	})
+	if i == 0 {
+		return nil, false
+	}
+	return d.sporks[i-1], true
+}
+
+// Newest returns the previous sporks, newest first. ID based lookups use this order, as recent
+// blocks are queried more often.
+func (d *SporkDirectory) Newest() []*HistoricalSpork {
+	sporks := make([]*HistoricalSpork, len(d.sporks))
+	for i, spork := range d.sporks {
+		sporks[len(d.sporks)-1-i] = spork
+	}
+	return sporks
+}
+
+// Clients returns the access node clients of the previous sporks, newest first.
+func (d *SporkDirectory) Clients() []accessproto.AccessAPIClient {
+	clients := make([]accessproto.AccessAPIClient, 0, len(d.sporks))
+	for _, spork := range d.Newest() {
+		clients = append(clients, spork.Client)
+	}
+	return clients
+}
+
+// Split splits the given height range (inclusive) into the ranges served by each spork, in
+// increasing order of heights. An error is returned if a part of the range is below the root of
+// the oldest known spork.
+func (d *SporkDirectory) Split(startHeight, endHeight uint64) ([]HeightRange, error) {
+	var ranges []HeightRange
+	for height := startHeight; height <= endHeight; {
+		if !d.IsHistorical(height) {
+			ranges = append(ranges, HeightRange{StartHeight: height, EndHeight: endHeight})
+			break
+		}
+
+		spork, ok := d.ByHeight(height)
+		if !ok {
+			return nil, fmt.Errorf("height %d is below the root height of the oldest known spork", height)
+		}
+		end := spork.EndHeight
+		if end > endHeight {
+			end = endHeight
+		}
+		ranges = append(ranges, HeightRange{Spork: spork, StartHeight: height, EndHeight: end})
+		height = end + 1
+	}
+	return ranges, nil
+}
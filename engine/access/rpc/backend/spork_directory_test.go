@@ -0,0 +1,77 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	access "github.com/onflow/flow-go/engine/access/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestSporkDirectory(t *testing.T) {
+	first := HistoricalSpork{RootHeight: 10, RootBlockID: unittest.IdentifierFixture(), Client: new(access.AccessAPIClient)}
+	second := HistoricalSpork{RootHeight: 50, RootBlockID: unittest.IdentifierFixture(), Client: new(access.AccessAPIClient)}
+
+	// sporks are ordered by root height regardless of the configured order
+	directory, err := NewSporkDirectory([]HistoricalSpork{second, first}, 100)
+	require.NoError(t, err)
+
+	t.Run("by height", func(t *testing.T) {
+		_, ok := directory.ByHeight(9)
+		assert.False(t, ok)
+
+		spork, ok := directory.ByHeight(10)
+		require.True(t, ok)
+		assert.Equal(t, first.RootBlockID, spork.RootBlockID)
+		assert.Equal(t, uint64(49), spork.EndHeight)
+
+		spork, ok = directory.ByHeight(99)
+		require.True(t, ok)
+		assert.Equal(t, second.RootBlockID, spork.RootBlockID)
+		assert.Equal(t, uint64(99), spork.EndHeight)
+
+		_, ok = directory.ByHeight(100)
+		assert.False(t, ok)
+		assert.False(t, directory.IsHistorical(100))
+	})
+
+	t.Run("newest first", func(t *testing.T) {
+		sporks := directory.Newest()
+		require.Len(t, sporks, 2)
+		assert.Equal(t, second.RootBlockID, sporks[0].RootBlockID)
+		assert.Equal(t, first.RootBlockID, sporks[1].RootBlockID)
+	})
+
+	t.Run("split", func(t *testing.T) {
+		ranges, err := directory.Split(40, 120)
+		require.NoError(t, err)
+		require.Len(t, ranges, 3)
+		assert.Equal(t, first.RootBlockID, ranges[0].Spork.RootBlockID)
+		assert.Equal(t, [2]uint64{40, 49}, [2]uint64{ranges[0].StartHeight, ranges[0].EndHeight})
+		assert.Equal(t, second.RootBlockID, ranges[1].Spork.RootBlockID)
+		assert.Equal(t, [2]uint64{50, 99}, [2]uint64{ranges[1].StartHeight, ranges[1].EndHeight})
+		assert.Nil(t, ranges[2].Spork)
+		assert.Equal(t, [2]uint64{100, 120}, [2]uint64{ranges[2].StartHeight, ranges[2].EndHeight})
+
+		ranges, err = directory.Split(60, 70)
+		require.NoError(t, err)
+		require.Len(t, ranges, 1)
+		assert.Equal(t, second.RootBlockID, ranges[0].Spork.RootBlockID)
+
+		_, err = directory.Split(5, 20)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid sporks", func(t *testing.T) {
+		_, err := NewSporkDirectory([]HistoricalSpork{first, first}, 100)
+		assert.Error(t, err)
+
+		_, err = NewSporkDirectory([]HistoricalSpork{first}, 10)
+		assert.Error(t, err)
+
+		_, err = NewSporkDirectory([]HistoricalSpork{{RootHeight: 1}}, 10)
+		assert.Error(t, err)
+	})
+}
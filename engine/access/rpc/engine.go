@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/access/extended"
 	legacyaccess "github.com/onflow/flow-go/access/legacy"
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/access/rest"
@@ -38,6 +39,7 @@ type Config struct {
 	RESTListenAddr            string                           // the REST server address as ip:port (if empty the REST server will not be started)
 	CollectionAddr            string                           // the address of the upstream collection node
 	HistoricalAccessAddrs     string                           // the list of all access nodes from previous spork
+	SporkDirectoryFile        string                           // the JSON file listing the previous sporks and their access nodes (optional)
 	MaxMsgSize                int                              // GRPC max message size
 	ExecutionClientTimeout    time.Duration                    // execution API GRPC client timeout
 	CollectionClientTimeout   time.Duration                    // collection API GRPC client timeout
@@ -176,6 +178,16 @@ func New(log zerolog.Logger,
 		access.NewHandler(backend, chainID.Chain()),
 	)
 
+	extended.RegisterExtendedAccessAPIServer(
+		eng.unsecureGrpcServer,
+		access.NewExtendedHandler(backend, chainID.Chain()),
+	)
+
+	extended.RegisterExtendedAccessAPIServer(
+		eng.secureGrpcServer,
+		access.NewExtendedHandler(backend, chainID.Chain()),
+	)
+
 	if rpcMetricsEnabled {
 		// Not interested in legacy metrics, so initialize here
 		grpc_prometheus.EnableHandlingTimeHistogram()
@@ -253,6 +265,35 @@ func (e *Engine) RestApiAddress() net.Addr {
 	return e.restAPIAddress
 }
 
+// API returns the Access API implementation served by the engine.
+func (e *Engine) API() access.API {
+	return e.backend
+}
+
+// WithEventIndex enables serving filtered event queries from the given event index. It must be
+// called before the engine is started.
+func (e *Engine) WithEventIndex(index storage.EventIndex, progress storage.ConsumerProgress) {
+	e.backend.WithEventIndex(index, progress)
+}
+
+// WithAccountTransactionIndex enables serving account transaction history queries from the given
+// index. It must be called before the engine is started.
+func (e *Engine) WithAccountTransactionIndex(index storage.AccountTransactions, progress storage.ConsumerProgress) {
+	e.backend.WithAccountTransactionIndex(index, progress)
+}
+
+// WithSlashingEvidence enables serving slashing evidence queries from the given storage. It must
+// be called before the engine is started.
+func (e *Engine) WithSlashingEvidence(evidence storage.SlashingEvidence) {
+	e.backend.WithSlashingEvidence(evidence)
+}
+
+// WithSporkDirectory enables routing calls for blocks of previous sporks to the access nodes of
+// these sporks. It must be called before the engine is started.
+func (e *Engine) WithSporkDirectory(directory *backend.SporkDirectory) {
+	e.backend.WithSporkDirectory(directory)
+}
+
 // process processes the given ingestion engine event. Events that are given
 // to this function originate within the expulsion engine on the node with the
 // given origin ID.
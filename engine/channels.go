@@ -123,6 +123,7 @@ const (
 
 	// Channels for dkg communication
 	DKGCommittee = "dkg-committee"
+	DKGBroadcast = "dkg-broadcast"
 
 	// Channels for actively pushing entities to subscribers
 	PushTransactions = network.Channel("push-transactions")
@@ -131,6 +132,9 @@ const (
 	PushReceipts     = network.Channel("push-receipts")
 	PushApprovals    = network.Channel("push-approvals")
 
+	// Channel for pushing evidence of slashable protocol violations to access nodes
+	PushSlashingEvidence = network.Channel("push-slashing-evidence")
+
 	// Channels for actively requesting missing entities
 	RequestCollections       = network.Channel("request-collections")
 	RequestChunks            = network.Channel("request-chunks")
@@ -144,6 +148,8 @@ const (
 	ReceiveReceipts     = PushReceipts
 	ReceiveApprovals    = PushApprovals
 
+	ReceiveSlashingEvidence = PushSlashingEvidence
+
 	ProvideCollections       = RequestCollections
 	ProvideChunks            = RequestChunks
 	ProvideReceiptsByBlockID = RequestReceiptsByBlockID
@@ -177,6 +183,7 @@ func initializeChannelRoleMap() {
 
 	// Channels for DKG communication
 	channelRoleMap[DKGCommittee] = flow.RoleList{flow.RoleConsensus}
+	channelRoleMap[DKGBroadcast] = flow.RoleList{flow.RoleConsensus}
 
 	// Channels for actively pushing entities to subscribers
 	channelRoleMap[PushTransactions] = flow.RoleList{flow.RoleCollection}
@@ -186,6 +193,7 @@ func initializeChannelRoleMap() {
 	channelRoleMap[PushReceipts] = flow.RoleList{flow.RoleConsensus, flow.RoleExecution, flow.RoleVerification,
 		flow.RoleAccess}
 	channelRoleMap[PushApprovals] = flow.RoleList{flow.RoleConsensus, flow.RoleVerification}
+	channelRoleMap[PushSlashingEvidence] = flow.RoleList{flow.RoleCollection, flow.RoleConsensus, flow.RoleAccess}
 
 	// Channels for actively requesting missing entities
 	channelRoleMap[RequestCollections] = flow.RoleList{flow.RoleCollection, flow.RoleExecution, flow.RoleAccess}
@@ -200,6 +208,7 @@ func initializeChannelRoleMap() {
 	channelRoleMap[ReceiveReceipts] = flow.RoleList{flow.RoleConsensus, flow.RoleExecution, flow.RoleVerification,
 		flow.RoleAccess}
 	channelRoleMap[ReceiveApprovals] = flow.RoleList{flow.RoleConsensus, flow.RoleVerification}
+	channelRoleMap[ReceiveSlashingEvidence] = flow.RoleList{flow.RoleCollection, flow.RoleConsensus, flow.RoleAccess}
 
 	channelRoleMap[ProvideCollections] = flow.RoleList{flow.RoleCollection, flow.RoleExecution, flow.RoleAccess}
 	channelRoleMap[ProvideChunks] = flow.RoleList{flow.RoleExecution, flow.RoleVerification}
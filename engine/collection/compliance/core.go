@@ -323,6 +323,25 @@ func (c *Core) OnBlockVote(originID flow.Identifier, vote *messages.ClusterBlock
 	return nil
 }
 
+// OnTimeout handles timeout objects by passing them to the core consensus
+// algorithm
+func (c *Core) OnTimeout(originID flow.Identifier, timeout *messages.ClusterTimeoutObject) error {
+
+	c.log.Debug().
+		Hex("origin_id", originID[:]).
+		Uint64("view", timeout.View).
+		Msg("received timeout")
+
+	c.voteAggregator.AddTimeout(&model.TimeoutObject{
+		View:       timeout.View,
+		NewestQC:   timeout.NewestQC,
+		LastViewTC: timeout.LastViewTC,
+		SignerID:   originID,
+		SigData:    timeout.SigData,
+	})
+	return nil
+}
+
 // ProcessFinalizedView performs pruning of stale data based on finalization event
 // removes pending blocks below the finalized view
 func (c *Core) ProcessFinalizedView(finalizedView uint64) {
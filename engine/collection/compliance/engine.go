@@ -33,6 +33,9 @@ const defaultBlockQueueCapacity = 10000
 // defaultVoteQueueCapacity maximum capacity of block votes queue
 const defaultVoteQueueCapacity = 1000
 
+// defaultTimeoutQueueCapacity maximum capacity of timeout objects queue
+const defaultTimeoutQueueCapacity = 1000
+
 // Engine is a wrapper struct for `Core` which implements cluster consensus algorithm.
 // Engine is responsible for handling incoming messages, queueing for processing, broadcasting proposals.
 type Engine struct {
@@ -47,6 +50,7 @@ type Engine struct {
 	core                       *Core
 	pendingBlocks              engine.MessageStore
 	pendingVotes               engine.MessageStore
+	pendingTimeouts            engine.MessageStore
 	messageHandler             *engine.MessageHandler
 	finalizedView              counters.StrictMonotonousCounter
 	finalizationEventsNotifier engine.Notifier
@@ -100,6 +104,16 @@ func NewEngine(
 	}
 	pendingVotes := &engine.FifoMessageStore{FifoQueue: votesQueue}
 
+	// FIFO queue for timeout objects
+	timeoutsQueue, err := fifoqueue.NewFifoQueue(
+		fifoqueue.WithCapacity(defaultTimeoutQueueCapacity),
+		fifoqueue.WithLengthObserver(func(len int) { core.mempoolMetrics.MempoolEntries(metrics.ResourceClusterTimeoutQueue, uint(len)) }),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue for inbound timeouts: %w", err)
+	}
+	pendingTimeouts := &engine.FifoMessageStore{FifoQueue: timeoutsQueue}
+
 	// define message queueing behaviour
 	handler := engine.NewMessageHandler(
 		engineLog,
@@ -145,6 +159,16 @@ func NewEngine(
 			},
 			Store: pendingVotes,
 		},
+		engine.Pattern{
+			Match: func(msg *engine.Message) bool {
+				_, ok := msg.Payload.(*messages.ClusterTimeoutObject)
+				if ok {
+					core.metrics.MessageReceived(metrics.EngineClusterCompliance, metrics.MessageClusterTimeoutObject)
+				}
+				return ok
+			},
+			Store: pendingTimeouts,
+		},
 	)
 
 	eng := &Engine{
@@ -159,6 +183,7 @@ func NewEngine(
 		core:                       core,
 		pendingBlocks:              pendingBlocks,
 		pendingVotes:               pendingVotes,
+		pendingTimeouts:            pendingTimeouts,
 		messageHandler:             handler,
 		finalizationEventsNotifier: engine.NewNotifier(),
 		con:                        nil,
@@ -300,6 +325,15 @@ func (e *Engine) processAvailableMessages() error {
 			continue
 		}
 
+		msg, ok = e.pendingTimeouts.Get()
+		if ok {
+			err := e.core.OnTimeout(msg.OriginID, msg.Payload.(*messages.ClusterTimeoutObject))
+			if err != nil {
+				return fmt.Errorf("could not handle timeout: %w", err)
+			}
+			continue
+		}
+
 		// when there is no more messages in the queue, back to the loop to wait
 		// for the next incoming message to arrive.
 		return nil
@@ -423,6 +457,49 @@ func (e *Engine) BroadcastProposal(header *flow.Header) error {
 	return e.BroadcastProposalWithDelay(header, 0)
 }
 
+// BroadcastTimeout submits a timeout object to all the collection nodes in our cluster.
+func (e *Engine) BroadcastTimeout(timeout *model.TimeoutObject) error {
+
+	log := e.log.With().
+		Uint64("timeout_view", timeout.View).
+		Uint64("timeout_newest_qc_view", timeout.NewestQC.View).
+		Logger()
+
+	log.Debug().Msg("preparing to broadcast timeout from hotstuff")
+
+	// retrieve all collection nodes in our cluster
+	recipients, err := e.state.Final().Identities(filter.And(
+		filter.In(e.cluster),
+		filter.Not(filter.HasNodeID(e.me.NodeID())),
+	))
+	if err != nil {
+		return fmt.Errorf("could not get cluster members: %w", err)
+	}
+
+	// NOTE: the signer ID is conveyed over the network message
+	msg := &messages.ClusterTimeoutObject{
+		View:       timeout.View,
+		NewestQC:   timeout.NewestQC,
+		LastViewTC: timeout.LastViewTC,
+		SigData:    timeout.SigData,
+	}
+
+	e.unit.Launch(func() {
+		err := e.con.Publish(msg, recipients.NodeIDs()...)
+		if errors.Is(err, network.EmptyTargetList) {
+			return
+		}
+		if err != nil {
+			log.Warn().Err(err).Msg("could not broadcast timeout")
+			return
+		}
+		e.metrics.MessageSent(metrics.EngineClusterCompliance, metrics.MessageClusterTimeoutObject)
+		log.Debug().Msg("broadcast timeout from hotstuff")
+	})
+
+	return nil
+}
+
 // OnFinalizedBlock implements the `OnFinalizedBlock` callback from the `hotstuff.FinalizationConsumer`
 //  (1) Informs sealing.Core about finalization of respective block.
 // CAUTION: the input to this callback is treated as trusted; precautions should be taken that messages
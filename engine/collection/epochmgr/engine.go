@@ -20,12 +20,18 @@ import (
 	"github.com/onflow/flow-go/state/cluster"
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/state/protocol/events"
+	"github.com/onflow/flow-go/storage"
 )
 
 // DefaultStartupTimeout is the default time we wait when starting epoch
 // components before giving up.
 const DefaultStartupTimeout = 30 * time.Second
 
+// DefaultRootQCVoteRetryInterval is the default time we wait before retrying
+// to vote for the root QC of the next epoch after a failed attempt, and in
+// between checking the contract for the votes of our cluster members.
+const DefaultRootQCVoteRetryInterval = time.Minute
+
 // ErrNotAuthorizedForEpoch is returned when we attempt to create epoch components
 // for an epoch in which we are not an authorized network participant. This is the
 // case for epochs during which this node is joining or leaving the network.
@@ -113,12 +119,15 @@ type Engine struct {
 	pools            *epochs.TransactionPools      // epoch-scoped transaction pools
 	factory          EpochComponentsFactory        // consolidates creating epoch for an epoch
 	voter            module.ClusterRootQCVoter     // manages process of voting for next epoch's QC
+	votes            storage.RootQCVotes           // persists the state of our votes for the root QC of our cluster
+	voteMetrics      module.RootQCVoteMetrics      // reports the progress of our votes for the root QC of our cluster
 	heightEvents     events.Heights                // allows subscribing to particular heights
 	irrecoverableCtx irrecoverable.SignalerContext // parent context for canceling all started epochs
 	stopComponents   context.CancelFunc            // used to stop all components
 
-	epochs         map[uint64]*StartableEpochComponents // epoch-scoped components per epoch
-	startupTimeout time.Duration                        // how long we wait for epoch components to start up
+	epochs                  map[uint64]*StartableEpochComponents // epoch-scoped components per epoch
+	startupTimeout          time.Duration                        // how long we wait for epoch components to start up
+	rootQCVoteRetryInterval time.Duration                        // how long we wait before retrying to vote for the root QC
 }
 
 func New(
@@ -127,6 +136,8 @@ func New(
 	state protocol.State,
 	pools *epochs.TransactionPools,
 	voter module.ClusterRootQCVoter,
+	votes storage.RootQCVotes,
+	voteMetrics module.RootQCVoteMetrics,
 	factory EpochComponentsFactory,
 	heightEvents events.Heights,
 ) (*Engine, error) {
@@ -134,18 +145,21 @@ func New(
 	signalerCtx, _ := irrecoverable.WithSignaler(ctx)
 
 	e := &Engine{
-		unit:             engine.NewUnit(),
-		log:              log.With().Str("engine", "epochmgr").Logger(),
-		me:               me,
-		state:            state,
-		pools:            pools,
-		voter:            voter,
-		factory:          factory,
-		heightEvents:     heightEvents,
-		epochs:           make(map[uint64]*StartableEpochComponents),
-		startupTimeout:   DefaultStartupTimeout,
-		irrecoverableCtx: signalerCtx,
-		stopComponents:   stopComponents,
+		unit:                    engine.NewUnit(),
+		log:                     log.With().Str("engine", "epochmgr").Logger(),
+		me:                      me,
+		state:                   state,
+		pools:                   pools,
+		voter:                   voter,
+		votes:                   votes,
+		voteMetrics:             voteMetrics,
+		factory:                 factory,
+		heightEvents:            heightEvents,
+		epochs:                  make(map[uint64]*StartableEpochComponents),
+		startupTimeout:          DefaultStartupTimeout,
+		rootQCVoteRetryInterval: DefaultRootQCVoteRetryInterval,
+		irrecoverableCtx:        signalerCtx,
+		stopComponents:          stopComponents,
 	}
 
 	// set up epoch-scoped epoch managed by this engine for the current epoch
@@ -188,7 +202,8 @@ func (e *Engine) Ready() <-chan struct{} {
 		<-util.AllReady(epochs...)
 	}, func() {
 		// check the current phase on startup, in case we are in setup phase
-		// and haven't yet voted for the next root QC
+		// and haven't yet voted for the next root QC, or in committed phase
+		// and haven't yet recorded whether our vote for the next root QC landed
 		finalSnapshot := e.state.Final()
 		phase, err := finalSnapshot.Phase()
 		if err != nil {
@@ -200,6 +215,11 @@ func (e *Engine) Ready() <-chan struct{} {
 				e.onEpochSetupPhaseStarted(finalSnapshot.Epochs().Next())
 			})
 		}
+		if phase == flow.EpochPhaseCommitted {
+			e.unit.Launch(func() {
+				e.onEpochCommittedPhaseStarted(finalSnapshot.Epochs().Next())
+			})
+		}
 	})
 }
 
@@ -251,6 +271,14 @@ func (e *Engine) EpochSetupPhaseStarted(_ uint64, first *flow.Header) {
 	})
 }
 
+// EpochCommittedPhaseStarted handles the epoch committed phase started protocol event.
+func (e *Engine) EpochCommittedPhaseStarted(_ uint64, first *flow.Header) {
+	e.unit.Launch(func() {
+		nextEpoch := e.state.AtBlockID(first.ID()).Epochs().Next()
+		e.onEpochCommittedPhaseStarted(nextEpoch)
+	})
+}
+
 // onEpochTransition is called when we transition to a new epoch. It arranges
 // to shut down the last epoch's components and starts up the new epoch's.
 func (e *Engine) onEpochTransition(first *flow.Header) error {
@@ -354,14 +382,219 @@ func (e *Engine) prepareToStopEpochComponents(epochCounter, epochMaxHeight uint6
 // setup phase, or when the node is restarted during the epoch setup phase. It
 // kicks off setup tasks for the phase, in particular submitting a vote for the
 // next epoch's root cluster QC.
+//
+// Failed vote attempts are retried for as long as we are in the setup phase.
+// The state of our vote is persisted, so that voting resumes after a restart.
+// Once our vote has landed, we keep checking the contract for the votes of our
+// cluster members until all of them have voted or the setup phase ends.
 func (e *Engine) onEpochSetupPhaseStarted(nextEpoch protocol.Epoch) {
 
 	ctx, cancel := context.WithCancel(e.unit.Ctx())
 	defer cancel()
-	err := e.voter.Vote(ctx, nextEpoch)
+
+	voteState, err := e.loadRootQCVoteState(nextEpoch)
+	if errors.Is(err, ErrNotAuthorizedForEpoch) {
+		e.log.Info().Msg("not a cluster member in next epoch, skipping QC vote")
+		return
+	}
+	if err != nil {
+		e.log.Error().Err(err).Msg("could not load QC vote state for next epoch")
+		return
+	}
+
+	log := e.log.With().
+		Uint64("next_epoch_counter", voteState.EpochCounter).
+		Uint("cluster_index", voteState.ClusterIndex).
+		Logger()
+
+	for voteState.Status != flow.RootQCVoteStatusVoted {
+		voteState.Status = flow.RootQCVoteStatusVoting
+		voteState.Attempts++
+		voteState.LastAttempt = time.Now().UTC()
+		e.storeRootQCVoteState(voteState)
+		e.voteMetrics.RootQCVoteAttempted()
+
+		err := e.voter.Vote(ctx, nextEpoch)
+		if err == nil {
+			voteState.Status = flow.RootQCVoteStatusVoted
+			voteState.LastError = ""
+			e.storeRootQCVoteState(voteState)
+			break
+		}
+		voteState.LastError = err.Error()
+		log.Error().Err(err).Uint("attempts", voteState.Attempts).Msg("failed to submit QC vote for next epoch")
+		e.storeRootQCVoteState(voteState)
+
+		// we can only vote during the setup phase, once it has ended we check
+		// whether our vote landed before giving up
+		if !e.inSetupPhase() {
+			e.finalizeRootQCVoteState(ctx, nextEpoch, voteState)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.rootQCVoteRetryInterval):
+		}
+	}
+
+	log.Info().Msg("QC vote for next epoch landed, checking votes of cluster members...")
+	for {
+		complete, err := e.checkClusterVotes(ctx, nextEpoch, voteState)
+		if err != nil {
+			log.Warn().Err(err).Msg("could not check QC votes of cluster members")
+		}
+		e.storeRootQCVoteState(voteState)
+		if complete || !e.inSetupPhase() {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.rootQCVoteRetryInterval):
+		}
+	}
+}
+
+// onEpochCommittedPhaseStarted is called either when we transition into the
+// epoch committed phase, or when the node is restarted during the epoch
+// committed phase. Voting for the next epoch's root cluster QC is no longer
+// possible, so we record whether our vote has landed in the contract.
+func (e *Engine) onEpochCommittedPhaseStarted(nextEpoch protocol.Epoch) {
+
+	ctx, cancel := context.WithCancel(e.unit.Ctx())
+	defer cancel()
+
+	voteState, err := e.loadRootQCVoteState(nextEpoch)
+	if errors.Is(err, ErrNotAuthorizedForEpoch) {
+		return
+	}
+	if err != nil {
+		e.log.Error().Err(err).Msg("could not load QC vote state for next epoch")
+		return
+	}
+	if voteState.Status == flow.RootQCVoteStatusVoted || voteState.Status == flow.RootQCVoteStatusMissed {
+		return
+	}
+
+	e.finalizeRootQCVoteState(ctx, nextEpoch, voteState)
+}
+
+// finalizeRootQCVoteState checks the contract for the votes of our cluster
+// members once the setup phase has ended, and persists whether our vote for
+// the root QC has landed or was missed. Our vote may have landed even if we
+// failed to submit it, so the vote is only recorded as missed once the contract
+// confirms it. Failed checks are retried until the engine shuts down, in which
+// case the vote state remains unresolved and is checked again after a restart.
+func (e *Engine) finalizeRootQCVoteState(ctx context.Context, nextEpoch protocol.Epoch, voteState *flow.RootQCVoteState) {
+
+	for {
+		_, err := e.checkClusterVotes(ctx, nextEpoch, voteState)
+		if err == nil {
+			break
+		}
+		e.log.Warn().Err(err).Msg("could not check QC votes of cluster members, retrying")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.rootQCVoteRetryInterval):
+		}
+	}
+
+	if voteState.ClusterVoted.Contains(e.me.NodeID()) {
+		voteState.Status = flow.RootQCVoteStatusVoted
+	} else {
+		voteState.Status = flow.RootQCVoteStatusMissed
+		e.log.Error().
+			Uint64("next_epoch_counter", voteState.EpochCounter).
+			Uint("cluster_index", voteState.ClusterIndex).
+			Uint("attempts", voteState.Attempts).
+			Str("last_error", voteState.LastError).
+			Msg("missed QC vote for next epoch")
+	}
+	e.storeRootQCVoteState(voteState)
+}
+
+// checkClusterVotes checks the contract for the votes of our cluster members
+// and records them in the given vote state. Returns true if all cluster members
+// have voted.
+func (e *Engine) checkClusterVotes(ctx context.Context, nextEpoch protocol.Epoch, voteState *flow.RootQCVoteState) (bool, error) {
+
+	members, voted, err := e.voter.ClusterVotes(ctx, nextEpoch)
+	if err != nil {
+		return false, fmt.Errorf("could not get cluster votes: %w", err)
+	}
+
+	voteState.ClusterMembers = members
+	voteState.ClusterVoted = voted
+	voteState.LastChecked = time.Now().UTC()
+	e.voteMetrics.RootQCClusterVotes(len(voted), len(members))
+
+	return len(voted) == len(members), nil
+}
+
+// loadRootQCVoteState retrieves the persisted state of our vote for the root
+// QC of the given epoch, or initializes it if none was persisted yet.
+//
+// Returns ErrNotAuthorizedForEpoch if we are not a cluster member in the epoch.
+func (e *Engine) loadRootQCVoteState(epoch protocol.Epoch) (*flow.RootQCVoteState, error) {
+
+	counter, err := epoch.Counter()
+	if err != nil {
+		return nil, fmt.Errorf("could not get epoch counter: %w", err)
+	}
+
+	voteState, err := e.votes.ByEpochCounter(counter)
+	if err == nil {
+		e.voteMetrics.RootQCVoteStatus(voteState.Status)
+		return voteState, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("could not retrieve QC vote state: %w", err)
+	}
+
+	clusters, err := epoch.Clustering()
+	if err != nil {
+		return nil, fmt.Errorf("could not get clustering: %w", err)
+	}
+	cluster, clusterIndex, ok := clusters.ByNodeID(e.me.NodeID())
+	if !ok {
+		return nil, ErrNotAuthorizedForEpoch
+	}
+
+	voteState = &flow.RootQCVoteState{
+		EpochCounter:   counter,
+		ClusterIndex:   clusterIndex,
+		Status:         flow.RootQCVoteStatusUnknown,
+		ClusterMembers: cluster.NodeIDs(),
+	}
+	e.voteMetrics.RootQCVoteStatus(voteState.Status)
+	return voteState, nil
+}
+
+// storeRootQCVoteState persists the state of our vote for the root QC and
+// reports its status to the metrics. Failing to persist the state is not
+// critical, as it only affects resuming the vote after a restart.
+func (e *Engine) storeRootQCVoteState(voteState *flow.RootQCVoteState) {
+	err := e.votes.Store(voteState)
+	if err != nil {
+		e.log.Error().Err(err).Uint64("next_epoch_counter", voteState.EpochCounter).Msg("could not store QC vote state")
+	}
+	e.voteMetrics.RootQCVoteStatus(voteState.Status)
+}
+
+// inSetupPhase returns true if the latest finalized block is in the epoch
+// setup phase. Errors are logged and treated as still being in the setup phase.
+func (e *Engine) inSetupPhase() bool {
+	phase, err := e.state.Final().Phase()
 	if err != nil {
-		e.log.Error().Err(err).Msg("failed to submit QC vote for next epoch")
+		e.log.Error().Err(err).Msg("could not get current phase")
+		return true
 	}
+	return phase == flow.EpochPhaseSetup
 }
 
 // startEpochComponents starts the components for the given epoch and adds them
@@ -1,6 +1,8 @@
 package epochmgr
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
 	"sync"
 	"testing"
@@ -28,6 +30,8 @@ import (
 	realprotocol "github.com/onflow/flow-go/state/protocol"
 	events "github.com/onflow/flow-go/state/protocol/events/mock"
 	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
 	"github.com/onflow/flow-go/utils/unittest"
 	"github.com/onflow/flow-go/utils/unittest/mocks"
 )
@@ -68,6 +72,7 @@ type Suite struct {
 	// engine dependencies
 	log   zerolog.Logger
 	me    *module.Local
+	myID  flow.Identifier
 	state *protocol.State
 	snap  *protocol.Snapshot
 	pools *epochs.TransactionPools
@@ -76,6 +81,7 @@ type Suite struct {
 	signer  *mockhotstuff.Signer
 	client  *module.QCContractClient
 	voter   *module.ClusterRootQCVoter
+	votes   *storagemock.RootQCVotes
 	factory *epochmgr.EpochComponentsFactory
 	heights *events.Heights
 
@@ -84,6 +90,13 @@ type Suite struct {
 	epochs     map[uint64]*protocol.Epoch // track all epochs
 	components map[uint64]*mockComponents // track all epoch components
 
+	cluster            flow.IdentifierList              // our cluster in the next epoch
+	clusterVoted       flow.IdentifierList              // cluster members which have voted in the next epoch
+	clusterVotesErr    error                            // error returned when checking the votes of cluster members
+	clusterVotesChecks int                              // number of times the votes of cluster members were checked
+	voteStates         map[uint64]*flow.RootQCVoteState // persisted root QC vote states
+	voteStateMu        sync.Mutex                       // protects voteStates and the cluster votes
+
 	engine *Engine
 }
 
@@ -91,6 +104,8 @@ func (suite *Suite) SetupTest() {
 
 	suite.log = zerolog.New(ioutil.Discard)
 	suite.me = new(module.Local)
+	suite.myID = unittest.IdentifierFixture()
+	suite.me.On("NodeID").Return(suite.myID)
 	suite.state = new(protocol.State)
 	suite.snap = new(protocol.Snapshot)
 
@@ -100,6 +115,7 @@ func (suite *Suite) SetupTest() {
 	suite.signer = new(mockhotstuff.Signer)
 	suite.client = new(module.QCContractClient)
 	suite.voter = new(module.ClusterRootQCVoter)
+	suite.votes = new(storagemock.RootQCVotes)
 	suite.factory = new(epochmgr.EpochComponentsFactory)
 	suite.heights = new(events.Heights)
 
@@ -127,17 +143,58 @@ func (suite *Suite) SetupTest() {
 	suite.state.On("Final").Return(suite.snap)
 	suite.snap.On("Epochs").Return(suite.epochQuery)
 
-	// add current and next epochs
+	// add current and next epochs, we are a cluster member in the next epoch
 	suite.AddEpoch(suite.counter)
-	suite.AddEpoch(suite.counter + 1)
+	next := suite.AddEpoch(suite.counter + 1)
+	suite.cluster = flow.IdentifierList{suite.myID, unittest.IdentifierFixture()}
+	next.On("Clustering").Return(flow.ClusterList{
+		flow.IdentityList{{NodeID: suite.cluster[0]}, {NodeID: suite.cluster[1]}},
+	}, nil)
+
+	// all cluster members have voted, unless specified otherwise by the test
+	suite.clusterVoted = suite.cluster
+	suite.voter.On("ClusterVotes", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ realprotocol.Epoch) flow.IdentifierList { return suite.cluster },
+		func(_ context.Context, _ realprotocol.Epoch) flow.IdentifierList { return suite.ClusterVoted() },
+		func(_ context.Context, _ realprotocol.Epoch) error {
+			suite.voteStateMu.Lock()
+			defer suite.voteStateMu.Unlock()
+			suite.clusterVotesChecks++
+			return suite.clusterVotesErr
+		},
+	).Maybe()
+
+	// persist root QC vote states in memory
+	suite.voteStates = make(map[uint64]*flow.RootQCVoteState)
+	suite.votes.On("Store", mock.Anything).Return(
+		func(state *flow.RootQCVoteState) error {
+			suite.voteStateMu.Lock()
+			defer suite.voteStateMu.Unlock()
+			stored := *state
+			suite.voteStates[state.EpochCounter] = &stored
+			return nil
+		},
+	)
+	suite.votes.On("ByEpochCounter", mock.Anything).Return(
+		func(counter uint64) *flow.RootQCVoteState {
+			return suite.VoteState(counter)
+		},
+		func(counter uint64) error {
+			if suite.VoteState(counter) == nil {
+				return storage.ErrNotFound
+			}
+			return nil
+		},
+	)
 
 	suite.pools = epochs.NewTransactionPools(func(_ uint64) mempool.Transactions {
 		return herocache.NewTransactions(1000, suite.log, metrics.NewNoopCollector())
 	})
 
 	var err error
-	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.voter, suite.factory, suite.heights)
+	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.voter, suite.votes, metrics.NewNoopCollector(), suite.factory, suite.heights)
 	suite.Require().Nil(err)
+	suite.engine.rootQCVoteRetryInterval = time.Millisecond
 }
 
 func TestEpochManager(t *testing.T) {
@@ -150,6 +207,47 @@ func (suite *Suite) TransitionEpoch() {
 	suite.epochQuery.Transition()
 }
 
+// ClusterVoted returns the cluster members which have voted in the next epoch.
+func (suite *Suite) ClusterVoted() flow.IdentifierList {
+	suite.voteStateMu.Lock()
+	defer suite.voteStateMu.Unlock()
+	return suite.clusterVoted
+}
+
+// SetClusterVoted sets the cluster members which have voted in the next epoch.
+func (suite *Suite) SetClusterVoted(voted flow.IdentifierList) {
+	suite.voteStateMu.Lock()
+	defer suite.voteStateMu.Unlock()
+	suite.clusterVoted = voted
+}
+
+// ClusterVotesChecks returns the number of times the votes of cluster members were checked.
+func (suite *Suite) ClusterVotesChecks() int {
+	suite.voteStateMu.Lock()
+	defer suite.voteStateMu.Unlock()
+	return suite.clusterVotesChecks
+}
+
+// SetClusterVotesErr sets the error returned when checking the votes of cluster members.
+func (suite *Suite) SetClusterVotesErr(err error) {
+	suite.voteStateMu.Lock()
+	defer suite.voteStateMu.Unlock()
+	suite.clusterVotesErr = err
+}
+
+// VoteState returns the persisted root QC vote state for the given epoch, or
+// nil if none was persisted.
+func (suite *Suite) VoteState(counter uint64) *flow.RootQCVoteState {
+	suite.voteStateMu.Lock()
+	defer suite.voteStateMu.Unlock()
+	state, ok := suite.voteStates[counter]
+	if !ok {
+		return nil
+	}
+	stored := *state
+	return &stored
+}
+
 // AddEpoch adds an epoch with the given counter.
 func (suite *Suite) AddEpoch(counter uint64) *protocol.Epoch {
 	epoch := new(protocol.Epoch)
@@ -195,8 +293,9 @@ func (suite *Suite) MockAsUnauthorizedNode() {
 		Return(nil, nil, nil, nil, nil, ErrNotAuthorizedForEpoch)
 
 	var err error
-	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.voter, suite.factory, suite.heights)
+	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.voter, suite.votes, metrics.NewNoopCollector(), suite.factory, suite.heights)
 	suite.Require().Nil(err)
+	suite.engine.rootQCVoteRetryInterval = time.Millisecond
 }
 
 // if we start up during the setup phase, we should kick off the root QC voter
@@ -273,6 +372,143 @@ func (suite *Suite) TestRespondToPhaseChange() {
 	suite.voter.AssertExpectations(suite.T())
 }
 
+// should retry failed votes during the setup phase and persist the vote state
+func (suite *Suite) TestRetryVoteInSetupPhase() {
+
+	suite.snap.On("Phase").Return(flow.EpochPhaseSetup, nil)
+	// the first attempt fails, the second succeeds
+	suite.voter.On("Vote", mock.Anything, suite.epochQuery.Next()).
+		Return(errors.New("transient error")).
+		Once()
+	suite.voter.On("Vote", mock.Anything, suite.epochQuery.Next()).
+		Return(nil).
+		Once()
+
+	unittest.AssertClosesBefore(suite.T(), suite.engine.Ready(), time.Second)
+	suite.Assert().Eventually(func() bool {
+		state := suite.VoteState(suite.counter + 1)
+		return state != nil && !state.LastChecked.IsZero()
+	}, time.Second, time.Millisecond)
+
+	state := suite.VoteState(suite.counter + 1)
+	suite.Assert().Equal(flow.RootQCVoteStatusVoted, state.Status)
+	suite.Assert().Equal(uint(2), state.Attempts)
+	suite.Assert().Empty(state.LastError)
+	suite.Assert().Equal(suite.cluster, state.ClusterMembers)
+	suite.Assert().Equal(suite.cluster, state.ClusterVoted)
+	suite.voter.AssertExpectations(suite.T())
+}
+
+// should not vote again after a restart if our vote has already landed
+func (suite *Suite) TestRestartAfterVoted() {
+
+	suite.snap.On("Phase").Return(flow.EpochPhaseSetup, nil)
+	err := suite.votes.Store(&flow.RootQCVoteState{
+		EpochCounter:   suite.counter + 1,
+		Status:         flow.RootQCVoteStatusVoted,
+		Attempts:       1,
+		ClusterMembers: suite.cluster,
+	})
+	suite.Require().NoError(err)
+
+	unittest.AssertClosesBefore(suite.T(), suite.engine.Ready(), time.Second)
+	suite.Assert().Eventually(func() bool {
+		return !suite.VoteState(suite.counter + 1).LastChecked.IsZero()
+	}, time.Second, time.Millisecond)
+
+	state := suite.VoteState(suite.counter + 1)
+	suite.Assert().Equal(flow.RootQCVoteStatusVoted, state.Status)
+	suite.Assert().Equal(uint(1), state.Attempts)
+	suite.voter.AssertNotCalled(suite.T(), "Vote", mock.Anything, mock.Anything)
+}
+
+// should record a missed vote if the setup phase ends before our vote landed
+func (suite *Suite) TestMissedVote() {
+
+	var phase = flow.EpochPhaseSetup
+	var mu sync.Mutex
+	suite.snap.On("Phase").Return(
+		func() flow.EpochPhase {
+			mu.Lock()
+			defer mu.Unlock()
+			return phase
+		},
+		func() error { return nil },
+	)
+	// the setup phase ends while we are voting
+	suite.voter.On("Vote", mock.Anything, suite.epochQuery.Next()).
+		Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			phase = flow.EpochPhaseCommitted
+		}).
+		Return(errors.New("no longer in setup phase")).
+		Once()
+	suite.SetClusterVoted(suite.cluster[1:])
+
+	unittest.AssertClosesBefore(suite.T(), suite.engine.Ready(), time.Second)
+	suite.Assert().Eventually(func() bool {
+		state := suite.VoteState(suite.counter + 1)
+		return state != nil && state.Status == flow.RootQCVoteStatusMissed
+	}, time.Second, time.Millisecond)
+
+	state := suite.VoteState(suite.counter + 1)
+	suite.Assert().Equal(uint(1), state.Attempts)
+	suite.Assert().Equal("no longer in setup phase", state.LastError)
+	suite.Assert().Equal(suite.cluster[1:], state.ClusterVoted)
+	suite.voter.AssertExpectations(suite.T())
+}
+
+// should check the contract on restart in the committed phase, and record
+// whether our vote landed
+func (suite *Suite) TestRestartInCommittedPhase() {
+
+	suite.snap.On("Phase").Return(flow.EpochPhaseCommitted, nil)
+	// we were restarted while voting, but our vote landed
+	err := suite.votes.Store(&flow.RootQCVoteState{
+		EpochCounter: suite.counter + 1,
+		Status:       flow.RootQCVoteStatusVoting,
+		Attempts:     1,
+	})
+	suite.Require().NoError(err)
+
+	unittest.AssertClosesBefore(suite.T(), suite.engine.Ready(), time.Second)
+	suite.Assert().Eventually(func() bool {
+		return suite.VoteState(suite.counter+1).Status == flow.RootQCVoteStatusVoted
+	}, time.Second, time.Millisecond)
+	suite.voter.AssertNotCalled(suite.T(), "Vote", mock.Anything, mock.Anything)
+}
+
+// should not record a missed vote on restart in the committed phase while the
+// contract can't be queried, as our vote may have landed
+func (suite *Suite) TestRestartInCommittedPhase_ContractUnavailable() {
+
+	suite.snap.On("Phase").Return(flow.EpochPhaseCommitted, nil)
+	// we were restarted after a failed vote attempt, but our vote landed
+	err := suite.votes.Store(&flow.RootQCVoteState{
+		EpochCounter: suite.counter + 1,
+		Status:       flow.RootQCVoteStatusVoting,
+		Attempts:     1,
+		LastError:    "transaction expired",
+	})
+	suite.Require().NoError(err)
+	suite.SetClusterVotesErr(errors.New("access node unavailable"))
+
+	unittest.AssertClosesBefore(suite.T(), suite.engine.Ready(), time.Second)
+	// the vote state remains unresolved while the contract can't be queried
+	suite.Assert().Eventually(func() bool {
+		return suite.ClusterVotesChecks() > 2
+	}, time.Second, time.Millisecond)
+	suite.Assert().Equal(flow.RootQCVoteStatusVoting, suite.VoteState(suite.counter+1).Status)
+
+	// once the contract can be queried, our vote is found
+	suite.SetClusterVotesErr(nil)
+	suite.Assert().Eventually(func() bool {
+		return suite.VoteState(suite.counter+1).Status == flow.RootQCVoteStatusVoted
+	}, time.Second, time.Millisecond)
+	suite.voter.AssertNotCalled(suite.T(), "Vote", mock.Anything, mock.Anything)
+}
+
 func (suite *Suite) TestRespondToEpochTransition() {
 
 	// we are in committed phase
@@ -3,21 +3,20 @@ package factories
 import (
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/module"
 	clusterkv "github.com/onflow/flow-go/state/cluster/badger"
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type ClusterStateFactory struct {
-	db      *badger.DB
+	db      kv.DB
 	metrics module.CacheMetrics
 	tracer  module.Tracer
 }
 
 func NewClusterStateFactory(
-	db *badger.DB,
+	db kv.DB,
 	metrics module.CacheMetrics,
 	tracer module.Tracer,
 ) (*ClusterStateFactory, error) {
@@ -3,7 +3,6 @@ package factories
 import (
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/consensus"
@@ -17,12 +16,14 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff/verification"
 	"github.com/onflow/flow-go/consensus/hotstuff/votecollector"
 	recovery "github.com/onflow/flow-go/consensus/recovery/cluster"
+	"github.com/onflow/flow-go/model/encoding"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	hotmetrics "github.com/onflow/flow-go/module/metrics/hotstuff"
 	"github.com/onflow/flow-go/state/cluster"
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type HotStuffMetricsFunc func(chainID flow.ChainID) module.HotstuffMetrics
@@ -30,18 +31,20 @@ type HotStuffMetricsFunc func(chainID flow.ChainID) module.HotstuffMetrics
 type HotStuffFactory struct {
 	log           zerolog.Logger
 	me            module.Local
-	db            *badger.DB
+	db            kv.DB
 	protoState    protocol.State
 	createMetrics HotStuffMetricsFunc
+	collector     module.SlashingEvidenceCollector
 	opts          []consensus.Option
 }
 
 func NewHotStuffFactory(
 	log zerolog.Logger,
 	me module.Local,
-	db *badger.DB,
+	db kv.DB,
 	protoState protocol.State,
 	createMetrics HotStuffMetricsFunc,
+	collector module.SlashingEvidenceCollector,
 	opts ...consensus.Option,
 ) (*HotStuffFactory, error) {
 
@@ -51,6 +54,7 @@ func NewHotStuffFactory(
 		db:            db,
 		protoState:    protoState,
 		createMetrics: createMetrics,
+		collector:     collector,
 		opts:          opts,
 	}
 	return factory, nil
@@ -73,6 +77,7 @@ func (f *HotStuffFactory) CreateModules(
 	notifier.AddConsumer(notifications.NewLogConsumer(f.log))
 	notifier.AddConsumer(hotmetrics.NewMetricsConsumer(metrics))
 	notifier.AddConsumer(notifications.NewTelemetryConsumer(f.log, cluster.ChainID()))
+	notifier.AddConsumer(notifications.NewSlashingViolationsConsumer(f.log, cluster.ChainID(), headers, f.collector))
 
 	var (
 		err       error
@@ -106,6 +111,7 @@ func (f *HotStuffFactory) CreateModules(
 	}
 
 	qcDistributor := pubsub.NewQCCreatedDistributor()
+	tcDistributor := pubsub.NewTCCreatedDistributor()
 
 	verifier := verification.NewStakingVerifier()
 	validator := validatorImpl.NewMetricsWrapper(validatorImpl.New(committee, forks, verifier), metrics)
@@ -118,6 +124,10 @@ func (f *HotStuffFactory) CreateModules(
 		notifier,
 		voteProcessorFactory,
 		finalizationDistributor,
+		committee,
+		validator,
+		encoding.CollectorTimeoutTag,
+		tcDistributor,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -132,6 +142,7 @@ func (f *HotStuffFactory) CreateModules(
 		Persist:                 persister.New(f.db, cluster.ChainID()),
 		Aggregator:              aggregator,
 		QCCreatedDistributor:    qcDistributor,
+		TCCreatedDistributor:    tcDistributor,
 		FinalizationDistributor: finalizationDistributor,
 	}, metrics, nil
 }
@@ -770,6 +770,13 @@ func MessageToServiceEvent(m *entities.ServiceEvent) (*flow.ServiceEvent, error)
 			return nil, fmt.Errorf("failed to marshal to EpochCommit event: %w", err)
 		}
 		event = commit
+	case flow.ServiceEventRecover:
+		epochRecover := new(flow.EpochRecover)
+		err := json.Unmarshal(rawEvent, epochRecover)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal to EpochRecover event: %w", err)
+		}
+		event = epochRecover
 	default:
 		return nil, fmt.Errorf("invalid event type: %s", m.Type)
 	}
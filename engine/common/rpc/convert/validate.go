@@ -62,6 +62,13 @@ func EventType(eventType string) (string, error) {
 	return eventType, nil
 }
 
+func SlashingEvidenceID(evidenceID []byte) (flow.Identifier, error) {
+	if len(evidenceID) == 0 {
+		return flow.ZeroID, status.Error(codes.InvalidArgument, "invalid slashing evidence id")
+	}
+	return flow.HashToID(evidenceID), nil
+}
+
 func TransactionID(txID []byte) (flow.Identifier, error) {
 	if len(txID) == 0 {
 		return flow.ZeroID, status.Error(codes.InvalidArgument, "invalid transaction id")
@@ -0,0 +1,247 @@
+package slashing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/flow/filter"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/logging"
+)
+
+// Engine persists evidence of slashable protocol violations and disseminates it to the
+// access nodes, which make it available through the Access API.
+//
+// On consensus and collection nodes, the engine is fed with the evidence detected by
+// the local HotStuff instances through AddEvidence. On access nodes, the engine receives
+// the evidence pushed by consensus and collection nodes. Evidence is only stored once
+// the signatures of both conflicting messages have been verified against the offender's
+// staking key, so that forged evidence can not take the place of valid evidence for the
+// same offence. Evidence is deduplicated per offence, i.e. only the first valid evidence
+// for an offender, view, chain and evidence type is stored and forwarded.
+type Engine struct {
+	unit     *engine.Unit
+	log      zerolog.Logger
+	metrics  module.EngineMetrics
+	me       module.Local
+	state    protocol.State
+	con      network.Conduit
+	evidence storage.SlashingEvidence
+	verifier module.SlashingEvidenceVerifier
+}
+
+var _ module.SlashingEvidenceCollector = (*Engine)(nil)
+
+// New creates a new slashing evidence engine.
+func New(log zerolog.Logger, metrics module.EngineMetrics, net network.Network, me module.Local, state protocol.State,
+	evidence storage.SlashingEvidence, verifier module.SlashingEvidenceVerifier) (*Engine, error) {
+
+	e := &Engine{
+		unit:     engine.NewUnit(),
+		log:      log.With().Str("engine", "slashing").Logger(),
+		metrics:  metrics,
+		me:       me,
+		state:    state,
+		evidence: evidence,
+		verifier: verifier,
+	}
+
+	// register the engine with the network layer and store the conduit
+	con, err := net.Register(engine.PushSlashingEvidence, e)
+	if err != nil {
+		return nil, fmt.Errorf("could not register engine: %w", err)
+	}
+	e.con = con
+
+	return e, nil
+}
+
+// Ready returns a ready channel that is closed once the engine has fully started.
+func (e *Engine) Ready() <-chan struct{} {
+	return e.unit.Ready()
+}
+
+// Done returns a done channel that is closed once the engine has fully stopped.
+func (e *Engine) Done() <-chan struct{} {
+	return e.unit.Done()
+}
+
+// AddEvidence stores evidence detected by the local node and forwards it to the access
+// nodes. It returns instantly and logs a potential processing error internally when done.
+func (e *Engine) AddEvidence(evidence *flow.SlashingEvidence) {
+	e.unit.Launch(func() {
+		err := e.onLocalEvidence(evidence)
+		if err != nil {
+			e.log.Error().Err(err).
+				Hex("evidence_id", logging.ID(evidence.ID())).
+				Msg("could not process local slashing evidence")
+		}
+	})
+}
+
+// SubmitLocal submits a message originating on the local node.
+func (e *Engine) SubmitLocal(message interface{}) {
+	e.unit.Launch(func() {
+		err := e.ProcessLocal(message)
+		if err != nil {
+			engine.LogError(e.log, err)
+		}
+	})
+}
+
+// Submit submits the given message from the node with the given origin ID
+// for processing in a non-blocking manner. It returns instantly and logs
+// a potential processing error internally when done.
+func (e *Engine) Submit(channel network.Channel, originID flow.Identifier, message interface{}) {
+	e.unit.Launch(func() {
+		err := e.Process(channel, originID, message)
+		if err != nil {
+			engine.LogError(e.log, err)
+		}
+	})
+}
+
+// ProcessLocal processes a message originating on the local node.
+func (e *Engine) ProcessLocal(message interface{}) error {
+	return e.unit.Do(func() error {
+		evidence, ok := message.(*flow.SlashingEvidence)
+		if !ok {
+			return engine.NewInvalidInputErrorf("invalid message type (%T)", message)
+		}
+		return e.onLocalEvidence(evidence)
+	})
+}
+
+// Process processes the given message from the node with the given origin ID in
+// a blocking manner. It returns the potential processing error when done.
+func (e *Engine) Process(channel network.Channel, originID flow.Identifier, message interface{}) error {
+	return e.unit.Do(func() error {
+		evidence, ok := message.(*flow.SlashingEvidence)
+		if !ok {
+			return engine.NewInvalidInputErrorf("invalid message type (%T)", message)
+		}
+		return e.onRemoteEvidence(originID, evidence)
+	})
+}
+
+// onLocalEvidence stores evidence detected by the local node and, unless evidence
+// for the same offence was stored before, forwards it to all access nodes. HotStuff
+// may detect conflicting messages before verifying their signatures, so evidence with
+// invalid signatures is dropped.
+func (e *Engine) onLocalEvidence(evidence *flow.SlashingEvidence) error {
+	err := e.verify(evidence)
+	if engine.IsInvalidInputError(err) {
+		e.log.Warn().Err(err).
+			Hex("evidence_id", logging.ID(evidence.ID())).
+			Msg("dropping invalid local slashing evidence")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	stored, err := e.store(evidence)
+	if err != nil {
+		return err
+	}
+	if !stored {
+		return nil
+	}
+
+	recipients, err := e.state.Final().Identities(filter.And(
+		filter.HasRole(flow.RoleAccess),
+		filter.Not(filter.HasNodeID(e.me.NodeID())),
+	))
+	if err != nil {
+		return fmt.Errorf("could not get access nodes: %w", err)
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	err = e.con.Publish(evidence, recipients.NodeIDs()...)
+	if err != nil {
+		return fmt.Errorf("could not push slashing evidence: %w", err)
+	}
+	e.metrics.MessageSent(metrics.EngineSlashingEvidence, metrics.MessageSlashingEvidence)
+
+	return nil
+}
+
+// onRemoteEvidence stores evidence received from another node. Only staked consensus
+// and collection nodes, which run HotStuff and hence detect violations, may push evidence.
+func (e *Engine) onRemoteEvidence(originID flow.Identifier, evidence *flow.SlashingEvidence) error {
+
+	e.metrics.MessageReceived(metrics.EngineSlashingEvidence, metrics.MessageSlashingEvidence)
+	defer e.metrics.MessageHandled(metrics.EngineSlashingEvidence, metrics.MessageSlashingEvidence)
+
+	origins, err := e.state.Final().Identities(filter.And(
+		filter.HasRole(flow.RoleConsensus, flow.RoleCollection),
+		filter.HasWeight(true),
+		filter.HasNodeID(originID),
+	))
+	if err != nil {
+		return fmt.Errorf("could not get origin identity: %w", err)
+	}
+	if len(origins) == 0 {
+		return engine.NewInvalidInputErrorf("invalid slashing evidence origin (%x)", originID)
+	}
+
+	err = e.verify(evidence)
+	if err != nil {
+		return fmt.Errorf("could not verify slashing evidence from %x: %w", originID, err)
+	}
+
+	_, err = e.store(evidence)
+	return err
+}
+
+// verify checks that the evidence is well-formed and that both conflicting messages were
+// signed by the offender. It returns an engine.InvalidInputError for invalid evidence.
+func (e *Engine) verify(evidence *flow.SlashingEvidence) error {
+	err := evidence.Validate()
+	if err != nil {
+		return engine.NewInvalidInputErrorf("malformed slashing evidence: %v", err)
+	}
+	valid, err := e.verifier.Verify(evidence)
+	if err != nil {
+		return fmt.Errorf("could not verify slashing evidence signatures: %w", err)
+	}
+	if !valid {
+		return engine.NewInvalidInputErrorf("slashing evidence %x has an invalid signature", evidence.ID())
+	}
+	return nil
+}
+
+// store persists the evidence. It returns false if evidence for the same offence was
+// stored before, in which case the evidence is dropped.
+func (e *Engine) store(evidence *flow.SlashingEvidence) (bool, error) {
+	err := e.evidence.Store(evidence)
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		e.log.Debug().
+			Hex("evidence_id", logging.ID(evidence.ID())).
+			Msg("skipping duplicate slashing evidence")
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not store slashing evidence: %w", err)
+	}
+
+	e.log.Warn().
+		Hex("evidence_id", logging.ID(evidence.ID())).
+		Str("type", string(evidence.Type)).
+		Str("chain_id", evidence.ChainID.String()).
+		Uint64("view", evidence.View).
+		Hex("offender_id", logging.ID(evidence.OffenderID)).
+		Msg("stored slashing evidence")
+
+	return true, nil
+}
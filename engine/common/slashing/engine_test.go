@@ -0,0 +1,181 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	module "github.com/onflow/flow-go/module/mock"
+	"github.com/onflow/flow-go/network/mocknetwork"
+	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestSlashingEngine(t *testing.T) {
+	suite.Run(t, new(SlashingSuite))
+}
+
+type SlashingSuite struct {
+	suite.Suite
+
+	identities flow.IdentityList
+	me         *flow.Identity
+	con        *mocknetwork.Conduit
+	evidence   *storagemock.SlashingEvidence
+	verifier   *module.SlashingEvidenceVerifier
+	engine     *Engine
+}
+
+func (s *SlashingSuite) SetupTest() {
+	s.identities = unittest.CompleteIdentitySet()
+	s.identities = append(s.identities, unittest.IdentityFixture(unittest.WithRole(flow.RoleAccess)))
+	s.me = s.identities.Filter(func(identity *flow.Identity) bool {
+		return identity.Role == flow.RoleConsensus
+	})[0]
+
+	final := &protocol.Snapshot{}
+	final.On("Identities", mock.Anything).Return(
+		func(selector flow.IdentityFilter) flow.IdentityList {
+			return s.identities.Filter(selector)
+		},
+		nil,
+	)
+	state := &protocol.State{}
+	state.On("Final").Return(final)
+
+	me := &module.Local{}
+	me.On("NodeID").Return(s.me.NodeID)
+
+	s.con = &mocknetwork.Conduit{}
+	net := &mocknetwork.Network{}
+	net.On("Register", engine.PushSlashingEvidence, mock.Anything).Return(s.con, nil)
+
+	s.evidence = &storagemock.SlashingEvidence{}
+	s.verifier = &module.SlashingEvidenceVerifier{}
+
+	var err error
+	s.engine, err = New(zerolog.Nop(), metrics.NewNoopCollector(), net, me, state, s.evidence, s.verifier)
+	require.NoError(s.T(), err)
+}
+
+// TestLocalEvidence checks that new evidence detected locally is stored and pushed to the access nodes.
+func (s *SlashingSuite) TestLocalEvidence() {
+	evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+	accessIDs := s.identities.Filter(func(identity *flow.Identity) bool {
+		return identity.Role == flow.RoleAccess
+	}).NodeIDs()
+
+	s.verifier.On("Verify", evidence).Return(true, nil).Once()
+	s.evidence.On("Store", evidence).Return(nil).Once()
+	args := []interface{}{evidence}
+	for _, accessID := range accessIDs {
+		args = append(args, accessID)
+	}
+	s.con.On("Publish", args...).Return(nil).Once()
+
+	err := s.engine.ProcessLocal(evidence)
+	require.NoError(s.T(), err)
+
+	s.evidence.AssertExpectations(s.T())
+	s.con.AssertExpectations(s.T())
+}
+
+// TestLocalEvidence_Duplicate checks that evidence for an already known offence is not pushed again.
+func (s *SlashingSuite) TestLocalEvidence_Duplicate() {
+	evidence := unittest.DoubleProposalEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+
+	s.verifier.On("Verify", evidence).Return(true, nil).Once()
+	s.evidence.On("Store", evidence).Return(storage.ErrAlreadyExists).Once()
+
+	err := s.engine.ProcessLocal(evidence)
+	require.NoError(s.T(), err)
+
+	s.evidence.AssertExpectations(s.T())
+	s.con.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+// TestLocalEvidence_InvalidSignature checks that local evidence with an invalid signature is
+// neither stored nor pushed to the access nodes.
+func (s *SlashingSuite) TestLocalEvidence_InvalidSignature() {
+	evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+
+	s.verifier.On("Verify", evidence).Return(false, nil).Once()
+
+	err := s.engine.ProcessLocal(evidence)
+	require.NoError(s.T(), err)
+
+	s.verifier.AssertExpectations(s.T())
+	s.evidence.AssertNotCalled(s.T(), "Store", mock.Anything)
+	s.con.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+// TestRemoteEvidence checks that well-formed evidence pushed by a staked consensus or
+// collection node is stored.
+func (s *SlashingSuite) TestRemoteEvidence() {
+	for _, role := range []flow.Role{flow.RoleConsensus, flow.RoleCollection} {
+		origin := s.identities.Filter(func(identity *flow.Identity) bool {
+			return identity.Role == role
+		})[0]
+		evidence := unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+
+		s.verifier.On("Verify", evidence).Return(true, nil).Once()
+		s.evidence.On("Store", evidence).Return(nil).Once()
+
+		err := s.engine.Process(engine.ReceiveSlashingEvidence, origin.NodeID, evidence)
+		require.NoError(s.T(), err)
+	}
+
+	s.evidence.AssertExpectations(s.T())
+	s.con.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+// TestRemoteEvidence_InvalidOrigin checks that evidence from nodes, which do not participate
+// in consensus, or from unknown nodes is rejected.
+func (s *SlashingSuite) TestRemoteEvidence_InvalidOrigin() {
+	evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+	execution := s.identities.Filter(func(identity *flow.Identity) bool {
+		return identity.Role == flow.RoleExecution
+	})[0]
+
+	err := s.engine.Process(engine.ReceiveSlashingEvidence, execution.NodeID, evidence)
+	require.True(s.T(), engine.IsInvalidInputError(err))
+
+	err = s.engine.Process(engine.ReceiveSlashingEvidence, unittest.IdentifierFixture(), evidence)
+	require.True(s.T(), engine.IsInvalidInputError(err))
+
+	s.evidence.AssertNotCalled(s.T(), "Store", mock.Anything)
+}
+
+// TestRemoteEvidence_Malformed checks that malformed evidence is rejected.
+func (s *SlashingSuite) TestRemoteEvidence_Malformed() {
+	evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+	evidence.Votes[1].BlockID = evidence.Votes[0].BlockID
+
+	err := s.engine.Process(engine.ReceiveSlashingEvidence, s.me.NodeID, evidence)
+	require.True(s.T(), engine.IsInvalidInputError(err))
+
+	s.verifier.AssertNotCalled(s.T(), "Verify", mock.Anything)
+	s.evidence.AssertNotCalled(s.T(), "Store", mock.Anything)
+}
+
+// TestRemoteEvidence_InvalidSignature checks that evidence with an invalid signature is rejected
+// before it is stored, so that it can not take the place of valid evidence for the same offence.
+func (s *SlashingSuite) TestRemoteEvidence_InvalidSignature() {
+	evidence := unittest.DoubleProposalEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+
+	s.verifier.On("Verify", evidence).Return(false, nil).Once()
+
+	err := s.engine.Process(engine.ReceiveSlashingEvidence, s.me.NodeID, evidence)
+	require.True(s.T(), engine.IsInvalidInputError(err))
+
+	s.verifier.AssertExpectations(s.T())
+	s.evidence.AssertNotCalled(s.T(), "Store", mock.Anything)
+}
@@ -0,0 +1,94 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/signature"
+	"github.com/onflow/flow-go/consensus/hotstuff/verification"
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/model/encoding"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/flow/filter"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/state/protocol"
+)
+
+// Verifier verifies the signatures of slashing evidence against the offender's staking key.
+// Votes and proposals of the main consensus carry a combined signature, of which only the
+// staking signature is verified; the random beacon share is not needed to prove the offence.
+type Verifier struct {
+	state protocol.State
+}
+
+var _ module.SlashingEvidenceVerifier = (*Verifier)(nil)
+
+// NewVerifier creates a new slashing evidence verifier.
+func NewVerifier(state protocol.State) *Verifier {
+	return &Verifier{
+		state: state,
+	}
+}
+
+// Verify checks the signatures of both conflicting messages against the offender's staking
+// key. It returns false if a signature is invalid or the offender is not a known node.
+func (v *Verifier) Verify(evidence *flow.SlashingEvidence) (bool, error) {
+
+	offenders, err := v.state.Final().Identities(filter.HasNodeID(evidence.OffenderID))
+	if err != nil {
+		return false, fmt.Errorf("could not get offender identity: %w", err)
+	}
+	if len(offenders) == 0 {
+		return false, nil
+	}
+	stakingKey := offenders[0].StakingPubKey
+
+	// the main consensus signs with the consensus tags and combines the staking signature
+	// with a random beacon share, while collector clusters only use staking signatures
+	chainID, err := v.state.Params().ChainID()
+	if err != nil {
+		return false, fmt.Errorf("could not get chain ID: %w", err)
+	}
+	mainConsensus := evidence.ChainID == chainID
+	voteTag, timeoutTag := encoding.CollectorVoteTag, encoding.CollectorTimeoutTag
+	if mainConsensus {
+		voteTag, timeoutTag = encoding.ConsensusVoteTag, encoding.ConsensusTimeoutTag
+	}
+
+	verifyVote := func(view uint64, blockID flow.Identifier, sigData []byte) (bool, error) {
+		stakingSig := crypto.Signature(sigData)
+		if mainConsensus {
+			stakingSig, _, err = signature.DecodeDoubleSig(sigData)
+			if err != nil {
+				return false, nil
+			}
+		}
+		msg := verification.MakeVoteMessage(view, blockID)
+		return stakingKey.Verify(stakingSig, msg, crypto.NewBLSKMAC(voteTag))
+	}
+
+	for i := 0; i < 2; i++ {
+		var valid bool
+		switch evidence.Type {
+		case flow.SlashingEvidenceDoubleProposal:
+			header := evidence.Proposals[i]
+			valid, err = verifyVote(header.View, header.ID(), header.ProposerSigData)
+		case flow.SlashingEvidenceDoubleVote:
+			vote := evidence.Votes[i]
+			valid, err = verifyVote(vote.View, vote.BlockID, vote.SigData)
+		case flow.SlashingEvidenceDoubleTimeout:
+			timeout := evidence.Timeouts[i]
+			msg := verification.MakeTimeoutMessage(timeout.View, timeout.NewestQC.View)
+			valid, err = stakingKey.Verify(timeout.SigData, msg, crypto.NewBLSKMAC(timeoutTag))
+		default:
+			return false, fmt.Errorf("unknown slashing evidence type %q", evidence.Type)
+		}
+		if err != nil {
+			return false, fmt.Errorf("could not verify signature of conflicting message %d: %w", i, err)
+		}
+		if !valid {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
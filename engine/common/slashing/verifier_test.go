@@ -0,0 +1,108 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/consensus/hotstuff/signature"
+	"github.com/onflow/flow-go/consensus/hotstuff/verification"
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/model/encoding"
+	"github.com/onflow/flow-go/model/flow"
+	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestVerifier checks that the verifier accepts evidence signed by the offender's staking key,
+// for the main consensus as well as for cluster consensus, and rejects any other evidence.
+func TestVerifier(t *testing.T) {
+	stakingPriv := unittest.StakingPrivKeyFixture()
+	offender := unittest.IdentityFixture(unittest.WithStakingPubKey(stakingPriv.PublicKey()))
+	clusterChainID := flow.ChainID("cluster-chain")
+
+	final := &protocol.Snapshot{}
+	final.On("Identities", mock.Anything).Return(
+		func(selector flow.IdentityFilter) flow.IdentityList {
+			return flow.IdentityList{offender}.Filter(selector)
+		},
+		nil,
+	)
+	params := &protocol.Params{}
+	params.On("ChainID").Return(flow.Emulator, nil)
+	state := &protocol.State{}
+	state.On("Final").Return(final)
+	state.On("Params").Return(params)
+
+	verifier := NewVerifier(state)
+
+	sign := func(key crypto.PrivateKey, msg []byte, tag string) crypto.Signature {
+		sig, err := key.Sign(msg, crypto.NewBLSKMAC(tag))
+		require.NoError(t, err)
+		return sig
+	}
+
+	t.Run("double vote on main consensus", func(t *testing.T) {
+		evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender.NodeID)
+		for _, vote := range evidence.Votes {
+			stakingSig := sign(stakingPriv, verification.MakeVoteMessage(vote.View, vote.BlockID), encoding.ConsensusVoteTag)
+			vote.SigData = signature.EncodeDoubleSig(stakingSig, unittest.SignatureFixture())
+		}
+
+		valid, err := verifier.Verify(evidence)
+		require.NoError(t, err)
+		require.True(t, valid)
+	})
+
+	t.Run("double proposal on cluster consensus", func(t *testing.T) {
+		evidence := unittest.DoubleProposalEvidenceFixture(clusterChainID, offender.NodeID)
+		for _, header := range evidence.Proposals {
+			header.ProposerSigData = sign(stakingPriv, verification.MakeVoteMessage(header.View, header.ID()), encoding.CollectorVoteTag)
+		}
+
+		valid, err := verifier.Verify(evidence)
+		require.NoError(t, err)
+		require.True(t, valid)
+	})
+
+	t.Run("double timeout on main consensus", func(t *testing.T) {
+		evidence := unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, offender.NodeID)
+		for _, timeout := range evidence.Timeouts {
+			timeout.SigData = sign(stakingPriv, verification.MakeTimeoutMessage(timeout.View, timeout.NewestQC.View), encoding.ConsensusTimeoutTag)
+		}
+
+		valid, err := verifier.Verify(evidence)
+		require.NoError(t, err)
+		require.True(t, valid)
+	})
+
+	t.Run("one signature not by the offender", func(t *testing.T) {
+		evidence := unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, offender.NodeID)
+		timeout := evidence.Timeouts[0]
+		timeout.SigData = sign(stakingPriv, verification.MakeTimeoutMessage(timeout.View, timeout.NewestQC.View), encoding.ConsensusTimeoutTag)
+
+		valid, err := verifier.Verify(evidence)
+		require.NoError(t, err)
+		require.False(t, valid)
+	})
+
+	t.Run("signature for the wrong consensus instance", func(t *testing.T) {
+		evidence := unittest.DoubleTimeoutEvidenceFixture(clusterChainID, offender.NodeID)
+		for _, timeout := range evidence.Timeouts {
+			timeout.SigData = sign(stakingPriv, verification.MakeTimeoutMessage(timeout.View, timeout.NewestQC.View), encoding.ConsensusTimeoutTag)
+		}
+
+		valid, err := verifier.Verify(evidence)
+		require.NoError(t, err)
+		require.False(t, valid)
+	})
+
+	t.Run("unknown offender", func(t *testing.T) {
+		evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, unittest.IdentifierFixture())
+
+		valid, err := verifier.Verify(evidence)
+		require.NoError(t, err)
+		require.False(t, valid)
+	})
+}
@@ -23,6 +23,7 @@ type ApprovalCollector struct {
 	aggregatedSignatures *AggregatedSignatures           // aggregated signature for each chunk
 	seals                mempool.IncorporatedResultSeals // holds candidate seals for incorporated results that have acquired sufficient approvals; candidate seals are constructed  without consideration of the sealability of parent results
 	numberOfChunks       uint64                          // number of chunks for execution result, remains constant
+	sealingParams        flow.SealingParameters          // sealing parameters in effect for the incorporating block
 }
 
 func NewApprovalCollector(
@@ -32,8 +33,9 @@ func NewApprovalCollector(
 	executedBlock *flow.Header,
 	assignment *chunks.Assignment,
 	seals mempool.IncorporatedResultSeals,
-	requiredApprovalsForSealConstruction uint,
+	sealingParams flow.SealingParameters,
 ) (*ApprovalCollector, error) {
+	requiredApprovalsForSealConstruction := sealingParams.RequiredApprovalsForSealConstruction
 	chunkCollectors := make([]*ChunkApprovalCollector, 0, result.Result.Chunks.Len())
 	for _, chunk := range result.Result.Chunks {
 		chunkAssignment := assignment.Verifiers(chunk).Lookup()
@@ -59,6 +61,7 @@ func NewApprovalCollector(
 		chunkCollectors:      chunkCollectors,
 		aggregatedSignatures: aggSigs,
 		seals:                seals,
+		sealingParams:        sealingParams,
 	}
 
 	// The following code implements a TEMPORARY SHORTCUT: In case no approvals are required
@@ -92,6 +95,11 @@ func (c *ApprovalCollector) IncorporatedBlock() *flow.Header {
 	return c.incorporatedBlock
 }
 
+// SealingParameters returns the sealing parameters in effect for the incorporating block.
+func (c *ApprovalCollector) SealingParameters() flow.SealingParameters {
+	return c.sealingParams
+}
+
 // IncorporatedResult returns the incorporated Result this ApprovalCollector is for
 func (c *ApprovalCollector) IncorporatedResult() *flow.IncorporatedResult {
 	return c.incorporatedResult
@@ -33,8 +33,11 @@ func (s *ApprovalCollectorTestSuite) SetupTest() {
 	s.BaseApprovalsTestSuite.SetupTest()
 	s.sealsPL = &mempool.IncorporatedResultSeals{}
 
+	sealingParams := flow.DefaultSealingParameters()
+	sealingParams.RequiredApprovalsForSealConstruction = uint(len(s.AuthorizedVerifiers))
+
 	var err error
-	s.collector, err = NewApprovalCollector(unittest.Logger(), s.IncorporatedResult, &s.IncorporatedBlock, &s.Block, s.ChunksAssignment, s.sealsPL, uint(len(s.AuthorizedVerifiers)))
+	s.collector, err = NewApprovalCollector(unittest.Logger(), s.IncorporatedResult, &s.IncorporatedBlock, &s.Block, s.ChunksAssignment, s.sealsPL, sealingParams)
 	require.NoError(s.T(), err)
 }
 
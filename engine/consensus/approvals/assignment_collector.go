@@ -91,6 +91,11 @@ type AssignmentCollectorState interface {
 	// during normal operations.
 	RequestMissingApprovals(observer consensus.SealingObservation, maxHeightForRequesting uint64) (uint, error)
 
+	// ObserveMissingApprovals reports the chunks that are missing approvals, together with the
+	// assigned verifiers whose approvals are missing, to the observer. In contrast to
+	// RequestMissingApprovals, no approvals are requested.
+	ObserveMissingApprovals(observer consensus.SealingObservation)
+
 	// ProcessingStatus returns the AssignmentCollector's ProcessingStatus (state descriptor).
 	ProcessingStatus() ProcessingStatus
 }
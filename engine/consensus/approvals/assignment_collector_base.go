@@ -21,15 +21,14 @@ import (
 type AssignmentCollectorBase struct {
 	log zerolog.Logger
 
-	workerPool                           *workerpool.WorkerPool
-	assigner                             module.ChunkAssigner            // component for computing chunk assignments
-	state                                protocol.State                  // protocol state
-	headers                              storage.Headers                 // used to query headers from storage
-	sigHasher                            hash.Hasher                     // used to verify result approval signatures
-	seals                                mempool.IncorporatedResultSeals // holds candidate seals for incorporated results that have acquired sufficient approvals; candidate seals are constructed  without consideration of the sealability of parent results
-	approvalConduit                      network.Conduit                 // used to request missing approvals from verification nodes
-	requestTracker                       *RequestTracker                 // used to keep track of number of approval requests, and blackout periods, by chunk
-	requiredApprovalsForSealConstruction uint                            // number of approvals that are required for each chunk to be sealed
+	workerPool      *workerpool.WorkerPool
+	assigner        module.ChunkAssigner            // component for computing chunk assignments
+	state           protocol.State                  // protocol state, also determines the sealing parameters
+	headers         storage.Headers                 // used to query headers from storage
+	sigHasher       hash.Hasher                     // used to verify result approval signatures
+	seals           mempool.IncorporatedResultSeals // holds candidate seals for incorporated results that have acquired sufficient approvals; candidate seals are constructed  without consideration of the sealability of parent results
+	approvalConduit network.Conduit                 // used to request missing approvals from verification nodes
+	requestTracker  *RequestTracker                 // used to keep track of number of approval requests, and blackout periods, by chunk
 
 	result        *flow.ExecutionResult // execution result
 	resultID      flow.Identifier       // ID of execution result
@@ -46,7 +45,6 @@ func NewAssignmentCollectorBase(logger zerolog.Logger,
 	sigHasher hash.Hasher,
 	approvalConduit network.Conduit,
 	requestTracker *RequestTracker,
-	requiredApprovalsForSealConstruction uint,
 ) (AssignmentCollectorBase, error) {
 	executedBlock, err := headers.ByBlockID(result.BlockID)
 	if err != nil {
@@ -54,19 +52,18 @@ func NewAssignmentCollectorBase(logger zerolog.Logger,
 	}
 
 	return AssignmentCollectorBase{
-		log:                                  logger,
-		workerPool:                           workerPool,
-		assigner:                             assigner,
-		state:                                state,
-		headers:                              headers,
-		sigHasher:                            sigHasher,
-		seals:                                seals,
-		approvalConduit:                      approvalConduit,
-		requestTracker:                       requestTracker,
-		requiredApprovalsForSealConstruction: requiredApprovalsForSealConstruction,
-		result:                               result,
-		resultID:                             result.ID(),
-		executedBlock:                        executedBlock,
+		log:             logger,
+		workerPool:      workerPool,
+		assigner:        assigner,
+		state:           state,
+		headers:         headers,
+		sigHasher:       sigHasher,
+		seals:           seals,
+		approvalConduit: approvalConduit,
+		requestTracker:  requestTracker,
+		result:          result,
+		resultID:        result.ID(),
+		executedBlock:   executedBlock,
 	}, nil
 }
 
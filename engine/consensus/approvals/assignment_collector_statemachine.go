@@ -121,6 +121,13 @@ func (asm *AssignmentCollectorStateMachine) RequestMissingApprovals(observer con
 	return collector.RequestMissingApprovals(observer, maxHeightForRequesting)
 }
 
+// ObserveMissingApprovals reports the chunks that are missing approvals, together with the
+// assigned verifiers whose approvals are missing, to the observer.
+func (asm *AssignmentCollectorStateMachine) ObserveMissingApprovals(observer consensus.SealingObservation) {
+	collector := asm.atomicLoadCollector()
+	collector.ObserveMissingApprovals(observer)
+}
+
 // ProcessingStatus returns the AssignmentCollector's ProcessingStatus (state descriptor).
 func (asm *AssignmentCollectorStateMachine) ProcessingStatus() ProcessingStatus {
 	collector := asm.atomicLoadCollector()
@@ -30,18 +30,17 @@ func (s *AssignmentCollectorStateMachineTestSuite) SetupTest() {
 	s.BaseAssignmentCollectorTestSuite.SetupTest()
 
 	s.collector = NewAssignmentCollectorStateMachine(AssignmentCollectorBase{
-		workerPool:                           workerpool.New(4),
-		assigner:                             s.Assigner,
-		state:                                s.State,
-		headers:                              s.Headers,
-		sigHasher:                            s.SigHasher,
-		seals:                                s.SealsPL,
-		approvalConduit:                      s.Conduit,
-		requestTracker:                       s.RequestTracker,
-		requiredApprovalsForSealConstruction: 5,
-		executedBlock:                        &s.Block,
-		result:                               s.IncorporatedResult.Result,
-		resultID:                             s.IncorporatedResult.Result.ID(),
+		workerPool:      workerpool.New(4),
+		assigner:        s.Assigner,
+		state:           s.State,
+		headers:         s.Headers,
+		sigHasher:       s.SigHasher,
+		seals:           s.SealsPL,
+		approvalConduit: s.Conduit,
+		requestTracker:  s.RequestTracker,
+		executedBlock:   &s.Block,
+		result:          s.IncorporatedResult.Result,
+		resultID:        s.IncorporatedResult.Result.ID(),
 	})
 }
 
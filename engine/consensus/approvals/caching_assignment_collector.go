@@ -35,6 +35,7 @@ func (ac *CachingAssignmentCollector) CheckEmergencySealing(consensus.SealingObs
 func (ac *CachingAssignmentCollector) RequestMissingApprovals(consensus.SealingObservation, uint64) (uint, error) {
 	return 0, nil
 }
+func (ac *CachingAssignmentCollector) ObserveMissingApprovals(consensus.SealingObservation) {}
 
 // ProcessIncorporatedResult starts tracking the approval for IncorporatedResult.
 // Method is idempotent.
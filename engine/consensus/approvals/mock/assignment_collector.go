@@ -76,6 +76,11 @@ func (_m *AssignmentCollector) CheckEmergencySealing(observer consensus.SealingO
 	return r0
 }
 
+// ObserveMissingApprovals provides a mock function with given fields: observer
+func (_m *AssignmentCollector) ObserveMissingApprovals(observer consensus.SealingObservation) {
+	_m.Called(observer)
+}
+
 // ProcessApproval provides a mock function with given fields: approval
 func (_m *AssignmentCollector) ProcessApproval(approval *flow.ResultApproval) error {
 	ret := _m.Called(approval)
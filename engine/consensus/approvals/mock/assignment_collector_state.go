@@ -62,6 +62,11 @@ func (_m *AssignmentCollectorState) CheckEmergencySealing(observer consensus.Sea
 	return r0
 }
 
+// ObserveMissingApprovals provides a mock function with given fields: observer
+func (_m *AssignmentCollectorState) ObserveMissingApprovals(observer consensus.SealingObservation) {
+	_m.Called(observer)
+}
+
 // ProcessApproval provides a mock function with given fields: approval
 func (_m *AssignmentCollectorState) ProcessApproval(approval *flow.ResultApproval) error {
 	ret := _m.Called(approval)
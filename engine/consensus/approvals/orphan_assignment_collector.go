@@ -23,6 +23,7 @@ func (oc *OrphanAssignmentCollector) CheckEmergencySealing(consensus.SealingObse
 func (oc *OrphanAssignmentCollector) RequestMissingApprovals(consensus.SealingObservation, uint64) (uint, error) {
 	return 0, nil
 }
+func (oc *OrphanAssignmentCollector) ObserveMissingApprovals(consensus.SealingObservation) {}
 func (oc *OrphanAssignmentCollector) ProcessIncorporatedResult(*flow.IncorporatedResult) error {
 	return nil
 }
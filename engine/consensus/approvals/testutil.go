@@ -91,6 +91,7 @@ type BaseAssignmentCollectorTestSuite struct {
 	FinalizedAtHeight map[uint64]*flow.Header
 	IdentitiesCache   map[flow.Identifier]map[flow.Identifier]*flow.Identity // helper map to store identities for given block
 	RequestTracker    *RequestTracker
+	SealingParameters flow.SealingParameters // sealing parameters returned by the protocol state for known blocks
 }
 
 func (s *BaseAssignmentCollectorTestSuite) SetupTest() {
@@ -105,6 +106,9 @@ func (s *BaseAssignmentCollectorTestSuite) SetupTest() {
 
 	s.RequestTracker = NewRequestTracker(s.Headers, 1, 3)
 
+	s.SealingParameters = flow.DefaultSealingParameters()
+	s.SealingParameters.RequiredApprovalsForSealConstruction = uint(len(s.AuthorizedVerifiers))
+
 	s.FinalizedAtHeight = make(map[uint64]*flow.Header)
 	s.FinalizedAtHeight[s.ParentBlock.Height] = &s.ParentBlock
 	s.FinalizedAtHeight[s.Block.Height] = &s.Block
@@ -158,6 +162,10 @@ func (s *BaseAssignmentCollectorTestSuite) SetupTest() {
 			}
 			if block, found := s.Blocks[blockID]; found {
 				snapshot := unittest.StateSnapshotForKnownBlock(block, s.IdentitiesCache[blockID])
+				snapshot.On("SealingParameters").Return(
+					func() flow.SealingParameters { return s.SealingParameters },
+					nil,
+				)
 				s.Snapshots[blockID] = snapshot
 				return snapshot
 			}
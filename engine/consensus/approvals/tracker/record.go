@@ -23,13 +23,22 @@ type SealingRecord struct {
 
 	// entries holds the individual entries of the sealing record
 	entries Rec
+
+	// structured counterparts of the entries, from which the SealingStatus is compiled
+	emergencySealable  bool
+	approvalsChecked   bool
+	missingApprovals   map[uint64]flow.IdentifierList
+	requestedApprovals uint
 }
 
 func (r *SealingRecord) QualifiesForEmergencySealing(emergencySealable bool) {
+	r.emergencySealable = emergencySealable
 	r.entries["qualifies_for_emergency_sealing"] = emergencySealable
 }
 
 func (r *SealingRecord) ApprovalsMissing(chunksWithMissingApprovals map[uint64]flow.IdentifierList) {
+	r.approvalsChecked = true
+	r.missingApprovals = chunksWithMissingApprovals
 	sufficientApprovals := len(chunksWithMissingApprovals) == 0
 	r.entries["sufficient_approvals_for_sealing"] = sufficientApprovals
 	if !sufficientApprovals {
@@ -49,6 +58,7 @@ func (r *SealingRecord) ApprovalsMissing(chunksWithMissingApprovals map[uint64]f
 }
 
 func (r *SealingRecord) ApprovalsRequested(requestCount uint) {
+	r.requestedApprovals = requestCount
 	r.entries["number_requested_approvals"] = requestCount
 }
 
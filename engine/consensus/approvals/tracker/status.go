@@ -0,0 +1,236 @@
+package tracker
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// MaxStatusBlocks is the maximum number of unsealed blocks listed in a SealingStatus. When sealing
+// halts, the number of unsealed blocks grows without bound, while the blocks right above the latest
+// sealed block are the ones holding up sealing progress.
+const MaxStatusBlocks = 100
+
+// SealingStatus is a snapshot of the sealing progress, compiled when a single execution of the
+// sealing logic has been completed. It lists the finalized but unsealed blocks in the order of
+// increasing height, up to MaxStatusBlocks.
+type SealingStatus struct {
+	FinalizedBlockID     flow.Identifier
+	FinalizedBlockHeight uint64
+	SealedBlockID        flow.Identifier
+	SealedBlockHeight    uint64
+	SealsMempoolSize     uint
+	ObservedAt           time.Time
+
+	// UnsealedBlocks is the number of finalized blocks which are not sealed yet, including the
+	// blocks beyond MaxStatusBlocks.
+	UnsealedBlocks uint
+	// BlocksWithoutReceipts is the number of listed blocks without any execution receipt.
+	BlocksWithoutReceipts uint
+	// ChunksMissingApprovals is the number of chunks of the listed results that are missing
+	// approvals for at least one incorporation of the result.
+	ChunksMissingApprovals uint
+
+	Blocks []*BlockSealingStatus
+}
+
+// BlockSealingStatus is the sealing status of a finalized but unsealed block.
+type BlockSealingStatus struct {
+	BlockID flow.Identifier
+	Height  uint64
+	// Results lists the results for the block, which execution nodes have committed to or which
+	// are incorporated in the chain.
+	Results []*ResultSealingStatus
+}
+
+// ResultSealingStatus is the sealing status of an execution result.
+type ResultSealingStatus struct {
+	ResultID flow.Identifier
+	// Executors are the execution nodes which have committed receipts for the result.
+	Executors flow.IdentifierList
+	// Incorporations lists the sealing status of the result for each block incorporating it.
+	Incorporations []*IncorporatedResultStatus
+}
+
+// IncorporatedResultStatus is the sealing status of an incorporated result. As the verifier
+// assignment depends on the incorporating block, approvals are tracked per incorporated result.
+type IncorporatedResultStatus struct {
+	IncorporatedResultID    flow.Identifier
+	IncorporatedBlockID     flow.Identifier
+	IncorporatedBlockHeight uint64
+	// IncorporatingBlock is either "finalized" or "orphaned", or empty if the incorporating block
+	// is not finalized yet.
+	IncorporatingBlock           string
+	NumberChunks                 int
+	SufficientApprovals          bool
+	ChunksMissingApprovals       []*ChunkApprovalStatus
+	QualifiesForEmergencySealing bool
+	RequestedApprovals           uint
+	CandidateSealInMempool       bool
+}
+
+// ChunkApprovalStatus lists the assigned verifiers whose approvals for a chunk are missing.
+type ChunkApprovalStatus struct {
+	ChunkIndex       uint64
+	MissingVerifiers flow.IdentifierList
+}
+
+// compileStatus compiles the SealingStatus from the records of the observation and the receipts
+// known for the unsealed blocks.
+func (st *SealingObservation) compileStatus() (*SealingStatus, error) {
+	sealedHeight := st.latestSealedBlock.Height
+	status := &SealingStatus{
+		FinalizedBlockID:     st.finalizedBlock.ID(),
+		FinalizedBlockHeight: st.finalizedBlock.Height,
+		SealedBlockID:        st.latestFinalizedSeal.BlockID,
+		SealedBlockHeight:    sealedHeight,
+		SealsMempoolSize:     st.sealsPl.Size(),
+		ObservedAt:           time.Now(),
+	}
+	if st.finalizedBlock.Height > sealedHeight {
+		status.UnsealedBlocks = uint(st.finalizedBlock.Height - sealedHeight)
+	}
+
+	recordsByBlock := make(map[flow.Identifier][]*SealingRecord)
+	for _, record := range st.records {
+		blockID := record.IncorporatedResult.Result.BlockID
+		recordsByBlock[blockID] = append(recordsByBlock[blockID], record)
+	}
+
+	for height := sealedHeight + 1; height <= st.finalizedBlock.Height && height <= sealedHeight+MaxStatusBlocks; height++ {
+		header, err := st.headersDB.ByHeight(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve finalized block at height %d: %w", height, err)
+		}
+		blockStatus, chunksMissingApprovals, err := st.blockStatus(header, recordsByBlock[header.ID()])
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile sealing status of block %v: %w", header.ID(), err)
+		}
+		if !blockStatus.hasReceipts() {
+			status.BlocksWithoutReceipts++
+		}
+		status.ChunksMissingApprovals += chunksMissingApprovals
+		status.Blocks = append(status.Blocks, blockStatus)
+	}
+
+	return status, nil
+}
+
+// blockStatus compiles the sealing status of the given finalized block from the given records of
+// its results. It also returns the number of chunks of the block's results that are missing approvals.
+func (st *SealingObservation) blockStatus(header *flow.Header, records []*SealingRecord) (*BlockSealingStatus, uint, error) {
+	blockID := header.ID()
+	receipts, err := st.receiptsDB.ByBlockID(blockID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("internal error querying receipts for block %v: %w", blockID, err)
+	}
+
+	results := make(map[flow.Identifier]*ResultSealingStatus)
+	resultStatus := func(resultID flow.Identifier) *ResultSealingStatus {
+		result, ok := results[resultID]
+		if !ok {
+			result = &ResultSealingStatus{ResultID: resultID}
+			results[resultID] = result
+		}
+		return result
+	}
+
+	for resultID, group := range receipts.GroupByResultID() {
+		result := resultStatus(resultID)
+		for executorID := range group.GroupByExecutorID() {
+			result.Executors = append(result.Executors, executorID)
+		}
+		sort.Sort(result.Executors)
+	}
+
+	chunksMissingApprovals := uint(0)
+	missingChunks := make(map[flow.Identifier]map[uint64]struct{})
+	for _, record := range records {
+		incorporation, err := record.status()
+		if err != nil {
+			return nil, 0, err
+		}
+		resultID := record.IncorporatedResult.Result.ID()
+		result := resultStatus(resultID)
+		result.Incorporations = append(result.Incorporations, incorporation)
+
+		if _, ok := missingChunks[resultID]; !ok {
+			missingChunks[resultID] = make(map[uint64]struct{})
+		}
+		for _, chunk := range incorporation.ChunksMissingApprovals {
+			if _, ok := missingChunks[resultID][chunk.ChunkIndex]; !ok {
+				missingChunks[resultID][chunk.ChunkIndex] = struct{}{}
+				chunksMissingApprovals++
+			}
+		}
+	}
+
+	status := &BlockSealingStatus{
+		BlockID: blockID,
+		Height:  header.Height,
+		Results: make([]*ResultSealingStatus, 0, len(results)),
+	}
+	for _, result := range results {
+		sort.Slice(result.Incorporations, func(i, j int) bool {
+			return result.Incorporations[i].IncorporatedBlockHeight < result.Incorporations[j].IncorporatedBlockHeight
+		})
+		status.Results = append(status.Results, result)
+	}
+	sort.Slice(status.Results, func(i, j int) bool {
+		return bytes.Compare(status.Results[i].ResultID[:], status.Results[j].ResultID[:]) < 0
+	})
+
+	return status, chunksMissingApprovals, nil
+}
+
+// hasReceipts returns true if any execution node has committed a receipt for the block.
+func (b *BlockSealingStatus) hasReceipts() bool {
+	for _, result := range b.Results {
+		if len(result.Executors) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// status compiles the sealing status of the incorporated result tracked by the record.
+func (r *SealingRecord) status() (*IncorporatedResultStatus, error) {
+	irID := r.IncorporatedResult.ID()
+	incorporatingBlock, err := r.headersDB.ByBlockID(r.IncorporatedResult.IncorporatedBlockID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve incorporating block %v: %w", r.IncorporatedResult.IncorporatedBlockID, err)
+	}
+	finalizationStatus, err := r.assignmentFinalizationStatus(incorporatingBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine finalization status of incorporating block %v: %w", r.IncorporatedResult.IncorporatedBlockID, err)
+	}
+
+	status := &IncorporatedResultStatus{
+		IncorporatedResultID:         irID,
+		IncorporatedBlockID:          r.IncorporatedResult.IncorporatedBlockID,
+		IncorporatedBlockHeight:      incorporatingBlock.Height,
+		NumberChunks:                 len(r.IncorporatedResult.Result.Chunks),
+		SufficientApprovals:          r.approvalsChecked && len(r.missingApprovals) == 0,
+		QualifiesForEmergencySealing: r.emergencySealable,
+		RequestedApprovals:           r.requestedApprovals,
+	}
+	if finalizationStatus != nil {
+		status.IncorporatingBlock = *finalizationStatus
+	}
+	_, status.CandidateSealInMempool = r.sealsPl.ByID(irID)
+
+	for chunkIndex, verifiers := range r.missingApprovals {
+		status.ChunksMissingApprovals = append(status.ChunksMissingApprovals, &ChunkApprovalStatus{
+			ChunkIndex:       chunkIndex,
+			MissingVerifiers: verifiers,
+		})
+	}
+	sort.Slice(status.ChunksMissingApprovals, func(i, j int) bool {
+		return status.ChunksMissingApprovals[i].ChunkIndex < status.ChunksMissingApprovals[j].ChunkIndex
+	})
+
+	return status, nil
+}
@@ -3,6 +3,7 @@ package tracker
 import (
 	"encoding/hex"
 	"encoding/json"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -10,35 +11,62 @@ import (
 	"github.com/onflow/flow-go/engine/consensus"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/flow/filter/id"
+	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/mempool"
 	"github.com/onflow/flow-go/storage"
 )
 
 // SealingTracker is an auxiliary component for tracking progress of the sealing
 // logic (specifically sealing.Core). It has access to the storage, to collect data
-// that is not be available directly from sealing.Core. The SealingTracker is immutable
-// and therefore intrinsically thread safe.
+// that is not be available directly from sealing.Core. Apart from the latest SealingStatus,
+// which is guarded by a lock, the SealingTracker is immutable and therefore thread safe.
 //
 // The SealingTracker essentially acts as a factory for individual SealingObservations,
 // which capture information about the progress of a _single_ go routine. Consequently,
 // SealingObservations don't need to be concurrency safe, as they are supposed to
-// be thread-local structure.
+// be thread-local structure. When a SealingObservation is completed, it compiles a
+// SealingStatus, which the SealingTracker retains as the latest sealing status.
 type SealingTracker struct {
 	log        zerolog.Logger
+	metrics    module.ConsensusMetrics
 	headersDB  storage.Headers
 	receiptsDB storage.ExecutionReceipts
 	sealsPl    mempool.IncorporatedResultSeals
+
+	mu     sync.RWMutex
+	status *SealingStatus // latest sealing status, nil until the first observation is completed
 }
 
-func NewSealingTracker(log zerolog.Logger, headersDB storage.Headers, receiptsDB storage.ExecutionReceipts, sealsPl mempool.IncorporatedResultSeals) *SealingTracker {
+func NewSealingTracker(log zerolog.Logger, metrics module.ConsensusMetrics, headersDB storage.Headers, receiptsDB storage.ExecutionReceipts, sealsPl mempool.IncorporatedResultSeals) *SealingTracker {
 	return &SealingTracker{
 		log:        log.With().Str("engine", "sealing.SealingTracker").Logger(),
+		metrics:    metrics,
 		headersDB:  headersDB,
 		receiptsDB: receiptsDB,
 		sealsPl:    sealsPl,
 	}
 }
 
+// LatestStatus returns the sealing status compiled by the most recently completed observation,
+// or nil if no observation has been completed yet. The returned status must not be modified.
+func (st *SealingTracker) LatestStatus() *SealingStatus {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.status
+}
+
+// updateStatus retains the given status as the latest sealing status, unless a status for a
+// higher finalized block has been retained already, and reports its summary to the metrics.
+func (st *SealingTracker) updateStatus(status *SealingStatus) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.status != nil && st.status.FinalizedBlockHeight > status.FinalizedBlockHeight {
+		return
+	}
+	st.status = status
+	st.metrics.SealingStatus(status.UnsealedBlocks, status.BlocksWithoutReceipts, status.ChunksMissingApprovals)
+}
+
 // nextUnsealedFinalizedBlock determines the ID of the finalized but unsealed
 // block with smallest height. It returns an Identity filter that only accepts
 // the respective ID.
@@ -81,16 +109,13 @@ type SealingObservation struct {
 	latestSealedBlock   *flow.Header
 
 	startTime  time.Time                          // time when this instance was created
-	isRelevant flow.IdentifierFilter              // policy to determine for which blocks we want to track
+	isRelevant flow.IdentifierFilter              // policy to determine for which blocks we want to log the records
 	records    map[flow.Identifier]*SealingRecord // each record is for one (unsealed) incorporated result
 }
 
 // QualifiesForEmergencySealing captures whether sealing.Core has
 // determined that the incorporated result qualifies for emergency sealing.
 func (st *SealingObservation) QualifiesForEmergencySealing(ir *flow.IncorporatedResult, emergencySealable bool) {
-	if !st.isRelevant(ir.Result.BlockID) {
-		return
-	}
 	st.getOrCreateRecord(ir).QualifiesForEmergencySealing(emergencySealable)
 }
 
@@ -99,18 +124,12 @@ func (st *SealingObservation) QualifiesForEmergencySealing(ir *flow.Incorporated
 // method with empty `chunksWithMissingApprovals` indicates that all chunks
 // have sufficient approvals.
 func (st *SealingObservation) ApprovalsMissing(ir *flow.IncorporatedResult, chunksWithMissingApprovals map[uint64]flow.IdentifierList) {
-	if !st.isRelevant(ir.Result.BlockID) {
-		return
-	}
 	st.getOrCreateRecord(ir).ApprovalsMissing(chunksWithMissingApprovals)
 }
 
 // ApprovalsRequested captures the number of approvals that the business
 // logic has re-requested for the incorporated result.
 func (st *SealingObservation) ApprovalsRequested(ir *flow.IncorporatedResult, requestCount uint) {
-	if !st.isRelevant(ir.Result.BlockID) {
-		return
-	}
 	st.getOrCreateRecord(ir).ApprovalsRequested(requestCount)
 }
 
@@ -131,7 +150,8 @@ func (st *SealingObservation) getOrCreateRecord(ir *flow.IncorporatedResult) *Se
 }
 
 // Complete is supposed to be called when a single execution of the sealing logic
-// has been completed. It compiles the information about the incorporated results.
+// has been completed. It compiles the information about the incorporated results
+// into the latest SealingStatus and logs the records of the next unsealed block.
 func (st *SealingObservation) Complete() {
 	observation := st.log.Info()
 
@@ -151,6 +171,9 @@ func (st *SealingObservation) Complete() {
 	// details about the unsealed results that are next
 	recList := make([]Rec, 0, len(st.records))
 	for irID, rec := range st.records {
+		if !st.isRelevant(rec.IncorporatedResult.Result.BlockID) {
+			continue
+		}
 		r, err := rec.Generate()
 		if err != nil {
 			st.log.Error().Err(err).
@@ -171,6 +194,13 @@ func (st *SealingObservation) Complete() {
 	// dump observation to Logger
 	observation = observation.Int64("duration_ms", time.Since(st.startTime).Milliseconds())
 	observation.Msg("sealing observation")
+
+	status, err := st.compileStatus()
+	if err != nil {
+		st.log.Error().Err(err).Msg("failed to compile sealing status")
+		return
+	}
+	st.updateStatus(status)
 }
 
 // latestFinalizedSealInfo returns a json string representation with the most
@@ -0,0 +1,123 @@
+package tracker
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	mempool "github.com/onflow/flow-go/module/mempool/mock"
+	module "github.com/onflow/flow-go/module/mock"
+	storage "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestSealingTracker_LatestStatus checks that completing a sealing observation compiles the
+// sealing status of all unsealed finalized blocks, retains it as the latest status and reports
+// its summary to the metrics.
+func TestSealingTracker_LatestStatus(t *testing.T) {
+	// chain of blocks: sealed <- block1 <- block2 <- block3 (finalized)
+	sealed := unittest.BlockHeaderFixture()
+	blocks := []*flow.Header{&sealed}
+	for i := 0; i < 3; i++ {
+		block := unittest.BlockHeaderWithParentFixture(blocks[len(blocks)-1])
+		blocks = append(blocks, &block)
+	}
+	finalized := blocks[3]
+	byID := make(map[flow.Identifier]*flow.Header)
+	byHeight := make(map[uint64]*flow.Header)
+	for _, block := range blocks {
+		byID[block.ID()] = block
+		byHeight[block.Height] = block
+	}
+
+	headers := new(storage.Headers)
+	headers.On("ByBlockID", mock.Anything).Return(
+		func(blockID flow.Identifier) *flow.Header { return byID[blockID] },
+		func(blockID flow.Identifier) error { return nil },
+	)
+	headers.On("ByHeight", mock.Anything).Return(
+		func(height uint64) *flow.Header { return byHeight[height] },
+		func(height uint64) error { return nil },
+	)
+
+	// block1 is executed by two ENs, block2 by a single EN, and block3 is not executed yet
+	executors := unittest.IdentifierListFixture(2)
+	result1 := unittest.ExecutionResultFixture(unittest.WithExecutionResultBlockID(blocks[1].ID()))
+	result2 := unittest.ExecutionResultFixture(unittest.WithExecutionResultBlockID(blocks[2].ID()))
+	receipts := map[flow.Identifier]flow.ExecutionReceiptList{
+		blocks[1].ID(): {
+			unittest.ExecutionReceiptFixture(unittest.WithResult(result1), unittest.WithExecutorID(executors[0])),
+			unittest.ExecutionReceiptFixture(unittest.WithResult(result1), unittest.WithExecutorID(executors[1])),
+		},
+		blocks[2].ID(): {
+			unittest.ExecutionReceiptFixture(unittest.WithResult(result2), unittest.WithExecutorID(executors[0])),
+		},
+	}
+	receiptsDB := new(storage.ExecutionReceipts)
+	receiptsDB.On("ByBlockID", mock.Anything).Return(
+		func(blockID flow.Identifier) flow.ExecutionReceiptList { return receipts[blockID] },
+		func(blockID flow.Identifier) error { return nil },
+	)
+
+	sealsPl := new(mempool.IncorporatedResultSeals)
+	sealsPl.On("Size").Return(uint(0))
+	sealsPl.On("ByID", mock.Anything).Return(nil, false)
+
+	metrics := new(module.ConsensusMetrics)
+	metrics.On("SealingStatus", uint(3), uint(1), uint(1)).Once()
+
+	tracker := NewSealingTracker(zerolog.Nop(), metrics, headers, receiptsDB, sealsPl)
+	require.Nil(t, tracker.LatestStatus())
+
+	// result1 is incorporated in block2, the first chunk is missing approvals from two verifiers
+	ir := unittest.IncorporatedResult.Fixture(
+		unittest.IncorporatedResult.WithResult(result1),
+		unittest.IncorporatedResult.WithIncorporatedBlockID(blocks[2].ID()))
+	verifiers := unittest.IdentifierListFixture(2)
+
+	seal := unittest.Seal.Fixture(unittest.Seal.WithBlockID(sealed.ID()))
+	observation := tracker.NewSealingObservation(finalized, seal, &sealed)
+	observation.QualifiesForEmergencySealing(ir, false)
+	observation.ApprovalsMissing(ir, map[uint64]flow.IdentifierList{0: verifiers})
+	observation.ApprovalsRequested(ir, 1)
+	observation.Complete()
+
+	status := tracker.LatestStatus()
+	require.NotNil(t, status)
+	require.Equal(t, finalized.ID(), status.FinalizedBlockID)
+	require.Equal(t, sealed.Height, status.SealedBlockHeight)
+	require.Equal(t, uint(3), status.UnsealedBlocks)
+	require.Equal(t, uint(1), status.BlocksWithoutReceipts)
+	require.Equal(t, uint(1), status.ChunksMissingApprovals)
+	require.Len(t, status.Blocks, 3)
+
+	block1 := status.Blocks[0]
+	require.Equal(t, blocks[1].ID(), block1.BlockID)
+	require.Len(t, block1.Results, 1)
+	require.Equal(t, result1.ID(), block1.Results[0].ResultID)
+	require.ElementsMatch(t, executors, block1.Results[0].Executors)
+	require.Len(t, block1.Results[0].Incorporations, 1)
+	incorporation := block1.Results[0].Incorporations[0]
+	require.Equal(t, ir.ID(), incorporation.IncorporatedResultID)
+	require.Equal(t, "finalized", incorporation.IncorporatingBlock)
+	require.False(t, incorporation.SufficientApprovals)
+	require.Equal(t, uint(1), incorporation.RequestedApprovals)
+	require.Equal(t, []*ChunkApprovalStatus{{ChunkIndex: 0, MissingVerifiers: verifiers}}, incorporation.ChunksMissingApprovals)
+
+	block2 := status.Blocks[1]
+	require.Len(t, block2.Results, 1)
+	require.Equal(t, flow.IdentifierList{executors[0]}, block2.Results[0].Executors)
+	require.Empty(t, block2.Results[0].Incorporations)
+
+	require.Empty(t, status.Blocks[2].Results)
+
+	// a status compiled for a lower finalized block must not replace the latest status
+	observation = tracker.NewSealingObservation(blocks[2], seal, &sealed)
+	observation.Complete()
+	require.Same(t, status, tracker.LatestStatus())
+
+	metrics.AssertExpectations(t)
+}
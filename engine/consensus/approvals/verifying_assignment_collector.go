@@ -17,10 +17,6 @@ import (
 	"github.com/onflow/flow-go/state/protocol"
 )
 
-// DefaultEmergencySealingThreshold is the default number of blocks which indicates that ER should be sealed using emergency
-// sealing.
-const DefaultEmergencySealingThreshold = 100
-
 // VerifyingAssignmentCollector
 // Context:
 //  * When the same result is incorporated in multiple different forks,
@@ -73,9 +69,10 @@ func (ac *VerifyingAssignmentCollector) collectorByBlockID(incorporatedBlockID f
 // sealing kicks in. This will be removed when implementation of Sealing & Verification is finished.
 func (ac *VerifyingAssignmentCollector) emergencySealable(collector *ApprovalCollector, finalizedBlockHeight uint64) bool {
 	// Criterion for emergency sealing:
-	// there must be at least DefaultEmergencySealingThreshold number of blocks between
-	// the block that _incorporates_ result and the latest finalized block
-	return collector.IncorporatedBlock().Height+DefaultEmergencySealingThreshold <= finalizedBlockHeight
+	// emergency sealing must be active for the block that _incorporates_ the result, and there
+	// must be at least EmergencySealingThreshold number of blocks between the block that
+	// _incorporates_ result and the latest finalized block
+	return collector.SealingParameters().EmergencySealable(collector.IncorporatedBlock().Height, finalizedBlockHeight)
 }
 
 // CheckEmergencySealing checks the managed assignments whether their result can be emergency
@@ -143,7 +140,14 @@ func (ac *VerifyingAssignmentCollector) ProcessIncorporatedResult(incorporatedRe
 		return fmt.Errorf("failed to retrieve header of incorporatedResult %s: %w",
 			incorporatedResult.Result.BlockID, err)
 	}
-	collector, err := NewApprovalCollector(ac.log, incorporatedResult, incorporatedBlock, executedBlock, assignment, ac.seals, ac.requiredApprovalsForSealConstruction)
+	// the sealing parameters in effect for the incorporating block determine the number of
+	// approvals required for sealing, consistently with the seal validator
+	sealingParams, err := ac.state.AtBlockID(incorporatedBlockID).SealingParameters()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve sealing parameters for incorporated block %s: %w",
+			incorporatedBlockID, err)
+	}
+	collector, err := NewApprovalCollector(ac.log, incorporatedResult, incorporatedBlock, executedBlock, assignment, ac.seals, sealingParams)
 	if err != nil {
 		return fmt.Errorf("instantiation of ApprovalCollector failed: %w", err)
 	}
@@ -369,6 +373,14 @@ func (ac *VerifyingAssignmentCollector) RequestMissingApprovals(observation cons
 	return overallRequestCount, nil
 }
 
+// ObserveMissingApprovals traverses all collectors and reports the chunks that are missing
+// approvals, together with the assigned verifiers whose approvals are missing, to the observer.
+func (ac *VerifyingAssignmentCollector) ObserveMissingApprovals(observation consensus.SealingObservation) {
+	for _, collector := range ac.allCollectors() {
+		observation.ApprovalsMissing(collector.IncorporatedResult(), collector.CollectMissingVerifiers())
+	}
+}
+
 // authorizedVerifiersAtBlock pre-select all authorized Verifiers at the block that incorporates the result.
 // The method returns the set of all node IDs that:
 //   * are authorized members of the network at the given block and
@@ -15,6 +15,7 @@ import (
 	"github.com/onflow/flow-go/crypto/hash"
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
+	consensusmock "github.com/onflow/flow-go/engine/consensus/mock"
 	"github.com/onflow/flow-go/model/chunks"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/messages"
@@ -49,10 +50,9 @@ func newVerifyingAssignmentCollector(logger zerolog.Logger,
 	sigHasher hash.Hasher,
 	approvalConduit network.Conduit,
 	requestTracker *RequestTracker,
-	requiredApprovalsForSealConstruction uint,
 ) (*VerifyingAssignmentCollector, error) {
 	b, err := NewAssignmentCollectorBase(logger, workerPool, result, state, headers, assigner, seals, sigHasher,
-		approvalConduit, requestTracker, requiredApprovalsForSealConstruction)
+		approvalConduit, requestTracker)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func (s *AssignmentCollectorTestSuite) SetupTest() {
 
 	var err error
 	s.collector, err = newVerifyingAssignmentCollector(unittest.Logger(), s.WorkerPool, s.IncorporatedResult.Result, s.State, s.Headers,
-		s.Assigner, s.SealsPL, s.SigHasher, s.Conduit, s.RequestTracker, uint(len(s.AuthorizedVerifiers)))
+		s.Assigner, s.SealsPL, s.SigHasher, s.Conduit, s.RequestTracker)
 	require.NoError(s.T(), err)
 }
 
@@ -207,7 +207,7 @@ func (s *AssignmentCollectorTestSuite) TestProcessIncorporatedResult() {
 		assigner.On("Assign", mock.Anything, mock.Anything).Return(nil, fmt.Errorf(""))
 
 		collector, err := newVerifyingAssignmentCollector(unittest.Logger(), s.WorkerPool, s.IncorporatedResult.Result, s.State, s.Headers,
-			assigner, s.SealsPL, s.SigHasher, s.Conduit, s.RequestTracker, 1)
+			assigner, s.SealsPL, s.SigHasher, s.Conduit, s.RequestTracker)
 		require.NoError(s.T(), err)
 
 		err = collector.ProcessIncorporatedResult(s.IncorporatedResult)
@@ -219,7 +219,7 @@ func (s *AssignmentCollectorTestSuite) TestProcessIncorporatedResult() {
 		delete(s.IdentitiesCache, s.IncorporatedResult.Result.BlockID)
 		s.Snapshots[s.IncorporatedResult.Result.BlockID] = unittest.StateSnapshotForKnownBlock(&s.Block, nil)
 		collector, err := newVerifyingAssignmentCollector(unittest.Logger(), s.WorkerPool, s.IncorporatedResult.Result, s.State, s.Headers,
-			s.Assigner, s.SealsPL, s.SigHasher, s.Conduit, s.RequestTracker, 1)
+			s.Assigner, s.SealsPL, s.SigHasher, s.Conduit, s.RequestTracker)
 		require.Error(s.T(), err)
 		require.Nil(s.T(), collector)
 	})
@@ -244,7 +244,7 @@ func (s *AssignmentCollectorTestSuite) TestProcessIncorporatedResult_InvalidIden
 		)
 
 		collector, err := newVerifyingAssignmentCollector(unittest.Logger(), s.WorkerPool, s.IncorporatedResult.Result, state, s.Headers, s.Assigner, s.SealsPL,
-			s.SigHasher, s.Conduit, s.RequestTracker, 1)
+			s.SigHasher, s.Conduit, s.RequestTracker)
 		require.Error(s.T(), err)
 		require.Nil(s.T(), collector)
 	})
@@ -264,7 +264,7 @@ func (s *AssignmentCollectorTestSuite) TestProcessIncorporatedResult_InvalidIden
 		)
 
 		collector, err := newVerifyingAssignmentCollector(unittest.Logger(), s.WorkerPool, s.IncorporatedResult.Result, state, s.Headers, s.Assigner, s.SealsPL,
-			s.SigHasher, s.Conduit, s.RequestTracker, 1)
+			s.SigHasher, s.Conduit, s.RequestTracker)
 		require.Nil(s.T(), collector)
 		require.Error(s.T(), err)
 	})
@@ -283,7 +283,7 @@ func (s *AssignmentCollectorTestSuite) TestProcessIncorporatedResult_InvalidIden
 		)
 
 		collector, err := newVerifyingAssignmentCollector(unittest.Logger(), s.WorkerPool, s.IncorporatedResult.Result, state, s.Headers, s.Assigner, s.SealsPL,
-			s.SigHasher, s.Conduit, s.RequestTracker, 1)
+			s.SigHasher, s.Conduit, s.RequestTracker)
 		require.Nil(s.T(), collector)
 		require.Error(s.T(), err)
 	})
@@ -382,9 +382,47 @@ func (s *AssignmentCollectorTestSuite) TestRequestMissingApprovals() {
 	}
 }
 
+// TestObserveMissingApprovals checks that the chunks without sufficient approvals are
+// reported to the observer together with the assigned verifiers whose approvals are missing,
+// and that no approvals are requested.
+func (s *AssignmentCollectorTestSuite) TestObserveMissingApprovals() {
+	err := s.collector.ProcessIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+
+	s.PublicKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+
+	// approve the first chunk by all assigned verifiers
+	approvedChunk := s.Chunks[0]
+	for _, verifierID := range s.ChunksAssignment.Verifiers(approvedChunk) {
+		approval := unittest.ResultApprovalFixture(unittest.WithChunk(approvedChunk.Index),
+			unittest.WithApproverID(verifierID),
+			unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()),
+			unittest.WithBlockID(s.Block.ID()))
+		err := s.collector.ProcessApproval(approval)
+		require.NoError(s.T(), err)
+	}
+
+	observation := new(consensusmock.SealingObservation)
+	observation.On("ApprovalsMissing", s.IncorporatedResult, mock.Anything).
+		Run(func(args mock.Arguments) {
+			missing := args[1].(map[uint64]flow.IdentifierList)
+			require.Len(s.T(), missing, s.Chunks.Len()-1)
+			require.NotContains(s.T(), missing, approvedChunk.Index)
+			for _, chunk := range s.Chunks[1:] {
+				require.ElementsMatch(s.T(), s.ChunksAssignment.Verifiers(chunk), missing[chunk.Index])
+			}
+		}).Once()
+
+	s.collector.ObserveMissingApprovals(observation)
+
+	observation.AssertExpectations(s.T())
+	s.Conduit.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
+
 // TestCheckEmergencySealing tests that currently tracked incorporated results can be emergency sealed
 // when height difference reached the emergency sealing threshold.
 func (s *AssignmentCollectorTestSuite) TestCheckEmergencySealing() {
+	s.SealingParameters.EmergencySealingActive = true
 	err := s.collector.ProcessIncorporatedResult(s.IncorporatedResult)
 	require.NoError(s.T(), err)
 
@@ -401,7 +439,7 @@ func (s *AssignmentCollectorTestSuite) TestCheckEmergencySealing() {
 		},
 	).Return(true, nil).Once()
 
-	err = s.collector.CheckEmergencySealing(&tracker.NoopSealingTracker{}, DefaultEmergencySealingThreshold+s.IncorporatedBlock.Height)
+	err = s.collector.CheckEmergencySealing(&tracker.NoopSealingTracker{}, s.SealingParameters.EmergencySealingThreshold+s.IncorporatedBlock.Height)
 	require.NoError(s.T(), err)
 
 	s.SealsPL.AssertExpectations(s.T())
@@ -377,6 +377,30 @@ func (c *Core) OnBlockVote(originID flow.Identifier, vote *messages.BlockVote) e
 	return nil
 }
 
+// OnTimeout handles incoming timeout objects by forwarding them to the
+// hotstuff vote aggregator.
+func (c *Core) OnTimeout(originID flow.Identifier, timeout *messages.TimeoutObject) error {
+
+	t := &model.TimeoutObject{
+		View:       timeout.View,
+		NewestQC:   timeout.NewestQC,
+		LastViewTC: timeout.LastViewTC,
+		SignerID:   originID,
+		SigData:    timeout.SigData,
+	}
+
+	c.log.Info().
+		Uint64("timeout_view", timeout.View).
+		Hex("signer", originID[:]).
+		Str("timeout_id", t.ID().String()).
+		Msg("timeout received, forwarding timeout to hotstuff vote aggregator")
+
+	// forward the timeout to hotstuff for processing
+	c.voteAggregator.AddTimeout(t)
+
+	return nil
+}
+
 // ProcessFinalizedView performs pruning of stale data based on finalization event
 // removes pending blocks below the finalized view
 func (c *Core) ProcessFinalizedView(finalizedView uint64) {
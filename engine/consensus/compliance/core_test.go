@@ -425,6 +425,30 @@ func (cs *ComplianceCoreSuite) TestOnSubmitVote() {
 	cs.hotstuff.AssertExpectations(cs.T())
 }
 
+func (cs *ComplianceCoreSuite) TestOnSubmitTimeout() {
+	// create a timeout
+	originID := unittest.IdentifierFixture()
+	timeout := messages.TimeoutObject{
+		View:     rand.Uint64(),
+		NewestQC: unittest.QuorumCertificateFixture(),
+		SigData:  unittest.SignatureFixture(),
+	}
+
+	cs.voteAggregator.On("AddTimeout", &model.TimeoutObject{
+		View:     timeout.View,
+		NewestQC: timeout.NewestQC,
+		SignerID: originID,
+		SigData:  timeout.SigData,
+	}).Return()
+
+	// execute the timeout submission
+	err := cs.core.OnTimeout(originID, &timeout)
+	require.NoError(cs.T(), err, "timeout should pass")
+
+	// check that the timeout was forwarded to the vote aggregator
+	cs.voteAggregator.AssertExpectations(cs.T())
+}
+
 func (cs *ComplianceCoreSuite) TestProposalBufferingOrder() {
 
 	// create a proposal that we will not submit until the end
@@ -32,6 +32,9 @@ const defaultBlockQueueCapacity = 10000
 // defaultVoteQueueCapacity maximum capacity of block votes queue
 const defaultVoteQueueCapacity = 1000
 
+// defaultTimeoutQueueCapacity maximum capacity of timeout objects queue
+const defaultTimeoutQueueCapacity = 1000
+
 // Engine is a wrapper struct for `Core` which implements consensus algorithm.
 // Engine is responsible for handling incoming messages, queueing for processing, broadcasting proposals.
 type Engine struct {
@@ -49,6 +52,7 @@ type Engine struct {
 	core                       *Core
 	pendingBlocks              engine.MessageStore
 	pendingVotes               engine.MessageStore
+	pendingTimeouts            engine.MessageStore
 	messageHandler             *engine.MessageHandler
 	finalizedView              counters.StrictMonotonousCounter
 	finalizationEventsNotifier engine.Notifier
@@ -85,6 +89,16 @@ func NewEngine(
 	}
 	pendingVotes := &engine.FifoMessageStore{FifoQueue: votesQueue}
 
+	// FIFO queue for timeout objects
+	timeoutsQueue, err := fifoqueue.NewFifoQueue(
+		fifoqueue.WithCapacity(defaultTimeoutQueueCapacity),
+		fifoqueue.WithLengthObserver(func(len int) { core.mempool.MempoolEntries(metrics.ResourceTimeoutQueue, uint(len)) }),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue for inbound timeouts: %w", err)
+	}
+	pendingTimeouts := &engine.FifoMessageStore{FifoQueue: timeoutsQueue}
+
 	// define message queueing behaviour
 	handler := engine.NewMessageHandler(
 		log.With().Str("compliance", "engine").Logger(),
@@ -130,6 +144,16 @@ func NewEngine(
 			},
 			Store: pendingVotes,
 		},
+		engine.Pattern{
+			Match: func(msg *engine.Message) bool {
+				_, ok := msg.Payload.(*messages.TimeoutObject)
+				if ok {
+					core.metrics.MessageReceived(metrics.EngineCompliance, metrics.MessageTimeoutObject)
+				}
+				return ok
+			},
+			Store: pendingTimeouts,
+		},
 	)
 
 	eng := &Engine{
@@ -143,6 +167,7 @@ func NewEngine(
 		payloads:                   core.payloads,
 		pendingBlocks:              pendingBlocks,
 		pendingVotes:               pendingVotes,
+		pendingTimeouts:            pendingTimeouts,
 		state:                      core.state,
 		tracer:                     core.tracer,
 		prov:                       prov,
@@ -276,6 +301,15 @@ func (e *Engine) processAvailableMessages() error {
 			continue
 		}
 
+		msg, ok = e.pendingTimeouts.Get()
+		if ok {
+			err := e.core.OnTimeout(msg.OriginID, msg.Payload.(*messages.TimeoutObject))
+			if err != nil {
+				return fmt.Errorf("could not handle timeout: %w", err)
+			}
+			continue
+		}
+
 		// when there is no more messages in the queue, back to the loop to wait
 		// for the next incoming message to arrive.
 		return nil
@@ -406,6 +440,49 @@ func (e *Engine) BroadcastProposal(header *flow.Header) error {
 	return e.BroadcastProposalWithDelay(header, 0)
 }
 
+// BroadcastTimeout will propagate a timeout object to all non-local consensus nodes.
+func (e *Engine) BroadcastTimeout(timeout *model.TimeoutObject) error {
+
+	log := e.log.With().
+		Uint64("timeout_view", timeout.View).
+		Uint64("timeout_newest_qc_view", timeout.NewestQC.View).
+		Logger()
+
+	log.Debug().Msg("processing timeout broadcast request from hotstuff")
+
+	// retrieve all consensus nodes without our ID
+	recipients, err := e.state.Final().Identities(filter.And(
+		filter.HasRole(flow.RoleConsensus),
+		filter.Not(filter.HasNodeID(e.me.NodeID())),
+	))
+	if err != nil {
+		return fmt.Errorf("could not get consensus recipients: %w", err)
+	}
+
+	// NOTE: the signer ID is conveyed over the network message
+	msg := &messages.TimeoutObject{
+		View:       timeout.View,
+		NewestQC:   timeout.NewestQC,
+		LastViewTC: timeout.LastViewTC,
+		SigData:    timeout.SigData,
+	}
+
+	e.unit.Launch(func() {
+		err := e.con.Publish(msg, recipients.NodeIDs()...)
+		if errors.Is(err, network.EmptyTargetList) {
+			return
+		}
+		if err != nil {
+			log.Warn().Err(err).Msg("could not broadcast timeout")
+			return
+		}
+		e.metrics.MessageSent(metrics.EngineCompliance, metrics.MessageTimeoutObject)
+		log.Info().Msg("timeout broadcasted")
+	})
+
+	return nil
+}
+
 // OnFinalizedBlock implements the `OnFinalizedBlock` callback from the `hotstuff.FinalizationConsumer`
 //  (1) Informs sealing.Core about finalization of respective block.
 // CAUTION: the input to this callback is treated as trusted; precautions should be taken that messages
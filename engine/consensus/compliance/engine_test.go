@@ -149,6 +149,43 @@ func (cs *ComplianceSuite) TestBroadcastProposalWithDelay() {
 	header.View--
 }
 
+// TestBroadcastTimeout tests that a timeout object is broadcast to all other consensus nodes
+func (cs *ComplianceSuite) TestBroadcastTimeout() {
+
+	// add execution node to participants to make sure we exclude them from broadcast
+	cs.participants = append(cs.participants, unittest.IdentityFixture(unittest.WithRole(flow.RoleExecution)))
+
+	timeout := &model.TimeoutObject{
+		View:     rand.Uint64(),
+		NewestQC: unittest.QuorumCertificateFixture(),
+		SignerID: cs.myID,
+		SigData:  unittest.SignatureFixture(),
+	}
+
+	err := cs.engine.BroadcastTimeout(timeout)
+	require.NoError(cs.T(), err, "timeout broadcast should pass")
+
+	done := func() <-chan struct{} {
+		channel := make(chan struct{})
+		close(channel)
+		return channel
+	}()
+
+	cs.hotstuff.On("Done", mock.Anything).Return(done)
+
+	// The timeout is transmitted asynchronously. We allow 10ms for the timeout to be sent:
+	<-time.After(10 * time.Millisecond)
+	<-cs.engine.Done()
+
+	// the signer ID is conveyed by the network layer and not part of the message
+	msg := &messages.TimeoutObject{
+		View:     timeout.View,
+		NewestQC: timeout.NewestQC,
+		SigData:  timeout.SigData,
+	}
+	cs.con.AssertCalled(cs.T(), "Publish", msg, cs.participants[1].NodeID, cs.participants[2].NodeID)
+}
+
 // TestSubmittingMultipleVotes tests that we can send multiple votes and they
 // are queued and processed in expected way
 func (cs *ComplianceSuite) TestSubmittingMultipleEntries() {
@@ -30,13 +30,15 @@ const retryBaseWait = 1 * time.Second
 const retryJitterPct = 25
 
 // MessagingEngine is a network engine that enables DKG nodes to exchange
-// private messages over the network.
+// private messages over the network, as well as broadcast messages when these
+// are disseminated over the DKG gossip channel.
 type MessagingEngine struct {
-	unit    *engine.Unit
-	log     zerolog.Logger
-	me      module.Local      // local object to identify the node
-	conduit network.Conduit   // network conduit for sending and receiving private messages
-	tunnel  *dkg.BrokerTunnel // tunnel for relaying private messages to and from controllers
+	unit          *engine.Unit
+	log           zerolog.Logger
+	me            module.Local      // local object to identify the node
+	conduit       network.Conduit   // network conduit for sending and receiving private messages
+	gossipConduit network.Conduit   // network conduit for sending and receiving broadcast messages
+	tunnel        *dkg.BrokerTunnel // tunnel for relaying messages to and from controllers
 }
 
 // NewMessagingEngine returns a new engine.
@@ -60,6 +62,10 @@ func NewMessagingEngine(
 	if err != nil {
 		return nil, fmt.Errorf("could not register dkg network engine: %w", err)
 	}
+	eng.gossipConduit, err = net.Register(engine.DKGBroadcast, &eng)
+	if err != nil {
+		return nil, fmt.Errorf("could not register dkg broadcast network engine: %w", err)
+	}
 
 	eng.unit.Launch(eng.forwardOutgoingMessages)
 
@@ -128,8 +134,11 @@ func (e *MessagingEngine) process(originID flow.Identifier, event interface{}) e
 		// block rate.
 		e.forwardInboundMessageAsync(originID, v)
 		return nil
+	case *msg.GossipDKGMessage:
+		e.forwardInboundGossipMessageAsync(originID, v)
+		return nil
 	default:
-		return engine.NewInvalidInputErrorf("expecting input with type msg.DKGMessage, but got %T", event)
+		return engine.NewInvalidInputErrorf("expecting input with type msg.DKGMessage or msg.GossipDKGMessage, but got %T", event)
 	}
 }
 
@@ -146,11 +155,26 @@ func (e *MessagingEngine) forwardInboundMessageAsync(originID flow.Identifier, m
 	})
 }
 
+// forwardInboundGossipMessageAsync forwards a broadcast DKG message, relayed by
+// another DKG participant over the gossip channel, to the DKG controller.
+func (e *MessagingEngine) forwardInboundGossipMessageAsync(relayerID flow.Identifier, message *msg.GossipDKGMessage) {
+	e.unit.Launch(func() {
+		e.tunnel.SendGossipIn(
+			msg.GossipDKGMessageIn{
+				GossipDKGMessage: *message,
+				RelayerID:        relayerID,
+			},
+		)
+	})
+}
+
 func (e *MessagingEngine) forwardOutgoingMessages() {
 	for {
 		select {
 		case msg := <-e.tunnel.MsgChOut:
 			e.forwardOutboundMessageAsync(msg)
+		case msg := <-e.tunnel.GossipMsgChOut:
+			e.forwardOutboundGossipMessageAsync(msg)
 		case <-e.unit.Quit():
 			return
 		}
@@ -184,3 +208,31 @@ func (e *MessagingEngine) forwardOutboundMessageAsync(message msg.PrivDKGMessage
 		}
 	})
 }
+
+// forwardOutboundGossipMessageAsync asynchronously attempts to publish a
+// broadcast DKG message to the other DKG participants over the gossip channel,
+// on a best effort basis.
+func (e *MessagingEngine) forwardOutboundGossipMessageAsync(message msg.GossipDKGMessageOut) {
+	e.unit.Launch(func() {
+		backoff := retry.NewExponential(retryBaseWait)
+		backoff = retry.WithMaxRetries(retryMax, backoff)
+		backoff = retry.WithJitterPercent(retryJitterPct, backoff)
+
+		attempts := 1
+		err := retry.Do(e.unit.Ctx(), backoff, func(ctx context.Context) error {
+			err := e.gossipConduit.Publish(&message.GossipDKGMessage, message.DestIDs...)
+			if err != nil {
+				e.log.Warn().Err(err).Msgf("error publishing dkg broadcast message retrying (%d)", attempts)
+			}
+
+			attempts++
+			return retry.RetryableError(err)
+		})
+
+		// Relays by the other participants make the echo broadcast resilient to
+		// individual publication failures, hence we log the error and move on.
+		if err != nil {
+			e.log.Error().Err(err).Msgf("error publishing dkg broadcast message after %d attempts", attempts)
+		}
+	})
+}
@@ -23,7 +23,7 @@ func createTestEngine(t *testing.T) *MessagingEngine {
 	network := new(mocknetwork.Network)
 	network.On("Register", mock.Anything, mock.Anything).
 		Return(conduit, nil).
-		Once()
+		Twice()
 
 	// setup local with nodeID
 	nodeID := unittest.IdentifierFixture()
@@ -98,3 +98,61 @@ func TestForwardIncomingMessages(t *testing.T) {
 
 	unittest.RequireCloseBefore(t, doneCh, time.Second, "message not received")
 }
+
+// TestForwardOutgoingGossipMessages checks that the engine correctly publishes
+// outgoing broadcast messages from the tunnel's GossipOut channel to the
+// gossip network conduit.
+func TestForwardOutgoingGossipMessages(t *testing.T) {
+	engine := createTestEngine(t)
+
+	destinationIDs := unittest.IdentifierListFixture(2)
+	expectedMsg := msg.GossipDKGMessage{
+		DKGMessage: msg.NewDKGMessage([]byte("hello"), "dkg-123"),
+		OriginID:   unittest.IdentifierFixture(),
+		Sequence:   1,
+		Signature:  unittest.SignatureFixture(),
+	}
+
+	conduit := &mocknetwork.Conduit{}
+	conduit.On("Publish", &expectedMsg, destinationIDs[0], destinationIDs[1]).
+		Return(nil).
+		Once()
+	engine.gossipConduit = conduit
+
+	engine.tunnel.SendGossipOut(msg.GossipDKGMessageOut{
+		GossipDKGMessage: expectedMsg,
+		DestIDs:          destinationIDs,
+	})
+
+	time.Sleep(5 * time.Millisecond)
+
+	conduit.AssertExpectations(t)
+}
+
+// TestForwardIncomingGossipMessages checks that the engine correctly forwards
+// broadcast messages from the gossip conduit to the tunnel's GossipIn channel.
+func TestForwardIncomingGossipMessages(t *testing.T) {
+	e := createTestEngine(t)
+
+	relayerID := unittest.IdentifierFixture()
+	expectedMsg := msg.GossipDKGMessageIn{
+		GossipDKGMessage: msg.GossipDKGMessage{
+			DKGMessage: msg.NewDKGMessage([]byte("hello"), "dkg-123"),
+			OriginID:   unittest.IdentifierFixture(),
+			Signature:  unittest.SignatureFixture(),
+		},
+		RelayerID: relayerID,
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		receivedMsg := <-e.tunnel.GossipMsgChIn
+		require.Equal(t, expectedMsg, receivedMsg)
+		close(doneCh)
+	}()
+
+	err := e.Process(engine.DKGBroadcast, relayerID, &expectedMsg.GossipDKGMessage)
+	require.NoError(t, err)
+
+	unittest.RequireCloseBefore(t, doneCh, time.Second, "message not received")
+}
@@ -221,17 +221,17 @@ func (e *ReactorEngine) startDKGForEpoch(currentEpochCounter uint64, first *flow
 	for view := curDKGInfo.phase1FinalView; view > first.View; view -= e.pollStep {
 		e.registerPoll(view)
 	}
-	e.registerPhaseTransition(curDKGInfo.phase1FinalView, dkgmodule.Phase1, e.controller.EndPhase1)
+	e.registerPhaseTransition(curDKGInfo.phase1FinalView, nextEpochCounter, dkgmodule.Phase1, e.controller.EndPhase1)
 
 	for view := curDKGInfo.phase2FinalView; view > curDKGInfo.phase1FinalView; view -= e.pollStep {
 		e.registerPoll(view)
 	}
-	e.registerPhaseTransition(curDKGInfo.phase2FinalView, dkgmodule.Phase2, e.controller.EndPhase2)
+	e.registerPhaseTransition(curDKGInfo.phase2FinalView, nextEpochCounter, dkgmodule.Phase2, e.controller.EndPhase2)
 
 	for view := curDKGInfo.phase3FinalView; view > curDKGInfo.phase2FinalView; view -= e.pollStep {
 		e.registerPoll(view)
 	}
-	e.registerPhaseTransition(curDKGInfo.phase3FinalView, dkgmodule.Phase3, e.end(nextEpochCounter))
+	e.registerPhaseTransition(curDKGInfo.phase3FinalView, nextEpochCounter, dkgmodule.Phase3, e.end(nextEpochCounter))
 }
 
 // handleEpochCommittedPhaseStarted is invoked upon the transition to the EpochCommitted
@@ -373,8 +373,10 @@ func (e *ReactorEngine) registerPoll(view uint64) {
 }
 
 // registerPhaseTransition instructs the engine to change phases at the
-// specified view.
-func (e *ReactorEngine) registerPhaseTransition(view uint64, fromState dkgmodule.State, phaseTransition func() error) {
+// specified view. After each phase transition, the DKG transcript summary is
+// persisted, so that it remains available for troubleshooting after the DKG
+// has ended or the node has restarted.
+func (e *ReactorEngine) registerPhaseTransition(view uint64, nextEpochCounter uint64, fromState dkgmodule.State, phaseTransition func() error) {
 	e.viewEvents.OnView(view, func(header *flow.Header) {
 		e.unit.Launch(func() {
 			e.unit.Lock()
@@ -388,6 +390,7 @@ func (e *ReactorEngine) registerPhaseTransition(view uint64, fromState dkgmodule
 
 			log.Info().Msgf("ending %s...", fromState)
 			err := phaseTransition()
+			e.storeTranscript(nextEpochCounter)
 			if err != nil {
 				log.Fatal().Err(err).Msgf("node failed to end %s", fromState)
 			}
@@ -430,3 +433,13 @@ func (e *ReactorEngine) end(nextEpochCounter uint64) func() error {
 		return nil
 	}
 }
+
+// storeTranscript persists the current transcript summary of the DKG for the
+// given epoch. The transcript is diagnostic information only, hence failures
+// to store it are logged but do not interrupt the DKG.
+func (e *ReactorEngine) storeTranscript(nextEpochCounter uint64) {
+	err := e.dkgState.StoreDKGTranscriptSummary(nextEpochCounter, e.controller.GetTranscript())
+	if err != nil {
+		e.log.Err(err).Uint64("next_epoch", nextEpochCounter).Msg("could not store dkg transcript summary")
+	}
+}
@@ -148,9 +148,13 @@ func (suite *ReactorEngineSuite_SetupPhase) SetupTest() {
 		}).
 		Return(nil).
 		Once()
+	// the transcript summary is persisted after each of the three phase transitions
+	transcript := &flow.DKGTranscriptSummary{DKGInstanceID: dkgmodule.CanonicalInstanceID(suite.firstBlock.ChainID, suite.NextEpochCounter())}
+	suite.dkgState.On("StoreDKGTranscriptSummary", suite.NextEpochCounter(), transcript).Return(nil).Times(3)
 
 	// we will ensure that the controller state transitions get called appropriately
 	suite.controller = new(module.DKGController)
+	suite.controller.On("GetTranscript").Return(transcript).Times(3)
 	suite.controller.On("Run").Return(nil).Once()
 	suite.controller.On("EndPhase1").Return(nil).Once()
 	suite.controller.On("EndPhase2").Return(nil).Once()
@@ -29,28 +29,17 @@ import (
 	"github.com/onflow/flow-go/utils/logging"
 )
 
-// DefaultRequiredApprovalsForSealConstruction is the default number of approvals required to construct a candidate seal
-// for subsequent inclusion in block.
-// when set to 1, it requires at least 1 approval to build a seal
-// when set to 0, it can build seal without any approval
-const DefaultRequiredApprovalsForSealConstruction = 1
-
-// DefaultEmergencySealingActive is a flag which indicates when emergency sealing is active, this is a temporary measure
-// to make fire fighting easier while seal & verification is under development.
-const DefaultEmergencySealingActive = false
-
-// Config is a structure of values that configure behavior of sealing engine
+// Config is a structure of values that configure behavior of sealing engine.
+// The number of approvals required for constructing a candidate seal and the rules for
+// emergency sealing are not node-local configuration; they are part of the protocol state
+// (see flow.SealingParameters).
 type Config struct {
-	EmergencySealingActive               bool   // flag which indicates if emergency sealing is active or not. NOTE: this is temporary while sealing & verification is under development
-	RequiredApprovalsForSealConstruction uint   // min number of approvals required for constructing a candidate seal
-	ApprovalRequestsThreshold            uint64 // threshold for re-requesting approvals: min height difference between the latest finalized block and the block incorporating a result
+	ApprovalRequestsThreshold uint64 // threshold for re-requesting approvals: min height difference between the latest finalized block and the block incorporating a result
 }
 
 func DefaultConfig() Config {
 	return Config{
-		EmergencySealingActive:               DefaultEmergencySealingActive,
-		RequiredApprovalsForSealConstruction: DefaultRequiredApprovalsForSealConstruction,
-		ApprovalRequestsThreshold:            10,
+		ApprovalRequestsThreshold: 10,
 	}
 }
 
@@ -121,7 +110,7 @@ func NewCore(
 	factoryMethod := func(result *flow.ExecutionResult) (approvals.AssignmentCollector, error) {
 		base, err := approvals.NewAssignmentCollectorBase(core.log, core.workerPool, result, core.state, core.headers,
 			assigner, sealsMempool, signatureHasher,
-			approvalConduit, core.requestTracker, config.RequiredApprovalsForSealConstruction)
+			approvalConduit, core.requestTracker)
 		if err != nil {
 			return nil, fmt.Errorf("could not create base collector: %w", err)
 		}
@@ -447,25 +436,15 @@ func (c *Core) processApproval(approval *flow.ResultApproval) error {
 	return nil
 }
 
+// checkEmergencySealing checks all collectors for unsealed finalized blocks for results qualifying
+// for emergency sealing. Whether emergency sealing is active, and after how many blocks a result
+// qualifies, is determined by the sealing parameters in effect for the block incorporating the
+// result, hence each collector performs the check w.r.t. its own incorporated results.
 func (c *Core) checkEmergencySealing(observer consensus.SealingObservation, lastSealedHeight, lastFinalizedHeight uint64) error {
-	if !c.config.EmergencySealingActive {
-		return nil
-	}
-
-	emergencySealingHeight := lastSealedHeight + approvals.DefaultEmergencySealingThreshold
-
-	// we are interested in all collectors that match condition:
-	// lastSealedBlock + sealing.DefaultEmergencySealingThreshold < lastFinalizedHeight
-	// in other words we should check for emergency sealing only if threshold was reached
-	if emergencySealingHeight >= lastFinalizedHeight {
-		return nil
-	}
-
-	delta := lastFinalizedHeight - emergencySealingHeight
 	// if block is emergency sealable depends on it's incorporated block height
 	// collectors tree stores collector by executed block height
 	// we need to select multiple levels to find eligible collectors for emergency sealing
-	for _, collector := range c.collectorTree.GetCollectorsByInterval(lastSealedHeight, lastSealedHeight+delta) {
+	for _, collector := range c.collectorTree.GetCollectorsByInterval(lastSealedHeight, lastFinalizedHeight) {
 		err := collector.CheckEmergencySealing(observer, lastFinalizedHeight)
 		if err != nil {
 			return err
@@ -550,6 +529,10 @@ func (c *Core) ProcessFinalizedBlock(finalizedBlockID flow.Identifier) error {
 		return fmt.Errorf("could not check emergency sealing at block %v", finalizedBlockID)
 	}
 
+	// report the chunks missing approvals for all unsealed results, so the sealing status covers
+	// every unsealed block and not only the ones for which approvals are re-requested
+	c.observeMissingApprovals(sealingObservation, lastSealed.Height, finalized.Height)
+
 	requestPendingApprovalsSpan := c.tracer.StartSpanFromParent(processFinalizedBlockSpan, trace.CONSealingRequestingPendingApproval)
 	err = c.requestPendingApprovals(sealingObservation, lastSealed.Height, finalized.Height)
 	requestPendingApprovalsSpan.Finish()
@@ -596,6 +579,14 @@ func (c *Core) prune(parentSpan opentracing.Span, finalized, lastSealed *flow.He
 	return nil
 }
 
+// observeMissingApprovals reports the chunks missing approvals to the observation for all
+// results of blocks with height in (lastSealedHeight, lastFinalizedHeight].
+func (c *Core) observeMissingApprovals(observation consensus.SealingObservation, lastSealedHeight, lastFinalizedHeight uint64) {
+	for _, collector := range c.collectorTree.GetCollectorsByInterval(lastSealedHeight+1, lastFinalizedHeight+1) {
+		collector.ObserveMissingApprovals(observation)
+	}
+}
+
 // requestPendingApprovals requests approvals for chunks that haven't collected
 // enough approvals. When the number of unsealed finalized blocks exceeds the
 // threshold, we go through the entire mempool of incorporated-results, which
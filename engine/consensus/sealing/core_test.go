@@ -31,11 +31,6 @@ func TestApprovalProcessingCore(t *testing.T) {
 	suite.Run(t, new(ApprovalProcessingCoreTestSuite))
 }
 
-// RequiredApprovalsForSealConstructionTestingValue defines the number of approvals that are
-// required to construct a seal for testing purposes. Thereby, the default production value
-// can be set independently without changing test behaviour.
-const RequiredApprovalsForSealConstructionTestingValue = 1
-
 type ApprovalProcessingCoreTestSuite struct {
 	approvals.BaseAssignmentCollectorTestSuite
 
@@ -66,9 +61,7 @@ func (s *ApprovalProcessingCoreTestSuite) SetupTest() {
 	tracer := trace.NewNoopTracer()
 
 	options := Config{
-		EmergencySealingActive:               false,
-		RequiredApprovalsForSealConstruction: uint(len(s.AuthorizedVerifiers)),
-		ApprovalRequestsThreshold:            2,
+		ApprovalRequestsThreshold: 2,
 	}
 
 	var err error
@@ -315,7 +308,8 @@ func (s *ApprovalProcessingCoreTestSuite) TestProcessIncorporated_ApprovalVerifi
 
 // TestOnBlockFinalized_EmergencySealing tests that emergency sealing kicks in to resolve sealing halt
 func (s *ApprovalProcessingCoreTestSuite) TestOnBlockFinalized_EmergencySealing() {
-	s.core.config.EmergencySealingActive = true
+	s.SealingParameters.EmergencySealingActive = true
+	threshold := s.SealingParameters.EmergencySealingThreshold
 	s.SealsPL.On("ByID", mock.Anything).Return(nil, false).Maybe()
 	s.SealsPL.On("Add", mock.Anything).Run(
 		func(args mock.Arguments) {
@@ -326,7 +320,7 @@ func (s *ApprovalProcessingCoreTestSuite) TestOnBlockFinalized_EmergencySealing(
 	).Return(true, nil).Once()
 
 	seal := unittest.Seal.Fixture(unittest.Seal.WithBlock(&s.ParentBlock))
-	s.sealsDB.On("ByBlockID", mock.Anything).Return(seal, nil).Times(approvals.DefaultEmergencySealingThreshold)
+	s.sealsDB.On("ByBlockID", mock.Anything).Return(seal, nil).Times(int(threshold))
 	s.State.On("Sealed").Return(unittest.StateSnapshotForKnownBlock(&s.ParentBlock, nil))
 
 	err := s.core.ProcessIncorporatedResult(s.IncorporatedResult)
@@ -334,7 +328,7 @@ func (s *ApprovalProcessingCoreTestSuite) TestOnBlockFinalized_EmergencySealing(
 
 	lastFinalizedBlock := &s.IncorporatedBlock
 	s.MarkFinalized(lastFinalizedBlock)
-	for i := 0; i < approvals.DefaultEmergencySealingThreshold; i++ {
+	for i := uint64(0); i < threshold; i++ {
 		finalizedBlock := unittest.BlockHeaderWithParentFixture(lastFinalizedBlock)
 		s.Blocks[finalizedBlock.ID()] = &finalizedBlock
 		s.MarkFinalized(&finalizedBlock)
@@ -540,7 +534,7 @@ func (s *ApprovalProcessingCoreTestSuite) TestRequestPendingApprovals() {
 	}
 
 	// the sealing Core requires approvals from both verifiers for each chunk
-	s.core.config.RequiredApprovalsForSealConstruction = 2
+	s.SealingParameters.RequiredApprovalsForSealConstruction = 2
 
 	// populate the incorporated-results tree with:
 	// - 50 that have collected two signatures per chunk
@@ -790,6 +784,7 @@ func (s *ApprovalProcessingCoreTestSuite) TestRepopulateAssignmentCollectorTree_
 	s.Snapshots[s.rootHeader.ID()] = finalSnapShot
 	// root snapshot has no pending children
 	finalSnapShot.On("ValidDescendants").Return(nil, nil)
+	finalSnapShot.On("SealingParameters").Return(s.SealingParameters, nil)
 	// set up sealing segment
 	finalSnapShot.On("SealingSegment").Return(
 		&flow.SealingSegment{
@@ -118,7 +118,7 @@ func NewEngine(log zerolog.Logger,
 		return nil, fmt.Errorf("initialization of inbound queues for trusted inputs failed: %w", err)
 	}
 
-	err = e.setupMessageHandler(options.RequiredApprovalsForSealConstruction)
+	err = e.setupMessageHandler()
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize message handler for untrusted inputs: %w", err)
 	}
@@ -172,7 +172,7 @@ func (e *Engine) setupTrustedInboundQueues() error {
 }
 
 // setupMessageHandler initializes the inbound queues and the MessageHandler for UNTRUSTED INPUTS.
-func (e *Engine) setupMessageHandler(requiredApprovalsForSealConstruction uint) error {
+func (e *Engine) setupMessageHandler() error {
 	// FIFO queue for broadcasted approvals
 	pendingApprovalsQueue, err := fifoqueue.NewFifoQueue(
 		fifoqueue.WithCapacity(defaultApprovalQueueCapacity),
@@ -210,14 +210,6 @@ func (e *Engine) setupMessageHandler(requiredApprovalsForSealConstruction uint)
 				}
 				return ok
 			},
-			Map: func(msg *engine.Message) (*engine.Message, bool) {
-				if requiredApprovalsForSealConstruction < 1 {
-					// if we don't require approvals to construct a seal, don't even process approvals.
-					return nil, false
-				}
-
-				return msg, true
-			},
 			Store: e.pendingApprovals,
 		},
 		engine.Pattern{
@@ -229,11 +221,6 @@ func (e *Engine) setupMessageHandler(requiredApprovalsForSealConstruction uint)
 				return ok
 			},
 			Map: func(msg *engine.Message) (*engine.Message, bool) {
-				if requiredApprovalsForSealConstruction < 1 {
-					// if we don't require approvals to construct a seal, don't even process approvals.
-					return nil, false
-				}
-
 				approval := msg.Payload.(*messages.ApprovalResponse).Approval
 				return &engine.Message{
 					OriginID: msg.OriginID,
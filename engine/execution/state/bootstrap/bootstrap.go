@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/engine/execution/state"
@@ -15,6 +14,7 @@ import (
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // an increased limit for bootstrapping
@@ -65,10 +65,10 @@ func (b *Bootstrapper) BootstrapLedger(
 
 // IsBootstrapped returns whether the execution database has been bootstrapped, if yes, returns the
 // root statecommitment
-func (b *Bootstrapper) IsBootstrapped(db *badger.DB) (flow.StateCommitment, bool, error) {
+func (b *Bootstrapper) IsBootstrapped(db kv.DB) (flow.StateCommitment, bool, error) {
 	var commit flow.StateCommitment
 
-	err := db.View(func(txn *badger.Txn) error {
+	err := db.View(func(txn kv.Transaction) error {
 		err := operation.LookupStateCommitment(flow.ZeroID, &commit)(txn)
 		if err != nil {
 			return fmt.Errorf("could not lookup state commitment: %w", err)
@@ -88,9 +88,9 @@ func (b *Bootstrapper) IsBootstrapped(db *badger.DB) (flow.StateCommitment, bool
 	return commit, true, nil
 }
 
-func (b *Bootstrapper) BootstrapExecutionDatabase(db *badger.DB, commit flow.StateCommitment, genesis *flow.Header) error {
+func (b *Bootstrapper) BootstrapExecutionDatabase(db kv.DB, commit flow.StateCommitment, genesis *flow.Header) error {
 
-	err := operation.RetryOnConflict(db.Update, func(txn *badger.Txn) error {
+	err := operation.RetryOnConflict(db.Update, func(txn kv.Transaction) error {
 
 		err := operation.InsertExecutedBlock(genesis.ID())(txn)
 		if err != nil {
@@ -0,0 +1,240 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// DefaultCheckpointFetchTimeout is the default time limit for fetching a root
+// checkpoint, including reading its body.
+const DefaultCheckpointFetchTimeout = 2 * time.Hour
+
+// NewCheckpointClient returns an HTTP client for FetchRootCheckpoint, which
+// gives up on unresponsive servers and on downloads exceeding the timeout.
+func NewCheckpointClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: time.Minute,
+		},
+	}
+}
+
+// CheckpointPath returns the path of the root checkpoint for the given state
+// commitment, relative to the base URL of a checkpoint server. File servers
+// hosting checkpoints use the same layout.
+func CheckpointPath(commit flow.StateCommitment) string {
+	return "/" + hex.EncodeToString(commit[:]) + "/" + bootstrap.FilenameWALRootCheckpoint
+}
+
+// FetchRootCheckpoint downloads the checkpoint of the given sealed state
+// commitment from a checkpoint server or file server, and stores it as root
+// checkpoint in the execution state directory. The checkpoint is not trusted:
+// callers must verify that the ledger restored from it holds the state
+// commitment, see VerifyRootCheckpoint.
+func FetchRootCheckpoint(ctx context.Context, log zerolog.Logger, client *http.Client, baseURL string, commit flow.StateCommitment, triedir string) error {
+
+	url := strings.TrimSuffix(baseURL, "/") + CheckpointPath(commit)
+	log = log.With().Str("url", url).Hex("state_commitment", commit[:]).Logger()
+
+	err := os.MkdirAll(triedir, 0700)
+	if err != nil {
+		return fmt.Errorf("could not create execution state directory: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	log.Info().Msg("fetching root checkpoint")
+	start := time.Now()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not fetch checkpoint: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not fetch checkpoint: unexpected status %s", resp.Status)
+	}
+
+	path := filepath.Join(triedir, bootstrap.FilenameWALRootCheckpoint)
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("could not create checkpoint file: %w", err)
+	}
+
+	size, err := io.Copy(file, resp.Body)
+	if err == nil {
+		err = file.Sync()
+	}
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("could not download checkpoint: %w", err)
+	}
+
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return fmt.Errorf("could not move checkpoint file: %w", err)
+	}
+
+	log.Info().
+		Int64("size", size).
+		Dur("duration", time.Since(start)).
+		Msg("root checkpoint fetched")
+
+	return nil
+}
+
+// StateHolder is a ledger which can tell if it holds a given state.
+type StateHolder interface {
+	HasState(state ledger.State) bool
+}
+
+// StateVerifier is a ledger which can verify the trie of a given state.
+type StateVerifier interface {
+	// VerifyState returns an error if the ledger doesn't hold the trie of the
+	// given state, or if its node hashes don't match the content of the trie.
+	VerifyState(state ledger.State) error
+}
+
+// VerifyRootCheckpoint checks that the ledger restored from a root checkpoint
+// holds the sealed state commitment the execution node is bootstrapped with.
+// The hashes stored in a checkpoint are not trusted: all hashes of the trie are
+// recomputed from its registers. Otherwise, the root checkpoint is removed, so
+// that a new one is fetched when the node restarts.
+func VerifyRootCheckpoint(verifier StateVerifier, commit flow.StateCommitment, triedir string) error {
+	verifyErr := verifier.VerifyState(ledger.State(commit))
+	if verifyErr == nil {
+		return nil
+	}
+
+	err := os.Remove(filepath.Join(triedir, bootstrap.FilenameWALRootCheckpoint))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("invalid state commitment %x in root checkpoint (%v), and could not remove it: %w", commit, verifyErr, err)
+	}
+	return fmt.Errorf("invalid state commitment %x in root checkpoint: %w", commit, verifyErr)
+}
+
+// CheckpointWriter is a ledger which can write a checkpoint of a state.
+type CheckpointWriter interface {
+	StateHolder
+	WriteCheckpointAt(state ledger.State, writer io.Writer) error
+}
+
+// CheckpointServer serves checkpoints of the states held by the ledger of an
+// execution node, so that execution nodes using dynamic startup can fetch their
+// root checkpoint from it.
+type CheckpointServer struct {
+	server *http.Server
+	log    zerolog.Logger
+	ledger CheckpointWriter
+}
+
+// NewCheckpointServer creates a server which serves checkpoints on the given
+// address once it is ready.
+func NewCheckpointServer(log zerolog.Logger, address string, ledger CheckpointWriter) *CheckpointServer {
+	s := &CheckpointServer{
+		log:    log.With().Str("component", "checkpoint_server").Logger(),
+		ledger: ledger,
+	}
+	s.server = &http.Server{Addr: address, Handler: s}
+	return s
+}
+
+// ServeHTTP serves the checkpoint for requests to CheckpointPath.
+func (s *CheckpointServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if len(parts) != 2 || parts[1] != bootstrap.FilenameWALRootCheckpoint {
+		http.NotFound(w, r)
+		return
+	}
+	commitBytes, err := hex.DecodeString(parts[0])
+	if err != nil {
+		http.Error(w, "invalid state commitment", http.StatusBadRequest)
+		return
+	}
+	commit, err := flow.ToStateCommitment(commitBytes)
+	if err != nil {
+		http.Error(w, "invalid state commitment", http.StatusBadRequest)
+		return
+	}
+	if !s.ledger.HasState(ledger.State(commit)) {
+		http.NotFound(w, r)
+		return
+	}
+
+	log := s.log.With().Hex("state_commitment", commit[:]).Str("remote", r.RemoteAddr).Logger()
+	log.Info().Msg("serving checkpoint")
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	err = s.ledger.WriteCheckpointAt(ledger.State(commit), w)
+	if err != nil {
+		// the status was sent already, the client detects the incomplete
+		// checkpoint by its checksum
+		log.Error().Err(err).Msg("could not write checkpoint")
+		return
+	}
+	log.Info().Msg("checkpoint served")
+}
+
+// Ready starts serving checkpoints.
+func (s *CheckpointServer) Ready() <-chan struct{} {
+	ready := make(chan struct{})
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil {
+			// http.ErrServerClosed is returned when Close or Shutdown is called
+			if errors.Is(err, http.ErrServerClosed) {
+				s.log.Debug().Err(err).Msg("checkpoint server shutdown")
+			} else {
+				s.log.Err(err).Msg("error shutting down checkpoint server")
+			}
+		}
+	}()
+	close(ready)
+	return ready
+}
+
+// Done stops serving checkpoints.
+func (s *CheckpointServer) Done() <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_ = s.server.Shutdown(ctx)
+		cancel()
+		close(done)
+	}()
+	return done
+}
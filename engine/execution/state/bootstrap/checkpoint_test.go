@@ -0,0 +1,108 @@
+package bootstrap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/hash"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	completeLedger "github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/ledger/complete/wal/fixtures"
+	bootstrapFilenames "github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// loadLedger restores a ledger from the root checkpoint in the given execution state directory.
+func loadLedger(t *testing.T, dir string) *completeLedger.Ledger {
+	diskWal, err := wal.NewDiskWAL(zerolog.Nop(), nil, &metrics.NoopCollector{}, dir, 100, pathfinder.PathByteSize, wal.SegmentSize)
+	require.NoError(t, err)
+	ls, err := completeLedger.NewLedger(diskWal, 100, &metrics.NoopCollector{}, zerolog.Nop(), completeLedger.DefaultPathFinderVersion)
+	require.NoError(t, err)
+	return ls
+}
+
+func TestFetchRootCheckpoint(t *testing.T) {
+	ls, err := completeLedger.NewLedger(&fixtures.NoopWAL{}, 100, &metrics.NoopCollector{}, zerolog.Nop(), completeLedger.DefaultPathFinderVersion)
+	require.NoError(t, err)
+
+	keys := utils.RandomUniqueKeys(10, 2, 16, 16)
+	values := utils.RandomValues(10, 16, 32)
+	update, err := ledger.NewUpdate(ls.InitialState(), keys, values)
+	require.NoError(t, err)
+	state, _, err := ls.Set(update)
+	require.NoError(t, err)
+	commit := flow.StateCommitment(state)
+
+	server := httptest.NewServer(NewCheckpointServer(zerolog.Nop(), "", ls))
+	defer server.Close()
+
+	t.Run("fetch and verify", func(t *testing.T) {
+		unittest.RunWithTempDir(t, func(dir string) {
+			err := FetchRootCheckpoint(context.Background(), zerolog.Nop(), NewCheckpointClient(DefaultCheckpointFetchTimeout), server.URL, commit, dir)
+			require.NoError(t, err)
+
+			tries, err := wal.LoadCheckpoint(filepath.Join(dir, bootstrapFilenames.FilenameWALRootCheckpoint), &zerolog.Logger{})
+			require.NoError(t, err)
+			require.Len(t, tries, 1)
+			assert.Equal(t, ledger.RootHash(state), tries[0].RootHash())
+
+			require.NoError(t, VerifyRootCheckpoint(loadLedger(t, dir), commit, dir))
+			assert.FileExists(t, filepath.Join(dir, bootstrapFilenames.FilenameWALRootCheckpoint))
+		})
+	})
+
+	t.Run("mismatching checkpoint is removed", func(t *testing.T) {
+		unittest.RunWithTempDir(t, func(dir string) {
+			err := FetchRootCheckpoint(context.Background(), zerolog.Nop(), NewCheckpointClient(DefaultCheckpointFetchTimeout), server.URL, commit, dir)
+			require.NoError(t, err)
+
+			assert.Error(t, VerifyRootCheckpoint(loadLedger(t, dir), unittest.StateCommitmentFixture(), dir))
+			assert.NoFileExists(t, filepath.Join(dir, bootstrapFilenames.FilenameWALRootCheckpoint))
+		})
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		unittest.RunWithTempDir(t, func(dir string) {
+			err := FetchRootCheckpoint(context.Background(), zerolog.Nop(), NewCheckpointClient(DefaultCheckpointFetchTimeout), server.URL, unittest.StateCommitmentFixture(), dir)
+			assert.Error(t, err)
+			assert.NoFileExists(t, filepath.Join(dir, bootstrapFilenames.FilenameWALRootCheckpoint))
+		})
+	})
+
+	t.Run("forged checkpoint is removed", func(t *testing.T) {
+		// the root node of the forged trie claims the sealed state commitment, but holds other registers
+		payload := utils.LightPayload(1, 1)
+		root := node.NewNode(ledger.NodeMaxHeight, nil, nil, utils.PathByUint8(0), payload, hash.Hash(commit))
+		forged, err := trie.NewMTrie(root, 1, uint64(payload.Size()))
+		require.NoError(t, err)
+
+		forgingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = wal.StoreCheckpoint(w, forged)
+		}))
+		defer forgingServer.Close()
+
+		unittest.RunWithTempDir(t, func(dir string) {
+			err := FetchRootCheckpoint(context.Background(), zerolog.Nop(), NewCheckpointClient(DefaultCheckpointFetchTimeout), forgingServer.URL, commit, dir)
+			require.NoError(t, err)
+
+			ls := loadLedger(t, dir)
+			require.True(t, ls.HasState(ledger.State(commit)))
+			assert.Error(t, VerifyRootCheckpoint(ls, commit, dir))
+			assert.NoFileExists(t, filepath.Join(dir, bootstrapFilenames.FilenameWALRootCheckpoint))
+		})
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/dgraph-io/badger/v2"
 
 	"github.com/onflow/flow-go/engine/execution/state/delta"
 	"github.com/onflow/flow-go/ledger"
@@ -18,6 +17,7 @@ import (
 	"github.com/onflow/flow-go/storage"
 	badgerstorage "github.com/onflow/flow-go/storage/badger"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // ReadOnlyExecutionState allows to read the execution state
@@ -89,7 +89,7 @@ type state struct {
 	events             storage.Events
 	serviceEvents      storage.ServiceEvents
 	transactionResults storage.TransactionResults
-	db                 *badger.DB
+	db                 kv.DB
 }
 
 func RegisterIDToKey(reg flow.RegisterID) ledger.Key {
@@ -114,7 +114,7 @@ func NewExecutionState(
 	events storage.Events,
 	serviceEvents storage.ServiceEvents,
 	transactionResults storage.TransactionResults,
-	db *badger.DB,
+	db kv.DB,
 	tracer module.Tracer,
 ) ExecutionState {
 	return &state{
@@ -439,7 +439,7 @@ func (s *state) RetrieveStateDelta(ctx context.Context, blockID flow.Identifier)
 	var serviceEvents []flow.Event
 	var txResults []flow.TransactionResult
 
-	err = s.db.View(func(txn *badger.Txn) error {
+	err = s.db.View(func(txn kv.Transaction) error {
 		err = operation.LookupStateCommitment(blockID, &endStateCommitment)(txn)
 		if err != nil {
 			return fmt.Errorf("cannot lookup state commitment: %w", err)
@@ -505,7 +505,7 @@ func (s *state) UpdateHighestExecutedBlockIfHigher(ctx context.Context, header *
 		defer span.Finish()
 	}
 
-	return operation.RetryOnConflict(s.db.Update, func(txn *badger.Txn) error {
+	return operation.RetryOnConflict(s.db.Update, func(txn kv.Transaction) error {
 		var blockID flow.Identifier
 		err := operation.RetrieveExecutedBlock(&blockID)(txn)
 		if err != nil {
@@ -533,7 +533,7 @@ func (s *state) UpdateHighestExecutedBlockIfHigher(ctx context.Context, header *
 func (s *state) GetHighestExecutedBlockID(ctx context.Context) (uint64, flow.Identifier, error) {
 	var blockID flow.Identifier
 	var highest flow.Header
-	err := s.db.View(func(tx *badger.Txn) error {
+	err := s.db.View(func(tx kv.Transaction) error {
 		err := operation.RetrieveExecutedBlock(&blockID)(tx)
 		if err != nil {
 			return fmt.Errorf("could not lookup executed block: %w", err)
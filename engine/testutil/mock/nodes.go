@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +16,7 @@ import (
 	followereng "github.com/onflow/flow-go/engine/common/follower"
 	"github.com/onflow/flow-go/engine/common/provider"
 	"github.com/onflow/flow-go/engine/common/requester"
+	"github.com/onflow/flow-go/engine/common/slashing"
 	"github.com/onflow/flow-go/engine/common/synchronization"
 	consensusingest "github.com/onflow/flow-go/engine/consensus/ingestion"
 	"github.com/onflow/flow-go/engine/consensus/matching"
@@ -50,6 +50,7 @@ import (
 	"github.com/onflow/flow-go/state/protocol/events"
 	"github.com/onflow/flow-go/storage"
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -57,8 +58,8 @@ import (
 // as well as all of its backend dependencies.
 type StateFixture struct {
 	DBDir          string
-	PublicDB       *badger.DB
-	SecretsDB      *badger.DB
+	PublicDB       kv.DB
+	SecretsDB      kv.DB
 	Storage        *storage.All
 	ProtocolEvents *events.Distributor
 	State          protocol.MutableState
@@ -73,8 +74,8 @@ type GenericNode struct {
 	Log            zerolog.Logger
 	Metrics        *metrics.NoopCollector
 	Tracer         module.Tracer
-	PublicDB       *badger.DB
-	SecretsDB      *badger.DB
+	PublicDB       kv.DB
+	SecretsDB      kv.DB
 	Headers        storage.Headers
 	Guarantees     storage.Guarantees
 	Seals          storage.Seals
@@ -128,6 +129,7 @@ type CollectionNode struct {
 	IngestionEngine    *collectioningest.Engine
 	PusherEngine       *pusher.Engine
 	ProviderEngine     *provider.Engine
+	SlashingEngine     *slashing.Engine
 	EpochManagerEngine *epochmgr.Engine
 }
 
@@ -136,6 +138,7 @@ func (n CollectionNode) Ready() <-chan struct{} {
 	return util.AllReady(
 		n.PusherEngine,
 		n.ProviderEngine,
+		n.SlashingEngine,
 		n.IngestionEngine,
 		n.EpochManagerEngine,
 	)
@@ -148,6 +151,7 @@ func (n CollectionNode) Done() <-chan struct{} {
 		<-util.AllDone(
 			n.PusherEngine,
 			n.ProviderEngine,
+			n.SlashingEngine,
 			n.IngestionEngine,
 			n.EpochManagerEngine,
 		)
@@ -205,7 +209,7 @@ type ExecutionNode struct {
 	FollowerEngine      *followereng.Engine
 	SyncEngine          *synchronization.Engine
 	DiskWAL             *wal.DiskWAL
-	BadgerDB            *badger.DB
+	BadgerDB            kv.DB
 	VM                  *fvm.VirtualMachine
 	ExecutionState      state.ExecutionState
 	Ledger              ledger.Ledger
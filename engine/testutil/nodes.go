@@ -27,6 +27,7 @@ import (
 	"github.com/onflow/flow-go/engine/common/follower"
 	"github.com/onflow/flow-go/engine/common/provider"
 	"github.com/onflow/flow-go/engine/common/requester"
+	"github.com/onflow/flow-go/engine/common/slashing"
 	"github.com/onflow/flow-go/engine/common/synchronization"
 	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
 	consensusingest "github.com/onflow/flow-go/engine/consensus/ingestion"
@@ -79,6 +80,7 @@ import (
 	"github.com/onflow/flow-go/state/protocol/events/gadgets"
 	"github.com/onflow/flow-go/state/protocol/util"
 	storage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -212,9 +214,9 @@ func CompleteStateFixture(
 	dataDir := unittest.TempDir(t)
 	publicDBDir := filepath.Join(dataDir, "protocol")
 	secretsDBDir := filepath.Join(dataDir, "secrets")
-	db := unittest.TypedBadgerDB(t, publicDBDir, storage.InitPublic)
+	db := badgerkv.New(unittest.TypedBadgerDB(t, publicDBDir, storage.InitPublic))
 	s := storage.InitAll(metric, db)
-	secretsDB := unittest.TypedBadgerDB(t, secretsDBDir, storage.InitSecret)
+	secretsDB := badgerkv.New(unittest.TypedBadgerDB(t, secretsDBDir, storage.InitSecret))
 	consumer := events.NewDistributor()
 
 	state, err := badgerstate.Bootstrap(metric, db, s.Headers, s.Seals, s.Results, s.Blocks, s.Setups, s.EpochCommits, s.Statuses, rootSnapshot)
@@ -265,6 +267,9 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 	pusherEngine, err := pusher.New(node.Log, node.Net, node.State, node.Metrics, node.Metrics, node.Me, collections, transactions)
 	require.NoError(t, err)
 
+	slashingEngine, err := slashing.New(node.Log, node.Metrics, node.Net, node.Me, node.State, storage.NewSlashingEvidence(node.PublicDB), slashing.NewVerifier(node.State))
+	require.NoError(t, err)
+
 	clusterStateFactory, err := factories.NewClusterStateFactory(
 		node.PublicDB,
 		node.Metrics,
@@ -309,6 +314,7 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 		node.PublicDB,
 		node.State,
 		createMetrics,
+		slashingEngine,
 		consensus.WithInitialTimeout(time.Second*2),
 	)
 	require.NoError(t, err)
@@ -325,6 +331,7 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 
 	rootQCVoter := new(mockmodule.ClusterRootQCVoter)
 	rootQCVoter.On("Vote", mock.Anything, mock.Anything).Return(nil)
+	rootQCVoter.On("ClusterVotes", mock.Anything, mock.Anything).Return(nil, nil, nil)
 
 	heights := gadgets.NewHeights()
 	node.ProtocolEvents.AddConsumer(heights)
@@ -335,6 +342,8 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 		node.State,
 		pools,
 		rootQCVoter,
+		storage.NewRootQCVotes(node.PublicDB),
+		metrics.NewNoopCollector(),
 		factory,
 		heights,
 	)
@@ -350,6 +359,7 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 		IngestionEngine:    ingestionEngine,
 		PusherEngine:       pusherEngine,
 		ProviderEngine:     providerEngine,
+		SlashingEngine:     slashingEngine,
 		EpochManagerEngine: epochManager,
 	}
 }
@@ -708,6 +718,18 @@ func (s *RoundRobinLeaderSelection) Identity(blockID flow.Identifier, participan
 	return id, nil
 }
 
+func (s *RoundRobinLeaderSelection) IdentitiesByEpoch(view uint64, selector flow.IdentityFilter) (flow.IdentityList, error) {
+	return s.identities.Filter(selector), nil
+}
+
+func (s *RoundRobinLeaderSelection) IdentityByEpoch(view uint64, participantID flow.Identifier) (*flow.Identity, error) {
+	id, found := s.identities.ByNodeID(participantID)
+	if !found {
+		return nil, fmt.Errorf("not found")
+	}
+	return id, nil
+}
+
 func (s *RoundRobinLeaderSelection) LeaderForView(view uint64) (flow.Identifier, error) {
 	return s.identities[int(view)%len(s.identities)].NodeID, nil
 }
@@ -720,6 +742,10 @@ func (s *RoundRobinLeaderSelection) DKG(blockID flow.Identifier) (hotstuff.DKG,
 	return nil, fmt.Errorf("error")
 }
 
+func (s *RoundRobinLeaderSelection) DKGByEpoch(view uint64) (hotstuff.DKG, error) {
+	return nil, fmt.Errorf("error")
+}
+
 func createFollowerCore(t *testing.T, node *testmock.GenericNode, followerState *badgerstate.FollowerState, notifier hotstuff.FinalizationConsumer,
 	rootHead *flow.Header, rootQC *flow.QuorumCertificate) (module.HotStuffFollower, *confinalizer.Finalizer) {
 
@@ -734,7 +760,7 @@ func createFollowerCore(t *testing.T, node *testmock.GenericNode, followerState
 	// mock finalization updater
 	verifier := &mockhotstuff.Verifier{}
 	verifier.On("VerifyVote", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-	verifier.On("VerifyQC", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	verifier.On("VerifyQC", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	finalizer := confinalizer.NewFinalizer(node.PublicDB, node.Headers, followerState, trace.NewNoopTracer())
 
@@ -853,7 +879,9 @@ func VerificationNode(t testing.TB,
 			node.State,
 			node.Me,
 			chunkVerifier,
-			approvalStorage)
+			approvalStorage,
+			verifier.DefaultMaxWorkers,
+			verifier.DefaultMaxInFlightBytes)
 		require.Nil(t, err)
 	}
 
@@ -899,7 +927,8 @@ func VerificationNode(t testing.TB,
 			node.ProcessedChunkIndex,
 			node.ChunksQueue,
 			node.FetcherEngine,
-			chunkconsumer.DefaultChunkWorkers) // defaults number of workers to 3.
+			chunkconsumer.DefaultChunkWorkers, // defaults number of workers to 3.
+			chunkconsumer.DefaultChunkWorkers)
 		err = mempoolCollector.Register(metrics.ResourceChunkConsumer, node.ChunkConsumer.Size)
 		require.NoError(t, err)
 	}
@@ -5,7 +5,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
@@ -17,6 +16,7 @@ import (
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/module/trace"
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -115,7 +115,7 @@ func withConsumer(
 	process func(notifier module.ProcessingNotifier, block *flow.Block),
 	withBlockConsumer func(*blockconsumer.BlockConsumer, []*flow.Block),
 ) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		maxProcessing := uint64(workerCount)
 
 		processedHeight := bstorage.NewConsumerProgress(db, module.ConsumeProgressVerificationBlockHeight)
@@ -2,6 +2,7 @@ package chunkconsumer
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/rs/zerolog"
 
@@ -12,18 +13,28 @@ import (
 )
 
 const (
-	DefaultJobIndex     = uint64(0)
-	DefaultChunkWorkers = uint64(5)
+	DefaultJobIndex        = uint64(0)
+	DefaultChunkWorkers    = uint64(5)
+	DefaultMaxChunkWorkers = uint64(20)
 )
 
 // ChunkConsumer consumes the jobs from the job queue, and pass it to the
 // Worker for processing.
 // It wraps the generic job consumer in order to be used as a ReadyDoneAware
 // on startup
+//
+// The number of jobs processed in parallel adapts to the depth of the chunks queue:
+// it grows up to maxWorkers while chunk jobs are piling up, and shrinks back to
+// minWorkers once the queue is drained.
 type ChunkConsumer struct {
-	consumer       module.JobConsumer
+	log            zerolog.Logger
+	consumer       *jobqueue.Consumer
 	chunkProcessor fetcher.AssignedChunkProcessor
+	chunksQueue    storage.ChunksQueue
 	metrics        module.VerificationMetrics
+	adjustLock     sync.Mutex // serializes adjustments of the worker count
+	minWorkers     uint64
+	maxWorkers     uint64
 }
 
 func NewChunkConsumer(
@@ -32,20 +43,29 @@ func NewChunkConsumer(
 	processedIndex storage.ConsumerProgress, // to persist the processed index
 	chunksQueue storage.ChunksQueue, // to read jobs (chunks) from
 	chunkProcessor fetcher.AssignedChunkProcessor, // to process jobs (chunks)
-	maxProcessing uint64, // max number of jobs to be processed in parallel
+	minWorkers uint64, // min number of jobs to be processed in parallel
+	maxWorkers uint64, // max number of jobs to be processed in parallel when the chunks queue is backed up
 ) *ChunkConsumer {
+	if maxWorkers < minWorkers {
+		maxWorkers = minWorkers
+	}
+
 	worker := NewWorker(chunkProcessor)
 	chunkProcessor.WithChunkConsumerNotifier(worker)
 
 	jobs := &ChunkJobs{locators: chunksQueue}
 
 	lg := log.With().Str("module", "chunk_consumer").Logger()
-	consumer := jobqueue.NewConsumer(lg, jobs, processedIndex, worker, maxProcessing)
+	consumer := jobqueue.NewConsumer(lg, jobs, processedIndex, worker, minWorkers)
 
 	chunkConsumer := &ChunkConsumer{
+		log:            lg,
 		consumer:       consumer,
 		chunkProcessor: chunkProcessor,
+		chunksQueue:    chunksQueue,
 		metrics:        metrics,
+		minWorkers:     minWorkers,
+		maxWorkers:     maxWorkers,
 	}
 
 	worker.consumer = chunkConsumer
@@ -56,6 +76,7 @@ func NewChunkConsumer(
 func (c *ChunkConsumer) NotifyJobIsDone(jobID module.JobID) {
 	processedIndex := c.consumer.NotifyJobIsDone(jobID)
 	c.metrics.OnChunkConsumerJobDone(processedIndex)
+	c.adjustWorkers()
 }
 
 // Size returns number of in-memory chunk jobs that chunk consumer is processing.
@@ -63,15 +84,63 @@ func (c *ChunkConsumer) Size() uint {
 	return c.consumer.Size()
 }
 
-func (c ChunkConsumer) Check() {
+// Workers returns the number of chunk jobs currently allowed to be processed in parallel.
+func (c *ChunkConsumer) Workers() uint64 {
+	return c.consumer.MaxProcessing()
+}
+
+func (c *ChunkConsumer) Check() {
+	c.adjustWorkers()
 	c.consumer.Check()
 }
 
+// adjustWorkers sets the number of chunk jobs processed in parallel to the number of pending
+// chunk jobs, bounded by the min and max number of workers.
+func (c *ChunkConsumer) adjustWorkers() {
+	c.adjustLock.Lock()
+	defer c.adjustLock.Unlock()
+
+	latest, err := c.chunksQueue.LatestIndex()
+	if err != nil {
+		c.log.Error().Err(err).Msg("could not read latest index of chunks queue")
+		return
+	}
+	processed := c.consumer.ProcessedIndex()
+	pending := uint64(0)
+	if latest > processed {
+		pending = latest - processed
+	}
+	c.metrics.SetChunkConsumerPendingJobs(pending)
+
+	target := pending
+	if target < c.minWorkers {
+		target = c.minWorkers
+	}
+	if target > c.maxWorkers {
+		target = c.maxWorkers
+	}
+
+	current := c.consumer.MaxProcessing()
+	if target == current {
+		return
+	}
+
+	c.consumer.SetMaxProcessing(target)
+	c.metrics.SetChunkConsumerWorkers(target)
+
+	c.log.Debug().
+		Uint64("pending", pending).
+		Uint64("previous_workers", current).
+		Uint64("workers", target).
+		Msg("chunk consumer workers adjusted")
+}
+
 func (c *ChunkConsumer) Ready() <-chan struct{} {
 	err := c.consumer.Start(DefaultJobIndex)
 	if err != nil {
 		panic(fmt.Errorf("could not start the chunk consumer for match engine: %w", err))
 	}
+	c.metrics.SetChunkConsumerWorkers(c.consumer.MaxProcessing())
 
 	return c.chunkProcessor.Ready()
 }
@@ -5,8 +5,8 @@ import (
 	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
 
@@ -15,6 +15,7 @@ import (
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
 	storage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -136,14 +137,76 @@ func TestProduceConsume(t *testing.T) {
 	})
 }
 
+// TestAdaptiveWorkers evaluates that the chunk consumer raises its number of workers up to the maximum while
+// chunk jobs are piling up, and lowers it back to the minimum once the chunks queue has been drained.
+func TestAdaptiveWorkers(t *testing.T) {
+	var called chunks.LocatorList
+	var notifier module.ProcessingNotifier
+	lock := &sync.Mutex{}
+	block := func(n module.ProcessingNotifier, locator *chunks.Locator) {
+		lock.Lock()
+		defer lock.Unlock()
+		notifier = n
+		called = append(called, locator)
+	}
+
+	WithAdaptiveConsumer(t, 2, 6, block, func(consumer *chunkconsumer.ChunkConsumer, chunksQueue *storage.ChunksQueue) {
+		<-consumer.Ready()
+		require.Equal(t, uint64(2), consumer.Workers())
+
+		locators := unittest.ChunkLocatorListFixture(10)
+		for i, locator := range locators {
+			ok, err := chunksQueue.StoreChunkLocator(locator)
+			require.NoError(t, err, fmt.Sprintf("chunk locator %v can't be stored", i))
+			require.True(t, ok)
+			consumer.Check() // notify the consumer
+		}
+
+		// the engine blocks on all jobs, so the consumer grows to its maximum number of workers
+		require.Eventually(t, func() bool {
+			lock.Lock()
+			defer lock.Unlock()
+			return len(called) == 6
+		}, time.Second, 10*time.Millisecond)
+		require.Equal(t, uint64(6), consumer.Workers())
+
+		// finishing all jobs drains the queue, and the consumer shrinks back to its minimum
+		for i := 0; i < len(locators); i++ {
+			require.Eventually(t, func() bool {
+				lock.Lock()
+				defer lock.Unlock()
+				return len(called) > i
+			}, time.Second, 10*time.Millisecond)
+			lock.Lock()
+			locator := called[i]
+			lock.Unlock()
+			notifier.Notify(locator.ID())
+		}
+		require.Equal(t, uint64(2), consumer.Workers())
+
+		<-consumer.Done()
+		require.Equal(t, locators, called)
+	})
+}
+
 func WithConsumer(
 	t *testing.T,
 	process func(module.ProcessingNotifier, *chunks.Locator),
 	withConsumer func(*chunkconsumer.ChunkConsumer, *storage.ChunksQueue),
 ) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
-		maxProcessing := uint64(3)
+	WithAdaptiveConsumer(t, 3, 3, process, withConsumer)
+}
 
+// WithAdaptiveConsumer runs the test function with a chunk consumer whose number of workers adapts
+// between the given min and max number of workers.
+func WithAdaptiveConsumer(
+	t *testing.T,
+	minWorkers uint64,
+	maxWorkers uint64,
+	process func(module.ProcessingNotifier, *chunks.Locator),
+	withConsumer func(*chunkconsumer.ChunkConsumer, *storage.ChunksQueue),
+) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		processedIndex := storage.NewConsumerProgress(db, module.ConsumeProgressVerificationChunkIndex)
 		chunksQueue := storage.NewChunkQueue(db)
 		ok, err := chunksQueue.Init(chunkconsumer.DefaultJobIndex)
@@ -161,7 +224,8 @@ func WithConsumer(
 			processedIndex,
 			chunksQueue,
 			engine,
-			maxProcessing,
+			minWorkers,
+			maxWorkers,
 		)
 
 		withConsumer(consumer, chunksQueue)
@@ -0,0 +1,113 @@
+package verifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const (
+	// DefaultMaxWorkers is the default number of chunks verified in parallel.
+	DefaultMaxWorkers = uint(4)
+
+	// DefaultMaxInFlightBytes is the default limit on the total size of the chunk data packs
+	// of chunks verified in parallel.
+	DefaultMaxInFlightBytes = uint64(2 << 30) // 2 GB
+)
+
+// admission bounds the number of chunks verified in parallel, as well as the total size of their
+// chunk data packs. Verifying a chunk requires an in-memory partial trie built from the chunk data
+// pack, so the memory consumption of the verifier grows with the size of the chunk data packs being
+// verified. A chunk whose data pack alone exceeds the memory budget is admitted once no other chunk
+// is being verified, so that it can not be starved.
+type admission struct {
+	mu       sync.Mutex
+	maxCount uint          // max number of chunks in flight
+	maxBytes uint64        // max total size of chunk data packs in flight, zero means unlimited
+	count    uint          // number of chunks in flight
+	bytes    uint64        // total size of chunk data packs in flight
+	released chan struct{} // closed and replaced whenever a chunk is released
+}
+
+func newAdmission(maxCount uint, maxBytes uint64) *admission {
+	if maxCount == 0 {
+		maxCount = 1
+	}
+	return &admission{
+		maxCount: maxCount,
+		maxBytes: maxBytes,
+		released: make(chan struct{}),
+	}
+}
+
+// acquire blocks until a chunk with a chunk data pack of the given size can be admitted, or until
+// the context is canceled, in which case the context error is returned.
+func (a *admission) acquire(ctx context.Context, size uint64) error {
+	for {
+		a.mu.Lock()
+		if a.admissible(size) {
+			a.count++
+			a.bytes += size
+			a.mu.Unlock()
+			return nil
+		}
+		released := a.released
+		a.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// release frees the resources of an admitted chunk with a chunk data pack of the given size.
+func (a *admission) release(size uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.count--
+	a.bytes -= size
+	close(a.released)
+	a.released = make(chan struct{})
+}
+
+// inFlight returns the number of chunks in flight and the total size of their chunk data packs.
+func (a *admission) inFlight() (uint, uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.count, a.bytes
+}
+
+// admissible must be called with the lock held.
+func (a *admission) admissible(size uint64) bool {
+	if a.count >= a.maxCount {
+		return false
+	}
+	if a.count == 0 || a.maxBytes == 0 {
+		return true
+	}
+	return a.bytes+size <= a.maxBytes
+}
+
+// chunkDataPackSize estimates the memory footprint of verifying the chunk data pack by the size of
+// its storage proof and the scripts and arguments of its transactions.
+func chunkDataPackSize(pack *flow.ChunkDataPack) uint64 {
+	if pack == nil {
+		return 0
+	}
+	size := uint64(len(pack.Proof))
+	if pack.Collection == nil {
+		return size
+	}
+	for _, tx := range pack.Collection.Transactions {
+		size += uint64(len(tx.Script))
+		for _, arg := range tx.Arguments {
+			size += uint64(len(arg))
+		}
+	}
+	return size
+}
@@ -0,0 +1,85 @@
+package verifier
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestAdmission evaluates that the admission bounds the number of chunks in flight and the total
+// size of their chunk data packs, and that oversized chunks are admitted when nothing else is in flight.
+func TestAdmission(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bounded by number of workers", func(t *testing.T) {
+		a := newAdmission(2, 0)
+		require.NoError(t, a.acquire(ctx, 10))
+		require.NoError(t, a.acquire(ctx, 10))
+
+		admitted := make(chan struct{})
+		go func() {
+			require.NoError(t, a.acquire(ctx, 10))
+			close(admitted)
+		}()
+		unittest.RequireNeverClosedWithin(t, admitted, 50*time.Millisecond, "third chunk must wait for a worker")
+
+		a.release(10)
+		unittest.RequireCloseBefore(t, admitted, time.Second, "third chunk must be admitted once a worker is released")
+
+		count, bytes := a.inFlight()
+		assert.Equal(t, uint(2), count)
+		assert.Equal(t, uint64(20), bytes)
+	})
+
+	t.Run("bounded by in-flight bytes", func(t *testing.T) {
+		a := newAdmission(10, 100)
+		require.NoError(t, a.acquire(ctx, 60))
+
+		admitted := make(chan struct{})
+		go func() {
+			require.NoError(t, a.acquire(ctx, 60))
+			close(admitted)
+		}()
+		unittest.RequireNeverClosedWithin(t, admitted, 50*time.Millisecond, "chunk must wait for memory budget")
+
+		a.release(60)
+		unittest.RequireCloseBefore(t, admitted, time.Second, "chunk must be admitted once memory is released")
+	})
+
+	t.Run("oversized chunk admitted alone", func(t *testing.T) {
+		a := newAdmission(10, 100)
+		require.NoError(t, a.acquire(ctx, 500))
+
+		count, bytes := a.inFlight()
+		assert.Equal(t, uint(1), count)
+		assert.Equal(t, uint64(500), bytes)
+	})
+
+	t.Run("waiting aborted on cancellation", func(t *testing.T) {
+		a := newAdmission(1, 0)
+		require.NoError(t, a.acquire(ctx, 10))
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, a.acquire(cancelCtx, 10), context.Canceled)
+	})
+}
+
+func TestChunkDataPackSize(t *testing.T) {
+	assert.Equal(t, uint64(0), chunkDataPackSize(nil))
+
+	tx := unittest.TransactionBodyFixture(func(tx *flow.TransactionBody) {
+		tx.Arguments = [][]byte{[]byte("abc")}
+	})
+	pack := &flow.ChunkDataPack{
+		Proof:      []byte("proof"),
+		Collection: &flow.Collection{Transactions: []*flow.TransactionBody{&tx}},
+	}
+	assert.Equal(t, uint64(len("proof")+len(tx.Script)+len("abc")), chunkDataPackSize(pack))
+}
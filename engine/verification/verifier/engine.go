@@ -3,6 +3,7 @@ package verifier
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/opentracing/opentracing-go/log"
 	"github.com/rs/zerolog"
@@ -29,6 +30,11 @@ import (
 // as input it accepts verifiable chunks (chunk + all data needed) and perform verification by
 // constructing a partial trie, executing transactions and check the final state commitment and
 // other chunk meta data (e.g. tx count)
+//
+// Chunks are verified concurrently, up to a maximum number of workers. Admission of chunks is
+// additionally bounded by the total size of the chunk data packs being verified, so that bursts
+// of large chunks can not exhaust the memory of the node. Chunks waiting for admission block the
+// fetcher engine, which propagates the backpressure to the chunk consumer.
 type Engine struct {
 	unit        *engine.Unit               // used to control startup/shutdown
 	log         zerolog.Logger             // used to log relevant actions
@@ -42,6 +48,7 @@ type Engine struct {
 	chVerif     module.ChunkVerifier       // used to verify chunks
 	spockHasher hash.Hasher                // used for generating spocks
 	approvals   storage.ResultApprovals    // used to store result approvals
+	admission   *admission                 // used to bound the number and size of chunks verified in parallel
 }
 
 // New creates and returns a new instance of a verifier engine.
@@ -54,6 +61,8 @@ func New(
 	me module.Local,
 	chVerif module.ChunkVerifier,
 	approvals storage.ResultApprovals,
+	maxWorkers uint,
+	maxInFlightBytes uint64,
 ) (*Engine, error) {
 
 	e := &Engine{
@@ -67,6 +76,7 @@ func New(
 		rah:         utils.NewResultApprovalHasher(),
 		spockHasher: crypto.NewBLSKMAC(encoding.SPOCKTag),
 		approvals:   approvals,
+		admission:   newAdmission(maxWorkers, maxInFlightBytes),
 	}
 
 	var err error
@@ -335,8 +345,25 @@ func (e *Engine) verifiableChunkHandler(originID flow.Identifier, ch *verificati
 
 	log.Info().Msg("verifiable chunk received")
 
+	// waits until the chunk can be verified without exceeding the verification workers or memory budget
+	size := chunkDataPackSize(ch.ChunkDataPack)
+	waitStart := time.Now()
+	err := e.admission.acquire(e.unit.Ctx(), size)
+	if err != nil {
+		log.Info().Err(err).Msg("verifier shutting down, chunk not verified")
+		return nil
+	}
+	e.metrics.OnVerifiableChunkAdmittedAtVerifier(time.Since(waitStart))
+	e.reportInFlight()
+	defer func() {
+		e.admission.release(size)
+		e.reportInFlight()
+	}()
+
 	// starts verification of chunk
-	err := e.verify(ctx, originID, ch)
+	verifyStart := time.Now()
+	err = e.verify(ctx, originID, ch)
+	e.metrics.OnChunkVerifiedAtVerifier(time.Since(verifyStart))
 
 	if err != nil {
 		log.Info().Err(err).Msg("could not verify chunk")
@@ -346,6 +373,12 @@ func (e *Engine) verifiableChunkHandler(originID flow.Identifier, ch *verificati
 	return nil
 }
 
+// reportInFlight reports the number and size of the chunks currently being verified.
+func (e *Engine) reportInFlight() {
+	count, bytes := e.admission.inFlight()
+	e.metrics.SetVerifierInFlight(uint64(count), bytes)
+}
+
 func (e *Engine) approvalRequestHandler(originID flow.Identifier, req *messages.ApprovalRequest) error {
 
 	log := e.log.With().
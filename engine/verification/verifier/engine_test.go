@@ -106,7 +106,9 @@ func (suite *VerifierEngineTestSuite) TestNewEngine() *verifier.Engine {
 		suite.state,
 		suite.me,
 		ChunkVerifierMock{},
-		suite.approvals)
+		suite.approvals,
+		verifier.DefaultMaxWorkers,
+		verifier.DefaultMaxInFlightBytes)
 	require.Nil(suite.T(), err)
 
 	suite.net.AssertExpectations(suite.T())
@@ -137,6 +139,10 @@ func (suite *VerifierEngineTestSuite) TestVerifyHappyPath() {
 	// mocks metrics
 	// reception of verifiable chunk
 	suite.metrics.On("OnVerifiableChunkReceivedAtVerifierEngine").Return()
+	// admission and verification of verifiable chunk
+	suite.metrics.On("OnVerifiableChunkAdmittedAtVerifier", testifymock.Anything).Return()
+	suite.metrics.On("SetVerifierInFlight", testifymock.Anything, testifymock.Anything).Return()
+	suite.metrics.On("OnChunkVerifiedAtVerifier", testifymock.Anything).Return()
 	// emission of result approval
 	suite.metrics.On("OnResultApprovalDispatchedInNetworkByVerifier").Return()
 
@@ -179,6 +185,10 @@ func (suite *VerifierEngineTestSuite) TestVerifyUnhappyPaths() {
 	// mocks metrics
 	// reception of verifiable chunk
 	suite.metrics.On("OnVerifiableChunkReceivedAtVerifierEngine").Return()
+	// admission and verification of verifiable chunk
+	suite.metrics.On("OnVerifiableChunkAdmittedAtVerifier", testifymock.Anything).Return()
+	suite.metrics.On("SetVerifierInFlight", testifymock.Anything, testifymock.Anything).Return()
+	suite.metrics.On("OnChunkVerifiedAtVerifier", testifymock.Anything).Return()
 
 	// we shouldn't receive any result approval
 	suite.pushCon.
@@ -33,8 +33,9 @@ const (
 
 	// Unqualified names of service events (not including address prefix or contract name)
 
-	EventNameEpochSetup  = "EpochSetup"
-	EventNameEpochCommit = "EpochCommit"
+	EventNameEpochSetup   = "EpochSetup"
+	EventNameEpochCommit  = "EpochCommit"
+	EventNameEpochRecover = "EpochRecover"
 
 	//  Unqualified names of service event contract functions (not including address prefix or contract name)
 
@@ -80,8 +81,9 @@ type SystemContracts struct {
 
 // ServiceEvents is a container for all service events on a particular chain.
 type ServiceEvents struct {
-	EpochSetup  ServiceEvent
-	EpochCommit ServiceEvent
+	EpochSetup   ServiceEvent
+	EpochCommit  ServiceEvent
+	EpochRecover ServiceEvent
 }
 
 // All returns all service events as a slice.
@@ -89,6 +91,7 @@ func (se ServiceEvents) All() []ServiceEvent {
 	return []ServiceEvent{
 		se.EpochSetup,
 		se.EpochCommit,
+		se.EpochRecover,
 	}
 }
 
@@ -135,6 +138,11 @@ func ServiceEventsForChain(chainID flow.ChainID) (*ServiceEvents, error) {
 			ContractName: ContractNameEpoch,
 			Name:         EventNameEpochCommit,
 		},
+		EpochRecover: ServiceEvent{
+			Address:      addresses[ContractNameEpoch],
+			ContractName: ContractNameEpoch,
+			Name:         EventNameEpochRecover,
+		},
 	}
 
 	return events, nil
@@ -3,6 +3,7 @@ package dkg
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	sdk "github.com/onflow/flow-go-sdk"
@@ -21,8 +22,9 @@ var errClientDisabled = fmt.Errorf("dkg client artifically disabled for tests")
 // smart-contract. The client can be disabled so that all requests to the
 // emulator return an error.
 type DKGClientWrapper struct {
-	client  *dkgmod.Client
-	enabled bool
+	client     *dkgmod.Client
+	enabled    bool
+	broadcasts uint64 // number of broadcast messages sent to the smart-contract
 }
 
 // NewDKGClientWrapper instantiates a new DKGClientWrapper
@@ -58,11 +60,17 @@ func (c *DKGClientWrapper) WaitForSealed(ctx context.Context, txID sdk.Identifie
 	return c.client.WaitForSealed(ctx, txID, started)
 }
 
+// Broadcasts returns the number of broadcast messages sent to the smart-contract
+func (c *DKGClientWrapper) Broadcasts() uint64 {
+	return atomic.LoadUint64(&c.broadcasts)
+}
+
 // Broadcast implements the DKGContractClient interface
 func (c *DKGClientWrapper) Broadcast(msg model.BroadcastDKGMessage) error {
 	if !c.enabled {
 		return fmt.Errorf("failed to broadcast DKG message: %w", errClientDisabled)
 	}
+	atomic.AddUint64(&c.broadcasts, 1)
 	return c.client.Broadcast(msg)
 }
 
@@ -29,6 +29,7 @@ import (
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/dkg"
 	emulatormod "github.com/onflow/flow-go/module/emulator"
+	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/network/stub"
 	"github.com/onflow/flow-go/state/protocol/events/gadgets"
 	"github.com/onflow/flow-go/storage/badger"
@@ -49,7 +50,8 @@ type DKGSuite struct {
 	dkgAddress             sdk.Address
 	dkgAccountKey          *sdk.AccountKey
 	dkgSigner              sdkcrypto.Signer
-	checkDKGUnhappy        bool // activate log hook for DKGBroker to check if the DKG core is flagging misbehaviours
+	checkDKGUnhappy        bool                   // activate log hook for DKGBroker to check if the DKG core is flagging misbehaviours
+	transport              dkg.BroadcastTransport // transport used by the DKG brokers to disseminate broadcast messages
 
 	netIDs       flow.IdentityList
 	nodeAccounts []*nodeAccount
@@ -456,10 +458,12 @@ func (s *DKGSuite) initEngines(node *node, ids flow.IdentityList) {
 		dkgState,
 		dkg.NewControllerFactory(
 			controllerFactoryLogger,
+			metrics.NewNoopCollector(),
 			core.Me,
 			[]module.DKGContractClient{node.dkgContractClient},
 			brokerTunnel,
 			config,
+			dkg.WithBroadcastTransport(s.transport),
 		),
 		viewsObserver,
 	)
@@ -13,11 +13,17 @@ import (
 
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/dkg"
 	"github.com/onflow/flow-go/module/signature"
 )
 
 func TestWithEmulator(t *testing.T) {
-	suite.Run(t, new(DKGSuite))
+	t.Run("contract broadcast", func(t *testing.T) {
+		suite.Run(t, &DKGSuite{transport: dkg.ContractBroadcast})
+	})
+	t.Run("p2p broadcast", func(t *testing.T) {
+		suite.Run(t, &DKGSuite{transport: dkg.P2PBroadcast})
+	})
 }
 
 func (s *DKGSuite) runTest(goodNodes int, emulatorProblems bool) {
@@ -97,7 +103,8 @@ func (s *DKGSuite) runTest(goodNodes int, emulatorProblems bool) {
 			}
 		}
 
-		// deliver private messages
+		// deliver private messages, and broadcast messages when using the
+		// p2p broadcast transport
 		s.hub.DeliverAll()
 
 		// submit a tx to force the emulator to create and finalize a block
@@ -125,6 +132,14 @@ func (s *DKGSuite) runTest(goodNodes int, emulatorProblems bool) {
 	completed := s.isDKGCompleted()
 	assert.True(s.T(), completed)
 
+	// with the p2p broadcast transport, the smart-contract is only used to
+	// submit the results
+	if s.transport == dkg.P2PBroadcast {
+		for _, node := range nodes {
+			assert.Zero(s.T(), node.dkgContractClient.Broadcasts())
+		}
+	}
+
 	// the result is an array of public keys where the first item is the group
 	// public key
 	res := s.getResult()
@@ -397,7 +397,6 @@ func prepareConsensusService(container testnet.ContainerConfig, i int, n int) Se
 		fmt.Sprintf("--hotstuff-timeout=%s", timeout),
 		fmt.Sprintf("--hotstuff-min-timeout=%s", timeout),
 		fmt.Sprintf("--chunk-alpha=1"),
-		fmt.Sprintf("--emergency-sealing-active=false"),
 		fmt.Sprintf("--insecure-access-api=false"),
 		fmt.Sprint("--access-node-ids=*"),
 	)
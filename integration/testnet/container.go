@@ -26,6 +26,8 @@ import (
 	state "github.com/onflow/flow-go/state/protocol/badger"
 	"github.com/onflow/flow-go/state/protocol/inmem"
 	storage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 )
 
 var (
@@ -196,14 +198,17 @@ func (c *Container) Name() string {
 }
 
 // DB returns the node's database.
-func (c *Container) DB() (*badger.DB, error) {
+func (c *Container) DB() (kv.DB, error) {
 	opts := badger.
 		DefaultOptions(c.DBPath()).
 		WithKeepL0InMemory(true).
 		WithLogger(nil)
 
 	db, err := badger.Open(opts)
-	return db, err
+	if err != nil {
+		return nil, err
+	}
+	return badgerkv.New(db), nil
 }
 
 func (c *Container) DBPath() string {
@@ -335,6 +335,7 @@ type NetworkConfig struct {
 	ViewsInDKGPhase       uint64
 	ViewsInStakingAuction uint64
 	ViewsInEpoch          uint64
+	SealingParameters     flow.SealingParameters
 }
 
 type NetworkConfigOpt func(*NetworkConfig)
@@ -347,6 +348,7 @@ func NewNetworkConfig(name string, nodes []NodeConfig, opts ...NetworkConfigOpt)
 		ViewsInStakingAuction: DefaultViewsInStakingAuction,
 		ViewsInDKGPhase:       DefaultViewsInDKGPhase,
 		ViewsInEpoch:          DefaultViewsInEpoch,
+		SealingParameters:     flow.DefaultSealingParameters(),
 	}
 
 	for _, apply := range opts {
@@ -364,6 +366,7 @@ func NewNetworkConfigWithEpochConfig(name string, nodes []NodeConfig, viewsInSta
 		ViewsInStakingAuction: viewsInStakingAuction,
 		ViewsInDKGPhase:       viewsInDKGPhase,
 		ViewsInEpoch:          viewsInEpoch,
+		SealingParameters:     flow.DefaultSealingParameters(),
 	}
 
 	for _, apply := range opts {
@@ -391,6 +394,21 @@ func WithViewsInDKGPhase(views uint64) func(*NetworkConfig) {
 	}
 }
 
+// WithRequiredApprovals sets the number of approvals per chunk required to construct
+// and to verify seals, keeping the default emergency sealing parameters.
+func WithRequiredApprovals(construction, verification uint) func(*NetworkConfig) {
+	return func(config *NetworkConfig) {
+		config.SealingParameters.RequiredApprovalsForSealConstruction = construction
+		config.SealingParameters.RequiredApprovalsForSealVerification = verification
+	}
+}
+
+func WithSealingParameters(params flow.SealingParameters) func(*NetworkConfig) {
+	return func(config *NetworkConfig) {
+		config.SealingParameters = params
+	}
+}
+
 func WithClusters(n uint) func(*NetworkConfig) {
 	return func(conf *NetworkConfig) {
 		conf.NClusters = n
@@ -1153,7 +1171,7 @@ func BootstrapNetwork(networkConf NetworkConfig, bootstrapDir string) (*flow.Blo
 		return nil, nil, nil, nil, nil, fmt.Errorf("generating root seal failed: %w", err)
 	}
 
-	snapshot, err := inmem.SnapshotFromBootstrapState(root, result, seal, qc)
+	snapshot, err := inmem.SnapshotFromBootstrapStateWithParams(root, result, seal, qc, flow.DefaultProtocolVersion, networkConf.SealingParameters)
 	if err != nil {
 		return nil, nil, nil, nil, nil, fmt.Errorf("could not create bootstrap state snapshot: %w", err)
 	}
@@ -140,8 +140,6 @@ func (suite *ConsensusFollowerSuite) buildNetworkConfig() {
 	consensusConfigs := []func(config *testnet.NodeConfig){
 		testnet.WithAdditionalFlag("--hotstuff-timeout=12s"),
 		testnet.WithAdditionalFlag("--block-rate-delay=100ms"),
-		testnet.WithAdditionalFlag(fmt.Sprintf("--required-verification-seal-approvals=%d", 1)),
-		testnet.WithAdditionalFlag(fmt.Sprintf("--required-construction-seal-approvals=%d", 1)),
 		testnet.WithLogLevel(zerolog.FatalLevel),
 	}
 
@@ -169,7 +167,7 @@ func (suite *ConsensusFollowerSuite) buildNetworkConfig() {
 	}
 
 	// consensus followers
-	conf := testnet.NewNetworkConfig("consensus follower test", net, testnet.WithConsensusFollowers(followerConfigs...))
+	conf := testnet.NewNetworkConfig("consensus follower test", net, testnet.WithConsensusFollowers(followerConfigs...), testnet.WithRequiredApprovals(1, 1))
 	suite.net = testnet.PrepareFlowNetwork(suite.T(), conf)
 
 	follower1 := suite.net.ConsensusFollowerByID(followerConfigs[0].NodeID)
@@ -0,0 +1,407 @@
+package epochs
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	sdk "github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/onflow/flow-go/cmd/bootstrap/run"
+	"github.com/onflow/flow-go/fvm/systemcontracts"
+	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/flow/filter"
+	"github.com/onflow/flow-go/state/cluster"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/utils/io"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// recoverEpochTxTemplate commits a recovery epoch through the FlowEpoch admin resource,
+// which emits the EpochRecover service event. The arguments follow the field layout of
+// the EpochRecover event.
+const recoverEpochTxTemplate = `
+import FlowEpoch from 0x%s
+
+transaction(
+	counter: UInt64,
+	nodeIDs: [String],
+	firstView: UInt64,
+	finalView: UInt64,
+	clusterAssignments: [[String]],
+	randomSource: String,
+	DKGPhase1FinalView: UInt64,
+	DKGPhase2FinalView: UInt64,
+	DKGPhase3FinalView: UInt64,
+	clusterQCVoterIDs: [[String]],
+	clusterQCSignatures: [String],
+	dkgPubKeys: [String]
+) {
+	prepare(signer: AuthAccount) {
+		let admin = signer.borrow<&FlowEpoch.Admin>(from: FlowEpoch.adminStoragePath)
+			?? panic("could not borrow epoch admin")
+
+		admin.recoverEpoch(
+			counter: counter,
+			nodeIDs: nodeIDs,
+			firstView: firstView,
+			finalView: finalView,
+			clusterAssignments: clusterAssignments,
+			randomSource: randomSource,
+			DKGPhase1FinalView: DKGPhase1FinalView,
+			DKGPhase2FinalView: DKGPhase2FinalView,
+			DKGPhase3FinalView: DKGPhase3FinalView,
+			clusterQCVoterIDs: clusterQCVoterIDs,
+			clusterQCSignatures: clusterQCSignatures,
+			dkgPubKeys: dkgPubKeys
+		)
+	}
+}
+`
+
+// epochRecoverEventDecl declares the EpochRecover service event, for FlowEpoch contracts
+// which predate it. The fields have the layout of the EpochSetup event, followed by the
+// cluster QCs and DKG keys of the EpochCommit event.
+const epochRecoverEventDecl = `
+    pub event EpochRecover(
+        counter: UInt64,
+        nodeInfo: [FlowIDTableStaking.NodeInfo],
+        firstView: UInt64,
+        finalView: UInt64,
+        collectorClusters: [FlowClusterQC.Cluster],
+        randomSource: String,
+        DKGPhase1FinalView: UInt64,
+        DKGPhase2FinalView: UInt64,
+        DKGPhase3FinalView: UInt64,
+        clusterQCVoteData: [FlowClusterQC.ClusterQC],
+        dkgPubKeys: [String],
+    )
+`
+
+// recoverEpochFunc adds the recoverEpoch function to the Admin resource of FlowEpoch
+// contracts which predate it. It resets the epoch state of the contract to the recovery
+// epoch, so that the contract resumes regular epoch transitions from the recovery epoch,
+// and emits the EpochRecover service event.
+const recoverEpochFunc = `
+        pub fun recoverEpoch(
+            counter: UInt64,
+            nodeIDs: [String],
+            firstView: UInt64,
+            finalView: UInt64,
+            clusterAssignments: [[String]],
+            randomSource: String,
+            DKGPhase1FinalView: UInt64,
+            DKGPhase2FinalView: UInt64,
+            DKGPhase3FinalView: UInt64,
+            clusterQCVoterIDs: [[String]],
+            clusterQCSignatures: [String],
+            dkgPubKeys: [String]
+        ) {
+            let nodeInfo: [FlowIDTableStaking.NodeInfo] = []
+            for nodeID in nodeIDs {
+                nodeInfo.append(FlowIDTableStaking.NodeInfo(nodeID: nodeID))
+            }
+
+            let clusters: [FlowClusterQC.Cluster] = []
+            let clusterQCs: [FlowClusterQC.ClusterQC] = []
+            var index = 0
+            while index < clusterAssignments.length {
+                let nodeWeights: {String: UInt64} = {}
+                for nodeID in clusterAssignments[index] {
+                    nodeWeights[nodeID] = FlowIDTableStaking.NodeInfo(nodeID: nodeID).initialWeight
+                }
+                clusters.append(FlowClusterQC.Cluster(index: UInt16(index), nodeWeights: nodeWeights))
+                clusterQCs.append(FlowClusterQC.ClusterQC(
+                    index: UInt16(index),
+                    signatures: [clusterQCSignatures[index]],
+                    message: "",
+                    voterIDs: clusterQCVoterIDs[index]
+                ))
+                index = index + 1
+            }
+
+            // reset the epoch state of the contract through the heartbeat, which ends
+            // the QC voting and DKG of the failed epoch
+            let heartbeat = FlowEpoch.account.borrow<&FlowEpoch.Heartbeat>(from: FlowEpoch.heartbeatStoragePath)
+                ?? panic("could not borrow epoch heartbeat")
+            heartbeat.resetEpoch(
+                currentEpochCounter: counter - 1,
+                randomSource: randomSource,
+                newPayout: nil,
+                startView: firstView,
+                stakingEndView: DKGPhase1FinalView - FlowEpoch.configurableMetadata.numViewsInDKGPhase,
+                endView: finalView,
+                collectorClusters: clusters,
+                clusterQCs: clusterQCs,
+                dkgPubKeys: dkgPubKeys
+            )
+
+            emit EpochRecover(
+                counter: counter,
+                nodeInfo: nodeInfo,
+                firstView: firstView,
+                finalView: finalView,
+                collectorClusters: clusters,
+                randomSource: randomSource,
+                DKGPhase1FinalView: DKGPhase1FinalView,
+                DKGPhase2FinalView: DKGPhase2FinalView,
+                DKGPhase3FinalView: DKGPhase3FinalView,
+                clusterQCVoteData: clusterQCs,
+                dkgPubKeys: dkgPubKeys
+            )
+        }
+`
+
+// updateContractTxTemplate updates a contract of the signer's account.
+const updateContractTxTemplate = `
+transaction(name: String, code: String) {
+	prepare(signer: AuthAccount) {
+		signer.contracts.update__experimental(name: name, code: code.decodeHex())
+	}
+}
+`
+
+func TestEpochRecover(t *testing.T) {
+	suite.Run(t, new(EpochRecoverSuite))
+}
+
+// EpochRecoverSuite tests that the network falls back to epoch emergency chain
+// continuation (EECC) when the next epoch is not committed in time, and resumes
+// epoch transitions once an EpochRecover service event commits a recovery epoch.
+type EpochRecoverSuite struct {
+	Suite
+}
+
+func (s *EpochRecoverSuite) SetupTest() {
+	// use shorter epoch phases as no staking operations need to occur in the
+	// staking phase for this test
+	s.StakingAuctionLen = 10
+	s.DKGPhaseLen = 50
+	s.EpochLen = 250
+
+	// run the generic setup, which starts up the network
+	s.Suite.SetupTest()
+}
+
+// TestRecoverEpoch triggers EECC by preventing the cluster QC voting for the next epoch,
+// checks that the current epoch is extended beyond its final view, and then recovers the
+// network with an EpochRecover service event.
+func (s *EpochRecoverSuite) TestRecoverEpoch() {
+
+	// pause the only collection node, so that the cluster QC voting for epoch 1 cannot
+	// complete and epoch 1 is never committed
+	collection := s.net.ContainersByRole(flow.RoleCollection)[0]
+	require.NoError(s.T(), collection.Pause())
+
+	snapshot, err := s.client.GetLatestProtocolSnapshot(s.ctx)
+	require.NoError(s.T(), err)
+	epoch0FinalView, err := snapshot.Epochs().Current().FinalView()
+	require.NoError(s.T(), err)
+
+	// consensus continues beyond the final view of epoch 0, which is extended by EECC
+	s.waitForFinalizedView(epoch0FinalView+10, 5*time.Minute)
+	s.assertEpochCounter(s.ctx, 0)
+	s.assertInPhase(s.ctx, flow.EpochPhaseSetup)
+
+	// resume the collection node, so that the recovery transaction can be processed
+	require.NoError(s.T(), collection.Start())
+
+	// the pinned core contracts may not be able to emit the EpochRecover event yet, in
+	// which case we upgrade the FlowEpoch contract with the recoverEpoch admin function
+	chainID := s.net.Root().Header.ChainID
+	contracts, err := systemcontracts.SystemContractsForChain(chainID)
+	require.NoError(s.T(), err)
+	s.ensureRecoverEpochContract(contracts.Epoch)
+
+	// commit a recovery epoch starting shortly after the current view
+	snapshot, err = s.client.GetLatestProtocolSnapshot(s.ctx)
+	require.NoError(s.T(), err)
+	head, err := snapshot.Head()
+	require.NoError(s.T(), err)
+	recoveryFirstView := head.View + 100
+	s.submitRecoverEpochTx(snapshot, contracts.Epoch.Address, recoveryFirstView)
+
+	// the network transitions into the recovery epoch at its first view
+	s.waitForFinalizedView(recoveryFirstView+1, 5*time.Minute)
+	s.assertEpochCounter(s.ctx, 1)
+	// EECC is no longer in effect, so the recovery epoch starts with a regular staking phase
+	s.assertInPhase(s.ctx, flow.EpochPhaseStaking)
+
+	snapshot, err = s.client.GetLatestProtocolSnapshot(s.ctx)
+	require.NoError(s.T(), err)
+	firstView, err := snapshot.Epochs().Current().FirstView()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), recoveryFirstView, firstView)
+
+	// the network is healthy in the recovery epoch
+	s.submitSmokeTestTransaction(s.ctx)
+}
+
+// waitForFinalizedView waits until the access node has finalized a block with at least the given view.
+func (s *EpochRecoverSuite) waitForFinalizedView(view uint64, timeout time.Duration) {
+	require.Eventually(s.T(), func() bool {
+		snapshot, err := s.client.GetLatestProtocolSnapshot(s.ctx)
+		require.NoError(s.T(), err)
+		head, err := snapshot.Head()
+		require.NoError(s.T(), err)
+		return head.View >= view
+	}, timeout, 500*time.Millisecond, fmt.Sprintf("did not finalize view %d within %v", view, timeout))
+}
+
+// ensureRecoverEpochContract upgrades the deployed FlowEpoch contract with the EpochRecover
+// service event and the recoverEpoch admin function, unless it already has them.
+func (s *EpochRecoverSuite) ensureRecoverEpochContract(epoch systemcontracts.SystemContract) {
+	epochAccount, err := s.client.GetAccount(sdk.Address(epoch.Address))
+	require.NoError(s.T(), err)
+	code := string(epochAccount.Contracts[epoch.Name])
+	if strings.Contains(code, "fun recoverEpoch") {
+		return
+	}
+
+	contractDecl := fmt.Sprintf("pub contract %s {\n", epoch.Name)
+	adminDecl := "pub resource Admin {\n"
+	require.Contains(s.T(), code, contractDecl)
+	require.Contains(s.T(), code, adminDecl)
+	code = strings.Replace(code, contractDecl, contractDecl+epochRecoverEventDecl, 1)
+	code = strings.Replace(code, adminDecl, adminDecl+recoverEpochFunc, 1)
+
+	s.sendServiceTx(
+		[]byte(updateContractTxTemplate),
+		epoch.Address,
+		cadenceString(s.T(), epoch.Name),
+		cadenceString(s.T(), hex.EncodeToString([]byte(code))),
+	)
+}
+
+// submitRecoverEpochTx commits a recovery epoch with the participants and DKG of the current
+// epoch, starting at the given view. The cluster QCs for the recovery epoch are signed with
+// the collection node keys from the bootstrap directory.
+func (s *EpochRecoverSuite) submitRecoverEpochTx(snapshot protocol.Snapshot, epochAddress flow.Address, firstView uint64) {
+	current := snapshot.Epochs().Current()
+	counter, err := current.Counter()
+	require.NoError(s.T(), err)
+	participants, err := current.InitialIdentities()
+	require.NoError(s.T(), err)
+	clustering, err := current.Clustering()
+	require.NoError(s.T(), err)
+	dkg, err := current.DKG()
+	require.NoError(s.T(), err)
+
+	recoveryCounter := counter + 1
+	finalView := firstView + s.EpochLen - 1
+
+	nodeIDs := make([]cadence.Value, 0, len(participants))
+	for _, participant := range participants {
+		nodeIDs = append(nodeIDs, cadenceString(s.T(), participant.NodeID.String()))
+	}
+
+	// sign the root blocks of the recovery epoch's clusters
+	clusterAssignments := make([]cadence.Value, 0, len(clustering))
+	voterIDs := make([]cadence.Value, 0, len(clustering))
+	signatures := make([]cadence.Value, 0, len(clustering))
+	for _, members := range clustering {
+		signers := make([]bootstrap.NodeInfo, 0, len(members))
+		ids := make([]cadence.Value, 0, len(members))
+		for _, member := range members {
+			signers = append(signers, s.privateNodeInfo(member))
+			ids = append(ids, cadenceString(s.T(), member.NodeID.String()))
+		}
+		qc, err := run.GenerateClusterRootQC(signers, members, cluster.CanonicalRootBlock(recoveryCounter, members))
+		require.NoError(s.T(), err)
+		vote := flow.ClusterQCVoteDataFromQC(qc)
+
+		voters := make([]cadence.Value, 0, len(vote.VoterIDs))
+		for _, voterID := range vote.VoterIDs {
+			voters = append(voters, cadenceString(s.T(), voterID.String()))
+		}
+		clusterAssignments = append(clusterAssignments, cadence.NewArray(ids))
+		voterIDs = append(voterIDs, cadence.NewArray(voters))
+		signatures = append(signatures, cadenceString(s.T(), hex.EncodeToString(vote.SigData)))
+	}
+
+	// reuse the DKG of the current epoch: the group key, followed by the key shares in DKG index order
+	consensus := participants.Filter(filter.HasRole(flow.RoleConsensus))
+	dkgKeys := make([]cadence.Value, len(consensus)+1)
+	dkgKeys[0] = cadenceString(s.T(), dkg.GroupKey().String())
+	for _, node := range consensus {
+		index, err := dkg.Index(node.NodeID)
+		require.NoError(s.T(), err)
+		share, err := dkg.KeyShare(node.NodeID)
+		require.NoError(s.T(), err)
+		dkgKeys[index+1] = cadenceString(s.T(), share.String())
+	}
+
+	s.sendServiceTx(
+		[]byte(fmt.Sprintf(recoverEpochTxTemplate, epochAddress.Hex())),
+		epochAddress,
+		cadence.NewUInt64(recoveryCounter),
+		cadence.NewArray(nodeIDs),
+		cadence.NewUInt64(firstView),
+		cadence.NewUInt64(finalView),
+		cadence.NewArray(clusterAssignments),
+		cadenceString(s.T(), hex.EncodeToString(unittest.SeedFixture(flow.EpochSetupRandomSourceLength))),
+		cadence.NewUInt64(firstView+s.StakingAuctionLen+s.DKGPhaseLen),
+		cadence.NewUInt64(firstView+s.StakingAuctionLen+2*s.DKGPhaseLen),
+		cadence.NewUInt64(firstView+s.StakingAuctionLen+3*s.DKGPhaseLen),
+		cadence.NewArray(voterIDs),
+		cadence.NewArray(signatures),
+		cadence.NewArray(dkgKeys),
+	)
+}
+
+// sendServiceTx sends the transaction with the given script and arguments, authorized by the
+// given account, which is controlled by the service account key, and waits until it is sealed.
+func (s *EpochRecoverSuite) sendServiceTx(script []byte, authorizer flow.Address, args ...cadence.Value) {
+	latestBlockID, err := s.client.GetLatestBlockID(s.ctx)
+	require.NoError(s.T(), err)
+	tx := sdk.NewTransaction().
+		SetScript(script).
+		SetGasLimit(9999).
+		SetReferenceBlockID(sdk.Identifier(latestBlockID)).
+		SetProposalKey(s.client.SDKServiceAddress(), 0, s.client.Account().Keys[0].SequenceNumber).
+		SetPayer(s.client.SDKServiceAddress()).
+		AddAuthorizer(sdk.Address(authorizer))
+	for _, arg := range args {
+		require.NoError(s.T(), tx.AddArgument(arg))
+	}
+
+	err = s.client.SignAndSendTransaction(s.ctx, tx)
+	require.NoError(s.T(), err)
+	result, err := s.client.WaitForSealed(s.ctx, tx.ID())
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), result.Error)
+	s.client.Account().Keys[0].SequenceNumber++
+}
+
+// privateNodeInfo reads the private keys of the given node from the bootstrap directory.
+func (s *EpochRecoverSuite) privateNodeInfo(identity *flow.Identity) bootstrap.NodeInfo {
+	path := filepath.Join(s.net.BootstrapDir, fmt.Sprintf(bootstrap.PathNodeInfoPriv, identity.NodeID))
+	data, err := io.ReadFile(path)
+	require.NoError(s.T(), err)
+	var private bootstrap.NodeInfoPriv
+	err = json.Unmarshal(data, &private)
+	require.NoError(s.T(), err)
+
+	return bootstrap.NewPrivateNodeInfo(
+		identity.NodeID,
+		identity.Role,
+		identity.Address,
+		identity.Weight,
+		private.NetworkPrivKey.PrivateKey,
+		private.StakingPrivKey.PrivateKey,
+	)
+}
+
+func cadenceString(t *testing.T, s string) cadence.String {
+	value, err := cadence.NewString(s)
+	require.NoError(t, err)
+	return value
+}
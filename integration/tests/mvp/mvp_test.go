@@ -128,8 +128,6 @@ func buildMVPNetConfig() testnet.NetworkConfig {
 	consensusConfigs := []func(config *testnet.NodeConfig){
 		testnet.WithAdditionalFlag("--hotstuff-timeout=12s"),
 		testnet.WithAdditionalFlag("--block-rate-delay=100ms"),
-		testnet.WithAdditionalFlag(fmt.Sprintf("--required-verification-seal-approvals=%d", 1)),
-		testnet.WithAdditionalFlag(fmt.Sprintf("--required-construction-seal-approvals=%d", 1)),
 		testnet.WithLogLevel(zerolog.FatalLevel),
 	}
 
@@ -145,7 +143,7 @@ func buildMVPNetConfig() testnet.NetworkConfig {
 		testnet.NewNodeConfig(flow.RoleAccess, testnet.WithLogLevel(zerolog.FatalLevel)),
 	}
 
-	return testnet.NewNetworkConfig("mvp", net)
+	return testnet.NewNetworkConfig("mvp", net, testnet.WithRequiredApprovals(1, 1))
 }
 
 func runMVPTest(t *testing.T, ctx context.Context, net *testnet.FlowNetwork) {
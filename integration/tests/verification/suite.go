@@ -82,8 +82,6 @@ func (s *Suite) SetupSuite() {
 			testnet.WithID(nodeID),
 			testnet.WithLogLevel(zerolog.FatalLevel),
 			testnet.WithAdditionalFlag("--hotstuff-timeout=12s"),
-			testnet.WithAdditionalFlag("--required-verification-seal-approvals=1"),
-			testnet.WithAdditionalFlag("--required-construction-seal-approvals=1"),
 			testnet.WithAdditionalFlag(blockRateFlag),
 		)
 		s.nodeConfigs = append(s.nodeConfigs, nodeConfig)
@@ -144,6 +142,7 @@ func (s *Suite) SetupSuite() {
 		// set long staking phase to avoid QC/DKG transactions during test run
 		testnet.WithViewsInStakingAuction(10_000),
 		testnet.WithViewsInEpoch(100_000),
+		testnet.WithRequiredApprovals(1, 1),
 	)
 
 	s.net = testnet.PrepareFlowNetwork(s.T(), netConfig)
@@ -12,6 +12,7 @@ import (
 	"github.com/onflow/flow-go/ledger/common/hash"
 	"github.com/onflow/flow-go/ledger/common/pathfinder"
 	"github.com/onflow/flow-go/ledger/complete/mtrie"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
 	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
 	"github.com/onflow/flow-go/ledger/complete/wal"
 	"github.com/onflow/flow-go/module"
@@ -46,15 +47,28 @@ func NewLedger(
 	metrics module.LedgerMetrics,
 	log zerolog.Logger,
 	pathFinderVer uint8) (*Ledger, error) {
+	return NewLedgerWithNodeStorage(wal, capacity, metrics, log, pathFinderVer, nil)
+}
+
+// NewLedgerWithNodeStorage creates a new trie-backed ledger storage with persistence, which
+// offloads trie nodes to the given node storage to bound its memory usage.
+// If nodeStorage is nil, the tries are held in memory.
+func NewLedgerWithNodeStorage(
+	wal wal.LedgerWAL,
+	capacity int,
+	metrics module.LedgerMetrics,
+	log zerolog.Logger,
+	pathFinderVer uint8,
+	nodeStorage *node.Storage) (*Ledger, error) {
 
 	logger := log.With().Str("ledger", "complete").Logger()
 
-	forest, err := mtrie.NewForest(capacity, metrics, func(evictedTrie *trie.MTrie) {
+	forest, err := mtrie.NewForestWithNodeStorage(capacity, metrics, func(evictedTrie *trie.MTrie) {
 		err := wal.RecordDelete(evictedTrie.RootHash())
 		if err != nil {
 			logger.Error().Err(err).Msg("failed to save delete record in wal")
 		}
-	})
+	}, nodeStorage)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create forest: %w", err)
 	}
@@ -93,10 +107,13 @@ func (l *Ledger) Ready() <-chan struct{} {
 }
 
 // Done implements interface module.ReadyDoneAware
-// it closes all the open write-ahead log files.
+// it stops offloading and pruning trie nodes in the background.
 func (l *Ledger) Done() <-chan struct{} {
 	done := make(chan struct{})
-	close(done)
+	go func() {
+		l.forest.Close()
+		close(done)
+	}()
 	return done
 }
 
@@ -303,7 +320,10 @@ func (l *Ledger) ExportCheckpointAt(
 	// l.logger.Info().Msg("Trie is valid.")
 
 	// get all payloads
-	payloads := t.AllPayloads()
+	payloads, err := t.AllPayloads()
+	if err != nil {
+		return ledger.State(hash.DummyHash), fmt.Errorf("cannot get payloads of the trie: %w", err)
+	}
 	payloadSize := len(payloads)
 
 	// migrate payloads
@@ -403,6 +423,43 @@ func (l *Ledger) MostRecentTouchedState() (ledger.State, error) {
 	return ledger.State(root), err
 }
 
+// HasState returns true if the ledger holds the trie of the given state.
+func (l *Ledger) HasState(state ledger.State) bool {
+	_, err := l.forest.GetTrie(ledger.RootHash(state))
+	return err == nil
+}
+
+// VerifyState recomputes all hashes of the trie of the given state, and
+// returns an error if the ledger doesn't hold the trie or if any cached hash
+// doesn't match. This reads the whole trie, including offloaded nodes.
+func (l *Ledger) VerifyState(state ledger.State) error {
+	trie, err := l.forest.GetTrie(ledger.RootHash(state))
+	if err != nil {
+		return fmt.Errorf("cannot find the target trie: %w", err)
+	}
+	if !trie.IsAValidTrie() {
+		return fmt.Errorf("trie of state %s has invalid node hashes", state)
+	}
+	return nil
+}
+
+// WriteCheckpointAt writes a checkpoint containing only the trie of the given
+// state, which can be used as root checkpoint by other execution nodes.
+func (l *Ledger) WriteCheckpointAt(state ledger.State, writer io.Writer) error {
+	trie, err := l.forest.GetTrie(ledger.RootHash(state))
+	if err != nil {
+		return fmt.Errorf("cannot find the target trie: %w", err)
+	}
+	return wal.StoreCheckpoint(writer, trie)
+}
+
+// Diff calls fn for each register whose payload differs between the two given states, in
+// ascending order of register paths. Unallocated registers are considered absent.
+// The walk stops at the first error returned by fn, which is returned as is.
+func (l *Ledger) Diff(from ledger.State, to ledger.State, fn func(change ledger.PayloadChange) error) error {
+	return l.forest.Diff(ledger.RootHash(from), ledger.RootHash(to), fn)
+}
+
 // DumpTrieAsJSON export trie at specific state as JSONL (each line is JSON encoding of a payload)
 func (l *Ledger) DumpTrieAsJSON(state ledger.State, writer io.Writer) error {
 	fmt.Println(ledger.RootHash(state))
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dgraph-io/badger/v2"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,6 +21,8 @@ import (
 	"github.com/onflow/flow-go/ledger/common/proof"
 	"github.com/onflow/flow-go/ledger/common/utils"
 	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/nodestore"
 	"github.com/onflow/flow-go/ledger/complete/wal"
 	"github.com/onflow/flow-go/ledger/complete/wal/fixtures"
 	"github.com/onflow/flow-go/ledger/partial/ptrie"
@@ -122,6 +125,50 @@ func TestLedger_Get(t *testing.T) {
 	})
 }
 
+func TestLedger_Diff(t *testing.T) {
+	led, err := complete.NewLedger(&fixtures.NoopWAL{}, 100, &metrics.NoopCollector{}, zerolog.Logger{}, complete.DefaultPathFinderVersion)
+	require.NoError(t, err)
+
+	k1 := ledger.NewKey([]ledger.KeyPart{ledger.NewKeyPart(0, []byte("a"))})
+	k2 := ledger.NewKey([]ledger.KeyPart{ledger.NewKeyPart(0, []byte("b"))})
+	k3 := ledger.NewKey([]ledger.KeyPart{ledger.NewKeyPart(0, []byte("c"))})
+
+	update, err := ledger.NewUpdate(led.InitialState(), []ledger.Key{k1, k2}, []ledger.Value{[]byte("1"), []byte("2")})
+	require.NoError(t, err)
+	state1, _, err := led.Set(update)
+	require.NoError(t, err)
+
+	// update k1, remove k2 and add k3
+	update, err = ledger.NewUpdate(state1, []ledger.Key{k1, k2, k3}, []ledger.Value{[]byte("10"), nil, []byte("3")})
+	require.NoError(t, err)
+	state2, _, err := led.Set(update)
+	require.NoError(t, err)
+
+	changes := make(map[string]ledger.PayloadChange)
+	err = led.Diff(state1, state2, func(change ledger.PayloadChange) error {
+		key := change.New
+		if key == nil {
+			key = change.Old
+		}
+		changes[key.Key.String()] = change
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, changes, 3)
+	assert.Equal(t, ledger.PayloadUpdated, changes[k1.String()].Type)
+	assert.Equal(t, ledger.Value("1"), changes[k1.String()].Old.Value)
+	assert.Equal(t, ledger.Value("10"), changes[k1.String()].New.Value)
+	assert.Equal(t, ledger.PayloadRemoved, changes[k2.String()].Type)
+	assert.Nil(t, changes[k2.String()].New)
+	assert.Equal(t, ledger.PayloadAdded, changes[k3.String()].Type)
+	assert.Nil(t, changes[k3.String()].Old)
+
+	// unknown states can't be compared
+	err = led.Diff(state1, ledger.State(unittest.StateCommitmentFixture()), func(ledger.PayloadChange) error { return nil })
+	require.Error(t, err)
+}
+
 func TestLedgerValueSizes(t *testing.T) {
 	t.Run("empty query", func(t *testing.T) {
 
@@ -402,6 +449,65 @@ func Test_WAL(t *testing.T) {
 	})
 }
 
+// Test_WALWithNodeStorage tests that a ledger offloading its trie nodes to a node storage
+// replays the WAL written by an in-memory ledger, and serves the same register values.
+func Test_WALWithNodeStorage(t *testing.T) {
+	size := 10
+	metricsCollector := &metrics.NoopCollector{}
+
+	unittest.RunWithTempDir(t, func(dir string) {
+		diskWal, err := wal.NewDiskWAL(zerolog.Nop(), nil, metricsCollector, dir, size, pathfinder.PathByteSize, wal.SegmentSize)
+		require.NoError(t, err)
+		led, err := complete.NewLedger(diskWal, size, metricsCollector, zerolog.Nop(), complete.DefaultPathFinderVersion)
+		require.NoError(t, err)
+
+		state := led.InitialState()
+		queries := make([]*ledger.Query, 0, size)
+		for i := 0; i < size; i++ {
+			keys := utils.RandomUniqueKeys(10, 2, 1, 10)
+			values := utils.RandomValues(10, 1, 100)
+			update, err := ledger.NewUpdate(state, keys, values)
+			require.NoError(t, err)
+			state, _, err = led.Set(update)
+			require.NoError(t, err)
+
+			query, err := ledger.NewQuery(state, keys)
+			require.NoError(t, err)
+			queries = append(queries, query)
+		}
+
+		<-diskWal.Done()
+		<-led.Done()
+
+		unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+			storage, err := node.NewStorage(nodestore.NewBadgerStore(db), 4, 1024)
+			require.NoError(t, err)
+
+			diskWal2, err := wal.NewDiskWAL(zerolog.Nop(), nil, metricsCollector, dir, size, pathfinder.PathByteSize, wal.SegmentSize)
+			require.NoError(t, err)
+			led2, err := complete.NewLedgerWithNodeStorage(diskWal2, size, metricsCollector, zerolog.Nop(), complete.DefaultPathFinderVersion, storage)
+			require.NoError(t, err)
+
+			for _, query := range queries {
+				expected, err := led.Get(query)
+				require.NoError(t, err)
+				values, err := led2.Get(query)
+				require.NoError(t, err)
+				require.True(t, valuesMatches(expected, values))
+
+				expectedProof, err := led.Prove(query)
+				require.NoError(t, err)
+				proof, err := led2.Prove(query)
+				require.NoError(t, err)
+				require.Equal(t, expectedProof, proof)
+			}
+
+			<-diskWal2.Done()
+			<-led2.Done()
+		})
+	})
+}
+
 func TestLedgerFunctionality(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	// You can manually increase this for more coverage
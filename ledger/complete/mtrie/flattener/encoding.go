@@ -48,9 +48,14 @@ const payloadEncodingVersion = 1
 // WARNING: The returned buffer is likely to share the same underlying array as
 // the scratch buffer. Caller is responsible for copying or using returned buffer
 // before scratch buffer is used again.
-func encodeLeafNode(n *node.Node, scratch []byte) []byte {
+func encodeLeafNode(n *node.Node, scratch []byte) ([]byte, error) {
 
-	encPayloadSize := encoding.EncodedPayloadLengthWithoutPrefix(n.Payload(), payloadEncodingVersion)
+	payload, err := n.LoadPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	encPayloadSize := encoding.EncodedPayloadLengthWithoutPrefix(payload, payloadEncodingVersion)
 
 	encodedNodeSize := encNodeTypeSize +
 		encHeightSize +
@@ -94,9 +99,9 @@ func encodeLeafNode(n *node.Node, scratch []byte) []byte {
 
 	// EncodeAndAppendPayloadWithoutPrefix appends encoded payload to the resliced buf.
 	// Returned buf is resliced to include appended payload.
-	buf = encoding.EncodeAndAppendPayloadWithoutPrefix(buf[:pos], n.Payload(), payloadEncodingVersion)
+	buf = encoding.EncodeAndAppendPayloadWithoutPrefix(buf[:pos], payload, payloadEncodingVersion)
 
-	return buf
+	return buf, nil
 }
 
 // encodeInterimNode encodes interim node in the following format:
@@ -157,14 +162,15 @@ func encodeInterimNode(n *node.Node, lchildIndex uint64, rchildIndex uint64, scr
 
 // EncodeNode encodes node.
 // Scratch buffer is used to avoid allocs.
+// An error is returned if the payload of an offloaded leaf can't be loaded.
 // WARNING: The returned buffer is likely to share the same underlying array as
 // the scratch buffer. Caller is responsible for copying or using returned buffer
 // before scratch buffer is used again.
-func EncodeNode(n *node.Node, lchildIndex uint64, rchildIndex uint64, scratch []byte) []byte {
+func EncodeNode(n *node.Node, lchildIndex uint64, rchildIndex uint64, scratch []byte) ([]byte, error) {
 	if n.IsLeaf() {
 		return encodeLeafNode(n, scratch)
 	}
-	return encodeInterimNode(n, lchildIndex, rchildIndex, scratch)
+	return encodeInterimNode(n, lchildIndex, rchildIndex, scratch), nil
 }
 
 // ReadNode reconstructs a node from data read from reader.
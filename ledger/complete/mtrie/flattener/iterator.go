@@ -47,12 +47,19 @@ type NodeIterator struct {
 	// Descendents-First-Relationship. As we search the trie in DFS manner, each
 	// node of the trie is recalled (once). Hence, the algorithm iterates all
 	// nodes of the MTrie while guaranteeing Descendents-First-Relationship.
+	//
+	// The children of offloaded nodes are loaded from the node storage when the node is
+	// pushed on the stack, and kept in the stack entry. Loading the same children again
+	// might return different node instances (if they were evicted from the node cache in
+	// between), so the iterator exposes the loaded children through Children().
 
 	// unprocessedRoot contains the trie's root before the first call of Next().
 	// Thereafter, it is set to nil (which prevents repeated iteration through the trie).
 	// This has the advantage, that we gracefully handle tries whose root node is nil.
 	unprocessedRoot *node.Node
-	stack           []*node.Node
+	stack           []stackEntry
+	// err is the error encountered while loading offloaded nodes, which ends the iteration
+	err error
 	// visitedNodes are nodes that were visited and can be skipped during
 	// traversal through dig(). visitedNodes is used to optimize node traveral
 	// IN FOREST by skipping nodes in shared sub-tries after they are visited,
@@ -64,6 +71,13 @@ type NodeIterator struct {
 	visitedNodes map[*node.Node]uint64
 }
 
+// stackEntry is a node on the NodeIterator's stack, with its (loaded) children.
+type stackEntry struct {
+	n      *node.Node
+	lChild *node.Node
+	rChild *node.Node
+}
+
 // NewNodeIterator returns a node NodeIterator, which iterates through all nodes
 // comprising the MTrie. The Iterator guarantees a DESCENDANTS-FIRST-RELATIONSHIP in
 // the sequence of nodes it generates:
@@ -80,7 +94,7 @@ func NewNodeIterator(mTrie *trie.MTrie) *NodeIterator {
 	// for a Trie with height H (measured by number of edges), the longest possible path contains H+1 vertices
 	stackSize := ledger.NodeMaxHeight + 1
 	i := &NodeIterator{
-		stack: make([]*node.Node, 0, stackSize),
+		stack: make([]stackEntry, 0, stackSize),
 	}
 	i.unprocessedRoot = mTrie.RootNode()
 	return i
@@ -103,19 +117,24 @@ func NewUniqueNodeIterator(mTrie *trie.MTrie, visitedNodes map[*node.Node]uint64
 	// contains H+1 vertices.
 	stackSize := ledger.NodeMaxHeight + 1
 	i := &NodeIterator{
-		stack:        make([]*node.Node, 0, stackSize),
+		stack:        make([]stackEntry, 0, stackSize),
 		visitedNodes: visitedNodes,
 	}
 	i.unprocessedRoot = mTrie.RootNode()
 	return i
 }
 
+// Next advances the iterator to the next node. It returns false if there are no more
+// nodes, or if an offloaded node couldn't be loaded (see Err).
 func (i *NodeIterator) Next() bool {
+	if i.err != nil {
+		return false
+	}
 	if i.unprocessedRoot != nil {
 		// initial call to Next() for a non-empty trie
 		i.dig(i.unprocessedRoot)
 		i.unprocessedRoot = nil
-		return true
+		return i.err == nil
 	}
 
 	// the current head of the stack, `n`, has been recalled
@@ -127,10 +146,10 @@ func (i *NodeIterator) Next() bool {
 		// done so already. As we decent into the left child with priority, the only case where
 		// we still need to dig into the right child is, if n is p's left child.
 		parent := i.peek()
-		if parent.LeftChild() == n {
-			i.dig(parent.RightChild())
+		if parent.lChild == n.n {
+			i.dig(parent.rChild)
 		}
-		return true
+		return i.err == nil
 	}
 	return false // as len(i.stack) == 0, i.e. there are no more elements to recall
 }
@@ -139,12 +158,28 @@ func (i *NodeIterator) Value() *node.Node {
 	if len(i.stack) == 0 {
 		return nil
 	}
-	return i.peek()
+	return i.peek().n
 }
 
-func (i *NodeIterator) pop() *node.Node {
+// Children returns the children of the current node. For offloaded nodes, these are the
+// same node instances which were previously returned by Value, unlike the children loaded
+// from the node storage again.
+func (i *NodeIterator) Children() (*node.Node, *node.Node) {
 	if len(i.stack) == 0 {
-		return nil
+		return nil, nil
+	}
+	head := i.peek()
+	return head.lChild, head.rChild
+}
+
+// Err returns the error which ended the iteration, if an offloaded node couldn't be loaded.
+func (i *NodeIterator) Err() error {
+	return i.err
+}
+
+func (i *NodeIterator) pop() stackEntry {
+	if len(i.stack) == 0 {
+		return stackEntry{}
 	}
 	headIdx := len(i.stack) - 1
 	head := i.stack[headIdx]
@@ -152,7 +187,7 @@ func (i *NodeIterator) pop() *node.Node {
 	return head
 }
 
-func (i *NodeIterator) peek() *node.Node {
+func (i *NodeIterator) peek() stackEntry {
 	return i.stack[len(i.stack)-1]
 }
 
@@ -164,14 +199,19 @@ func (i *NodeIterator) dig(n *node.Node) {
 		return
 	}
 	for {
-		i.stack = append(i.stack, n)
-		if lChild := n.LeftChild(); lChild != nil {
+		lChild, rChild, err := n.LoadChildren()
+		if err != nil {
+			i.err = err
+			return
+		}
+		i.stack = append(i.stack, stackEntry{n: n, lChild: lChild, rChild: rChild})
+		if lChild != nil {
 			if _, found := i.visitedNodes[lChild]; !found {
 				n = lChild
 				continue
 			}
 		}
-		if rChild := n.RightChild(); rChild != nil {
+		if rChild != nil {
 			if _, found := i.visitedNodes[rChild]; !found {
 				n = rChild
 				continue
@@ -1,13 +1,17 @@
 package mtrie
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/onflow/flow-go/ledger"
 	"github.com/onflow/flow-go/ledger/common/hash"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
 	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
 	"github.com/onflow/flow-go/module"
 )
@@ -23,14 +27,54 @@ import (
 //
 // TODO: Storage Eviction Policy for Forest
 //       For the execution node: we only evict on sealing a result.
+//
+// Optionally, the Forest offloads the nodes of its tries to a node storage, so that
+// only the upper part of the tries is held in memory (see node.Storage for details).
+// Tries are offloaded in the background after they were added, and the nodes which are
+// only reachable from evicted tries are pruned from the node storage in the background.
+// Hence, with a node storage, evicted tries MUST NOT be accessed anymore.
 type Forest struct {
-	// tries stores all MTries in the forest. It is NOT a CACHE in the conventional sense:
+	// tries stores all MTries in the forest, as *forestEntry. It is NOT a CACHE in the conventional sense:
 	// there is no mechanism to load a trie from disk in case of a cache miss. Missing a
 	// needed trie in the forest might cause a fatal application logic error.
 	tries          *lru.Cache
 	forestCapacity int
 	onTreeEvicted  func(tree *trie.MTrie)
 	metrics        module.LedgerMetrics
+	storage        *node.Storage // nil if the tries are held in memory
+
+	offloads      chan *forestEntry // tries to offload, in the order they were added
+	pruneRequests chan struct{}     // buffered, so that requests are coalesced while pruning
+	evictions     uint64            // number of evicted tries, to trigger pruning
+	ctx           context.Context
+	cancel        context.CancelFunc
+	workers       sync.WaitGroup
+
+	errMu sync.Mutex
+	err   error // first error of offloading or pruning in the background
+}
+
+// offloadQueueSize is the maximum number of tries waiting to be offloaded. Adding tries
+// blocks while the queue is full, which bounds the memory held by tries not offloaded yet.
+const offloadQueueSize = 16
+
+// forestEntry holds a trie of the forest. Once the trie is offloaded, it is replaced by its
+// offloaded equivalent, without changing the entry's position in the forest's LRU cache.
+type forestEntry struct {
+	mu   sync.RWMutex
+	trie *trie.MTrie
+}
+
+func (e *forestEntry) get() *trie.MTrie {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.trie
+}
+
+func (e *forestEntry) set(t *trie.MTrie) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.trie = t
 }
 
 // NewForest returns a new instance of memory forest.
@@ -41,28 +85,58 @@ type Forest struct {
 // Make sure you chose a sufficiently large forestCapacity, such that, when reaching the capacity, the
 // Least Recently Used trie will never be needed again.
 func NewForest(forestCapacity int, metrics module.LedgerMetrics, onTreeEvicted func(tree *trie.MTrie)) (*Forest, error) {
+	return NewForestWithNodeStorage(forestCapacity, metrics, onTreeEvicted, nil)
+}
+
+// NewForestWithNodeStorage returns a new instance of a forest, which offloads the nodes of
+// the tries added to it to the given node storage. If storage is nil, tries are held in memory.
+// Nodes are offloaded and pruned in the background, until the forest is closed.
+//
+// The same CAUTION on forestCapacity applies as for NewForest. Moreover, the nodes of evicted
+// tries are pruned from the node storage, once forestCapacity tries were evicted since the
+// last pruning. Pruning reads all nodes of the tries in the forest from the node storage.
+func NewForestWithNodeStorage(forestCapacity int, metrics module.LedgerMetrics, onTreeEvicted func(tree *trie.MTrie), storage *node.Storage) (*Forest, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	forest := &Forest{
+		forestCapacity: forestCapacity,
+		onTreeEvicted:  onTreeEvicted,
+		metrics:        metrics,
+		storage:        storage,
+		offloads:       make(chan *forestEntry, offloadQueueSize),
+		pruneRequests:  make(chan struct{}, 1),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+
 	// init LRU cache as a SHORTCUT for a usage-related storage eviction policy
 	var cache *lru.Cache
 	var err error
-	if onTreeEvicted != nil {
+	if onTreeEvicted != nil || storage != nil {
 		cache, err = lru.NewWithEvict(forestCapacity, func(key interface{}, value interface{}) {
-			trie, ok := value.(*trie.MTrie)
+			entry, ok := value.(*forestEntry)
 			if !ok {
 				panic(fmt.Sprintf("cache contains item of type %T", value))
 			}
-			onTreeEvicted(trie)
+			if onTreeEvicted != nil {
+				onTreeEvicted(entry.get())
+			}
+			if storage != nil {
+				forest.requestPruning()
+			}
 		})
 	} else {
 		cache, err = lru.New(forestCapacity)
 	}
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("cannot create forest cache: %w", err)
 	}
+	forest.tries = cache
 
-	forest := &Forest{tries: cache,
-		forestCapacity: forestCapacity,
-		onTreeEvicted:  onTreeEvicted,
-		metrics:        metrics,
+	if storage != nil {
+		forest.workers.Add(2)
+		go forest.offloadTries()
+		go forest.pruneNodes()
 	}
 
 	// add trie with no allocated registers
@@ -104,7 +178,10 @@ func (f *Forest) ValueSizes(r *ledger.TrieRead) ([]int, error) {
 		pathOrgIndex[path] = append(indices, i)
 	}
 
-	sizes := trie.UnsafeValueSizes(deduplicatedPaths) // this sorts deduplicatedPaths IN-PLACE
+	sizes, err := trie.UnsafeValueSizes(deduplicatedPaths) // this sorts deduplicatedPaths IN-PLACE
+	if err != nil {
+		return nil, fmt.Errorf("cannot read value sizes: %w", err)
+	}
 
 	// reconstruct value sizes in the same key order that called the method
 	orderedValueSizes := make([]int, len(r.Paths))
@@ -153,7 +230,10 @@ func (f *Forest) Read(r *ledger.TrieRead) ([]*ledger.Payload, error) {
 		pathOrgIndex[path] = append(indices, i)
 	}
 
-	payloads := trie.UnsafeRead(deduplicatedPaths) // this sorts deduplicatedPaths IN-PLACE
+	payloads, err := trie.UnsafeRead(deduplicatedPaths) // this sorts deduplicatedPaths IN-PLACE
+	if err != nil {
+		return nil, fmt.Errorf("cannot read payloads: %w", err)
+	}
 
 	// reconstruct the payloads in the same key order that called the method
 	orderedPayloads := make([]*ledger.Payload, len(r.Paths))
@@ -286,20 +366,37 @@ func (f *Forest) Proofs(r *ledger.TrieRead) (*ledger.TrieBatchProof, error) {
 		stateTrie = newTrie
 	}
 
-	bp := stateTrie.UnsafeProofs(r.Paths)
+	bp, err := stateTrie.UnsafeProofs(r.Paths)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate proofs: %w", err)
+	}
 	return bp, nil
 }
 
+// Diff calls fn for each register whose payload differs between the tries with the given
+// root hashes, in ascending order of register paths (see trie.Diff for details).
+func (f *Forest) Diff(fromRootHash, toRootHash ledger.RootHash, fn func(change ledger.PayloadChange) error) error {
+	fromTrie, err := f.GetTrie(fromRootHash)
+	if err != nil {
+		return err
+	}
+	toTrie, err := f.GetTrie(toRootHash)
+	if err != nil {
+		return err
+	}
+	return trie.Diff(fromTrie, toTrie, fn)
+}
+
 // GetTrie returns trie at specific rootHash
 // warning, use this function for read-only operation
 func (f *Forest) GetTrie(rootHash ledger.RootHash) (*trie.MTrie, error) {
 	// if in memory
 	if ent, found := f.tries.Get(rootHash); found {
-		trie, ok := ent.(*trie.MTrie)
+		entry, ok := ent.(*forestEntry)
 		if !ok {
 			return nil, fmt.Errorf("forest contains an element of a wrong type")
 		}
-		return trie, nil
+		return entry.get(), nil
 	}
 	return nil, fmt.Errorf("trie with the given rootHash %s not found", rootHash)
 }
@@ -314,11 +411,11 @@ func (f *Forest) GetTries() ([]*trie.MTrie, error) {
 		if !ok {
 			return nil, errors.New("concurrent Forest modification")
 		}
-		trie, ok := t.(*trie.MTrie)
+		entry, ok := t.(*forestEntry)
 		if !ok {
 			return nil, errors.New("forest contains an element of a wrong type")
 		}
-		tries[i] = trie
+		tries[i] = entry.get()
 	}
 	return tries, nil
 }
@@ -335,29 +432,139 @@ func (f *Forest) AddTries(newTries []*trie.MTrie) error {
 }
 
 // AddTrie adds a trie to the forest
+// If the forest has a node storage, the nodes of the trie are offloaded in the background
+// after it is added. AddTrie blocks while too many tries are waiting to be offloaded, and
+// returns the error if offloading or pruning nodes failed in the background.
 func (f *Forest) AddTrie(newTrie *trie.MTrie) error {
 	if newTrie == nil {
 		return nil
 	}
 
+	err := f.backgroundErr()
+	if err != nil {
+		return fmt.Errorf("node storage failed: %w", err)
+	}
+
 	// TODO: check Thread safety
 	rootHash := newTrie.RootHash()
 	if storedTrie, found := f.tries.Get(rootHash); found {
-		trie, ok := storedTrie.(*trie.MTrie)
+		entry, ok := storedTrie.(*forestEntry)
 		if !ok {
 			return fmt.Errorf("forest contains an element of a wrong type")
 		}
-		if trie.Equals(newTrie) {
+		if entry.get().Equals(newTrie) {
 			return nil
 		}
 		return fmt.Errorf("forest already contains a tree with same root hash but other properties")
 	}
-	f.tries.Add(rootHash, newTrie)
+
+	entry := &forestEntry{trie: newTrie}
+	f.tries.Add(rootHash, entry)
 	f.metrics.ForestNumberOfTrees(uint64(f.tries.Len()))
 
+	if f.storage != nil {
+		select {
+		case f.offloads <- entry:
+		case <-f.ctx.Done():
+			// the forest is closed, the trie is kept in memory
+		}
+	}
+
 	return nil
 }
 
+// Close stops offloading and pruning nodes in the background, and waits until it stopped.
+// Tries which weren't offloaded yet are kept in memory.
+func (f *Forest) Close() {
+	f.cancel()
+	f.workers.Wait()
+}
+
+// offloadTries offloads the queued tries and replaces them by their offloaded equivalent.
+func (f *Forest) offloadTries() {
+	defer f.workers.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case entry := <-f.offloads:
+			t := entry.get()
+			if current, ok := f.tries.Peek(t.RootHash()); !ok || current != entry {
+				// the trie was evicted while it was queued
+				continue
+			}
+			root, err := f.storage.Offload(t.RootNode())
+			if err != nil {
+				f.setBackgroundErr(fmt.Errorf("could not offload trie %s: %w", t.RootHash(), err))
+				continue
+			}
+			offloaded, err := trie.NewMTrie(root, t.AllocatedRegCount(), t.AllocatedRegSize())
+			if err != nil {
+				f.setBackgroundErr(fmt.Errorf("could not create offloaded trie %s: %w", t.RootHash(), err))
+				continue
+			}
+			entry.set(offloaded)
+		}
+	}
+}
+
+// requestPruning requests pruning the node storage once forestCapacity tries were evicted.
+func (f *Forest) requestPruning() {
+	if atomic.AddUint64(&f.evictions, 1)%uint64(f.forestCapacity) != 0 {
+		return
+	}
+	select {
+	case f.pruneRequests <- struct{}{}:
+	default:
+		// pruning was already requested
+	}
+}
+
+// pruneNodes prunes the nodes which aren't reachable from the tries in the forest from the node storage.
+func (f *Forest) pruneNodes() {
+	defer f.workers.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.pruneRequests:
+			err := f.storage.Prune(f.ctx, f.liveRoots)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				f.setBackgroundErr(fmt.Errorf("could not prune node storage: %w", err))
+			}
+		}
+	}
+}
+
+// liveRoots returns the root nodes of the tries in the forest.
+func (f *Forest) liveRoots() []*node.Node {
+	keys := f.tries.Keys()
+	roots := make([]*node.Node, 0, len(keys))
+	for _, key := range keys {
+		value, ok := f.tries.Peek(key)
+		if !ok {
+			// evicted concurrently
+			continue
+		}
+		roots = append(roots, value.(*forestEntry).get().RootNode())
+	}
+	return roots
+}
+
+func (f *Forest) backgroundErr() error {
+	f.errMu.Lock()
+	defer f.errMu.Unlock()
+	return f.err
+}
+
+func (f *Forest) setBackgroundErr(err error) {
+	f.errMu.Lock()
+	defer f.errMu.Unlock()
+	if f.err == nil {
+		f.err = err
+	}
+}
+
 // RemoveTrie removes a trie to the forest
 func (f *Forest) RemoveTrie(rootHash ledger.RootHash) {
 	// TODO remove from the file as well
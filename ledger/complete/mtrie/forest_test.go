@@ -2,11 +2,14 @@ package mtrie
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"math/rand"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -14,9 +17,12 @@ import (
 	"github.com/onflow/flow-go/ledger/common/encoding"
 	prf "github.com/onflow/flow-go/ledger/common/proof"
 	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/nodestore"
 	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
 	"github.com/onflow/flow-go/ledger/partial/ptrie"
 	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
 )
 
 // TestTrieOperations tests adding removing and retrieving Trie from Forest
@@ -982,3 +988,130 @@ func TestValueSizesWithDuplicatedKeys(t *testing.T) {
 		require.Equal(t, expectedValueSizes[i], retValueSizes[i])
 	}
 }
+
+// TestForestWithNodeStorage tests that a forest offloading its nodes to a node storage behaves
+// exactly like an in-memory forest: root hashes, register counts, reads and proofs are identical.
+// The node cache is too small to hold any node, so that all nodes are loaded from the store.
+func TestForestWithNodeStorage(t *testing.T) {
+	for _, subtrieDepth := range []int{0, 1, 4} {
+		subtrieDepth := subtrieDepth
+		t.Run(fmt.Sprintf("subtrie depth %d", subtrieDepth), func(t *testing.T) {
+			unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+				storage, err := node.NewStorage(nodestore.NewBadgerStore(db), subtrieDepth, 1)
+				require.NoError(t, err)
+
+				memForest, err := NewForest(10, &metrics.NoopCollector{}, nil)
+				require.NoError(t, err)
+				diskForest, err := NewForestWithNodeStorage(10, &metrics.NoopCollector{}, nil, storage)
+				require.NoError(t, err)
+				defer diskForest.Close()
+
+				activeRoot := memForest.GetEmptyRootHash()
+				var allPaths []ledger.Path
+				written := make(map[ledger.Path]struct{})
+				for i := 0; i < 10; i++ {
+					paths := utils.RandomPaths(50)
+					payloads := utils.RandomPayloads(len(paths), 2, 10)
+					// unallocate some previously written registers
+					for j := 0; j < 5 && j < len(allPaths); j++ {
+						paths = append(paths, allPaths[rand.Intn(len(allPaths))])
+						payloads = append(payloads, ledger.EmptyPayload())
+					}
+
+					update := &ledger.TrieUpdate{RootHash: activeRoot, Paths: paths, Payloads: payloads}
+					memRoot, err := memForest.Update(update)
+					require.NoError(t, err)
+					update = &ledger.TrieUpdate{RootHash: activeRoot, Paths: paths, Payloads: payloads}
+					diskRoot, err := diskForest.Update(update)
+					require.NoError(t, err)
+					require.Equal(t, memRoot, diskRoot)
+					activeRoot = memRoot
+					for _, path := range paths {
+						if _, ok := written[path]; !ok {
+							written[path] = struct{}{}
+							allPaths = append(allPaths, path)
+						}
+					}
+
+					memTrie, err := memForest.GetTrie(activeRoot)
+					require.NoError(t, err)
+					diskTrie, err := diskForest.GetTrie(activeRoot)
+					require.NoError(t, err)
+					require.Equal(t, memTrie.AllocatedRegCount(), diskTrie.AllocatedRegCount())
+					require.Equal(t, memTrie.AllocatedRegSize(), diskTrie.AllocatedRegSize())
+					require.True(t, diskTrie.IsAValidTrie())
+
+					// unwritten and written registers, including unallocated ones
+					readPaths := append(utils.RandomPaths(10), allPaths...)
+					memPayloads, err := memForest.Read(&ledger.TrieRead{RootHash: activeRoot, Paths: readPaths})
+					require.NoError(t, err)
+					diskPayloads, err := diskForest.Read(&ledger.TrieRead{RootHash: activeRoot, Paths: readPaths})
+					require.NoError(t, err)
+					require.Equal(t, memPayloads, diskPayloads)
+
+					memProofs, err := memForest.Proofs(&ledger.TrieRead{RootHash: activeRoot, Paths: readPaths})
+					require.NoError(t, err)
+					diskProofs, err := diskForest.Proofs(&ledger.TrieRead{RootHash: activeRoot, Paths: readPaths})
+					require.NoError(t, err)
+					require.Equal(t, encoding.EncodeTrieBatchProof(memProofs), encoding.EncodeTrieBatchProof(diskProofs))
+					require.True(t, prf.VerifyTrieBatchProof(diskProofs, ledger.State(activeRoot)))
+				}
+			})
+		})
+	}
+}
+
+// TestForestNodeStoragePruning tests that the tries of a forest are offloaded in the background,
+// and that the nodes of evicted tries are pruned from the node storage, while the tries in the
+// forest remain readable.
+func TestForestNodeStoragePruning(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		store := nodestore.NewBadgerStore(db)
+		storage, err := node.NewStorage(store, 0, 1)
+		require.NoError(t, err)
+
+		forestCapacity := 2
+		forest, err := NewForestWithNodeStorage(forestCapacity, &metrics.NoopCollector{}, nil, storage)
+		require.NoError(t, err)
+
+		// every update overwrites all registers, so that evicted tries share no payload with live tries
+		paths := utils.RandomPaths(20)
+		activeRoot := forest.GetEmptyRootHash()
+		for i := 0; i < 10; i++ {
+			payloads := utils.RandomPayloads(len(paths), 2, 10)
+			activeRoot, err = forest.Update(&ledger.TrieUpdate{RootHash: activeRoot, Paths: paths, Payloads: payloads})
+			require.NoError(t, err)
+		}
+
+		countKeys := func() int {
+			count := 0
+			err := store.ForEachKey(func([]byte) error {
+				count++
+				return nil
+			})
+			require.NoError(t, err)
+			return count
+		}
+
+		// wait until the queued tries are offloaded
+		require.Eventually(t, func() bool {
+			return len(forest.offloads) == 0 && countKeys() > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		forest.Close()
+
+		err = storage.Prune(context.Background(), forest.liveRoots)
+		require.NoError(t, err)
+		// only the payloads of the live tries remain
+		require.LessOrEqual(t, countKeys(), forestCapacity*len(paths))
+
+		tries, err := forest.GetTries()
+		require.NoError(t, err)
+		require.Len(t, tries, forestCapacity)
+		for _, tr := range tries {
+			require.True(t, tr.IsAValidTrie())
+		}
+		read, err := forest.Read(&ledger.TrieRead{RootHash: activeRoot, Paths: paths})
+		require.NoError(t, err)
+		require.Len(t, read, len(paths))
+	})
+}
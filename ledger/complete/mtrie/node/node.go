@@ -23,7 +23,11 @@ import (
 // registers).
 //
 // Nodes are supposed to be treated as _immutable_ data structures.
-// TODO: optimized data structures might be able to reduce memory consumption
+//
+// With a node Storage, leaf payloads and interim subtrees can be offloaded to a
+// content-addressed store (see `Storage` for details). Offloaded nodes don't hold
+// their payload or children in memory: LoadPayload and LoadChildren load them from
+// the store, and return an error if they can't be loaded.
 type Node struct {
 	// Implementation Comments:
 	// Formally, a tree can hold up to 2^maxDepth number of registers. However,
@@ -36,6 +40,8 @@ type Node struct {
 	path      ledger.Path     // the storage path (dummy value for interim nodes)
 	payload   *ledger.Payload // the payload this node is storing (leaf nodes only)
 	hashValue hash.Hash       // hash value of node (cached)
+	storage   *Storage        // storage the node is offloaded to (offloaded nodes only)
+	state     nodeState       // whether the node is offloaded
 }
 
 // NewNode creates a new Node.
@@ -103,7 +109,9 @@ func NewInterimNode(height int, lchild, rchild *Node) *Node {
 //    a single allocated register. In this case, we return a compactified leaf.
 // UNCHECKED requirement:
 //  * for any child `c` that is non-nil, its height must satisfy: height = c.height + 1
-func NewInterimCompactifiedNode(height int, lChild, rChild *Node) *Node {
+// The payload of an offloaded leaf is loaded to compactify it, an error is returned if it
+// can't be loaded.
+func NewInterimCompactifiedNode(height int, lChild, rChild *Node) (*Node, error) {
 	if lChild.IsDefaultNode() {
 		lChild = nil
 	}
@@ -113,22 +121,30 @@ func NewInterimCompactifiedNode(height int, lChild, rChild *Node) *Node {
 
 	// CASE (a): _both_ children do _not_ contain any allocated registers:
 	if lChild == nil && rChild == nil {
-		return nil // return nil representing as completely empty sub-trie
+		return nil, nil // return nil representing as completely empty sub-trie
 	}
 
 	// CASE (b): one child is a compactified leaf (single allocated register) _and_ the other child represents
 	// an empty subtrie => in total we have one allocated register, which we represent as single leaf node
 	if rChild == nil && lChild.IsLeaf() {
+		payload, err := lChild.LoadPayload()
+		if err != nil {
+			return nil, err
+		}
 		h := hash.HashInterNode(lChild.hashValue, ledger.GetDefaultHashForHeight(lChild.height))
-		return &Node{height: height, path: lChild.path, payload: lChild.payload, hashValue: h}
+		return &Node{height: height, path: lChild.path, payload: payload, hashValue: h}, nil
 	}
 	if lChild == nil && rChild.IsLeaf() {
+		payload, err := rChild.LoadPayload()
+		if err != nil {
+			return nil, err
+		}
 		h := hash.HashInterNode(ledger.GetDefaultHashForHeight(rChild.height), rChild.hashValue)
-		return &Node{height: height, path: rChild.path, payload: rChild.payload, hashValue: h}
+		return &Node{height: height, path: rChild.path, payload: payload, hashValue: h}, nil
 	}
 
 	// CASE (b): both children contain some allocated registers => we can't compactify; return a full interim leaf
-	return NewInterimNode(height, lChild, rChild)
+	return NewInterimNode(height, lChild, rChild), nil
 }
 
 // IsDefaultNode returns true iff the sub-trie represented by this root node contains
@@ -143,48 +159,70 @@ func (n *Node) IsDefaultNode() bool {
 
 // computeHash returns the hashValue of the node
 func (n *Node) computeHash() hash.Hash {
+	return computeHash(n.height, n.path, n.payload, n.lChild, n.rChild)
+}
+
+// computeHash returns the hashValue of a node at the given height, which is a leaf
+// with the given path and payload if both children are nil, and an interim node otherwise.
+func computeHash(height int, path ledger.Path, payload *ledger.Payload, lChild, rChild *Node) hash.Hash {
 	// check for leaf node
-	if n.lChild == nil && n.rChild == nil {
+	if lChild == nil && rChild == nil {
 		// if payload is non-nil, compute the hash based on the payload content
-		if n.payload != nil {
-			return ledger.ComputeCompactValue(hash.Hash(n.path), n.payload.Value, n.height)
+		if payload != nil {
+			return ledger.ComputeCompactValue(hash.Hash(path), payload.Value, height)
 		}
 		// if payload is nil, return the default hash
-		return ledger.GetDefaultHashForHeight(n.height)
+		return ledger.GetDefaultHashForHeight(height)
 	}
 
 	// this is an interim node at least one of lChild or rChild is not nil.
 	var h1, h2 hash.Hash
-	if n.lChild != nil {
-		h1 = n.lChild.Hash()
+	if lChild != nil {
+		h1 = lChild.Hash()
 	} else {
-		h1 = ledger.GetDefaultHashForHeight(n.height - 1)
+		h1 = ledger.GetDefaultHashForHeight(height - 1)
 	}
 
-	if n.rChild != nil {
-		h2 = n.rChild.Hash()
+	if rChild != nil {
+		h2 = rChild.Hash()
 	} else {
-		h2 = ledger.GetDefaultHashForHeight(n.height - 1)
+		h2 = ledger.GetDefaultHashForHeight(height - 1)
 	}
 	return hash.HashInterNode(h1, h2)
 }
 
-// VerifyCachedHash verifies the hash of a node is valid
-func verifyCachedHashRecursive(n *Node) bool {
+// verifyCachedHashRecursive verifies the hashes of the subtrie rooted at n.
+// Offloaded nodes are loaded from the node storage.
+func verifyCachedHashRecursive(n *Node) (bool, error) {
 	if n == nil {
-		return true
+		return true, nil
 	}
-	if !verifyCachedHashRecursive(n.lChild) || !verifyCachedHashRecursive(n.rChild) {
-		return false
+	if n.IsLeaf() {
+		payload, err := n.LoadPayload()
+		if err != nil {
+			return false, err
+		}
+		return n.hashValue == computeHash(n.height, n.path, payload, nil, nil), nil
 	}
 
-	computedHash := n.computeHash()
-	return n.hashValue == computedHash
+	lChild, rChild, err := n.LoadChildren()
+	if err != nil {
+		return false, err
+	}
+	for _, child := range []*Node{lChild, rChild} {
+		valid, err := verifyCachedHashRecursive(child)
+		if err != nil || !valid {
+			return false, err
+		}
+	}
+	return n.hashValue == computeHash(n.height, n.path, nil, lChild, rChild), nil
 }
 
 // VerifyCachedHash verifies the hash of a node is valid
+// Nodes which can't be loaded from the node storage are not valid.
 func (n *Node) VerifyCachedHash() bool {
-	return verifyCachedHashRecursive(n)
+	valid, err := verifyCachedHashRecursive(n)
+	return err == nil && valid
 }
 
 // Hash returns the Node's hash value.
@@ -210,40 +248,78 @@ func (n *Node) Path() *ledger.Path {
 }
 
 // Payload returns the the Node's payload.
+// Offloaded leaves don't hold their payload in memory, use LoadPayload instead.
 // Do NOT MODIFY returned slices!
 func (n *Node) Payload() *ledger.Payload {
 	return n.payload
 }
 
+// LoadPayload returns the Node's payload, which is loaded from the node storage
+// for offloaded leaves.
+// Do NOT MODIFY returned slices!
+func (n *Node) LoadPayload() (*ledger.Payload, error) {
+	if n.state == offloadedLeaf {
+		return n.storage.loadPayload(n)
+	}
+	return n.payload, nil
+}
+
 // LeftChild returns the the Node's left child.
 // Only INTERIM nodes have children.
+// Offloaded interim nodes don't hold their children in memory, use LoadChildren instead.
 // Do NOT MODIFY returned Node!
 func (n *Node) LeftChild() *Node { return n.lChild }
 
 // RightChild returns the the Node's right child.
 // Only INTERIM nodes have children.
+// Offloaded interim nodes don't hold their children in memory, use LoadChildren instead.
 // Do NOT MODIFY returned Node!
 func (n *Node) RightChild() *Node { return n.rChild }
 
+// LoadChildren returns the Node's left and right child, which are loaded from the
+// node storage for offloaded interim nodes.
+// Do NOT MODIFY returned Nodes!
+func (n *Node) LoadChildren() (*Node, *Node, error) {
+	if n.state == offloadedInterim {
+		children, err := n.storage.loadChildren(n)
+		if err != nil {
+			return nil, nil, err
+		}
+		return children.lChild, children.rChild, nil
+	}
+	return n.lChild, n.rChild, nil
+}
+
 // IsLeaf returns true if and only if Node is a LEAF.
 func (n *Node) IsLeaf() bool {
-	// Per definition, a node is a leaf if and only it has no children
-	return n == nil || (n.lChild == nil && n.rChild == nil)
+	// Per definition, a node is a leaf if and only it has no children.
+	// The children of offloaded interim nodes are not held in memory.
+	return n == nil || (n.lChild == nil && n.rChild == nil && n.state != offloadedInterim)
 }
 
 // FmtStr provides formatted string representation of the Node and sub tree
+// Offloaded nodes which can't be loaded are represented by the error.
 func (n *Node) FmtStr(prefix string, subpath string) string {
+	lChild, rChild, err := n.LoadChildren()
+	if err != nil {
+		return fmt.Sprintf("%v%v: %v [%s]", prefix, n.height, err, subpath)
+	}
+	payload, err := n.LoadPayload()
+	if err != nil {
+		return fmt.Sprintf("%v%v: %v [%s]", prefix, n.height, err, subpath)
+	}
+
 	right := ""
-	if n.rChild != nil {
-		right = fmt.Sprintf("\n%v", n.rChild.FmtStr(prefix+"\t", subpath+"1"))
+	if rChild != nil {
+		right = fmt.Sprintf("\n%v", rChild.FmtStr(prefix+"\t", subpath+"1"))
 	}
 	left := ""
-	if n.lChild != nil {
-		left = fmt.Sprintf("\n%v", n.lChild.FmtStr(prefix+"\t", subpath+"0"))
+	if lChild != nil {
+		left = fmt.Sprintf("\n%v", lChild.FmtStr(prefix+"\t", subpath+"0"))
 	}
 	payloadSize := 0
-	if n.payload != nil {
-		payloadSize = n.payload.Size()
+	if payload != nil {
+		payloadSize = payload.Size()
 	}
 	hashStr := hex.EncodeToString(n.hashValue[:])
 	hashStr = hashStr[:3] + "..." + hashStr[len(hashStr)-3:]
@@ -251,20 +327,30 @@ func (n *Node) FmtStr(prefix string, subpath string) string {
 }
 
 // AllPayloads returns the payload of this node and all payloads of the subtrie
-func (n *Node) AllPayloads() []ledger.Payload {
+func (n *Node) AllPayloads() ([]ledger.Payload, error) {
 	return n.appendSubtreePayloads([]ledger.Payload{})
 }
 
 // appendSubtreePayloads appends the payloads of the subtree with this node as root
 // to the provided Payload slice. Follows same pattern as Go's native append method.
-func (n *Node) appendSubtreePayloads(result []ledger.Payload) []ledger.Payload {
+func (n *Node) appendSubtreePayloads(result []ledger.Payload) ([]ledger.Payload, error) {
 	if n == nil {
-		return result
+		return result, nil
 	}
 	if n.IsLeaf() {
-		return append(result, *n.Payload())
+		payload, err := n.LoadPayload()
+		if err != nil {
+			return nil, err
+		}
+		return append(result, *payload), nil
+	}
+	lChild, rChild, err := n.LoadChildren()
+	if err != nil {
+		return nil, err
+	}
+	result, err = lChild.appendSubtreePayloads(result)
+	if err != nil {
+		return nil, err
 	}
-	result = n.lChild.appendSubtreePayloads(result)
-	result = n.rChild.appendSubtreePayloads(result)
-	return result
+	return rChild.appendSubtreePayloads(result)
 }
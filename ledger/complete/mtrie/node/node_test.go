@@ -100,7 +100,9 @@ func Test_AllPayloads(t *testing.T) {
 	n3 := node.NewLeaf(path, payload, 1)
 	n4 := node.NewInterimNode(1, n1, n2)
 	n5 := node.NewInterimNode(2, n4, n3)
-	require.Equal(t, 3, len(n5.AllPayloads()))
+	payloads, err := n5.AllPayloads()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(payloads))
 }
 
 func Test_VerifyCachedHash(t *testing.T) {
@@ -125,16 +127,16 @@ func Test_Compactify_EmptySubtrie(t *testing.T) {
 	n2 := node.NewLeaf(utils.PathByUint16LeftPadded(1<<4), &ledger.Payload{}, 4) // path: ...0001 0000
 
 	t.Run("both children empty", func(t *testing.T) {
-		require.Nil(t, node.NewInterimCompactifiedNode(5, n1, n2))
+		require.Nil(t, newInterimCompactifiedNode(t, 5, n1, n2))
 	})
 
 	t.Run("one child nil and one child empty", func(t *testing.T) {
-		require.Nil(t, node.NewInterimCompactifiedNode(5, nil, n2))
-		require.Nil(t, node.NewInterimCompactifiedNode(5, n1, nil))
+		require.Nil(t, newInterimCompactifiedNode(t, 5, nil, n2))
+		require.Nil(t, newInterimCompactifiedNode(t, 5, n1, nil))
 	})
 
 	t.Run("both children nil", func(t *testing.T) {
-		require.Nil(t, node.NewInterimCompactifiedNode(5, nil, nil))
+		require.Nil(t, newInterimCompactifiedNode(t, 5, nil, nil))
 	})
 }
 
@@ -159,10 +161,10 @@ func Test_Compactify_ToLeaf(t *testing.T) {
 		// Constructing a trie with pruning/compactification should result in
 		//       nn3(A)
 		// while keeping the root hash invariant
-		nn3 := node.NewInterimCompactifiedNode(5, n1, n2)
+		nn3 := newInterimCompactifiedNode(t, 5, n1, n2)
 		requireIsLeafWithHash(t, nn3, n3.Hash())
 
-		nn3 = node.NewInterimCompactifiedNode(5, nil, n2)
+		nn3 = newInterimCompactifiedNode(t, 5, nil, n2)
 		requireIsLeafWithHash(t, nn3, n3.Hash())
 	})
 
@@ -178,10 +180,10 @@ func Test_Compactify_ToLeaf(t *testing.T) {
 		// Constructing a trie with pruning/compactification should result in
 		//       nn3(A)
 		// while keeping the root hash invariant
-		nn3 := node.NewInterimCompactifiedNode(5, n1, n2)
+		nn3 := newInterimCompactifiedNode(t, 5, n1, n2)
 		requireIsLeafWithHash(t, nn3, n3.Hash())
 
-		nn3 = node.NewInterimCompactifiedNode(5, n1, nil)
+		nn3 = newInterimCompactifiedNode(t, 5, n1, nil)
 		requireIsLeafWithHash(t, nn3, n3.Hash())
 	})
 }
@@ -209,7 +211,7 @@ func Test_Compactify_EmptyChild(t *testing.T) {
 
 		// Constructing a trie with pruning/compactification should result
 		// in n4 being replaced with nil, while keeping the root hash invariant.
-		nn5 := node.NewInterimCompactifiedNode(6, n3, n4)
+		nn5 := newInterimCompactifiedNode(t, 6, n3, n4)
 		require.Equal(t, n3, nn5.LeftChild())
 		require.Nil(t, nn5.RightChild())
 		require.True(t, nn5.VerifyCachedHash())
@@ -231,7 +233,7 @@ func Test_Compactify_EmptyChild(t *testing.T) {
 
 		// Constructing a trie with pruning/compactification should result
 		// in n4 being replaced with nil, while keeping the root hash invariant.
-		nn5 := node.NewInterimCompactifiedNode(6, n3, n4)
+		nn5 := newInterimCompactifiedNode(t, 6, n3, n4)
 		require.Nil(t, nn5.LeftChild())
 		require.Equal(t, n4, nn5.RightChild())
 		require.True(t, nn5.VerifyCachedHash())
@@ -263,19 +265,26 @@ func Test_Compactify_BothChildrenPopulated(t *testing.T) {
 
 	// Constructing a trie with pruning/compactification should result
 	// reproduce exactly the same trie as no pruning/compactification is possible
-	nn3 := node.NewInterimCompactifiedNode(5, n1, n2)
+	nn3 := newInterimCompactifiedNode(t, 5, n1, n2)
 	require.Equal(t, n1, nn3.LeftChild())
 	require.Equal(t, n2, nn3.RightChild())
 	require.True(t, nn3.VerifyCachedHash())
 	require.Equal(t, n3.Hash(), nn3.Hash())
 
-	nn5 := node.NewInterimCompactifiedNode(6, nn3, n4)
+	nn5 := newInterimCompactifiedNode(t, 6, nn3, n4)
 	require.Equal(t, nn3, nn5.LeftChild())
 	require.Equal(t, n4, nn5.RightChild())
 	require.True(t, nn5.VerifyCachedHash())
 	require.Equal(t, n5.Hash(), nn5.Hash())
 }
 
+// newInterimCompactifiedNode creates a compactified interim node from in-memory children.
+func newInterimCompactifiedNode(t *testing.T, height int, lChild, rChild *node.Node) *node.Node {
+	n, err := node.NewInterimCompactifiedNode(height, lChild, rChild)
+	require.NoError(t, err)
+	return n
+}
+
 func hashToString(hash hash.Hash) string {
 	return hex.EncodeToString(hash[:])
 }
@@ -0,0 +1,500 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/encoding"
+	"github.com/onflow/flow-go/ledger/common/hash"
+)
+
+// nodeState describes whether a node is held in memory or offloaded to a node storage.
+type nodeState uint8
+
+const (
+	// inMemory nodes hold their payload and children in memory
+	inMemory nodeState = iota
+	// persisted interim nodes are held in memory, but all their descendants are offloaded
+	// or in memory and persisted, i.e. the subtrie doesn't contain anything to offload
+	persisted
+	// offloadedLeaf nodes hold their path in memory, their payload is loaded from the store
+	offloadedLeaf
+	// offloadedInterim nodes hold no children in memory, they are loaded from the store
+	offloadedInterim
+)
+
+// key prefixes for entries in the store
+const (
+	payloadPrefix  byte = 1
+	childrenPrefix byte = 2
+)
+
+// child kinds in an encoded children entry
+const (
+	noChild      byte = 0
+	leafChild    byte = 1
+	interimChild byte = 2
+)
+
+// encoded child sizes: kind + hash, and additionally the path for leaves
+const (
+	encInterimChildSize = 1 + hash.HashLen
+	encLeafChildSize    = encInterimChildSize + ledger.PathLen
+)
+
+// pruneBatchSize is the maximum number of keys deleted from the store at once when pruning
+const pruneBatchSize = 1000
+
+// estimated memory footprint of cache entries, on top of the payload size
+const (
+	cacheEntryOverhead    = 128
+	cachedChildrenSize    = cacheEntryOverhead + 2*200
+	cachedPayloadOverhead = cacheEntryOverhead + 100
+)
+
+// Entry is a key-value pair written to a Store.
+type Entry struct {
+	Key   []byte
+	Value []byte
+}
+
+// Store is a content-addressed key-value store holding offloaded trie nodes. As keys are
+// derived from node hashes, a value written for a key never changes.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the value stored for the given key.
+	Get(key []byte) ([]byte, error)
+	// Put writes the given entries.
+	Put(entries []Entry) error
+	// Delete removes the given keys, keys which aren't stored are ignored.
+	Delete(keys [][]byte) error
+	// ForEachKey calls fn for each key in the store, until fn returns an error.
+	// fn may delete keys from the store, and owns the key passed to it.
+	ForEachKey(fn func(key []byte) error) error
+}
+
+// Storage offloads trie nodes to a Store and loads them lazily when they are accessed,
+// keeping recently loaded nodes in a memory-bounded LRU cache:
+//   - the payloads of leaves are always offloaded, the leaves keep their path and hash.
+//   - if a subtrie depth is configured, interim nodes at this depth (distance to the
+//     root) are offloaded together with their complete subtrie. Only their hash is kept.
+//
+// Offloaded nodes are stored by their hash, so that offloading doesn't change root hashes,
+// and subtries shared between tries are only stored once. As subtries are shared, nodes
+// can't be removed from the store when a trie is removed; instead, Prune removes all nodes
+// which aren't reachable from the given live tries.
+//
+// Subtries representing only unallocated registers (default hash) are never offloaded:
+// they all share the same hash, but might hold different (empty) payloads.
+//
+// Offloaded nodes are loaded through Node.LoadPayload and Node.LoadChildren, which return
+// an error if the node can't be loaded from the store.
+type Storage struct {
+	store        Store
+	subtrieDepth int // depth of the offloaded interim nodes, 0 to only offload payloads
+
+	mu        sync.Mutex
+	cache     *simplelru.LRU
+	cacheSize uint64 // estimated memory footprint of the cache entries
+	maxSize   uint64 // maximum memory footprint of the cache entries
+
+	// pruneMu guards the store keys written while pruning, which must not be deleted
+	// as they might not be reachable from the live tries the pruning started with.
+	pruneMu sync.Mutex
+	written map[string]struct{} // nil if not pruning
+}
+
+// cachedChildren are the children of an offloaded interim node.
+type cachedChildren struct {
+	lChild *Node
+	rChild *Node
+}
+
+// NewStorage creates a node storage offloading nodes to the given store.
+// Interim nodes at `subtrieDepth` (distance to the root, at least 1) are offloaded
+// with their subtrie, 0 disables offloading interim nodes.
+// `cacheSize` is the (approximate) maximum number of bytes of the cache of loaded nodes.
+func NewStorage(store Store, subtrieDepth int, cacheSize uint64) (*Storage, error) {
+	if subtrieDepth < 0 || subtrieDepth > ledger.NodeMaxHeight {
+		return nil, fmt.Errorf("subtrie depth must be between 0 and %d, but is %d", ledger.NodeMaxHeight, subtrieDepth)
+	}
+
+	s := &Storage{
+		store:        store,
+		subtrieDepth: subtrieDepth,
+		maxSize:      cacheSize,
+	}
+	// the number of entries is bounded by the estimated size of the entries instead
+	cache, err := simplelru.NewLRU(int(^uint(0)>>1), func(_ interface{}, value interface{}) {
+		s.cacheSize -= cachedEntrySize(value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create node cache: %w", err)
+	}
+	s.cache = cache
+
+	return s, nil
+}
+
+// Offload writes all nodes of the subtrie rooted at n, which are not already offloaded,
+// to the store. It returns the root of an equivalent subtrie, whose nodes are offloaded.
+// The given subtrie is not modified.
+func (s *Storage) Offload(n *Node) (*Node, error) {
+	var entries []Entry
+	root := s.offload(n, &entries)
+	if len(entries) == 0 {
+		return root, nil
+	}
+
+	s.pruneMu.Lock()
+	if s.written != nil {
+		for _, entry := range entries {
+			s.written[string(entry.Key)] = struct{}{}
+		}
+	}
+	s.pruneMu.Unlock()
+
+	err := s.store.Put(entries)
+	if err != nil {
+		return nil, fmt.Errorf("could not write offloaded nodes: %w", err)
+	}
+	return root, nil
+}
+
+// Prune removes all nodes from the store, which aren't reachable from the roots returned by
+// liveRoots. Tries offloaded concurrently must be live. liveRoots is called once pruning
+// started, so that nodes offloaded concurrently are either reachable from these roots,
+// or aren't removed.
+// Removed nodes can't be loaded anymore: all tries which might still be accessed must be live.
+// Pruning reads all live nodes from the store, and can be aborted through the context.
+func (s *Storage) Prune(ctx context.Context, liveRoots func() []*Node) error {
+	s.pruneMu.Lock()
+	if s.written != nil {
+		s.pruneMu.Unlock()
+		return fmt.Errorf("pruning is already in progress")
+	}
+	s.written = make(map[string]struct{})
+	s.pruneMu.Unlock()
+	defer func() {
+		s.pruneMu.Lock()
+		s.written = nil
+		s.pruneMu.Unlock()
+	}()
+
+	live := make(map[string]struct{})
+	for _, root := range liveRoots() {
+		err := s.mark(ctx, root, live)
+		if err != nil {
+			return fmt.Errorf("could not mark live nodes: %w", err)
+		}
+	}
+
+	batch := make([][]byte, 0, pruneBatchSize)
+	err := s.store.ForEachKey(func(key []byte) error {
+		if _, ok := live[string(key)]; ok {
+			return nil
+		}
+		batch = append(batch, key)
+		if len(batch) < pruneBatchSize {
+			return nil
+		}
+		err := s.deleteUnwritten(ctx, batch)
+		batch = batch[:0]
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("could not remove unreachable nodes: %w", err)
+	}
+	err = s.deleteUnwritten(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("could not remove unreachable nodes: %w", err)
+	}
+	return nil
+}
+
+// mark adds the store keys of all nodes of the subtrie rooted at n to live. The children
+// of offloaded interim nodes are read from the store, bypassing the cache.
+func (s *Storage) mark(ctx context.Context, n *Node, live map[string]struct{}) error {
+	if n == nil || n.IsDefaultNode() {
+		// unallocated subtries are never offloaded
+		return nil
+	}
+	if n.IsLeaf() {
+		live[string(storeKey(payloadPrefix, n.hashValue))] = struct{}{}
+		return nil
+	}
+
+	key := storeKey(childrenPrefix, n.hashValue)
+	if _, ok := live[string(key)]; ok {
+		// subtries with the same hash are identical, the subtrie was already marked
+		return nil
+	}
+	live[string(key)] = struct{}{}
+
+	lChild, rChild := n.lChild, n.rChild
+	if n.state == offloadedInterim {
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+		data, err := s.store.Get(key)
+		if err != nil {
+			return fmt.Errorf("could not load children of offloaded node %x at height %d: %w", n.hashValue, n.height, err)
+		}
+		children, err := s.decodeChildren(data, n.height-1)
+		if err != nil {
+			return fmt.Errorf("could not decode children of offloaded node %x at height %d: %w", n.hashValue, n.height, err)
+		}
+		lChild, rChild = children.lChild, children.rChild
+	}
+
+	err := s.mark(ctx, lChild, live)
+	if err != nil {
+		return err
+	}
+	return s.mark(ctx, rChild, live)
+}
+
+// deleteUnwritten deletes the given keys from the store, except the ones written since pruning started.
+func (s *Storage) deleteUnwritten(ctx context.Context, keys [][]byte) error {
+	err := ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	// hold the lock while deleting, so that keys written concurrently are either excluded,
+	// or written after they were deleted.
+	s.pruneMu.Lock()
+	defer s.pruneMu.Unlock()
+
+	unwritten := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := s.written[string(key)]; !ok {
+			unwritten = append(unwritten, key)
+		}
+	}
+	if len(unwritten) == 0 {
+		return nil
+	}
+	return s.store.Delete(unwritten)
+}
+
+// offload returns the offloaded equivalent of n, and appends the store entries to write to entries.
+func (s *Storage) offload(n *Node, entries *[]Entry) *Node {
+	if n == nil || n.state != inMemory || n.IsDefaultNode() {
+		return n
+	}
+
+	if n.IsLeaf() {
+		*entries = append(*entries, Entry{
+			Key:   storeKey(payloadPrefix, n.hashValue),
+			Value: encoding.EncodePayload(n.payload),
+		})
+		return s.offloadedLeaf(n.height, n.path, n.hashValue)
+	}
+
+	depth := ledger.NodeMaxHeight - n.height
+	if s.subtrieDepth > 0 && depth >= s.subtrieDepth {
+		subtrieEntries, ok := s.offloadSubtrie(n, nil)
+		if ok {
+			*entries = append(*entries, subtrieEntries...)
+			return s.offloadedInterim(n.height, n.hashValue)
+		}
+		// the subtrie holds an unallocated subtrie, which we can't offload:
+		// keep this node in memory and try to offload its children instead.
+	}
+
+	return &Node{
+		lChild:    s.offload(n.lChild, entries),
+		rChild:    s.offload(n.rChild, entries),
+		height:    n.height,
+		hashValue: n.hashValue,
+		state:     persisted,
+	}
+}
+
+// offloadSubtrie appends the store entries for the subtrie rooted at interim node n to entries.
+// It returns false if the subtrie can't be offloaded because it holds an unallocated subtrie.
+func (s *Storage) offloadSubtrie(n *Node, entries []Entry) ([]Entry, bool) {
+	value := make([]byte, 0, 2*encLeafChildSize)
+	for _, child := range []*Node{n.lChild, n.rChild} {
+		switch {
+		case child == nil:
+			value = append(value, noChild)
+			continue
+		case child.IsDefaultNode():
+			return nil, false
+		case child.IsLeaf():
+			value = append(value, leafChild)
+			value = append(value, child.hashValue[:]...)
+			value = append(value, child.path[:]...)
+			if child.state != offloadedLeaf {
+				entries = append(entries, Entry{
+					Key:   storeKey(payloadPrefix, child.hashValue),
+					Value: encoding.EncodePayload(child.payload),
+				})
+			}
+		default:
+			value = append(value, interimChild)
+			value = append(value, child.hashValue[:]...)
+			if child.state != offloadedInterim {
+				var ok bool
+				entries, ok = s.offloadSubtrie(child, entries)
+				if !ok {
+					return nil, false
+				}
+			}
+		}
+	}
+
+	entries = append(entries, Entry{
+		Key:   storeKey(childrenPrefix, n.hashValue),
+		Value: value,
+	})
+	return entries, true
+}
+
+// loadPayload returns the payload of an offloaded leaf.
+func (s *Storage) loadPayload(n *Node) (*ledger.Payload, error) {
+	key := storeKey(payloadPrefix, n.hashValue)
+	if value, ok := s.cached(key); ok {
+		return value.(*ledger.Payload), nil
+	}
+
+	data, err := s.store.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not load payload of offloaded leaf %x at height %d: %w", n.hashValue, n.height, err)
+	}
+	payload, err := encoding.DecodePayload(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode payload of offloaded leaf %x at height %d: %w", n.hashValue, n.height, err)
+	}
+
+	s.addToCache(key, payload)
+	return payload, nil
+}
+
+// loadChildren returns the children of an offloaded interim node.
+func (s *Storage) loadChildren(n *Node) (*cachedChildren, error) {
+	key := storeKey(childrenPrefix, n.hashValue)
+	if value, ok := s.cached(key); ok {
+		return value.(*cachedChildren), nil
+	}
+
+	data, err := s.store.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not load children of offloaded node %x at height %d: %w", n.hashValue, n.height, err)
+	}
+	children, err := s.decodeChildren(data, n.height-1)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode children of offloaded node %x at height %d: %w", n.hashValue, n.height, err)
+	}
+
+	s.addToCache(key, children)
+	return children, nil
+}
+
+// decodeChildren decodes the offloaded children at the given height of an interim node.
+func (s *Storage) decodeChildren(data []byte, height int) (*cachedChildren, error) {
+	var nodes [2]*Node
+	for i := range nodes {
+		if len(data) < 1 {
+			return nil, fmt.Errorf("missing child kind")
+		}
+		kind := data[0]
+		if kind == noChild {
+			data = data[1:]
+			continue
+		}
+		if len(data) < encInterimChildSize {
+			return nil, fmt.Errorf("child too short: %d bytes", len(data))
+		}
+		h, err := hash.ToHash(data[1:encInterimChildSize])
+		if err != nil {
+			return nil, fmt.Errorf("invalid child hash: %w", err)
+		}
+
+		switch kind {
+		case leafChild:
+			if len(data) < encLeafChildSize {
+				return nil, fmt.Errorf("leaf child too short: %d bytes", len(data))
+			}
+			path, err := ledger.ToPath(data[encInterimChildSize:encLeafChildSize])
+			if err != nil {
+				return nil, fmt.Errorf("invalid leaf child path: %w", err)
+			}
+			nodes[i] = s.offloadedLeaf(height, path, h)
+			data = data[encLeafChildSize:]
+		case interimChild:
+			nodes[i] = s.offloadedInterim(height, h)
+			data = data[encInterimChildSize:]
+		default:
+			return nil, fmt.Errorf("unknown child kind: %d", kind)
+		}
+	}
+	if len(data) != 0 {
+		return nil, fmt.Errorf("%d trailing bytes", len(data))
+	}
+
+	return &cachedChildren{lChild: nodes[0], rChild: nodes[1]}, nil
+}
+
+func (s *Storage) offloadedLeaf(height int, path ledger.Path, hashValue hash.Hash) *Node {
+	return &Node{
+		height:    height,
+		path:      path,
+		hashValue: hashValue,
+		storage:   s,
+		state:     offloadedLeaf,
+	}
+}
+
+func (s *Storage) offloadedInterim(height int, hashValue hash.Hash) *Node {
+	return &Node{
+		height:    height,
+		hashValue: hashValue,
+		storage:   s,
+		state:     offloadedInterim,
+	}
+}
+
+func (s *Storage) cached(key []byte) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Get(string(key))
+}
+
+func (s *Storage) addToCache(key []byte, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cache.Contains(string(key)) {
+		return
+	}
+	s.cache.Add(string(key), value)
+	s.cacheSize += cachedEntrySize(value)
+	for s.cacheSize > s.maxSize && s.cache.Len() > 0 {
+		s.cache.RemoveOldest()
+	}
+}
+
+// cachedEntrySize returns the estimated memory footprint of a cache entry.
+func cachedEntrySize(value interface{}) uint64 {
+	switch v := value.(type) {
+	case *ledger.Payload:
+		return uint64(cachedPayloadOverhead + v.Size())
+	default:
+		return cachedChildrenSize
+	}
+}
+
+func storeKey(prefix byte, h hash.Hash) []byte {
+	key := make([]byte, 1+hash.HashLen)
+	key[0] = prefix
+	copy(key[1:], h[:])
+	return key
+}
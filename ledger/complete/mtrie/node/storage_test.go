@@ -0,0 +1,226 @@
+package node_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+)
+
+// memoryStore is an in-memory node.Store counting its reads and writes.
+type memoryStore struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+	gets    int
+	puts    int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{entries: make(map[string][]byte)}
+}
+
+func (s *memoryStore) Get(key []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gets++
+	value, ok := s.entries[string(key)]
+	if !ok {
+		return nil, fmt.Errorf("key %x not found", key)
+	}
+	return value, nil
+}
+
+func (s *memoryStore) Put(entries []node.Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.puts++
+	for _, entry := range entries {
+		s.entries[string(entry.Key)] = entry.Value
+	}
+	return nil
+}
+
+func (s *memoryStore) Delete(keys [][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.entries, string(key))
+	}
+	return nil
+}
+
+func (s *memoryStore) ForEachKey(fn func(key []byte) error) error {
+	s.mu.Lock()
+	keys := make([][]byte, 0, len(s.entries))
+	for key := range s.entries {
+		keys = append(keys, []byte(key))
+	}
+	s.mu.Unlock()
+
+	for _, key := range keys {
+		err := fn(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storageTestTrie returns the following subtrie, with `n4` at height 2:
+//
+//	      n4
+//	     /  \
+//	   n3    n2
+//	  /  \
+//	n1    n0
+func storageTestTrie() *node.Node {
+	n0 := node.NewLeaf(utils.PathByUint8(1), utils.LightPayload8('A', 'a'), 0)
+	n1 := node.NewLeaf(utils.PathByUint8(0), utils.LightPayload8('B', 'b'), 0)
+	n2 := node.NewLeaf(utils.PathByUint8(2), utils.LightPayload8('C', 'c'), 1)
+	n3 := node.NewInterimNode(1, n1, n0)
+	return node.NewInterimNode(2, n3, n2)
+}
+
+// requireSamePayloads requires both subtries to hold the same payloads.
+func requireSamePayloads(t *testing.T, expected, actual *node.Node) {
+	expectedPayloads, err := expected.AllPayloads()
+	require.NoError(t, err)
+	payloads, err := actual.AllPayloads()
+	require.NoError(t, err)
+	require.Equal(t, expectedPayloads, payloads)
+}
+
+// Test_StorageOffloadLeaves verifies that offloading leaf payloads keeps the hashes and
+// payloads of the subtrie, and that the payloads are loaded from the store.
+func Test_StorageOffloadLeaves(t *testing.T) {
+	store := newMemoryStore()
+	storage, err := node.NewStorage(store, 0, 0)
+	require.NoError(t, err)
+
+	n4 := storageTestTrie()
+	offloaded, err := storage.Offload(n4)
+	require.NoError(t, err)
+	require.Len(t, store.entries, 3)
+
+	require.Equal(t, n4.Hash(), offloaded.Hash())
+	requireSamePayloads(t, n4, offloaded)
+	require.True(t, offloaded.VerifyCachedHash())
+	require.Equal(t, *n4.RightChild().Path(), *offloaded.RightChild().Path())
+	require.Nil(t, offloaded.RightChild().Payload())
+	require.Greater(t, store.gets, 0)
+
+	// offloading an offloaded subtrie doesn't write anything
+	again, err := storage.Offload(offloaded)
+	require.NoError(t, err)
+	require.Same(t, offloaded, again)
+	require.Equal(t, 1, store.puts)
+}
+
+// Test_StorageOffloadSubtries verifies that interim nodes at the configured depth are offloaded
+// together with their subtrie, and loaded from the store when accessed.
+func Test_StorageOffloadSubtries(t *testing.T) {
+	store := newMemoryStore()
+	// n3 is at depth 255
+	storage, err := node.NewStorage(store, ledger.NodeMaxHeight-1, 0)
+	require.NoError(t, err)
+
+	n4 := storageTestTrie()
+	offloaded, err := storage.Offload(n4)
+	require.NoError(t, err)
+	// payloads of n0, n1, n2 and the children of n3
+	require.Len(t, store.entries, 4)
+
+	n3 := offloaded.LeftChild()
+	require.False(t, n3.IsLeaf())
+	require.Equal(t, n4.LeftChild().Hash(), n3.Hash())
+	require.Nil(t, n3.LeftChild())
+	n1, n0, err := n3.LoadChildren()
+	require.NoError(t, err)
+	require.Equal(t, n4.LeftChild().LeftChild().Hash(), n1.Hash())
+	payload, err := n0.LoadPayload()
+	require.NoError(t, err)
+	require.Equal(t, n4.LeftChild().RightChild().Payload(), payload)
+	requireSamePayloads(t, n4, offloaded)
+	require.True(t, offloaded.VerifyCachedHash())
+}
+
+// Test_StorageCache verifies that loaded nodes are cached.
+func Test_StorageCache(t *testing.T) {
+	store := newMemoryStore()
+	storage, err := node.NewStorage(store, 0, 1<<20)
+	require.NoError(t, err)
+
+	offloaded, err := storage.Offload(storageTestTrie())
+	require.NoError(t, err)
+
+	leaf := offloaded.RightChild()
+	payload, err := leaf.LoadPayload()
+	require.NoError(t, err)
+	require.Equal(t, 1, store.gets)
+	cached, err := leaf.LoadPayload()
+	require.NoError(t, err)
+	require.Same(t, payload, cached)
+	require.Equal(t, 1, store.gets)
+}
+
+// Test_StorageMissingNode verifies that loading a node missing from the store returns an error.
+func Test_StorageMissingNode(t *testing.T) {
+	store := newMemoryStore()
+	storage, err := node.NewStorage(store, ledger.NodeMaxHeight-1, 0)
+	require.NoError(t, err)
+
+	offloaded, err := storage.Offload(storageTestTrie())
+	require.NoError(t, err)
+
+	store.entries = make(map[string][]byte)
+	_, err = offloaded.RightChild().LoadPayload()
+	require.Error(t, err)
+	_, _, err = offloaded.LeftChild().LoadChildren()
+	require.Error(t, err)
+	_, err = offloaded.AllPayloads()
+	require.Error(t, err)
+	require.False(t, offloaded.VerifyCachedHash())
+}
+
+// Test_StoragePrune verifies that pruning removes the nodes which aren't reachable from the
+// live tries, and keeps the nodes shared with live tries.
+func Test_StoragePrune(t *testing.T) {
+	store := newMemoryStore()
+	storage, err := node.NewStorage(store, ledger.NodeMaxHeight-1, 0)
+	require.NoError(t, err)
+
+	n4 := storageTestTrie()
+	live, err := storage.Offload(n4)
+	require.NoError(t, err)
+
+	// shares n3 with n4, but holds another leaf instead of n2
+	n5 := node.NewLeaf(utils.PathByUint8(3), utils.LightPayload8('D', 'd'), 1)
+	evicted, err := storage.Offload(node.NewInterimNode(2, n4.LeftChild(), n5))
+	require.NoError(t, err)
+	require.Len(t, store.entries, 5)
+
+	err = storage.Prune(context.Background(), func() []*node.Node {
+		return []*node.Node{live}
+	})
+	require.NoError(t, err)
+	require.Len(t, store.entries, 4)
+
+	requireSamePayloads(t, n4, live)
+	require.True(t, live.VerifyCachedHash())
+	_, err = evicted.RightChild().LoadPayload()
+	require.Error(t, err)
+}
+
+// Test_StorageInvalidDepth verifies that the subtrie depth is checked.
+func Test_StorageInvalidDepth(t *testing.T) {
+	_, err := node.NewStorage(newMemoryStore(), -1, 0)
+	require.Error(t, err)
+	_, err = node.NewStorage(newMemoryStore(), ledger.NodeMaxHeight+1, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package nodestore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+)
+
+// ErrNotFound is returned when a node isn't in the store.
+var ErrNotFound = errors.New("node not found")
+
+// BadgerStore is a node.Store persisting offloaded trie nodes in a badger database.
+type BadgerStore struct {
+	db *badger.DB
+}
+
+var _ node.Store = (*BadgerStore)(nil)
+
+// NewBadgerStore creates a node store using the given badger database.
+// The database must not be used for anything else.
+func NewBadgerStore(db *badger.DB) *BadgerStore {
+	return &BadgerStore{db: db}
+}
+
+// Open opens (or creates) a badger node store in the given directory. If an encryption
+// key is provided, the nodes are encrypted at rest.
+func Open(dir string, encryptionKey []byte) (*BadgerStore, error) {
+	opts := badger.DefaultOptions(dir).WithLogger(nil)
+	if encryptionKey != nil {
+		// badger requires an index cache when encryption is enabled
+		opts = opts.WithEncryptionKey(encryptionKey).WithIndexCacheSize(100 << 20)
+	}
+
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not open node store: %w", err)
+	}
+	return NewBadgerStore(db), nil
+}
+
+// Get returns the value stored for the given key, or ErrNotFound.
+func (s *BadgerStore) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := s.db.View(func(tx *badger.Txn) error {
+		item, err := tx.Get(key)
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, fmt.Errorf("could not get key %x: %w", key, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get key %x: %w", key, err)
+	}
+	return value, nil
+}
+
+// Put writes the given entries in a single batch.
+func (s *BadgerStore) Put(entries []node.Entry) error {
+	batch := s.db.NewWriteBatch()
+	defer batch.Cancel()
+
+	for _, entry := range entries {
+		err := batch.Set(entry.Key, entry.Value)
+		if err != nil {
+			return fmt.Errorf("could not write key %x: %w", entry.Key, err)
+		}
+	}
+
+	err := batch.Flush()
+	if err != nil {
+		return fmt.Errorf("could not flush nodes: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the given keys in a single batch.
+func (s *BadgerStore) Delete(keys [][]byte) error {
+	batch := s.db.NewWriteBatch()
+	defer batch.Cancel()
+
+	for _, key := range keys {
+		err := batch.Delete(key)
+		if err != nil {
+			return fmt.Errorf("could not delete key %x: %w", key, err)
+		}
+	}
+
+	err := batch.Flush()
+	if err != nil {
+		return fmt.Errorf("could not flush deleted nodes: %w", err)
+	}
+	return nil
+}
+
+// ForEachKey calls fn for each key in the store, iterating over a snapshot of the store.
+func (s *BadgerStore) ForEachKey(fn func(key []byte) error) error {
+	return s.db.View(func(tx *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := tx.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			err := fn(it.Item().KeyCopy(nil))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Close closes the underlying database.
+func (s *BadgerStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,149 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/hash"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+)
+
+// Diff walks both tries simultaneously and calls fn for each register whose payload differs
+// between the older trie `from` and the newer trie `to`. Subtries with identical hashes
+// are skipped, so the cost of the walk is proportional to the size of the difference.
+// Changes are reported in ascending order of register paths, and the walk stops at the
+// first error returned by fn, which is returned as is.
+// Concurrency safe (as Tries are immutable structures by convention)
+func Diff(from, to *MTrie, fn func(change ledger.PayloadChange) error) error {
+	return diff(ledger.NodeMaxHeight, from.root, to.root, fn)
+}
+
+// diff reports the changes between the subtries `from` and `to`, both at the given height.
+func diff(height int, from, to *node.Node, fn func(change ledger.PayloadChange) error) error {
+	if subtrieHash(height, from) == subtrieHash(height, to) {
+		return nil
+	}
+
+	// at least one of the subtries holds at most one register: compare it with
+	// all registers of the other subtrie
+	if from.IsLeaf() {
+		return diffLeaf(from, to, fn, false)
+	}
+	if to.IsLeaf() {
+		return diffLeaf(to, from, fn, true)
+	}
+
+	fromLeft, fromRight, err := from.LoadChildren()
+	if err != nil {
+		return err
+	}
+	toLeft, toRight, err := to.LoadChildren()
+	if err != nil {
+		return err
+	}
+	err = diff(height-1, fromLeft, toLeft, fn)
+	if err != nil {
+		return err
+	}
+	return diff(height-1, fromRight, toRight, fn)
+}
+
+// diffLeaf reports the changes between a leaf (or empty subtrie) and another subtrie at
+// the same height. If reversed is false, the leaf is in the older trie, otherwise in the newer.
+func diffLeaf(leaf, other *node.Node, fn func(change ledger.PayloadChange) error, reversed bool) error {
+	var leafPath *ledger.Path
+	var leafPayload *ledger.Payload
+	if leaf != nil {
+		payload, err := leaf.LoadPayload()
+		if err != nil {
+			return err
+		}
+		if !payload.IsEmpty() {
+			leafPath = leaf.Path()
+			leafPayload = payload
+		}
+	}
+
+	// change creates the change of a register with the given payload in the leaf and the other subtrie
+	change := func(path ledger.Path, inLeaf, inOther *ledger.Payload) ledger.PayloadChange {
+		c := ledger.PayloadChange{Path: path, Old: inLeaf, New: inOther}
+		if reversed {
+			c.Old, c.New = inOther, inLeaf
+		}
+		switch {
+		case c.Old == nil:
+			c.Type = ledger.PayloadAdded
+		case c.New == nil:
+			c.Type = ledger.PayloadRemoved
+		default:
+			c.Type = ledger.PayloadUpdated
+		}
+		return c
+	}
+
+	// registers of the other subtrie are visited in ascending order of paths: the
+	// leaf register is reported in order, unless it's also in the other subtrie
+	err := walkRegisters(other, func(path ledger.Path, payload *ledger.Payload) error {
+		if leafPath != nil {
+			cmp := bytes.Compare(leafPath[:], path[:])
+			if cmp == 0 {
+				leafPath = nil
+				if leafPayload.Equals(payload) {
+					return nil
+				}
+				return fn(change(path, leafPayload, payload))
+			}
+			if cmp < 0 {
+				err := fn(change(*leafPath, leafPayload, nil))
+				leafPath = nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return fn(change(path, nil, payload))
+	})
+	if err != nil {
+		return err
+	}
+
+	if leafPath != nil {
+		return fn(change(*leafPath, leafPayload, nil))
+	}
+	return nil
+}
+
+// walkRegisters calls fn for all allocated registers in the subtrie, in ascending order of paths.
+func walkRegisters(n *node.Node, fn func(path ledger.Path, payload *ledger.Payload) error) error {
+	if n == nil {
+		return nil
+	}
+	if n.IsLeaf() {
+		payload, err := n.LoadPayload()
+		if err != nil {
+			return err
+		}
+		if payload.IsEmpty() {
+			return nil
+		}
+		return fn(*n.Path(), payload)
+	}
+
+	lChild, rChild, err := n.LoadChildren()
+	if err != nil {
+		return err
+	}
+	err = walkRegisters(lChild, fn)
+	if err != nil {
+		return err
+	}
+	return walkRegisters(rChild, fn)
+}
+
+// subtrieHash returns the hash of the subtrie at the given height, which might be empty (nil).
+func subtrieHash(height int, n *node.Node) hash.Hash {
+	if n == nil {
+		return ledger.GetDefaultHashForHeight(height)
+	}
+	return n.Hash()
+}
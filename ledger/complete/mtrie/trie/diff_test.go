@@ -0,0 +1,156 @@
+package trie_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+)
+
+// Test_DiffIdenticalTries verifies that identical tries have no difference.
+func Test_DiffIdenticalTries(t *testing.T) {
+	paths := utils.RandomPaths(100)
+	payloads := payloadsOf(utils.RandomPayloads(len(paths), 1, 10))
+	updated, _, err := trie.NewTrieWithUpdatedRegisters(trie.NewEmptyMTrie(), paths, payloads, true)
+	require.NoError(t, err)
+
+	changes := diffTries(t, updated, updated)
+	require.Empty(t, changes)
+
+	changes = diffTries(t, trie.NewEmptyMTrie(), trie.NewEmptyMTrie())
+	require.Empty(t, changes)
+}
+
+// Test_DiffRandomUpdates verifies the difference between tries against the difference
+// of their registers, for random updates adding, updating and removing registers.
+func Test_DiffRandomUpdates(t *testing.T) {
+	registers := make(map[ledger.Path]*ledger.Payload)
+	current := trie.NewEmptyMTrie()
+
+	for step := 0; step < 10; step++ {
+		paths := utils.RandomPaths(50)
+		payloads := payloadsOf(utils.RandomPayloads(len(paths), 1, 10))
+		// update and remove some existing registers
+		for existing := range registers {
+			if len(paths) >= 70 {
+				break
+			}
+			paths = append(paths, existing)
+			if rand.Intn(2) == 0 {
+				payloads = append(payloads, *ledger.EmptyPayload())
+			} else {
+				payloads = append(payloads, *utils.RandomPayload(1, 10))
+			}
+		}
+
+		updatedRegisters := make(map[ledger.Path]*ledger.Payload, len(registers))
+		for path, payload := range registers {
+			updatedRegisters[path] = payload
+		}
+		for i, path := range paths {
+			payload := payloads[i]
+			if payload.IsEmpty() {
+				delete(updatedRegisters, path)
+			} else {
+				updatedRegisters[path] = &payload
+			}
+		}
+
+		updated, _, err := trie.NewTrieWithUpdatedRegisters(current, append([]ledger.Path{}, paths...), append([]ledger.Payload{}, payloads...), true)
+		require.NoError(t, err)
+
+		require.Equal(t, expectedChanges(registers, updatedRegisters), diffTries(t, current, updated))
+		require.Equal(t, expectedChanges(updatedRegisters, registers), diffTries(t, updated, current))
+
+		current = updated
+		registers = updatedRegisters
+	}
+}
+
+// Test_DiffExpandedTrie verifies that a trie expanded with unallocated registers (as done
+// for non-inclusion proofs) has no difference with the original trie.
+func Test_DiffExpandedTrie(t *testing.T) {
+	paths := utils.RandomPaths(20)
+	payloads := payloadsOf(utils.RandomPayloads(len(paths), 1, 10))
+	original, _, err := trie.NewTrieWithUpdatedRegisters(trie.NewEmptyMTrie(), paths, payloads, true)
+	require.NoError(t, err)
+
+	emptyPaths := utils.RandomPaths(20)
+	emptyPayloads := make([]ledger.Payload, len(emptyPaths))
+	for i := range emptyPayloads {
+		emptyPayloads[i] = *ledger.EmptyPayload()
+	}
+	expanded, _, err := trie.NewTrieWithUpdatedRegisters(original, emptyPaths, emptyPayloads, false)
+	require.NoError(t, err)
+	require.Equal(t, original.RootHash(), expanded.RootHash())
+
+	require.Empty(t, diffTries(t, original, expanded))
+}
+
+// Test_DiffStopsOnError verifies that the walk stops at the first error.
+func Test_DiffStopsOnError(t *testing.T) {
+	paths := utils.RandomPaths(10)
+	payloads := payloadsOf(utils.RandomPayloads(len(paths), 1, 10))
+	updated, _, err := trie.NewTrieWithUpdatedRegisters(trie.NewEmptyMTrie(), paths, payloads, true)
+	require.NoError(t, err)
+
+	expected := errors.New("expected")
+	calls := 0
+	err = trie.Diff(trie.NewEmptyMTrie(), updated, func(ledger.PayloadChange) error {
+		calls++
+		return expected
+	})
+	require.ErrorIs(t, err, expected)
+	require.Equal(t, 1, calls)
+}
+
+func diffTries(t *testing.T, from, to *trie.MTrie) []ledger.PayloadChange {
+	var changes []ledger.PayloadChange
+	err := trie.Diff(from, to, func(change ledger.PayloadChange) error {
+		changes = append(changes, change)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// changes are reported in ascending order of paths
+	require.True(t, sort.SliceIsSorted(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Path[:], changes[j].Path[:]) < 0
+	}))
+	return changes
+}
+
+func expectedChanges(from, to map[ledger.Path]*ledger.Payload) []ledger.PayloadChange {
+	var changes []ledger.PayloadChange
+	for path, old := range from {
+		updated, ok := to[path]
+		if !ok {
+			changes = append(changes, ledger.PayloadChange{Path: path, Type: ledger.PayloadRemoved, Old: old})
+		} else if !old.Equals(updated) {
+			changes = append(changes, ledger.PayloadChange{Path: path, Type: ledger.PayloadUpdated, Old: old, New: updated})
+		}
+	}
+	for path, added := range to {
+		if _, ok := from[path]; !ok {
+			changes = append(changes, ledger.PayloadChange{Path: path, Type: ledger.PayloadAdded, New: added})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Path[:], changes[j].Path[:]) < 0
+	})
+	return changes
+}
+
+func payloadsOf(payloads []*ledger.Payload) []ledger.Payload {
+	values := make([]ledger.Payload, len(payloads))
+	for i, payload := range payloads {
+		values[i] = *payload
+	}
+	return values
+}
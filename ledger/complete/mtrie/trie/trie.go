@@ -106,11 +106,15 @@ func (mt *MTrie) String() string {
 //     For each path, the corresponding payload value size is written into sizes. AFTER
 //     the size operation completes, the order of `path` and `sizes` are such that
 //     for `path[i]` the corresponding register value size is referenced by `sizes[i]`.
+//  * error, if offloaded nodes can't be loaded from the node storage
 // TODO move consistency checks from Forest into Trie to obtain a safe, self-contained API
-func (mt *MTrie) UnsafeValueSizes(paths []ledger.Path) []int {
+func (mt *MTrie) UnsafeValueSizes(paths []ledger.Path) ([]int, error) {
 	sizes := make([]int, len(paths)) // pre-allocate slice for the result
-	valueSizes(sizes, paths, mt.root)
-	return sizes
+	err := valueSizes(sizes, paths, mt.root)
+	if err != nil {
+		return nil, err
+	}
+	return sizes, nil
 }
 
 // valueSizes returns value sizes of all the registers in `paths`` in subtree with `head` as root node.
@@ -118,22 +122,25 @@ func (mt *MTrie) UnsafeValueSizes(paths []ledger.Path) []int {
 // CAUTION:
 //  * while reading the payloads, `paths` is permuted IN-PLACE for optimized processing.
 //  * unchecked requirement: all paths must go through the `head` node
-func valueSizes(sizes []int, paths []ledger.Path, head *node.Node) {
+func valueSizes(sizes []int, paths []ledger.Path, head *node.Node) error {
 	// check for empty paths
 	if len(paths) == 0 {
-		return
+		return nil
 	}
 
 	// path not found
 	if head == nil {
-		return
+		return nil
 	}
 
 	// reached a leaf node
 	if head.IsLeaf() {
 		for i, p := range paths {
 			if *head.Path() == p {
-				payload := head.Payload()
+				payload, err := head.LoadPayload()
+				if err != nil {
+					return err
+				}
 				if payload != nil {
 					sizes[i] = payload.Value.Size()
 				}
@@ -141,7 +148,7 @@ func valueSizes(sizes []int, paths []ledger.Path, head *node.Node) {
 				// doesn't require paths being deduplicated.
 			}
 		}
-		return
+		return nil
 	}
 
 	// reached an interim node with only one path
@@ -151,20 +158,23 @@ func valueSizes(sizes []int, paths []ledger.Path, head *node.Node) {
 		// traverse nodes following the path until a leaf node or nil node is reached.
 		// "for" loop helps to skip partition and recursive call when there's only one path to follow.
 		for {
+			lChild, rChild, err := head.LoadChildren()
+			if err != nil {
+				return err
+			}
 			depth := ledger.NodeMaxHeight - head.Height() // distance to the tree root
 			bit := bitutils.ReadBit(path, depth)
 			if bit == 0 {
-				head = head.LeftChild()
+				head = lChild
 			} else {
-				head = head.RightChild()
+				head = rChild
 			}
 			if head.IsLeaf() {
 				break
 			}
 		}
 
-		valueSizes(sizes, paths, head)
-		return
+		return valueSizes(sizes, paths, head)
 	}
 
 	// reached an interim node with more than one paths
@@ -177,22 +187,35 @@ func valueSizes(sizes []int, paths []ledger.Path, head *node.Node) {
 	lpaths, rpaths := paths[:partitionIndex], paths[partitionIndex:]
 	lsizes, rsizes := sizes[:partitionIndex], sizes[partitionIndex:]
 
+	lChild, rChild, err := head.LoadChildren()
+	if err != nil {
+		return err
+	}
+
 	// read values from left and right subtrees in parallel
 	parallelRecursionThreshold := 32 // threshold to avoid the parallelization going too deep in the recursion
 	if len(lpaths) < parallelRecursionThreshold || len(rpaths) < parallelRecursionThreshold {
-		valueSizes(lsizes, lpaths, head.LeftChild())
-		valueSizes(rsizes, rpaths, head.RightChild())
-	} else {
-		// concurrent read of left and right subtree
-		wg := sync.WaitGroup{}
-		wg.Add(1)
-		go func() {
-			valueSizes(lsizes, lpaths, head.LeftChild())
-			wg.Done()
-		}()
-		valueSizes(rsizes, rpaths, head.RightChild())
-		wg.Wait() // wait for all threads
-	}
+		err = valueSizes(lsizes, lpaths, lChild)
+		if err != nil {
+			return err
+		}
+		return valueSizes(rsizes, rpaths, rChild)
+	}
+
+	// concurrent read of left and right subtree
+	var lErr error
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		lErr = valueSizes(lsizes, lpaths, lChild)
+		wg.Done()
+	}()
+	err = valueSizes(rsizes, rpaths, rChild)
+	wg.Wait() // wait for all threads
+	if lErr != nil {
+		return lErr
+	}
+	return err
 }
 
 // UnsafeRead reads payloads for the given paths.
@@ -203,11 +226,15 @@ func valueSizes(sizes []int, paths []ledger.Path, head *node.Node) {
 //     For each path, the corresponding payload is written into payloads. AFTER
 //     the read operation completes, the order of `path` and `payloads` are such that
 //     for `path[i]` the corresponding register value is referenced by 0`payloads[i]`.
+//  * error, if offloaded nodes can't be loaded from the node storage
 // TODO move consistency checks from Forest into Trie to obtain a safe, self-contained API
-func (mt *MTrie) UnsafeRead(paths []ledger.Path) []*ledger.Payload {
+func (mt *MTrie) UnsafeRead(paths []ledger.Path) ([]*ledger.Payload, error) {
 	payloads := make([]*ledger.Payload, len(paths)) // pre-allocate slice for the result
-	read(payloads, paths, mt.root)
-	return payloads
+	err := read(payloads, paths, mt.root)
+	if err != nil {
+		return nil, err
+	}
+	return payloads, nil
 }
 
 // read reads all the registers in subtree with `head` as root node. For each
@@ -215,10 +242,10 @@ func (mt *MTrie) UnsafeRead(paths []ledger.Path) []*ledger.Payload {
 // CAUTION:
 //  * while reading the payloads, `paths` is permuted IN-PLACE for optimized processing.
 //  * unchecked requirement: all paths must go through the `head` node
-func read(payloads []*ledger.Payload, paths []ledger.Path, head *node.Node) {
+func read(payloads []*ledger.Payload, paths []ledger.Path, head *node.Node) error {
 	// check for empty paths
 	if len(paths) == 0 {
-		return
+		return nil
 	}
 
 	// path not found
@@ -226,18 +253,22 @@ func read(payloads []*ledger.Payload, paths []ledger.Path, head *node.Node) {
 		for i := range paths {
 			payloads[i] = ledger.EmptyPayload()
 		}
-		return
+		return nil
 	}
 	// reached a leaf node
 	if head.IsLeaf() {
 		for i, p := range paths {
 			if *head.Path() == p {
-				payloads[i] = head.Payload()
+				payload, err := head.LoadPayload()
+				if err != nil {
+					return err
+				}
+				payloads[i] = payload
 			} else {
 				payloads[i] = ledger.EmptyPayload()
 			}
 		}
-		return
+		return nil
 	}
 
 	// partition step to quick sort the paths:
@@ -248,22 +279,35 @@ func read(payloads []*ledger.Payload, paths []ledger.Path, head *node.Node) {
 	lpaths, rpaths := paths[:partitionIndex], paths[partitionIndex:]
 	lpayloads, rpayloads := payloads[:partitionIndex], payloads[partitionIndex:]
 
+	lChild, rChild, err := head.LoadChildren()
+	if err != nil {
+		return err
+	}
+
 	// read values from left and right subtrees in parallel
 	parallelRecursionThreshold := 32 // threshold to avoid the parallelization going too deep in the recursion
 	if len(lpaths) < parallelRecursionThreshold || len(rpaths) < parallelRecursionThreshold {
-		read(lpayloads, lpaths, head.LeftChild())
-		read(rpayloads, rpaths, head.RightChild())
-	} else {
-		// concurrent read of left and right subtree
-		wg := sync.WaitGroup{}
-		wg.Add(1)
-		go func() {
-			read(lpayloads, lpaths, head.LeftChild())
-			wg.Done()
-		}()
-		read(rpayloads, rpaths, head.RightChild())
-		wg.Wait() // wait for all threads
-	}
+		err = read(lpayloads, lpaths, lChild)
+		if err != nil {
+			return err
+		}
+		return read(rpayloads, rpaths, rChild)
+	}
+
+	// concurrent read of left and right subtree
+	var lErr error
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		lErr = read(lpayloads, lpaths, lChild)
+		wg.Done()
+	}()
+	err = read(rpayloads, rpaths, rChild)
+	wg.Wait() // wait for all threads
+	if lErr != nil {
+		return lErr
+	}
+	return err
 }
 
 // NewTrieWithUpdatedRegisters constructs a new trie containing all registers from the parent trie,
@@ -286,7 +330,7 @@ func NewTrieWithUpdatedRegisters(
 	updatedPayloads []ledger.Payload,
 	prune bool,
 ) (*MTrie, uint16, error) {
-	updatedRoot, regCountDelta, regSizeDelta, lowestHeightTouched := update(
+	updatedRoot, regCountDelta, regSizeDelta, lowestHeightTouched, err := update(
 		ledger.NodeMaxHeight,
 		parentTrie.root,
 		updatedPaths,
@@ -294,6 +338,9 @@ func NewTrieWithUpdatedRegisters(
 		nil,
 		prune,
 	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("updating registers failed: %w", err)
+	}
 
 	updatedTrieRegCount := int64(parentTrie.AllocatedRegCount()) + regCountDelta
 	updatedTrieRegSize := int64(parentTrie.AllocatedRegSize()) + regSizeDelta
@@ -313,6 +360,7 @@ type updateResult struct {
 	allocatedRegCountDelta int64
 	allocatedRegSizeDelta  int64
 	lowestHeightTouched    int
+	err                    error
 }
 
 // update traverses the subtree, updates the stored registers, and returns:
@@ -320,6 +368,7 @@ type updateResult struct {
 //   * allocated register count delta in subtrie (allocatedRegCountDelta)
 //   * allocated register size delta in subtrie (allocatedRegSizeDelta)
 //   * lowest height reached during recursive update in subtrie (lowestHeightTouched)
+//   * error, if offloaded nodes can't be loaded from the node storage
 // allocatedRegCountDelta and allocatedRegSizeDelta are used to compute updated
 // trie's allocated register count and size.  lowestHeightTouched is used to
 // compute max depth touched during update.
@@ -332,26 +381,30 @@ func update(
 	nodeHeight int, parentNode *node.Node,
 	paths []ledger.Path, payloads []ledger.Payload, compactLeaf *node.Node,
 	prune bool,
-) (n *node.Node, allocatedRegCountDelta int64, allocatedRegSizeDelta int64, lowestHeightTouched int) {
+) (n *node.Node, allocatedRegCountDelta int64, allocatedRegSizeDelta int64, lowestHeightTouched int, err error) {
 	// No new paths to write
 	if len(paths) == 0 {
 		// check is a compactLeaf from a higher height is still left.
 		if compactLeaf != nil {
 			// create a new node for the compact leaf path and payload. The old node shouldn't
 			// be recycled as it is still used by the tree copy before the update.
-			n = node.NewLeaf(*compactLeaf.Path(), compactLeaf.Payload(), nodeHeight)
-			return n, 0, 0, nodeHeight
+			payload, err := compactLeaf.LoadPayload()
+			if err != nil {
+				return nil, 0, 0, 0, err
+			}
+			n = node.NewLeaf(*compactLeaf.Path(), payload, nodeHeight)
+			return n, 0, 0, nodeHeight, nil
 		}
-		return parentNode, 0, 0, nodeHeight
+		return parentNode, 0, 0, nodeHeight, nil
 	}
 
 	if len(paths) == 1 && parentNode == nil && compactLeaf == nil {
 		n = node.NewLeaf(paths[0], payloads[0].DeepCopy(), nodeHeight)
 		if payloads[0].IsEmpty() {
 			// Unallocated register doesn't affect allocatedRegCountDelta and allocatedRegSizeDelta.
-			return n, 0, 0, nodeHeight
+			return n, 0, 0, nodeHeight, nil
 		}
-		return n, 1, int64(payloads[0].Size()), nodeHeight
+		return n, 1, int64(payloads[0].Size()), nodeHeight, nil
 	}
 
 	if parentNode != nil && parentNode.IsLeaf() { // if we're here then compactLeaf == nil
@@ -360,24 +413,28 @@ func update(
 		parentPath := *parentNode.Path()
 		for i, p := range paths {
 			if p == parentPath {
+				parentPayload, err := parentNode.LoadPayload()
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
 				// the case where the recursion stops: only one path to update
 				if len(paths) == 1 {
-					if !parentNode.Payload().Equals(&payloads[i]) {
+					if !parentPayload.Equals(&payloads[i]) {
 						n = node.NewLeaf(paths[i], payloads[i].DeepCopy(), nodeHeight)
 
 						allocatedRegCountDelta, allocatedRegSizeDelta =
-							computeAllocatedRegDeltas(parentNode.Payload(), &payloads[i])
+							computeAllocatedRegDeltas(parentPayload, &payloads[i])
 
-						return n, allocatedRegCountDelta, allocatedRegSizeDelta, nodeHeight
+						return n, allocatedRegCountDelta, allocatedRegSizeDelta, nodeHeight, nil
 					}
 					// avoid creating a new node when the same payload is written
-					return parentNode, 0, 0, nodeHeight
+					return parentNode, 0, 0, nodeHeight, nil
 				}
 				// the case where the recursion carries on: len(paths)>1
 				found = true
 
 				allocatedRegCountDelta, allocatedRegSizeDelta =
-					computeAllocatedRegDeltasFromHigherHeight(parentNode.Payload())
+					computeAllocatedRegDeltasFromHigherHeight(parentPayload)
 
 				break
 			}
@@ -417,8 +474,10 @@ func update(
 	// set the parent node children
 	var lchildParent, rchildParent *node.Node
 	if parentNode != nil {
-		lchildParent = parentNode.LeftChild()
-		rchildParent = parentNode.RightChild()
+		lchildParent, rchildParent, err = parentNode.LoadChildren()
+		if err != nil {
+			return nil, 0, 0, 0, err
+		}
 	}
 
 	// recurse over each branch
@@ -429,8 +488,14 @@ func update(
 	parallelRecursionThreshold := 16
 	if len(lpaths) < parallelRecursionThreshold || len(rpaths) < parallelRecursionThreshold {
 		// runtime optimization: if there are _no_ updates for either left or right sub-tree, proceed single-threaded
-		lChild, lRegCountDelta, lRegSizeDelta, lLowestHeightTouched = update(nodeHeight-1, lchildParent, lpaths, lpayloads, lcompactLeaf, prune)
-		rChild, rRegCountDelta, rRegSizeDelta, rLowestHeightTouched = update(nodeHeight-1, rchildParent, rpaths, rpayloads, rcompactLeaf, prune)
+		lChild, lRegCountDelta, lRegSizeDelta, lLowestHeightTouched, err = update(nodeHeight-1, lchildParent, lpaths, lpayloads, lcompactLeaf, prune)
+		if err != nil {
+			return nil, 0, 0, 0, err
+		}
+		rChild, rRegCountDelta, rRegSizeDelta, rLowestHeightTouched, err = update(nodeHeight-1, rchildParent, rpaths, rpayloads, rcompactLeaf, prune)
+		if err != nil {
+			return nil, 0, 0, 0, err
+		}
 	} else {
 		// runtime optimization: process the left child is a separate thread
 
@@ -441,14 +506,20 @@ func update(
 		// channel is faster and uses fewer allocs/op in this case.
 		results := make(chan updateResult, 1)
 		go func(retChan chan<- updateResult) {
-			child, regCountDelta, regSizeDelta, lowestHeightTouched := update(nodeHeight-1, lchildParent, lpaths, lpayloads, lcompactLeaf, prune)
-			retChan <- updateResult{child, regCountDelta, regSizeDelta, lowestHeightTouched}
+			child, regCountDelta, regSizeDelta, lowestHeightTouched, err := update(nodeHeight-1, lchildParent, lpaths, lpayloads, lcompactLeaf, prune)
+			retChan <- updateResult{child, regCountDelta, regSizeDelta, lowestHeightTouched, err}
 		}(results)
 
-		rChild, rRegCountDelta, rRegSizeDelta, rLowestHeightTouched = update(nodeHeight-1, rchildParent, rpaths, rpayloads, rcompactLeaf, prune)
+		rChild, rRegCountDelta, rRegSizeDelta, rLowestHeightTouched, err = update(nodeHeight-1, rchildParent, rpaths, rpayloads, rcompactLeaf, prune)
 
 		// Wait for results from goroutine.
 		ret := <-results
+		if ret.err != nil {
+			return nil, 0, 0, 0, ret.err
+		}
+		if err != nil {
+			return nil, 0, 0, 0, err
+		}
 		lChild, lRegCountDelta, lRegSizeDelta, lLowestHeightTouched = ret.child, ret.allocatedRegCountDelta, ret.allocatedRegSizeDelta, ret.lowestHeightTouched
 	}
 
@@ -461,18 +532,21 @@ func update(
 	// unchanged. This is only sufficient for interim nodes (for leaf nodes, the children
 	// might be unchanged, i.e. both nil, but the payload could have changed).
 	if !parentNode.IsLeaf() && lChild == lchildParent && rChild == rchildParent {
-		return parentNode, 0, 0, lowestHeightTouched
+		return parentNode, 0, 0, lowestHeightTouched, nil
 	}
 
 	// In case the parent node was a leaf, we _cannot reuse_ it, because we potentially
 	// updated registers in the sub-trie
 	if prune {
-		n = node.NewInterimCompactifiedNode(nodeHeight, lChild, rChild)
-		return n, allocatedRegCountDelta, allocatedRegSizeDelta, lowestHeightTouched
+		n, err = node.NewInterimCompactifiedNode(nodeHeight, lChild, rChild)
+		if err != nil {
+			return nil, 0, 0, 0, err
+		}
+		return n, allocatedRegCountDelta, allocatedRegSizeDelta, lowestHeightTouched, nil
 	}
 
 	n = node.NewInterimNode(nodeHeight, lChild, rChild)
-	return n, allocatedRegCountDelta, allocatedRegSizeDelta, lowestHeightTouched
+	return n, allocatedRegCountDelta, allocatedRegSizeDelta, lowestHeightTouched, nil
 }
 
 // computeAllocatedRegDeltasFromHigherHeight returns the deltas
@@ -515,10 +589,14 @@ func computeAllocatedRegDeltas(oldPayload, newPayload *ledger.Payload) (allocate
 // UNSAFE: requires _all_ paths to have a length of mt.Height bits.
 // Paths in the input query don't have to be deduplicated, though deduplication would
 // result in allocating less dynamic memory to store the proofs.
-func (mt *MTrie) UnsafeProofs(paths []ledger.Path) *ledger.TrieBatchProof {
+// An error is returned if offloaded nodes can't be loaded from the node storage.
+func (mt *MTrie) UnsafeProofs(paths []ledger.Path) (*ledger.TrieBatchProof, error) {
 	batchProofs := ledger.NewTrieBatchProofWithEmptyProofs(len(paths))
-	prove(mt.root, paths, batchProofs.Proofs)
-	return batchProofs
+	err := prove(mt.root, paths, batchProofs.Proofs)
+	if err != nil {
+		return nil, err
+	}
+	return batchProofs, nil
 }
 
 // prove traverses the subtree and stores proofs for the given register paths in
@@ -527,17 +605,17 @@ func (mt *MTrie) UnsafeProofs(paths []ledger.Path) *ledger.TrieBatchProof {
 // UNSAFE: method requires the following conditions to be satisfied:
 //   * paths all share the same common prefix [0 : mt.maxHeight-1 - nodeHeight)
 //     (excluding the bit at index headHeight)
-func prove(head *node.Node, paths []ledger.Path, proofs []*ledger.TrieProof) {
+func prove(head *node.Node, paths []ledger.Path, proofs []*ledger.TrieProof) error {
 	// check for empty paths
 	if len(paths) == 0 {
-		return
+		return nil
 	}
 
 	// we've reached the end of a trie
 	// and path is not found (noninclusion proof)
 	if head == nil {
 		// by default, proofs are non-inclusion proofs
-		return
+		return nil
 	}
 
 	// we've reached a leaf
@@ -545,13 +623,22 @@ func prove(head *node.Node, paths []ledger.Path, proofs []*ledger.TrieProof) {
 		for i, path := range paths {
 			// value matches (inclusion proof)
 			if *head.Path() == path {
+				payload, err := head.LoadPayload()
+				if err != nil {
+					return err
+				}
 				proofs[i].Path = *head.Path()
-				proofs[i].Payload = head.Payload()
+				proofs[i].Payload = payload
 				proofs[i].Inclusion = true
 			}
 		}
 		// by default, proofs are non-inclusion proofs
-		return
+		return nil
+	}
+
+	lChild, rChild, err := head.LoadChildren()
+	if err != nil {
+		return err
 	}
 
 	// increment steps for all the proofs
@@ -570,24 +657,32 @@ func prove(head *node.Node, paths []ledger.Path, proofs []*ledger.TrieProof) {
 	parallelRecursionThreshold := 64 // threshold to avoid the parallelization going too deep in the recursion
 	if len(lpaths) < parallelRecursionThreshold || len(rpaths) < parallelRecursionThreshold {
 		// runtime optimization: below the parallelRecursionThreshold, we proceed single-threaded
-		addSiblingTrieHashToProofs(head.RightChild(), depth, lproofs)
-		prove(head.LeftChild(), lpaths, lproofs)
+		addSiblingTrieHashToProofs(rChild, depth, lproofs)
+		err = prove(lChild, lpaths, lproofs)
+		if err != nil {
+			return err
+		}
 
-		addSiblingTrieHashToProofs(head.LeftChild(), depth, rproofs)
-		prove(head.RightChild(), rpaths, rproofs)
-	} else {
-		wg := sync.WaitGroup{}
-		wg.Add(1)
-		go func() {
-			addSiblingTrieHashToProofs(head.RightChild(), depth, lproofs)
-			prove(head.LeftChild(), lpaths, lproofs)
-			wg.Done()
-		}()
-
-		addSiblingTrieHashToProofs(head.LeftChild(), depth, rproofs)
-		prove(head.RightChild(), rpaths, rproofs)
-		wg.Wait()
+		addSiblingTrieHashToProofs(lChild, depth, rproofs)
+		return prove(rChild, rpaths, rproofs)
 	}
+
+	var lErr error
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		addSiblingTrieHashToProofs(rChild, depth, lproofs)
+		lErr = prove(lChild, lpaths, lproofs)
+		wg.Done()
+	}()
+
+	addSiblingTrieHashToProofs(lChild, depth, rproofs)
+	err = prove(rChild, rpaths, rproofs)
+	wg.Wait()
+	if lErr != nil {
+		return lErr
+	}
+	return err
 }
 
 // addSiblingTrieHashToProofs inspects the sibling Trie and adds its root hash
@@ -648,7 +743,11 @@ func (mt *MTrie) DumpAsJSON(w io.Writer) error {
 func dumpAsJSON(n *node.Node, encoder *json.Encoder) error {
 	if n.IsLeaf() {
 		if n != nil {
-			err := encoder.Encode(n.Payload())
+			payload, err := n.LoadPayload()
+			if err != nil {
+				return err
+			}
+			err = encoder.Encode(payload)
 			if err != nil {
 				return err
 			}
@@ -656,14 +755,19 @@ func dumpAsJSON(n *node.Node, encoder *json.Encoder) error {
 		return nil
 	}
 
-	if lChild := n.LeftChild(); lChild != nil {
+	lChild, rChild, err := n.LoadChildren()
+	if err != nil {
+		return err
+	}
+
+	if lChild != nil {
 		err := dumpAsJSON(lChild, encoder)
 		if err != nil {
 			return err
 		}
 	}
 
-	if rChild := n.RightChild(); rChild != nil {
+	if rChild != nil {
 		err := dumpAsJSON(rChild, encoder)
 		if err != nil {
 			return err
@@ -678,11 +782,12 @@ func EmptyTrieRootHash() ledger.RootHash {
 }
 
 // AllPayloads returns all payloads
-func (mt *MTrie) AllPayloads() []ledger.Payload {
+func (mt *MTrie) AllPayloads() ([]ledger.Payload, error) {
 	return mt.root.AllPayloads()
 }
 
 // IsAValidTrie verifies the content of the trie for potential issues
+// Tries whose offloaded nodes can't be loaded from the node storage are not valid.
 func (mt *MTrie) IsAValidTrie() bool {
 	// TODO add checks on the health of node max height ...
 	return mt.root.VerifyCachedHash()
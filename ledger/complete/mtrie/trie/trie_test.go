@@ -701,13 +701,15 @@ func Test_Pruning(t *testing.T) {
 				queryPaths = append(queryPaths, path)
 			}
 
-			payloads := activeTrie.UnsafeRead(queryPaths)
+			payloads, err := activeTrie.UnsafeRead(queryPaths)
+			require.NoError(t, err)
 			for i, pp := range payloads {
 				expectedPayload := allPaths[queryPaths[i]]
 				require.True(t, pp.Equals(&expectedPayload))
 			}
 
-			payloads = activeTrieWithPruning.UnsafeRead(queryPaths)
+			payloads, err = activeTrieWithPruning.UnsafeRead(queryPaths)
+			require.NoError(t, err)
 			for i, pp := range payloads {
 				expectedPayload := allPaths[queryPaths[i]]
 				require.True(t, pp.Equals(&expectedPayload))
@@ -730,7 +732,8 @@ func TestValueSizes(t *testing.T) {
 	t.Run("empty trie", func(t *testing.T) {
 		path := utils.PathByUint16LeftPadded(0)
 		pathsToGetValueSize := []ledger.Path{path}
-		sizes := emptyTrie.UnsafeValueSizes(pathsToGetValueSize)
+		sizes, err := emptyTrie.UnsafeValueSizes(pathsToGetValueSize)
+		require.NoError(t, err)
 		require.Equal(t, len(pathsToGetValueSize), len(sizes))
 		require.Equal(t, 0, sizes[0])
 	})
@@ -752,7 +755,8 @@ func TestValueSizes(t *testing.T) {
 
 		pathsToGetValueSize := []ledger.Path{path1, path2}
 
-		sizes := newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		sizes, err := newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		require.NoError(t, err)
 		require.Equal(t, len(pathsToGetValueSize), len(sizes))
 		require.Equal(t, payload1.Value.Size(), sizes[0])
 		require.Equal(t, 0, sizes[1])
@@ -794,7 +798,8 @@ func TestValueSizes(t *testing.T) {
 		}
 
 		// Test value sizes for a mix of existent and non-existent paths.
-		sizes := newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		sizes, err := newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		require.NoError(t, err)
 		require.Equal(t, len(pathsToGetValueSize), len(sizes))
 		for i, p := range pathsToGetValueSize {
 			switch p {
@@ -810,13 +815,15 @@ func TestValueSizes(t *testing.T) {
 
 		// Test value size for a single existent path
 		pathsToGetValueSize = []ledger.Path{path1}
-		sizes = newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		sizes, err = newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		require.NoError(t, err)
 		require.Equal(t, len(pathsToGetValueSize), len(sizes))
 		require.Equal(t, payload1.Value.Size(), sizes[0])
 
 		// Test value size for a single non-existent path
 		pathsToGetValueSize = []ledger.Path{utils.PathByUint16(3 << 12)}
-		sizes = newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		sizes, err = newTrie.UnsafeValueSizes(pathsToGetValueSize)
+		require.NoError(t, err)
 		require.Equal(t, len(pathsToGetValueSize), len(sizes))
 		require.Equal(t, 0, sizes[0])
 	})
@@ -845,7 +852,8 @@ func TestValueSizesWithDuplicatePaths(t *testing.T) {
 		path1, path2, path3,
 	}
 
-	sizes := newTrie.UnsafeValueSizes(pathsToGetValueSize)
+	sizes, err := newTrie.UnsafeValueSizes(pathsToGetValueSize)
+	require.NoError(t, err)
 	require.Equal(t, len(pathsToGetValueSize), len(sizes))
 	for i, p := range pathsToGetValueSize {
 		switch p {
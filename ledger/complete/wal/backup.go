@@ -0,0 +1,92 @@
+package wal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	prometheusWAL "github.com/m4ksio/wal/wal"
+
+	"github.com/onflow/flow-go/model/bootstrap"
+	utilsio "github.com/onflow/flow-go/utils/io"
+)
+
+// backupOpenAttempts is the number of times OpenBackupFiles lists the files
+// again if a checkpoint was removed by the compactor while opening the files.
+const backupOpenAttempts = 3
+
+// OpenBackupFiles starts a new segment, so that all records written so far are
+// in complete segments, and opens the files needed to restore the execution
+// state as of now: the latest checkpoint (or the root checkpoint if there is
+// none) and the complete segments after it. The segment of the latest checkpoint
+// is included, so that the checkpoint is found when replaying the segments.
+//
+// The files are copied as they are, so they remain encrypted if the WAL is
+// encrypted. The caller is responsible for closing the files.
+func (w *DiskWAL) OpenBackupFiles() ([]*os.File, error) {
+	err := w.wal.NextSegment()
+	if err != nil {
+		return nil, fmt.Errorf("cannot start new segment: %w", err)
+	}
+
+	// the compactor can remove the latest checkpoint after a new checkpoint was
+	// created, in which case the new checkpoint is used
+	for attempt := 1; ; attempt++ {
+		files, err := openBackupFiles(w.dir, w.encryption)
+		if errors.Is(err, os.ErrNotExist) && attempt < backupOpenAttempts {
+			continue
+		}
+		return files, err
+	}
+}
+
+func openBackupFiles(dir string, encryption *Encryption) ([]*os.File, error) {
+	first, last, err := prometheusWAL.Segments(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list segments: %w", err)
+	}
+	// the last segment is the one currently written to
+	if first < 0 || last <= first {
+		return nil, fmt.Errorf("no complete segments in %s", dir)
+	}
+
+	_, latestCheckpoint, err := listCheckpoints(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list checkpoints: %w", err)
+	}
+
+	var filenames []string
+	startSegment := first
+	if latestCheckpoint >= 0 {
+		// incremental checkpoints are restored on top of their base checkpoints
+		chain, err := checkpointChain(dir, latestCheckpoint, encryption)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get chain of checkpoint %d: %w", latestCheckpoint, err)
+		}
+		for _, checkpoint := range chain {
+			filenames = append(filenames, NumberToFilename(checkpoint))
+		}
+		if latestCheckpoint > startSegment {
+			startSegment = latestCheckpoint
+		}
+	} else if utilsio.FileExists(path.Join(dir, bootstrap.FilenameWALRootCheckpoint)) {
+		filenames = append(filenames, bootstrap.FilenameWALRootCheckpoint)
+	}
+	for i := startSegment; i < last; i++ {
+		filenames = append(filenames, path.Base(prometheusWAL.SegmentName(dir, i)))
+	}
+
+	files := make([]*os.File, 0, len(filenames))
+	for _, filename := range filenames {
+		file, err := os.Open(path.Join(dir, filename))
+		if err != nil {
+			for _, f := range files {
+				_ = f.Close()
+			}
+			return nil, fmt.Errorf("cannot open %s: %w", filename, err)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
@@ -46,6 +46,11 @@ const VersionV4 uint16 = 0x04
 // See EncodeNode() and EncodeTrie() for more details.
 const VersionV5 uint16 = 0x05
 
+// Version 6 is an incremental checkpoint, with the encoding of version 5: it only contains
+// the nodes which aren't in its base checkpoint, and references the nodes of its base
+// checkpoint by their index and hash. See storeIncrementalCheckpoint() for more details.
+const VersionV6 uint16 = 0x06
+
 const (
 	encMagicSize     = 2
 	encVersionSize   = 2
@@ -83,12 +88,18 @@ func NewCheckpointer(wal *DiskWAL, keyByteSize int, forestCapacity int) *Checkpo
 
 // listCheckpoints returns all the numbers (unsorted) of the checkpoint files, and the number of the last checkpoint.
 func (c *Checkpointer) listCheckpoints() ([]int, int, error) {
+	return listCheckpoints(c.dir)
+}
+
+// listCheckpoints returns all the numbers (unsorted) of the checkpoint files in
+// the given directory, and the number of the last checkpoint.
+func listCheckpoints(dir string) ([]int, int, error) {
 
 	list := make([]int, 0)
 
-	files, err := os.ReadDir(c.dir)
+	files, err := os.ReadDir(dir)
 	if err != nil {
-		return nil, -1, fmt.Errorf("cannot list directory [%s] content: %w", c.dir, err)
+		return nil, -1, fmt.Errorf("cannot list directory [%s] content: %w", dir, err)
 	}
 	last := -1
 	for _, fn := range files {
@@ -246,12 +257,21 @@ func NumberToFilename(n int) string {
 	return fmt.Sprintf("%s%s", checkpointFilenamePrefix, NumberToFilenamePart(n))
 }
 
+// CheckpointWriter returns a writer for the checkpoint file with the given number.
+// If the WAL is encrypted, the checkpoint is encrypted as well.
 func (c *Checkpointer) CheckpointWriter(to int) (io.WriteCloser, error) {
-	return CreateCheckpointWriterForFile(c.dir, NumberToFilename(to), &c.wal.log)
+	return CreateEncryptedCheckpointWriterForFile(c.dir, NumberToFilename(to), &c.wal.log, c.wal.encryption)
 }
 
 // CreateCheckpointWriterForFile returns a file writer that will write to a temporary file and then move it to the checkpoint folder by renaming it.
 func CreateCheckpointWriterForFile(dir, filename string, logger *zerolog.Logger) (io.WriteCloser, error) {
+	return CreateEncryptedCheckpointWriterForFile(dir, filename, logger, nil)
+}
+
+// CreateEncryptedCheckpointWriterForFile is like CreateCheckpointWriterForFile, but
+// encrypts the checkpoint file with the given encryption. If encryption is nil,
+// the checkpoint file is not encrypted.
+func CreateEncryptedCheckpointWriterForFile(dir, filename string, logger *zerolog.Logger, encryption *Encryption) (io.WriteCloser, error) {
 
 	fullname := path.Join(dir, filename)
 
@@ -264,11 +284,25 @@ func CreateCheckpointWriterForFile(dir, filename string, logger *zerolog.Logger)
 		return nil, fmt.Errorf("cannot create temporary file for checkpoint %v: %w", tmpFile, err)
 	}
 
-	writer := bufio.NewWriterSize(tmpFile, defaultBufioWriteSize)
+	var target io.Writer = tmpFile
+	var encrypted io.Closer
+	if encryption != nil {
+		encryptedFile, err := encryption.NewFileWriter(tmpFile)
+		if err != nil {
+			_ = tmpFile.Close()
+			_ = os.Remove(tmpFile.Name())
+			return nil, fmt.Errorf("cannot create encrypted checkpoint writer: %w", err)
+		}
+		target = encryptedFile
+		encrypted = encryptedFile
+	}
+
+	writer := bufio.NewWriterSize(target, defaultBufioWriteSize)
 	return &SyncOnCloseRenameFile{
 		logger:     logger,
 		file:       tmpFile,
 		targetName: fullname,
+		encrypted:  encrypted,
 		Writer:     writer,
 	}, nil
 }
@@ -319,7 +353,8 @@ func StoreCheckpoint(writer io.Writer, tries ...*trie.MTrie) error {
 	for _, t := range tries {
 
 		// Traverse all unique nodes for trie t.
-		for itr := flattener.NewUniqueNodeIterator(t, allNodes); itr.Next(); {
+		itr := flattener.NewUniqueNodeIterator(t, allNodes)
+		for itr.Next() {
 			n := itr.Value()
 
 			allNodes[n] = nodeCounter
@@ -327,7 +362,8 @@ func StoreCheckpoint(writer io.Writer, tries ...*trie.MTrie) error {
 
 			var lchildIndex, rchildIndex uint64
 
-			if lchild := n.LeftChild(); lchild != nil {
+			lchild, rchild := itr.Children()
+			if lchild != nil {
 				var found bool
 				lchildIndex, found = allNodes[lchild]
 				if !found {
@@ -335,7 +371,7 @@ func StoreCheckpoint(writer io.Writer, tries ...*trie.MTrie) error {
 					return fmt.Errorf("internal error: missing node with hash %s", hex.EncodeToString(hash[:]))
 				}
 			}
-			if rchild := n.RightChild(); rchild != nil {
+			if rchild != nil {
 				var found bool
 				rchildIndex, found = allNodes[rchild]
 				if !found {
@@ -344,12 +380,18 @@ func StoreCheckpoint(writer io.Writer, tries ...*trie.MTrie) error {
 				}
 			}
 
-			encNode := flattener.EncodeNode(n, lchildIndex, rchildIndex, scratch)
+			encNode, err := flattener.EncodeNode(n, lchildIndex, rchildIndex, scratch)
+			if err != nil {
+				return fmt.Errorf("cannot encode node: %w", err)
+			}
 			_, err = crc32Writer.Write(encNode)
 			if err != nil {
 				return fmt.Errorf("cannot serialize node: %w", err)
 			}
 		}
+		if err := itr.Err(); err != nil {
+			return fmt.Errorf("cannot traverse trie: %w", err)
+		}
 	}
 
 	// Serialize trie root nodes
@@ -394,12 +436,12 @@ func StoreCheckpoint(writer io.Writer, tries ...*trie.MTrie) error {
 
 func (c *Checkpointer) LoadCheckpoint(checkpoint int) ([]*trie.MTrie, error) {
 	filepath := path.Join(c.dir, NumberToFilename(checkpoint))
-	return LoadCheckpoint(filepath, &c.wal.log)
+	return LoadEncryptedCheckpoint(filepath, &c.wal.log, c.wal.encryption)
 }
 
 func (c *Checkpointer) LoadRootCheckpoint() ([]*trie.MTrie, error) {
 	filepath := path.Join(c.dir, bootstrap.FilenameWALRootCheckpoint)
-	return LoadCheckpoint(filepath, &c.wal.log)
+	return LoadEncryptedCheckpoint(filepath, &c.wal.log, c.wal.encryption)
 }
 
 func (c *Checkpointer) HasRootCheckpoint() (bool, error) {
@@ -417,6 +459,23 @@ func (c *Checkpointer) RemoveCheckpoint(checkpoint int) error {
 }
 
 func LoadCheckpoint(filepath string, logger *zerolog.Logger) ([]*trie.MTrie, error) {
+	return LoadEncryptedCheckpoint(filepath, logger, nil)
+}
+
+// LoadEncryptedCheckpoint loads a checkpoint file which is either plaintext or
+// encrypted with one of the keys of the given encryption, which can be nil.
+// Incremental checkpoints are loaded on top of their base checkpoints, which must
+// be in the same directory.
+func LoadEncryptedCheckpoint(filepath string, logger *zerolog.Logger, encryption *Encryption) ([]*trie.MTrie, error) {
+	version, _, err := readCheckpointHeader(filepath, encryption)
+	if err != nil {
+		return nil, err
+	}
+	if version == VersionV6 {
+		_, tries, err := loadCheckpointChain(path.Dir(filepath), path.Base(filepath), logger, encryption)
+		return tries, err
+	}
+
 	file, err := os.Open(filepath)
 	if err != nil {
 		return nil, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
@@ -427,10 +486,41 @@ func LoadCheckpoint(filepath string, logger *zerolog.Logger) ([]*trie.MTrie, err
 		_ = requestDropFromOSFileCache(filepath, logger)
 	}()
 
-	return readCheckpoint(file)
+	reader, err := OpenCheckpointFile(file, encryption)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+
+	return readCheckpoint(reader)
 }
 
-func readCheckpoint(f *os.File) ([]*trie.MTrie, error) {
+// OpenCheckpointFile returns a reader for the plaintext of the given checkpoint
+// file, decrypting it if it is encrypted. Plaintext checkpoint files are
+// rejected with ErrPlaintext if an encryption is given.
+func OpenCheckpointFile(f io.ReadSeeker, encryption *Encryption) (io.ReadSeeker, error) {
+	return openCheckpointFile(f, encryption, false)
+}
+
+// openCheckpointFile is OpenCheckpointFile, which accepts plaintext checkpoint
+// files with an encryption if allowPlaintext is true.
+func openCheckpointFile(f io.ReadSeeker, encryption *Encryption, allowPlaintext bool) (io.ReadSeeker, error) {
+	encrypted, err := IsEncryptedFile(f)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		if encryption != nil && !allowPlaintext {
+			return nil, fmt.Errorf("cannot read plaintext checkpoint with encryption key: %w", ErrPlaintext)
+		}
+		return f, nil
+	}
+	if encryption == nil {
+		return nil, fmt.Errorf("cannot read encrypted checkpoint without key: %w", ErrEncrypted)
+	}
+	return encryption.NewFileReader(f)
+}
+
+func readCheckpoint(f io.ReadSeeker) ([]*trie.MTrie, error) {
 
 	// Read header: magic (2 bytes) + version (2 bytes)
 	header := make([]byte, headerSize)
@@ -460,6 +550,8 @@ func readCheckpoint(f *os.File) ([]*trie.MTrie, error) {
 		return readCheckpointV4(f)
 	case VersionV5:
 		return readCheckpointV5(f)
+	case VersionV6:
+		return nil, fmt.Errorf("incremental checkpoint must be loaded on top of its base checkpoint")
 	default:
 		return nil, fmt.Errorf("unsupported file version %x", version)
 	}
@@ -474,7 +566,7 @@ type nodeWithRegMetrics struct {
 // readCheckpointV3AndEarlier deserializes checkpoint file (version 3 and earlier) and returns a list of tries.
 // Header (magic and version) is verified by the caller.
 // This function is for backwards compatibility, not optimized.
-func readCheckpointV3AndEarlier(f *os.File, version uint16) ([]*trie.MTrie, error) {
+func readCheckpointV3AndEarlier(f io.ReadSeeker, version uint16) ([]*trie.MTrie, error) {
 
 	var bufReader io.Reader = bufio.NewReaderSize(f, defaultBufioReadSize)
 	crcReader := NewCRC32Reader(bufReader)
@@ -557,7 +649,7 @@ func readCheckpointV3AndEarlier(f *os.File, version uint16) ([]*trie.MTrie, erro
 // readCheckpointV4 decodes checkpoint file (version 4) and returns a list of tries.
 // Header (magic and version) is verified by the caller.
 // This function is for backwards compatibility.
-func readCheckpointV4(f *os.File) ([]*trie.MTrie, error) {
+func readCheckpointV4(f io.ReadSeeker) ([]*trie.MTrie, error) {
 
 	// Scratch buffer is used as temporary buffer that reader can read into.
 	// Raw data in scratch buffer should be copied or converted into desired
@@ -668,7 +760,15 @@ func readCheckpointV4(f *os.File) ([]*trie.MTrie, error) {
 
 // readCheckpointV5 decodes checkpoint file (version 5) and returns a list of tries.
 // Checkpoint file header (magic and version) are verified by the caller.
-func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
+func readCheckpointV5(f io.ReadSeeker) ([]*trie.MTrie, error) {
+	_, tries, err := readCheckpointV5WithNodes(f)
+	return tries, err
+}
+
+// readCheckpointV5WithNodes decodes checkpoint file (version 5) and returns all its nodes,
+// by index (index 0 is nil), and the list of tries.
+// Checkpoint file header (magic and version) are verified by the caller.
+func readCheckpointV5WithNodes(f io.ReadSeeker) ([]*node.Node, []*trie.MTrie, error) {
 
 	// Scratch buffer is used as temporary buffer that reader can read into.
 	// Raw data in scratch buffer should be copied or converted into desired
@@ -686,14 +786,14 @@ func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
 	// Seek to footer
 	_, err := f.Seek(-footerOffset, io.SeekEnd)
 	if err != nil {
-		return nil, fmt.Errorf("cannot seek to footer: %w", err)
+		return nil, nil, fmt.Errorf("cannot seek to footer: %w", err)
 	}
 
 	footer := scratch[:footerSize]
 
 	_, err = io.ReadFull(f, footer)
 	if err != nil {
-		return nil, fmt.Errorf("cannot read footer: %w", err)
+		return nil, nil, fmt.Errorf("cannot read footer: %w", err)
 	}
 
 	// Decode node count and trie count
@@ -703,7 +803,7 @@ func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
 	// Seek to the start of file
 	_, err = f.Seek(0, io.SeekStart)
 	if err != nil {
-		return nil, fmt.Errorf("cannot seek to start of file: %w", err)
+		return nil, nil, fmt.Errorf("cannot seek to start of file: %w", err)
 	}
 
 	var bufReader io.Reader = bufio.NewReaderSize(f, defaultBufioReadSize)
@@ -715,7 +815,7 @@ func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
 
 	_, err = io.ReadFull(reader, scratch[:headerSize])
 	if err != nil {
-		return nil, fmt.Errorf("cannot read header: %w", err)
+		return nil, nil, fmt.Errorf("cannot read header: %w", err)
 	}
 
 	// nodes's element at index 0 is a special, meaning nil .
@@ -730,7 +830,7 @@ func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
 			return nodes[nodeIndex], nil
 		})
 		if err != nil {
-			return nil, fmt.Errorf("cannot read node %d: %w", i, err)
+			return nil, nil, fmt.Errorf("cannot read node %d: %w", i, err)
 		}
 		nodes[i] = n
 	}
@@ -743,7 +843,7 @@ func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
 			return nodes[nodeIndex], nil
 		})
 		if err != nil {
-			return nil, fmt.Errorf("cannot read trie %d: %w", i, err)
+			return nil, nil, fmt.Errorf("cannot read trie %d: %w", i, err)
 		}
 		tries[i] = trie
 	}
@@ -752,14 +852,14 @@ func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
 	// No action is needed.
 	_, err = io.ReadFull(reader, footer)
 	if err != nil {
-		return nil, fmt.Errorf("cannot read footer: %w", err)
+		return nil, nil, fmt.Errorf("cannot read footer: %w", err)
 	}
 
 	// Read CRC32
 	crc32buf := scratch[:crc32SumSize]
 	_, err = io.ReadFull(bufReader, crc32buf)
 	if err != nil {
-		return nil, fmt.Errorf("cannot read CRC32: %w", err)
+		return nil, nil, fmt.Errorf("cannot read CRC32: %w", err)
 	}
 
 	readCrc32 := binary.BigEndian.Uint32(crc32buf)
@@ -767,10 +867,176 @@ func readCheckpointV5(f *os.File) ([]*trie.MTrie, error) {
 	calculatedCrc32 := crcReader.Crc32()
 
 	if calculatedCrc32 != readCrc32 {
-		return nil, fmt.Errorf("checkpoint checksum failed! File contains %x but calculated crc32 is %x", readCrc32, calculatedCrc32)
+		return nil, nil, fmt.Errorf("checkpoint checksum failed! File contains %x but calculated crc32 is %x", readCrc32, calculatedCrc32)
 	}
 
-	return tries, nil
+	return nodes, tries, nil
+}
+
+// ReadCheckpointPayloads streams the allocated registers of a checkpoint file holding a single
+// trie (such as a root checkpoint) to fn, without holding the trie in memory, and returns the
+// root hash of the trie. The file is either plaintext or encrypted with one of the keys of the
+// given encryption, which can be nil. Only checkpoint files of the current version are supported.
+// The checksum of the file is verified before any payload is streamed, so that fn only receives
+// payloads of intact files. Reading stops at the first error returned by fn.
+func ReadCheckpointPayloads(filepath string, logger *zerolog.Logger, encryption *Encryption, fn func(payload *ledger.Payload) error) (ledger.RootHash, error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+	defer func() {
+		_ = file.Close()
+
+		_ = requestDropFromOSFileCache(filepath, logger)
+	}()
+
+	f, err := OpenCheckpointFile(file, encryption)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+
+	scratch := make([]byte, 1024*4) // must not be less than 1024
+
+	// Read header: magic (2 bytes) + version (2 bytes)
+	header := scratch[:headerSize]
+	_, err = io.ReadFull(f, header)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot read header: %w", err)
+	}
+	magicBytes := binary.BigEndian.Uint16(header)
+	version := binary.BigEndian.Uint16(header[encMagicSize:])
+	if magicBytes != MagicBytes {
+		return ledger.RootHash{}, fmt.Errorf("unknown file format. Magic constant %x does not match expected %x", magicBytes, MagicBytes)
+	}
+	if version != VersionV5 {
+		return ledger.RootHash{}, fmt.Errorf("unsupported file version %x, only version %x can be streamed", version, VersionV5)
+	}
+
+	// Read footer to get node count and trie count
+	const footerOffset = encNodeCountSize + encTrieCountSize + crc32SumSize
+	const footerSize = encNodeCountSize + encTrieCountSize // footer doesn't include crc32 sum
+
+	_, err = f.Seek(-footerOffset, io.SeekEnd)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot seek to footer: %w", err)
+	}
+	footer := scratch[:footerSize]
+	_, err = io.ReadFull(f, footer)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot read footer: %w", err)
+	}
+	nodesCount := binary.BigEndian.Uint64(footer)
+	triesCount := binary.BigEndian.Uint16(footer[encNodeCountSize:])
+	if triesCount != 1 {
+		return ledger.RootHash{}, fmt.Errorf("checkpoint holds %d tries, only checkpoints with a single trie can be streamed", triesCount)
+	}
+
+	// the file is read twice, to verify the checksum before streaming any payload
+	err = verifyCheckpointChecksum(f, scratch)
+	if err != nil {
+		return ledger.RootHash{}, err
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot seek to start of file: %w", err)
+	}
+
+	var bufReader io.Reader = bufio.NewReaderSize(f, defaultBufioReadSize)
+	crcReader := NewCRC32Reader(bufReader)
+	var reader io.Reader = crcReader
+
+	_, err = io.ReadFull(reader, scratch[:headerSize])
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot read header: %w", err)
+	}
+
+	// Nodes are not linked to their children: only the last node, which is the root of
+	// the single trie, is kept to verify the root hash of the trie.
+	var last *node.Node
+	for i := uint64(1); i <= nodesCount; i++ {
+		n, err := flattener.ReadNode(reader, scratch, func(nodeIndex uint64) (*node.Node, error) {
+			if nodeIndex >= i {
+				return nil, fmt.Errorf("sequence of serialized nodes does not satisfy Descendents-First-Relationship")
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return ledger.RootHash{}, fmt.Errorf("cannot read node %d: %w", i, err)
+		}
+
+		// only leaves are decoded with a payload
+		if payload := n.Payload(); payload != nil && !payload.IsEmpty() {
+			err = fn(payload)
+			if err != nil {
+				return ledger.RootHash{}, err
+			}
+		}
+		last = n
+	}
+
+	t, err := flattener.ReadTrie(reader, scratch, func(nodeIndex uint64) (*node.Node, error) {
+		if nodeIndex != nodesCount {
+			return nil, fmt.Errorf("root of the trie is not the last node")
+		}
+		return last, nil
+	})
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot read trie: %w", err)
+	}
+
+	// Read footer again for crc32 computation
+	_, err = io.ReadFull(reader, footer)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot read footer: %w", err)
+	}
+
+	crc32buf := scratch[:crc32SumSize]
+	_, err = io.ReadFull(bufReader, crc32buf)
+	if err != nil {
+		return ledger.RootHash{}, fmt.Errorf("cannot read CRC32: %w", err)
+	}
+	readCrc32 := binary.BigEndian.Uint32(crc32buf)
+	calculatedCrc32 := crcReader.Crc32()
+	if calculatedCrc32 != readCrc32 {
+		return ledger.RootHash{}, fmt.Errorf("checkpoint checksum failed! File contains %x but calculated crc32 is %x", readCrc32, calculatedCrc32)
+	}
+
+	return t.RootHash(), nil
+}
+
+// verifyCheckpointChecksum verifies the crc32 sum at the end of the checkpoint file.
+func verifyCheckpointChecksum(f io.ReadSeeker, scratch []byte) error {
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("cannot seek to end of file: %w", err)
+	}
+	if size < crc32SumSize {
+		return fmt.Errorf("checkpoint file too short: %d bytes", size)
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("cannot seek to start of file: %w", err)
+	}
+
+	bufReader := bufio.NewReaderSize(f, defaultBufioReadSize)
+	crcReader := NewCRC32Reader(bufReader)
+	_, err = io.CopyN(io.Discard, crcReader, size-crc32SumSize)
+	if err != nil {
+		return fmt.Errorf("cannot read checkpoint file: %w", err)
+	}
+
+	crc32buf := scratch[:crc32SumSize]
+	_, err = io.ReadFull(bufReader, crc32buf)
+	if err != nil {
+		return fmt.Errorf("cannot read CRC32: %w", err)
+	}
+	readCrc32 := binary.BigEndian.Uint32(crc32buf)
+	calculatedCrc32 := crcReader.Crc32()
+	if calculatedCrc32 != readCrc32 {
+		return fmt.Errorf("checkpoint checksum failed! File contains %x but calculated crc32 is %x", readCrc32, calculatedCrc32)
+	}
+	return nil
 }
 
 // requestDropFromOSFileCache requests the specified file be dropped from OS file cache.
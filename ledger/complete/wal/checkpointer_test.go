@@ -559,6 +559,224 @@ func Test_StoringLoadingCheckpoints(t *testing.T) {
 	})
 }
 
+func Test_IncrementalCheckpointing(t *testing.T) {
+
+	unittest.RunWithTempDir(t, func(dir string) {
+		fullDir := t.TempDir()
+
+		f, err := mtrie.NewForest(size*10, metricsCollector, nil)
+		require.NoError(t, err)
+
+		rootHash := f.GetEmptyRootHash()
+		rootHashes := make([]ledger.RootHash, 0, size)
+
+		wal, err := realWAL.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize)
+		require.NoError(t, err)
+
+		// WAL segments are 32kB, so here we generate 2 keys 64kB each, times `size`
+		// so we should get at least `size` segments
+		for i := 0; i < size; i++ {
+			keys := utils.RandomUniqueKeys(numInsPerStep, keyNumberOfParts, 1600, 1600)
+			values := utils.RandomValues(numInsPerStep, valueMaxByteSize/2, valueMaxByteSize)
+			update, err := ledger.NewUpdate(ledger.State(rootHash), keys, values)
+			require.NoError(t, err)
+
+			trieUpdate, err := pathfinder.UpdateToTrieUpdate(update, pathFinderVersion)
+			require.NoError(t, err)
+
+			err = wal.RecordUpdate(trieUpdate)
+			require.NoError(t, err)
+
+			rootHash, err = f.Update(trieUpdate)
+			require.NoError(t, err)
+			rootHashes = append(rootHashes, rootHash)
+		}
+		<-wal.Done()
+		require.FileExists(t, path.Join(dir, "00000010")) //make sure we have enough segments saved
+
+		wal2, err := realWAL.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize)
+		require.NoError(t, err)
+
+		checkpointer, err := wal2.NewCheckpointer()
+		require.NoError(t, err)
+
+		// the base checkpoint
+		err = checkpointer.Checkpoint(3, func() (io.WriteCloser, error) {
+			return checkpointer.CheckpointWriter(3)
+		})
+		require.NoError(t, err)
+
+		// full checkpoints to compare the incremental checkpoints with, on top of the base checkpoint
+		for _, to := range []int{6, 9} {
+			to := to
+			err = checkpointer.Checkpoint(to, func() (io.WriteCloser, error) {
+				return realWAL.CreateCheckpointWriterForFile(fullDir, realWAL.NumberToFilename(to), &logger)
+			})
+			require.NoError(t, err)
+		}
+
+		for _, to := range []int{6, 9} {
+			err = checkpointer.IncrementalCheckpoint(to, func() (io.WriteCloser, error) {
+				return checkpointer.CheckpointWriter(to)
+			})
+			require.NoError(t, err)
+		}
+
+		chain, err := checkpointer.CheckpointChain(9)
+		require.NoError(t, err)
+		require.Equal(t, []int{3, 6, 9}, chain)
+
+		t.Run("incremental checkpoints hold the tries of full checkpoints", func(t *testing.T) {
+			for _, checkpoint := range []int{6, 9} {
+				fullTries, err := realWAL.LoadCheckpoint(path.Join(fullDir, realWAL.NumberToFilename(checkpoint)), &logger)
+				require.NoError(t, err)
+
+				tries, err := checkpointer.LoadCheckpoint(checkpoint)
+				require.NoError(t, err)
+				require.Len(t, tries, len(fullTries))
+
+				byRootHash := make(map[ledger.RootHash]*trie.MTrie, len(tries))
+				for _, t := range tries {
+					byRootHash[t.RootHash()] = t
+				}
+				for _, full := range fullTries {
+					incremental, ok := byRootHash[full.RootHash()]
+					require.True(t, ok)
+					require.Equal(t, full.AllocatedRegCount(), incremental.AllocatedRegCount())
+					require.Equal(t, full.AllocatedRegSize(), incremental.AllocatedRegSize())
+					fullPayloads, err := full.AllPayloads()
+					require.NoError(t, err)
+					incrementalPayloads, err := incremental.AllPayloads()
+					require.NoError(t, err)
+					require.ElementsMatch(t, fullPayloads, incrementalPayloads)
+					require.True(t, incremental.IsAValidTrie())
+				}
+
+				// only the changed nodes are written
+				fullInfo, err := os.Stat(path.Join(fullDir, realWAL.NumberToFilename(checkpoint)))
+				require.NoError(t, err)
+				info, err := os.Stat(path.Join(dir, realWAL.NumberToFilename(checkpoint)))
+				require.NoError(t, err)
+				require.Less(t, info.Size(), fullInfo.Size())
+			}
+		})
+
+		t.Run("replay WAL from incremental checkpoint", func(t *testing.T) {
+			f2, err := mtrie.NewForest(size*10, metricsCollector, nil)
+			require.NoError(t, err)
+
+			err = wal2.Replay(
+				func(tries []*trie.MTrie) error {
+					return f2.AddTries(tries)
+				},
+				func(update *ledger.TrieUpdate) error {
+					_, err := f2.Update(update)
+					return err
+				},
+				func(rootHash ledger.RootHash) error {
+					return nil
+				},
+			)
+			require.NoError(t, err)
+
+			for _, rootHash := range rootHashes {
+				expected, err := f.GetTrie(rootHash)
+				require.NoError(t, err)
+				replayed, err := f2.GetTrie(rootHash)
+				require.NoError(t, err)
+				expectedPayloads, err := expected.AllPayloads()
+				require.NoError(t, err)
+				replayedPayloads, err := replayed.AllPayloads()
+				require.NoError(t, err)
+				require.ElementsMatch(t, expectedPayloads, replayedPayloads)
+			}
+		})
+
+		t.Run("incremental checkpoint can't be loaded without its base", func(t *testing.T) {
+			err = checkpointer.RemoveCheckpoint(3)
+			require.NoError(t, err)
+
+			_, err = checkpointer.LoadCheckpoint(9)
+			require.Error(t, err)
+		})
+
+		<-wal2.Done()
+	})
+}
+
+func Test_ReadCheckpointPayloads(t *testing.T) {
+
+	unittest.RunWithTempDir(t, func(dir string) {
+		paths := utils.RandomPaths(100)
+		payloads := utils.RandomPayloads(len(paths), 1, 100)
+		values := make([]ledger.Payload, len(payloads))
+		for i, payload := range payloads {
+			values[i] = *payload
+		}
+		updatedTrie, _, err := trie.NewTrieWithUpdatedRegisters(trie.NewEmptyMTrie(), paths, values, true)
+		require.NoError(t, err)
+
+		filepath := path.Join(dir, "checkpoint")
+		file, err := os.Create(filepath)
+		require.NoError(t, err)
+		err = realWAL.StoreCheckpoint(file, updatedTrie)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		t.Run("streams all registers", func(t *testing.T) {
+			var read []ledger.Payload
+			rootHash, err := realWAL.ReadCheckpointPayloads(filepath, &logger, nil, func(payload *ledger.Payload) error {
+				read = append(read, *payload)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, updatedTrie.RootHash(), rootHash)
+			expected, err := updatedTrie.AllPayloads()
+			require.NoError(t, err)
+			require.ElementsMatch(t, expected, read)
+		})
+
+		t.Run("stops on error", func(t *testing.T) {
+			expected := errors.New("expected")
+			_, err := realWAL.ReadCheckpointPayloads(filepath, &logger, nil, func(payload *ledger.Payload) error {
+				return expected
+			})
+			require.ErrorIs(t, err, expected)
+		})
+
+		t.Run("rejects corrupted checkpoints before streaming", func(t *testing.T) {
+			data, err := ioutil.ReadFile(filepath)
+			require.NoError(t, err)
+			// corrupt a byte of the first node, which is a leaf
+			data[10] ^= 0xFF
+			corrupted := path.Join(dir, "checkpoint-corrupted")
+			require.NoError(t, ioutil.WriteFile(corrupted, data, 0600))
+
+			called := false
+			_, err = realWAL.ReadCheckpointPayloads(corrupted, &logger, nil, func(payload *ledger.Payload) error {
+				called = true
+				return nil
+			})
+			require.Error(t, err)
+			require.False(t, called)
+		})
+
+		t.Run("rejects checkpoints with several tries", func(t *testing.T) {
+			filepath := path.Join(dir, "checkpoint-2")
+			file, err := os.Create(filepath)
+			require.NoError(t, err)
+			err = realWAL.StoreCheckpoint(file, trie.NewEmptyMTrie(), updatedTrie)
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+
+			_, err = realWAL.ReadCheckpointPayloads(filepath, &logger, nil, func(payload *ledger.Payload) error {
+				return nil
+			})
+			require.Error(t, err)
+		})
+	})
+}
+
 type writeCloserWithErrors struct {
 	writeError error
 	closeError error
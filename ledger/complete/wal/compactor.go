@@ -22,9 +22,18 @@ type Compactor struct {
 	interval           time.Duration
 	checkpointDistance uint
 	checkpointsToKeep  uint
+	maxIncrements      uint
 }
 
 func NewCompactor(checkpointer *Checkpointer, interval time.Duration, checkpointDistance uint, checkpointsToKeep uint, logger zerolog.Logger) *Compactor {
+	return NewIncrementalCompactor(checkpointer, interval, checkpointDistance, checkpointsToKeep, 0, logger)
+}
+
+// NewIncrementalCompactor creates a compactor which creates incremental checkpoints, holding only
+// the nodes changed since the previous checkpoint. After `maxIncrements` incremental checkpoints
+// on top of a full checkpoint, a full checkpoint is created again, which bounds the number of
+// checkpoint files to load. A `maxIncrements` of 0 disables incremental checkpoints.
+func NewIncrementalCompactor(checkpointer *Checkpointer, interval time.Duration, checkpointDistance uint, checkpointsToKeep uint, maxIncrements uint, logger zerolog.Logger) *Compactor {
 	if checkpointDistance < 1 {
 		checkpointDistance = 1
 	}
@@ -37,6 +46,7 @@ func NewCompactor(checkpointer *Checkpointer, interval time.Duration, checkpoint
 		interval:           interval,
 		checkpointDistance: checkpointDistance,
 		checkpointsToKeep:  checkpointsToKeep,
+		maxIncrements:      maxIncrements,
 	}
 }
 
@@ -119,10 +129,21 @@ func (c *Compactor) createCheckpoints() (int, error) {
 		startTime := time.Now()
 
 		checkpointNumber := to - 1
-		c.logger.Info().Msgf("creating checkpoint %d from segment %d to segment %d", checkpointNumber, from, checkpointNumber)
-		err = c.checkpointer.Checkpoint(checkpointNumber, func() (io.WriteCloser, error) {
+		targetWriter := func() (io.WriteCloser, error) {
 			return c.checkpointer.CheckpointWriter(checkpointNumber)
-		})
+		}
+
+		incremental, err := c.incremental()
+		if err != nil {
+			return -1, fmt.Errorf("cannot get checkpoint chain: %w", err)
+		}
+
+		c.logger.Info().Bool("incremental", incremental).Msgf("creating checkpoint %d from segment %d to segment %d", checkpointNumber, from, checkpointNumber)
+		if incremental {
+			err = c.checkpointer.IncrementalCheckpoint(checkpointNumber, targetWriter)
+		} else {
+			err = c.checkpointer.Checkpoint(checkpointNumber, targetWriter)
+		}
 		if err != nil {
 			return -1, fmt.Errorf("error creating checkpoint (%d): %w", checkpointNumber, err)
 		}
@@ -134,6 +155,28 @@ func (c *Compactor) createCheckpoints() (int, error) {
 	return newLatestCheckpoint, nil
 }
 
+// incremental returns whether the next checkpoint is an incremental checkpoint, that is if
+// there are less than `maxIncrements` incremental checkpoints on top of the latest full checkpoint.
+func (c *Compactor) incremental() (bool, error) {
+	if c.maxIncrements == 0 {
+		return false, nil
+	}
+
+	latestCheckpoint, err := c.checkpointer.LatestCheckpoint()
+	if err != nil {
+		return false, err
+	}
+	if latestCheckpoint < 0 {
+		return false, nil
+	}
+
+	chain, err := c.checkpointer.CheckpointChain(latestCheckpoint)
+	if err != nil {
+		return false, err
+	}
+	return uint(len(chain)-1) < c.maxIncrements, nil
+}
+
 func (c *Compactor) cleanupCheckpoints() error {
 	// don't bother listing checkpoints if we keep them all
 	if c.checkpointsToKeep == 0 {
@@ -146,7 +189,22 @@ func (c *Compactor) cleanupCheckpoints() error {
 	if len(checkpoints) > int(c.checkpointsToKeep) {
 		checkpointsToRemove := checkpoints[:len(checkpoints)-int(c.checkpointsToKeep)] // if condition guarantees this never fails
 
+		// the base checkpoints of kept incremental checkpoints are kept as well
+		bases := make(map[int]struct{})
+		for _, checkpoint := range checkpoints[len(checkpointsToRemove):] {
+			chain, err := c.checkpointer.CheckpointChain(checkpoint)
+			if err != nil {
+				return fmt.Errorf("cannot get chain of checkpoint %d: %w", checkpoint, err)
+			}
+			for _, base := range chain {
+				bases[base] = struct{}{}
+			}
+		}
+
 		for _, checkpoint := range checkpointsToRemove {
+			if _, ok := bases[checkpoint]; ok {
+				continue
+			}
 			err := c.checkpointer.RemoveCheckpoint(checkpoint)
 			if err != nil {
 				return fmt.Errorf("cannot remove checkpoint %d: %w", checkpoint, err)
@@ -329,3 +329,112 @@ func Test_Compactor_checkpointInterval(t *testing.T) {
 		})
 	})
 }
+
+func Test_Compactor_incrementalCheckpoints(t *testing.T) {
+
+	numInsPerStep := 2
+	pathByteSize := 32
+	minPayloadByteSize := 100
+	maxPayloadByteSize := 2 << 16
+	size := 20
+	metricsCollector := &metrics.NoopCollector{}
+	checkpointDistance := uint(3) // there should be 3 WAL not checkpointed
+	maxIncrements := uint(2)
+
+	unittest.RunWithTempDir(t, func(dir string) {
+
+		f, err := mtrie.NewForest(size*10, metricsCollector, nil)
+		require.NoError(t, err)
+
+		var rootHash = f.GetEmptyRootHash()
+
+		wal, err := NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, 32*1024)
+		require.NoError(t, err)
+
+		checkpointer, err := wal.NewCheckpointer()
+		require.NoError(t, err)
+
+		compactor := NewIncrementalCompactor(checkpointer, 100*time.Millisecond, checkpointDistance, 1, maxIncrements, zerolog.Nop())
+
+		t.Run("Compactor creates incremental checkpoints", func(t *testing.T) {
+
+			for i := 0; i < size; i++ {
+
+				paths := utils.RandomPaths(numInsPerStep)
+				payloads := utils.RandomPayloads(numInsPerStep, minPayloadByteSize, maxPayloadByteSize)
+
+				update := &ledger.TrieUpdate{RootHash: rootHash, Paths: paths, Payloads: payloads}
+
+				err = wal.RecordUpdate(update)
+				require.NoError(t, err)
+
+				rootHash, err = f.Update(update)
+				require.NoError(t, err)
+
+				require.FileExists(t, path.Join(dir, NumberToFilenamePart(i)))
+
+				// run checkpoint creation after every file
+				_, err = compactor.createCheckpoints()
+				require.NoError(t, err)
+			}
+
+			checkpoints, err := checkpointer.Checkpoints()
+			require.NoError(t, err)
+			require.Equal(t, []int{3, 7, 11, 15, 19}, checkpoints)
+
+			// a full checkpoint is created after `maxIncrements` incremental checkpoints
+			chain, err := checkpointer.CheckpointChain(11)
+			require.NoError(t, err)
+			require.Equal(t, []int{3, 7, 11}, chain)
+
+			chain, err = checkpointer.CheckpointChain(19)
+			require.NoError(t, err)
+			require.Equal(t, []int{15, 19}, chain)
+		})
+
+		t.Run("base checkpoints are kept", func(t *testing.T) {
+			err = compactor.cleanupCheckpoints()
+			require.NoError(t, err)
+
+			checkpoints, err := checkpointer.Checkpoints()
+			require.NoError(t, err)
+			require.Equal(t, []int{15, 19}, checkpoints)
+		})
+
+		t.Run("incremental checkpoint holds all tries", func(t *testing.T) {
+			tries, err := checkpointer.LoadCheckpoint(19)
+			require.NoError(t, err)
+
+			// replay the segments covered by the checkpoint
+			replayed, err := mtrie.NewForest(size*10, metricsCollector, nil)
+			require.NoError(t, err)
+			err = wal.replay(0, 19,
+				func(tries []*trie.MTrie) error {
+					return fmt.Errorf("no checkpoint expected")
+				},
+				func(update *ledger.TrieUpdate) error {
+					_, err := replayed.Update(update)
+					return err
+				},
+				func(rootHash ledger.RootHash) error {
+					return nil
+				}, false)
+			require.NoError(t, err)
+
+			expected, err := replayed.GetTries()
+			require.NoError(t, err)
+
+			require.ElementsMatch(t, rootHashes(expected), rootHashes(tries))
+		})
+
+		<-wal.Done()
+	})
+}
+
+func rootHashes(tries []*trie.MTrie) []ledger.RootHash {
+	hashes := make([]ledger.RootHash, len(tries))
+	for i, t := range tries {
+		hashes[i] = t.RootHash()
+	}
+	return hashes
+}
@@ -12,6 +12,7 @@ type WALOperation uint8
 
 const WALUpdate WALOperation = 1
 const WALDelete WALOperation = 2
+const WALEncrypted WALOperation = 3
 
 /*
 The LedgerWAL update record uses two operations so far - an update which must include all keys and values, and deletion
@@ -30,6 +31,10 @@ If OP = WALUpdate, record has:
 and for every pair after
 bytes for key | 4 bytes Big Endian uint32 - length of value | value bytes
 
+If OP = WALEncrypted, the record wraps one of the above records, see Encryption:
+
+1 byte Operation Type | 8 bytes key ID | 12 bytes nonce | encrypted record | 16 bytes tag
+
 The code here is deliberately simple, for performance.
 
 */
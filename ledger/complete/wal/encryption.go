@@ -0,0 +1,523 @@
+package wal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrEncrypted is returned when reading an encrypted WAL record or checkpoint
+// without an encryption key, or with a key that doesn't match the one used to
+// encrypt the data.
+var ErrEncrypted = errors.New("data is encrypted with an unknown key")
+
+// ErrPlaintext is returned when reading a plaintext WAL record or checkpoint
+// with an encryption key, as an attacker with write access could otherwise
+// replace encrypted data with plaintext data.
+var ErrPlaintext = errors.New("data is not encrypted")
+
+// EncryptedMagicBytes marks a checkpoint file encrypted with an Encryption.
+// The encrypted file contains a regular (plaintext) checkpoint file.
+const EncryptedMagicBytes uint16 = 0x2138
+const EncryptionVersionV1 uint16 = 0x01
+
+const (
+	keyIDSize            = 8
+	encSequenceSize      = 8
+	encryptionSaltSize   = 32
+	encChunkSizeSize     = 4
+	encryptedHeaderSize  = encMagicSize + encVersionSize + keyIDSize + encryptionSaltSize + encChunkSizeSize
+	encryptionNonceSize  = 12
+	encryptionTagSize    = 16
+	encryptedRecordSize  = encRecordHeaderSize + encryptionNonceSize + encryptionTagSize // overhead of an encrypted WAL record
+	encRecordHeaderSize  = 1 + keyIDSize + encSequenceSize
+	encryptionChunkSize  = 64 * 1024
+	encryptionKeyIDLabel = "flow-ledger-encryption-key-id"
+)
+
+// Encryption provides authenticated encryption (AES-GCM) of LedgerWAL records
+// and checkpoint files.
+//
+// Data is always encrypted with the current key. Previous keys can be given
+// so that data encrypted before a key rotation can still be read. Each WAL
+// record and checkpoint file stores the ID of the key it was encrypted with.
+type Encryption struct {
+	current keyID
+	keys    map[keyID][]byte
+}
+
+type keyID [keyIDSize]byte
+
+// NewEncryption creates a new Encryption that encrypts with the given key, and
+// decrypts with the given key or any of the previous keys. Keys must be valid
+// AES keys (16, 24 or 32 bytes).
+func NewEncryption(key []byte, previous ...[]byte) (*Encryption, error) {
+	e := &Encryption{
+		keys: make(map[keyID][]byte),
+	}
+	for i, k := range append([][]byte{key}, previous...) {
+		_, err := aes.NewCipher(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %d: %w", i, err)
+		}
+		id := encryptionKeyID(k)
+		if i == 0 {
+			e.current = id
+		}
+		e.keys[id] = k
+	}
+	return e, nil
+}
+
+// encryptionKeyID returns the ID of the given key, which is stored alongside
+// encrypted data to select the key for decryption.
+func encryptionKeyID(key []byte) keyID {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(encryptionKeyIDLabel))
+	var id keyID
+	copy(id[:], mac.Sum(nil))
+	return id
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealRecord encrypts an encoded WAL record with the given sequence number.
+// The encrypted record has:
+//
+// 1 byte WALEncrypted | 8 bytes key ID | 8 bytes sequence number | 12 bytes nonce | encrypted record with 16 bytes tag
+//
+// The sequence number is the position of the record in the WAL. It is
+// authenticated with the record, so that reordered, dropped or replayed
+// records are detected when reading the WAL (see recordDecryptor).
+func (e *Encryption) sealRecord(record []byte, sequence uint64) ([]byte, error) {
+	aead, err := newGCM(e.keys[e.current])
+	if err != nil {
+		return nil, fmt.Errorf("could not create cipher: %w", err)
+	}
+
+	buf := make([]byte, encRecordHeaderSize+encryptionNonceSize, len(record)+encryptedRecordSize)
+	buf[0] = byte(WALEncrypted)
+	copy(buf[1:], e.current[:])
+	binary.BigEndian.PutUint64(buf[1+keyIDSize:], sequence)
+	nonce := buf[encRecordHeaderSize:]
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate nonce: %w", err)
+	}
+
+	// authenticate operation type, key ID and sequence number together with the record
+	return aead.Seal(buf, nonce, record, buf[:encRecordHeaderSize]), nil
+}
+
+// recordHeader returns the key ID and sequence number of a record encrypted
+// by sealRecord.
+func recordHeader(record []byte) (keyID, uint64, error) {
+	if len(record) < encryptedRecordSize {
+		return keyID{}, 0, fmt.Errorf("encrypted record too short: %d bytes", len(record))
+	}
+	var id keyID
+	copy(id[:], record[1:])
+	return id, binary.BigEndian.Uint64(record[1+keyIDSize:]), nil
+}
+
+// openRecord decrypts a WAL record encrypted by sealRecord, and returns the
+// plaintext record and its sequence number.
+func (e *Encryption) openRecord(record []byte) ([]byte, uint64, error) {
+	id, sequence, err := recordHeader(record)
+	if err != nil {
+		return nil, 0, err
+	}
+	key, ok := e.key(id)
+	if !ok {
+		return nil, 0, fmt.Errorf("cannot decrypt record with key %x: %w", id, ErrEncrypted)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not create cipher: %w", err)
+	}
+
+	nonce := record[encRecordHeaderSize : encRecordHeaderSize+encryptionNonceSize]
+	plaintext, err := aead.Open(nil, nonce, record[encRecordHeaderSize+encryptionNonceSize:], record[:encRecordHeaderSize])
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot decrypt record: %w", err)
+	}
+	return plaintext, sequence, nil
+}
+
+func (e *Encryption) key(id keyID) ([]byte, bool) {
+	if e == nil {
+		return nil, false
+	}
+	key, ok := e.keys[id]
+	return key, ok
+}
+
+// fileCipher derives the cipher for a single encrypted file from the key and
+// the random salt in the file header, so that chunk nonces can be counters.
+func fileCipher(key []byte, salt []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(salt)
+	return newGCM(mac.Sum(nil))
+}
+
+// chunkNonce returns the nonce of the i-th chunk of an encrypted file.
+func chunkNonce(i uint64) []byte {
+	nonce := make([]byte, encryptionNonceSize)
+	binary.BigEndian.PutUint64(nonce[encryptionNonceSize-8:], i)
+	return nonce
+}
+
+// chunkAdditionalData authenticates the file header with each chunk, and marks
+// the last chunk so that truncated files are detected.
+func chunkAdditionalData(header []byte, final bool) []byte {
+	ad := make([]byte, len(header)+1)
+	copy(ad, header)
+	if final {
+		ad[len(header)] = 1
+	}
+	return ad
+}
+
+// NewFileWriter returns a writer which encrypts everything written to it and
+// writes it to w. Data is encrypted in chunks, so the resulting file can be
+// read with random access. The returned writer must be closed to write the
+// last chunk, it doesn't close w.
+//
+// Encrypted file has:
+//
+// 2 bytes EncryptedMagicBytes | 2 bytes version | 8 bytes key ID | 32 bytes salt | 4 bytes chunk size |
+// encrypted chunks, each with 16 bytes tag
+func (e *Encryption) NewFileWriter(w io.Writer) (io.WriteCloser, error) {
+
+	header := make([]byte, encryptedHeaderSize)
+	binary.BigEndian.PutUint16(header, EncryptedMagicBytes)
+	binary.BigEndian.PutUint16(header[encMagicSize:], EncryptionVersionV1)
+	copy(header[encMagicSize+encVersionSize:], e.current[:])
+	salt := header[encMagicSize+encVersionSize+keyIDSize : encMagicSize+encVersionSize+keyIDSize+encryptionSaltSize]
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate salt: %w", err)
+	}
+	binary.BigEndian.PutUint32(header[encryptedHeaderSize-encChunkSizeSize:], encryptionChunkSize)
+
+	aead, err := fileCipher(e.keys[e.current], salt)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cipher: %w", err)
+	}
+
+	_, err = w.Write(header)
+	if err != nil {
+		return nil, fmt.Errorf("could not write encryption header: %w", err)
+	}
+
+	return &encryptedFileWriter{
+		w:      w,
+		aead:   aead,
+		header: header,
+		buf:    make([]byte, 0, encryptionChunkSize),
+		out:    make([]byte, 0, encryptionChunkSize+encryptionTagSize),
+	}, nil
+}
+
+type encryptedFileWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header []byte
+	buf    []byte // plaintext of the current chunk
+	out    []byte
+	chunk  uint64
+	closed bool
+}
+
+func (f *encryptedFileWriter) Write(p []byte) (int, error) {
+	if f.closed {
+		return 0, fmt.Errorf("write to closed encrypted file")
+	}
+	written := 0
+	for len(p) > 0 {
+		// the current chunk is only written once more data arrives, as the last
+		// chunk needs to be sealed differently
+		if len(f.buf) == encryptionChunkSize {
+			err := f.writeChunk(false)
+			if err != nil {
+				return written, err
+			}
+		}
+		n := copy(f.buf[len(f.buf):cap(f.buf)], p)
+		f.buf = f.buf[:len(f.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (f *encryptedFileWriter) writeChunk(final bool) error {
+	f.out = f.aead.Seal(f.out[:0], chunkNonce(f.chunk), f.buf, chunkAdditionalData(f.header, final))
+	_, err := f.w.Write(f.out)
+	if err != nil {
+		return fmt.Errorf("could not write encrypted chunk %d: %w", f.chunk, err)
+	}
+	f.chunk++
+	f.buf = f.buf[:0]
+	return nil
+}
+
+// Close writes the last chunk.
+func (f *encryptedFileWriter) Close() error {
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	return f.writeChunk(true)
+}
+
+// IsEncryptedFile returns true if the file starts with the header of an
+// encrypted file. The read offset is reset to the start of the file.
+func IsEncryptedFile(f io.ReadSeeker) (bool, error) {
+	header := make([]byte, encMagicSize)
+	_, err := io.ReadFull(f, header)
+	if err != nil {
+		return false, fmt.Errorf("cannot read header: %w", err)
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return false, fmt.Errorf("cannot seek to start of file: %w", err)
+	}
+	return binary.BigEndian.Uint16(header) == EncryptedMagicBytes, nil
+}
+
+// NewFileReader returns a reader for a file written by NewFileWriter. The
+// reader decrypts and authenticates one chunk at a time, and supports seeking.
+func (e *Encryption) NewFileReader(f io.ReadSeeker) (io.ReadSeeker, error) {
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("cannot seek to end of file: %w", err)
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("cannot seek to start of file: %w", err)
+	}
+
+	header := make([]byte, encryptedHeaderSize)
+	_, err = io.ReadFull(f, header)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read encryption header: %w", err)
+	}
+	magic := binary.BigEndian.Uint16(header)
+	if magic != EncryptedMagicBytes {
+		return nil, fmt.Errorf("unknown file format. Magic constant %x does not match expected %x", magic, EncryptedMagicBytes)
+	}
+	version := binary.BigEndian.Uint16(header[encMagicSize:])
+	if version != EncryptionVersionV1 {
+		return nil, fmt.Errorf("unsupported encryption version %x", version)
+	}
+
+	var id keyID
+	copy(id[:], header[encMagicSize+encVersionSize:])
+	key, ok := e.key(id)
+	if !ok {
+		return nil, fmt.Errorf("cannot decrypt file with key %x: %w", id, ErrEncrypted)
+	}
+	salt := header[encMagicSize+encVersionSize+keyIDSize : encMagicSize+encVersionSize+keyIDSize+encryptionSaltSize]
+	aead, err := fileCipher(key, salt)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cipher: %w", err)
+	}
+
+	chunkSize := int64(binary.BigEndian.Uint32(header[encryptedHeaderSize-encChunkSizeSize:]))
+	if chunkSize == 0 {
+		return nil, fmt.Errorf("invalid chunk size 0")
+	}
+	sealedChunkSize := chunkSize + encryptionTagSize
+	encrypted := size - encryptedHeaderSize
+	chunks := (encrypted + sealedChunkSize - 1) / sealedChunkSize
+	if chunks == 0 || encrypted-(chunks-1)*sealedChunkSize < encryptionTagSize {
+		return nil, fmt.Errorf("encrypted file is truncated")
+	}
+
+	return &encryptedFileReader{
+		f:         f,
+		aead:      aead,
+		header:    header,
+		chunkSize: chunkSize,
+		chunks:    chunks,
+		size:      encrypted - chunks*encryptionTagSize,
+		current:   -1,
+	}, nil
+}
+
+type encryptedFileReader struct {
+	f         io.ReadSeeker
+	aead      cipher.AEAD
+	header    []byte
+	chunkSize int64
+	chunks    int64
+	size      int64 // plaintext size
+	pos       int64 // plaintext read offset
+	current   int64 // index of the decrypted chunk in buf, -1 if none
+	buf       []byte
+	sealed    []byte
+}
+
+func (r *encryptedFileReader) Read(p []byte) (int, error) {
+	if r.pos >= r.size {
+		return 0, io.EOF
+	}
+	chunk := r.pos / r.chunkSize
+	if chunk != r.current {
+		err := r.readChunk(chunk)
+		if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf[r.pos-chunk*r.chunkSize:])
+	r.pos += int64(n)
+	return n, nil
+}
+
+func (r *encryptedFileReader) readChunk(chunk int64) error {
+	sealedChunkSize := r.chunkSize + encryptionTagSize
+	_, err := r.f.Seek(encryptedHeaderSize+chunk*sealedChunkSize, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("cannot seek to chunk %d: %w", chunk, err)
+	}
+
+	final := chunk == r.chunks-1
+	size := sealedChunkSize
+	if final {
+		size = r.size - chunk*r.chunkSize + encryptionTagSize
+	}
+	if int64(cap(r.sealed)) < size {
+		r.sealed = make([]byte, size)
+	}
+	r.sealed = r.sealed[:size]
+	_, err = io.ReadFull(r.f, r.sealed)
+	if err != nil {
+		return fmt.Errorf("cannot read chunk %d: %w", chunk, err)
+	}
+
+	r.buf, err = r.aead.Open(r.buf[:0], chunkNonce(uint64(chunk)), r.sealed, chunkAdditionalData(r.header, final))
+	if err != nil {
+		r.current = -1
+		return fmt.Errorf("cannot decrypt chunk %d: %w", chunk, err)
+	}
+	r.current = chunk
+	return nil
+}
+
+func (r *encryptedFileReader) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = r.pos + offset
+	case io.SeekEnd:
+		pos = r.size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if pos < 0 {
+		return 0, fmt.Errorf("negative position %d", pos)
+	}
+	r.pos = pos
+	return pos, nil
+}
+
+// EncryptRecord encrypts an encoded WAL record with the given sequence number.
+// Records which are already encrypted are returned unchanged if they were
+// encrypted with the current key and the same sequence number, otherwise they
+// are re-encrypted.
+func (e *Encryption) EncryptRecord(record []byte, sequence uint64) ([]byte, error) {
+	if isEncryptedRecord(record) {
+		id, encryptedSequence, err := recordHeader(record)
+		if err != nil {
+			return nil, err
+		}
+		if id == e.current && encryptedSequence == sequence {
+			return record, nil
+		}
+		plaintext, _, err := e.openRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		record = plaintext
+	}
+	return e.sealRecord(record, sequence)
+}
+
+// DecryptRecord decrypts a WAL record encrypted with any of the keys of the
+// given Encryption. If the Encryption is nil, plaintext records are returned
+// unchanged, otherwise plaintext records are rejected with ErrPlaintext.
+// The sequence number of the record is not checked, see recordDecryptor.
+func DecryptRecord(e *Encryption, record []byte) ([]byte, error) {
+	plaintext, _, err := decryptRecord(e, record, false)
+	return plaintext, err
+}
+
+// decryptRecord decrypts a WAL record, and returns the plaintext and its
+// sequence number. The sequence number of plaintext records is zero. If
+// allowPlaintext is true, plaintext records are accepted even if the
+// Encryption is not nil, which is only used for re-encryption.
+func decryptRecord(e *Encryption, record []byte, allowPlaintext bool) ([]byte, uint64, error) {
+	if !isEncryptedRecord(record) {
+		if e != nil && !allowPlaintext {
+			return nil, 0, fmt.Errorf("cannot read plaintext record with encryption key: %w", ErrPlaintext)
+		}
+		return record, 0, nil
+	}
+	if e == nil {
+		return nil, 0, fmt.Errorf("cannot decrypt record without key: %w", ErrEncrypted)
+	}
+	return e.openRecord(record)
+}
+
+func isEncryptedRecord(record []byte) bool {
+	return len(record) > 0 && WALOperation(record[0]) == WALEncrypted
+}
+
+// recordDecryptor decrypts consecutive records read from the WAL, and checks
+// that the sequence numbers of encrypted records are consecutive, so that
+// records cannot be reordered, dropped or replayed. The first record read can
+// have any sequence number, as reading can start at any segment.
+type recordDecryptor struct {
+	encryption     *Encryption
+	allowPlaintext bool
+	next           uint64 // expected sequence number of the next encrypted record
+	started        bool   // true once an encrypted record was read
+}
+
+func newRecordDecryptor(encryption *Encryption) *recordDecryptor {
+	return &recordDecryptor{encryption: encryption}
+}
+
+// decrypt decrypts the next record, see DecryptRecord. An error is returned if
+// the sequence number of an encrypted record doesn't follow the previous one.
+func (d *recordDecryptor) decrypt(record []byte) ([]byte, error) {
+	plaintext, sequence, err := decryptRecord(d.encryption, record, d.allowPlaintext)
+	if err != nil {
+		return nil, err
+	}
+	if !isEncryptedRecord(record) {
+		return plaintext, nil
+	}
+	if d.started && sequence != d.next {
+		return nil, fmt.Errorf("encrypted record out of order: sequence number %d, expected %d", sequence, d.next)
+	}
+	d.started = true
+	d.next = sequence + 1
+	return plaintext, nil
+}
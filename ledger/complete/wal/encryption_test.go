@@ -0,0 +1,495 @@
+package wal_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	prometheusWAL "github.com/m4ksio/wal/wal"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/ledger/complete/mtrie"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	realWAL "github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func encryptionKeyFixture(t *testing.T) []byte {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestEncryption_Records(t *testing.T) {
+	key := encryptionKeyFixture(t)
+	encryption, err := realWAL.NewEncryption(key)
+	require.NoError(t, err)
+
+	rootHash := ledger.RootHash(unittest.StateCommitmentFixture())
+	record := realWAL.EncodeDelete(rootHash)
+
+	encrypted, err := encryption.EncryptRecord(record, 7)
+	require.NoError(t, err)
+	assert.Equal(t, byte(realWAL.WALEncrypted), encrypted[0])
+	assert.NotContains(t, string(encrypted), string(rootHash[:]))
+
+	t.Run("decrypt", func(t *testing.T) {
+		decrypted, err := realWAL.DecryptRecord(encryption, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, record, decrypted)
+	})
+
+	t.Run("plaintext records are rejected with a key", func(t *testing.T) {
+		_, err := realWAL.DecryptRecord(encryption, record)
+		assert.True(t, errors.Is(err, realWAL.ErrPlaintext))
+
+		decrypted, err := realWAL.DecryptRecord(nil, record)
+		require.NoError(t, err)
+		assert.Equal(t, record, decrypted)
+	})
+
+	t.Run("truncated record", func(t *testing.T) {
+		for _, size := range []int{1, 5, len(encrypted) / 2} {
+			_, err := realWAL.DecryptRecord(encryption, encrypted[:size])
+			assert.Error(t, err)
+			_, err = encryption.EncryptRecord(encrypted[:size], 7)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("sequence number", func(t *testing.T) {
+		// records encrypted with the current key and sequence number are unchanged
+		same, err := encryption.EncryptRecord(encrypted, 7)
+		require.NoError(t, err)
+		assert.Equal(t, encrypted, same)
+
+		// the sequence number is authenticated with the record
+		tampered := append([]byte{}, encrypted...)
+		tampered[1+8+7] ^= 1
+		_, err = realWAL.DecryptRecord(encryption, tampered)
+		assert.Error(t, err)
+
+		renumbered, err := encryption.EncryptRecord(encrypted, 8)
+		require.NoError(t, err)
+		assert.NotEqual(t, encrypted, renumbered)
+		decrypted, err := realWAL.DecryptRecord(encryption, renumbered)
+		require.NoError(t, err)
+		assert.Equal(t, record, decrypted)
+	})
+
+	t.Run("missing or wrong key", func(t *testing.T) {
+		_, err := realWAL.DecryptRecord(nil, encrypted)
+		assert.True(t, errors.Is(err, realWAL.ErrEncrypted))
+
+		other, err := realWAL.NewEncryption(encryptionKeyFixture(t))
+		require.NoError(t, err)
+		_, err = realWAL.DecryptRecord(other, encrypted)
+		assert.True(t, errors.Is(err, realWAL.ErrEncrypted))
+	})
+
+	t.Run("tampered record", func(t *testing.T) {
+		tampered := append([]byte{}, encrypted...)
+		tampered[len(tampered)-1] ^= 1
+		_, err := realWAL.DecryptRecord(encryption, tampered)
+		assert.Error(t, err)
+	})
+
+	t.Run("key rotation", func(t *testing.T) {
+		rotated, err := realWAL.NewEncryption(encryptionKeyFixture(t), key)
+		require.NoError(t, err)
+
+		// records encrypted with the previous key can still be read
+		decrypted, err := realWAL.DecryptRecord(rotated, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, record, decrypted)
+
+		// and are re-encrypted with the new key
+		reencrypted, err := rotated.EncryptRecord(encrypted, 7)
+		require.NoError(t, err)
+		_, err = realWAL.DecryptRecord(encryption, reencrypted)
+		assert.True(t, errors.Is(err, realWAL.ErrEncrypted))
+
+		decrypted, err = realWAL.DecryptRecord(rotated, reencrypted)
+		require.NoError(t, err)
+		assert.Equal(t, record, decrypted)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := realWAL.NewEncryption(make([]byte, 31))
+		assert.Error(t, err)
+	})
+}
+
+func TestEncryption_Files(t *testing.T) {
+	encryption, err := realWAL.NewEncryption(encryptionKeyFixture(t))
+	require.NoError(t, err)
+
+	encrypt := func(t *testing.T, plaintext []byte) []byte {
+		var buf bytes.Buffer
+		w, err := encryption.NewFileWriter(&buf)
+		require.NoError(t, err)
+		// write in uneven pieces to cross chunk boundaries
+		for data := plaintext; len(data) > 0; {
+			n := 1000
+			if n > len(data) {
+				n = len(data)
+			}
+			_, err = w.Write(data[:n])
+			require.NoError(t, err)
+			data = data[n:]
+		}
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	chunk := 64 * 1024
+	for _, size := range []int{1, 100, chunk - 1, chunk, chunk + 1, 3*chunk + 17} {
+		t.Run(fmt.Sprintf("size %d", size), func(t *testing.T) {
+			plaintext := make([]byte, size)
+			_, err := rand.Read(plaintext)
+			require.NoError(t, err)
+
+			encrypted := encrypt(t, plaintext)
+
+			isEncrypted, err := realWAL.IsEncryptedFile(bytes.NewReader(encrypted))
+			require.NoError(t, err)
+			assert.True(t, isEncrypted)
+
+			reader, err := encryption.NewFileReader(bytes.NewReader(encrypted))
+			require.NoError(t, err)
+			decrypted, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, decrypted)
+
+			// seek to the last bytes, as checkpoint readers do to read the footer
+			tail := size / 3
+			_, err = reader.Seek(-int64(tail), io.SeekEnd)
+			require.NoError(t, err)
+			decrypted, err = io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext[size-tail:], decrypted)
+
+			// tampering with any byte is detected
+			tampered := append([]byte{}, encrypted...)
+			tampered[len(tampered)/2+len(tampered)%7] ^= 1
+			reader, err = encryption.NewFileReader(bytes.NewReader(tampered))
+			if err == nil {
+				_, err = io.ReadAll(reader)
+			}
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("truncated file", func(t *testing.T) {
+		plaintext := make([]byte, 3*chunk)
+		encrypted := encrypt(t, plaintext)
+
+		// truncate at a chunk boundary
+		truncated := encrypted[:len(encrypted)-(len(encrypted)-48)/3]
+		reader, err := encryption.NewFileReader(bytes.NewReader(truncated))
+		if err == nil {
+			_, err = io.ReadAll(reader)
+		}
+		assert.Error(t, err)
+	})
+}
+
+// Test_EncryptedWAL checks that records and checkpoints of an encrypted WAL
+// are encrypted, and that the WAL can be replayed with the key but not without it.
+func Test_EncryptedWAL(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		encryption, err := realWAL.NewEncryption(encryptionKeyFixture(t))
+		require.NoError(t, err)
+
+		f, err := mtrie.NewForest(size*10, metricsCollector, nil)
+		require.NoError(t, err)
+		rootHash := f.GetEmptyRootHash()
+
+		wal, err := realWAL.NewEncryptedDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize, encryption)
+		require.NoError(t, err)
+
+		var values []ledger.Value
+		for i := 0; i < size; i++ {
+			keys := utils.RandomUniqueKeys(numInsPerStep, keyNumberOfParts, 1600, 1600)
+			vals := utils.RandomValues(numInsPerStep, valueMaxByteSize/2, valueMaxByteSize)
+			update, err := ledger.NewUpdate(ledger.State(rootHash), keys, vals)
+			require.NoError(t, err)
+			trieUpdate, err := pathfinder.UpdateToTrieUpdate(update, pathFinderVersion)
+			require.NoError(t, err)
+
+			require.NoError(t, wal.RecordUpdate(trieUpdate))
+			rootHash, err = f.Update(trieUpdate)
+			require.NoError(t, err)
+			values = append(values, vals...)
+		}
+		<-wal.Done()
+
+		// values are not stored in plaintext
+		segment, err := os.ReadFile(path.Join(dir, "00000000"))
+		require.NoError(t, err)
+		assert.False(t, bytes.Contains(segment, values[0]))
+
+		noop := func(tries []*trie.MTrie) error { return nil }
+		noUpdate := func(update *ledger.TrieUpdate) error { return nil }
+		noDelete := func(rootHash ledger.RootHash) error { return nil }
+
+		t.Run("reopened WAL continues the sequence", func(t *testing.T) {
+			wal2, err := realWAL.NewEncryptedDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize, encryption)
+			require.NoError(t, err)
+			require.NoError(t, wal2.RecordDelete(rootHash))
+			<-wal2.Done()
+
+			wal3, err := realWAL.NewEncryptedDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize, encryption)
+			require.NoError(t, err)
+			defer func() { <-wal3.Done() }()
+
+			deletes := 0
+			err = wal3.ReplayLogsOnly(noop, noUpdate, func(ledger.RootHash) error {
+				deletes++
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 1, deletes)
+		})
+
+		t.Run("replay without key fails", func(t *testing.T) {
+			plainWAL, err := realWAL.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize)
+			require.NoError(t, err)
+			defer func() { <-plainWAL.Done() }()
+
+			err = plainWAL.Replay(noop, noUpdate, noDelete)
+			assert.True(t, errors.Is(err, realWAL.ErrEncrypted))
+		})
+
+		t.Run("checkpoint and replay with key", func(t *testing.T) {
+			wal2, err := realWAL.NewEncryptedDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize, encryption)
+			require.NoError(t, err)
+			defer func() { <-wal2.Done() }()
+
+			checkpointer, err := wal2.NewCheckpointer()
+			require.NoError(t, err)
+			err = checkpointer.Checkpoint(10, func() (io.WriteCloser, error) {
+				return checkpointer.CheckpointWriter(10)
+			})
+			require.NoError(t, err)
+
+			checkpointFile := path.Join(dir, "checkpoint.00000010")
+			file, err := os.Open(checkpointFile)
+			require.NoError(t, err)
+			isEncrypted, err := realWAL.IsEncryptedFile(file)
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+			assert.True(t, isEncrypted)
+
+			_, err = realWAL.LoadCheckpoint(checkpointFile, &zerolog.Logger{})
+			assert.True(t, errors.Is(err, realWAL.ErrEncrypted))
+
+			tries, err := checkpointer.LoadCheckpoint(10)
+			require.NoError(t, err)
+			require.Len(t, tries, size+1) // including the empty trie
+			rootHashes := make([]ledger.RootHash, 0, len(tries))
+			for _, tr := range tries {
+				rootHashes = append(rootHashes, tr.RootHash())
+			}
+			assert.Contains(t, rootHashes, rootHash)
+
+			f2, err := mtrie.NewForest(size*10, metricsCollector, nil)
+			require.NoError(t, err)
+			err = wal2.ReplayLogsOnly(
+				noop,
+				func(update *ledger.TrieUpdate) error {
+					_, err := f2.Update(update)
+					return err
+				},
+				noDelete,
+			)
+			require.NoError(t, err)
+			_, err = f2.GetTrie(rootHash)
+			assert.NoError(t, err)
+		})
+	})
+}
+
+// Test_Reencrypt checks that a WAL can be encrypted, re-encrypted with a new
+// key and decrypted, with all segments and checkpoints replayed correctly.
+func Test_Reencrypt(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+
+		f, err := mtrie.NewForest(size*10, metricsCollector, nil)
+		require.NoError(t, err)
+		rootHash := f.GetEmptyRootHash()
+
+		// create a plaintext WAL with a checkpoint
+		wal, err := realWAL.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize)
+		require.NoError(t, err)
+		for i := 0; i < size; i++ {
+			keys := utils.RandomUniqueKeys(numInsPerStep, keyNumberOfParts, 1600, 1600)
+			values := utils.RandomValues(numInsPerStep, valueMaxByteSize/2, valueMaxByteSize)
+			update, err := ledger.NewUpdate(ledger.State(rootHash), keys, values)
+			require.NoError(t, err)
+			trieUpdate, err := pathfinder.UpdateToTrieUpdate(update, pathFinderVersion)
+			require.NoError(t, err)
+
+			require.NoError(t, wal.RecordUpdate(trieUpdate))
+			rootHash, err = f.Update(trieUpdate)
+			require.NoError(t, err)
+		}
+		require.NoError(t, wal.RecordDelete(rootHash))
+		checkpointer, err := wal.NewCheckpointer()
+		require.NoError(t, err)
+		err = checkpointer.Checkpoint(5, func() (io.WriteCloser, error) {
+			return checkpointer.CheckpointWriter(5)
+		})
+		require.NoError(t, err)
+		<-wal.Done()
+
+		// segments before the checkpoint can be removed, segment numbers must be preserved
+		require.NoError(t, os.Remove(path.Join(dir, "00000000")))
+
+		// replay returns the root hashes of all updates, and whether the WAL was
+		// replayed from the checkpoint
+		replay := func(t *testing.T, encryption *realWAL.Encryption) ([]ledger.RootHash, bool, error) {
+			w, err := realWAL.NewEncryptedDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize, encryption)
+			require.NoError(t, err)
+			defer func() { <-w.Done() }()
+
+			forest, err := mtrie.NewForest(size*10, metricsCollector, nil)
+			require.NoError(t, err)
+			var rootHashes []ledger.RootHash
+			checkpointLoaded := false
+			err = w.Replay(
+				func(tries []*trie.MTrie) error {
+					checkpointLoaded = true
+					return forest.AddTries(tries)
+				},
+				func(update *ledger.TrieUpdate) error {
+					rootHash, err := forest.Update(update)
+					rootHashes = append(rootHashes, rootHash)
+					return err
+				},
+				func(rootHash ledger.RootHash) error {
+					return nil
+				},
+			)
+			return rootHashes, checkpointLoaded, err
+		}
+
+		expected, checkpointLoaded, err := replay(t, nil)
+		require.NoError(t, err)
+		require.True(t, checkpointLoaded)
+		require.NotEmpty(t, expected)
+		require.Equal(t, rootHash, expected[len(expected)-1])
+
+		key1 := encryptionKeyFixture(t)
+		encryption1, err := realWAL.NewEncryption(key1)
+		require.NoError(t, err)
+		encryption2, err := realWAL.NewEncryption(encryptionKeyFixture(t), key1)
+		require.NoError(t, err)
+
+		t.Run("encrypt", func(t *testing.T) {
+			// the plaintext WAL cannot be read with a key before it is encrypted
+			_, _, err = replay(t, encryption1)
+			assert.True(t, errors.Is(err, realWAL.ErrPlaintext))
+
+			err := realWAL.Reencrypt(zerolog.Nop(), dir, nil, encryption1)
+			require.NoError(t, err)
+
+			_, _, err = replay(t, nil)
+			assert.True(t, errors.Is(err, realWAL.ErrEncrypted))
+
+			rootHashes, checkpointLoaded, err := replay(t, encryption1)
+			require.NoError(t, err)
+			assert.True(t, checkpointLoaded)
+			assert.Equal(t, expected, rootHashes)
+		})
+
+		t.Run("rotate key", func(t *testing.T) {
+			err := realWAL.Reencrypt(zerolog.Nop(), dir, encryption1, encryption2)
+			require.NoError(t, err)
+
+			_, _, err = replay(t, encryption1)
+			assert.True(t, errors.Is(err, realWAL.ErrEncrypted))
+
+			rootHashes, checkpointLoaded, err := replay(t, encryption2)
+			require.NoError(t, err)
+			assert.True(t, checkpointLoaded)
+			assert.Equal(t, expected, rootHashes)
+		})
+
+		t.Run("decrypt", func(t *testing.T) {
+			err := realWAL.Reencrypt(zerolog.Nop(), dir, encryption2, nil)
+			require.NoError(t, err)
+
+			rootHashes, checkpointLoaded, err := replay(t, nil)
+			require.NoError(t, err)
+			assert.True(t, checkpointLoaded)
+			assert.Equal(t, expected, rootHashes)
+		})
+	})
+}
+
+// Test_EncryptedWALRecordOrder checks that replaying an encrypted WAL fails if
+// records are out of order, or if it contains plaintext records.
+func Test_EncryptedWALRecordOrder(t *testing.T) {
+	encryption, err := realWAL.NewEncryption(encryptionKeyFixture(t))
+	require.NoError(t, err)
+	record := realWAL.EncodeDelete(ledger.RootHash(unittest.StateCommitmentFixture()))
+
+	replay := func(t *testing.T, records ...[]byte) error {
+		dir := unittest.TempDir(t)
+		defer os.RemoveAll(dir)
+
+		w, err := prometheusWAL.NewSize(zerolog.Nop(), nil, dir, segmentSize, false)
+		require.NoError(t, err)
+		_, err = w.Log(records...)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		wal, err := realWAL.NewEncryptedDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize, encryption)
+		require.NoError(t, err)
+		defer func() { <-wal.Done() }()
+
+		return wal.ReplayLogsOnly(
+			func(tries []*trie.MTrie) error { return nil },
+			func(update *ledger.TrieUpdate) error { return nil },
+			func(rootHash ledger.RootHash) error { return nil },
+		)
+	}
+	encrypt := func(sequence uint64) []byte {
+		encrypted, err := encryption.EncryptRecord(record, sequence)
+		require.NoError(t, err)
+		return encrypted
+	}
+
+	t.Run("consecutive records", func(t *testing.T) {
+		err := replay(t, encrypt(5), encrypt(6), encrypt(7))
+		assert.NoError(t, err)
+	})
+
+	t.Run("dropped record", func(t *testing.T) {
+		err := replay(t, encrypt(5), encrypt(7))
+		assert.Error(t, err)
+	})
+
+	t.Run("replayed record", func(t *testing.T) {
+		err := replay(t, encrypt(5), encrypt(6), encrypt(5))
+		assert.Error(t, err)
+	})
+
+	t.Run("plaintext record", func(t *testing.T) {
+		err := replay(t, encrypt(5), record)
+		assert.True(t, errors.Is(err, realWAL.ErrPlaintext))
+	})
+}
@@ -0,0 +1,531 @@
+package wal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/hash"
+	"github.com/onflow/flow-go/ledger/complete/mtrie"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/flattener"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/module/metrics"
+)
+
+const (
+	encCheckpointNumberSize = 8
+	encBaseRefCountSize     = 8
+	encBaseRefSize          = encNodeCountSize + hash.HashLen
+
+	// incremental checkpoint header: magic + version + base checkpoint number + base node count
+	incrementalHeaderSize = headerSize + encCheckpointNumberSize + encNodeCountSize
+)
+
+// IncrementalCheckpoint creates a new incremental checkpoint stopping at the given segment,
+// on top of the latest checkpoint: only the nodes which aren't in the latest checkpoint (or its
+// base checkpoints) are written. If there is no checkpoint yet, or the latest checkpoint can't
+// be the base of an incremental checkpoint (older checkpoint versions), a full checkpoint is
+// created instead.
+func (c *Checkpointer) IncrementalCheckpoint(to int, targetWriter func() (io.WriteCloser, error)) (err error) {
+
+	_, notCheckpointedTo, err := c.NotCheckpointedSegments()
+	if err != nil {
+		return fmt.Errorf("cannot get not checkpointed segments: %w", err)
+	}
+
+	latestCheckpoint, err := c.LatestCheckpoint()
+	if err != nil {
+		return fmt.Errorf("cannot get latest checkpoint: %w", err)
+	}
+
+	if latestCheckpoint == to {
+		return nil //nothing to do
+	}
+
+	if notCheckpointedTo < to {
+		return fmt.Errorf("no segments to checkpoint to %d, latests not checkpointed segment: %d", to, notCheckpointedTo)
+	}
+
+	if latestCheckpoint < 0 {
+		return c.Checkpoint(to, targetWriter)
+	}
+
+	version, _, err := readCheckpointHeader(path.Join(c.dir, NumberToFilename(latestCheckpoint)), c.wal.encryption)
+	if err != nil {
+		return fmt.Errorf("cannot read header of checkpoint %d: %w", latestCheckpoint, err)
+	}
+	if version != VersionV5 && version != VersionV6 {
+		c.wal.log.Info().Msgf("checkpoint %d (version %d) can't be the base of an incremental checkpoint, creating full checkpoint", latestCheckpoint, version)
+		return c.Checkpoint(to, targetWriter)
+	}
+
+	c.wal.log.Info().Msgf("creating incremental checkpoint %d on top of checkpoint %d", to, latestCheckpoint)
+
+	baseNodes, baseTries, err := loadCheckpointChain(c.dir, NumberToFilename(latestCheckpoint), &c.wal.log, c.wal.encryption)
+	if err != nil {
+		return fmt.Errorf("cannot load base checkpoint %d: %w", latestCheckpoint, err)
+	}
+
+	forest, err := mtrie.NewForest(c.forestCapacity, &metrics.NoopCollector{}, nil)
+	if err != nil {
+		return fmt.Errorf("cannot create Forest: %w", err)
+	}
+
+	err = forest.AddTries(baseTries)
+	if err != nil {
+		return fmt.Errorf("cannot add tries of base checkpoint: %w", err)
+	}
+
+	err = c.wal.replay(latestCheckpoint+1, to,
+		func(tries []*trie.MTrie) error {
+			return forest.AddTries(tries)
+		},
+		func(update *ledger.TrieUpdate) error {
+			_, err := forest.Update(update)
+			return err
+		}, func(rootHash ledger.RootHash) error {
+			return nil
+		}, false)
+
+	if err != nil {
+		return fmt.Errorf("cannot replay WAL: %w", err)
+	}
+
+	tries, err := forest.GetTries()
+	if err != nil {
+		return fmt.Errorf("cannot get forest tries: %w", err)
+	}
+
+	c.wal.log.Info().Msgf("serializing incremental checkpoint %d", to)
+
+	writer, err := targetWriter()
+	if err != nil {
+		return fmt.Errorf("cannot generate writer: %w", err)
+	}
+	defer func() {
+		closeErr := writer.Close()
+		// Return close error if there isn't any prior error to return.
+		if err == nil {
+			err = closeErr
+		}
+	}()
+
+	err = storeIncrementalCheckpoint(writer, latestCheckpoint, baseNodes, tries...)
+
+	c.wal.log.Info().Msgf("created incremental checkpoint %d with %d tries", to, len(tries))
+
+	return err
+}
+
+// CheckpointChain returns the numbers of the checkpoints needed to load the given checkpoint, in
+// asc order: the full checkpoint followed by the incremental checkpoints up to the given checkpoint.
+func (c *Checkpointer) CheckpointChain(checkpoint int) ([]int, error) {
+	return checkpointChain(c.dir, checkpoint, c.wal.encryption)
+}
+
+// checkpointChain returns the numbers of the checkpoints in the given directory needed to
+// load the given checkpoint, in asc order.
+func checkpointChain(dir string, checkpoint int, encryption *Encryption) ([]int, error) {
+	chain := []int{checkpoint}
+	for {
+		version, base, err := readCheckpointHeader(path.Join(dir, NumberToFilename(chain[0])), encryption)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read header of checkpoint %d: %w", chain[0], err)
+		}
+		if version != VersionV6 {
+			return chain, nil
+		}
+		if base >= chain[0] {
+			return nil, fmt.Errorf("base checkpoint %d of incremental checkpoint %d is not an earlier checkpoint", base, chain[0])
+		}
+		chain = append([]int{base}, chain...)
+	}
+}
+
+// readCheckpointHeader returns the version of the given checkpoint file, and for incremental
+// checkpoints, the number of their base checkpoint (-1 otherwise).
+func readCheckpointHeader(filepath string, encryption *Encryption) (version uint16, base int, err error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return 0, -1, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+	defer file.Close()
+
+	f, err := OpenCheckpointFile(file, encryption)
+	if err != nil {
+		return 0, -1, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+
+	header := make([]byte, incrementalHeaderSize)
+	_, err = io.ReadFull(f, header[:headerSize])
+	if err != nil {
+		return 0, -1, fmt.Errorf("cannot read header: %w", err)
+	}
+
+	magicBytes := binary.BigEndian.Uint16(header)
+	version = binary.BigEndian.Uint16(header[encMagicSize:])
+	if magicBytes != MagicBytes {
+		return 0, -1, fmt.Errorf("unknown file format. Magic constant %x does not match expected %x", magicBytes, MagicBytes)
+	}
+	if version != VersionV6 {
+		return version, -1, nil
+	}
+
+	_, err = io.ReadFull(f, header[headerSize:])
+	if err != nil {
+		return 0, -1, fmt.Errorf("cannot read header: %w", err)
+	}
+	return version, int(binary.BigEndian.Uint64(header[headerSize:])), nil
+}
+
+// storeIncrementalCheckpoint writes the given tries to an incremental checkpoint on top of
+// the base checkpoint with the given number, which holds the given nodes (as returned by
+// loadCheckpointChain). Like StoreCheckpoint, it appends a CRC32 file checksum.
+// The incremental checkpoint file consists of:
+//   - a header with the number of the base checkpoint and its number of nodes (including the
+//     nodes of its own base checkpoints).
+//   - a list of encoded nodes which aren't in the base checkpoint. Nodes are indexed after the
+//     nodes of the base checkpoint, so that references to other nodes are by index, as in a
+//     full checkpoint.
+//   - a list of encoded tries, each referencing their respective root node by index.
+//   - the list of nodes of the base checkpoint referenced by the new nodes and tries, by index
+//     and hash, so that the references can be verified when loading the checkpoint.
+//
+// The nodes are listed in an order which satisfies Descendents-First-Relationship.
+func storeIncrementalCheckpoint(writer io.Writer, base int, baseNodes []*node.Node, tries ...*trie.MTrie) error {
+
+	crc32Writer := NewCRC32Writer(writer)
+
+	// Scratch buffer is used as temporary buffer that node can encode into.
+	// Data in scratch buffer should be copied or used before scratch buffer is used again.
+	// If the scratch buffer isn't large enough, a new buffer will be allocated.
+	scratch := make([]byte, 1024*4)
+
+	baseNodeCount := uint64(len(baseNodes) - 1) // -1 to account for 0 node meaning nil
+
+	// Write header: magic (2 bytes) + version (2 bytes) + base checkpoint (8 bytes) + base node count (8 bytes)
+	header := scratch[:incrementalHeaderSize]
+	binary.BigEndian.PutUint16(header, MagicBytes)
+	binary.BigEndian.PutUint16(header[encMagicSize:], VersionV6)
+	binary.BigEndian.PutUint64(header[headerSize:], uint64(base))
+	binary.BigEndian.PutUint64(header[headerSize+encCheckpointNumberSize:], baseNodeCount)
+
+	_, err := crc32Writer.Write(header)
+	if err != nil {
+		return fmt.Errorf("cannot write checkpoint header: %w", err)
+	}
+
+	// allNodes contains all nodes of the base checkpoint and all unique nodes of given
+	// tries, and their index. Index 0 is a special case with nil node.
+	allNodes := make(map[*node.Node]uint64, len(baseNodes))
+	for i, n := range baseNodes {
+		allNodes[n] = uint64(i)
+	}
+	allNodes[nil] = 0
+
+	// baseRefs are the indexes of the referenced nodes of the base checkpoint
+	baseRefs := make(map[uint64]struct{})
+	index := func(n *node.Node) (uint64, error) {
+		i, found := allNodes[n]
+		if !found {
+			hash := n.Hash()
+			return 0, fmt.Errorf("internal error: missing node with hash %s", hex.EncodeToString(hash[:]))
+		}
+		if i > 0 && i <= baseNodeCount {
+			baseRefs[i] = struct{}{}
+		}
+		return i, nil
+	}
+
+	// Serialize all unique nodes which aren't in the base checkpoint
+	nodeCounter := baseNodeCount + 1
+	for _, t := range tries {
+
+		itr := flattener.NewUniqueNodeIterator(t, allNodes)
+		for itr.Next() {
+			n := itr.Value()
+			if n == nil {
+				// the root node of the trie was already visited
+				continue
+			}
+
+			allNodes[n] = nodeCounter
+			nodeCounter++
+
+			lchild, rchild := itr.Children()
+			lchildIndex, err := index(lchild)
+			if err != nil {
+				return err
+			}
+			rchildIndex, err := index(rchild)
+			if err != nil {
+				return err
+			}
+
+			encNode, err := flattener.EncodeNode(n, lchildIndex, rchildIndex, scratch)
+			if err != nil {
+				return fmt.Errorf("cannot encode node: %w", err)
+			}
+			_, err = crc32Writer.Write(encNode)
+			if err != nil {
+				return fmt.Errorf("cannot serialize node: %w", err)
+			}
+		}
+		if err := itr.Err(); err != nil {
+			return fmt.Errorf("cannot traverse trie: %w", err)
+		}
+	}
+
+	// Serialize trie root nodes
+	for _, t := range tries {
+		rootIndex, err := index(t.RootNode())
+		if err != nil {
+			return err
+		}
+
+		encTrie := flattener.EncodeTrie(t, rootIndex, scratch)
+		_, err = crc32Writer.Write(encTrie)
+		if err != nil {
+			return fmt.Errorf("cannot serialize trie: %w", err)
+		}
+	}
+
+	// Serialize references to nodes of the base checkpoint
+	refs := make([]uint64, 0, len(baseRefs))
+	for i := range baseRefs {
+		refs = append(refs, i)
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i] < refs[j] })
+
+	for _, i := range refs {
+		encRef := scratch[:encBaseRefSize]
+		binary.BigEndian.PutUint64(encRef, i)
+		h := baseNodes[i].Hash()
+		copy(encRef[encNodeCountSize:], h[:])
+
+		_, err = crc32Writer.Write(encRef)
+		if err != nil {
+			return fmt.Errorf("cannot serialize base node reference: %w", err)
+		}
+	}
+
+	// Write footer with nodes count, tries count and base node references count
+	footer := scratch[:encNodeCountSize+encTrieCountSize+encBaseRefCountSize]
+	binary.BigEndian.PutUint64(footer, nodeCounter-baseNodeCount-1)
+	binary.BigEndian.PutUint16(footer[encNodeCountSize:], uint16(len(tries)))
+	binary.BigEndian.PutUint64(footer[encNodeCountSize+encTrieCountSize:], uint64(len(refs)))
+
+	_, err = crc32Writer.Write(footer)
+	if err != nil {
+		return fmt.Errorf("cannot write checkpoint footer: %w", err)
+	}
+
+	// Write CRC32 sum
+	crc32buf := scratch[:crc32SumSize]
+	binary.BigEndian.PutUint32(crc32buf, crc32Writer.Crc32())
+
+	_, err = writer.Write(crc32buf)
+	if err != nil {
+		return fmt.Errorf("cannot write CRC32: %w", err)
+	}
+
+	return nil
+}
+
+// loadCheckpointChain loads the given checkpoint file in the given directory, on top of its base
+// checkpoints if it's an incremental checkpoint. It returns all nodes of the chain of checkpoints,
+// by index (index 0 is nil), and the tries of the given checkpoint.
+func loadCheckpointChain(dir string, filename string, logger *zerolog.Logger, encryption *Encryption) ([]*node.Node, []*trie.MTrie, error) {
+	filepath := path.Join(dir, filename)
+
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+	defer func() {
+		_ = file.Close()
+
+		_ = requestDropFromOSFileCache(filepath, logger)
+	}()
+
+	f, err := OpenCheckpointFile(file, encryption)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+
+	header := make([]byte, headerSize)
+	_, err = io.ReadFull(f, header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read header: %w", err)
+	}
+	magicBytes := binary.BigEndian.Uint16(header)
+	version := binary.BigEndian.Uint16(header[encMagicSize:])
+	if magicBytes != MagicBytes {
+		return nil, nil, fmt.Errorf("unknown file format. Magic constant %x does not match expected %x", magicBytes, MagicBytes)
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot seek to start of file: %w", err)
+	}
+
+	switch version {
+	case VersionV5:
+		return readCheckpointV5WithNodes(f)
+	case VersionV6:
+		return readCheckpointV6(f, func(base int) ([]*node.Node, error) {
+			nodes, _, err := loadCheckpointChain(dir, NumberToFilename(base), logger, encryption)
+			if err != nil {
+				return nil, fmt.Errorf("cannot load base checkpoint %d: %w", base, err)
+			}
+			return nodes, nil
+		})
+	default:
+		return nil, nil, fmt.Errorf("checkpoint file %s of version %x can't be the base of an incremental checkpoint", filepath, version)
+	}
+}
+
+// readCheckpointV6 decodes incremental checkpoint file (version 6) on top of the nodes of
+// its base checkpoint, loaded with loadBase. It returns all nodes of the chain of checkpoints,
+// by index (index 0 is nil), and the list of tries.
+// Checkpoint file header (magic and version) are verified by the caller.
+func readCheckpointV6(f io.ReadSeeker, loadBase func(base int) ([]*node.Node, error)) ([]*node.Node, []*trie.MTrie, error) {
+
+	// Scratch buffer is used as temporary buffer that reader can read into.
+	scratch := make([]byte, 1024*4) // must not be less than 1024
+
+	// footer offset: nodes count (8 bytes) + tries count (2 bytes) + base refs count (8 bytes) + CRC32 sum (4 bytes)
+	const footerOffset = encNodeCountSize + encTrieCountSize + encBaseRefCountSize + crc32SumSize
+	const footerSize = encNodeCountSize + encTrieCountSize + encBaseRefCountSize // footer doesn't include crc32 sum
+
+	_, err := f.Seek(-footerOffset, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot seek to footer: %w", err)
+	}
+
+	footer := scratch[:footerSize]
+	_, err = io.ReadFull(f, footer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read footer: %w", err)
+	}
+
+	nodesCount := binary.BigEndian.Uint64(footer)
+	triesCount := binary.BigEndian.Uint16(footer[encNodeCountSize:])
+	refsCount := binary.BigEndian.Uint64(footer[encNodeCountSize+encTrieCountSize:])
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot seek to start of file: %w", err)
+	}
+
+	var bufReader io.Reader = bufio.NewReaderSize(f, defaultBufioReadSize)
+	crcReader := NewCRC32Reader(bufReader)
+	var reader io.Reader = crcReader
+
+	header := scratch[:incrementalHeaderSize]
+	_, err = io.ReadFull(reader, header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read header: %w", err)
+	}
+	base := int(binary.BigEndian.Uint64(header[headerSize:]))
+	baseNodeCount := binary.BigEndian.Uint64(header[headerSize+encCheckpointNumberSize:])
+
+	baseNodes, err := loadBase(base)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(baseNodes)-1) != baseNodeCount {
+		return nil, nil, fmt.Errorf("base checkpoint %d has %d nodes, but %d are expected", base, len(baseNodes)-1, baseNodeCount)
+	}
+
+	// nodes's element at index 0 is a special, meaning nil.
+	nodes := make([]*node.Node, baseNodeCount+nodesCount+1)
+	copy(nodes, baseNodes)
+	tries := make([]*trie.MTrie, triesCount)
+
+	// baseRefs are the referenced nodes of the base checkpoint, which must be verified
+	baseRefs := make(map[uint64]struct{})
+	getNode := func(nodeIndex uint64) (*node.Node, error) {
+		if nodeIndex >= uint64(len(nodes)) {
+			return nil, fmt.Errorf("sequence of stored nodes doesn't contain node")
+		}
+		if nodeIndex > 0 && nodeIndex <= baseNodeCount {
+			baseRefs[nodeIndex] = struct{}{}
+		}
+		return nodes[nodeIndex], nil
+	}
+
+	for i := baseNodeCount + 1; i <= baseNodeCount+nodesCount; i++ {
+		n, err := flattener.ReadNode(reader, scratch, func(nodeIndex uint64) (*node.Node, error) {
+			if nodeIndex >= i {
+				return nil, fmt.Errorf("sequence of serialized nodes does not satisfy Descendents-First-Relationship")
+			}
+			return getNode(nodeIndex)
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot read node %d: %w", i, err)
+		}
+		nodes[i] = n
+	}
+
+	for i := uint16(0); i < triesCount; i++ {
+		trie, err := flattener.ReadTrie(reader, scratch, getNode)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot read trie %d: %w", i, err)
+		}
+		tries[i] = trie
+	}
+
+	// Verify the references to nodes of the base checkpoint
+	for i := uint64(0); i < refsCount; i++ {
+		encRef := scratch[:encBaseRefSize]
+		_, err = io.ReadFull(reader, encRef)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot read base node reference %d: %w", i, err)
+		}
+		nodeIndex := binary.BigEndian.Uint64(encRef)
+		if nodeIndex == 0 || nodeIndex > baseNodeCount {
+			return nil, nil, fmt.Errorf("base node reference %d to node %d is not in the base checkpoint", i, nodeIndex)
+		}
+		h := nodes[nodeIndex].Hash()
+		if !bytes.Equal(h[:], encRef[encNodeCountSize:]) {
+			return nil, nil, fmt.Errorf("base node %d has hash %x, but %x is referenced", nodeIndex, h, encRef[encNodeCountSize:])
+		}
+		delete(baseRefs, nodeIndex)
+	}
+	if len(baseRefs) > 0 {
+		return nil, nil, fmt.Errorf("%d nodes of the base checkpoint are referenced without hash", len(baseRefs))
+	}
+
+	// Read footer again for crc32 computation
+	_, err = io.ReadFull(reader, footer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read footer: %w", err)
+	}
+
+	crc32buf := scratch[:crc32SumSize]
+	_, err = io.ReadFull(bufReader, crc32buf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read CRC32: %w", err)
+	}
+
+	readCrc32 := binary.BigEndian.Uint32(crc32buf)
+
+	calculatedCrc32 := crcReader.Crc32()
+
+	if calculatedCrc32 != readCrc32 {
+		return nil, nil, fmt.Errorf("checkpoint checksum failed! File contains %x but calculated crc32 is %x", readCrc32, calculatedCrc32)
+	}
+
+	return nodes, tries, nil
+}
@@ -0,0 +1,227 @@
+package wal
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	prometheusWAL "github.com/m4ksio/wal/wal"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/bootstrap"
+	utilsio "github.com/onflow/flow-go/utils/io"
+)
+
+// walPageSize is the page size of the WAL, segment sizes must be a multiple of it.
+const walPageSize = 32 * 1024
+
+// Reencrypt re-encrypts all WAL segments and checkpoint files in the given
+// directory. Data encrypted with any key of `from`, as well as plaintext data,
+// is encrypted with the current key of `to`. If `to` is nil, the data is
+// decrypted. `from` can be nil if the data is not encrypted. Records are
+// renumbered consecutively, starting at the first record of the first segment.
+//
+// The WAL must not be in use. Files are replaced one by one, so the directory
+// should be backed up first: if re-encryption fails, the directory can contain
+// files encrypted with either key, which are readable with an Encryption
+// having both keys.
+func Reencrypt(logger zerolog.Logger, dir string, from, to *Encryption) error {
+
+	checkpoints, _, err := listCheckpoints(dir)
+	if err != nil {
+		return fmt.Errorf("cannot list checkpoints: %w", err)
+	}
+	filenames := make([]string, 0, len(checkpoints)+1)
+	for _, checkpoint := range checkpoints {
+		filenames = append(filenames, NumberToFilename(checkpoint))
+	}
+	if utilsio.FileExists(path.Join(dir, bootstrap.FilenameWALRootCheckpoint)) {
+		filenames = append(filenames, bootstrap.FilenameWALRootCheckpoint)
+	}
+
+	for _, filename := range filenames {
+		logger.Info().Str("checkpoint", filename).Msg("re-encrypting checkpoint")
+		err = ReencryptCheckpoint(logger, dir, filename, from, to)
+		if err != nil {
+			return fmt.Errorf("cannot re-encrypt checkpoint %s: %w", filename, err)
+		}
+	}
+
+	err = ReencryptSegments(logger, dir, from, to)
+	if err != nil {
+		return fmt.Errorf("cannot re-encrypt segments: %w", err)
+	}
+
+	return nil
+}
+
+// ReencryptCheckpoint re-encrypts a single checkpoint file, see Reencrypt.
+func ReencryptCheckpoint(logger zerolog.Logger, dir string, filename string, from, to *Encryption) error {
+
+	filepath := path.Join(dir, filename)
+	file, err := os.Open(filepath)
+	if err != nil {
+		return fmt.Errorf("cannot open checkpoint file: %w", err)
+	}
+	defer file.Close()
+
+	reader, err := openCheckpointFile(file, from, true)
+	if err != nil {
+		return fmt.Errorf("cannot read checkpoint file: %w", err)
+	}
+
+	// remove leftovers of a previously failed re-encryption
+	tmpName := filename + ".reencrypt"
+	err = os.RemoveAll(path.Join(dir, tmpName))
+	if err != nil {
+		return fmt.Errorf("cannot remove temporary file: %w", err)
+	}
+
+	writer, err := CreateEncryptedCheckpointWriterForFile(dir, tmpName, &logger, to)
+	if err != nil {
+		return fmt.Errorf("cannot create checkpoint writer: %w", err)
+	}
+
+	// hide ReadFrom of the buffered writer, so that write errors are tracked
+	_, err = io.Copy(struct{ io.Writer }{writer}, reader)
+	if err != nil {
+		_ = writer.Close()
+		return fmt.Errorf("cannot copy checkpoint: %w", err)
+	}
+	err = writer.Close()
+	if err != nil {
+		return fmt.Errorf("cannot close checkpoint writer: %w", err)
+	}
+
+	err = os.Rename(path.Join(dir, tmpName), filepath)
+	if err != nil {
+		return fmt.Errorf("cannot replace checkpoint file: %w", err)
+	}
+	return nil
+}
+
+// ReencryptSegments re-encrypts all WAL segments in the given directory, see
+// Reencrypt. Segment numbers are preserved, so that checkpoints still refer to
+// the correct segments.
+func ReencryptSegments(logger zerolog.Logger, dir string, from, to *Encryption) error {
+
+	first, last, err := prometheusWAL.Segments(dir)
+	if err != nil {
+		return fmt.Errorf("cannot list segments: %w", err)
+	}
+	if first < 0 {
+		return nil
+	}
+
+	// re-encrypted records can be larger than the original ones, so the new
+	// segments must be large enough to hold all records of an original segment
+	maxSize := int64(0)
+	for i := first; i <= last; i++ {
+		info, err := os.Stat(prometheusWAL.SegmentName(dir, i))
+		if err != nil {
+			return fmt.Errorf("cannot get size of segment %d: %w", i, err)
+		}
+		if info.Size() > maxSize {
+			maxSize = info.Size()
+		}
+	}
+	segmentSize := int((3*maxSize/walPageSize + 1) * walPageSize)
+
+	tmpDir, err := os.MkdirTemp(dir, "reencrypt-wal-*")
+	if err != nil {
+		return fmt.Errorf("cannot create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// the new WAL starts writing at the segment after the last existing one
+	if first > 0 {
+		placeholder, err := prometheusWAL.CreateSegment(tmpDir, first-1)
+		if err != nil {
+			return fmt.Errorf("cannot create placeholder segment: %w", err)
+		}
+		err = placeholder.Close()
+		if err != nil {
+			return fmt.Errorf("cannot close placeholder segment: %w", err)
+		}
+	}
+
+	w, err := prometheusWAL.NewSize(logger, nil, tmpDir, segmentSize, false)
+	if err != nil {
+		return fmt.Errorf("cannot create WAL: %w", err)
+	}
+
+	decryptor := newRecordDecryptor(from)
+	decryptor.allowPlaintext = true
+	sequence := uint64(0)
+	for i := first; i <= last; i++ {
+		logger.Info().Int("segment", i).Msg("re-encrypting segment")
+
+		err = reencryptSegment(w, dir, i, decryptor, to, &sequence)
+		if err != nil {
+			_ = w.Close()
+			return fmt.Errorf("cannot re-encrypt segment %d: %w", i, err)
+		}
+		if i < last {
+			err = w.NextSegment()
+			if err != nil {
+				_ = w.Close()
+				return fmt.Errorf("cannot start segment %d: %w", i+1, err)
+			}
+		}
+	}
+
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("cannot close WAL: %w", err)
+	}
+
+	for i := first; i <= last; i++ {
+		err = os.Rename(prometheusWAL.SegmentName(tmpDir, i), prometheusWAL.SegmentName(dir, i))
+		if err != nil {
+			return fmt.Errorf("cannot replace segment %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// reencryptSegment re-encrypts the records of a single segment, and writes them
+// to w. The sequence number of the next record is updated for each record.
+func reencryptSegment(w *prometheusWAL.WAL, dir string, segment int, decryptor *recordDecryptor, to *Encryption, sequence *uint64) error {
+
+	sr, err := prometheusWAL.NewSegmentsRangeReader(prometheusWAL.SegmentRange{
+		Dir:   dir,
+		First: segment,
+		Last:  segment,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot create segment reader: %w", err)
+	}
+	defer sr.Close()
+
+	reader := prometheusWAL.NewReader(sr)
+	for reader.Next() {
+		record, err := decryptor.decrypt(reader.Record())
+		if err != nil {
+			return fmt.Errorf("cannot decrypt record: %w", err)
+		}
+		if to != nil {
+			record, err = to.EncryptRecord(record, *sequence)
+			if err != nil {
+				return fmt.Errorf("cannot encrypt record: %w", err)
+			}
+		}
+		_, err = w.Log(record)
+		if err != nil {
+			return fmt.Errorf("cannot write record: %w", err)
+		}
+		*sequence++
+	}
+
+	err = reader.Err()
+	if err != nil {
+		return fmt.Errorf("cannot read segment: %w", err)
+	}
+	return nil
+}
@@ -24,7 +24,8 @@ type SyncOnCloseRenameFile struct {
 	logger     *zerolog.Logger
 	file       *os.File
 	targetName string
-	savedError error // savedError is the first error returned from Write.  Close() renames temp file to target file only if savedError is nil.
+	savedError error     // savedError is the first error returned from Write.  Close() renames temp file to target file only if savedError is nil.
+	encrypted  io.Closer // encrypted writes the last chunk of an encrypted file on Close, nil if the file isn't encrypted
 	*bufio.Writer
 }
 
@@ -48,6 +49,14 @@ func (s *SyncOnCloseRenameFile) Close() error {
 		return fmt.Errorf("cannot flush buffer: %w", err)
 	}
 
+	if s.encrypted != nil {
+		err = s.encrypted.Close()
+		if err != nil {
+			_ = s.closeOnError()
+			return fmt.Errorf("cannot finish encrypted file %s: %w", s.file.Name(), err)
+		}
+	}
+
 	err = s.file.Sync()
 	if err != nil {
 		return fmt.Errorf("cannot sync file %s: %w", s.file.Name(), err)
@@ -0,0 +1,181 @@
+package wal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	prometheusWAL "github.com/m4ksio/wal/wal"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger"
+)
+
+// RecordPosition is the position of a WAL record: the segment holding it and
+// the offset in the segment right after the record.
+type RecordPosition struct {
+	Segment int
+	Offset  int64
+}
+
+// SegmentRecord is a decoded WAL record.
+type SegmentRecord struct {
+	RecordPosition
+	Operation WALOperation
+	Encrypted bool
+	// RootHash is the root hash of the trie removed by a delete, or the root
+	// hash of the trie an update is applied to.
+	RootHash ledger.RootHash
+	// Update is nil for deletes.
+	Update *ledger.TrieUpdate
+}
+
+// SegmentsVerification is the result of verifying WAL segments.
+type SegmentsVerification struct {
+	// First and Last are the verified segments, -1 if there are none.
+	First int
+	Last  int
+	// Records is the number of valid records.
+	Records int
+	// LastValid is the position of the last valid record, nil if there is none.
+	LastValid *RecordPosition
+	// Corruption is the first corruption found, nil if all records are valid.
+	// All records from the corruption onwards are discarded by RepairSegments.
+	Corruption *prometheusWAL.CorruptionErr
+}
+
+// VerifySegments reads the WAL segments from `from` to `to` in the given
+// directory (-1 for an open range) and checks the checksums and the encoding
+// of all records. Every valid record is passed to fn, the record (including
+// its update) is only valid until fn returns. Reading stops at the
+// first corrupted record, which is reported in the result rather than as an
+// error, including encrypted records which are out of order. Records encrypted
+// with a key missing in `encryption` are not treated as corrupted: an error
+// wrapping ErrEncrypted is returned instead. Similarly, plaintext records read
+// with an encryption result in an error wrapping ErrPlaintext.
+func VerifySegments(dir string, from, to int, encryption *Encryption, fn func(record *SegmentRecord) error) (*SegmentsVerification, error) {
+
+	first, last, err := prometheusWAL.Segments(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list segments: %w", err)
+	}
+	if from >= 0 && from > first {
+		first = from
+	}
+	if to >= 0 && to < last {
+		last = to
+	}
+	result := &SegmentsVerification{First: first, Last: last}
+	if first < 0 || first > last {
+		result.First, result.Last = -1, -1
+		return result, nil
+	}
+
+	sr, err := prometheusWAL.NewSegmentsRangeReader(prometheusWAL.SegmentRange{
+		Dir:   dir,
+		First: first,
+		Last:  last,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create segment reader: %w", err)
+	}
+	defer sr.Close()
+
+	decryptor := newRecordDecryptor(encryption)
+	reader := prometheusWAL.NewReader(sr)
+	for reader.Next() {
+		position := RecordPosition{Segment: reader.Segment(), Offset: reader.Offset()}
+
+		encrypted := len(reader.Record()) > 0 && WALOperation(reader.Record()[0]) == WALEncrypted
+		record, err := decryptor.decrypt(reader.Record())
+		if errors.Is(err, ErrEncrypted) || errors.Is(err, ErrPlaintext) {
+			return nil, fmt.Errorf("cannot decrypt record in segment %d: %w", position.Segment, err)
+		}
+		var operation WALOperation
+		var rootHash ledger.RootHash
+		var update *ledger.TrieUpdate
+		if err == nil {
+			operation, rootHash, update, err = Decode(record)
+		}
+		if update != nil {
+			rootHash = update.RootHash
+		}
+		if err != nil {
+			// the record is discarded on repair, as the checksum of the
+			// record is valid, but its content is not
+			result.Corruption = &prometheusWAL.CorruptionErr{
+				Dir:     dir,
+				Segment: position.Segment,
+				Offset:  position.Offset,
+				Err:     fmt.Errorf("invalid record: %w", err),
+			}
+			return result, nil
+		}
+
+		err = fn(&SegmentRecord{
+			RecordPosition: position,
+			Operation:      operation,
+			Encrypted:      encrypted,
+			RootHash:       rootHash,
+			Update:         update,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while processing record: %w", err)
+		}
+
+		result.Records++
+		result.LastValid = &position
+	}
+
+	err = reader.Err()
+	if err != nil {
+		var corruption *prometheusWAL.CorruptionErr
+		if !errors.As(err, &corruption) || corruption.Segment < 0 {
+			return nil, fmt.Errorf("cannot read segments: %w", err)
+		}
+		result.Corruption = corruption
+	}
+
+	return result, nil
+}
+
+// RepairSegments truncates the WAL in the given directory right before the
+// corrupted record found by VerifySegments, and removes all later segments.
+// Afterwards, the WAL only contains the valid records up to
+// verification.LastValid, followed by an empty segment.
+//
+// The WAL must not be in use, and the directory should be backed up first.
+func RepairSegments(logger zerolog.Logger, dir string, verification *SegmentsVerification) error {
+
+	if verification.Corruption == nil {
+		return nil
+	}
+
+	// the corrupted segment is rewritten, so new segments must be large enough
+	// to hold all its records
+	info, err := os.Stat(prometheusWAL.SegmentName(dir, verification.Corruption.Segment))
+	if err != nil {
+		return fmt.Errorf("cannot get size of corrupted segment: %w", err)
+	}
+	segmentSize := SegmentSize
+	if size := int((info.Size()/walPageSize + 1) * walPageSize); size > segmentSize {
+		segmentSize = size
+	}
+
+	w, err := prometheusWAL.NewSize(logger, nil, dir, segmentSize, false)
+	if err != nil {
+		return fmt.Errorf("cannot open WAL: %w", err)
+	}
+
+	err = w.Repair(verification.Corruption)
+	if err != nil {
+		_ = w.Close()
+		return fmt.Errorf("cannot repair WAL: %w", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("cannot close WAL: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package wal_test
+
+import (
+	"os"
+	"testing"
+
+	prometheusWAL "github.com/m4ksio/wal/wal"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/ledger/complete/mtrie"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	realWAL "github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// Test_VerifyAndRepairSegments checks that corrupted records are detected,
+// and that repairing the WAL keeps exactly the records before the corruption.
+func Test_VerifyAndRepairSegments(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+
+		f, err := mtrie.NewForest(size*10, metricsCollector, nil)
+		require.NoError(t, err)
+		rootHash := f.GetEmptyRootHash()
+
+		wal, err := realWAL.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize)
+		require.NoError(t, err)
+		var rootHashes []ledger.RootHash
+		for i := 0; i < size; i++ {
+			keys := utils.RandomUniqueKeys(numInsPerStep, keyNumberOfParts, 1600, 1600)
+			values := utils.RandomValues(numInsPerStep, valueMaxByteSize/2, valueMaxByteSize)
+			update, err := ledger.NewUpdate(ledger.State(rootHash), keys, values)
+			require.NoError(t, err)
+			trieUpdate, err := pathfinder.UpdateToTrieUpdate(update, pathFinderVersion)
+			require.NoError(t, err)
+
+			require.NoError(t, wal.RecordUpdate(trieUpdate))
+			rootHashes = append(rootHashes, rootHash)
+			rootHash, err = f.Update(trieUpdate)
+			require.NoError(t, err)
+		}
+		require.NoError(t, wal.RecordDelete(rootHash))
+		rootHashes = append(rootHashes, rootHash)
+		<-wal.Done()
+
+		// verify returns the verification and the records, without their
+		// updates, as those are only valid while processing the record
+		verify := func(t *testing.T) (*realWAL.SegmentsVerification, []realWAL.SegmentRecord) {
+			var records []realWAL.SegmentRecord
+			verification, err := realWAL.VerifySegments(dir, -1, -1, nil, func(record *realWAL.SegmentRecord) error {
+				if record.Operation == realWAL.WALUpdate {
+					assert.Len(t, record.Update.Paths, numInsPerStep)
+				}
+				r := *record
+				r.Update = nil
+				records = append(records, r)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, verification.Records, len(records))
+			return verification, records
+		}
+
+		verification, records := verify(t)
+		require.Nil(t, verification.Corruption)
+		require.Len(t, records, size+1)
+		for i, record := range records {
+			assert.Equal(t, rootHashes[i], record.RootHash)
+			if i < size {
+				assert.Equal(t, realWAL.WALUpdate, record.Operation)
+			} else {
+				assert.Equal(t, realWAL.WALDelete, record.Operation)
+			}
+		}
+		require.Equal(t, records[size].RecordPosition, *verification.LastValid)
+		require.Greater(t, verification.LastValid.Segment, 0)
+
+		t.Run("invalid record", func(t *testing.T) {
+			// the checksum of the record is valid, but the operation is unknown
+			w, err := prometheusWAL.NewSize(zerolog.Nop(), nil, dir, segmentSize, false)
+			require.NoError(t, err)
+			_, err = w.Log([]byte{0xff, 0, 0, 0})
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			verification, records := verify(t)
+			require.NotNil(t, verification.Corruption)
+			require.Len(t, records, size+1)
+
+			require.NoError(t, realWAL.RepairSegments(zerolog.Nop(), dir, verification))
+
+			repaired, repairedRecords := verify(t)
+			require.Nil(t, repaired.Corruption)
+			require.Equal(t, records, repairedRecords)
+		})
+
+		t.Run("corrupted record", func(t *testing.T) {
+			// corrupt the last byte of the record in the middle of the WAL
+			corrupted := records[size/2]
+			name := prometheusWAL.SegmentName(dir, corrupted.Segment)
+			data, err := os.ReadFile(name)
+			require.NoError(t, err)
+			data[corrupted.Offset-1] ^= 0xff
+			require.NoError(t, os.WriteFile(name, data, 0644))
+
+			verification, valid := verify(t)
+			require.NotNil(t, verification.Corruption)
+			require.Equal(t, corrupted.Segment, verification.Corruption.Segment)
+			require.Equal(t, records[:size/2], valid)
+			require.Equal(t, records[size/2-1].RecordPosition, *verification.LastValid)
+
+			require.NoError(t, realWAL.RepairSegments(zerolog.Nop(), dir, verification))
+
+			repaired, repairedRecords := verify(t)
+			require.Nil(t, repaired.Corruption)
+			require.Equal(t, valid, repairedRecords)
+
+			// the repaired WAL can be replayed
+			w, err := realWAL.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), dir, size*10, pathByteSize, segmentSize)
+			require.NoError(t, err)
+			defer func() { <-w.Done() }()
+
+			updates := 0
+			err = w.ReplayLogsOnly(
+				func(tries []*trie.MTrie) error { return nil },
+				func(update *ledger.TrieUpdate) error {
+					updates++
+					return nil
+				},
+				func(rootHash ledger.RootHash) error { return nil },
+			)
+			require.NoError(t, err)
+			require.Equal(t, size/2, updates)
+		})
+	})
+}
@@ -3,6 +3,7 @@ package wal
 import (
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	prometheusWAL "github.com/m4ksio/wal/wal"
@@ -28,14 +29,36 @@ type DiskWAL struct {
 	diskUpdateLimiter *time.Ticker
 	metrics           module.WALMetrics
 	dir               string
+	// encryption is used to encrypt records and checkpoints, nil if disabled
+	encryption *Encryption
+	// sequenceLock guards sequence, and keeps encrypted records in the order of their sequence numbers
+	sequenceLock sync.Mutex
+	// sequence is the sequence number of the next encrypted record
+	sequence uint64
 }
 
 // TODO use real logger and metrics, but that would require passing them to Trie storage
 func NewDiskWAL(logger zerolog.Logger, reg prometheus.Registerer, metrics module.WALMetrics, dir string, forestCapacity int, pathByteSize int, segmentSize int) (*DiskWAL, error) {
+	return NewEncryptedDiskWAL(logger, reg, metrics, dir, forestCapacity, pathByteSize, segmentSize, nil)
+}
+
+// NewEncryptedDiskWAL creates a DiskWAL which encrypts new records and checkpoints
+// with the given encryption. Plaintext records and checkpoints are rejected when
+// reading an encrypted WAL, existing plaintext data must be encrypted with
+// Reencrypt first. If encryption is nil, the WAL is not encrypted.
+func NewEncryptedDiskWAL(logger zerolog.Logger, reg prometheus.Registerer, metrics module.WALMetrics, dir string, forestCapacity int, pathByteSize int, segmentSize int, encryption *Encryption) (*DiskWAL, error) {
 	w, err := prometheusWAL.NewSize(logger, reg, dir, segmentSize, false)
 	if err != nil {
 		return nil, err
 	}
+	sequence := uint64(0)
+	if encryption != nil {
+		sequence, err = nextRecordSequence(dir)
+		if err != nil {
+			_ = w.Close()
+			return nil, fmt.Errorf("cannot determine sequence number of next record: %w", err)
+		}
+	}
 	return &DiskWAL{
 		wal:               w,
 		paused:            false,
@@ -45,9 +68,60 @@ func NewDiskWAL(logger zerolog.Logger, reg prometheus.Registerer, metrics module
 		diskUpdateLimiter: time.NewTicker(5 * time.Second),
 		metrics:           metrics,
 		dir:               dir,
+		encryption:        encryption,
+		sequence:          sequence,
 	}, nil
 }
 
+// nextRecordSequence returns the sequence number following the one of the last
+// encrypted record in the WAL, or zero if there is none.
+func nextRecordSequence(dir string) (uint64, error) {
+	first, last, err := prometheusWAL.Segments(dir)
+	if err != nil {
+		return 0, fmt.Errorf("cannot list segments: %w", err)
+	}
+	if first < 0 {
+		return 0, nil
+	}
+
+	// the last segments can be empty, e.g. the segment created when opening the WAL
+	for segment := last; segment >= first; segment-- {
+		sr, err := prometheusWAL.NewSegmentsRangeReader(prometheusWAL.SegmentRange{
+			Dir:   dir,
+			First: segment,
+			Last:  segment,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("cannot create segment reader: %w", err)
+		}
+
+		// corrupted records at the end of the segment are reported when replaying
+		// the WAL, so the sequence number continues after the last valid record
+		found := false
+		var sequence uint64
+		reader := prometheusWAL.NewReader(sr)
+		for reader.Next() {
+			if !isEncryptedRecord(reader.Record()) {
+				continue
+			}
+			_, recordSequence, err := recordHeader(reader.Record())
+			if err != nil {
+				continue
+			}
+			found = true
+			sequence = recordSequence
+		}
+		err = sr.Close()
+		if err != nil {
+			return 0, fmt.Errorf("cannot close segment reader: %w", err)
+		}
+		if found {
+			return sequence + 1, nil
+		}
+	}
+	return 0, nil
+}
+
 func (w *DiskWAL) PauseRecord() {
 	w.paused = true
 }
@@ -61,10 +135,7 @@ func (w *DiskWAL) RecordUpdate(update *ledger.TrieUpdate) error {
 		return nil
 	}
 
-	bytes := EncodeUpdate(update)
-
-	_, err := w.wal.Log(bytes)
-
+	err := w.logRecord(EncodeUpdate(update))
 	if err != nil {
 		return fmt.Errorf("error while recording update in LedgerWAL: %w", err)
 	}
@@ -93,13 +164,33 @@ func (w *DiskWAL) RecordDelete(rootHash ledger.RootHash) error {
 		return nil
 	}
 
-	bytes := EncodeDelete(rootHash)
+	err := w.logRecord(EncodeDelete(rootHash))
+	if err != nil {
+		return fmt.Errorf("error while recording delete in LedgerWAL: %w", err)
+	}
+	return nil
+}
+
+// logRecord writes the encoded record to the WAL, encrypting it with the next
+// sequence number if encryption is enabled.
+func (w *DiskWAL) logRecord(record []byte) error {
+	if w.encryption == nil {
+		_, err := w.wal.Log(record)
+		return err
+	}
 
-	_, err := w.wal.Log(bytes)
+	w.sequenceLock.Lock()
+	defer w.sequenceLock.Unlock()
 
+	encrypted, err := w.encryption.EncryptRecord(record, w.sequence)
 	if err != nil {
-		return fmt.Errorf("error while recording delete in LedgerWAL: %w", err)
+		return fmt.Errorf("cannot encrypt record: %w", err)
 	}
+	_, err = w.wal.Log(encrypted)
+	if err != nil {
+		return err
+	}
+	w.sequence++
 	return nil
 }
 
@@ -251,11 +342,15 @@ func (w *DiskWAL) replay(
 	}
 
 	reader := prometheusWAL.NewReader(sr)
+	decryptor := newRecordDecryptor(w.encryption)
 
 	defer sr.Close()
 
 	for reader.Next() {
-		record := reader.Record()
+		record, err := decryptor.decrypt(reader.Record())
+		if err != nil {
+			return fmt.Errorf("cannot decrypt LedgerWAL record: %w", err)
+		}
 		operation, rootHash, update, err := Decode(record)
 		if err != nil {
 			return fmt.Errorf("cannot decode LedgerWAL record: %w", err)
@@ -261,6 +261,40 @@ func EmptyPayload() *Payload {
 	return &Payload{}
 }
 
+// PayloadChangeType describes how the payload of a register differs between two tries.
+type PayloadChangeType int
+
+const (
+	// PayloadAdded registers are only allocated in the newer trie.
+	PayloadAdded PayloadChangeType = iota + 1
+	// PayloadRemoved registers are only allocated in the older trie.
+	PayloadRemoved
+	// PayloadUpdated registers are allocated in both tries, with different payloads.
+	PayloadUpdated
+)
+
+func (t PayloadChangeType) String() string {
+	switch t {
+	case PayloadAdded:
+		return "added"
+	case PayloadRemoved:
+		return "removed"
+	case PayloadUpdated:
+		return "updated"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(t))
+	}
+}
+
+// PayloadChange describes a register whose payload differs between two tries.
+// Unallocated registers (i.e. with an empty value) are considered absent from a trie.
+type PayloadChange struct {
+	Path Path
+	Type PayloadChangeType
+	Old  *Payload // nil if the register was added
+	New  *Payload // nil if the register was removed
+}
+
 // TrieProof includes all the information needed to walk
 // through a trie branch from an specific leaf node (key)
 // up to the root of the trie.
@@ -33,6 +33,7 @@ var (
 	DirPrivateRoot                   = "private-root-information"
 	FilenameRandomBeaconPriv         = "random-beacon.priv.json"
 	FilenameSecretsEncryptionKey     = "secretsdb-key"
+	FilenameDataEncryptionKey        = "data-encryption-key"
 	PathPrivNodeInfoPrefix           = "node-info.priv."
 	FilenameRootBlockVotePrefix      = "root-block-vote."
 	PathRootDKGData                  = filepath.Join(DirPrivateRoot, "root-dkg-data.priv.json")
@@ -43,4 +44,5 @@ var (
 	PathNodeRootBlockVote            = filepath.Join(DirPrivateRoot, "private-node-info_%v", "root-block-vote.json")
 	FilenameRootBlockVote            = FilenameRootBlockVotePrefix + "%v.json"
 	PathSecretsEncryptionKey         = filepath.Join(DirPrivateRoot, "private-node-info_%v", FilenameSecretsEncryptionKey) // %v will be replaced by NodeID
+	PathDataEncryptionKey            = filepath.Join(DirPrivateRoot, "private-node-info_%v", FilenameDataEncryptionKey)    // %v will be replaced by NodeID
 )
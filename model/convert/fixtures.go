@@ -1,6 +1,9 @@
 package convert
 
 import (
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/fvm/systemcontracts"
 	"github.com/onflow/flow-go/model/flow"
@@ -147,6 +150,60 @@ func EpochCommitFixture(chain flow.ChainID) (flow.Event, *flow.EpochCommit) {
 	return event, expected
 }
 
+// EpochRecoverFixture returns an EpochRecover service event as a Cadence event
+// representation and as a protocol model representation. The Cadence event is
+// composed of the fields of the EpochSetup and EpochCommit fixtures.
+func EpochRecoverFixture(chain flow.ChainID) (flow.Event, *flow.EpochRecover) {
+
+	events, err := systemcontracts.ServiceEventsForChain(chain)
+	if err != nil {
+		panic(err)
+	}
+
+	setupEvent, expectedSetup := EpochSetupFixture(chain)
+	commitEvent, expectedCommit := EpochCommitFixture(chain)
+
+	setupPayload, err := jsoncdc.Decode(setupEvent.Payload)
+	if err != nil {
+		panic(err)
+	}
+	commitPayload, err := jsoncdc.Decode(commitEvent.Payload)
+	if err != nil {
+		panic(err)
+	}
+	cdcSetup := setupPayload.(cadence.Event)
+	cdcCommit := commitPayload.(cadence.Event)
+
+	// the recover event has the fields of the setup event, followed by the
+	// cluster QCs and DKG keys of the commit event
+	fields := make([]cadence.Value, 0, 11)
+	fields = append(fields, cdcSetup.Fields[:9]...)
+	fields = append(fields, cdcCommit.Fields[1:3]...)
+	fieldTypes := make([]cadence.Field, 0, 11)
+	fieldTypes = append(fieldTypes, cdcSetup.EventType.Fields[:9]...)
+	fieldTypes = append(fieldTypes, cdcCommit.EventType.Fields[1:3]...)
+
+	cdcRecover := cadence.NewEvent(fields).WithType(&cadence.EventType{
+		Location:            cdcSetup.EventType.Location,
+		QualifiedIdentifier: events.EpochRecover.QualifiedIdentifier(),
+		Fields:              fieldTypes,
+	})
+	payload, err := jsoncdc.Encode(cdcRecover)
+	if err != nil {
+		panic(err)
+	}
+
+	event := unittest.EventFixture(events.EpochRecover.EventType(), 1, 1, unittest.IdentifierFixture(), 0)
+	event.Payload = payload
+
+	expected := &flow.EpochRecover{
+		EpochSetup:  *expectedSetup,
+		EpochCommit: *expectedCommit,
+	}
+
+	return event, expected
+}
+
 var epochSetupFixtureJSON = `
 {
   "type": "Event",
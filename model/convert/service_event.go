@@ -29,6 +29,8 @@ func ServiceEvent(chainID flow.ChainID, event flow.Event) (*flow.ServiceEvent, e
 		return convertServiceEventEpochSetup(event)
 	case events.EpochCommit.EventType():
 		return convertServiceEventEpochCommit(event)
+	case events.EpochRecover.EventType():
+		return convertServiceEventEpochRecover(event)
 	default:
 		return nil, fmt.Errorf("invalid event type: %s", event.Type)
 	}
@@ -44,9 +46,6 @@ func convertServiceEventEpochSetup(event flow.Event) (*flow.ServiceEvent, error)
 		return nil, fmt.Errorf("could not unmarshal event payload: %w", err)
 	}
 
-	// parse cadence types to required fields
-	setup := new(flow.EpochSetup)
-
 	// NOTE: variable names prefixed with cdc represent cadence types
 	cdcEvent, ok := payload.(cadence.Event)
 	if !ok {
@@ -57,54 +56,86 @@ func convertServiceEventEpochSetup(event flow.Event) (*flow.ServiceEvent, error)
 		return nil, fmt.Errorf("insufficient fields in EpochSetup event (%d < 9)", len(cdcEvent.Fields))
 	}
 
+	// parse cadence types to required fields
+	setup, err := convertEpochSetupFields(cdcEvent.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse sealing parameters, which are optional for backward compatibility
+	// with service events emitted by earlier versions of the epoch contract
+	if len(cdcEvent.Fields) > 9 {
+		setup.SealingParameters, err = convertSealingParameters(cdcEvent.Fields[9])
+		if err != nil {
+			return nil, fmt.Errorf("could not convert sealing parameters: %w", err)
+		}
+	}
+
+	// construct the service event
+	serviceEvent := &flow.ServiceEvent{
+		Type:  flow.ServiceEventSetup,
+		Event: setup,
+	}
+
+	return serviceEvent, nil
+}
+
+// convertEpochSetupFields converts the leading fields of an EpochSetup or
+// EpochRecover event, which share the same layout, to an EpochSetup. The caller
+// must ensure that there are at least 9 fields.
+func convertEpochSetupFields(fields []cadence.Value) (*flow.EpochSetup, error) {
+
+	setup := new(flow.EpochSetup)
+
 	// extract simple fields
-	counter, ok := cdcEvent.Fields[0].(cadence.UInt64)
+	counter, ok := fields[0].(cadence.UInt64)
 	if !ok {
-		return nil, invalidCadenceTypeError("counter", cdcEvent.Fields[0], cadence.UInt64(0))
+		return nil, invalidCadenceTypeError("counter", fields[0], cadence.UInt64(0))
 	}
 	setup.Counter = uint64(counter)
-	firstView, ok := cdcEvent.Fields[2].(cadence.UInt64)
+	firstView, ok := fields[2].(cadence.UInt64)
 	if !ok {
-		return nil, invalidCadenceTypeError("firstView", cdcEvent.Fields[2], cadence.UInt64(0))
+		return nil, invalidCadenceTypeError("firstView", fields[2], cadence.UInt64(0))
 	}
 	setup.FirstView = uint64(firstView)
-	finalView, ok := cdcEvent.Fields[3].(cadence.UInt64)
+	finalView, ok := fields[3].(cadence.UInt64)
 	if !ok {
-		return nil, invalidCadenceTypeError("finalView", cdcEvent.Fields[3], cadence.UInt64(0))
+		return nil, invalidCadenceTypeError("finalView", fields[3], cadence.UInt64(0))
 	}
 	setup.FinalView = uint64(finalView)
-	randomSrcHex, ok := cdcEvent.Fields[5].(cadence.String)
+	randomSrcHex, ok := fields[5].(cadence.String)
 	if !ok {
-		return nil, invalidCadenceTypeError("randomSource", cdcEvent.Fields[5], cadence.String(""))
+		return nil, invalidCadenceTypeError("randomSource", fields[5], cadence.String(""))
 	}
 	// Cadence's unsafeRandom().toString() produces a string of variable length.
 	// Here we pad it with enough 0s to meet the required length.
 	paddedRandomSrcHex := fmt.Sprintf("%0*s", 2*flow.EpochSetupRandomSourceLength, string(randomSrcHex))
+	var err error
 	setup.RandomSource, err = hex.DecodeString(paddedRandomSrcHex)
 	if err != nil {
 		return nil, fmt.Errorf("could not decode random source hex (%v): %w", paddedRandomSrcHex, err)
 	}
 
-	dkgPhase1FinalView, ok := cdcEvent.Fields[6].(cadence.UInt64)
+	dkgPhase1FinalView, ok := fields[6].(cadence.UInt64)
 	if !ok {
-		return nil, invalidCadenceTypeError("dkgPhase1FinalView", cdcEvent.Fields[6], cadence.UInt64(0))
+		return nil, invalidCadenceTypeError("dkgPhase1FinalView", fields[6], cadence.UInt64(0))
 	}
 	setup.DKGPhase1FinalView = uint64(dkgPhase1FinalView)
-	dkgPhase2FinalView, ok := cdcEvent.Fields[7].(cadence.UInt64)
+	dkgPhase2FinalView, ok := fields[7].(cadence.UInt64)
 	if !ok {
-		return nil, invalidCadenceTypeError("dkgPhase2FinalView", cdcEvent.Fields[7], cadence.UInt64(0))
+		return nil, invalidCadenceTypeError("dkgPhase2FinalView", fields[7], cadence.UInt64(0))
 	}
 	setup.DKGPhase2FinalView = uint64(dkgPhase2FinalView)
-	dkgPhase3FinalView, ok := cdcEvent.Fields[8].(cadence.UInt64)
+	dkgPhase3FinalView, ok := fields[8].(cadence.UInt64)
 	if !ok {
-		return nil, invalidCadenceTypeError("dkgPhase3FinalView", cdcEvent.Fields[8], cadence.UInt64(0))
+		return nil, invalidCadenceTypeError("dkgPhase3FinalView", fields[8], cadence.UInt64(0))
 	}
 	setup.DKGPhase3FinalView = uint64(dkgPhase3FinalView)
 
 	// parse cluster assignments
-	cdcClusters, ok := cdcEvent.Fields[4].(cadence.Array)
+	cdcClusters, ok := fields[4].(cadence.Array)
 	if !ok {
-		return nil, invalidCadenceTypeError("clusters", cdcEvent.Fields[4], cadence.Array{})
+		return nil, invalidCadenceTypeError("clusters", fields[4], cadence.Array{})
 	}
 	setup.Assignments, err = convertClusterAssignments(cdcClusters.Values)
 	if err != nil {
@@ -112,22 +143,16 @@ func convertServiceEventEpochSetup(event flow.Event) (*flow.ServiceEvent, error)
 	}
 
 	// parse epoch participants
-	cdcParticipants, ok := cdcEvent.Fields[1].(cadence.Array)
+	cdcParticipants, ok := fields[1].(cadence.Array)
 	if !ok {
-		return nil, invalidCadenceTypeError("participants", cdcEvent.Fields[1], cadence.Array{})
+		return nil, invalidCadenceTypeError("participants", fields[1], cadence.Array{})
 	}
 	setup.Participants, err = convertParticipants(cdcParticipants.Values)
 	if err != nil {
 		return nil, fmt.Errorf("could not convert participants: %w", err)
 	}
 
-	// construct the service event
-	serviceEvent := &flow.ServiceEvent{
-		Type:  flow.ServiceEventSetup,
-		Event: setup,
-	}
-
-	return serviceEvent, nil
+	return setup, nil
 }
 
 // convertServiceEventEpochCommit converts a service event encoded as the generic
@@ -172,6 +197,79 @@ func convertServiceEventEpochCommit(event flow.Event) (*flow.ServiceEvent, error
 	return serviceEvent, nil
 }
 
+// convertServiceEventEpochRecover converts a service event encoded as the generic
+// flow.Event type to a ServiceEvent type for an EpochRecover event. The leading
+// fields of the event have the same layout as the EpochSetup event, followed by
+// the cluster QC votes and DKG keys in the same representation as the EpochCommit event.
+func convertServiceEventEpochRecover(event flow.Event) (*flow.ServiceEvent, error) {
+
+	// decode bytes using jsoncdc
+	payload, err := json.Decode(event.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal event payload: %w", err)
+	}
+
+	// NOTE: variable names prefixed with cdc represent cadence types
+	cdcEvent, ok := payload.(cadence.Event)
+	if !ok {
+		return nil, invalidCadenceTypeError("payload", payload, cadence.Event{})
+	}
+
+	if len(cdcEvent.Fields) < 11 {
+		return nil, fmt.Errorf("insufficient fields in EpochRecover event (%d < 11)", len(cdcEvent.Fields))
+	}
+
+	setup, err := convertEpochSetupFields(cdcEvent.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse sealing parameters, which are optional
+	if len(cdcEvent.Fields) > 11 {
+		setup.SealingParameters, err = convertSealingParameters(cdcEvent.Fields[11])
+		if err != nil {
+			return nil, fmt.Errorf("could not convert sealing parameters: %w", err)
+		}
+	}
+
+	// the commit of the recovery epoch shares the counter of the setup
+	commit := &flow.EpochCommit{
+		Counter: setup.Counter,
+	}
+
+	// parse cluster qc votes
+	cdcClusterQCVotes, ok := cdcEvent.Fields[9].(cadence.Array)
+	if !ok {
+		return nil, invalidCadenceTypeError("clusterQCVoteData", cdcEvent.Fields[9], cadence.Array{})
+	}
+	commit.ClusterQCs, err = convertClusterQCVotes(cdcClusterQCVotes.Values)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert cluster qc votes: %w", err)
+	}
+
+	// parse DKG group key and participants, with the group public key first
+	// followed by individual keys, as for the EpochCommit event
+	cdcDKGKeys, ok := cdcEvent.Fields[10].(cadence.Array)
+	if !ok {
+		return nil, invalidCadenceTypeError("dkgPubKeys", cdcEvent.Fields[10], cadence.Array{})
+	}
+	commit.DKGGroupKey, commit.DKGParticipantKeys, err = convertDKGKeys(cdcDKGKeys.Values)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert DKG keys: %w", err)
+	}
+
+	// construct the service event
+	serviceEvent := &flow.ServiceEvent{
+		Type: flow.ServiceEventRecover,
+		Event: &flow.EpochRecover{
+			EpochSetup:  *setup,
+			EpochCommit: *commit,
+		},
+	}
+
+	return serviceEvent, nil
+}
+
 // convertClusterAssignments converts the Cadence representation of cluster
 // assignments included in the EpochSetup into the protocol AssignmentList
 // representation.
@@ -230,6 +328,60 @@ func convertClusterAssignments(cdcClusters []cadence.Value) (flow.AssignmentList
 	return assignments, nil
 }
 
+// convertSealingParameters converts the optional sealing parameters specified in
+// the EpochSetup event. Returns nil if no sealing parameters are specified, in which
+// case the sealing parameters of the root snapshot apply.
+func convertSealingParameters(value cadence.Value) (*flow.SealingParameters, error) {
+
+	cdcOptional, ok := value.(cadence.Optional)
+	if !ok {
+		return nil, invalidCadenceTypeError("sealingParameters", value, cadence.Optional{})
+	}
+	if cdcOptional.Value == nil {
+		return nil, nil
+	}
+
+	cdcParams, ok := cdcOptional.Value.(cadence.Struct)
+	if !ok {
+		return nil, invalidCadenceTypeError("sealingParameters", cdcOptional.Value, cadence.Struct{})
+	}
+
+	expectedFields := 4
+	if len(cdcParams.Fields) < expectedFields {
+		return nil, fmt.Errorf("insufficient fields (%d < %d)", len(cdcParams.Fields), expectedFields)
+	}
+
+	requiredApprovalsForSealConstruction, ok := cdcParams.Fields[0].(cadence.UInt64)
+	if !ok {
+		return nil, invalidCadenceTypeError("sealingParameters.requiredApprovalsForSealConstruction", cdcParams.Fields[0], cadence.UInt64(0))
+	}
+	requiredApprovalsForSealVerification, ok := cdcParams.Fields[1].(cadence.UInt64)
+	if !ok {
+		return nil, invalidCadenceTypeError("sealingParameters.requiredApprovalsForSealVerification", cdcParams.Fields[1], cadence.UInt64(0))
+	}
+	emergencySealingActive, ok := cdcParams.Fields[2].(cadence.Bool)
+	if !ok {
+		return nil, invalidCadenceTypeError("sealingParameters.emergencySealingActive", cdcParams.Fields[2], cadence.Bool(false))
+	}
+	emergencySealingThreshold, ok := cdcParams.Fields[3].(cadence.UInt64)
+	if !ok {
+		return nil, invalidCadenceTypeError("sealingParameters.emergencySealingThreshold", cdcParams.Fields[3], cadence.UInt64(0))
+	}
+
+	params := &flow.SealingParameters{
+		RequiredApprovalsForSealConstruction: uint(requiredApprovalsForSealConstruction),
+		RequiredApprovalsForSealVerification: uint(requiredApprovalsForSealVerification),
+		EmergencySealingActive:               bool(emergencySealingActive),
+		EmergencySealingThreshold:            uint64(emergencySealingThreshold),
+	}
+	err := params.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sealing parameters: %w", err)
+	}
+
+	return params, nil
+}
+
 // convertParticipants converts the network participants specified in the
 // EpochSetup event into an IdentityList.
 func convertParticipants(cdcParticipants []cadence.Value) (flow.IdentityList, error) {
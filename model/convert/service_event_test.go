@@ -3,6 +3,8 @@ package convert
 import (
 	"testing"
 
+	"github.com/onflow/cadence"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -45,4 +47,62 @@ func TestEventConversion(t *testing.T) {
 
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("epoch recover", func(t *testing.T) {
+
+		fixture, expected := EpochRecoverFixture(chainID)
+
+		// convert Cadence types to Go types
+		event, err := ServiceEvent(chainID, fixture)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		assert.Equal(t, flow.ServiceEventRecover, event.Type)
+
+		// cast event type to epoch recover
+		actual, ok := event.Event.(*flow.EpochRecover)
+		require.True(t, ok)
+
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestSealingParametersConversion(t *testing.T) {
+
+	t.Run("unspecified", func(t *testing.T) {
+		params, err := convertSealingParameters(cadence.NewOptional(nil))
+		require.NoError(t, err)
+		assert.Nil(t, params)
+	})
+
+	t.Run("specified", func(t *testing.T) {
+		params, err := convertSealingParameters(cadence.NewOptional(cadence.NewStruct([]cadence.Value{
+			cadence.UInt64(2),
+			cadence.UInt64(1),
+			cadence.Bool(true),
+			cadence.UInt64(50),
+		})))
+		require.NoError(t, err)
+		expected := &flow.SealingParameters{
+			RequiredApprovalsForSealConstruction: 2,
+			RequiredApprovalsForSealVerification: 1,
+			EmergencySealingActive:               true,
+			EmergencySealingThreshold:            50,
+		}
+		assert.Equal(t, expected, params)
+	})
+
+	t.Run("inconsistent", func(t *testing.T) {
+		_, err := convertSealingParameters(cadence.NewOptional(cadence.NewStruct([]cadence.Value{
+			cadence.UInt64(1),
+			cadence.UInt64(2),
+			cadence.Bool(false),
+			cadence.UInt64(50),
+		})))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := convertSealingParameters(cadence.UInt64(1))
+		assert.Error(t, err)
+	})
 }
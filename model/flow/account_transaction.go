@@ -0,0 +1,122 @@
+package flow
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// TransactionRoles is the set of roles an account has in a transaction.
+type TransactionRoles uint8
+
+const (
+	// TransactionRolePayer marks the account paying the fees of the transaction.
+	TransactionRolePayer TransactionRoles = 1 << iota
+	// TransactionRoleProposer marks the account providing the proposal key of the transaction.
+	TransactionRoleProposer
+	// TransactionRoleAuthorizer marks an account authorizing the transaction.
+	TransactionRoleAuthorizer
+	// TransactionRoleEvent marks an account referenced by an event emitted by the transaction.
+	TransactionRoleEvent
+)
+
+var transactionRoleNames = []struct {
+	role TransactionRoles
+	name string
+}{
+	{TransactionRolePayer, "payer"},
+	{TransactionRoleProposer, "proposer"},
+	{TransactionRoleAuthorizer, "authorizer"},
+	{TransactionRoleEvent, "event"},
+}
+
+// Has returns true if all of the given roles are contained in the set.
+func (r TransactionRoles) Has(role TransactionRoles) bool {
+	return r&role == role
+}
+
+// Names returns the names of the roles contained in the set.
+func (r TransactionRoles) Names() []string {
+	names := make([]string, 0, len(transactionRoleNames))
+	for _, role := range transactionRoleNames {
+		if r.Has(role.role) {
+			names = append(names, role.name)
+		}
+	}
+	return names
+}
+
+func (r TransactionRoles) String() string {
+	return strings.Join(r.Names(), "|")
+}
+
+// TransactionPosition is the position of a transaction within the sealed chain. Positions are
+// ordered by block height and transaction index, and are used as pagination cursors when
+// querying the account transaction index.
+type TransactionPosition struct {
+	Height           uint64
+	TransactionIndex uint32
+}
+
+// Less returns true if the position p is strictly before the position other.
+func (p TransactionPosition) Less(other TransactionPosition) bool {
+	if p.Height != other.Height {
+		return p.Height < other.Height
+	}
+	return p.TransactionIndex < other.TransactionIndex
+}
+
+// Next returns the smallest position strictly after p.
+func (p TransactionPosition) Next() TransactionPosition {
+	if p.TransactionIndex < math.MaxUint32 {
+		p.TransactionIndex++
+		return p
+	}
+	p.Height++
+	p.TransactionIndex = 0
+	return p
+}
+
+// Cursor returns the opaque string representation of the position handed out to API clients.
+func (p TransactionPosition) Cursor() string {
+	buf := make([]byte, 12)
+	binary.BigEndian.PutUint64(buf[0:8], p.Height)
+	binary.BigEndian.PutUint32(buf[8:12], p.TransactionIndex)
+	return hex.EncodeToString(buf)
+}
+
+// ParseTransactionCursor decodes a cursor created by TransactionPosition.Cursor.
+func ParseTransactionCursor(cursor string) (TransactionPosition, error) {
+	buf, err := hex.DecodeString(cursor)
+	if err != nil {
+		return TransactionPosition{}, fmt.Errorf("could not decode cursor: %w", err)
+	}
+	if len(buf) != 12 {
+		return TransactionPosition{}, fmt.Errorf("invalid cursor length (%d)", len(buf))
+	}
+	return TransactionPosition{
+		Height:           binary.BigEndian.Uint64(buf[0:8]),
+		TransactionIndex: binary.BigEndian.Uint32(buf[8:12]),
+	}, nil
+}
+
+// AccountTransaction records that an account was involved in a sealed transaction. It is the
+// value stored in the account transaction index of Access nodes.
+type AccountTransaction struct {
+	Address          Address
+	BlockID          Identifier
+	Height           uint64
+	TransactionID    Identifier
+	TransactionIndex uint32
+	Roles            TransactionRoles
+}
+
+// Position returns the position of the transaction within the sealed chain.
+func (a AccountTransaction) Position() TransactionPosition {
+	return TransactionPosition{
+		Height:           a.Height,
+		TransactionIndex: a.TransactionIndex,
+	}
+}
@@ -0,0 +1,35 @@
+package flow_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func TestTransactionRoles(t *testing.T) {
+	roles := flow.TransactionRolePayer | flow.TransactionRoleEvent
+
+	assert.True(t, roles.Has(flow.TransactionRolePayer))
+	assert.False(t, roles.Has(flow.TransactionRolePayer|flow.TransactionRoleProposer))
+	assert.Equal(t, []string{"payer", "event"}, roles.Names())
+	assert.Equal(t, "payer|event", roles.String())
+}
+
+func TestTransactionPosition(t *testing.T) {
+	p := flow.TransactionPosition{Height: 10, TransactionIndex: 2}
+
+	decoded, err := flow.ParseTransactionCursor(p.Cursor())
+	require.NoError(t, err)
+	assert.Equal(t, p, decoded)
+
+	_, err = flow.ParseTransactionCursor(flow.EventPosition{}.Cursor())
+	assert.Error(t, err)
+
+	assert.Equal(t, flow.TransactionPosition{Height: 10, TransactionIndex: 3}, p.Next())
+	last := flow.TransactionPosition{Height: 10, TransactionIndex: math.MaxUint32}
+	assert.Equal(t, flow.TransactionPosition{Height: 11}, last.Next())
+}
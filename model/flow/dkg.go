@@ -1,5 +1,9 @@
 package flow
 
+import (
+	"time"
+)
+
 // DKGEndState captures the final state of a completed DKG.
 type DKGEndState uint32
 
@@ -30,3 +34,58 @@ func (state DKGEndState) String() string {
 		return "DKGEndStateUnknown"
 	}
 }
+
+// DKGTranscriptSummary is a structured summary of the DKG for an epoch, as
+// observed by this node. It is recorded locally by each DKG participant, so
+// that failed DKGs can be analyzed after the fact.
+type DKGTranscriptSummary struct {
+	DKGInstanceID    string                  // unique identifier of the DKG instance
+	MyIndex          int                     // index of this node in the DKG committee
+	PhaseTransitions []DKGPhaseTransition    // local phase transitions, in the order they occurred
+	Participants     []DKGParticipantSummary // per-participant summary, in DKG committee order
+	BroadcastsSent   uint                    // number of broadcast messages this node published
+	BroadcastsFailed uint                    // number of broadcast messages this node failed to publish
+	ResultSubmitted  bool                    // whether this node submitted its result
+	ResultHash       Identifier              // hash of the group key and key vector submitted by this node
+}
+
+// DKGPhaseTransition records a phase transition of the local DKG instance.
+type DKGPhaseTransition struct {
+	Phase string    // the phase the DKG transitioned into
+	Time  time.Time // the time of the transition
+}
+
+// DKGParticipantSummary summarizes the messages this node received from a
+// DKG participant, and the misbehaviour this node observed for it.
+type DKGParticipantSummary struct {
+	Index                   int        // index of the participant in the DKG committee
+	NodeID                  Identifier // node ID of the participant
+	BroadcastsReceived      uint       // number of valid broadcast messages received from the participant
+	PrivateMessagesReceived uint       // number of private messages received from the participant
+	Complaints              []string   // reasons for which the participant was flagged for misbehaviour
+	Disqualified            bool       // whether this node disqualified the participant
+	DisqualificationReason  string     // reason for which the participant was disqualified
+}
+
+// MissingBroadcasts returns the indices of the participants from which this
+// node did not receive any broadcast message.
+func (s *DKGTranscriptSummary) MissingBroadcasts() []int {
+	var missing []int
+	for _, participant := range s.Participants {
+		if participant.BroadcastsReceived == 0 {
+			missing = append(missing, participant.Index)
+		}
+	}
+	return missing
+}
+
+// Disqualified returns the indices of the participants which this node disqualified.
+func (s *DKGTranscriptSummary) Disqualified() []int {
+	var disqualified []int
+	for _, participant := range s.Participants {
+		if participant.Disqualified {
+			disqualified = append(disqualified, participant.Index)
+		}
+	}
+	return disqualified
+}
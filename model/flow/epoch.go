@@ -12,6 +12,7 @@ import (
 
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/model/encodable"
+	"github.com/onflow/flow-go/model/fingerprint"
 )
 
 // EpochPhase represents a phase of the Epoch Preparation Protocol. The phase
@@ -72,6 +73,9 @@ type EpochSetup struct {
 	Participants       IdentityList   // all participants of the epoch
 	Assignments        AssignmentList // cluster assignment for the epoch
 	RandomSource       []byte         // source of randomness for epoch-specific setup tasks
+	// SealingParameters are the sealing parameters for the epoch. If nil, the sealing
+	// parameters of the root snapshot apply.
+	SealingParameters *SealingParameters
 }
 
 func (setup *EpochSetup) ServiceEvent() ServiceEvent {
@@ -86,6 +90,59 @@ func (setup *EpochSetup) ID() Identifier {
 	return MakeID(setup)
 }
 
+// Fingerprint returns the canonical encoding of the event, which is used for its ID. Events
+// without sealing parameters keep their original encoding, so that the IDs of existing events
+// do not change.
+func (setup *EpochSetup) Fingerprint() []byte {
+	if setup.SealingParameters != nil {
+		return fingerprint.Fingerprint(struct {
+			Counter            uint64
+			FirstView          uint64
+			DKGPhase1FinalView uint64
+			DKGPhase2FinalView uint64
+			DKGPhase3FinalView uint64
+			FinalView          uint64
+			Participants       IdentityList
+			Assignments        AssignmentList
+			RandomSource       []byte
+			SealingParameters  SealingParameters
+		}{
+			Counter:            setup.Counter,
+			FirstView:          setup.FirstView,
+			DKGPhase1FinalView: setup.DKGPhase1FinalView,
+			DKGPhase2FinalView: setup.DKGPhase2FinalView,
+			DKGPhase3FinalView: setup.DKGPhase3FinalView,
+			FinalView:          setup.FinalView,
+			Participants:       setup.Participants,
+			Assignments:        setup.Assignments,
+			RandomSource:       setup.RandomSource,
+			SealingParameters:  *setup.SealingParameters,
+		})
+	}
+
+	return fingerprint.Fingerprint(struct {
+		Counter            uint64
+		FirstView          uint64
+		DKGPhase1FinalView uint64
+		DKGPhase2FinalView uint64
+		DKGPhase3FinalView uint64
+		FinalView          uint64
+		Participants       IdentityList
+		Assignments        AssignmentList
+		RandomSource       []byte
+	}{
+		Counter:            setup.Counter,
+		FirstView:          setup.FirstView,
+		DKGPhase1FinalView: setup.DKGPhase1FinalView,
+		DKGPhase2FinalView: setup.DKGPhase2FinalView,
+		DKGPhase3FinalView: setup.DKGPhase3FinalView,
+		FinalView:          setup.FinalView,
+		Participants:       setup.Participants,
+		Assignments:        setup.Assignments,
+		RandomSource:       setup.RandomSource,
+	})
+}
+
 func (setup *EpochSetup) EqualTo(other *EpochSetup) bool {
 	if setup.Counter != other.Counter {
 		return false
@@ -111,6 +168,12 @@ func (setup *EpochSetup) EqualTo(other *EpochSetup) bool {
 	if !setup.Assignments.EqualTo(other.Assignments) {
 		return false
 	}
+	if (setup.SealingParameters == nil) != (other.SealingParameters == nil) {
+		return false
+	}
+	if setup.SealingParameters != nil && *setup.SealingParameters != *other.SealingParameters {
+		return false
+	}
 	return bytes.Equal(setup.RandomSource, other.RandomSource)
 }
 
@@ -309,6 +372,38 @@ func (commit *EpochCommit) EqualTo(other *EpochCommit) bool {
 	return true
 }
 
+// EpochRecover is a service event emitted to recover from epoch emergency fallback
+// (EECC) without a spork. It injects a new epoch with a fresh committee, cluster
+// assignment and DKG result into the protocol state, which replaces the fallback
+// epoch as of the epoch's first view and resumes normal epoch transitions.
+type EpochRecover struct {
+	EpochSetup  EpochSetup  // setup of the recovery epoch
+	EpochCommit EpochCommit // commit of the recovery epoch, including cluster QCs and DKG result
+}
+
+func (er *EpochRecover) ServiceEvent() ServiceEvent {
+	return ServiceEvent{
+		Type:  ServiceEventRecover,
+		Event: er,
+	}
+}
+
+// ID returns the hash of the event contents.
+func (er *EpochRecover) ID() Identifier {
+	return MakeID(struct {
+		SetupID  Identifier
+		CommitID Identifier
+	}{
+		SetupID:  er.EpochSetup.ID(),
+		CommitID: er.EpochCommit.ID(),
+	})
+}
+
+func (er *EpochRecover) EqualTo(other *EpochRecover) bool {
+	return er.EpochSetup.EqualTo(&other.EpochSetup) &&
+		er.EpochCommit.EqualTo(&other.EpochCommit)
+}
+
 // ToDKGParticipantLookup constructs a DKG participant lookup from an identity
 // list and a key list. The identity list must be EXACTLY the same (order and
 // contents) as that used when initializing the corresponding DKG instance.
@@ -411,6 +506,10 @@ type EpochStatus struct {
 	PreviousEpoch EventIDs // EpochSetup and EpochCommit events for the previous epoch
 	CurrentEpoch  EventIDs // EpochSetup and EpochCommit events for the current epoch
 	NextEpoch     EventIDs // EpochSetup and EpochCommit events for the next epoch
+	// InvalidServiceEventIncorporated is true iff an invalid epoch service event has been
+	// incorporated in this fork during the current epoch, which triggers epoch emergency
+	// fallback for all descendants until a recovery epoch begins.
+	InvalidServiceEventIncorporated bool
 }
 
 // Copy returns a copy of the epoch status.
@@ -419,6 +518,8 @@ func (es *EpochStatus) Copy() *EpochStatus {
 		PreviousEpoch: es.PreviousEpoch,
 		CurrentEpoch:  es.CurrentEpoch,
 		NextEpoch:     es.NextEpoch,
+
+		InvalidServiceEventIncorporated: es.InvalidServiceEventIncorporated,
 	}
 }
 
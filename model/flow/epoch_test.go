@@ -303,4 +303,75 @@ func TestEpochSetup_EqualTo(t *testing.T) {
 		require.False(t, a.EqualTo(b))
 		require.False(t, b.EqualTo(a))
 	})
+
+	t.Run("SealingParameters same", func(t *testing.T) {
+
+		params := flow.DefaultSealingParameters()
+		a := &flow.EpochSetup{SealingParameters: &params}
+		b := &flow.EpochSetup{SealingParameters: &flow.SealingParameters{
+			RequiredApprovalsForSealConstruction: params.RequiredApprovalsForSealConstruction,
+			RequiredApprovalsForSealVerification: params.RequiredApprovalsForSealVerification,
+			EmergencySealingActive:               params.EmergencySealingActive,
+			EmergencySealingThreshold:            params.EmergencySealingThreshold,
+		}}
+
+		require.True(t, a.EqualTo(b))
+		require.True(t, b.EqualTo(a))
+	})
+
+	t.Run("SealingParameters diff", func(t *testing.T) {
+
+		params := flow.DefaultSealingParameters()
+		a := &flow.EpochSetup{SealingParameters: &params}
+		b := &flow.EpochSetup{SealingParameters: &flow.SealingParameters{RequiredApprovalsForSealConstruction: 2}}
+		c := &flow.EpochSetup{}
+
+		require.False(t, a.EqualTo(b))
+		require.False(t, b.EqualTo(a))
+		require.False(t, a.EqualTo(c))
+		require.False(t, c.EqualTo(a))
+	})
+}
+
+func TestEpochSetup_ID(t *testing.T) {
+
+	// setups without sealing parameters must keep the ID they had before the field was added
+	t.Run("without sealing parameters", func(t *testing.T) {
+		setup := unittest.EpochSetupFixture()
+		legacy := struct {
+			Counter            uint64
+			FirstView          uint64
+			DKGPhase1FinalView uint64
+			DKGPhase2FinalView uint64
+			DKGPhase3FinalView uint64
+			FinalView          uint64
+			Participants       flow.IdentityList
+			Assignments        flow.AssignmentList
+			RandomSource       []byte
+		}{
+			Counter:            setup.Counter,
+			FirstView:          setup.FirstView,
+			DKGPhase1FinalView: setup.DKGPhase1FinalView,
+			DKGPhase2FinalView: setup.DKGPhase2FinalView,
+			DKGPhase3FinalView: setup.DKGPhase3FinalView,
+			FinalView:          setup.FinalView,
+			Participants:       setup.Participants,
+			Assignments:        setup.Assignments,
+			RandomSource:       setup.RandomSource,
+		}
+		require.Equal(t, flow.MakeID(legacy), setup.ID())
+	})
+
+	t.Run("with sealing parameters", func(t *testing.T) {
+		setup := unittest.EpochSetupFixture()
+		withoutParams := setup.ID()
+
+		params := flow.DefaultSealingParameters()
+		setup.SealingParameters = &params
+		withParams := setup.ID()
+		require.NotEqual(t, withoutParams, withParams)
+
+		params.RequiredApprovalsForSealConstruction++
+		require.NotEqual(t, withParams, setup.ID())
+	})
 }
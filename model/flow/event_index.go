@@ -0,0 +1,140 @@
+package flow
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// EventTypeWildcard is the suffix which turns an event type filter into a prefix match,
+// for example `A.0123456789abcdef.*` matches all events emitted by contracts of the account.
+const EventTypeWildcard = ".*"
+
+// Address returns the address of the account which deployed the contract emitting
+// events of this type. Returns false for event types which are not emitted by a
+// contract, such as the built-in `flow.AccountCreated`.
+func (t EventType) Address() (Address, bool) {
+	parts := strings.Split(string(t), ".")
+	if len(parts) < 3 || parts[0] != "A" {
+		return EmptyAddress, false
+	}
+	if len(parts[1]) != 2*AddressLength {
+		return EmptyAddress, false
+	}
+	raw, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return EmptyAddress, false
+	}
+	return BytesToAddress(raw), true
+}
+
+// EventPosition is the position of an event within the sealed chain. Positions are totally
+// ordered by block height, transaction index and event index, and are used as pagination
+// cursors when querying the event index.
+type EventPosition struct {
+	Height           uint64
+	TransactionIndex uint32
+	EventIndex       uint32
+}
+
+// Less returns true if the position p is strictly before the position other.
+func (p EventPosition) Less(other EventPosition) bool {
+	if p.Height != other.Height {
+		return p.Height < other.Height
+	}
+	if p.TransactionIndex != other.TransactionIndex {
+		return p.TransactionIndex < other.TransactionIndex
+	}
+	return p.EventIndex < other.EventIndex
+}
+
+// Next returns the smallest position strictly after p.
+func (p EventPosition) Next() EventPosition {
+	switch {
+	case p.EventIndex < math.MaxUint32:
+		p.EventIndex++
+	case p.TransactionIndex < math.MaxUint32:
+		p.TransactionIndex++
+		p.EventIndex = 0
+	default:
+		p.Height++
+		p.TransactionIndex = 0
+		p.EventIndex = 0
+	}
+	return p
+}
+
+// Cursor returns the opaque string representation of the position handed out to API clients.
+func (p EventPosition) Cursor() string {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf[0:8], p.Height)
+	binary.BigEndian.PutUint32(buf[8:12], p.TransactionIndex)
+	binary.BigEndian.PutUint32(buf[12:16], p.EventIndex)
+	return hex.EncodeToString(buf)
+}
+
+// ParseEventCursor decodes a cursor created by EventPosition.Cursor.
+func ParseEventCursor(cursor string) (EventPosition, error) {
+	buf, err := hex.DecodeString(cursor)
+	if err != nil {
+		return EventPosition{}, fmt.Errorf("could not decode cursor: %w", err)
+	}
+	if len(buf) != 16 {
+		return EventPosition{}, fmt.Errorf("invalid cursor length (%d)", len(buf))
+	}
+	return EventPosition{
+		Height:           binary.BigEndian.Uint64(buf[0:8]),
+		TransactionIndex: binary.BigEndian.Uint32(buf[8:12]),
+		EventIndex:       binary.BigEndian.Uint32(buf[12:16]),
+	}, nil
+}
+
+// EventLocator points to an event emitted in a sealed block. It is the value stored in the
+// secondary event indexes and is sufficient to retrieve the full event from storage.
+type EventLocator struct {
+	Type             EventType
+	BlockID          Identifier
+	Height           uint64
+	TransactionID    Identifier
+	TransactionIndex uint32
+	EventIndex       uint32
+}
+
+// Position returns the position of the located event within the sealed chain.
+func (l EventLocator) Position() EventPosition {
+	return EventPosition{
+		Height:           l.Height,
+		TransactionIndex: l.TransactionIndex,
+		EventIndex:       l.EventIndex,
+	}
+}
+
+// EventFilter selects events from the event index.
+type EventFilter struct {
+	// Types lists the event types to match. An entry ending in EventTypeWildcard matches
+	// every event type with the preceding prefix.
+	Types []EventType
+	// Fields optionally restricts results to events whose decoded payload contains
+	// a field with the given name and string representation of its value.
+	Fields map[string]string
+}
+
+// IsWildcardEventType returns true if the event type is a prefix pattern.
+func IsWildcardEventType(t EventType) bool {
+	return strings.HasSuffix(string(t), EventTypeWildcard)
+}
+
+// EventTypePrefix returns the prefix matched by a wildcard event type, including the trailing dot.
+func EventTypePrefix(t EventType) string {
+	return strings.TrimSuffix(string(t), "*")
+}
+
+// MatchesEventType returns true if the event type t is matched by the (possibly wildcard) pattern.
+func MatchesEventType(pattern EventType, t EventType) bool {
+	if IsWildcardEventType(pattern) {
+		return strings.HasPrefix(string(t), EventTypePrefix(pattern))
+	}
+	return pattern == t
+}
@@ -0,0 +1,58 @@
+package flow_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func TestEventTypeAddress(t *testing.T) {
+	address, ok := flow.EventType("A.0123456789abcdef.FlowToken.TokensDeposited").Address()
+	require.True(t, ok)
+	assert.Equal(t, flow.HexToAddress("0123456789abcdef"), address)
+
+	address, ok = flow.EventType("A.0123456789abcdef.*").Address()
+	require.True(t, ok)
+	assert.Equal(t, flow.HexToAddress("0123456789abcdef"), address)
+
+	_, ok = flow.EventAccountCreated.Address()
+	assert.False(t, ok)
+
+	_, ok = flow.EventType("A.0123.FlowToken.TokensDeposited").Address()
+	assert.False(t, ok)
+}
+
+func TestEventPosition(t *testing.T) {
+	p := flow.EventPosition{Height: 10, TransactionIndex: 2, EventIndex: 3}
+
+	t.Run("cursor round trip", func(t *testing.T) {
+		decoded, err := flow.ParseEventCursor(p.Cursor())
+		require.NoError(t, err)
+		assert.Equal(t, p, decoded)
+
+		_, err = flow.ParseEventCursor("abcd")
+		assert.Error(t, err)
+	})
+
+	t.Run("next", func(t *testing.T) {
+		assert.Equal(t, flow.EventPosition{Height: 10, TransactionIndex: 2, EventIndex: 4}, p.Next())
+		assert.True(t, p.Less(p.Next()))
+
+		last := flow.EventPosition{Height: 10, TransactionIndex: 2, EventIndex: math.MaxUint32}
+		assert.Equal(t, flow.EventPosition{Height: 10, TransactionIndex: 3}, last.Next())
+	})
+}
+
+func TestMatchesEventType(t *testing.T) {
+	eventType := flow.EventType("A.0123456789abcdef.FlowToken.TokensDeposited")
+
+	assert.True(t, flow.MatchesEventType(eventType, eventType))
+	assert.True(t, flow.MatchesEventType("A.0123456789abcdef.*", eventType))
+	assert.True(t, flow.MatchesEventType("A.0123456789abcdef.FlowToken.*", eventType))
+	assert.False(t, flow.MatchesEventType("A.0123456789abcdef.Flow*", eventType))
+	assert.False(t, flow.MatchesEventType("A.0123456789abcdef.FlowToken", eventType))
+}
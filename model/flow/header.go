@@ -43,10 +43,42 @@ type Header struct {
 
 	ProposerSigData []byte // signature of the proposer over the new block. Not a single cryptographic
 	// signature since the data represents cryptographic signatures serialized in some way (concatenation or other)
+
+	LastViewTC *TimeoutCertificate // timeout certificate for the view preceding the block's view.
+	// It is only set if the proposer entered the block's view through a timeout certificate,
+	// i.e. the block's QC is not for the view immediately before the block's view.
 }
 
 // Body returns the immutable part of the block header.
 func (h Header) Body() interface{} {
+	// headers without a timeout certificate keep their original encoding, so
+	// that the IDs of existing blocks do not change
+	if h.LastViewTC != nil {
+		return struct {
+			ChainID            ChainID
+			ParentID           Identifier
+			Height             uint64
+			PayloadHash        Identifier
+			Timestamp          uint64
+			View               uint64
+			ParentVoterIDs     []Identifier
+			ParentVoterSigData []byte
+			ProposerID         Identifier
+			LastViewTCID       Identifier
+		}{
+			ChainID:            h.ChainID,
+			ParentID:           h.ParentID,
+			Height:             h.Height,
+			PayloadHash:        h.PayloadHash,
+			Timestamp:          uint64(h.Timestamp.UnixNano()),
+			View:               h.View,
+			ParentVoterIDs:     h.ParentVoterIDs,
+			ParentVoterSigData: h.ParentVoterSigData,
+			ProposerID:         h.ProposerID,
+			LastViewTCID:       h.LastViewTC.ID(),
+		}
+	}
+
 	return struct {
 		ChainID            ChainID
 		ParentID           Identifier
@@ -92,8 +124,11 @@ func (h Header) ID() Identifier {
 	// unlock at the return
 	defer mutexHeader.Unlock()
 
-	// compare these elements individually
-	if prevHeader.ParentVoterIDs != nil &&
+	// compare these elements individually; headers with a timeout certificate
+	// are never cached
+	if h.LastViewTC == nil &&
+		prevHeader.LastViewTC == nil &&
+		prevHeader.ParentVoterIDs != nil &&
 		prevHeader.ParentVoterSigData != nil &&
 		prevHeader.ProposerSigData != nil &&
 		len(h.ParentVoterIDs) == len(prevHeader.ParentVoterIDs) &&
@@ -0,0 +1,49 @@
+package flow
+
+import (
+	"time"
+)
+
+// RootQCVoteStatus captures the progress of this node's vote for the root QC
+// of its cluster in an upcoming epoch.
+type RootQCVoteStatus uint32
+
+const (
+	// RootQCVoteStatusUnknown - zero value for this enum, indicates unset value
+	RootQCVoteStatusUnknown RootQCVoteStatus = iota
+	// RootQCVoteStatusVoting - this node is submitting its vote, but the vote was not yet observed in the contract.
+	RootQCVoteStatusVoting
+	// RootQCVoteStatusVoted - the vote of this node was observed in the contract.
+	RootQCVoteStatusVoted
+	// RootQCVoteStatusMissed - the setup phase ended before the vote of this node landed in the contract.
+	RootQCVoteStatusMissed
+)
+
+func (status RootQCVoteStatus) String() string {
+	switch status {
+	case RootQCVoteStatusVoting:
+		return "RootQCVoteStatusVoting"
+	case RootQCVoteStatusVoted:
+		return "RootQCVoteStatusVoted"
+	case RootQCVoteStatusMissed:
+		return "RootQCVoteStatusMissed"
+	default:
+		return "RootQCVoteStatusUnknown"
+	}
+}
+
+// RootQCVoteState is the persisted state of this node's vote for the root QC
+// of its cluster in an upcoming epoch. It is maintained by collection nodes,
+// so that voting can be resumed after a restart and missed votes are visible
+// to operators.
+type RootQCVoteState struct {
+	EpochCounter   uint64           // counter of the epoch the root QC is voted for
+	ClusterIndex   uint             // index of this node's cluster in the epoch
+	Status         RootQCVoteStatus // progress of this node's vote
+	Attempts       uint             // number of times this node attempted to vote
+	LastAttempt    time.Time        // time of the latest vote attempt
+	LastError      string           // error of the latest failed vote attempt, empty if it succeeded
+	ClusterMembers IdentifierList   // members of this node's cluster
+	ClusterVoted   IdentifierList   // cluster members whose vote was observed in the contract
+	LastChecked    time.Time        // time the cluster votes were last checked against the contract
+}
@@ -0,0 +1,69 @@
+package flow
+
+import (
+	"fmt"
+)
+
+// DefaultRequiredApprovalsForSealConstruction is the default number of approvals per chunk
+// required to construct a candidate seal.
+const DefaultRequiredApprovalsForSealConstruction = 1
+
+// DefaultRequiredApprovalsForSealVerification is the default number of approvals per chunk
+// required for a seal to be valid. Setting this to 0 disables counting of chunk approvals.
+const DefaultRequiredApprovalsForSealVerification = 0
+
+// DefaultEmergencySealingActive is the default for whether emergency sealing is active. Emergency
+// sealing is a temporary measure while sealing & verification is under development.
+const DefaultEmergencySealingActive = false
+
+// DefaultEmergencySealingThreshold is the default number of finalized blocks on top of the block
+// incorporating a result, after which the result qualifies for emergency sealing.
+const DefaultEmergencySealingThreshold = 100
+
+// SealingParameters are the protocol parameters governing the construction and validation of
+// seals. They are part of the protocol state, so that all consensus nodes apply the same rules:
+// they are set at bootstrap in the root snapshot and can be changed at epoch boundaries through
+// the EpochSetup service event.
+type SealingParameters struct {
+	// RequiredApprovalsForSealConstruction is the min number of approvals per chunk required to
+	// construct a candidate seal.
+	RequiredApprovalsForSealConstruction uint
+	// RequiredApprovalsForSealVerification is the min number of approvals per chunk required for
+	// a seal to be valid. Seals with fewer approvals than required for construction, but at least
+	// as many as required for verification, are emergency seals.
+	RequiredApprovalsForSealVerification uint
+	// EmergencySealingActive determines whether emergency seals are allowed.
+	EmergencySealingActive bool
+	// EmergencySealingThreshold is the min number of blocks on top of the block incorporating a
+	// result, before the result may be emergency sealed.
+	EmergencySealingThreshold uint64
+}
+
+// DefaultSealingParameters returns the sealing parameters used when none are specified in the
+// root snapshot.
+func DefaultSealingParameters() SealingParameters {
+	return SealingParameters{
+		RequiredApprovalsForSealConstruction: DefaultRequiredApprovalsForSealConstruction,
+		RequiredApprovalsForSealVerification: DefaultRequiredApprovalsForSealVerification,
+		EmergencySealingActive:               DefaultEmergencySealingActive,
+		EmergencySealingThreshold:            DefaultEmergencySealingThreshold,
+	}
+}
+
+// Validate checks the consistency of the sealing parameters.
+func (p SealingParameters) Validate() error {
+	if p.RequiredApprovalsForSealVerification > p.RequiredApprovalsForSealConstruction {
+		return fmt.Errorf("required number of approvals for seal construction (%d) cannot be smaller than for seal verification (%d)",
+			p.RequiredApprovalsForSealConstruction, p.RequiredApprovalsForSealVerification)
+	}
+	if p.EmergencySealingActive && p.EmergencySealingThreshold == 0 {
+		return fmt.Errorf("emergency sealing threshold must be positive if emergency sealing is active")
+	}
+	return nil
+}
+
+// EmergencySealable returns true if a result incorporated at the given height may be emergency
+// sealed in a block with the given height.
+func (p SealingParameters) EmergencySealable(incorporatedHeight, height uint64) bool {
+	return p.EmergencySealingActive && incorporatedHeight+p.EmergencySealingThreshold <= height
+}
@@ -0,0 +1,48 @@
+package flow_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func TestSealingParameters_Validate(t *testing.T) {
+
+	t.Run("default parameters are valid", func(t *testing.T) {
+		require.NoError(t, flow.DefaultSealingParameters().Validate())
+	})
+
+	t.Run("more approvals for verification than for construction", func(t *testing.T) {
+		params := flow.SealingParameters{
+			RequiredApprovalsForSealConstruction: 1,
+			RequiredApprovalsForSealVerification: 2,
+		}
+		require.Error(t, params.Validate())
+	})
+
+	t.Run("active emergency sealing without threshold", func(t *testing.T) {
+		params := flow.SealingParameters{
+			RequiredApprovalsForSealConstruction: 2,
+			RequiredApprovalsForSealVerification: 1,
+			EmergencySealingActive:               true,
+		}
+		require.Error(t, params.Validate())
+	})
+}
+
+func TestSealingParameters_EmergencySealable(t *testing.T) {
+	params := flow.SealingParameters{
+		RequiredApprovalsForSealConstruction: 2,
+		RequiredApprovalsForSealVerification: 1,
+		EmergencySealingActive:               true,
+		EmergencySealingThreshold:            10,
+	}
+
+	require.False(t, params.EmergencySealable(100, 109))
+	require.True(t, params.EmergencySealable(100, 110))
+
+	params.EmergencySealingActive = false
+	require.False(t, params.EmergencySealable(100, 110))
+}
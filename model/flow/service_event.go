@@ -11,8 +11,9 @@ import (
 )
 
 const (
-	ServiceEventSetup  = "setup"
-	ServiceEventCommit = "commit"
+	ServiceEventSetup   = "setup"
+	ServiceEventCommit  = "commit"
+	ServiceEventRecover = "recover"
 )
 
 // ServiceEvent represents a service event, which is a special event that when
@@ -87,6 +88,13 @@ func (se *ServiceEvent) UnmarshalJSON(b []byte) error {
 			return err
 		}
 		event = commit
+	case ServiceEventRecover:
+		epochRecover := new(EpochRecover)
+		err = json.Unmarshal(evb, epochRecover)
+		if err != nil {
+			return err
+		}
+		event = epochRecover
 	default:
 		return fmt.Errorf("invalid type: %s", tp)
 	}
@@ -137,6 +145,13 @@ func (se *ServiceEvent) UnmarshalMsgpack(b []byte) error {
 			return err
 		}
 		event = commit
+	case ServiceEventRecover:
+		epochRecover := new(EpochRecover)
+		err = msgpack.Unmarshal(evb, epochRecover)
+		if err != nil {
+			return err
+		}
+		event = epochRecover
 	default:
 		return fmt.Errorf("invalid type: %s", tp)
 	}
@@ -186,6 +201,13 @@ func (se *ServiceEvent) UnmarshalCBOR(b []byte) error {
 			return err
 		}
 		event = commit
+	case ServiceEventRecover:
+		epochRecover := new(EpochRecover)
+		err = cbor.Unmarshal(evb, epochRecover)
+		if err != nil {
+			return err
+		}
+		event = epochRecover
 	default:
 		return fmt.Errorf("invalid type: %s", tp)
 	}
@@ -223,6 +245,17 @@ func (se *ServiceEvent) EqualTo(other *ServiceEvent) (bool, error) {
 			return false, fmt.Errorf("internal invalid type for ServiceEventCommit: %T", other.Event)
 		}
 		return commit.EqualTo(otherCommit), nil
+
+	case ServiceEventRecover:
+		epochRecover, ok := se.Event.(*EpochRecover)
+		if !ok {
+			return false, fmt.Errorf("internal invalid type for ServiceEventRecover: %T", se.Event)
+		}
+		otherEpochRecover, ok := other.Event.(*EpochRecover)
+		if !ok {
+			return false, fmt.Errorf("internal invalid type for ServiceEventRecover: %T", other.Event)
+		}
+		return epochRecover.EqualTo(otherEpochRecover), nil
 	default:
 		return false, fmt.Errorf("unknown serice event type: %s", se.Type)
 	}
@@ -20,6 +20,7 @@ func TestEncodeDecode(t *testing.T) {
 
 	setup := unittest.EpochSetupFixture()
 	commit := unittest.EpochCommitFixture()
+	epochRecover := unittest.EpochRecoverFixture()
 
 	comparePubKey := cmp.FilterValues(func(a, b crypto.PublicKey) bool {
 		return true
@@ -72,6 +73,16 @@ func TestEncodeDecode(t *testing.T) {
 			gotCommit, ok := outer.Event.(*flow.EpochCommit)
 			require.True(t, ok)
 			assert.DeepEqual(t, commit, gotCommit, comparePubKey)
+
+			b, err = json.Marshal(epochRecover.ServiceEvent())
+			require.NoError(t, err)
+
+			outer = new(flow.ServiceEvent)
+			err = json.Unmarshal(b, outer)
+			require.NoError(t, err)
+			gotRecover, ok := outer.Event.(*flow.EpochRecover)
+			require.True(t, ok)
+			assert.DeepEqual(t, epochRecover, gotRecover, comparePubKey)
 		})
 	})
 
@@ -117,6 +128,16 @@ func TestEncodeDecode(t *testing.T) {
 			gotCommit, ok := outer.Event.(*flow.EpochCommit)
 			require.True(t, ok)
 			assert.DeepEqual(t, commit, gotCommit, comparePubKey)
+
+			b, err = msgpack.Marshal(epochRecover.ServiceEvent())
+			require.NoError(t, err)
+
+			outer = new(flow.ServiceEvent)
+			err = msgpack.Unmarshal(b, outer)
+			require.NoError(t, err)
+			gotRecover, ok := outer.Event.(*flow.EpochRecover)
+			require.True(t, ok)
+			assert.DeepEqual(t, epochRecover, gotRecover, comparePubKey)
 		})
 	})
 
@@ -162,6 +183,16 @@ func TestEncodeDecode(t *testing.T) {
 			gotCommit, ok := outer.Event.(*flow.EpochCommit)
 			require.True(t, ok)
 			assert.DeepEqual(t, commit, gotCommit, comparePubKey)
+
+			b, err = cborcodec.EncMode.Marshal(epochRecover.ServiceEvent())
+			require.NoError(t, err)
+
+			outer = new(flow.ServiceEvent)
+			err = cbor.Unmarshal(b, outer)
+			require.NoError(t, err)
+			gotRecover, ok := outer.Event.(*flow.EpochRecover)
+			require.True(t, ok)
+			assert.DeepEqual(t, epochRecover, gotRecover, comparePubKey)
 		})
 	})
 }
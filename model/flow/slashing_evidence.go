@@ -0,0 +1,144 @@
+package flow
+
+import (
+	"fmt"
+)
+
+// SlashingEvidenceType identifies the protocol violation proven by a SlashingEvidence.
+type SlashingEvidenceType string
+
+const (
+	// SlashingEvidenceDoubleProposal proves that a leader proposed two different blocks for the same view.
+	SlashingEvidenceDoubleProposal SlashingEvidenceType = "double_proposal"
+	// SlashingEvidenceDoubleVote proves that a replica voted for two different blocks in the same view.
+	SlashingEvidenceDoubleVote SlashingEvidenceType = "double_vote"
+	// SlashingEvidenceDoubleTimeout proves that a replica signed two different timeouts for the same view.
+	SlashingEvidenceDoubleTimeout SlashingEvidenceType = "double_timeout"
+)
+
+// SlashingVote is a signed HotStuff vote as included in slashing evidence.
+type SlashingVote struct {
+	View     uint64
+	BlockID  Identifier
+	SignerID Identifier
+	SigData  []byte
+}
+
+// ID returns the identifier of the vote.
+func (v *SlashingVote) ID() Identifier {
+	return MakeID(v)
+}
+
+// SlashingTimeout is a signed HotStuff timeout as included in slashing evidence.
+// The signer signs the pair (View, NewestQC.View).
+type SlashingTimeout struct {
+	View       uint64
+	NewestQC   *QuorumCertificate
+	LastViewTC *TimeoutCertificate
+	SignerID   Identifier
+	SigData    []byte
+}
+
+// ID returns the identifier of the timeout.
+func (t *SlashingTimeout) ID() Identifier {
+	return MakeID(t)
+}
+
+// SlashingEvidence is a self-contained proof that a consensus participant violated the protocol.
+// It holds the two conflicting messages, both signed by the offender, so that anybody with
+// access to the offender's public keys can verify the violation. Evidence is collected for
+// the main consensus as well as for the consensus of collector clusters, which are told apart
+// by the ChainID.
+//
+// Exactly one of Proposals, Votes and Timeouts holds the two conflicting messages, depending
+// on the Type of the evidence.
+type SlashingEvidence struct {
+	Type       SlashingEvidenceType
+	ChainID    ChainID    // chain of the consensus instance in which the violation occurred
+	View       uint64     // view in which the violation occurred
+	OffenderID Identifier // node which signed both conflicting messages
+
+	Proposals []*Header          // for double proposals: the two conflicting block headers
+	Votes     []*SlashingVote    // for double votes: the two conflicting votes
+	Timeouts  []*SlashingTimeout // for double timeouts: the two conflicting timeouts
+}
+
+// ID returns the identifier of the slashing evidence. The identifier only depends on the
+// offence, i.e. the type, chain, view and offender, but not on the conflicting messages.
+// Hence, different evidence proving the same offence has the same identifier.
+func (e *SlashingEvidence) ID() Identifier {
+	return MakeID(struct {
+		Type       SlashingEvidenceType
+		ChainID    ChainID
+		View       uint64
+		OffenderID Identifier
+	}{
+		Type:       e.Type,
+		ChainID:    e.ChainID,
+		View:       e.View,
+		OffenderID: e.OffenderID,
+	})
+}
+
+// Validate checks that the evidence is well-formed: it must hold exactly two different messages
+// of the kind matching its type, which were both signed by the offender for the evidence's view.
+// It does not verify the signatures, which requires the offender's public keys.
+func (e *SlashingEvidence) Validate() error {
+	switch e.Type {
+	case SlashingEvidenceDoubleProposal:
+		if len(e.Proposals) != 2 || len(e.Votes) != 0 || len(e.Timeouts) != 0 {
+			return fmt.Errorf("double proposal evidence must hold exactly two proposals")
+		}
+		for _, header := range e.Proposals {
+			if header == nil {
+				return fmt.Errorf("missing proposal")
+			}
+			if header.View != e.View || header.ProposerID != e.OffenderID || header.ChainID != e.ChainID {
+				return fmt.Errorf("proposal %x (view %d, chain %s) was not proposed by %x in view %d on chain %s",
+					header.ID(), header.View, header.ChainID, e.OffenderID, e.View, e.ChainID)
+			}
+		}
+		if e.Proposals[0].ID() == e.Proposals[1].ID() {
+			return fmt.Errorf("proposals are identical")
+		}
+
+	case SlashingEvidenceDoubleVote:
+		if len(e.Votes) != 2 || len(e.Proposals) != 0 || len(e.Timeouts) != 0 {
+			return fmt.Errorf("double vote evidence must hold exactly two votes")
+		}
+		for _, vote := range e.Votes {
+			if vote == nil {
+				return fmt.Errorf("missing vote")
+			}
+			if vote.View != e.View || vote.SignerID != e.OffenderID {
+				return fmt.Errorf("vote for block %x (view %d) was not signed by %x in view %d",
+					vote.BlockID, vote.View, e.OffenderID, e.View)
+			}
+		}
+		if e.Votes[0].BlockID == e.Votes[1].BlockID {
+			return fmt.Errorf("votes are for the same block %x", e.Votes[0].BlockID)
+		}
+
+	case SlashingEvidenceDoubleTimeout:
+		if len(e.Timeouts) != 2 || len(e.Proposals) != 0 || len(e.Votes) != 0 {
+			return fmt.Errorf("double timeout evidence must hold exactly two timeouts")
+		}
+		for _, timeout := range e.Timeouts {
+			if timeout == nil || timeout.NewestQC == nil {
+				return fmt.Errorf("missing timeout or its newest QC")
+			}
+			if timeout.View != e.View || timeout.SignerID != e.OffenderID {
+				return fmt.Errorf("timeout (view %d) was not signed by %x in view %d",
+					timeout.View, e.OffenderID, e.View)
+			}
+		}
+		if e.Timeouts[0].ID() == e.Timeouts[1].ID() {
+			return fmt.Errorf("timeouts are identical")
+		}
+
+	default:
+		return fmt.Errorf("unknown slashing evidence type %q", e.Type)
+	}
+
+	return nil
+}
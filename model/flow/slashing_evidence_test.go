@@ -0,0 +1,76 @@
+package flow_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestSlashingEvidenceID(t *testing.T) {
+	offender := unittest.IdentifierFixture()
+	evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender)
+
+	// evidence for the same offence has the same ID, regardless of the conflicting messages
+	other := *evidence
+	other.Votes = []*flow.SlashingVote{evidence.Votes[1], evidence.Votes[0]}
+	assert.Equal(t, evidence.ID(), other.ID())
+
+	// evidence for a different offence has a different ID
+	other.ChainID = "cluster"
+	assert.NotEqual(t, evidence.ID(), other.ID())
+}
+
+func TestSlashingEvidenceValidate(t *testing.T) {
+	offender := unittest.IdentifierFixture()
+
+	t.Run("valid evidence", func(t *testing.T) {
+		assert.NoError(t, unittest.DoubleProposalEvidenceFixture(flow.Emulator, offender).Validate())
+		assert.NoError(t, unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender).Validate())
+		assert.NoError(t, unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, offender).Validate())
+	})
+
+	t.Run("identical proposals", func(t *testing.T) {
+		evidence := unittest.DoubleProposalEvidenceFixture(flow.Emulator, offender)
+		evidence.Proposals[1] = evidence.Proposals[0]
+		assert.Error(t, evidence.Validate())
+	})
+
+	t.Run("proposal on a different chain", func(t *testing.T) {
+		evidence := unittest.DoubleProposalEvidenceFixture(flow.Emulator, offender)
+		evidence.ChainID = flow.Testnet
+		assert.Error(t, evidence.Validate())
+	})
+
+	t.Run("votes for the same block", func(t *testing.T) {
+		evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender)
+		evidence.Votes[1].BlockID = evidence.Votes[0].BlockID
+		assert.Error(t, evidence.Validate())
+	})
+
+	t.Run("vote by a different signer", func(t *testing.T) {
+		evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender)
+		evidence.Votes[1].SignerID = unittest.IdentifierFixture()
+		assert.Error(t, evidence.Validate())
+	})
+
+	t.Run("timeout for a different view", func(t *testing.T) {
+		evidence := unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, offender)
+		evidence.Timeouts[1].View++
+		assert.Error(t, evidence.Validate())
+	})
+
+	t.Run("missing message", func(t *testing.T) {
+		evidence := unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, offender)
+		evidence.Timeouts = evidence.Timeouts[:1]
+		assert.Error(t, evidence.Validate())
+	})
+
+	t.Run("mismatching type", func(t *testing.T) {
+		evidence := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender)
+		evidence.Type = flow.SlashingEvidenceDoubleTimeout
+		assert.Error(t, evidence.Validate())
+	})
+}
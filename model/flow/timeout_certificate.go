@@ -0,0 +1,34 @@
+package flow
+
+// TimeoutCertificate proves that a super-majority of consensus participants want to abandon the specified View.
+// At its core, a timeout certificate is an aggregation of TimeoutObjects, which individual nodes send to signal
+// their intent to leave the active view. A replica entering view V+1 through a TC for view V does not need to
+// have seen a QC for view V.
+type TimeoutCertificate struct {
+	View uint64
+
+	// NewestQCViews lists for each signer (in the same order as SignerIDs) the view of the newest
+	// QC known to the signer at the time it timed out. Each signer signs its own pair
+	// (View, NewestQCView), so the list is required to verify the aggregated signature.
+	NewestQCViews []uint64
+
+	// NewestQC is the newest QC from all TimeoutObjects that were aggregated for this certificate.
+	// Its view is the maximum of NewestQCViews.
+	NewestQC *QuorumCertificate
+
+	// SignerIDs holds the IDs of HotStuff participants whose TimeoutObjects were included in this
+	// certificate.
+	SignerIDs []Identifier
+
+	// SigData is the aggregated staking signature of all signers. Each signer signs its individual
+	// pair (View, NewestQCView), so the signature aggregates signatures over different messages.
+	SigData []byte
+}
+
+// ID returns the TimeoutCertificate's identifier
+func (t *TimeoutCertificate) ID() Identifier {
+	if t == nil {
+		return ZeroID
+	}
+	return MakeID(t)
+}
@@ -39,3 +39,12 @@ type ClusterBlockVote struct {
 	View    uint64
 	SigData []byte
 }
+
+// ClusterTimeoutObject is part of the collection cluster consensus protocol and
+// represents a collection node timing out in a given round.
+type ClusterTimeoutObject struct {
+	View       uint64
+	NewestQC   *flow.QuorumCertificate
+	LastViewTC *flow.TimeoutCertificate
+	SigData    []byte
+}
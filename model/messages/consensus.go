@@ -18,3 +18,12 @@ type BlockVote struct {
 	View    uint64
 	SigData []byte
 }
+
+// TimeoutObject is part of the consensus protocol and represents a consensus node
+// timing out in a given round. The signer is the origin of the message.
+type TimeoutObject struct {
+	View       uint64
+	NewestQC   *flow.QuorumCertificate
+	LastViewTC *flow.TimeoutCertificate
+	SigData    []byte
+}
@@ -44,3 +44,30 @@ type BroadcastDKGMessage struct {
 	NodeID               flow.Identifier `json:"-"` // NodeID field is added when reading broadcast messages from the DKG contract, this field is ignored when sending broadcast messages
 	Signature            crypto.Signature
 }
+
+// GossipDKGMessage is a DKG broadcast message which is disseminated over the
+// DKG gossip channel instead of the DKG smart-contract. It contains a signature
+// of the DKGMessage, the origin and the sequence number, signed with the
+// staking key of the origin. Nodes relay (echo) every message they receive, so
+// that all participants end up with the same messages, and an origin sending
+// conflicting messages for the same sequence number is detected.
+type GossipDKGMessage struct {
+	DKGMessage
+	OriginID  flow.Identifier // NodeID of the participant which created the message
+	Sequence  uint64          // Sequence is the number of broadcasts sent by the origin prior to this message
+	Signature crypto.Signature
+}
+
+// GossipDKGMessageIn is a wrapper around a GossipDKGMessage containing the
+// network ID of the node which relayed the message.
+type GossipDKGMessageIn struct {
+	GossipDKGMessage
+	RelayerID flow.Identifier
+}
+
+// GossipDKGMessageOut is a wrapper around a GossipDKGMessage containing the
+// network IDs of the destinations.
+type GossipDKGMessageOut struct {
+	GossipDKGMessage
+	DestIDs []flow.Identifier
+}
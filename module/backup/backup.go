@@ -0,0 +1,251 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+)
+
+// protocolDBName is the name of the protocol database backup within a backup.
+const protocolDBName = "protocol.db"
+
+// Config configures which data of a node is backed up.
+type Config struct {
+	NodeID flow.Identifier
+	Role   flow.Role
+	// DB is the protocol database.
+	DB *badger.DB
+	// WAL is the execution state write-ahead log, only set for execution nodes.
+	WAL *wal.DiskWAL
+	// DataEncryptionKey encrypts the protocol database backup, which badger
+	// writes in plaintext. If nil, the backup is not encrypted.
+	DataEncryptionKey []byte
+}
+
+// Create takes a consistent backup of the protocol database and, for execution
+// nodes, of the execution state, while the node is running. The backup is
+// written to the store as objects prefixed with the backup name, the manifest
+// is written last.
+//
+// The protocol database is backed up first, so that the execution state, which
+// is written before execution results are stored in the protocol database,
+// contains the state of all blocks executed as of the protocol database backup.
+func Create(ctx context.Context, log zerolog.Logger, store Store, name string, config Config) (*Manifest, error) {
+
+	err := ValidateName(name)
+	if err != nil {
+		return nil, err
+	}
+	if config.Role == flow.RoleExecution && config.WAL == nil {
+		return nil, fmt.Errorf("execution state is required for execution node backups")
+	}
+
+	var encryption *wal.Encryption
+	if config.DataEncryptionKey != nil {
+		encryption, err = wal.NewEncryption(config.DataEncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data encryption key: %w", err)
+		}
+	}
+
+	manifest := &Manifest{
+		Version:   ManifestVersion,
+		NodeID:    config.NodeID,
+		Role:      config.Role,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	// the backup contains at least the blocks finalized and sealed now
+	err = badgerkv.New(config.DB).View(func(tx kv.Transaction) error {
+		err := operation.RetrieveFinalizedHeight(&manifest.FinalizedHeight)(tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve finalized height: %w", err)
+		}
+		err = operation.LookupBlockHeight(manifest.FinalizedHeight, &manifest.FinalizedBlockID)(tx)
+		if err != nil {
+			return fmt.Errorf("could not look up finalized block: %w", err)
+		}
+		err = operation.RetrieveSealedHeight(&manifest.SealedHeight)(tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve sealed height: %w", err)
+		}
+		err = operation.LookupBlockHeight(manifest.SealedHeight, &manifest.SealedBlockID)(tx)
+		if err != nil {
+			return fmt.Errorf("could not look up sealed block: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log = log.With().Str("backup", name).Logger()
+	log.Info().
+		Uint64("finalized_height", manifest.FinalizedHeight).
+		Uint64("sealed_height", manifest.SealedHeight).
+		Msg("backing up protocol database")
+
+	file, err := write(ctx, store, name, protocolDBName, func(w io.Writer) error {
+		if encryption == nil {
+			_, err := config.DB.Backup(w, 0)
+			return err
+		}
+		encrypted, err := encryption.NewFileWriter(w)
+		if err != nil {
+			return fmt.Errorf("could not create encrypted writer: %w", err)
+		}
+		_, err = config.DB.Backup(encrypted, 0)
+		if err != nil {
+			return err
+		}
+		return encrypted.Close()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not back up protocol database: %w", err)
+	}
+	file.Kind = KindProtocolDB
+	file.Encrypted = encryption != nil
+	manifest.Files = append(manifest.Files, file)
+
+	if config.WAL != nil {
+		files, err := backupLedger(ctx, log, store, name, config.WAL)
+		if err != nil {
+			return nil, fmt.Errorf("could not back up execution state: %w", err)
+		}
+		manifest.Files = append(manifest.Files, files...)
+	}
+
+	err = manifest.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	_, err = write(ctx, store, name, ManifestName, func(w io.Writer) error {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(manifest)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not write manifest: %w", err)
+	}
+
+	log.Info().Int("files", len(manifest.Files)).Msg("backup complete")
+
+	return manifest, nil
+}
+
+// backupLedger copies the latest checkpoint and the write-ahead log segments
+// after it to the store.
+func backupLedger(ctx context.Context, log zerolog.Logger, store Store, name string, diskWAL *wal.DiskWAL) ([]File, error) {
+
+	// all files are opened before copying, so that the compactor removing an old
+	// checkpoint doesn't interfere with the backup
+	sources, err := diskWAL.OpenBackupFiles()
+	if err != nil {
+		return nil, fmt.Errorf("could not open execution state files: %w", err)
+	}
+	defer func() {
+		for _, source := range sources {
+			_ = source.Close()
+		}
+	}()
+
+	files := make([]File, 0, len(sources))
+	for _, source := range sources {
+		filename := filepath.Base(source.Name())
+		log.Info().Str("file", filename).Msg("backing up execution state file")
+
+		file, err := write(ctx, store, name, filename, func(w io.Writer) error {
+			_, err := io.Copy(w, source)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not back up %s: %w", filename, err)
+		}
+		file.Kind = ledgerFileKind(filename)
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// ledgerFileKind returns the kind of a file of the execution state directory.
+func ledgerFileKind(filename string) FileKind {
+	// segment files are named with the segment number only
+	for _, c := range filename {
+		if c < '0' || c > '9' {
+			return KindCheckpoint
+		}
+	}
+	return KindSegment
+}
+
+// write writes an object of the backup and returns its description.
+func write(ctx context.Context, store Store, backup string, name string, fn func(io.Writer) error) (File, error) {
+	// cancelling the context aborts the object, so that failed objects are not created
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer, err := store.Writer(ctx, objectName(backup, name))
+	if err != nil {
+		return File{}, fmt.Errorf("could not create %s: %w", name, err)
+	}
+
+	counter := &hashingWriter{hash: sha256.New()}
+	err = fn(io.MultiWriter(writer, counter))
+	if err != nil {
+		cancel()
+		_ = writer.Close()
+		return File{}, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return File{}, fmt.Errorf("could not complete %s: %w", name, err)
+	}
+
+	return File{
+		Name:   name,
+		Size:   counter.size,
+		SHA256: hex.EncodeToString(counter.hash.Sum(nil)),
+	}, nil
+}
+
+// hashingWriter computes the size and hash of the data written to it.
+type hashingWriter struct {
+	hash hash.Hash
+	size int64
+}
+
+func (h *hashingWriter) Write(p []byte) (int, error) {
+	n, err := h.hash.Write(p)
+	h.size += int64(n)
+	return n, err
+}
+
+// isEmptyDir returns true if the directory doesn't exist or is empty.
+func isEmptyDir(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return len(entries) == 0, nil
+}
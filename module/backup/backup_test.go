@@ -0,0 +1,269 @@
+package backup_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/common/utils"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/backup"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+const (
+	forestCapacity = 100
+	segmentSize    = 32 * 1024
+)
+
+// protocolDBFixture opens a new protocol database with finalized and sealed blocks.
+func protocolDBFixture(t *testing.T, dir string) (*badger.DB, *flow.Header, *flow.Header) {
+	db, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil))
+	require.NoError(t, err)
+
+	sealed := unittest.BlockHeaderFixture()
+	finalized := unittest.BlockHeaderWithParentFixture(&sealed)
+	err = badgerkv.New(db).Update(func(tx kv.Transaction) error {
+		for _, header := range []*flow.Header{&sealed, &finalized} {
+			err := operation.InsertHeader(header.ID(), header)(tx)
+			if err != nil {
+				return err
+			}
+			err = operation.IndexBlockHeight(header.Height, header.ID())(tx)
+			if err != nil {
+				return err
+			}
+		}
+		err := operation.InsertFinalizedHeight(finalized.Height)(tx)
+		if err != nil {
+			return err
+		}
+		return operation.InsertSealedHeight(sealed.Height)(tx)
+	})
+	require.NoError(t, err)
+
+	return db, &sealed, &finalized
+}
+
+// recordUpdates records random updates in the WAL and applies them to the forest,
+// it returns the root hash of the last update.
+func recordUpdates(t *testing.T, diskWAL *wal.DiskWAL, forest *mtrie.Forest, rootHash ledger.RootHash, n int) ledger.RootHash {
+	for i := 0; i < n; i++ {
+		keys := utils.RandomUniqueKeys(10, 2, 16, 16)
+		values := utils.RandomValues(10, 256, 512)
+		update, err := ledger.NewUpdate(ledger.State(rootHash), keys, values)
+		require.NoError(t, err)
+		trieUpdate, err := pathfinder.UpdateToTrieUpdate(update, complete.DefaultPathFinderVersion)
+		require.NoError(t, err)
+
+		require.NoError(t, diskWAL.RecordUpdate(trieUpdate))
+		rootHash, err = forest.Update(trieUpdate)
+		require.NoError(t, err)
+	}
+	return rootHash
+}
+
+func TestBackupRestore(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		ctx := context.Background()
+		store := backup.NewLocalStore(filepath.Join(dir, "backups"))
+
+		db, sealed, finalized := protocolDBFixture(t, filepath.Join(dir, "protocol"))
+		defer db.Close()
+
+		t.Run("protocol database", func(t *testing.T) {
+			manifest, err := backup.Create(ctx, zerolog.Nop(), store, "consensus", backup.Config{
+				NodeID: unittest.IdentifierFixture(),
+				Role:   flow.RoleConsensus,
+				DB:     db,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, finalized.ID(), manifest.FinalizedBlockID)
+			assert.Equal(t, sealed.ID(), manifest.SealedBlockID)
+			require.Len(t, manifest.Files, 1)
+
+			datadir := filepath.Join(dir, "restored-consensus")
+			restored, err := backup.Restore(ctx, zerolog.Nop(), store, "consensus", backup.RestoreConfig{
+				Datadir: datadir,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, manifest.Files, restored.Files)
+
+			restoredDB, err := badger.Open(badger.DefaultOptions(datadir).WithLogger(nil))
+			require.NoError(t, err)
+			defer restoredDB.Close()
+			var header flow.Header
+			require.NoError(t, badgerkv.New(restoredDB).View(operation.RetrieveHeader(finalized.ID(), &header)))
+			assert.Equal(t, finalized.ID(), header.ID())
+
+			// the directory is not empty anymore
+			_, err = backup.Restore(ctx, zerolog.Nop(), store, "consensus", backup.RestoreConfig{
+				Datadir: datadir,
+			})
+			assert.Error(t, err)
+		})
+
+		t.Run("encrypted protocol database", func(t *testing.T) {
+			key := make([]byte, 32)
+			_, err := rand.Read(key)
+			require.NoError(t, err)
+
+			manifest, err := backup.Create(ctx, zerolog.Nop(), store, "encrypted", backup.Config{
+				NodeID:            unittest.IdentifierFixture(),
+				Role:              flow.RoleAccess,
+				DB:                db,
+				DataEncryptionKey: key,
+			})
+			require.NoError(t, err)
+			assert.True(t, manifest.Files[0].Encrypted)
+
+			_, err = backup.Restore(ctx, zerolog.Nop(), store, "encrypted", backup.RestoreConfig{
+				Datadir: filepath.Join(dir, "restored-plain"),
+			})
+			assert.Error(t, err)
+
+			datadir := filepath.Join(dir, "restored-encrypted")
+			_, err = backup.Restore(ctx, zerolog.Nop(), store, "encrypted", backup.RestoreConfig{
+				Datadir:           datadir,
+				DataEncryptionKey: key,
+			})
+			require.NoError(t, err)
+
+			restoredDB, err := badger.Open(badger.DefaultOptions(datadir).WithLogger(nil).WithEncryptionKey(key))
+			require.NoError(t, err)
+			defer restoredDB.Close()
+			var header flow.Header
+			require.NoError(t, badgerkv.New(restoredDB).View(operation.RetrieveHeader(sealed.ID(), &header)))
+		})
+
+		t.Run("execution state", func(t *testing.T) {
+			triedir := filepath.Join(dir, "trie")
+			diskWAL, err := wal.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), triedir, forestCapacity, pathfinder.PathByteSize, segmentSize)
+			require.NoError(t, err)
+			defer func() { <-diskWAL.Done() }()
+
+			forest, err := mtrie.NewForest(forestCapacity, metrics.NewNoopCollector(), nil)
+			require.NoError(t, err)
+			rootHash := recordUpdates(t, diskWAL, forest, forest.GetEmptyRootHash(), 20)
+
+			// checkpoint some of the segments, so that the backup contains a checkpoint
+			checkpointer, err := diskWAL.NewCheckpointer()
+			require.NoError(t, err)
+			_, last, err := diskWAL.Segments()
+			require.NoError(t, err)
+			require.Greater(t, last, 1)
+			err = checkpointer.Checkpoint(last-1, func() (io.WriteCloser, error) {
+				return checkpointer.CheckpointWriter(last - 1)
+			})
+			require.NoError(t, err)
+
+			rootHash = recordUpdates(t, diskWAL, forest, rootHash, 10)
+
+			manifest, err := backup.Create(ctx, zerolog.Nop(), store, "execution", backup.Config{
+				NodeID: unittest.IdentifierFixture(),
+				Role:   flow.RoleExecution,
+				DB:     db,
+				WAL:    diskWAL,
+			})
+			require.NoError(t, err)
+			require.Len(t, manifest.LedgerFiles(), len(manifest.Files)-1)
+			assert.Equal(t, wal.NumberToFilename(last-1), manifest.LedgerFiles()[0].Name)
+
+			// updates after the backup are not part of it
+			_ = recordUpdates(t, diskWAL, forest, rootHash, 10)
+
+			restoredTriedir := filepath.Join(dir, "restored-trie")
+			_, err = backup.Restore(ctx, zerolog.Nop(), store, "execution", backup.RestoreConfig{
+				Datadir: filepath.Join(dir, "restored-execution"),
+				Triedir: restoredTriedir,
+			})
+			require.NoError(t, err)
+
+			restoredWAL, err := wal.NewDiskWAL(zerolog.Nop(), nil, metrics.NewNoopCollector(), restoredTriedir, forestCapacity, pathfinder.PathByteSize, segmentSize)
+			require.NoError(t, err)
+			defer func() { <-restoredWAL.Done() }()
+
+			restoredForest, err := mtrie.NewForest(forestCapacity, metrics.NewNoopCollector(), nil)
+			require.NoError(t, err)
+			require.NoError(t, restoredWAL.ReplayOnForest(restoredForest))
+
+			_, err = restoredForest.GetTrie(rootHash)
+			assert.NoError(t, err)
+		})
+
+		t.Run("corrupted file", func(t *testing.T) {
+			_, err := backup.Create(ctx, zerolog.Nop(), store, "corrupted", backup.Config{
+				NodeID: unittest.IdentifierFixture(),
+				Role:   flow.RoleCollection,
+				DB:     db,
+			})
+			require.NoError(t, err)
+
+			path := filepath.Join(dir, "backups", "corrupted", "protocol.db")
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			data[len(data)/2] ^= 0xff
+			require.NoError(t, os.WriteFile(path, data, 0600))
+
+			datadir := filepath.Join(dir, "restored-corrupted")
+			_, err = backup.Restore(ctx, zerolog.Nop(), store, "corrupted", backup.RestoreConfig{
+				Datadir: datadir,
+			})
+			assert.Error(t, err)
+			assert.NoDirExists(t, datadir)
+		})
+	})
+}
+
+func TestManifest_Validate(t *testing.T) {
+	valid := func() *backup.Manifest {
+		return &backup.Manifest{
+			Version: backup.ManifestVersion,
+			Role:    flow.RoleExecution,
+			Files: []backup.File{
+				{Name: "protocol.db", Kind: backup.KindProtocolDB, SHA256: unittest.IdentifierFixture().String()},
+				{Name: "checkpoint.00000001", Kind: backup.KindCheckpoint, SHA256: unittest.IdentifierFixture().String()},
+				{Name: "00000001", Kind: backup.KindSegment, SHA256: unittest.IdentifierFixture().String()},
+			},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	invalid := map[string]func(*backup.Manifest){
+		"unknown version":        func(m *backup.Manifest) { m.Version++ },
+		"sealed above finalized": func(m *backup.Manifest) { m.SealedHeight = 1 },
+		"missing protocol db":    func(m *backup.Manifest) { m.Files = m.Files[1:] },
+		"missing segments":       func(m *backup.Manifest) { m.Files = m.Files[:2] },
+		"ledger files for other roles": func(m *backup.Manifest) {
+			m.Role = flow.RoleVerification
+		},
+		"path in name":   func(m *backup.Manifest) { m.Files[2].Name = "../00000001" },
+		"duplicate name": func(m *backup.Manifest) { m.Files[2].Name = m.Files[1].Name },
+		"invalid hash":   func(m *backup.Manifest) { m.Files[0].SHA256 = "00" },
+		"unknown kind":   func(m *backup.Manifest) { m.Files[1].Kind = "blob" },
+	}
+	for name, modify := range invalid {
+		t.Run(name, func(t *testing.T) {
+			manifest := valid()
+			modify(manifest)
+			assert.Error(t, manifest.Validate())
+		})
+	}
+}
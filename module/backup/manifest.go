@@ -0,0 +1,155 @@
+package backup
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ManifestVersion is the version of the manifest format written by Create.
+const ManifestVersion = 1
+
+// ManifestName is the name of the manifest object within a backup.
+const ManifestName = "manifest.json"
+
+// FileKind is the kind of data a backed up file contains.
+type FileKind string
+
+const (
+	// KindProtocolDB is a badger backup of the protocol database.
+	KindProtocolDB FileKind = "protocol-db"
+	// KindCheckpoint is an execution state checkpoint, possibly the root checkpoint.
+	KindCheckpoint FileKind = "checkpoint"
+	// KindSegment is a segment of the execution state write-ahead log.
+	KindSegment FileKind = "wal-segment"
+)
+
+// File describes a file of a backup.
+type File struct {
+	// Name is the name of the object within the backup. For execution state
+	// files, it is also the name of the file in the execution state directory.
+	Name string
+	Kind FileKind
+	Size int64
+	// SHA256 is the hex encoded SHA-256 hash of the object.
+	SHA256 string
+	// Encrypted is true if the protocol database backup is encrypted with the
+	// node's data encryption key. Execution state files are copied as they
+	// are, and are encrypted if the node encrypts its execution state.
+	Encrypted bool
+}
+
+// Manifest describes a backup. The protocol database contains at least the
+// blocks finalized and sealed at the heights recorded in the manifest; it can
+// contain later blocks, which were finalized while the backup was taken. The
+// execution state contains at least the state of the blocks executed as of
+// the protocol database.
+type Manifest struct {
+	Version          uint
+	NodeID           flow.Identifier
+	Role             flow.Role
+	CreatedAt        time.Time
+	FinalizedHeight  uint64
+	FinalizedBlockID flow.Identifier
+	SealedHeight     uint64
+	SealedBlockID    flow.Identifier
+	Files            []File
+}
+
+// Validate checks that the manifest is well-formed and describes a complete
+// backup for the node's role.
+func (m *Manifest) Validate() error {
+	if m.Version != ManifestVersion {
+		return fmt.Errorf("unsupported manifest version %d (expected %d)", m.Version, ManifestVersion)
+	}
+	if !m.Role.Valid() {
+		return fmt.Errorf("invalid role %d", m.Role)
+	}
+	if m.SealedHeight > m.FinalizedHeight {
+		return fmt.Errorf("sealed height %d is above finalized height %d", m.SealedHeight, m.FinalizedHeight)
+	}
+
+	names := make(map[string]struct{}, len(m.Files))
+	counts := make(map[FileKind]int)
+	for _, file := range m.Files {
+		if file.Name == "" || file.Name == ManifestName || strings.ContainsAny(file.Name, `/\`) || file.Name == "." || file.Name == ".." {
+			return fmt.Errorf("invalid file name %q", file.Name)
+		}
+		if _, ok := names[file.Name]; ok {
+			return fmt.Errorf("duplicate file %s", file.Name)
+		}
+		names[file.Name] = struct{}{}
+
+		switch file.Kind {
+		case KindProtocolDB:
+		case KindCheckpoint, KindSegment:
+			if file.Encrypted {
+				return fmt.Errorf("file %s: only the protocol database can be encrypted by the backup", file.Name)
+			}
+		default:
+			return fmt.Errorf("file %s: unknown kind %q", file.Name, file.Kind)
+		}
+		counts[file.Kind]++
+
+		if file.Size < 0 {
+			return fmt.Errorf("file %s: invalid size %d", file.Name, file.Size)
+		}
+		hash, err := hex.DecodeString(file.SHA256)
+		if err != nil || len(hash) != 32 {
+			return fmt.Errorf("file %s: invalid SHA-256 hash %q", file.Name, file.SHA256)
+		}
+	}
+
+	if counts[KindProtocolDB] != 1 {
+		return fmt.Errorf("expected exactly one protocol database backup, got %d", counts[KindProtocolDB])
+	}
+	if m.Role == flow.RoleExecution {
+		if counts[KindSegment] == 0 {
+			return fmt.Errorf("execution node backup has no write-ahead log segments")
+		}
+		if counts[KindCheckpoint] > 1 {
+			return fmt.Errorf("expected at most one checkpoint, got %d", counts[KindCheckpoint])
+		}
+	} else if counts[KindCheckpoint]+counts[KindSegment] > 0 {
+		return fmt.Errorf("%s node backup contains execution state", m.Role)
+	}
+
+	return nil
+}
+
+// LedgerFiles returns the execution state files of the backup.
+func (m *Manifest) LedgerFiles() []File {
+	var files []File
+	for _, file := range m.Files {
+		if file.Kind == KindCheckpoint || file.Kind == KindSegment {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// DefaultName returns the name of a backup of the given node taken at the given time.
+func DefaultName(role flow.Role, nodeID flow.Identifier, createdAt time.Time) string {
+	return fmt.Sprintf("%s-%s-%s", role, nodeID, createdAt.UTC().Format("20060102T150405Z"))
+}
+
+// ValidateName checks that the backup name is a relative, slash-separated path
+// within the store.
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("backup name must not be empty")
+	}
+	if path.IsAbs(name) || path.Clean(name) != name || name == "." || strings.HasPrefix(name, "../") || name == ".." || strings.Contains(name, `\`) {
+		return fmt.Errorf("invalid backup name %q", name)
+	}
+	return nil
+}
+
+// objectName returns the name of the object of a backup in the store.
+func objectName(backup string, name string) string {
+	return path.Join(backup, name)
+}
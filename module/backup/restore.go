@@ -0,0 +1,259 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+)
+
+// RestoreConfig configures where a backup is restored to.
+type RestoreConfig struct {
+	// Datadir is the directory of the protocol database, it must be empty.
+	Datadir string
+	// Triedir is the directory of the execution state, it must be empty. It is
+	// only required for execution node backups.
+	Triedir string
+	// DataEncryptionKey decrypts encrypted backups, and encrypts the restored
+	// protocol database. It must be set if the node encrypts its data.
+	DataEncryptionKey []byte
+}
+
+// ReadManifest reads the manifest of the backup with the given name and
+// validates it.
+func ReadManifest(ctx context.Context, store Store, name string) (*Manifest, error) {
+	err := ValidateName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := store.Reader(ctx, objectName(name, ManifestName))
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest: %w", err)
+	}
+	defer reader.Close()
+
+	var manifest Manifest
+	err = json.NewDecoder(reader).Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode manifest: %w", err)
+	}
+	err = manifest.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// Restore restores the backup with the given name into empty directories.
+// The manifest is validated first, and every file is checked against the size
+// and hash in the manifest before it is used. The restored protocol database
+// must contain the finalized and sealed blocks of the manifest.
+func Restore(ctx context.Context, log zerolog.Logger, store Store, name string, config RestoreConfig) (*Manifest, error) {
+
+	manifest, err := ReadManifest(ctx, store, name)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerFiles := manifest.LedgerFiles()
+	if len(ledgerFiles) > 0 && config.Triedir == "" {
+		return nil, fmt.Errorf("backup contains execution state, but no execution state directory is given")
+	}
+
+	dirs := []string{config.Datadir}
+	if len(ledgerFiles) > 0 {
+		dirs = append(dirs, config.Triedir)
+	}
+	for _, dir := range dirs {
+		empty, err := isEmptyDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("could not check directory %s: %w", dir, err)
+		}
+		if !empty {
+			return nil, fmt.Errorf("directory %s is not empty", dir)
+		}
+	}
+
+	var encryption *wal.Encryption
+	if config.DataEncryptionKey != nil {
+		encryption, err = wal.NewEncryption(config.DataEncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data encryption key: %w", err)
+		}
+	}
+
+	log = log.With().Str("backup", name).Logger()
+
+	for _, file := range manifest.Files {
+		if file.Kind != KindProtocolDB {
+			continue
+		}
+		if file.Encrypted && encryption == nil {
+			return nil, fmt.Errorf("protocol database backup is encrypted, but no data encryption key is given")
+		}
+
+		log.Info().Str("file", file.Name).Msg("restoring protocol database")
+		err = restoreProtocolDB(ctx, store, name, file, config, encryption, manifest)
+		if err != nil {
+			return nil, fmt.Errorf("could not restore protocol database: %w", err)
+		}
+	}
+
+	if len(ledgerFiles) > 0 {
+		err = os.MkdirAll(config.Triedir, 0700)
+		if err != nil {
+			return nil, fmt.Errorf("could not create execution state directory: %w", err)
+		}
+	}
+	for _, file := range ledgerFiles {
+		log.Info().Str("file", file.Name).Msg("restoring execution state file")
+
+		path := filepath.Join(config.Triedir, file.Name)
+		err = download(ctx, store, name, file, path+".tmp")
+		if err != nil {
+			return nil, fmt.Errorf("could not restore %s: %w", file.Name, err)
+		}
+		err = os.Rename(path+".tmp", path)
+		if err != nil {
+			return nil, fmt.Errorf("could not rename %s: %w", file.Name, err)
+		}
+	}
+
+	log.Info().Msg("restore complete")
+
+	return manifest, nil
+}
+
+// restoreProtocolDB downloads and verifies the protocol database backup, then
+// loads it into a new database and checks that it contains the blocks of the
+// manifest.
+func restoreProtocolDB(ctx context.Context, store Store, name string, file File, config RestoreConfig, encryption *wal.Encryption, manifest *Manifest) error {
+
+	// the backup is downloaded next to the database, so that it is verified
+	// before anything is loaded into the database
+	path := filepath.Clean(config.Datadir) + ".backup"
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return fmt.Errorf("could not create parent directory of %s: %w", config.Datadir, err)
+	}
+	err = download(ctx, store, name, file, path)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open downloaded backup: %w", err)
+	}
+	defer f.Close()
+
+	var reader io.Reader = f
+	if file.Encrypted {
+		reader, err = encryption.NewFileReader(f)
+		if err != nil {
+			return fmt.Errorf("could not decrypt backup: %w", err)
+		}
+	}
+
+	db, err := badger.Open(badger.DefaultOptions(config.Datadir).WithLogger(nil).WithEncryptionKey(config.DataEncryptionKey))
+	if err != nil {
+		return fmt.Errorf("could not open database: %w", err)
+	}
+
+	err = db.Load(reader, 256)
+	if err != nil {
+		_ = db.Close()
+		return fmt.Errorf("could not load backup: %w", err)
+	}
+
+	err = badgerkv.New(db).View(func(tx kv.Transaction) error {
+		err := checkBlock(tx, "finalized", operation.RetrieveFinalizedHeight, manifest.FinalizedHeight, manifest.FinalizedBlockID)
+		if err != nil {
+			return err
+		}
+		return checkBlock(tx, "sealed", operation.RetrieveSealedHeight, manifest.SealedHeight, manifest.SealedBlockID)
+	})
+	closeErr := db.Close()
+	if err != nil {
+		return fmt.Errorf("restored database does not match manifest: %w", err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("could not close database: %w", closeErr)
+	}
+	return nil
+}
+
+// checkBlock checks that the block with the given ID is finalized at the given
+// height, and that the database's latest (finalized or sealed) height is not
+// below it.
+func checkBlock(tx kv.Transaction, kind string, retrieveLatest func(*uint64) func(kv.Transaction) error, height uint64, blockID flow.Identifier) error {
+	var latest uint64
+	err := retrieveLatest(&latest)(tx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve %s height: %w", kind, err)
+	}
+	if latest < height {
+		return fmt.Errorf("%s height %d is below %d", kind, latest, height)
+	}
+
+	var id flow.Identifier
+	err = operation.LookupBlockHeight(height, &id)(tx)
+	if err != nil {
+		return fmt.Errorf("could not look up %s block at height %d: %w", kind, height, err)
+	}
+	if id != blockID {
+		return fmt.Errorf("%s block at height %d is %x, expected %x", kind, height, id, blockID)
+	}
+	return nil
+}
+
+// download copies an object of the backup to the given path, and checks its
+// size and hash against the manifest. The file is removed if the check fails.
+func download(ctx context.Context, store Store, backup string, file File, path string) error {
+	reader, err := store.Reader(ctx, objectName(backup, file.Name))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("could not create %s: %w", path, err)
+	}
+
+	counter := &hashingWriter{hash: sha256.New()}
+	_, err = io.Copy(io.MultiWriter(f, counter), reader)
+	if err == nil {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil && counter.size != file.Size {
+		err = fmt.Errorf("size mismatch for %s: got %d bytes, expected %d", file.Name, counter.size, file.Size)
+	}
+	if hash := hex.EncodeToString(counter.hash.Sum(nil)); err == nil && hash != file.SHA256 {
+		err = fmt.Errorf("hash mismatch for %s: got %s, expected %s", file.Name, hash, file.SHA256)
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+	return nil
+}
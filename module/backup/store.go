@@ -0,0 +1,239 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"cloud.google.com/go/storage"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Store is where backups are written to and restored from. Objects are
+// identified by slash-separated names, the objects of a backup share the
+// backup name as prefix.
+type Store interface {
+	// Writer returns a writer for the object with the given name. The object
+	// is only complete once the writer is closed without error.
+	Writer(ctx context.Context, name string) (io.WriteCloser, error)
+
+	// Reader returns a reader for the object with the given name.
+	Reader(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// StoreConfig selects where backups are stored, exactly one of the fields must be set.
+type StoreConfig struct {
+	// Dir is a local directory.
+	Dir string
+	// GCSBucket is the name of a GCP bucket.
+	GCSBucket string
+	// S3Bucket is the name of a S3 bucket, the client is configured from the environment.
+	S3Bucket string
+}
+
+// NewStore returns the store selected by the config.
+func NewStore(ctx context.Context, config StoreConfig) (Store, error) {
+	set := 0
+	for _, location := range []string{config.Dir, config.GCSBucket, config.S3Bucket} {
+		if location != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one of directory, GCP bucket and S3 bucket must be set")
+	}
+
+	switch {
+	case config.Dir != "":
+		return NewLocalStore(config.Dir), nil
+	case config.GCSBucket != "":
+		return NewGCSStore(ctx, config.GCSBucket)
+	default:
+		awsConfig, err := awsconfig.LoadDefaultConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not load AWS configuration: %w", err)
+		}
+		return NewS3Store(s3.NewFromConfig(awsConfig), config.S3Bucket), nil
+	}
+}
+
+var _ Store = (*LocalStore)(nil)
+
+// LocalStore stores backups in a local directory.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore returns a store for backups in the given directory.
+func NewLocalStore(dir string) *LocalStore {
+	return &LocalStore{
+		dir: dir,
+	}
+}
+
+// Writer writes the object to a temporary file, which is renamed once the
+// writer is closed, so that incomplete objects are never visible. If the
+// context is cancelled, the object is discarded when the writer is closed.
+func (s *LocalStore) Writer(ctx context.Context, name string) (io.WriteCloser, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(name))
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("could not create directory for %s: %w", name, err)
+	}
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, fmt.Errorf("could not create %s: %w", name, err)
+	}
+	return &localWriter{
+		File: file,
+		ctx:  ctx,
+		path: path,
+	}, nil
+}
+
+func (s *LocalStore) Reader(_ context.Context, name string) (io.ReadCloser, error) {
+	file, err := os.Open(filepath.Join(s.dir, filepath.FromSlash(name)))
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s: %w", name, err)
+	}
+	return file, nil
+}
+
+type localWriter struct {
+	*os.File
+	ctx  context.Context
+	path string
+}
+
+func (w *localWriter) Close() error {
+	if w.ctx.Err() != nil {
+		_ = w.File.Close()
+		_ = os.Remove(w.File.Name())
+		return w.ctx.Err()
+	}
+	err := w.File.Sync()
+	if err != nil {
+		_ = w.File.Close()
+		return fmt.Errorf("could not sync %s: %w", w.File.Name(), err)
+	}
+	err = w.File.Close()
+	if err != nil {
+		return fmt.Errorf("could not close %s: %w", w.File.Name(), err)
+	}
+	return os.Rename(w.File.Name(), w.path)
+}
+
+var _ Store = (*GCSStore)(nil)
+
+// GCSStore stores backups in a GCP bucket.
+type GCSStore struct {
+	bucket *storage.BucketHandle
+}
+
+// NewGCSStore returns a store for backups in the given GCP bucket.
+func NewGCSStore(ctx context.Context, bucketName string) (*GCSStore, error) {
+
+	// no need to close the client according to documentation
+	// https://pkg.go.dev/cloud.google.com/go/storage#Client.Close
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create GCP Bucket client: %w", err)
+	}
+	bucket := client.Bucket(bucketName)
+
+	// try accessing buckets to validate settings
+	_, err = bucket.Attrs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing bucket attributes: %w", err)
+	}
+
+	return &GCSStore{
+		bucket: bucket,
+	}, nil
+}
+
+// Writer uploads the object, which is only created in the bucket once the
+// writer is closed.
+func (s *GCSStore) Writer(ctx context.Context, name string) (io.WriteCloser, error) {
+	return s.bucket.Object(name).NewWriter(ctx), nil
+}
+
+func (s *GCSStore) Reader(ctx context.Context, name string) (io.ReadCloser, error) {
+	reader, err := s.bucket.Object(name).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read object %s: %w", name, err)
+	}
+	return reader, nil
+}
+
+var _ Store = (*S3Store)(nil)
+
+// S3Store stores backups in a S3 bucket.
+type S3Store struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewS3Store returns a store for backups in the given S3 bucket.
+func NewS3Store(client *s3.Client, bucket string) *S3Store {
+	return &S3Store{
+		client: client,
+		bucket: bucket,
+	}
+}
+
+// Writer streams the object to S3 in a multipart upload, which is completed
+// once the writer is closed.
+func (s *S3Store) Writer(ctx context.Context, name string) (io.WriteCloser, error) {
+	reader, writer := io.Pipe()
+	w := &s3Writer{
+		PipeWriter: writer,
+		ctx:        ctx,
+		done:       make(chan error, 1),
+	}
+
+	uploader := manager.NewUploader(s.client)
+	go func() {
+		_, err := uploader.Upload(ctx, &s3.PutObjectInput{
+			Bucket: &s.bucket,
+			Key:    &name,
+			Body:   reader,
+		})
+		// unblock writes if the upload failed
+		_ = reader.CloseWithError(err)
+		w.done <- err
+	}()
+
+	return w, nil
+}
+
+func (s *S3Store) Reader(ctx context.Context, name string) (io.ReadCloser, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    &name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read object %s: %w", name, err)
+	}
+	return output.Body, nil
+}
+
+type s3Writer struct {
+	*io.PipeWriter
+	ctx  context.Context
+	done chan error
+}
+
+// Close finishes the upload and waits for it to complete. If the context is
+// cancelled, the upload is aborted.
+func (w *s3Writer) Close() error {
+	err := w.PipeWriter.CloseWithError(w.ctx.Err())
+	if err != nil {
+		return err
+	}
+	return <-w.done
+}
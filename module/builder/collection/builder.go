@@ -7,7 +7,6 @@ import (
 	"math"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/opentracing/opentracing-go"
 
 	"github.com/onflow/flow-go/model/cluster"
@@ -18,6 +17,7 @@ import (
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/procedure"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // Builder is the builder for collection block payloads. Upon providing a
@@ -27,7 +27,7 @@ import (
 // HotStuff event loop is the only consumer of this interface and is single
 // threaded, this is OK.
 type Builder struct {
-	db             *badger.DB
+	db             kv.DB
 	mainHeaders    storage.Headers
 	clusterHeaders storage.Headers
 	payloads       storage.ClusterPayloads
@@ -37,7 +37,7 @@ type Builder struct {
 }
 
 func NewBuilder(
-	db *badger.DB,
+	db kv.DB,
 	tracer module.Tracer,
 	mainHeaders storage.Headers,
 	clusterHeaders storage.Headers,
@@ -72,7 +72,7 @@ func (b *Builder) BuildOn(parentID flow.Identifier, setter func(*flow.Header) er
 
 	// first we construct a proposal in-memory, ensuring it is a valid extension
 	// of chain state -- this can be done in a read-only transaction
-	err := b.db.View(func(tx *badger.Txn) error {
+	err := b.db.View(func(tx kv.Transaction) error {
 
 		// STEP ONE: Load some things we need to do our work.
 		// TODO (ramtin): enable this again
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -28,6 +27,8 @@ import (
 	"github.com/onflow/flow-go/state/protocol/util"
 	storage "github.com/onflow/flow-go/storage/badger"
 	"github.com/onflow/flow-go/storage/badger/procedure"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 	sutil "github.com/onflow/flow-go/storage/util"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -36,7 +37,7 @@ var noopSetter = func(*flow.Header) error { return nil }
 
 type BuilderSuite struct {
 	suite.Suite
-	db    *badger.DB
+	db    kv.DB
 	dbdir string
 
 	genesis *model.Block
@@ -68,7 +69,7 @@ func (suite *BuilderSuite) SetupTest() {
 	suite.pool = herocache.NewTransactions(1000, unittest.Logger(), metrics.NewNoopCollector())
 
 	suite.dbdir = unittest.TempDir(suite.T())
-	suite.db = unittest.BadgerDB(suite.T(), suite.dbdir)
+	suite.db = badgerkv.New(unittest.BadgerDB(suite.T(), suite.dbdir))
 
 	metrics := metrics.NewNoopCollector()
 	tracer := trace.NewNoopTracer()
@@ -832,7 +833,7 @@ func benchmarkBuildOn(b *testing.B, size int) {
 		suite.pool = herocache.NewTransactions(1000, unittest.Logger(), metrics.NewNoopCollector())
 
 		suite.dbdir = unittest.TempDir(b)
-		suite.db = unittest.BadgerDB(b, suite.dbdir)
+		suite.db = badgerkv.New(unittest.BadgerDB(b, suite.dbdir))
 		defer func() {
 			err = suite.db.Close()
 			assert.Nil(b, err)
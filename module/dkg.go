@@ -75,6 +75,11 @@ type DKGController interface {
 	// SubmitResult instructs the broker to publish the results of the DKG run
 	// (ex. publish to DKG smart contract).
 	SubmitResult() error
+
+	// GetTranscript returns a structured summary of the DKG run, as observed by
+	// this node, including phase transitions, received broadcasts, complaints,
+	// disqualifications and the submitted result.
+	GetTranscript() *flow.DKGTranscriptSummary
 }
 
 // DKGControllerFactory is a factory to create instances of DKGController.
@@ -13,11 +13,51 @@ import (
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/model/fingerprint"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/flow/filter"
 	"github.com/onflow/flow-go/model/messages"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/retrymiddleware"
 )
 
+// BroadcastTransport determines how the Broker disseminates DKG broadcast messages.
+type BroadcastTransport int
+
+const (
+	// ContractBroadcast sends broadcast messages as transactions to the DKG
+	// smart-contract, and reads the broadcast messages of other participants
+	// by polling the smart-contract.
+	ContractBroadcast BroadcastTransport = iota
+	// P2PBroadcast sends broadcast messages over the DKG gossip channel, and
+	// relies on an echo broadcast so that no two participants deliver
+	// conflicting messages. Unlike the smart-contract, it does not guarantee
+	// that all participants deliver every message. The DKG smart-contract is
+	// only used to submit the result.
+	P2PBroadcast
+)
+
+func (t BroadcastTransport) String() string {
+	switch t {
+	case ContractBroadcast:
+		return "contract"
+	case P2PBroadcast:
+		return "p2p"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseBroadcastTransport parses the string representation of a broadcast transport.
+func ParseBroadcastTransport(transport string) (BroadcastTransport, error) {
+	switch transport {
+	case ContractBroadcast.String():
+		return ContractBroadcast, nil
+	case P2PBroadcast.String():
+		return P2PBroadcast, nil
+	default:
+		return 0, fmt.Errorf("invalid dkg broadcast transport %q, expected %q or %q", transport, ContractBroadcast, P2PBroadcast)
+	}
+}
+
 // BrokerOpt is a functional option which modifies the DKG Broker config.
 type BrokerOpt func(*BrokerConfig)
 
@@ -38,6 +78,8 @@ type BrokerConfig struct {
 	// RetryJitterPct is the percentage jitter to introduce to each retry interval
 	// for all retryable requests.
 	RetryJitterPct uint64
+	// BroadcastTransport determines how broadcast messages are disseminated.
+	BroadcastTransport BroadcastTransport
 }
 
 // DefaultBrokerConfig returns the default config for the DKG Broker component.
@@ -48,12 +90,23 @@ func DefaultBrokerConfig() BrokerConfig {
 		RetryMaxConsecutiveFailures: 2,
 		RetryInitialWait:            time.Second,
 		RetryJitterPct:              25,
+		BroadcastTransport:          ContractBroadcast,
+	}
+}
+
+// WithBroadcastTransport sets the transport used to disseminate broadcast messages.
+func WithBroadcastTransport(transport BroadcastTransport) BrokerOpt {
+	return func(config *BrokerConfig) {
+		config.BroadcastTransport = transport
 	}
 }
 
 // Broker is an implementation of the DKGBroker interface which is intended to
 // be used in conjunction with the DKG MessagingEngine for private messages, and
-// with the DKG smart-contract for broadcast messages.
+// with the DKG smart-contract for broadcast messages. Alternatively, broadcast
+// messages can be disseminated over the DKG gossip channel of the
+// MessagingEngine (see P2PBroadcast), in which case the smart-contract is only
+// used to publish the result.
 type Broker struct {
 	config                    BrokerConfig
 	log                       zerolog.Logger
@@ -69,6 +122,8 @@ type Broker struct {
 	broadcastMsgCh            chan messages.BroadcastDKGMessage // channel to forward incoming broadcast messages to consumers
 	messageOffset             uint                              // offset for next broadcast messages to fetch
 	shutdownCh                chan struct{}                     // channel to stop the broker from listening
+	transcript                *Transcript                       // structured summary of the DKG as observed by this node
+	echo                      *EchoBroadcast                    // tracks broadcast messages received over the gossip channel, nil unless using P2PBroadcast
 
 	broadcasts uint // broadcasts counts the number of attempted broadcasts
 
@@ -89,6 +144,7 @@ func NewBroker(
 	myIndex int,
 	dkgContractClients []module.DKGContractClient,
 	tunnel *BrokerTunnel,
+	transcript *Transcript,
 	opts ...BrokerOpt,
 ) *Broker {
 
@@ -110,6 +166,14 @@ func NewBroker(
 		privateMsgCh:       make(chan messages.PrivDKGMessageIn),
 		broadcastMsgCh:     make(chan messages.BroadcastDKGMessage),
 		shutdownCh:         make(chan struct{}),
+		transcript:         transcript,
+	}
+
+	if config.BroadcastTransport == P2PBroadcast {
+		// a message is delivered once echoed by a quorum of participants, such
+		// that two quorums for conflicting messages share an honest participant,
+		// while the honest participants alone still form a quorum
+		b.echo = NewEchoBroadcast(me.NodeID(), EchoQuorum(len(committee)))
 	}
 
 	go b.listen()
@@ -157,9 +221,15 @@ func (b *Broker) Broadcast(data []byte) {
 			b.log.Info().Msgf("preparing to send DKG message broadcast with header %d", data[0])
 		}
 		b.broadcasts++
+		sequence := uint64(b.broadcasts - 1)
 		log := b.log.With().Uint("broadcast_number", b.broadcasts).Logger()
 		b.broadcastLock.Unlock()
 
+		if b.echo != nil {
+			b.gossipBroadcast(log, data, sequence)
+			return
+		}
+
 		bcastMsg, err := b.prepareBroadcastMessage(data)
 		if err != nil {
 			log.Fatal().Err(err).Msg("failed to create broadcast message")
@@ -196,9 +266,11 @@ func (b *Broker) Broadcast(data []byte) {
 		// Because the overall DKG is resilient to individual message failures,
 		// it is acceptable to log the error and move on.
 		if err != nil {
+			b.transcript.OnBroadcastFailed()
 			log.Error().Err(err).Msgf("failed to broadcast message after %d attempts", attempts)
 			return
 		}
+		b.transcript.OnBroadcastSent()
 		log.Info().Msgf("dkg broadcast successfully on attempt %d", attempts)
 	})
 }
@@ -245,6 +317,7 @@ func (b *Broker) SubmitResult(groupKey crypto.PublicKey, pubKeys []crypto.Public
 	if err != nil {
 		return fmt.Errorf("failed to submit dkg result after %d attempts: %w", attempts, err)
 	}
+	b.transcript.OnResultSubmitted(groupKey, pubKeys)
 
 	b.log.Info().Msgf("dkg result submitted successfully on attempt %d", attempts)
 	return nil
@@ -260,6 +333,7 @@ func (b *Broker) Disqualify(node int, log string) {
 	// The warn-level log is used by the integration tests to check if this method is called.
 	b.log.Warn().Msgf("participant %d (this node) is disqualifying participant (index=%d, node_id=%s) because: %s",
 		b.myIndex, node, nodeID, log)
+	b.transcript.OnDisqualified(node, log)
 }
 
 // FlagMisbehavior warns that a node is misbehaving.
@@ -272,6 +346,7 @@ func (b *Broker) FlagMisbehavior(node int, log string) {
 	// The warn-level log is used by the integration tests to check if this method is called.
 	b.log.Warn().Msgf("participant %d (this node) is flagging participant (index=%d, node_id=%s) because: %s",
 		b.myIndex, node, nodeID, log)
+	b.transcript.OnMisbehaviorFlagged(node, log)
 }
 
 // GetPrivateMsgCh returns the channel through which consumers can receive
@@ -290,6 +365,8 @@ func (b *Broker) GetBroadcastMsgCh() <-chan messages.BroadcastDKGMessage {
 // epoch, and forwards them to the msgCh. It should be called with the ID of a
 // block whose seal is finalized. The function doesn't return until the received
 // messages are processed by the consumer because b.msgCh is not buffered.
+// When using P2PBroadcast, Poll instead forwards the messages received over the
+// gossip channel which became deliverable since the last poll.
 func (b *Broker) Poll(referenceBlock flow.Identifier) error {
 	// We only issue one poll at a time to avoid delivering duplicate broadcast messages.
 	// The messageOffset determines which messages are retrieved by a Poll,
@@ -297,6 +374,11 @@ func (b *Broker) Poll(referenceBlock flow.Identifier) error {
 	b.pollLock.Lock()
 	defer b.pollLock.Unlock()
 
+	if b.echo != nil {
+		b.pollGossip()
+		return nil
+	}
+
 	backoff := retry.NewExponential(b.config.RetryInitialWait)
 	backoff = retry.WithMaxRetries(b.config.ReadMaxRetries, backoff)
 	backoff = retry.WithJitterPercent(b.config.RetryJitterPct, backoff)
@@ -348,6 +430,7 @@ func (b *Broker) Poll(referenceBlock flow.Identifier) error {
 			b.log.Error().Msg("invalid signature on broadcast dkg message")
 			continue
 		}
+		b.transcript.OnBroadcastReceived(int(memberIndex))
 		b.log.Debug().Msgf("forwarding broadcast message to controller")
 		b.broadcastMsgCh <- msg
 	}
@@ -405,6 +488,8 @@ func (b *Broker) listen() {
 		select {
 		case msg := <-b.tunnel.MsgChIn:
 			b.onPrivateMessage(msg.OriginID, msg.DKGMessage)
+		case msg := <-b.tunnel.GossipMsgChIn:
+			b.onGossipMessage(msg.RelayerID, msg.GossipDKGMessage)
 		case <-b.shutdownCh:
 			return
 		}
@@ -426,6 +511,7 @@ func (b *Broker) onPrivateMessage(originID flow.Identifier, msg messages.DKGMess
 		return
 	}
 
+	b.transcript.OnPrivateMessageReceived(int(memberIndex))
 	b.privateMsgCh <- messages.PrivDKGMessageIn{DKGMessage: msg, OriginID: originID, CommitteeMemberIndex: uint64(memberIndex)}
 }
 
@@ -479,3 +565,149 @@ func (b *Broker) verifyBroadcastMessage(bcastMsg messages.BroadcastDKGMessage) (
 		NewDKGMessageHasher(),
 	)
 }
+
+/*~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~
+P2P broadcast
+~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~*/
+
+// gossipContent is the content of a GossipDKGMessage which is signed by the
+// origin. It also determines the digest of the message.
+type gossipContent struct {
+	DKGMessage messages.DKGMessage
+	OriginID   flow.Identifier
+	Sequence   uint64
+}
+
+func newGossipContent(msg messages.GossipDKGMessage) gossipContent {
+	return gossipContent{
+		DKGMessage: msg.DKGMessage,
+		OriginID:   msg.OriginID,
+		Sequence:   msg.Sequence,
+	}
+}
+
+// gossipBroadcast signs the broadcast message with the given sequence number,
+// and publishes it over the DKG gossip channel. Retries of the publication are
+// handled by the MessagingEngine.
+func (b *Broker) gossipBroadcast(log zerolog.Logger, data []byte, sequence uint64) {
+	msg, err := b.prepareGossipMessage(data, sequence)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create gossip broadcast message")
+	}
+
+	// our own message is delivered to our own DKG instance as well, similarly to
+	// messages read back from the smart-contract
+	b.echo.Add(b.me.NodeID(), msg, flow.MakeID(newGossipContent(msg)))
+	b.relayGossip(msg)
+
+	b.transcript.OnBroadcastSent()
+	log.Info().Msg("dkg broadcast published to gossip channel")
+}
+
+// prepareGossipMessage creates a GossipDKGMessage with the given sequence
+// number, signed with the node's staking key.
+func (b *Broker) prepareGossipMessage(data []byte, sequence uint64) (messages.GossipDKGMessage, error) {
+	msg := messages.GossipDKGMessage{
+		DKGMessage: messages.NewDKGMessage(data, b.dkgInstanceID),
+		OriginID:   b.me.NodeID(),
+		Sequence:   sequence,
+	}
+	sigData := fingerprint.Fingerprint(newGossipContent(msg))
+	sig, err := b.me.Sign(sigData, NewDKGMessageHasher())
+	if err != nil {
+		return messages.GossipDKGMessage{}, err
+	}
+	msg.Signature = sig
+	return msg, nil
+}
+
+// onGossipMessage processes a broadcast message received over the DKG gossip
+// channel from the given relayer: the message is verified, recorded by the
+// echo broadcast and relayed to the other participants if it is new to us.
+func (b *Broker) onGossipMessage(relayerID flow.Identifier, msg messages.GossipDKGMessage) {
+	if b.echo == nil {
+		b.log.Debug().Msgf("dropping gossip broadcast message from %v, as broadcasts are read from the dkg smart-contract", relayerID)
+		return
+	}
+
+	_, ok := b.committee.GetIndex(relayerID)
+	if !ok {
+		b.log.Error().Msgf("bad gossip message: relayer (%v) does not match the NodeID of any committee member", relayerID)
+		return
+	}
+	originIndex, ok := b.committee.GetIndex(msg.OriginID)
+	if !ok {
+		b.log.Error().Msgf("bad gossip message: origin (%v) does not match the NodeID of any committee member", msg.OriginID)
+		return
+	}
+	err := b.hasValidDKGInstanceID(msg.DKGMessage)
+	if err != nil {
+		b.log.Err(err).Msg("bad gossip message")
+		return
+	}
+
+	// messages are verified only once, further relays of a verified message
+	// only count towards its quorum
+	content := newGossipContent(msg)
+	digest := flow.MakeID(content)
+	if !b.echo.Known(msg, digest) {
+		sigData := fingerprint.Fingerprint(content)
+		valid, err := b.committee[originIndex].StakingPubKey.Verify(msg.Signature, sigData, NewDKGMessageHasher())
+		if err != nil {
+			b.log.Error().Err(err).Msg("unable to verify gossip broadcast message")
+			return
+		}
+		if !valid {
+			b.log.Error().Msgf("invalid signature on gossip broadcast message relayed by %v", relayerID)
+			return
+		}
+	}
+
+	relay, equivocation := b.echo.Add(relayerID, msg, digest)
+	if equivocation {
+		reason := fmt.Sprintf("conflicting broadcast messages with sequence number %d", msg.Sequence)
+		b.log.Warn().Msgf("participant %d (this node) detected that participant (index=%d, node_id=%s) sent %s",
+			b.myIndex, originIndex, msg.OriginID, reason)
+		b.transcript.OnMisbehaviorFlagged(int(originIndex), reason)
+	}
+	if relay {
+		b.unit.Launch(func() {
+			b.relayGossip(msg)
+		})
+	}
+}
+
+// relayGossip publishes the gossip message to all participants other than
+// ourselves and the origin of the message.
+func (b *Broker) relayGossip(msg messages.GossipDKGMessage) {
+	destIDs := b.committee.Filter(filter.Not(filter.HasNodeID(b.me.NodeID(), msg.OriginID))).NodeIDs()
+	if len(destIDs) == 0 {
+		return
+	}
+	b.tunnel.SendGossipOut(messages.GossipDKGMessageOut{
+		GossipDKGMessage: msg,
+		DestIDs:          destIDs,
+	})
+}
+
+// pollGossip forwards the gossip broadcast messages which became deliverable
+// since the last poll to the consumer.
+// CAUTION: not concurrency safe, caller must hold the poll lock.
+func (b *Broker) pollGossip() {
+	for _, msg := range b.echo.Deliverable() {
+		memberIndex, ok := b.committee.GetIndex(msg.OriginID)
+		if !ok {
+			// sanity check, messages from non-members are never added to the echo broadcast
+			b.log.Error().Msgf("deliverable gossip message from node with id (%v) does not match the ID of any committee member", msg.OriginID)
+			continue
+		}
+		b.transcript.OnBroadcastReceived(int(memberIndex))
+		b.log.Debug().Msgf("forwarding gossip broadcast message to controller")
+		b.broadcastMsgCh <- messages.BroadcastDKGMessage{
+			DKGMessage:           msg.DKGMessage,
+			CommitteeMemberIndex: uint64(memberIndex),
+			NodeID:               msg.OriginID,
+			Signature:            msg.Signature,
+		}
+	}
+}
@@ -11,10 +11,12 @@ import (
 	mocks "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/flow-go/model/fingerprint"
 	"github.com/onflow/flow-go/model/flow"
 	msg "github.com/onflow/flow-go/model/messages"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/local"
+	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/module/mock"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -78,6 +80,7 @@ func TestPrivateSend_Valid(t *testing.T) {
 		orig,
 		[]module.DKGContractClient{&mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, orig),
 	)
 
 	// expected DKGMessageOut
@@ -119,6 +122,7 @@ func TestPrivateSend_IndexOutOfRange(t *testing.T) {
 		orig,
 		[]module.DKGContractClient{&mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, orig),
 	)
 
 	// Launch a background routine to capture messages sent through the tunnel.
@@ -153,6 +157,7 @@ func TestReceivePrivateMessage_Valid(t *testing.T) {
 		dest,
 		[]module.DKGContractClient{&mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, dest),
 	)
 
 	dkgMessage := msg.NewDKGMessage(msgb, dkgInstanceID)
@@ -200,6 +205,7 @@ func TestBroadcastMessage(t *testing.T) {
 		orig,
 		[]module.DKGContractClient{&mock.DKGContractClient{}, &mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, orig),
 		func(config *BrokerConfig) { config.RetryInitialWait = 1 }, // disable waiting between retries for tests
 	)
 
@@ -244,6 +250,7 @@ func TestPoll(t *testing.T) {
 		orig,
 		[]module.DKGContractClient{&mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, orig),
 	)
 
 	recipient := NewBroker(
@@ -254,6 +261,7 @@ func TestPoll(t *testing.T) {
 		dest,
 		[]module.DKGContractClient{&mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, dest),
 	)
 
 	blockID := unittest.IdentifierFixture()
@@ -323,6 +331,7 @@ func TestLogHook(t *testing.T) {
 		orig,
 		[]module.DKGContractClient{&mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, orig),
 	)
 
 	sender.Disqualify(1, "testing")
@@ -344,6 +353,7 @@ func TestProcessPrivateMessage_InvalidOrigin(t *testing.T) {
 		dest,
 		[]module.DKGContractClient{&mock.DKGContractClient{}},
 		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, dest),
 	)
 
 	// Launch a background routine to capture messages forwared to the private
@@ -372,3 +382,167 @@ func TestProcessPrivateMessage_InvalidOrigin(t *testing.T) {
 
 	unittest.RequireNeverClosedWithin(t, doneCh, 50*time.Millisecond, "no invalid incoming message should be forwarded")
 }
+
+// newGossipBroker creates a broker for the committee member with the given
+// index, which disseminates broadcast messages over the gossip channel.
+func newGossipBroker(committee flow.IdentityList, locals []module.Local, index int) *Broker {
+	return NewBroker(
+		zerolog.Logger{},
+		dkgInstanceID,
+		committee,
+		locals[index],
+		index,
+		[]module.DKGContractClient{&mock.DKGContractClient{}},
+		NewBrokerTunnel(),
+		NewTranscript(metrics.NewNoopCollector(), dkgInstanceID, committee, index),
+		WithBroadcastTransport(P2PBroadcast),
+	)
+}
+
+// TestGossipBroadcast checks that, when using the p2p broadcast transport, the
+// broker publishes signed broadcast messages to all other committee members
+// through the tunnel, and never calls the dkg contract client.
+func TestGossipBroadcast(t *testing.T) {
+	committee, locals := initCommittee(3)
+	sender := newGossipBroker(committee, locals, orig)
+
+	doneCh := make(chan struct{})
+	go func() {
+		out := <-sender.tunnel.GossipMsgChOut
+		require.Equal(t, msgb, out.Data)
+		require.Equal(t, dkgInstanceID, out.DKGInstanceID)
+		require.Equal(t, committee[orig].NodeID, out.OriginID)
+		require.Equal(t, uint64(0), out.Sequence)
+		require.ElementsMatch(t, committee[1:].NodeIDs(), out.DestIDs)
+
+		valid, err := committee[orig].StakingPubKey.Verify(out.Signature, fingerprint.Fingerprint(newGossipContent(out.GossipDKGMessage)), NewDKGMessageHasher())
+		require.NoError(t, err)
+		require.True(t, valid)
+		close(doneCh)
+	}()
+
+	sender.Broadcast(msgb)
+	unittest.RequireCloseBefore(t, doneCh, time.Second, "message not published")
+
+	// the contract client has no expectations, so any call would fail the test
+	sender.dkgContractClients[0].(*mock.DKGContractClient).AssertExpectations(t)
+}
+
+// TestGossipEchoAndPoll checks that a broker relays new gossip messages, and
+// only forwards them to the broadcast channel once a quorum of committee
+// members is known to hold them.
+func TestGossipEchoAndPoll(t *testing.T) {
+	// with 4 participants, the quorum is 3
+	committee, locals := initCommittee(4)
+	sender := newGossipBroker(committee, locals, orig)
+	receiver := newGossipBroker(committee, locals, dest)
+
+	bmsg, err := sender.prepareGossipMessage(msgb, 0)
+	require.NoError(t, err)
+
+	receivedMsgs := make(chan msg.BroadcastDKGMessage, 1)
+	go func() {
+		for m := range receiver.GetBroadcastMsgCh() {
+			receivedMsgs <- m
+		}
+	}()
+
+	// the receiver gets the message from its origin, and relays it to the
+	// committee members other than itself and the origin
+	receiver.tunnel.SendGossipIn(msg.GossipDKGMessageIn{GossipDKGMessage: bmsg, RelayerID: committee[orig].NodeID})
+	select {
+	case out := <-receiver.tunnel.GossipMsgChOut:
+		require.Equal(t, bmsg, out.GossipDKGMessage)
+		require.ElementsMatch(t, committee[2:].NodeIDs(), out.DestIDs)
+	case <-time.After(time.Second):
+		t.Fatal("message not relayed")
+	}
+
+	// only the origin and the receiver echoed the message
+	require.NoError(t, receiver.Poll(unittest.IdentifierFixture()))
+	require.Empty(t, receivedMsgs)
+
+	// further relays by the origin are not counted again
+	receiver.tunnel.SendGossipIn(msg.GossipDKGMessageIn{GossipDKGMessage: bmsg, RelayerID: committee[orig].NodeID})
+	require.Never(t, func() bool {
+		require.NoError(t, receiver.Poll(unittest.IdentifierFixture()))
+		return len(receivedMsgs) > 0
+	}, 100*time.Millisecond, 10*time.Millisecond, "message forwarded below quorum")
+
+	// once echoed by another member, the message reaches the quorum but is not
+	// relayed again, even though the last member stays silent
+	receiver.tunnel.SendGossipIn(msg.GossipDKGMessageIn{GossipDKGMessage: bmsg, RelayerID: committee[2].NodeID})
+	require.Eventually(t, func() bool {
+		require.NoError(t, receiver.Poll(unittest.IdentifierFixture()))
+		return len(receivedMsgs) > 0
+	}, time.Second, 10*time.Millisecond, "message not forwarded")
+	received := <-receivedMsgs
+	require.Equal(t, bmsg.DKGMessage, received.DKGMessage)
+	require.Equal(t, uint64(orig), received.CommitteeMemberIndex)
+	require.Equal(t, committee[orig].NodeID, received.NodeID)
+	select {
+	case <-receiver.tunnel.GossipMsgChOut:
+		t.Fatal("message relayed twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// the message is forwarded only once
+	require.NoError(t, receiver.Poll(unittest.IdentifierFixture()))
+	require.Empty(t, receivedMsgs)
+	require.Equal(t, uint(1), receiver.transcript.Summary().Participants[orig].BroadcastsReceived)
+}
+
+// TestGossipEquivocation checks that a broker never forwards conflicting
+// messages from the same origin, and flags the origin as misbehaving.
+func TestGossipEquivocation(t *testing.T) {
+	committee, locals := initCommittee(3)
+	sender := newGossipBroker(committee, locals, orig)
+	receiver := newGossipBroker(committee, locals, dest)
+
+	first, err := sender.prepareGossipMessage([]byte("first"), 0)
+	require.NoError(t, err)
+	second, err := sender.prepareGossipMessage([]byte("second"), 0)
+	require.NoError(t, err)
+
+	go func() {
+		for m := range receiver.GetBroadcastMsgCh() {
+			t.Errorf("unexpected message forwarded: %v", m)
+		}
+	}()
+
+	// both versions are relayed, so that all participants detect the equivocation
+	for _, bmsg := range []msg.GossipDKGMessage{first, second} {
+		receiver.tunnel.SendGossipIn(msg.GossipDKGMessageIn{GossipDKGMessage: bmsg, RelayerID: committee[orig].NodeID})
+		select {
+		case out := <-receiver.tunnel.GossipMsgChOut:
+			require.Equal(t, bmsg, out.GossipDKGMessage)
+		case <-time.After(time.Second):
+			t.Fatal("message not relayed")
+		}
+	}
+
+	require.NoError(t, receiver.Poll(unittest.IdentifierFixture()))
+	require.Len(t, receiver.transcript.Summary().Participants[orig].Complaints, 1)
+}
+
+// TestGossipInvalidMessages checks that gossip messages with an invalid
+// signature, or from a relayer outside the committee, are discarded.
+func TestGossipInvalidMessages(t *testing.T) {
+	committee, locals := initCommittee(3)
+	sender := newGossipBroker(committee, locals, orig)
+	receiver := newGossipBroker(committee, locals, dest)
+
+	bmsg, err := sender.prepareGossipMessage(msgb, 0)
+	require.NoError(t, err)
+	forged := bmsg
+	forged.Data = []byte("forged")
+
+	receiver.tunnel.SendGossipIn(msg.GossipDKGMessageIn{GossipDKGMessage: forged, RelayerID: committee[2].NodeID})
+	receiver.tunnel.SendGossipIn(msg.GossipDKGMessageIn{GossipDKGMessage: bmsg, RelayerID: unittest.IdentifierFixture()})
+
+	select {
+	case <-receiver.tunnel.GossipMsgChOut:
+		t.Fatal("invalid message relayed")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -87,6 +87,9 @@ type Controller struct {
 	// broker enables the controller to communicate with other nodes
 	broker module.DKGBroker
 
+	// transcript records a structured summary of the DKG run
+	transcript *Transcript
+
 	// Channels used internally to trigger state transitions
 	h1Ch       chan struct{}
 	h2Ch       chan struct{}
@@ -113,6 +116,7 @@ func NewController(
 	dkg crypto.DKGState,
 	seed []byte,
 	broker module.DKGBroker,
+	transcript *Transcript,
 	config ControllerConfig,
 ) *Controller {
 
@@ -126,6 +130,7 @@ func NewController(
 		dkg:        dkg,
 		seed:       seed,
 		broker:     broker,
+		transcript: transcript,
 		h1Ch:       make(chan struct{}),
 		h2Ch:       make(chan struct{}),
 		endCh:      make(chan struct{}),
@@ -190,7 +195,7 @@ func (c *Controller) EndPhase1() error {
 		return NewInvalidStateTransitionError(state, Phase2)
 	}
 
-	c.SetState(Phase2)
+	c.transition(Phase2)
 	close(c.h1Ch)
 
 	return nil
@@ -203,7 +208,7 @@ func (c *Controller) EndPhase2() error {
 		return NewInvalidStateTransitionError(state, Phase3)
 	}
 
-	c.SetState(Phase3)
+	c.transition(Phase3)
 	close(c.h2Ch)
 
 	return nil
@@ -233,7 +238,7 @@ func (c *Controller) End() error {
 	c.publicKeys = publicKeys
 	c.artifactsLock.Unlock()
 
-	c.SetState(End)
+	c.transition(End)
 	close(c.endCh)
 
 	return nil
@@ -242,7 +247,7 @@ func (c *Controller) End() error {
 // Shutdown stops the controller regardless of the current state.
 func (c *Controller) Shutdown() {
 	c.broker.Shutdown()
-	c.SetState(Shutdown)
+	c.transition(Shutdown)
 	close(c.shutdownCh)
 }
 
@@ -275,6 +280,11 @@ func (c *Controller) SubmitResult() error {
 	return c.broker.SubmitResult(pubKey, groupKeys)
 }
 
+// GetTranscript returns a summary of the DKG transcript, as observed by this node.
+func (c *Controller) GetTranscript() *flow.DKGTranscriptSummary {
+	return c.transcript.Summary()
+}
+
 /*******************************************************************************
 WORKERS
 *******************************************************************************/
@@ -350,7 +360,7 @@ func (c *Controller) start() error {
 	}
 
 	c.log.Debug().Msg("DKG engine started")
-	c.SetState(Phase1)
+	c.transition(Phase1)
 	return nil
 }
 
@@ -419,6 +429,13 @@ func (c *Controller) phase3() error {
 	}
 }
 
+// transition sets the state of the controller and records the phase transition
+// in the transcript.
+func (c *Controller) transition(state State) {
+	c.SetState(state)
+	c.transcript.OnPhaseTransition(state)
+}
+
 // preStartDelay returns a duration to delay prior to starting the DKG process.
 // This prevents synchronization of the DKG starting (an expensive operation)
 // across the network, which can impact finalization.
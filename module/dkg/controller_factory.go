@@ -18,27 +18,34 @@ import (
 // smart-contract.
 type ControllerFactory struct {
 	log                zerolog.Logger
+	metrics            module.DKGMetrics
 	me                 module.Local
 	dkgContractClients []module.DKGContractClient
 	tunnel             *BrokerTunnel
 	config             ControllerConfig
+	brokerOpts         []BrokerOpt
 }
 
 // NewControllerFactory creates a new factory that generates Controllers with
-// the same underlying Local object, tunnel and dkg smart-contract client.
+// the same underlying Local object, tunnel and dkg smart-contract client. The
+// broker options are applied to the broker of each Controller.
 func NewControllerFactory(
 	log zerolog.Logger,
+	metrics module.DKGMetrics,
 	me module.Local,
 	dkgContractClients []module.DKGContractClient,
 	tunnel *BrokerTunnel,
-	config ControllerConfig) *ControllerFactory {
+	config ControllerConfig,
+	brokerOpts ...BrokerOpt) *ControllerFactory {
 
 	return &ControllerFactory{
 		log:                log,
+		metrics:            metrics,
 		me:                 me,
 		dkgContractClients: dkgContractClients,
 		tunnel:             tunnel,
 		config:             config,
+		brokerOpts:         brokerOpts,
 	}
 }
 
@@ -54,6 +61,8 @@ func (f *ControllerFactory) Create(
 		return nil, fmt.Errorf("failed to create controller factory, node %s is not part of DKG committee", f.me.NodeID().String())
 	}
 
+	transcript := NewTranscript(f.metrics, dkgInstanceID, participants, int(myIndex))
+
 	broker := NewBroker(
 		f.log,
 		dkgInstanceID,
@@ -62,6 +71,8 @@ func (f *ControllerFactory) Create(
 		int(myIndex),
 		f.dkgContractClients,
 		f.tunnel,
+		transcript,
+		f.brokerOpts...,
 	)
 
 	n := len(participants)
@@ -77,6 +88,7 @@ func (f *ControllerFactory) Create(
 		dkg,
 		seed,
 		broker,
+		transcript,
 		f.config,
 	)
 
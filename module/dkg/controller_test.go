@@ -13,6 +13,7 @@ import (
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/model/flow"
 	msg "github.com/onflow/flow-go/model/messages"
+	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/module/signature"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -238,6 +239,7 @@ func initNodes(t *testing.T, n int, phase1Duration, phase2Duration, phase3Durati
 	nodes := make([]*node, 0, n)
 
 	// Setup
+	committee := unittest.IdentityListFixture(n)
 	for i := 0; i < n; i++ {
 		logger := zerolog.New(os.Stderr).With().Int("id", i).Logger()
 
@@ -266,6 +268,7 @@ func initNodes(t *testing.T, n int, phase1Duration, phase2Duration, phase3Durati
 			dkg,
 			seed,
 			broker,
+			NewTranscript(metrics.NewNoopCollector(), "dkg_test", committee, i),
 			config,
 		)
 		require.NoError(t, err)
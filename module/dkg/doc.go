@@ -75,5 +75,16 @@ To send and receive broadcast messages, the broker communicates with the DKG
 smart-contract via a smart-contract client. The broker's Poll method must be
 called regularly to read broadcast messages from the smart-contract.
 
+Alternatively, with the P2PBroadcast transport, broadcast messages are sent
+through the BrokerTunnel as well, and published over the DKG gossip channel.
+Each participant relays the messages it receives to all other participants
+(EchoBroadcast), and a message is only delivered once a quorum of participants,
+including its origin, echoed it and no conflicting message from the same origin
+was observed. This ensures no two participants deliver conflicting messages, but
+a message from an equivocating origin, or with missing echoes, might not be
+delivered by all participants. Poll then forwards the messages which became
+deliverable to the controller, and the smart-contract is only used to submit
+the DKG result.
+
 */
 package dkg
@@ -0,0 +1,162 @@
+package dkg
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/messages"
+)
+
+// echoSlotID identifies a broadcast message by its origin and sequence number.
+type echoSlotID struct {
+	originID flow.Identifier
+	sequence uint64
+}
+
+// echoSlot tracks the relays of the broadcast message with a given origin and
+// sequence number.
+type echoSlot struct {
+	message     messages.GossipDKGMessage    // first version of the message we received
+	digest      flow.Identifier              // digest of the first version of the message
+	echoes      map[flow.Identifier]struct{} // nodes which endorsed the first version of the message, including its origin
+	equivocated bool                         // true if the origin created conflicting messages for this slot
+	delivered   bool                         // true if the message was delivered to the DKG
+}
+
+// EchoBroadcast implements a signed echo broadcast for DKG broadcast messages
+// disseminated over the DKG gossip channel rather than the DKG smart-contract.
+// It guarantees consistency: no two honest DKG participants deliver different
+// versions of a broadcast message with the same origin and sequence number.
+// It does NOT guarantee totality: if the origin equivocates, no honest
+// participant might deliver the message, and if echoes are lost, only some of
+// the honest participants might deliver it. Callers must therefore tolerate
+// broadcast messages which are never delivered, as with a participant which
+// did not broadcast at all.
+//
+// Every participant relays the first copy of each message it receives to all
+// other participants. Messages are signed by their origin, so a message
+// relayed by a node which is not its origin cannot be forged, and the signed
+// message itself counts as the origin's echo. A message is delivered once it
+// was echoed by a quorum of distinct participants (see EchoQuorum), and only if
+// no conflicting message was observed for the same origin and sequence number.
+// As conflicting messages are relayed as well, an origin sending different
+// messages to different participants is usually detected by all honest
+// participants, which then refuse to deliver either message.
+//
+// EchoBroadcast is safe for concurrent use.
+type EchoBroadcast struct {
+	mu     sync.Mutex
+	myID   flow.Identifier
+	quorum int
+	slots  map[echoSlotID]*echoSlot
+}
+
+// EchoQuorum returns the number of distinct participants, including the
+// origin, which must echo a broadcast message before it is delivered in a
+// committee of the given size: ceil((size+f+1)/2), where f = (size-1)/3 is the
+// number of byzantine participants tolerated. Any two quorums share at least
+// f+1 participants, hence an honest one, and honest participants only echo the
+// first version of a message they receive, so two quorums for conflicting
+// versions of a message cannot exist. The size-f honest participants, which
+// include the origin of an honest broadcast, always form a quorum.
+func EchoQuorum(size int) int {
+	f := (size - 1) / 3
+	return (size + f + 2) / 2
+}
+
+// NewEchoBroadcast creates a new echo broadcast for the participant with the
+// given node ID, which delivers messages echoed by at least quorum distinct
+// participants. The origin of a message and ourselves count as echoes.
+func NewEchoBroadcast(myID flow.Identifier, quorum int) *EchoBroadcast {
+	return &EchoBroadcast{
+		myID:   myID,
+		quorum: quorum,
+		slots:  make(map[echoSlotID]*echoSlot),
+	}
+}
+
+// Known returns true if the message with the given digest was already added
+// for the given origin and sequence number. Known messages don't need to be
+// verified again.
+func (e *EchoBroadcast) Known(msg messages.GossipDKGMessage, digest flow.Identifier) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	slot, ok := e.slots[echoSlotID{originID: msg.OriginID, sequence: msg.Sequence}]
+	return ok && slot.digest == digest
+}
+
+// Add records that the given message, which must have a valid signature from
+// its origin, was relayed by the relayer. Messages we send are added with
+// ourselves as relayer. As the message is signed by its origin, the origin
+// counts as an echo of every version of the message. It returns:
+//   - relay: true if this is the first time we see this version of the message,
+//     in which case it must be relayed to all other participants
+//   - equivocation: true if this message is the first conflicting version
+//     observed for the origin and sequence number
+func (e *EchoBroadcast) Add(relayerID flow.Identifier, msg messages.GossipDKGMessage, digest flow.Identifier) (relay bool, equivocation bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	id := echoSlotID{originID: msg.OriginID, sequence: msg.Sequence}
+	slot, ok := e.slots[id]
+	if !ok {
+		slot = &echoSlot{
+			message: msg,
+			digest:  digest,
+			echoes:  make(map[flow.Identifier]struct{}),
+		}
+		// the origin endorsed the message by signing it, and we echo the first
+		// version of the message by relaying it
+		slot.addEcho(msg.OriginID)
+		slot.addEcho(relayerID)
+		slot.addEcho(e.myID)
+		e.slots[id] = slot
+		return true, false
+	}
+
+	if slot.digest == digest {
+		slot.addEcho(relayerID)
+		return false, false
+	}
+
+	// We relay the first conflicting version only, which is sufficient for all
+	// honest participants to detect the equivocation.
+	if slot.equivocated {
+		return false, false
+	}
+	slot.equivocated = true
+	return true, true
+}
+
+// addEcho records that the node echoed the first version of the message.
+func (s *echoSlot) addEcho(nodeID flow.Identifier) {
+	s.echoes[nodeID] = struct{}{}
+}
+
+// Deliverable returns the messages which reached the quorum since the last
+// call, and marks them as delivered. Messages are ordered by origin and
+// sequence number.
+func (e *EchoBroadcast) Deliverable() []messages.GossipDKGMessage {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var deliverable []messages.GossipDKGMessage
+	for _, slot := range e.slots {
+		if slot.delivered || slot.equivocated || len(slot.echoes) < e.quorum {
+			continue
+		}
+		slot.delivered = true
+		deliverable = append(deliverable, slot.message)
+	}
+
+	sort.Slice(deliverable, func(i, j int) bool {
+		if deliverable[i].OriginID != deliverable[j].OriginID {
+			return bytes.Compare(deliverable[i].OriginID[:], deliverable[j].OriginID[:]) < 0
+		}
+		return deliverable[i].Sequence < deliverable[j].Sequence
+	})
+	return deliverable
+}
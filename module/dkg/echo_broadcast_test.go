@@ -0,0 +1,189 @@
+package dkg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/messages"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestEchoBroadcast_Quorum checks that messages are delivered exactly once,
+// after they were echoed by a quorum of participants, including their origin.
+func TestEchoBroadcast_Quorum(t *testing.T) {
+	myID := unittest.IdentifierFixture()
+	nodeIDs := unittest.IdentifierListFixture(3)
+	echo := NewEchoBroadcast(myID, 4)
+
+	msg := messages.GossipDKGMessage{
+		DKGMessage: messages.NewDKGMessage(msgb, dkgInstanceID),
+		OriginID:   nodeIDs[0],
+	}
+	digest := flow.MakeID(msg)
+
+	// the first copy, relayed by the origin, counts the origin's and our own echo
+	assert.False(t, echo.Known(msg, digest))
+	relay, equivocation := echo.Add(nodeIDs[0], msg, digest)
+	assert.True(t, relay)
+	assert.False(t, equivocation)
+	assert.True(t, echo.Known(msg, digest))
+	assert.Empty(t, echo.Deliverable())
+
+	// duplicate relays are counted once
+	relay, _ = echo.Add(nodeIDs[1], msg, digest)
+	assert.False(t, relay)
+	echo.Add(nodeIDs[1], msg, digest)
+	echo.Add(nodeIDs[0], msg, digest)
+	assert.Empty(t, echo.Deliverable())
+
+	// further relays are not relayed again, and complete the quorum
+	relay, _ = echo.Add(nodeIDs[2], msg, digest)
+	assert.False(t, relay)
+	assert.Equal(t, []messages.GossipDKGMessage{msg}, echo.Deliverable())
+
+	// delivered messages are not delivered again
+	assert.Empty(t, echo.Deliverable())
+}
+
+// TestEchoBroadcast_OwnMessage checks that the origin of a message counts its
+// own copy as an echo.
+func TestEchoBroadcast_OwnMessage(t *testing.T) {
+	myID := unittest.IdentifierFixture()
+	nodeIDs := unittest.IdentifierListFixture(2)
+	echo := NewEchoBroadcast(myID, 3)
+
+	msg := messages.GossipDKGMessage{
+		DKGMessage: messages.NewDKGMessage(msgb, dkgInstanceID),
+		OriginID:   myID,
+	}
+	digest := flow.MakeID(msg)
+
+	echo.Add(myID, msg, digest)
+	echo.Add(nodeIDs[0], msg, digest)
+	assert.Empty(t, echo.Deliverable())
+
+	echo.Add(nodeIDs[1], msg, digest)
+	assert.Equal(t, []messages.GossipDKGMessage{msg}, echo.Deliverable())
+}
+
+// TestEchoBroadcast_SingleParticipant checks that the only participant of a
+// committee delivers its own messages.
+func TestEchoBroadcast_SingleParticipant(t *testing.T) {
+	myID := unittest.IdentifierFixture()
+	echo := NewEchoBroadcast(myID, EchoQuorum(1))
+
+	msg := messages.GossipDKGMessage{
+		DKGMessage: messages.NewDKGMessage(msgb, dkgInstanceID),
+		OriginID:   myID,
+	}
+	echo.Add(myID, msg, flow.MakeID(msg))
+	assert.Equal(t, []messages.GossipDKGMessage{msg}, echo.Deliverable())
+}
+
+// TestEchoBroadcast_SilentParticipants checks that, when f participants never
+// echo any message, the messages of every honest origin are still delivered by
+// every honest participant.
+func TestEchoBroadcast_SilentParticipants(t *testing.T) {
+	for _, size := range []int{4, 7, 10, 100} {
+		size := size
+		t.Run(fmt.Sprintf("%d participants", size), func(t *testing.T) {
+			f := (size - 1) / 3
+			nodeIDs := unittest.IdentifierListFixture(size)
+			honestIDs := nodeIDs[f:]
+
+			for _, originID := range honestIDs {
+				msg := messages.GossipDKGMessage{
+					DKGMessage: messages.NewDKGMessage(msgb, dkgInstanceID),
+					OriginID:   originID,
+				}
+				digest := flow.MakeID(msg)
+
+				for _, myID := range honestIDs {
+					echo := NewEchoBroadcast(myID, EchoQuorum(size))
+					// every honest participant relays the message, the silent ones don't
+					for _, relayerID := range honestIDs {
+						echo.Add(relayerID, msg, digest)
+					}
+					require.Equal(t, []messages.GossipDKGMessage{msg}, echo.Deliverable())
+				}
+			}
+		})
+	}
+}
+
+// TestEchoQuorum checks that the quorum is ceil((n+f+1)/2) for committees of
+// n participants tolerating f byzantine participants.
+func TestEchoQuorum(t *testing.T) {
+	assert.Equal(t, 1, EchoQuorum(1))
+	assert.Equal(t, 2, EchoQuorum(2))
+	assert.Equal(t, 2, EchoQuorum(3))
+	assert.Equal(t, 3, EchoQuorum(4))
+	assert.Equal(t, 4, EchoQuorum(6))
+	assert.Equal(t, 5, EchoQuorum(7))
+	assert.Equal(t, 67, EchoQuorum(100))
+
+	// the honest participants always form a quorum, and two quorums always
+	// share an honest participant
+	for size := 1; size <= 100; size++ {
+		f := (size - 1) / 3
+		quorum := EchoQuorum(size)
+		assert.LessOrEqual(t, quorum, size-f, "honest participants do not form a quorum with %d participants", size)
+		assert.Greater(t, 2*quorum-size, f, "quorums might not share an honest participant with %d participants", size)
+	}
+}
+
+// TestEchoBroadcast_Equivocation checks that conflicting messages with the same
+// origin and sequence number are relayed once, and never delivered.
+func TestEchoBroadcast_Equivocation(t *testing.T) {
+	myID := unittest.IdentifierFixture()
+	originID := unittest.IdentifierFixture()
+	echo := NewEchoBroadcast(myID, 3)
+
+	first := messages.GossipDKGMessage{DKGMessage: messages.NewDKGMessage([]byte("first"), dkgInstanceID), OriginID: originID}
+	second := messages.GossipDKGMessage{DKGMessage: messages.NewDKGMessage([]byte("second"), dkgInstanceID), OriginID: originID}
+	third := messages.GossipDKGMessage{DKGMessage: messages.NewDKGMessage([]byte("third"), dkgInstanceID), OriginID: originID}
+
+	relay, equivocation := echo.Add(originID, first, flow.MakeID(first))
+	require.True(t, relay)
+	require.False(t, equivocation)
+	echo.Add(unittest.IdentifierFixture(), first, flow.MakeID(first))
+
+	relay, equivocation = echo.Add(originID, second, flow.MakeID(second))
+	assert.True(t, relay)
+	assert.True(t, equivocation)
+
+	relay, equivocation = echo.Add(originID, third, flow.MakeID(third))
+	assert.False(t, relay)
+	assert.False(t, equivocation)
+
+	// the first version reached the quorum, but is not delivered
+	assert.Empty(t, echo.Deliverable())
+}
+
+// TestEchoBroadcast_Order checks that deliverable messages are ordered by
+// origin and sequence number.
+func TestEchoBroadcast_Order(t *testing.T) {
+	myID := unittest.IdentifierFixture()
+	echo := NewEchoBroadcast(myID, 1)
+
+	originIDs := flow.IdentifierList{{1}, {2}}
+	var expected []messages.GossipDKGMessage
+	for _, originID := range originIDs {
+		for sequence := uint64(0); sequence < 3; sequence++ {
+			expected = append(expected, messages.GossipDKGMessage{
+				DKGMessage: messages.NewDKGMessage(msgb, dkgInstanceID),
+				OriginID:   originID,
+				Sequence:   sequence,
+			})
+		}
+	}
+	for i := len(expected) - 1; i >= 0; i-- {
+		echo.Add(expected[i].OriginID, expected[i], flow.MakeID(expected[i]))
+	}
+
+	assert.Equal(t, expected, echo.Deliverable())
+}
@@ -0,0 +1,199 @@
+package dkg
+
+import (
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+)
+
+// Transcript records a structured summary of a DKG instance, as observed by
+// this node. It is updated by the Broker and Controller as the DKG progresses,
+// and reports the progress of the DKG to the metrics. Transcript is safe for
+// concurrent use.
+type Transcript struct {
+	mu      sync.Mutex
+	metrics module.DKGMetrics
+	summary flow.DKGTranscriptSummary
+}
+
+// NewTranscript creates a new transcript for the DKG instance with the given
+// committee, in which this node has index myIndex.
+func NewTranscript(metrics module.DKGMetrics, dkgInstanceID string, committee flow.IdentityList, myIndex int) *Transcript {
+	participants := make([]flow.DKGParticipantSummary, 0, len(committee))
+	for i, identity := range committee {
+		participants = append(participants, flow.DKGParticipantSummary{
+			Index:  i,
+			NodeID: identity.NodeID,
+		})
+	}
+
+	metrics.DKGCommitteeSize(len(committee))
+	metrics.DKGParticipantsBroadcasting(0)
+	metrics.DKGParticipantsFlagged(0)
+	metrics.DKGParticipantsDisqualified(0)
+
+	return &Transcript{
+		metrics: metrics,
+		summary: flow.DKGTranscriptSummary{
+			DKGInstanceID: dkgInstanceID,
+			MyIndex:       myIndex,
+			Participants:  participants,
+		},
+	}
+}
+
+// OnPhaseTransition records that the DKG transitioned into the given phase.
+func (t *Transcript) OnPhaseTransition(phase State) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.summary.PhaseTransitions = append(t.summary.PhaseTransitions, flow.DKGPhaseTransition{
+		Phase: phase.String(),
+		Time:  time.Now().UTC(),
+	})
+	t.metrics.DKGPhase(phase.String())
+}
+
+// OnBroadcastSent records that this node published a broadcast message.
+func (t *Transcript) OnBroadcastSent() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.summary.BroadcastsSent++
+}
+
+// OnBroadcastFailed records that this node failed to publish a broadcast message.
+func (t *Transcript) OnBroadcastFailed() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.summary.BroadcastsFailed++
+	t.metrics.DKGBroadcastFailed()
+}
+
+// OnBroadcastReceived records a valid broadcast message from the participant
+// with the given index. Indices outside the committee are ignored.
+func (t *Transcript) OnBroadcastReceived(index int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	participant, ok := t.participant(index)
+	if !ok {
+		return
+	}
+	participant.BroadcastsReceived++
+	if participant.BroadcastsReceived == 1 {
+		t.metrics.DKGParticipantsBroadcasting(t.count(func(p *flow.DKGParticipantSummary) bool {
+			return p.BroadcastsReceived > 0
+		}))
+	}
+}
+
+// OnPrivateMessageReceived records a private message from the participant with
+// the given index. Indices outside the committee are ignored.
+func (t *Transcript) OnPrivateMessageReceived(index int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	participant, ok := t.participant(index)
+	if !ok {
+		return
+	}
+	participant.PrivateMessagesReceived++
+}
+
+// OnMisbehaviorFlagged records a complaint against the participant with the
+// given index. Indices outside the committee are ignored.
+func (t *Transcript) OnMisbehaviorFlagged(index int, reason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	participant, ok := t.participant(index)
+	if !ok {
+		return
+	}
+	participant.Complaints = append(participant.Complaints, reason)
+	t.metrics.DKGParticipantsFlagged(t.count(func(p *flow.DKGParticipantSummary) bool {
+		return len(p.Complaints) > 0
+	}))
+}
+
+// OnDisqualified records that the participant with the given index was
+// disqualified. Indices outside the committee are ignored.
+func (t *Transcript) OnDisqualified(index int, reason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	participant, ok := t.participant(index)
+	if !ok {
+		return
+	}
+	participant.Disqualified = true
+	participant.DisqualificationReason = reason
+	t.metrics.DKGParticipantsDisqualified(t.count(func(p *flow.DKGParticipantSummary) bool {
+		return p.Disqualified
+	}))
+}
+
+// OnResultSubmitted records that this node submitted the given DKG result.
+func (t *Transcript) OnResultSubmitted(groupKey crypto.PublicKey, pubKeys []crypto.PublicKey) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.summary.ResultSubmitted = true
+	t.summary.ResultHash = ResultHash(groupKey, pubKeys)
+	t.metrics.DKGResultSubmitted()
+}
+
+// Summary returns a copy of the current transcript summary.
+func (t *Transcript) Summary() *flow.DKGTranscriptSummary {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	summary := t.summary
+	summary.PhaseTransitions = append([]flow.DKGPhaseTransition(nil), t.summary.PhaseTransitions...)
+	summary.Participants = make([]flow.DKGParticipantSummary, 0, len(t.summary.Participants))
+	for _, participant := range t.summary.Participants {
+		participant.Complaints = append([]string(nil), participant.Complaints...)
+		summary.Participants = append(summary.Participants, participant)
+	}
+	return &summary
+}
+
+// participant returns the summary of the participant with the given index.
+// CAUTION: not concurrency safe, caller must hold the lock.
+func (t *Transcript) participant(index int) (*flow.DKGParticipantSummary, bool) {
+	if index < 0 || index >= len(t.summary.Participants) {
+		return nil, false
+	}
+	return &t.summary.Participants[index], true
+}
+
+// count returns the number of participants matching the given predicate.
+// CAUTION: not concurrency safe, caller must hold the lock.
+func (t *Transcript) count(predicate func(*flow.DKGParticipantSummary) bool) int {
+	count := 0
+	for i := range t.summary.Participants {
+		if predicate(&t.summary.Participants[i]) {
+			count++
+		}
+	}
+	return count
+}
+
+// ResultHash computes the hash of a DKG result, consisting of the group key and
+// the participants' key vector. Missing keys are hashed as empty encodings.
+func ResultHash(groupKey crypto.PublicKey, pubKeys []crypto.PublicKey) flow.Identifier {
+	encodings := make([][]byte, 0, len(pubKeys)+1)
+	for _, key := range append([]crypto.PublicKey{groupKey}, pubKeys...) {
+		if key == nil {
+			encodings = append(encodings, nil)
+			continue
+		}
+		encodings = append(encodings, key.Encode())
+	}
+	return flow.MakeID(encodings)
+}
@@ -0,0 +1,115 @@
+package dkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestTranscript checks that the transcript records the progress of the DKG
+// and reports participant health to the metrics.
+func TestTranscript(t *testing.T) {
+	committee := flow.IdentityList{
+		{NodeID: unittest.IdentifierFixture()},
+		{NodeID: unittest.IdentifierFixture()},
+		{NodeID: unittest.IdentifierFixture()},
+	}
+
+	metrics := new(mock.DKGMetrics)
+	metrics.On("DKGCommitteeSize", 3).Once()
+	metrics.On("DKGParticipantsBroadcasting", 0).Once()
+	metrics.On("DKGParticipantsFlagged", 0).Once()
+	metrics.On("DKGParticipantsDisqualified", 0).Once()
+
+	transcript := NewTranscript(metrics, dkgInstanceID, committee, 2)
+
+	t.Run("phase transitions", func(t *testing.T) {
+		metrics.On("DKGPhase", Phase1.String()).Once()
+		metrics.On("DKGPhase", Phase2.String()).Once()
+
+		transcript.OnPhaseTransition(Phase1)
+		transcript.OnPhaseTransition(Phase2)
+
+		summary := transcript.Summary()
+		require.Len(t, summary.PhaseTransitions, 2)
+		assert.Equal(t, Phase1.String(), summary.PhaseTransitions[0].Phase)
+		assert.Equal(t, Phase2.String(), summary.PhaseTransitions[1].Phase)
+		assert.False(t, summary.PhaseTransitions[1].Time.Before(summary.PhaseTransitions[0].Time))
+	})
+
+	t.Run("broadcasts", func(t *testing.T) {
+		// only the first broadcast of a participant changes the number of broadcasting participants
+		metrics.On("DKGParticipantsBroadcasting", 1).Once()
+		metrics.On("DKGParticipantsBroadcasting", 2).Once()
+		metrics.On("DKGBroadcastFailed").Once()
+
+		transcript.OnBroadcastReceived(1)
+		transcript.OnBroadcastReceived(1)
+		transcript.OnBroadcastReceived(2)
+		transcript.OnBroadcastReceived(len(committee)) // out of range, ignored
+		transcript.OnBroadcastFailed()
+		transcript.OnBroadcastSent()
+
+		summary := transcript.Summary()
+		assert.Equal(t, uint(0), summary.Participants[0].BroadcastsReceived)
+		assert.Equal(t, uint(2), summary.Participants[1].BroadcastsReceived)
+		assert.Equal(t, uint(1), summary.Participants[2].BroadcastsReceived)
+		assert.Equal(t, []int{0}, summary.MissingBroadcasts())
+		assert.Equal(t, uint(1), summary.BroadcastsSent)
+		assert.Equal(t, uint(1), summary.BroadcastsFailed)
+	})
+
+	t.Run("private messages", func(t *testing.T) {
+		transcript.OnPrivateMessageReceived(0)
+		transcript.OnPrivateMessageReceived(-1) // out of range, ignored
+
+		summary := transcript.Summary()
+		assert.Equal(t, uint(1), summary.Participants[0].PrivateMessagesReceived)
+	})
+
+	t.Run("complaints and disqualifications", func(t *testing.T) {
+		metrics.On("DKGParticipantsFlagged", 1).Twice()
+		metrics.On("DKGParticipantsDisqualified", 1).Once()
+
+		transcript.OnMisbehaviorFlagged(0, "invalid share")
+		transcript.OnMisbehaviorFlagged(0, "missing broadcast")
+		transcript.OnDisqualified(0, "missing broadcast")
+
+		summary := transcript.Summary()
+		assert.Equal(t, []string{"invalid share", "missing broadcast"}, summary.Participants[0].Complaints)
+		assert.True(t, summary.Participants[0].Disqualified)
+		assert.Equal(t, "missing broadcast", summary.Participants[0].DisqualificationReason)
+		assert.Equal(t, []int{0}, summary.Disqualified())
+	})
+
+	t.Run("result submission", func(t *testing.T) {
+		metrics.On("DKGResultSubmitted").Once()
+
+		groupKey := unittest.KeyFixture(crypto.ECDSAP256).PublicKey()
+		pubKeys := []crypto.PublicKey{nil, unittest.KeyFixture(crypto.ECDSAP256).PublicKey(), nil}
+		transcript.OnResultSubmitted(groupKey, pubKeys)
+
+		summary := transcript.Summary()
+		assert.True(t, summary.ResultSubmitted)
+		assert.Equal(t, ResultHash(groupKey, pubKeys), summary.ResultHash)
+		assert.NotEqual(t, ResultHash(groupKey, nil), summary.ResultHash)
+	})
+
+	t.Run("summary is a copy", func(t *testing.T) {
+		summary := transcript.Summary()
+		summary.Participants[0].Complaints[0] = "modified"
+		summary.PhaseTransitions[0].Phase = "modified"
+
+		actual := transcript.Summary()
+		assert.Equal(t, "invalid share", actual.Participants[0].Complaints[0])
+		assert.Equal(t, Phase1.String(), actual.PhaseTransitions[0].Phase)
+	})
+
+	metrics.AssertExpectations(t)
+}
@@ -8,15 +8,19 @@ import (
 // loosely-coupled Broker and Controller. The same BrokerTunnel is intended
 // to be reused across epochs.
 type BrokerTunnel struct {
-	MsgChIn  chan messages.PrivDKGMessageIn  // from network engine to broker
-	MsgChOut chan messages.PrivDKGMessageOut // from broker to network engine
+	MsgChIn        chan messages.PrivDKGMessageIn    // from network engine to broker
+	MsgChOut       chan messages.PrivDKGMessageOut   // from broker to network engine
+	GossipMsgChIn  chan messages.GossipDKGMessageIn  // broadcast messages from network engine to broker
+	GossipMsgChOut chan messages.GossipDKGMessageOut // broadcast messages from broker to network engine
 }
 
 // NewBrokerTunnel instantiates a new BrokerTunnel
 func NewBrokerTunnel() *BrokerTunnel {
 	return &BrokerTunnel{
-		MsgChIn:  make(chan messages.PrivDKGMessageIn),
-		MsgChOut: make(chan messages.PrivDKGMessageOut),
+		MsgChIn:        make(chan messages.PrivDKGMessageIn),
+		MsgChOut:       make(chan messages.PrivDKGMessageOut),
+		GossipMsgChIn:  make(chan messages.GossipDKGMessageIn),
+		GossipMsgChOut: make(chan messages.GossipDKGMessageOut),
 	}
 }
 
@@ -31,3 +35,15 @@ func (t *BrokerTunnel) SendIn(msg messages.PrivDKGMessageIn) {
 func (t *BrokerTunnel) SendOut(msg messages.PrivDKGMessageOut) {
 	t.MsgChOut <- msg
 }
+
+// SendGossipIn pushes incoming broadcast messages in the GossipMsgChIn channel
+// to be received by the Broker.
+func (t *BrokerTunnel) SendGossipIn(msg messages.GossipDKGMessageIn) {
+	t.GossipMsgChIn <- msg
+}
+
+// SendGossipOut pushes outgoing broadcast messages in the GossipMsgChOut
+// channel to be received and published by the network engine.
+func (t *BrokerTunnel) SendGossipOut(msg messages.GossipDKGMessageOut) {
+	t.GossipMsgChOut <- msg
+}
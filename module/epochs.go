@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/state/protocol"
 )
 
@@ -19,6 +20,11 @@ type ClusterRootQCVoter interface {
 	//
 	// It is safe to run Vote multiple times within a single setup phase.
 	Vote(context.Context, protocol.Epoch) error
+
+	// ClusterVotes checks the cluster QC contract for the votes of the members
+	// of this node's cluster in the given epoch. It returns all cluster members
+	// and the members whose vote was observed in the contract.
+	ClusterVotes(context.Context, protocol.Epoch) (members flow.IdentifierList, voted flow.IdentifierList, err error)
 }
 
 // QCContractClient enables interacting with the cluster QC aggregator smart
@@ -35,6 +41,11 @@ type QCContractClient interface {
 	// Voted returns true if we have successfully submitted a vote to the
 	// cluster QC aggregator smart contract for the current epoch.
 	Voted(ctx context.Context) (bool, error)
+
+	// NodeVoted returns true if the node with the given ID has successfully
+	// submitted a vote to the cluster QC aggregator smart contract for the
+	// current epoch.
+	NodeVoted(ctx context.Context, nodeID flow.Identifier) (bool, error)
 }
 
 // EpochLookup provides a method to find epochs by view.
@@ -65,6 +65,8 @@ func (l *EpochLookup) EpochForView(view uint64) (epochCounter uint64, err error)
 // case that any epoch preparation. For example, if we are in epoch 10, and
 // reach the final view of epoch 10 before epoch 11 has finished being setup,
 // this function will return 10 even after the final view of epoch 10.
+// Likewise, views between the final view of an epoch and the first view of
+// the recovery epoch succeeding it are attributed to the extended epoch.
 //
 func (l *EpochLookup) EpochForViewWithFallback(view uint64) (uint64, error) {
 
@@ -96,6 +98,38 @@ func (l *EpochLookup) EpochForViewWithFallback(view uint64) (uint64, error) {
 		if err != nil {
 			return 0, fmt.Errorf("unexpected error in EECC logic while retrieving DKG data: %w", err)
 		}
+
+		// If the next epoch is a recovery epoch, committed by an EpochRecover
+		// service event, the current epoch is extended until the recovery epoch
+		// begins.
+		nextFirstView, err := next.FirstView()
+		if err != nil {
+			return 0, err
+		}
+		if view < nextFirstView {
+			return current.Counter()
+		}
+	}
+
+	// If the current epoch is a recovery epoch, the previous epoch has been
+	// extended until the current epoch began.
+	previous := epochs.Previous()
+	_, err = previous.Counter()
+	if err != nil && !errors.Is(err, protocol.ErrNoPreviousEpoch) {
+		return 0, err
+	}
+	if err == nil {
+		previousFinalView, err := previous.FinalView()
+		if err != nil {
+			return 0, err
+		}
+		currentFirstView, err := current.FirstView()
+		if err != nil {
+			return 0, err
+		}
+		if previousFinalView < view && view < currentFirstView {
+			return previous.Counter()
+		}
 	}
 
 	// HAPPY PATH logic
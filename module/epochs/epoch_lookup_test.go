@@ -66,3 +66,60 @@ func TestEpochForView(t *testing.T) {
 		require.Equal(t, tc.epoch, epoch)
 	}
 }
+
+// TestEpochForViewWithFallback_EpochRecover tests that views between the final
+// view of an epoch and the first view of the recovery epoch succeeding it are
+// attributed to the extended epoch.
+func TestEpochForViewWithFallback_EpochRecover(t *testing.T) {
+	// epoch 100: 0    -> 999
+	// epoch 101: 1500 -> 2499 (recovery epoch)
+	newEpoch := func(counter, firstView, finalView uint64) *mockprotocol.Epoch {
+		epoch := new(mockprotocol.Epoch)
+		epoch.On("FirstView").Return(firstView, nil)
+		epoch.On("FinalView").Return(finalView, nil)
+		epoch.On("Counter").Return(counter, nil)
+		// return nil error to indicate a committed epoch
+		epoch.On("DKG").Return(nil, nil)
+		return epoch
+	}
+
+	epochQuery := mocks.NewEpochQuery(t, 100, newEpoch(100, 0, 999), newEpoch(101, 1500, 2499))
+
+	snapshot := new(mockprotocol.Snapshot)
+	snapshot.On("Epochs").Return(epochQuery)
+
+	state := new(mockprotocol.State)
+	state.On("Final").Return(snapshot)
+
+	lookup := NewEpochLookup(state)
+
+	type testCase struct {
+		view  uint64
+		epoch uint64
+	}
+
+	testCases := []testCase{
+		{view: 999, epoch: 100},
+		{view: 1000, epoch: 100},
+		{view: 1499, epoch: 100},
+		{view: 1500, epoch: 101},
+		{view: 2499, epoch: 101},
+	}
+
+	t.Run("recovery epoch committed", func(t *testing.T) {
+		for _, tc := range testCases {
+			epoch, err := lookup.EpochForViewWithFallback(tc.view)
+			require.NoError(t, err)
+			require.Equal(t, tc.epoch, epoch)
+		}
+	})
+
+	t.Run("recovery epoch started", func(t *testing.T) {
+		epochQuery.Transition()
+		for _, tc := range testCases {
+			epoch, err := lookup.EpochForViewWithFallback(tc.view)
+			require.NoError(t, err)
+			require.Equal(t, tc.epoch, epoch)
+		}
+	})
+}
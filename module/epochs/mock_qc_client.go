@@ -6,6 +6,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
 )
 
 // TEMPORARY: The functionality to allow starting up a node without a properly configured
@@ -36,3 +37,8 @@ func (c *MockQCContractClient) Voted(ctx context.Context) (bool, error) {
 	c.log.Fatal().Msg("caution: missing machine account configuration, but machine account used (Voted)")
 	return false, nil
 }
+
+func (c *MockQCContractClient) NodeVoted(ctx context.Context, nodeID flow.Identifier) (bool, error) {
+	c.log.Fatal().Msg("caution: missing machine account configuration, but machine account used (NodeVoted)")
+	return false, nil
+}
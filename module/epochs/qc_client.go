@@ -146,10 +146,17 @@ func (c *QCContractClient) SubmitVote(ctx context.Context, vote *model.Vote) err
 // Voted returns true if we have successfully submitted a vote to the
 // cluster QC aggregator smart contract for the current epoch.
 func (c *QCContractClient) Voted(ctx context.Context) (bool, error) {
+	return c.NodeVoted(ctx, c.nodeID)
+}
+
+// NodeVoted returns true if the node with the given ID has successfully
+// submitted a vote to the cluster QC aggregator smart contract for the
+// current epoch.
+func (c *QCContractClient) NodeVoted(ctx context.Context, nodeID flow.Identifier) (bool, error) {
 
 	// execute script to read if voted
 	template := templates.GenerateGetNodeHasVotedScript(c.env)
-	hasVoted, err := c.FlowClient.ExecuteScriptAtLatestBlock(ctx, template, []cadence.Value{cadence.String(c.nodeID.String())})
+	hasVoted, err := c.FlowClient.ExecuteScriptAtLatestBlock(ctx, template, []cadence.Value{cadence.String(nodeID.String())})
 	if err != nil {
 		return false, fmt.Errorf("could not execute voted script: %w", err)
 	}
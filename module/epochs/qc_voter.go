@@ -161,6 +161,37 @@ func (voter *RootQCVoter) Vote(ctx context.Context, epoch protocol.Epoch) error
 	return err
 }
 
+// ClusterVotes checks the cluster QC contract for the votes of the members of
+// this node's cluster in the given epoch. It returns all cluster members and
+// the members whose vote was observed in the contract. The contract is queried
+// using the contract client which was last successful.
+func (voter *RootQCVoter) ClusterVotes(ctx context.Context, epoch protocol.Epoch) (flow.IdentifierList, flow.IdentifierList, error) {
+
+	clusters, err := epoch.Clustering()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get clustering: %w", err)
+	}
+	cluster, _, ok := clusters.ByNodeID(voter.me.NodeID())
+	if !ok {
+		return nil, nil, fmt.Errorf("could not find self in clustering")
+	}
+
+	_, qcContractClient := voter.getInitialContractClient()
+	members := cluster.NodeIDs()
+	voted := make(flow.IdentifierList, 0, len(members))
+	for _, nodeID := range members {
+		hasVoted, err := qcContractClient.NodeVoted(ctx, nodeID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not check vote status of cluster member %x: %w", nodeID, err)
+		}
+		if hasVoted {
+			voted = append(voted, nodeID)
+		}
+	}
+
+	return members, voted, nil
+}
+
 // updateContractClient will return the last successful client index by default for all initial operations or else
 // it will return the appropriate client index with respect to last successful and number of client.
 func (voter *RootQCVoter) updateContractClient(clientIndex int) (int, module.QCContractClient) {
@@ -123,3 +123,18 @@ func (suite *Suite) TestVoting() {
 	err := suite.voter.Vote(context.Background(), suite.epoch)
 	suite.Assert().Nil(err)
 }
+
+// should report the cluster members whose vote was observed in the contract
+func (suite *Suite) TestClusterVotes() {
+	cluster, _, ok := suite.clustering.ByNodeID(suite.me.NodeID)
+	suite.Require().True(ok)
+	suite.client.On("NodeVoted", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, nodeID flow.Identifier) bool { return nodeID == suite.me.NodeID },
+		func(_ context.Context, _ flow.Identifier) error { return nil },
+	)
+
+	members, voted, err := suite.voter.ClusterVotes(context.Background(), suite.epoch)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(flow.IdentifierList(cluster.NodeIDs()), members)
+	suite.Assert().Equal(flow.IdentifierList{suite.me.NodeID}, voted)
+}
@@ -19,11 +19,13 @@ import (
 	"github.com/onflow/flow-go/network/mocknetwork"
 	cluster "github.com/onflow/flow-go/state/cluster/badger"
 	"github.com/onflow/flow-go/storage/badger/procedure"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestFinalizer(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerDB(t, func(badgerDB *badger.DB) {
+		db := badgerkv.New(badgerDB)
 
 		// seed the RNG
 		rand.Seed(time.Now().UnixNano())
@@ -39,7 +41,7 @@ func TestFinalizer(t *testing.T) {
 		// a helper function to clean up shared state between tests
 		cleanup := func() {
 			// wipe the DB
-			err := db.DropAll()
+			err := badgerDB.DropAll()
 			require.Nil(t, err)
 			// clear the mempool
 			for _, tx := range pool.All() {
@@ -6,20 +6,19 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/trace"
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // Finalizer is a simple wrapper around our temporary state to clean up after a
 // block has been fully finalized to the persistent protocol state.
 type Finalizer struct {
-	db      *badger.DB
+	db      kv.DB
 	headers storage.Headers
 	state   protocol.MutableState
 	cleanup CleanupFunc
@@ -27,7 +26,7 @@ type Finalizer struct {
 }
 
 // NewFinalizer creates a new finalizer for the temporary state.
-func NewFinalizer(db *badger.DB,
+func NewFinalizer(db kv.DB,
 	headers storage.Headers,
 	state protocol.MutableState,
 	tracer module.Tracer,
@@ -133,6 +133,36 @@ func (c *Consumer) Size() uint {
 	return uint(len(c.processings))
 }
 
+// MaxProcessing returns the max number of jobs processed concurrently.
+func (c *Consumer) MaxProcessing() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.maxProcessing
+}
+
+// SetMaxProcessing updates the max number of jobs processed concurrently. When the limit is raised,
+// the consumer immediately takes further jobs from the job queue. When it is lowered, jobs already being
+// processed are not affected, but no new job is taken until the number of running jobs drops below the limit.
+func (c *Consumer) SetMaxProcessing(maxProcessing uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	raised := maxProcessing > c.maxProcessing
+	c.maxProcessing = maxProcessing
+	if raised {
+		c.checkProcessable()
+	}
+}
+
+// ProcessedIndex returns the index of the last processed job.
+func (c *Consumer) ProcessedIndex() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.processedIndex
+}
+
 // NotifyJobIsDone let the consumer know a job has been finished, so that consumer will take
 // the next job from the job queue if there are workers available. It returns the last processed job index.
 func (c *Consumer) NotifyJobIsDone(jobID module.JobID) uint64 {
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	badgerdb "github.com/dgraph-io/badger/v2"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
@@ -15,6 +14,7 @@ import (
 	"github.com/onflow/flow-go/module/jobqueue"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -85,14 +85,14 @@ func TestConsumer(t *testing.T) {
 }
 
 func testOnStartup(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		assertProcessed(t, cp, 0)
 	})
 }
 
 func TestProcessedOrder(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(5))
 		assertProcessed(t, cp, 5)
 	})
@@ -101,7 +101,7 @@ func TestProcessedOrder(t *testing.T) {
 // [+1] => 									[0#, 1!]
 // when received job 1, it will be processed
 func testOnReceiveOneJob(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 
@@ -117,7 +117,7 @@ func testOnReceiveOneJob(t *testing.T) {
 // [+1, 1*] => 							[0#, 1#]
 // when job 1 is finished, it will be marked as processed
 func testOnJobFinished(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 
@@ -134,7 +134,7 @@ func testOnJobFinished(t *testing.T) {
 // [+1, +2, 1*, 2*] => 			[0#, 1#, 2#]
 // when job 2 and 1 are finished, they will be marked as processed
 func testOnJobsFinished(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -155,7 +155,7 @@ func testOnJobsFinished(t *testing.T) {
 // [+1, +2, +3, +4] => 			[0#, 1!, 2!, 3!, 4]
 // when more jobs are arrived than the max number of workers, only the first 3 jobs will be processed
 func testMaxWorker(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -179,7 +179,7 @@ func testMaxWorker(t *testing.T) {
 // [+1, +2, +3, +4, 3*] => 	[0#, 1!, 2!, 3*, 4!]
 // when job 3 is finished, which is not the next processing job 1, the processed index won't change
 func testNonNextFinished(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -209,7 +209,7 @@ func testNonNextFinished(t *testing.T) {
 //
 // [+1, +2, +3, +3, +4] => 	[1, 2, 3*, 4] => [1, 2, 3*, 4*] => => [1#, 2, 3*, 4*] => [1#, 2#, 3#, 4#]
 func testMovingProcessedIndex(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -262,7 +262,7 @@ func testMovingProcessedIndex(t *testing.T) {
 // [+1, +2, +3, +4, 3*, 2*] => 			[0#, 1!, 2*, 3*, 4!]
 // when job 3 and 2 are finished, the processed index won't change, because 1 is still not finished
 func testTwoNonNextFinished(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -289,7 +289,7 @@ func testTwoNonNextFinished(t *testing.T) {
 // [+1, +2, +3, +4, 3*, 2*, +5] =>	[0#, 1!, 2*, 3*, 4!, 5!]
 // when job 5 is received, it will be processed, because the worker has capacity
 func testProcessingWithNonNextFinished(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -319,7 +319,7 @@ func testProcessingWithNonNextFinished(t *testing.T) {
 // [+1, +2, +3, +4, 3*, 2*, +5, +6] =>	[0#, 1!, 2*, 3*, 4!, 5!, 6]
 // when job 6 is received, no more worker can process it, it will be buffered
 func testMaxWorkerWithFinishedNonNexts(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -352,7 +352,7 @@ func testMaxWorkerWithFinishedNonNexts(t *testing.T) {
 // [+1, +2, +3, +4, 3*, 2*, +5, 1*] => [0#, 1#, 2#, 3#, 4!, 5!]
 // when job 1 is finally finished, it will fast forward the processed index to 3
 func testFastforward(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -384,7 +384,7 @@ func testFastforward(t *testing.T) {
 // [+1, +2, +3, +4, 3*, 2*, +5, 1*, +6, +7, 6*], restart => [0#, 1#, 2#, 3#, 4!, 5!, 6*, 7!]
 // when job queue crashed and restarted, the queue can be resumed
 func testWorkOnNextAfterFastforward(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		require.NoError(t, j.PushOne()) // +1
 		c.Check()
@@ -435,7 +435,7 @@ func testWorkOnNextAfterFastforward(t *testing.T) {
 // [+1, +2, +3, +4, Stop, 2*] => [0#, 1!, 2*, 3!, 4]
 // when Stop is called, it won't work on any job any more
 func testStopRunning(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		for i := 0; i < 4; i++ {
 			require.NoError(t, j.PushOne())
@@ -457,7 +457,7 @@ func testStopRunning(t *testing.T) {
 }
 
 func testConcurrency(t *testing.T) {
-	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(t, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		require.NoError(t, c.Start(DefaultIndex))
 		var finishAll sync.WaitGroup
 		finishAll.Add(100)
@@ -499,8 +499,8 @@ func testConcurrency(t *testing.T) {
 type JobID = module.JobID
 type Job = module.Job
 
-func runWith(t testing.TB, runTestWith func(module.JobConsumer, storage.ConsumerProgress, *mockWorker, *jobqueue.MockJobs, *badgerdb.DB)) {
-	unittest.RunWithBadgerDB(t, func(db *badgerdb.DB) {
+func runWith(t testing.TB, runTestWith func(module.JobConsumer, storage.ConsumerProgress, *mockWorker, *jobqueue.MockJobs, kv.DB)) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		jobs := jobqueue.NewMockJobs()
 		worker := newMockWorker()
 		progress := badger.NewConsumerProgress(db, ConsumerTag)
@@ -574,7 +574,7 @@ func (w *mockWorker) AssertCalled(t *testing.T, expectCalled []int64) {
 // 0.22 ms to finish job
 func BenchmarkPushAndConsume(b *testing.B) {
 	b.StopTimer()
-	runWith(b, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db *badgerdb.DB) {
+	runWith(b, func(c module.JobConsumer, cp storage.ConsumerProgress, w *mockWorker, j *jobqueue.MockJobs, db kv.DB) {
 		var wg sync.WaitGroup
 		wg.Add(b.N)
 
@@ -4,7 +4,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -15,6 +14,8 @@ import (
 	"github.com/onflow/flow-go/module/mempool"
 	actormock "github.com/onflow/flow-go/module/mempool/consensus/mock"
 	poolmock "github.com/onflow/flow-go/module/mempool/mock"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -225,7 +226,7 @@ func Test_ConflictingResults(t *testing.T) {
 // persisted in the data base
 func Test_ForkDetectionPersisted(t *testing.T) {
 	unittest.RunWithTempDir(t, func(dir string) {
-		db := unittest.BadgerDB(t, dir)
+		db := badgerkv.New(unittest.BadgerDB(t, dir))
 		defer db.Close()
 
 		// initialize ExecForkSuppressor
@@ -252,7 +253,7 @@ func Test_ForkDetectionPersisted(t *testing.T) {
 
 		// crash => re-initialization
 		db.Close()
-		db2 := unittest.BadgerDB(t, dir)
+		db2 := badgerkv.New(unittest.BadgerDB(t, dir))
 		wrappedMempool2 := &poolmock.IncorporatedResultSeals{}
 		execForkActor2 := &actormock.ExecForkActorMock{}
 		execForkActor2.On("OnExecFork", mock.Anything).
@@ -287,7 +288,7 @@ func Test_AddRem_SmokeTest(t *testing.T) {
 	onExecFork := func([]*flow.IncorporatedResultSeal) {
 		assert.Fail(t, "no call to onExecFork expected ")
 	}
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		wrapper, err := NewExecStateForkSuppressor(onExecFork, db, zerolog.New(os.Stderr), 10)
 		require.NoError(t, err)
 		require.NotNil(t, wrapper)
@@ -326,7 +327,7 @@ func Test_AddRem_SmokeTest(t *testing.T) {
 //  3. ensures that initializing the wrapper did not error
 //  4. executes the `testLogic`
 func WithExecStateForkSuppressor(t testing.TB, testLogic func(wrapper *ExecForkSuppressor, wrappedMempool *poolmock.IncorporatedResultSeals, execForkActor *actormock.ExecForkActorMock)) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		wrappedMempool := &poolmock.IncorporatedResultSeals{}
 		execForkActor := &actormock.ExecForkActorMock{}
 		wrapper, err := NewExecStateForkSuppressor(execForkActor.OnExecFork, db, zerolog.New(os.Stderr), 10)
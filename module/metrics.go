@@ -87,6 +87,7 @@ type ComplianceMetrics interface {
 	CurrentDKGPhase2FinalView(view uint64)
 	CurrentDKGPhase3FinalView(view uint64)
 	EpochEmergencyFallbackTriggered()
+	EpochEmergencyFallbackRecovered()
 }
 
 type CleanerMetrics interface {
@@ -167,6 +168,22 @@ type CollectionMetrics interface {
 	ClusterBlockFinalized(block *cluster.Block)
 }
 
+// RootQCVoteMetrics reports the progress of the vote of a collection node for
+// the root QC of its cluster in the upcoming epoch.
+type RootQCVoteMetrics interface {
+	// RootQCVoteStatus reports the status of this node's vote for the root QC
+	// of its cluster in the upcoming epoch.
+	RootQCVoteStatus(status flow.RootQCVoteStatus)
+
+	// RootQCVoteAttempted increments the number of attempts of this node to
+	// vote for the root QC of its cluster.
+	RootQCVoteAttempted()
+
+	// RootQCClusterVotes reports the number of members of this node's cluster
+	// whose root QC vote was observed in the contract, and the cluster size.
+	RootQCClusterVotes(voted int, clusterSize int)
+}
+
 type ConsensusMetrics interface {
 	// StartCollectionToFinalized reports Metrics C1: Collection Received by CCL→ Collection Included in Finalized Block
 	StartCollectionToFinalized(collectionID flow.Identifier)
@@ -191,6 +208,40 @@ type ConsensusMetrics interface {
 
 	// CheckSealingDuration records absolute time for the full sealing check by the consensus match engine
 	CheckSealingDuration(duration time.Duration)
+
+	// SealingStatus reports a summary of the sealing status: the number of finalized blocks which
+	// are not sealed yet, the number of those blocks without any execution receipt, and the number
+	// of chunks of their results that are missing approvals.
+	SealingStatus(unsealedBlocks, blocksWithoutReceipts, chunksMissingApprovals uint)
+}
+
+// DKGMetrics reports the live progress of the DKG run by a consensus node,
+// and the health of the DKG participants as observed by this node.
+type DKGMetrics interface {
+	// DKGPhase reports the current phase of the local DKG instance.
+	DKGPhase(phase string)
+
+	// DKGCommitteeSize reports the number of participants in the DKG committee.
+	DKGCommitteeSize(size int)
+
+	// DKGParticipantsBroadcasting reports the number of DKG participants from
+	// which this node received at least one broadcast message.
+	DKGParticipantsBroadcasting(count int)
+
+	// DKGParticipantsFlagged reports the number of DKG participants which this
+	// node flagged for misbehaviour.
+	DKGParticipantsFlagged(count int)
+
+	// DKGParticipantsDisqualified reports the number of DKG participants which
+	// this node disqualified.
+	DKGParticipantsDisqualified(count int)
+
+	// DKGBroadcastFailed increments the number of broadcast messages this node
+	// failed to publish.
+	DKGBroadcastFailed()
+
+	// DKGResultSubmitted reports that this node submitted its DKG result.
+	DKGResultSubmitted()
 }
 
 type VerificationMetrics interface {
@@ -258,6 +309,26 @@ type VerificationMetrics interface {
 	// OnResultApprovalDispatchedInNetwork increments a counter that keeps track of number of result approvals dispatched in the network
 	// by verifier engine.
 	OnResultApprovalDispatchedInNetworkByVerifier()
+
+	// SetChunkConsumerWorkers sets a gauge that keeps track of the number of chunk jobs the chunk consumer
+	// processes in parallel.
+	SetChunkConsumerWorkers(workers uint64)
+
+	// SetChunkConsumerPendingJobs sets a gauge that keeps track of the number of chunk jobs in the chunks queue
+	// which have not been processed by the chunk consumer yet.
+	SetChunkConsumerPendingJobs(pending uint64)
+
+	// OnVerifiableChunkAdmittedAtVerifier is invoked when a verifiable chunk is admitted for verification by the
+	// verifier engine. It records the time the chunk waited for admission.
+	OnVerifiableChunkAdmittedAtVerifier(waited time.Duration)
+
+	// SetVerifierInFlight sets gauges that keep track of the number of chunks being verified in parallel by the
+	// verifier engine, and the total size of their chunk data packs.
+	SetVerifierInFlight(chunks uint64, bytes uint64)
+
+	// OnChunkVerifiedAtVerifier is invoked when the verifier engine finishes verifying a chunk. It records the
+	// duration of the verification.
+	OnChunkVerifiedAtVerifier(duration time.Duration)
 }
 
 // LedgerMetrics provides an interface to record Ledger Storage metrics.
@@ -24,6 +24,15 @@ type ConsensusCollector struct {
 
 	// The number of emergency seals
 	emergencySealedBlocks prometheus.Counter
+
+	// The number of finalized blocks which are not sealed yet
+	unsealedBlocks prometheus.Gauge
+
+	// The number of unsealed finalized blocks without any execution receipt
+	blocksWithoutReceipts prometheus.Gauge
+
+	// The number of chunks of unsealed results that are missing approvals
+	chunksMissingApprovals prometheus.Gauge
 }
 
 // NewConsensusCollector created a new consensus collector
@@ -52,18 +61,42 @@ func NewConsensusCollector(tracer module.Tracer, registerer prometheus.Registere
 		Subsystem: subsystemCompliance,
 		Help:      "the number of blocks sealed in emergency mode",
 	})
+	unsealedBlocks := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "unsealed_finalized_blocks",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemSealing,
+		Help:      "the number of finalized blocks which are not sealed yet",
+	})
+	blocksWithoutReceipts := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "unsealed_blocks_without_receipts",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemSealing,
+		Help:      "the number of unsealed finalized blocks without any execution receipt",
+	})
+	chunksMissingApprovals := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "chunks_missing_approvals",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemSealing,
+		Help:      "the number of chunks of unsealed results that are missing approvals",
+	})
 	registerer.MustRegister(
 		onReceiptDuration,
 		onApprovalDuration,
 		checkSealingDuration,
 		emergencySealedBlocks,
+		unsealedBlocks,
+		blocksWithoutReceipts,
+		chunksMissingApprovals,
 	)
 	cc := &ConsensusCollector{
-		tracer:                tracer,
-		onReceiptDuration:     onReceiptDuration,
-		onApprovalDuration:    onApprovalDuration,
-		checkSealingDuration:  checkSealingDuration,
-		emergencySealedBlocks: emergencySealedBlocks,
+		tracer:                 tracer,
+		onReceiptDuration:      onReceiptDuration,
+		onApprovalDuration:     onApprovalDuration,
+		checkSealingDuration:   checkSealingDuration,
+		emergencySealedBlocks:  emergencySealedBlocks,
+		unsealedBlocks:         unsealedBlocks,
+		blocksWithoutReceipts:  blocksWithoutReceipts,
+		chunksMissingApprovals: chunksMissingApprovals,
 	}
 	return cc
 }
@@ -103,3 +136,10 @@ func (cc *ConsensusCollector) OnApprovalProcessingDuration(duration time.Duratio
 func (cc *ConsensusCollector) CheckSealingDuration(duration time.Duration) {
 	cc.checkSealingDuration.Add(duration.Seconds())
 }
+
+// SealingStatus reports a summary of the sealing status.
+func (cc *ConsensusCollector) SealingStatus(unsealedBlocks, blocksWithoutReceipts, chunksMissingApprovals uint) {
+	cc.unsealedBlocks.Set(float64(unsealedBlocks))
+	cc.blocksWithoutReceipts.Set(float64(blocksWithoutReceipts))
+	cc.chunksMissingApprovals.Set(float64(chunksMissingApprovals))
+}
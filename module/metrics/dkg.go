@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DKGCollector reports the progress of the DKG run by a consensus node.
+type DKGCollector struct {
+	// The current phase of the local DKG instance
+	phase *prometheus.GaugeVec
+
+	// The number of participants in the DKG committee
+	committeeSize prometheus.Gauge
+
+	// The number of participants from which a broadcast message was received
+	participantsBroadcasting prometheus.Gauge
+
+	// The number of participants flagged for misbehaviour
+	participantsFlagged prometheus.Gauge
+
+	// The number of disqualified participants
+	participantsDisqualified prometheus.Gauge
+
+	// The number of broadcast messages this node failed to publish
+	broadcastsFailed prometheus.Counter
+
+	// The number of DKG results this node submitted
+	resultsSubmitted prometheus.Counter
+}
+
+// NewDKGCollector creates a new DKG collector
+func NewDKGCollector(registerer prometheus.Registerer) *DKGCollector {
+	phase := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:      "phase",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemDKG,
+		Help:      "the current phase of the local DKG instance, the gauge of the current phase is set to 1",
+	}, []string{LabelDKGPhase})
+	committeeSize := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "committee_size",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemDKG,
+		Help:      "the number of participants in the DKG committee",
+	})
+	participantsBroadcasting := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "participants_broadcasting",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemDKG,
+		Help:      "the number of DKG participants from which a broadcast message was received",
+	})
+	participantsFlagged := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "participants_flagged",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemDKG,
+		Help:      "the number of DKG participants flagged for misbehaviour",
+	})
+	participantsDisqualified := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "participants_disqualified",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemDKG,
+		Help:      "the number of disqualified DKG participants",
+	})
+	broadcastsFailed := prometheus.NewCounter(prometheus.CounterOpts{
+		Name:      "broadcasts_failed_total",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemDKG,
+		Help:      "the number of DKG broadcast messages this node failed to publish",
+	})
+	resultsSubmitted := prometheus.NewCounter(prometheus.CounterOpts{
+		Name:      "results_submitted_total",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemDKG,
+		Help:      "the number of DKG results this node submitted",
+	})
+	registerer.MustRegister(
+		phase,
+		committeeSize,
+		participantsBroadcasting,
+		participantsFlagged,
+		participantsDisqualified,
+		broadcastsFailed,
+		resultsSubmitted,
+	)
+	return &DKGCollector{
+		phase:                    phase,
+		committeeSize:            committeeSize,
+		participantsBroadcasting: participantsBroadcasting,
+		participantsFlagged:      participantsFlagged,
+		participantsDisqualified: participantsDisqualified,
+		broadcastsFailed:         broadcastsFailed,
+		resultsSubmitted:         resultsSubmitted,
+	}
+}
+
+// DKGPhase reports the current phase of the local DKG instance.
+func (dc *DKGCollector) DKGPhase(phase string) {
+	dc.phase.Reset()
+	dc.phase.WithLabelValues(phase).Set(1)
+}
+
+// DKGCommitteeSize reports the number of participants in the DKG committee.
+func (dc *DKGCollector) DKGCommitteeSize(size int) {
+	dc.committeeSize.Set(float64(size))
+}
+
+// DKGParticipantsBroadcasting reports the number of DKG participants from which
+// a broadcast message was received.
+func (dc *DKGCollector) DKGParticipantsBroadcasting(count int) {
+	dc.participantsBroadcasting.Set(float64(count))
+}
+
+// DKGParticipantsFlagged reports the number of DKG participants flagged for misbehaviour.
+func (dc *DKGCollector) DKGParticipantsFlagged(count int) {
+	dc.participantsFlagged.Set(float64(count))
+}
+
+// DKGParticipantsDisqualified reports the number of disqualified DKG participants.
+func (dc *DKGCollector) DKGParticipantsDisqualified(count int) {
+	dc.participantsDisqualified.Set(float64(count))
+}
+
+// DKGBroadcastFailed increments the number of broadcast messages this node failed to publish.
+func (dc *DKGCollector) DKGBroadcastFailed() {
+	dc.broadcastsFailed.Inc()
+}
+
+// DKGResultSubmitted increments the number of DKG results this node submitted.
+func (dc *DKGCollector) DKGResultSubmitted() {
+	dc.resultsSubmitted.Inc()
+}
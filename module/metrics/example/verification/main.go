@@ -144,6 +144,12 @@ func demo() {
 			tryRandomCall(func() {
 				vc.OnChunkConsumerJobDone(rand.Uint64() % 10000)
 			})
+			tryRandomCall(func() {
+				vc.SetChunkConsumerWorkers(rand.Uint64() % 20)
+			})
+			tryRandomCall(func() {
+				vc.SetChunkConsumerPendingJobs(rand.Uint64() % 100)
+			})
 
 			// assigner
 			tryRandomCall(func() {
@@ -173,6 +179,15 @@ func demo() {
 			// verifier
 			tryRandomCall(vc.OnVerifiableChunkReceivedAtVerifierEngine)
 			tryRandomCall(vc.OnResultApprovalDispatchedInNetworkByVerifier)
+			tryRandomCall(func() {
+				vc.OnVerifiableChunkAdmittedAtVerifier(time.Duration(rand.Int63n(int64(time.Second))))
+			})
+			tryRandomCall(func() {
+				vc.SetVerifierInFlight(rand.Uint64()%4, rand.Uint64()%(1<<30))
+			})
+			tryRandomCall(func() {
+				vc.OnChunkVerifiedAtVerifier(time.Duration(rand.Int63n(int64(5 * time.Second))))
+			})
 
 			// memory pools
 			receipt := unittest.ExecutionReceiptFixture()
@@ -210,6 +225,15 @@ func demo() {
 			time.Sleep(1 * time.Second)
 
 			tryRandomCall(vc.OnResultApprovalDispatchedInNetworkByVerifier)
+			tryRandomCall(func() {
+				vc.OnVerifiableChunkAdmittedAtVerifier(time.Duration(rand.Int63n(int64(time.Second))))
+			})
+			tryRandomCall(func() {
+				vc.SetVerifierInFlight(rand.Uint64()%4, rand.Uint64()%(1<<30))
+			})
+			tryRandomCall(func() {
+				vc.OnChunkVerifiedAtVerifier(time.Duration(rand.Int63n(int64(5 * time.Second))))
+			})
 		}
 	})
 }
@@ -30,7 +30,7 @@ func (c *MetricsConsumer) OnQcIncorporated(qc *flow.QuorumCertificate) {
 	c.metrics.SetQCView(qc.View)
 }
 
-func (c *MetricsConsumer) OnQcTriggeredViewChange(qc *flow.QuorumCertificate, newView uint64) {
+func (c *MetricsConsumer) OnTcTriggeredViewChange(tc *flow.TimeoutCertificate, newView uint64) {
 	c.metrics.CountSkipped()
 }
 
@@ -13,6 +13,8 @@ const (
 	LabelNodeInfo    = "nodeinfo"
 	LabelNodeVersion = "nodeversion"
 	LabelPriority    = "priority"
+	LabelDKGPhase    = "phase"
+	LabelVoteStatus  = "status"
 )
 
 const (
@@ -34,7 +36,8 @@ const (
 	EngineSealing            = "sealing"
 	EngineSynchronization    = "sync"
 	// common
-	EngineFollower = "follower"
+	EngineFollower         = "follower"
+	EngineSlashingEvidence = "slashing_evidence"
 )
 
 const (
@@ -82,6 +85,7 @@ const (
 
 	ResourceClusterBlockProposalQueue = "cluster_compliance_proposal_queue" // collection node, compliance engine
 	ResourceClusterBlockVoteQueue     = "cluster_compliance_vote_queue"     // collection node, compliance engine
+	ResourceClusterTimeoutQueue       = "cluster_compliance_timeout_queue"  // collection node, compliance engine
 	ResourceTransactionIngestQueue    = "ingest_transaction_queue"          // collection node, ingest engine
 	ResourceBeaconKey                 = "beacon-key"                        // consensus node, DKG engine
 	ResourceApprovalQueue             = "sealing_approval_queue"            // consensus node, sealing engine
@@ -89,6 +93,7 @@ const (
 	ResourceApprovalResponseQueue     = "sealing_approval_response_queue"   // consensus node, sealing engine
 	ResourceBlockProposalQueue        = "compliance_proposal_queue"         // consensus node, compliance engine
 	ResourceBlockVoteQueue            = "compliance_vote_queue"             // consensus node, compliance engine
+	ResourceTimeoutQueue              = "compliance_timeout_queue"          // consensus node, compliance engine
 	ResourceCollectionGuaranteesQueue = "ingestion_col_guarantee_queue"     // consensus node, ingestion engine
 	ResourceChunkDataPack             = "chunk_data_pack"                   // execution node
 	ResourceEvents                    = "events"                            // execution node
@@ -102,6 +107,7 @@ const (
 	MessageCollectionGuarantee  = "guarantee"
 	MessageBlockProposal        = "proposal"
 	MessageBlockVote            = "vote"
+	MessageTimeoutObject        = "timeout"
 	MessageExecutionReceipt     = "receipt"
 	MessageResultApproval       = "approval"
 	MessageSyncRequest          = "ping"
@@ -112,6 +118,7 @@ const (
 	MessageSyncedBlock          = "synced_block"
 	MessageClusterBlockProposal = "cluster_proposal"
 	MessageClusterBlockVote     = "cluster_vote"
+	MessageClusterTimeoutObject = "cluster_timeout"
 	MessageClusterBlockResponse = "cluster_block_response"
 	MessageSyncedClusterBlock   = "synced_cluster_block"
 	MessageTransaction          = "transaction"
@@ -120,4 +127,5 @@ const (
 	MessageCollectionResponse   = "collection_response"
 	MessageEntityRequest        = "entity_request"
 	MessageEntityResponse       = "entity_response"
+	MessageSlashingEvidence     = "slashing_evidence"
 )
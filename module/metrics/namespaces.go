@@ -37,6 +37,7 @@ const (
 // Collection subsystem
 const (
 	subsystemProposal = "proposal"
+	subsystemRootQC   = "root_qc"
 )
 
 // Consensus subsystems represent the different components of the consensus algorithm.
@@ -44,6 +45,8 @@ const (
 	subsystemCompliance  = "compliance"
 	subsystemHotstuff    = "hotstuff"
 	subsystemMatchEngine = "match"
+	subsystemSealing     = "sealing"
+	subsystemDKG         = "dkg"
 )
 
 // Execution Subsystems
@@ -62,6 +62,7 @@ func (nc *NoopCollector) CurrentDKGPhase1FinalView(view uint64)
 func (nc *NoopCollector) CurrentDKGPhase2FinalView(view uint64)                                  {}
 func (nc *NoopCollector) CurrentDKGPhase3FinalView(view uint64)                                  {}
 func (nc *NoopCollector) EpochEmergencyFallbackTriggered()                                       {}
+func (nc *NoopCollector) EpochEmergencyFallbackRecovered()                                       {}
 func (nc *NoopCollector) CacheEntries(resource string, entries uint)                             {}
 func (nc *NoopCollector) CacheHit(resource string)                                               {}
 func (nc *NoopCollector) CacheNotFound(resource string)                                          {}
@@ -91,6 +92,17 @@ func (nc *NoopCollector) EmergencySeal()
 func (nc *NoopCollector) OnReceiptProcessingDuration(duration time.Duration)                     {}
 func (nc *NoopCollector) OnApprovalProcessingDuration(duration time.Duration)                    {}
 func (nc *NoopCollector) CheckSealingDuration(duration time.Duration)                            {}
+func (nc *NoopCollector) SealingStatus(uint, uint, uint)                                         {}
+func (nc *NoopCollector) DKGPhase(string)                                                        {}
+func (nc *NoopCollector) DKGCommitteeSize(int)                                                   {}
+func (nc *NoopCollector) DKGParticipantsBroadcasting(int)                                        {}
+func (nc *NoopCollector) DKGParticipantsFlagged(int)                                             {}
+func (nc *NoopCollector) DKGParticipantsDisqualified(int)                                        {}
+func (nc *NoopCollector) DKGBroadcastFailed()                                                    {}
+func (nc *NoopCollector) DKGResultSubmitted()                                                    {}
+func (nc *NoopCollector) RootQCVoteStatus(flow.RootQCVoteStatus)                                 {}
+func (nc *NoopCollector) RootQCVoteAttempted()                                                   {}
+func (nc *NoopCollector) RootQCClusterVotes(int, int)                                            {}
 func (nc *NoopCollector) OnExecutionResultReceivedAtAssignerEngine()                             {}
 func (nc *NoopCollector) OnVerifiableChunkReceivedAtVerifierEngine()                             {}
 func (nc *NoopCollector) OnResultApprovalDispatchedInNetworkByVerifier()                         {}
@@ -108,6 +120,11 @@ func (nc *NoopCollector) OnChunkDataPackSentToFetcher()
 func (nc *NoopCollector) OnVerifiableChunkSentToVerifier()                                      {}
 func (nc *NoopCollector) OnBlockConsumerJobDone(uint64)                                         {}
 func (nc *NoopCollector) OnChunkConsumerJobDone(uint64)                                         {}
+func (nc *NoopCollector) SetChunkConsumerWorkers(uint64)                                        {}
+func (nc *NoopCollector) SetChunkConsumerPendingJobs(uint64)                                    {}
+func (nc *NoopCollector) OnVerifiableChunkAdmittedAtVerifier(time.Duration)                     {}
+func (nc *NoopCollector) SetVerifierInFlight(uint64, uint64)                                    {}
+func (nc *NoopCollector) OnChunkVerifiedAtVerifier(time.Duration)                               {}
 func (nc *NoopCollector) OnChunkDataPackResponseReceivedFromNetworkByRequester()                {}
 func (nc *NoopCollector) StartBlockReceivedToExecuted(blockID flow.Identifier)                  {}
 func (nc *NoopCollector) FinishBlockReceivedToExecuted(blockID flow.Identifier)                 {}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// RootQCVoteCollector reports the progress of the vote of a collection node
+// for the root QC of its cluster in the upcoming epoch.
+type RootQCVoteCollector struct {
+	// The status of this node's vote
+	status *prometheus.GaugeVec
+
+	// The number of attempts of this node to vote
+	attempts prometheus.Counter
+
+	// The number of cluster members whose vote was observed in the contract
+	clusterVoted prometheus.Gauge
+
+	// The number of members of this node's cluster
+	clusterSize prometheus.Gauge
+}
+
+// NewRootQCVoteCollector creates a new root QC vote collector
+func NewRootQCVoteCollector(registerer prometheus.Registerer) *RootQCVoteCollector {
+	status := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:      "vote_status",
+		Namespace: namespaceCollection,
+		Subsystem: subsystemRootQC,
+		Help:      "the status of this node's vote for the root QC of its cluster, the gauge of the current status is set to 1",
+	}, []string{LabelVoteStatus})
+	attempts := prometheus.NewCounter(prometheus.CounterOpts{
+		Name:      "vote_attempts_total",
+		Namespace: namespaceCollection,
+		Subsystem: subsystemRootQC,
+		Help:      "the number of attempts of this node to vote for the root QC of its cluster",
+	})
+	clusterVoted := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "cluster_voted",
+		Namespace: namespaceCollection,
+		Subsystem: subsystemRootQC,
+		Help:      "the number of members of this node's cluster whose root QC vote was observed in the contract",
+	})
+	clusterSize := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "cluster_size",
+		Namespace: namespaceCollection,
+		Subsystem: subsystemRootQC,
+		Help:      "the number of members of this node's cluster in the upcoming epoch",
+	})
+	registerer.MustRegister(
+		status,
+		attempts,
+		clusterVoted,
+		clusterSize,
+	)
+	return &RootQCVoteCollector{
+		status:       status,
+		attempts:     attempts,
+		clusterVoted: clusterVoted,
+		clusterSize:  clusterSize,
+	}
+}
+
+// RootQCVoteStatus reports the status of this node's vote for the root QC of
+// its cluster in the upcoming epoch.
+func (rc *RootQCVoteCollector) RootQCVoteStatus(status flow.RootQCVoteStatus) {
+	rc.status.Reset()
+	rc.status.WithLabelValues(status.String()).Set(1)
+}
+
+// RootQCVoteAttempted increments the number of attempts of this node to vote
+// for the root QC of its cluster.
+func (rc *RootQCVoteCollector) RootQCVoteAttempted() {
+	rc.attempts.Inc()
+}
+
+// RootQCClusterVotes reports the number of members of this node's cluster whose
+// root QC vote was observed in the contract, and the cluster size.
+func (rc *RootQCVoteCollector) RootQCClusterVotes(voted int, clusterSize int) {
+	rc.clusterVoted.Set(float64(voted))
+	rc.clusterSize.Set(float64(clusterSize))
+}
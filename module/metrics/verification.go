@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/onflow/flow-go/module"
@@ -12,6 +14,8 @@ type VerificationCollector struct {
 	// Job Consumers
 	lastProcessedBlockJobIndexBlockConsumer prometheus.Gauge
 	lastProcessedChunkJobIndexChunkConsumer prometheus.Gauge
+	workersChunkConsumer                    prometheus.Gauge // number of chunk jobs processed in parallel by chunk consumer
+	pendingJobsChunkConsumer                prometheus.Gauge // number of chunk jobs not yet processed by chunk consumer
 
 	// Assigner Engine
 	receivedFinalizedHeightAssigner prometheus.Gauge   // the last finalized height received by assigner engine
@@ -39,9 +43,12 @@ type VerificationCollector struct {
 	maxChunkDataPackRequestAttemptForNextUnsealedHeight prometheus.Gauge
 
 	// Verifier Engine
-	receivedVerifiableChunkTotalVerifier prometheus.Counter // total verifiable chunks received by verifier engine
-	sentResultApprovalTotalVerifier      prometheus.Counter // total result approvals sent by verifier engine
-
+	receivedVerifiableChunkTotalVerifier prometheus.Counter   // total verifiable chunks received by verifier engine
+	sentResultApprovalTotalVerifier      prometheus.Counter   // total result approvals sent by verifier engine
+	admissionWaitVerifier                prometheus.Histogram // time verifiable chunks wait for admission at verifier engine
+	inFlightChunksVerifier               prometheus.Gauge     // number of chunks being verified in parallel by verifier engine
+	inFlightBytesVerifier                prometheus.Gauge     // total size of chunk data packs being verified by verifier engine
+	verificationDurationVerifier         prometheus.Histogram // time it takes verifier engine to verify a chunk
 }
 
 func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Registerer) *VerificationCollector {
@@ -60,6 +67,20 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		Help:      "the last chunk job index processed by chunk consumer",
 	})
 
+	workersChunkConsumer := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "workers",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemChunkConsumer,
+		Help:      "the number of chunk jobs processed in parallel by chunk consumer",
+	})
+
+	pendingJobsChunkConsumer := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "pending_jobs",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemChunkConsumer,
+		Help:      "the number of chunk jobs in the chunks queue not yet processed by chunk consumer",
+	})
+
 	// Assigner Engine
 	receivedFinalizedHeightAssigner := prometheus.NewGauge(prometheus.GaugeOpts{
 		Name:      "finalized_height",
@@ -173,11 +194,43 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		Help:      "total number of emitted result approvals by verifier engine",
 	})
 
+	admissionWaitVerifier := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:      "admission_wait_seconds",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemVerifierEngine,
+		Help:      "time verifiable chunks wait for admission before being verified by verifier engine",
+		Buckets:   []float64{0.01, 0.1, 0.5, 1, 2, 5, 10, 30, 60},
+	})
+
+	inFlightChunksVerifier := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "in_flight_chunks",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemVerifierEngine,
+		Help:      "number of chunks being verified in parallel by verifier engine",
+	})
+
+	inFlightBytesVerifier := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "in_flight_bytes",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemVerifierEngine,
+		Help:      "total size of the chunk data packs of chunks being verified by verifier engine",
+	})
+
+	verificationDurationVerifier := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:      "chunk_verification_seconds",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemVerifierEngine,
+		Help:      "time it takes verifier engine to verify a chunk",
+		Buckets:   []float64{0.05, 0.1, 0.5, 1, 2, 5, 10, 30, 60},
+	})
+
 	// registers all metrics and panics if any fails.
 	registerer.MustRegister(
 		// job consumers
 		lastProcessedBlockJobIndexBlockConsumer,
 		lastProcessedChunkJobIndexChunkConsumer,
+		workersChunkConsumer,
+		pendingJobsChunkConsumer,
 
 		// assigner
 		receivedFinalizedHeightAssigner,
@@ -200,7 +253,11 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 
 		// verifier engine
 		receivedVerifiableChunksTotalVerifier,
-		sentResultApprovalTotalVerifier)
+		sentResultApprovalTotalVerifier,
+		admissionWaitVerifier,
+		inFlightChunksVerifier,
+		inFlightBytesVerifier,
+		verificationDurationVerifier)
 
 	vc := &VerificationCollector{
 		tracer: tracer,
@@ -208,6 +265,8 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		// job consumers
 		lastProcessedChunkJobIndexChunkConsumer: lastProcessedChunkJobIndexChunkConsumer,
 		lastProcessedBlockJobIndexBlockConsumer: lastProcessedBlockJobIndexBlockConsumer,
+		workersChunkConsumer:                    workersChunkConsumer,
+		pendingJobsChunkConsumer:                pendingJobsChunkConsumer,
 
 		// assigner
 		receivedFinalizedHeightAssigner: receivedFinalizedHeightAssigner,
@@ -224,6 +283,10 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		// verifier
 		sentResultApprovalTotalVerifier:      sentResultApprovalTotalVerifier,
 		receivedVerifiableChunkTotalVerifier: receivedVerifiableChunksTotalVerifier,
+		admissionWaitVerifier:                admissionWaitVerifier,
+		inFlightChunksVerifier:               inFlightChunksVerifier,
+		inFlightBytesVerifier:                inFlightBytesVerifier,
+		verificationDurationVerifier:         verificationDurationVerifier,
 
 		// requester
 		receivedChunkDataPackRequestsTotalRequester:         receivedChunkDataPackRequestsTotalRequester,
@@ -339,3 +402,34 @@ func (vc *VerificationCollector) OnBlockConsumerJobDone(processedIndex uint64) {
 func (vc *VerificationCollector) SetMaxChunkDataPackAttemptsForNextUnsealedHeightAtRequester(attempts uint64) {
 	vc.maxChunkDataPackRequestAttemptForNextUnsealedHeight.Set(float64(attempts))
 }
+
+// SetChunkConsumerWorkers sets a gauge that keeps track of the number of chunk jobs the chunk consumer
+// processes in parallel.
+func (vc *VerificationCollector) SetChunkConsumerWorkers(workers uint64) {
+	vc.workersChunkConsumer.Set(float64(workers))
+}
+
+// SetChunkConsumerPendingJobs sets a gauge that keeps track of the number of chunk jobs in the chunks queue
+// which have not been processed by the chunk consumer yet.
+func (vc *VerificationCollector) SetChunkConsumerPendingJobs(pending uint64) {
+	vc.pendingJobsChunkConsumer.Set(float64(pending))
+}
+
+// OnVerifiableChunkAdmittedAtVerifier is invoked when a verifiable chunk is admitted for verification by the
+// verifier engine. It records the time the chunk waited for admission.
+func (vc *VerificationCollector) OnVerifiableChunkAdmittedAtVerifier(waited time.Duration) {
+	vc.admissionWaitVerifier.Observe(waited.Seconds())
+}
+
+// SetVerifierInFlight sets gauges that keep track of the number of chunks being verified in parallel by the
+// verifier engine, and the total size of their chunk data packs.
+func (vc *VerificationCollector) SetVerifierInFlight(chunks uint64, bytes uint64) {
+	vc.inFlightChunksVerifier.Set(float64(chunks))
+	vc.inFlightBytesVerifier.Set(float64(bytes))
+}
+
+// OnChunkVerifiedAtVerifier is invoked when the verifier engine finishes verifying a chunk. It records the
+// duration of the verification.
+func (vc *VerificationCollector) OnChunkVerifiedAtVerifier(duration time.Duration) {
+	vc.verificationDurationVerifier.Observe(duration.Seconds())
+}
@@ -5,6 +5,8 @@ package mock
 import (
 	context "context"
 
+	flow "github.com/onflow/flow-go/model/flow"
+
 	mock "github.com/stretchr/testify/mock"
 
 	protocol "github.com/onflow/flow-go/state/protocol"
@@ -15,6 +17,38 @@ type ClusterRootQCVoter struct {
 	mock.Mock
 }
 
+// ClusterVotes provides a mock function with given fields: _a0, _a1
+func (_m *ClusterRootQCVoter) ClusterVotes(_a0 context.Context, _a1 protocol.Epoch) (flow.IdentifierList, flow.IdentifierList, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 flow.IdentifierList
+	if rf, ok := ret.Get(0).(func(context.Context, protocol.Epoch) flow.IdentifierList); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(flow.IdentifierList)
+		}
+	}
+
+	var r1 flow.IdentifierList
+	if rf, ok := ret.Get(1).(func(context.Context, protocol.Epoch) flow.IdentifierList); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(flow.IdentifierList)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, protocol.Epoch) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Vote provides a mock function with given fields: _a0, _a1
 func (_m *ClusterRootQCVoter) Vote(_a0 context.Context, _a1 protocol.Epoch) error {
 	ret := _m.Called(_a0, _a1)
@@ -64,6 +64,11 @@ func (_m *ComplianceMetrics) CurrentEpochPhase(phase flow.EpochPhase) {
 	_m.Called(phase)
 }
 
+// EpochEmergencyFallbackRecovered provides a mock function with given fields:
+func (_m *ComplianceMetrics) EpochEmergencyFallbackRecovered() {
+	_m.Called()
+}
+
 // EpochEmergencyFallbackTriggered provides a mock function with given fields:
 func (_m *ComplianceMetrics) EpochEmergencyFallbackTriggered() {
 	_m.Called()
@@ -44,6 +44,11 @@ func (_m *ConsensusMetrics) OnReceiptProcessingDuration(duration time.Duration)
 	_m.Called(duration)
 }
 
+// SealingStatus provides a mock function with given fields: unsealedBlocks, blocksWithoutReceipts, chunksMissingApprovals
+func (_m *ConsensusMetrics) SealingStatus(unsealedBlocks uint, blocksWithoutReceipts uint, chunksMissingApprovals uint) {
+	_m.Called(unsealedBlocks, blocksWithoutReceipts, chunksMissingApprovals)
+}
+
 // StartBlockToSeal provides a mock function with given fields: blockID
 func (_m *ConsensusMetrics) StartBlockToSeal(blockID flow.Identifier) {
 	_m.Called(blockID)
@@ -104,6 +104,22 @@ func (_m *DKGController) GetIndex() int {
 	return r0
 }
 
+// GetTranscript provides a mock function with given fields:
+func (_m *DKGController) GetTranscript() *flow.DKGTranscriptSummary {
+	ret := _m.Called()
+
+	var r0 *flow.DKGTranscriptSummary
+	if rf, ok := ret.Get(0).(func() *flow.DKGTranscriptSummary); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.DKGTranscriptSummary)
+		}
+	}
+
+	return r0
+}
+
 // Poll provides a mock function with given fields: blockReference
 func (_m *DKGController) Poll(blockReference flow.Identifier) error {
 	ret := _m.Called(blockReference)
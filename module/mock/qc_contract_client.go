@@ -5,6 +5,8 @@ package mock
 import (
 	context "context"
 
+	flow "github.com/onflow/flow-go/model/flow"
+
 	model "github.com/onflow/flow-go/consensus/hotstuff/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -14,6 +16,27 @@ type QCContractClient struct {
 	mock.Mock
 }
 
+// NodeVoted provides a mock function with given fields: ctx, nodeID
+func (_m *QCContractClient) NodeVoted(ctx context.Context, nodeID flow.Identifier) (bool, error) {
+	ret := _m.Called(ctx, nodeID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) bool); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier) error); ok {
+		r1 = rf(ctx, nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubmitVote provides a mock function with given fields: ctx, vote
 func (_m *QCContractClient) SubmitVote(ctx context.Context, vote *model.Vote) error {
 	ret := _m.Called(ctx, vote)
@@ -2,7 +2,11 @@
 
 package mock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // VerificationMetrics is an autogenerated mock type for the VerificationMetrics type
 type VerificationMetrics struct {
@@ -59,6 +63,11 @@ func (_m *VerificationMetrics) OnChunkDataPackSentToFetcher() {
 	_m.Called()
 }
 
+// OnChunkVerifiedAtVerifier provides a mock function with given fields: duration
+func (_m *VerificationMetrics) OnChunkVerifiedAtVerifier(duration time.Duration) {
+	_m.Called(duration)
+}
+
 // OnChunksAssignmentDoneAtAssigner provides a mock function with given fields: chunks
 func (_m *VerificationMetrics) OnChunksAssignmentDoneAtAssigner(chunks int) {
 	_m.Called(chunks)
@@ -79,6 +88,11 @@ func (_m *VerificationMetrics) OnResultApprovalDispatchedInNetworkByVerifier() {
 	_m.Called()
 }
 
+// OnVerifiableChunkAdmittedAtVerifier provides a mock function with given fields: waited
+func (_m *VerificationMetrics) OnVerifiableChunkAdmittedAtVerifier(waited time.Duration) {
+	_m.Called(waited)
+}
+
 // OnVerifiableChunkReceivedAtVerifierEngine provides a mock function with given fields:
 func (_m *VerificationMetrics) OnVerifiableChunkReceivedAtVerifierEngine() {
 	_m.Called()
@@ -89,7 +103,22 @@ func (_m *VerificationMetrics) OnVerifiableChunkSentToVerifier() {
 	_m.Called()
 }
 
+// SetChunkConsumerPendingJobs provides a mock function with given fields: pending
+func (_m *VerificationMetrics) SetChunkConsumerPendingJobs(pending uint64) {
+	_m.Called(pending)
+}
+
+// SetChunkConsumerWorkers provides a mock function with given fields: workers
+func (_m *VerificationMetrics) SetChunkConsumerWorkers(workers uint64) {
+	_m.Called(workers)
+}
+
 // SetMaxChunkDataPackAttemptsForNextUnsealedHeightAtRequester provides a mock function with given fields: attempts
 func (_m *VerificationMetrics) SetMaxChunkDataPackAttemptsForNextUnsealedHeightAtRequester(attempts uint64) {
 	_m.Called(attempts)
 }
+
+// SetVerifierInFlight provides a mock function with given fields: chunks, bytes
+func (_m *VerificationMetrics) SetVerifierInFlight(chunks uint64, bytes uint64) {
+	_m.Called(chunks, bytes)
+}
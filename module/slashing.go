@@ -0,0 +1,24 @@
+package module
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// SlashingEvidenceCollector collects evidence of slashable protocol violations detected by
+// this node, for the main consensus as well as for collector cluster consensus.
+type SlashingEvidenceCollector interface {
+	// AddEvidence persists the evidence and forwards it to the access nodes. Evidence for an
+	// offence, for which evidence has been collected before, is dropped. The evidence is
+	// processed asynchronously, hence the method does not block.
+	AddEvidence(evidence *flow.SlashingEvidence)
+}
+
+// SlashingEvidenceVerifier verifies that both conflicting messages of slashing evidence were
+// signed by the offender.
+type SlashingEvidenceVerifier interface {
+	// Verify checks the signatures of both conflicting messages against the offender's staking
+	// key. It returns false if a signature is invalid or the offender is not a known node. The
+	// evidence must be well-formed, see flow.SlashingEvidence.Validate. Any error indicates an
+	// unexpected exception.
+	Verify(evidence *flow.SlashingEvidence) (bool, error)
+}
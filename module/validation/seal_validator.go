@@ -14,29 +14,21 @@ import (
 	"github.com/onflow/flow-go/storage"
 )
 
-// DefaultRequiredApprovalsForSealValidation is the default number of approvals that should be
-// present and valid for each chunk. Setting this to 0 will disable counting of chunk approvals
-// this can be used temporarily to ease the migration to new chunk based sealing.
-// TODO:
-//   * This value is for the happy path (requires just one approval per chunk).
-//   * Full protocol should be +2/3 of all currently authorized verifiers.
-const DefaultRequiredApprovalsForSealValidation = 0
-
 // sealValidator holds all needed context for checking seal
 // validity against current protocol state.
 type sealValidator struct {
-	state                                protocol.State
-	assigner                             module.ChunkAssigner
-	signatureHasher                      hash.Hasher
-	seals                                storage.Seals
-	headers                              storage.Headers
-	index                                storage.Index
-	results                              storage.ExecutionResults
-	requiredApprovalsForSealConstruction uint // number of required approvals per chunk to construct a seal
-	requiredApprovalsForSealVerification uint // number of required approvals per chunk for a seal to be valid
-	metrics                              module.ConsensusMetrics
+	state           protocol.State
+	assigner        module.ChunkAssigner
+	signatureHasher hash.Hasher
+	seals           storage.Seals
+	headers         storage.Headers
+	index           storage.Index
+	results         storage.ExecutionResults
+	metrics         module.ConsensusMetrics
 }
 
+// NewSealValidator creates a new seal validator. The number of approvals required per chunk
+// and the rules for emergency sealing are part of the protocol state (see flow.SealingParameters).
 func NewSealValidator(
 	state protocol.State,
 	headers storage.Headers,
@@ -44,27 +36,18 @@ func NewSealValidator(
 	results storage.ExecutionResults,
 	seals storage.Seals,
 	assigner module.ChunkAssigner,
-	requiredApprovalsForSealConstruction uint,
-	requiredApprovalsForSealVerification uint,
 	metrics module.ConsensusMetrics,
-) (*sealValidator, error) {
-	if requiredApprovalsForSealConstruction < requiredApprovalsForSealVerification {
-		return nil, fmt.Errorf("required number of approvals for seal construction (%d) cannot be smaller than for seal verification (%d)",
-			requiredApprovalsForSealConstruction, requiredApprovalsForSealVerification)
-	}
-
+) *sealValidator {
 	return &sealValidator{
-		state:                                state,
-		assigner:                             assigner,
-		signatureHasher:                      crypto.NewBLSKMAC(encoding.ResultApprovalTag),
-		headers:                              headers,
-		results:                              results,
-		seals:                                seals,
-		index:                                index,
-		requiredApprovalsForSealConstruction: requiredApprovalsForSealConstruction,
-		requiredApprovalsForSealVerification: requiredApprovalsForSealVerification,
-		metrics:                              metrics,
-	}, nil
+		state:           state,
+		assigner:        assigner,
+		signatureHasher: crypto.NewBLSKMAC(encoding.ResultApprovalTag),
+		headers:         headers,
+		results:         results,
+		seals:           seals,
+		index:           index,
+		metrics:         metrics,
+	}
 }
 
 func (s *sealValidator) verifySealSignature(aggregatedSignatures *flow.AggregatedSignature,
@@ -152,6 +135,8 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 	// incorporatedResults collects execution results that are incorporated in unsealed
 	// blocks; CAUTION: some of these incorporated results might already be sealed.
 	incorporatedResults := make(map[flow.Identifier]*flow.IncorporatedResult)
+	// incorporatedHeights holds the height of the block incorporating each of the incorporatedResults
+	incorporatedHeights := make(map[flow.Identifier]uint64)
 
 	// IDs of unsealed blocks on the fork
 	var unsealedBlockIDs []flow.Identifier
@@ -185,6 +170,7 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 				return fmt.Errorf("internal error fetching result %v incorporated in block %v: %w", resultID, blockID, err)
 			}
 			incorporatedResults[resultID] = flow.NewIncorporatedResult(blockID, result)
+			incorporatedHeights[resultID] = header.Height
 		}
 		return nil
 	}
@@ -221,7 +207,7 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 		}
 
 		// check the integrity of the seal (by itself)
-		err := s.validateSeal(seal, incorporatedResult)
+		err := s.validateSeal(seal, incorporatedResult, incorporatedHeights[seal.ResultID], header.Height)
 		if err != nil {
 			if !engine.IsInvalidInputError(err) {
 				return nil, fmt.Errorf("unexpected internal error while validating seal %x for result %x for block %x: %w",
@@ -249,15 +235,25 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 // validateSeal performs integrity checks of single seal. To be valid, we
 // require that seal:
 // 1) Contains correct number of approval signatures, one aggregated sig for each chunk.
-// 2) Every aggregated signature contains valid signer ids. module.ChunkAssigner is used to perform this check.
-// 3) Every aggregated signature contains valid signatures.
+// 2) Every aggregated signature contains enough approvals, as required by the sealing
+//    parameters in effect for the block incorporating the result. A seal with fewer approvals
+//    than required for seal construction is only valid as an emergency seal.
+// 3) Every aggregated signature contains valid signer ids. module.ChunkAssigner is used to perform this check.
+// 4) Every aggregated signature contains valid signatures.
 // Returns:
 // * nil - in case of success
 // * engine.InvalidInputError - in case of malformed seal
 // * exception - in case of unexpected error
-func (s *sealValidator) validateSeal(seal *flow.Seal, incorporatedResult *flow.IncorporatedResult) error {
+func (s *sealValidator) validateSeal(seal *flow.Seal, incorporatedResult *flow.IncorporatedResult, incorporatedHeight uint64, candidateHeight uint64) error {
 	executionResult := incorporatedResult.Result
 
+	// The sealing parameters in effect for the block incorporating the result determine how
+	// many approvals are required, consistently with the verifier assignment for the result.
+	params, err := s.state.AtBlockID(incorporatedResult.IncorporatedBlockID).SealingParameters()
+	if err != nil {
+		return fmt.Errorf("could not get sealing parameters for block %x: %w", incorporatedResult.IncorporatedBlockID, err)
+	}
+
 	// check that each chunk has an AggregatedSignature
 	if len(seal.AggregatedApprovalSigs) != executionResult.Chunks.Len() {
 		return engine.NewInvalidInputErrorf("mismatching signatures, expected: %d, got: %d",
@@ -289,17 +285,20 @@ func (s *sealValidator) validateSeal(seal *flow.Seal, incorporatedResult *flow.I
 
 		// the chunk must have been approved by at least the minimally
 		// required number of Verification Nodes
-		if uint(numberApprovers) < s.requiredApprovalsForSealConstruction {
-			if uint(numberApprovers) >= s.requiredApprovalsForSealVerification {
-				// Emergency sealing is a _temporary_ fallback to reduce the probability of
-				// sealing halts due to bugs in the verification nodes, where they don't
-				// approve a chunk even though they should (false-negative).
-				// TODO: remove this fallback for BFT
-				emergencySealed = true
-			} else {
+		if uint(numberApprovers) < params.RequiredApprovalsForSealConstruction {
+			if uint(numberApprovers) < params.RequiredApprovalsForSealVerification {
 				return engine.NewInvalidInputErrorf("chunk %d has %d approvals but require at least %d",
-					chunk.Index, numberApprovers, s.requiredApprovalsForSealVerification)
+					chunk.Index, numberApprovers, params.RequiredApprovalsForSealVerification)
+			}
+			// Emergency sealing is a _temporary_ fallback to reduce the probability of
+			// sealing halts due to bugs in the verification nodes, where they don't
+			// approve a chunk even though they should (false-negative).
+			// TODO: remove this fallback for BFT
+			if !params.EmergencySealable(incorporatedHeight, candidateHeight) {
+				return engine.NewInvalidInputErrorf("chunk %d has %d approvals but require at least %d, and result does not qualify for emergency sealing",
+					chunk.Index, numberApprovers, params.RequiredApprovalsForSealConstruction)
 			}
+			emergencySealed = true
 		}
 
 		// only Verification Nodes that were assigned to the chunk are allowed to approve it
@@ -310,7 +309,7 @@ func (s *sealValidator) validateSeal(seal *flow.Seal, incorporatedResult *flow.I
 		}
 
 		// Verification Nodes' approval signatures must be valid
-		err := s.verifySealSignature(chunkSigs, chunk, executionResultID)
+		err = s.verifySealSignature(chunkSigs, chunk, executionResultID)
 		if err != nil {
 			return fmt.Errorf("invalid seal signature: %w", err)
 		}
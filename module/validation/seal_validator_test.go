@@ -29,19 +29,15 @@ func (s *SealValidationSuite) SetupTest() {
 	s.SetupChain()
 	s.publicKey = &module.PublicKey{}
 	s.metrics = &module.ConsensusMetrics{}
+	s.SealingParameters = flow.SealingParameters{
+		RequiredApprovalsForSealConstruction: 2,
+		RequiredApprovalsForSealVerification: 2,
+		EmergencySealingActive:               false,
+		EmergencySealingThreshold:            flow.DefaultEmergencySealingThreshold,
+	}
 
-	var err error
-	s.sealValidator, err = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, 2, 2, s.metrics)
-	s.Require().NoError(err)
-}
-
-// TestConsistencyCheckOnApprovals verifies that SealValidator instantiation fails if
-// required number of approvals for seal construction is smaller than for seal verification
-func (s *SealValidationSuite) TestConsistencyCheckOnApprovals() {
-	_, err := NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, 2, 3, s.metrics)
-	s.Require().Error(err)
+	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
+		s.Assigner, s.metrics)
 }
 
 // TestSealValid tests that a candidate block with a valid seal passes validation.
@@ -114,15 +110,19 @@ func (s *SealValidationSuite) TestSealInvalidAggregatedSigCount() {
 	s.Require().True(engine.IsInvalidInputError(err))
 }
 
-// TestSealEmergencySeal checks that, when requiredApprovalsForSealVerification
-// is 0, a seal which has 0 signatures for at least one chunk will be accepted,
-// and that the emergency-seal metric will be incremented.
+// TestSealEmergencySeal checks that, when emergency sealing is active and
+// requiredApprovalsForSealVerification is 0, a seal which has 0 signatures for
+// at least one chunk will be accepted, and that the emergency-seal metric will
+// be incremented.
 // We test with the following fork:
 //   ... <- LatestSealedBlock <- B0 <- B1{ Result[B0], Receipt[B0] } <- B2 <- ░newBlock{ Seal[B0]}░
 // The gap of 1 block, i.e. B2, is required to avoid a sealing edge-case
 // (see test `TestSeal_EnforceGap` for more details)
 func (s *SealValidationSuite) TestSealEmergencySeal() {
 	_, b2, _, receipt, _ := s.generateBasicTestFork()
+	// newBlock has height B1.Height+2, so the result incorporated in B1 qualifies for emergency sealing
+	s.SealingParameters.EmergencySealingActive = true
+	s.SealingParameters.EmergencySealingThreshold = 2
 
 	// requiredApprovalsForSealConstruction = 2
 	// receive seal with 2 approvals => _not_ emergency sealed
@@ -139,7 +139,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal() {
 	// requiredApprovalsForSealConstruction = 2
 	// requiredApprovalsForSealVerification = 1
 	// receive seal with 1 approval => emergency sealed
-	s.sealValidator.requiredApprovalsForSealVerification = 1
+	s.SealingParameters.RequiredApprovalsForSealVerification = 1
 	newBlock = s.makeBlockSealingResult(b2.Header, &receipt.ExecutionResult, 1)
 	metrics = &module.ConsensusMetrics{}
 	metrics.On("EmergencySeal").Once()
@@ -152,7 +152,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal() {
 	// requiredApprovalsForSealConstruction = 2
 	// requiredApprovalsForSealVerification = 1
 	// receive seal with 0 approval => invalid
-	s.sealValidator.requiredApprovalsForSealVerification = 1
+	s.SealingParameters.RequiredApprovalsForSealVerification = 1
 	newBlock = s.makeBlockSealingResult(b2.Header, &receipt.ExecutionResult, 0)
 	metrics = &module.ConsensusMetrics{}
 	metrics.On("EmergencySeal").Run(func(args mock.Arguments) {
@@ -167,7 +167,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal() {
 	// requiredApprovalsForSealConstruction = 2
 	// requiredApprovalsForSealVerification = 0
 	// receive seal with 1 approval => emergency sealed
-	s.sealValidator.requiredApprovalsForSealVerification = 0
+	s.SealingParameters.RequiredApprovalsForSealVerification = 0
 	newBlock = s.makeBlockSealingResult(b2.Header, &receipt.ExecutionResult, 1)
 	metrics = &module.ConsensusMetrics{}
 	metrics.On("EmergencySeal").Once()
@@ -180,7 +180,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal() {
 	// requiredApprovalsForSealConstruction = 2
 	// requiredApprovalsForSealVerification = 0
 	// receive seal with 0 approval => emergency sealed
-	s.sealValidator.requiredApprovalsForSealVerification = 0
+	s.SealingParameters.RequiredApprovalsForSealVerification = 0
 	newBlock = s.makeBlockSealingResult(b2.Header, &receipt.ExecutionResult, 0)
 	metrics = &module.ConsensusMetrics{}
 	metrics.On("EmergencySeal").Once()
@@ -191,6 +191,37 @@ func (s *SealValidationSuite) TestSealEmergencySeal() {
 	metrics.AssertExpectations(s.T())
 }
 
+// TestSealEmergencySeal_RulesEnforced checks that a seal with fewer approvals than required for
+// seal construction is rejected, if emergency sealing is inactive or the sealed result does not
+// qualify for emergency sealing yet.
+// We test with the following fork:
+//   ... <- LatestSealedBlock <- B0 <- B1{ Result[B0], Receipt[B0] } <- B2 <- ░newBlock{ Seal[B0]}░
+func (s *SealValidationSuite) TestSealEmergencySeal_RulesEnforced() {
+	_, b2, _, receipt, _ := s.generateBasicTestFork()
+	s.SealingParameters.RequiredApprovalsForSealVerification = 1
+	metrics := &module.ConsensusMetrics{}
+	metrics.On("EmergencySeal").Run(func(args mock.Arguments) {
+		s.T().Errorf("invalid seal should not be counted as emmergency sealed")
+	}).Return()
+	s.sealValidator.metrics = metrics
+
+	// emergency sealing inactive => invalid
+	s.SealingParameters.EmergencySealingActive = false
+	s.SealingParameters.EmergencySealingThreshold = 2
+	newBlock := s.makeBlockSealingResult(b2.Header, &receipt.ExecutionResult, 1)
+	_, err := s.sealValidator.Validate(newBlock)
+	s.Require().Error(err)
+	s.Require().True(engine.IsInvalidInputError(err))
+
+	// emergency sealing active, but newBlock has height B1.Height+2 < B1.Height+threshold => invalid
+	s.SealingParameters.EmergencySealingActive = true
+	s.SealingParameters.EmergencySealingThreshold = 3
+	newBlock = s.makeBlockSealingResult(b2.Header, &receipt.ExecutionResult, 1)
+	_, err = s.sealValidator.Validate(newBlock)
+	s.Require().Error(err)
+	s.Require().True(engine.IsInvalidInputError(err))
+}
+
 // TestSealInvalidChunkSignersCount tests that we reject seal with invalid approval signatures for
 // submitted seal
 func (s *SealValidationSuite) TestSealInvalidChunkSignersCount() {
@@ -8,6 +8,7 @@ const (
 	// consensus
 	CodeBlockProposal
 	CodeBlockVote
+	CodeTimeoutObject
 
 	// protocol state sync
 	CodeSyncRequest
@@ -19,6 +20,7 @@ const (
 	// cluster consensus
 	CodeClusterBlockProposal
 	CodeClusterBlockVote
+	CodeClusterTimeoutObject
 	CodeClusterBlockResponse
 
 	// collections, guarantees & transactions
@@ -51,6 +53,10 @@ const (
 
 	// DKG
 	CodeDKGMessage
+	CodeGossipDKGMessage
+
+	// slashing evidence
+	CodeSlashingEvidence
 
 	CodeMax
 )
@@ -24,12 +24,16 @@ func switchenv2v(env Envelope) (interface{}, error) {
 		v = &messages.BlockProposal{}
 	case CodeBlockVote:
 		v = &messages.BlockVote{}
+	case CodeTimeoutObject:
+		v = &messages.TimeoutObject{}
 
 	// cluster consensus
 	case CodeClusterBlockProposal:
 		v = &messages.ClusterBlockProposal{}
 	case CodeClusterBlockVote:
 		v = &messages.ClusterBlockVote{}
+	case CodeClusterTimeoutObject:
+		v = &messages.ClusterTimeoutObject{}
 	case CodeClusterBlockResponse:
 		v = &messages.ClusterBlockResponse{}
 
@@ -89,6 +93,12 @@ func switchenv2v(env Envelope) (interface{}, error) {
 	// dkg
 	case CodeDKGMessage:
 		v = &messages.DKGMessage{}
+	case CodeGossipDKGMessage:
+		v = &messages.GossipDKGMessage{}
+
+	// slashing evidence
+	case CodeSlashingEvidence:
+		v = &flow.SlashingEvidence{}
 
 	default:
 		return nil, errors.Errorf("invalid message code (%d)", env.Code)
@@ -107,12 +117,16 @@ func switchenv2what(env Envelope) (string, error) {
 		what = "CodeBlockProposal"
 	case CodeBlockVote:
 		what = "CodeBlockVote"
+	case CodeTimeoutObject:
+		what = "CodeTimeoutObject"
 
 	// cluster consensus
 	case CodeClusterBlockProposal:
 		what = "CodeClusterBlockProposal"
 	case CodeClusterBlockVote:
 		what = "CodeClusterBlockVote"
+	case CodeClusterTimeoutObject:
+		what = "CodeClusterTimeoutObject"
 	case CodeClusterBlockResponse:
 		what = "CodeClusterBlockResponse"
 
@@ -172,6 +186,12 @@ func switchenv2what(env Envelope) (string, error) {
 	// dkg
 	case CodeDKGMessage:
 		what = "CodeDKGMessage"
+	case CodeGossipDKGMessage:
+		what = "CodeGossipDKGMessage"
+
+	// slashing evidence
+	case CodeSlashingEvidence:
+		what = "CodeSlashingEvidence"
 
 	default:
 		return "", errors.Errorf("invalid message code (%d)", env.Code)
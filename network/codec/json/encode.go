@@ -24,6 +24,8 @@ func switchv2code(v interface{}) (uint8, error) {
 		code = CodeBlockProposal
 	case *messages.BlockVote:
 		code = CodeBlockVote
+	case *messages.TimeoutObject:
+		code = CodeTimeoutObject
 
 	// protocol state sync
 	case *messages.SyncRequest:
@@ -42,6 +44,8 @@ func switchv2code(v interface{}) (uint8, error) {
 		code = CodeClusterBlockProposal
 	case *messages.ClusterBlockVote:
 		code = CodeClusterBlockVote
+	case *messages.ClusterTimeoutObject:
+		code = CodeClusterTimeoutObject
 	case *messages.ClusterBlockResponse:
 		code = CodeClusterBlockResponse
 
@@ -90,6 +94,12 @@ func switchv2code(v interface{}) (uint8, error) {
 	// dkg
 	case *messages.DKGMessage:
 		code = CodeDKGMessage
+	case *messages.GossipDKGMessage:
+		code = CodeGossipDKGMessage
+
+	// slashing evidence
+	case *flow.SlashingEvidence:
+		code = CodeSlashingEvidence
 
 	default:
 		return 0, errors.Errorf("invalid encode type (%T)", v)
@@ -108,6 +118,8 @@ func switchv2what(v interface{}) (string, error) {
 		what = "CodeBlockProposal"
 	case *messages.BlockVote:
 		what = "CodeBlockVote"
+	case *messages.TimeoutObject:
+		what = "CodeTimeoutObject"
 
 	// protocol state sync
 	case *messages.SyncRequest:
@@ -126,6 +138,8 @@ func switchv2what(v interface{}) (string, error) {
 		what = "CodeClusterBlockProposal"
 	case *messages.ClusterBlockVote:
 		what = "CodeClusterBlockVote"
+	case *messages.ClusterTimeoutObject:
+		what = "CodeClusterTimeoutObject"
 	case *messages.ClusterBlockResponse:
 		what = "CodeClusterBlockResponse"
 
@@ -174,6 +188,12 @@ func switchv2what(v interface{}) (string, error) {
 	// dkg
 	case *messages.DKGMessage:
 		what = "CodeDKGMessage"
+	case *messages.GossipDKGMessage:
+		what = "CodeGossipDKGMessage"
+
+	// slashing evidence
+	case *flow.SlashingEvidence:
+		what = "CodeSlashingEvidence"
 
 	default:
 		return "", errors.Errorf("invalid encode type (%T)", v)
@@ -11,6 +11,7 @@ const (
 	// consensus
 	CodeBlockProposal = iota + 1
 	CodeBlockVote
+	CodeTimeoutObject
 
 	// protocol state sync
 	CodeSyncRequest
@@ -22,6 +23,7 @@ const (
 	// cluster consensus
 	CodeClusterBlockProposal
 	CodeClusterBlockVote
+	CodeClusterTimeoutObject
 	CodeClusterBlockResponse
 
 	// collections, guarantees & transactions
@@ -54,6 +56,10 @@ const (
 
 	// DKG
 	CodeDKGMessage
+	CodeGossipDKGMessage
+
+	// slashing evidence
+	CodeSlashingEvidence
 )
 
 // Envelope is a wrapper to convey type information with JSON encoding without
@@ -44,6 +44,8 @@ func getPriorityByType(message interface{}) Priority {
 		return HighPriority
 	case *messages.BlockVote:
 		return HighPriority
+	case *messages.TimeoutObject:
+		return HighPriority
 
 	// protocol state sync
 	case *messages.SyncRequest:
@@ -62,6 +64,8 @@ func getPriorityByType(message interface{}) Priority {
 		return HighPriority
 	case *messages.ClusterBlockVote:
 		return HighPriority
+	case *messages.ClusterTimeoutObject:
+		return HighPriority
 	case *messages.ClusterBlockResponse:
 		return HighPriority
 
@@ -97,6 +101,10 @@ func getPriorityByType(message interface{}) Priority {
 	case *messages.ApprovalResponse:
 		return MediumPriority
 
+	// slashing evidence
+	case *flow.SlashingEvidence:
+		return LowPriority
+
 	// generic entity exchange engines
 	case *messages.EntityRequest:
 		return LowPriority
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"math"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/cluster"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
@@ -16,6 +14,7 @@ import (
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/procedure"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type MutableState struct {
@@ -43,7 +42,7 @@ func (m *MutableState) Extend(block *cluster.Block) error {
 	span, ctx, _ := m.tracer.StartCollectionSpan(context.Background(), blockID, trace.COLClusterStateMutatorExtend)
 	defer span.Finish()
 
-	err := m.State.db.View(func(tx *badger.Txn) error {
+	err := m.State.db.View(func(tx kv.Transaction) error {
 
 		setupSpan, _ := m.tracer.StartSpanFromContext(ctx, trace.COLClusterStateMutatorExtendSetup)
 
@@ -9,7 +9,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -26,13 +25,15 @@ import (
 	storage "github.com/onflow/flow-go/storage/badger"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/procedure"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 	"github.com/onflow/flow-go/storage/util"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 type MutatorSuite struct {
 	suite.Suite
-	db    *badger.DB
+	db    kv.DB
 	dbdir string
 
 	genesis *model.Block
@@ -56,7 +57,7 @@ func (suite *MutatorSuite) SetupTest() {
 	suite.chainID = suite.genesis.Header.ChainID
 
 	suite.dbdir = unittest.TempDir(suite.T())
-	suite.db = unittest.BadgerDB(suite.T(), suite.dbdir)
+	suite.db = badgerkv.New(unittest.BadgerDB(suite.T(), suite.dbdir))
 
 	metrics := metrics.NewNoopCollector()
 	tracer := trace.NewNoopTracer()
@@ -176,7 +177,7 @@ func (suite *MutatorSuite) TestBootstrap_InvalidPayload() {
 }
 
 func (suite *MutatorSuite) TestBootstrap_Successful() {
-	err := suite.db.View(func(tx *badger.Txn) error {
+	err := suite.db.View(func(tx kv.Transaction) error {
 
 		// should insert collection
 		var collection flow.LightCollection
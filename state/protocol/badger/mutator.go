@@ -7,8 +7,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
@@ -19,6 +17,7 @@ import (
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/procedure"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // errIncompleteEpochConfiguration is a sentinel error returned when there are
@@ -531,26 +530,36 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 		return fmt.Errorf("could not get parent (id=%x): %w", header.ParentID, err)
 	}
 
-	// EECC - check whether the epoch emergency fallback flag has been set
-	// in the database. If so, skip updating any epoch-related metrics.
-	epochFallbackTriggered, err := m.isEpochEmergencyFallbackTriggered()
+	parentEpochStatus, err := m.epoch.statuses.ByBlockID(header.ParentID)
+	if err != nil {
+		return fmt.Errorf("could not retrieve epoch state for parent: %w", err)
+	}
+
+	// EECC - check whether epoch emergency fallback is in effect for the parent or
+	// this block. If so, skip updating any epoch-related metrics, unless the network
+	// is recovering from EECC through an EpochRecover event.
+	parentFallbackTriggered, err := m.isEpochFallbackTriggered(parent.Header, parentEpochStatus)
+	if err != nil {
+		return fmt.Errorf("could not check epoch emergency fallback for parent: %w", err)
+	}
+	epochFallbackTriggered, err := m.isEpochFallbackTriggered(header, epochStatus)
 	if err != nil {
-		return fmt.Errorf("could not check epoch emergency fallback flag: %w", err)
+		return fmt.Errorf("could not check epoch emergency fallback: %w", err)
 	}
 
 	// track service event driven metrics and protocol events that should be emitted
 	var events []func()
 	for _, seal := range parent.Payload.Seals {
-		// skip updating epoch-related metrics if EECC is triggered
-		if epochFallbackTriggered {
-			break
-		}
-
 		result, err := m.results.ByID(seal.ResultID)
 		if err != nil {
 			return fmt.Errorf("could not retrieve result (id=%x) for seal (id=%x): %w", seal.ResultID, seal.ID(), err)
 		}
 		for _, event := range result.ServiceEvents {
+			// skip updating epoch-related metrics if EECC is triggered
+			if _, isRecover := event.Event.(*flow.EpochRecover); (parentFallbackTriggered || epochFallbackTriggered) && !isRecover {
+				continue
+			}
+
 			switch ev := event.Event.(type) {
 			case *flow.EpochSetup:
 				// update current epoch phase
@@ -568,6 +577,18 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 					return fmt.Errorf("could not retrieve setup event for next epoch: %w", err)
 				}
 				events = append(events, func() { m.metrics.CommittedEpochFinalView(nextEpochSetup.FinalView) })
+			case *flow.EpochRecover:
+				// invalid recover events are ignored when applying service events,
+				// so we only track the recover event which committed the next epoch
+				if epochStatus.NextEpoch.CommitID != ev.EpochCommit.ID() {
+					continue
+				}
+				// update current epoch phase
+				events = append(events, func() { m.metrics.CurrentEpochPhase(flow.EpochPhaseCommitted) })
+				// track epoch phase transition (fallback->committed)
+				events = append(events, func() { m.consumer.EpochCommittedPhaseStarted(ev.EpochSetup.Counter-1, header) })
+				// track final view of committed recovery epoch
+				events = append(events, func() { m.metrics.CommittedEpochFinalView(ev.EpochSetup.FinalView) })
 			default:
 				return fmt.Errorf("invalid service event type in payload (%T)", event)
 			}
@@ -578,20 +599,19 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 	// Convention:
 	// Service notifications and updating metrics happen when we finalize the _first_
 	// block of the new Epoch (same convention as for Epoch-Phase-Changes)
-	// Approach: We retrieve the parent block's epoch information. If this block's view
-	// exceeds the final view of its parent's current epoch, this block begins the next epoch.
-	parentBlocksEpoch := m.AtBlockID(header.ParentID).Epochs().Current()
-	parentEpochFinalView, err := parentBlocksEpoch.FinalView()
-	if err != nil {
-		return fmt.Errorf("could not get parent epoch final view: %w", err)
-	}
-
-	// When this block's view exceeds the parent epoch's final view, this block
-	// represents the first block of the next epoch. Therefore we update metrics
-	// related to the epoch transition here.
-	//
-	// We skip updating these metrics when EECC has been triggered
-	if header.View > parentEpochFinalView && !epochFallbackTriggered {
+	// Approach: We retrieve the parent block's epoch status. If this block's current
+	// epoch differs from its parent's current epoch, this block begins the next epoch.
+	// Comparing views is insufficient here, as the parent's epoch might have been
+	// extended beyond its final view by EECC.
+	epochTransition := epochStatus.CurrentEpoch.SetupID != parentEpochStatus.CurrentEpoch.SetupID
+
+	// EECC can only be left by transitioning into the epoch injected by an
+	// EpochRecover event, at which point normal epoch transitions resume.
+	epochRecovered := epochTransition && parentFallbackTriggered
+
+	// When this block begins a new epoch, we update metrics related to the
+	// epoch transition here.
+	if epochTransition {
 		events = append(events, func() { m.consumer.EpochTransition(currentEpochSetup.Counter, header) })
 
 		// set current epoch counter corresponding to new epoch
@@ -609,7 +629,9 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 	}
 
 	// if EECC is triggered, update metric
-	if epochFallbackTriggered {
+	if epochRecovered {
+		events = append(events, m.metrics.EpochEmergencyFallbackRecovered)
+	} else if epochFallbackTriggered {
 		m.metrics.EpochEmergencyFallbackTriggered()
 	}
 
@@ -619,7 +641,7 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 	// * Update the largest height of sealed and finalized block.
 	//   This value could actually stay the same if it has no seals in
 	//   its payload, in which case the parent's seal is the same.
-	err = operation.RetryOnConflict(m.db.Update, func(tx *badger.Txn) error {
+	err = operation.RetryOnConflict(m.db.Update, func(tx kv.Transaction) error {
 		err = operation.IndexBlockHeight(header.Height, blockID)(tx)
 		if err != nil {
 			return fmt.Errorf("could not insert number mapping: %w", err)
@@ -632,6 +654,12 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 		if err != nil {
 			return fmt.Errorf("could not update sealed height: %w", err)
 		}
+		if epochRecovered {
+			err = operation.UnsetEpochEmergencyFallbackTriggered()(tx)
+			if err != nil {
+				return fmt.Errorf("could not unset epoch emergency fallback flag: %w", err)
+			}
+		}
 
 		// emit protocol events within the scope of the Badger transaction to
 		// guarantee at-least-once delivery
@@ -661,6 +689,21 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 	return nil
 }
 
+// isEpochFallbackTriggered returns true iff epoch emergency fallback is in effect for
+// the given block in its fork, i.e. an invalid epoch service event has been incorporated
+// in the block's current epoch, or the block is beyond the final view of its epoch.
+// No errors are expected during normal operation.
+func (m *FollowerState) isEpochFallbackTriggered(header *flow.Header, status *flow.EpochStatus) (bool, error) {
+	if status.InvalidServiceEventIncorporated {
+		return true, nil
+	}
+	setup, err := m.epoch.setups.ByID(status.CurrentEpoch.SetupID)
+	if err != nil {
+		return false, fmt.Errorf("could not retrieve setup event for current epoch: %w", err)
+	}
+	return header.View > setup.FinalView, nil
+}
+
 // epochStatus computes the EpochStatus for the given block
 // BEFORE applying the block payload itself
 // Specifically, we must determine whether block is the first block of a new
@@ -671,6 +714,10 @@ func (m *FollowerState) Finalize(ctx context.Context, blockID flow.Identifier) e
 //           the parent's EpochStatus.CurrentEpoch also applies for the current block
 // case (b): block starts new Epoch in its respective fork.
 //           the parent's EpochStatus.NextEpoch is the current block's EpochStatus.CurrentEpoch
+// case (c): block is beyond the final view of the parent's epoch, but before the first view
+//           of a recovery epoch committed by an EpochRecover service event. The current epoch
+//           has been extended by epoch emergency fallback until the recovery epoch starts,
+//           hence the parent's EpochStatus also applies for the current block.
 // As the parent was a valid extension of the chain, by induction, the parent satisfies all
 // consistency requirements of the protocol.
 //
@@ -698,6 +745,13 @@ func (m *FollowerState) epochStatus(block *flow.Header) (*flow.EpochStatus, erro
 		if parentStatus.NextEpoch.CommitID == flow.ZeroID {
 			return nil, fmt.Errorf("missing commit event for starting next epoch: %w", errIncompleteEpochConfiguration)
 		}
+		nextSetup, err := m.epoch.setups.ByID(parentStatus.NextEpoch.SetupID)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve EpochSetup event for next epoch: %w", err)
+		}
+		if block.View < nextSetup.FirstView { // recovery epoch has not started yet
+			return parentStatus.Copy(), nil
+		}
 		status, err := flow.NewEpochStatus(
 			parentStatus.CurrentEpoch.SetupID, parentStatus.CurrentEpoch.CommitID,
 			parentStatus.NextEpoch.SetupID, parentStatus.NextEpoch.CommitID,
@@ -751,6 +805,9 @@ func (m *FollowerState) handleServiceEvents(block *flow.Block) ([]func(*transact
 	// As we don't have slashing yet, there is nothing in the payload which could
 	// modify the protocol state for the current epoch.
 
+	// extendedEpoch is true iff the block is beyond the final view of its epoch,
+	// because the current epoch has been extended by epoch emergency fallback
+	extendedEpoch := false
 	epochStatus, err := m.epochStatus(block.Header)
 	if errors.Is(err, errIncompleteEpochConfiguration) {
 		// TMP: EMERGENCY EPOCH CHAIN CONTINUATION
@@ -766,13 +823,13 @@ func (m *FollowerState) handleServiceEvents(block *flow.Block) ([]func(*transact
 		if err != nil {
 			return nil, fmt.Errorf("internal error constructing EECC from parent's epoch status: %w", err)
 		}
-		ops = append(ops, m.epoch.statuses.StoreTx(blockID, parentStatus.Copy()))
+		epochStatus = parentStatus.Copy()
+		extendedEpoch = true
 		ops = append(ops, transaction.WithTx(operation.SetEpochEmergencyFallbackTriggered(blockID)))
 		ops = append(ops, func(tx *transaction.Tx) error {
 			tx.OnSucceed(m.metrics.EpochEmergencyFallbackTriggered)
 			return nil
 		})
-		return ops, nil
 	} else if err != nil {
 		return nil, fmt.Errorf("could not determine epoch status: %w", err)
 	}
@@ -803,6 +860,15 @@ SealLoop:
 
 		for _, event := range result.ServiceEvents {
 
+			// EECC - once the current epoch has been extended beyond its final view,
+			// or an invalid service event has been incorporated in this fork, the only
+			// way to resume epoch transitions is an EpochRecover event. Any other epoch
+			// service event is ignored.
+			epochFallbackTriggered := extendedEpoch || epochStatus.InvalidServiceEventIncorporated
+			if _, isRecover := event.Event.(*flow.EpochRecover); epochFallbackTriggered && !isRecover {
+				continue
+			}
+
 			switch ev := event.Event.(type) {
 			case *flow.EpochSetup:
 
@@ -810,7 +876,9 @@ SealLoop:
 				err := isValidExtendingEpochSetup(ev, activeSetup, epochStatus)
 				if protocol.IsInvalidServiceEventError(err) {
 					// EECC - we have observed an invalid service event, which is
-					// an unrecoverable failure. Flag this in the DB and exit
+					// an unrecoverable failure. Flag this in the DB and in the epoch
+					// status of this fork, and exit
+					epochStatus.InvalidServiceEventIncorporated = true
 					ops = append(ops, transaction.WithTx(operation.SetEpochEmergencyFallbackTriggered(blockID)))
 					break SealLoop
 				}
@@ -831,7 +899,9 @@ SealLoop:
 				err = isValidExtendingEpochCommit(ev, extendingSetup, activeSetup, epochStatus)
 				if protocol.IsInvalidServiceEventError(err) {
 					// EECC - we have observed an invalid service event, which is
-					// an unrecoverable failure. Flag this in the DB and exit
+					// an unrecoverable failure. Flag this in the DB and in the epoch
+					// status of this fork, and exit
+					epochStatus.InvalidServiceEventIncorporated = true
 					ops = append(ops, transaction.WithTx(operation.SetEpochEmergencyFallbackTriggered(blockID)))
 					break SealLoop
 				}
@@ -842,6 +912,33 @@ SealLoop:
 				// we'll insert the commit event when we insert the block
 				ops = append(ops, m.epoch.commits.StoreTx(ev))
 
+			case *flow.EpochRecover:
+
+				// the recovery epoch is only accepted while epoch emergency fallback is
+				// in effect in this fork, otherwise the regular epoch preparation applies
+				if !epochFallbackTriggered {
+					continue
+				}
+
+				// validate the service event; an invalid recovery has no effect
+				// on the protocol state, as EECC is in effect already
+				err = isValidEpochRecover(ev, activeSetup, epochStatus, block.Header.View)
+				if protocol.IsInvalidServiceEventError(err) {
+					continue
+				}
+				if err != nil {
+					return nil, fmt.Errorf("could not validate epoch recover event: %w", err)
+				}
+
+				// the recovery epoch replaces the next epoch, including a set up
+				// epoch which has not been committed before EECC was triggered
+				epochStatus.NextEpoch.SetupID = ev.EpochSetup.ID()
+				epochStatus.NextEpoch.CommitID = ev.EpochCommit.ID()
+
+				// we'll insert the setup and commit events when we insert the block
+				ops = append(ops, m.epoch.setups.StoreTx(&ev.EpochSetup))
+				ops = append(ops, m.epoch.commits.StoreTx(&ev.EpochCommit))
+
 			default:
 				return nil, fmt.Errorf("invalid service event type: %s", event.Type)
 			}
@@ -899,7 +996,7 @@ func (m *FollowerState) MarkValid(blockID flow.Identifier) error {
 		return fmt.Errorf("could not retrieve root block's height: %w", err)
 	}
 
-	err = operation.RetryOnConflict(m.db.Update, func(tx *badger.Txn) error {
+	err = operation.RetryOnConflict(m.db.Update, func(tx kv.Transaction) error {
 		// insert block validity for this block
 		err = operation.SkipDuplicates(operation.InsertBlockValidity(blockID, true))(tx)
 		if err != nil {
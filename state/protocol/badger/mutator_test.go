@@ -10,7 +10,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -33,6 +32,7 @@ import (
 	stoerr "github.com/onflow/flow-go/storage"
 	storage "github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 	storeutil "github.com/onflow/flow-go/storage/util"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -45,7 +45,7 @@ var participants = unittest.IdentityListFixture(5, unittest.WithAllRoles())
 
 func TestBootstrapValid(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithBootstrapState(t, rootSnapshot, func(db *badger.DB, state *protocol.State) {
+	util.RunWithBootstrapState(t, rootSnapshot, func(db kv.DB, state *protocol.State) {
 		var finalized uint64
 		err := db.View(operation.RetrieveFinalizedHeight(&finalized))
 		require.NoError(t, err)
@@ -82,7 +82,7 @@ func TestBootstrapValid(t *testing.T) {
 }
 
 func TestExtendValid(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		tracer := trace.NewNoopTracer()
 		headers, _, seals, index, payloads, blocks, setups, commits, statuses, results := storeutil.StorageLayer(t, db)
@@ -124,7 +124,7 @@ func TestExtendValid(t *testing.T) {
 
 func TestExtendSealedBoundary(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 		_, seal, err := rootSnapshot.SealedResult()
@@ -187,7 +187,7 @@ func TestExtendSealedBoundary(t *testing.T) {
 
 func TestExtendMissingParent(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		extend := unittest.BlockFixture()
 		extend.Payload.Guarantees = nil
 		extend.Payload.Seals = nil
@@ -210,7 +210,7 @@ func TestExtendMissingParent(t *testing.T) {
 
 func TestExtendHeightTooSmall(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
@@ -241,7 +241,7 @@ func TestExtendHeightTooSmall(t *testing.T) {
 
 func TestExtendHeightTooLarge(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
@@ -258,7 +258,7 @@ func TestExtendHeightTooLarge(t *testing.T) {
 
 func TestExtendBlockNotConnected(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
@@ -290,7 +290,7 @@ func TestExtendBlockNotConnected(t *testing.T) {
 
 func TestExtendInvalidChainID(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
@@ -315,7 +315,7 @@ func TestExtendReceiptsNotSorted(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 	head, err := rootSnapshot.Head()
 	require.NoError(t, err)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		// create block2 and block3
 		block2 := unittest.BlockWithParentFixture(head)
 		block2.Payload.Guarantees = nil
@@ -349,7 +349,7 @@ func TestExtendReceiptsInvalid(t *testing.T) {
 	validator := &mockmodule.ReceiptValidator{}
 
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolStateAndValidator(t, rootSnapshot, validator, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolStateAndValidator(t, rootSnapshot, validator, func(db kv.DB, state *protocol.MutableState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
@@ -382,7 +382,7 @@ func TestExtendReceiptsInvalid(t *testing.T) {
 
 func TestExtendReceiptsValid(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 		block2 := unittest.BlockWithParentFixture(head)
@@ -450,7 +450,7 @@ func TestExtendEpochTransitionValid(t *testing.T) {
 	consumer.On("BlockFinalized", mock.Anything)
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 
 		// set up state and mock ComplianceMetrics object
 		metrics := new(mockmodule.ComplianceMetrics)
@@ -732,7 +732,7 @@ func TestExtendEpochTransitionValid(t *testing.T) {
 //
 func TestExtendConflictingEpochEvents(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
@@ -845,7 +845,7 @@ func TestExtendConflictingEpochEvents(t *testing.T) {
 //
 func TestExtendDuplicateEpochEvents(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
@@ -941,7 +941,7 @@ func TestExtendDuplicateEpochEvents(t *testing.T) {
 // extending protocol state with an invalid epoch setup service event should cause an error
 func TestExtendEpochSetupInvalid(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 		result, _, err := rootSnapshot.SealedResult()
@@ -1024,7 +1024,7 @@ func TestExtendEpochSetupInvalid(t *testing.T) {
 // extending protocol state with an invalid epoch commit service event should cause an error
 func TestExtendEpochCommitInvalid(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
@@ -1148,7 +1148,7 @@ func TestExtendEpochTransitionWithoutCommit(t *testing.T) {
 	unittest.SkipUnless(t, unittest.TEST_TODO, "disabled as the current implementation uses a temporary fallback measure in this case (triggers EECC), rather than returning an error")
 
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 		result, _, err := rootSnapshot.SealedResult()
@@ -1217,7 +1217,7 @@ func TestEmergencyEpochChainContinuation(t *testing.T) {
 		metricsMock := new(mockmodule.ComplianceMetrics)
 		mockMetricsForRootSnapshot(metricsMock, rootSnapshot)
 
-		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db *badger.DB, state *protocol.MutableState) {
+		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db kv.DB, state *protocol.MutableState) {
 			head, err := rootSnapshot.Head()
 			require.NoError(t, err)
 			result, _, err := rootSnapshot.SealedResult()
@@ -1248,6 +1248,7 @@ func TestEmergencyEpochChainContinuation(t *testing.T) {
 
 			receipt1, seal1 := unittest.ReceiptAndSealForBlock(block1)
 			receipt1.ExecutionResult.ServiceEvents = []flow.ServiceEvent{epoch2Setup.ServiceEvent()}
+			seal1.ResultID = receipt1.ExecutionResult.ID()
 
 			// add a block containing a receipt for block 1
 			block2 := unittest.BlockWithParentFixture(block1.Header)
@@ -1294,7 +1295,7 @@ func TestEmergencyEpochChainContinuation(t *testing.T) {
 		metricsMock := new(mockmodule.ComplianceMetrics)
 		mockMetricsForRootSnapshot(metricsMock, rootSnapshot)
 
-		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db *badger.DB, state *protocol.MutableState) {
+		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db kv.DB, state *protocol.MutableState) {
 			head, err := rootSnapshot.Head()
 			require.NoError(t, err)
 			result, _, err := rootSnapshot.SealedResult()
@@ -1357,7 +1358,7 @@ func TestEmergencyEpochChainContinuation(t *testing.T) {
 		metricsMock := new(mockmodule.ComplianceMetrics)
 		mockMetricsForRootSnapshot(metricsMock, rootSnapshot)
 
-		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db *badger.DB, state *protocol.MutableState) {
+		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db kv.DB, state *protocol.MutableState) {
 			head, err := rootSnapshot.Head()
 			require.NoError(t, err)
 			result, _, err := rootSnapshot.SealedResult()
@@ -1389,6 +1390,7 @@ func TestEmergencyEpochChainContinuation(t *testing.T) {
 
 			receipt1, seal1 := unittest.ReceiptAndSealForBlock(block1)
 			receipt1.ExecutionResult.ServiceEvents = []flow.ServiceEvent{epoch2Setup.ServiceEvent()}
+			seal1.ResultID = receipt1.ExecutionResult.ID()
 
 			// incorporating the service event should trigger EECC
 			metricsMock.On("EpochEmergencyFallbackTriggered").Once()
@@ -1426,8 +1428,338 @@ func TestEmergencyEpochChainContinuation(t *testing.T) {
 	})
 }
 
+// TestEpochRecover tests recovering from epoch emergency chain continuation (EECC)
+// through an EpochRecover service event, which injects the next epoch without a spork.
+func TestEpochRecover(t *testing.T) {
+
+	// triggerEECC builds the following chain, where the setup event for the second
+	// epoch is emitted in B1, but the commit event is never emitted. B4 is the first
+	// block beyond the final view of the first epoch, hence it triggers EECC.
+	//
+	// ROOT <- B1 <- B2(R1) <- B3(S1) <- B4
+	triggerEECC := func(t *testing.T, rootSnapshot *inmem.Snapshot, db kv.DB, state *protocol.MutableState) (*flow.EpochSetup, *flow.Block) {
+		head, err := rootSnapshot.Head()
+		require.NoError(t, err)
+		result, _, err := rootSnapshot.SealedResult()
+		require.NoError(t, err)
+
+		block1 := unittest.BlockWithParentFixture(head)
+		block1.SetPayload(flow.EmptyPayload())
+		err = state.Extend(context.Background(), block1)
+		require.NoError(t, err)
+
+		epoch1Setup := result.ServiceEvents[0].Event.(*flow.EpochSetup)
+		epoch2Setup := unittest.EpochSetupFixture(
+			unittest.WithParticipants(participants),
+			unittest.SetupWithCounter(epoch1Setup.Counter+1),
+			unittest.WithFinalView(epoch1Setup.FinalView+1000),
+			unittest.WithFirstView(epoch1Setup.FinalView+1),
+		)
+
+		receipt1, seal1 := unittest.ReceiptAndSealForBlock(block1)
+		receipt1.ExecutionResult.ServiceEvents = []flow.ServiceEvent{epoch2Setup.ServiceEvent()}
+		seal1.ResultID = receipt1.ExecutionResult.ID()
+
+		block2 := unittest.BlockWithParentFixture(block1.Header)
+		block2.SetPayload(unittest.PayloadFixture(unittest.WithReceipts(receipt1)))
+		err = state.Extend(context.Background(), block2)
+		require.NoError(t, err)
+
+		block3 := unittest.BlockWithParentFixture(block2.Header)
+		block3.SetPayload(flow.Payload{
+			Seals: []*flow.Seal{seal1},
+		})
+		err = state.Extend(context.Background(), block3)
+		require.NoError(t, err)
+
+		block4 := unittest.BlockWithParentFixture(block3.Header)
+		block4.SetPayload(flow.EmptyPayload())
+		block4.Header.View = epoch1Setup.FinalView + 1
+		err = state.Extend(context.Background(), block4)
+		require.NoError(t, err)
+
+		for _, block := range []*flow.Block{block1, block2, block3, block4} {
+			err = state.Finalize(context.Background(), block.ID())
+			require.NoError(t, err)
+		}
+		assertEpochEmergencyFallbackTriggered(t, db)
+
+		return epoch1Setup, block4
+	}
+
+	// sealServiceEvents seals the given service events, emitted in the execution
+	// of the parent block, and returns the first block for which the events are
+	// applied to the protocol state.
+	//
+	// parent <- B5(R) <- B6(S) <- B7
+	sealServiceEvents := func(t *testing.T, state *protocol.MutableState, parent *flow.Block, serviceEvents ...flow.ServiceEvent) *flow.Block {
+		receipt, seal := unittest.ReceiptAndSealForBlock(parent)
+		receipt.ExecutionResult.ServiceEvents = serviceEvents
+		seal.ResultID = receipt.ExecutionResult.ID()
+
+		block5 := unittest.BlockWithParentFixture(parent.Header)
+		block5.SetPayload(unittest.PayloadFixture(unittest.WithReceipts(receipt)))
+		err := state.Extend(context.Background(), block5)
+		require.NoError(t, err)
+
+		block6 := unittest.BlockWithParentFixture(block5.Header)
+		block6.SetPayload(flow.Payload{
+			Seals: []*flow.Seal{seal},
+		})
+		err = state.Extend(context.Background(), block6)
+		require.NoError(t, err)
+
+		block7 := unittest.BlockWithParentFixture(block6.Header)
+		block7.SetPayload(flow.EmptyPayload())
+		err = state.Extend(context.Background(), block7)
+		require.NoError(t, err)
+
+		for _, block := range []*flow.Block{block5, block6, block7} {
+			err = state.Finalize(context.Background(), block.ID())
+			require.NoError(t, err)
+		}
+		return block7
+	}
+
+	// a valid recover event should commit the recovery epoch, which begins
+	// at its first view, and EECC should be left once the recovery epoch begins.
+	//
+	// ... <- B4 <- B5(R4) <- B6(S4) <- B7 <- B8
+	t.Run("valid recover event - should transition into recovery epoch", func(t *testing.T) {
+
+		rootSnapshot := unittest.RootSnapshotFixture(participants)
+		metricsMock := new(mockmodule.ComplianceMetrics)
+		mockMetricsForRootSnapshot(metricsMock, rootSnapshot)
+		metricsMock.On("EpochEmergencyFallbackTriggered")
+
+		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db kv.DB, state *protocol.MutableState) {
+			epoch1Setup, block4 := triggerEECC(t, rootSnapshot, db, state)
+
+			epochRecover := unittest.EpochRecoverFixture(
+				unittest.WithParticipants(participants),
+				unittest.SetupWithCounter(epoch1Setup.Counter+1),
+				unittest.WithFirstView(epoch1Setup.FinalView+100),
+				unittest.WithFinalView(epoch1Setup.FinalView+1000),
+			)
+			recoverySetup := epochRecover.EpochSetup
+
+			// committing the recovery epoch should update the epoch phase
+			metricsMock.On("CurrentEpochPhase", flow.EpochPhaseCommitted).Once()
+			metricsMock.On("CommittedEpochFinalView", recoverySetup.FinalView).Once()
+
+			block7 := sealServiceEvents(t, state, block4, epochRecover.ServiceEvent())
+
+			// the recovery epoch should be committed, while the current epoch continues
+			phase, err := state.AtBlockID(block7.ID()).Phase()
+			require.NoError(t, err)
+			assert.Equal(t, flow.EpochPhaseCommitted, phase)
+			counter, err := state.AtBlockID(block7.ID()).Epochs().Current().Counter()
+			require.NoError(t, err)
+			assert.Equal(t, epoch1Setup.Counter, counter)
+			counter, err = state.AtBlockID(block7.ID()).Epochs().Next().Counter()
+			require.NoError(t, err)
+			assert.Equal(t, recoverySetup.Counter, counter)
+			assertEpochEmergencyFallbackTriggered(t, db)
+
+			// block 8 will be the first block of the recovery epoch
+			block8 := unittest.BlockWithParentFixture(block7.Header)
+			block8.SetPayload(flow.EmptyPayload())
+			block8.Header.View = recoverySetup.FirstView
+			err = state.Extend(context.Background(), block8)
+			require.NoError(t, err)
+
+			counter, err = state.AtBlockID(block8.ID()).Epochs().Current().Counter()
+			require.NoError(t, err)
+			assert.Equal(t, recoverySetup.Counter, counter)
+
+			// finalizing block 8 should emit the epoch transition metrics and leave EECC
+			metricsMock.On("EpochEmergencyFallbackRecovered").Once()
+			metricsMock.On("CurrentEpochCounter", recoverySetup.Counter).Once()
+			metricsMock.On("CurrentEpochFinalView", recoverySetup.FinalView).Once()
+			metricsMock.On("CurrentDKGPhase1FinalView", recoverySetup.DKGPhase1FinalView)
+			metricsMock.On("CurrentDKGPhase2FinalView", recoverySetup.DKGPhase2FinalView)
+			metricsMock.On("CurrentDKGPhase3FinalView", recoverySetup.DKGPhase3FinalView)
+
+			err = state.Finalize(context.Background(), block8.ID())
+			require.NoError(t, err)
+
+			var triggered bool
+			err = db.View(operation.CheckEpochEmergencyFallbackTriggered(&triggered))
+			require.NoError(t, err)
+			assert.False(t, triggered)
+
+			metricsMock.AssertCalled(t, "CurrentEpochPhase", flow.EpochPhaseStaking)
+			metricsMock.AssertExpectations(t)
+		})
+	})
+
+	// an invalid recover event should be ignored, and the chain should continue
+	// with the fallback epoch.
+	t.Run("invalid recover event - should continue with fallback epoch", func(t *testing.T) {
+
+		rootSnapshot := unittest.RootSnapshotFixture(participants)
+		metricsMock := new(mockmodule.ComplianceMetrics)
+		mockMetricsForRootSnapshot(metricsMock, rootSnapshot)
+		metricsMock.On("EpochEmergencyFallbackTriggered")
+
+		util.RunWithFullProtocolStateAndMetrics(t, rootSnapshot, metricsMock, func(db kv.DB, state *protocol.MutableState) {
+			epoch1Setup, block4 := triggerEECC(t, rootSnapshot, db, state)
+
+			// the recovery epoch is invalid because it uses a non-consecutive counter
+			epochRecover := unittest.EpochRecoverFixture(
+				unittest.WithParticipants(participants),
+				unittest.SetupWithCounter(epoch1Setup.Counter+2),
+				unittest.WithFirstView(epoch1Setup.FinalView+100),
+				unittest.WithFinalView(epoch1Setup.FinalView+1000),
+			)
+
+			block7 := sealServiceEvents(t, state, block4, epochRecover.ServiceEvent())
+
+			// the next epoch should not be committed
+			phase, err := state.AtBlockID(block7.ID()).Phase()
+			require.NoError(t, err)
+			assert.NotEqual(t, flow.EpochPhaseCommitted, phase)
+
+			// blocks beyond the first view of the invalid recovery epoch remain in the fallback epoch
+			block8 := unittest.BlockWithParentFixture(block7.Header)
+			block8.SetPayload(flow.EmptyPayload())
+			block8.Header.View = epochRecover.EpochSetup.FirstView
+			err = state.Extend(context.Background(), block8)
+			require.NoError(t, err)
+			err = state.Finalize(context.Background(), block8.ID())
+			require.NoError(t, err)
+
+			counter, err := state.AtBlockID(block8.ID()).Epochs().Current().Counter()
+			require.NoError(t, err)
+			assert.Equal(t, epoch1Setup.Counter, counter)
+			assertEpochEmergencyFallbackTriggered(t, db)
+
+			metricsMock.AssertNotCalled(t, "EpochEmergencyFallbackRecovered")
+			metricsMock.AssertNotCalled(t, "CurrentEpochPhase", flow.EpochPhaseCommitted)
+			metricsMock.AssertExpectations(t)
+		})
+	})
+
+	// a recover event emitted while EECC is not in effect should be ignored
+	//
+	// ROOT <- B1 <- B2(R1) <- B3(S1) <- B4
+	t.Run("recover event without epoch fallback - should be ignored", func(t *testing.T) {
+
+		rootSnapshot := unittest.RootSnapshotFixture(participants)
+		util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
+			head, err := rootSnapshot.Head()
+			require.NoError(t, err)
+			result, _, err := rootSnapshot.SealedResult()
+			require.NoError(t, err)
+			epoch1Setup := result.ServiceEvents[0].Event.(*flow.EpochSetup)
+
+			block1 := unittest.BlockWithParentFixture(head)
+			block1.SetPayload(flow.EmptyPayload())
+			err = state.Extend(context.Background(), block1)
+			require.NoError(t, err)
+			err = state.Finalize(context.Background(), block1.ID())
+			require.NoError(t, err)
+
+			epochRecover := unittest.EpochRecoverFixture(
+				unittest.WithParticipants(participants),
+				unittest.SetupWithCounter(epoch1Setup.Counter+1),
+				unittest.WithFirstView(epoch1Setup.FinalView+1),
+				unittest.WithFinalView(epoch1Setup.FinalView+1000),
+			)
+
+			block4 := sealServiceEvents(t, state, block1, epochRecover.ServiceEvent())
+
+			// the next epoch should not have been set up
+			_, err = state.AtBlockID(block4.ID()).Epochs().Next().Counter()
+			assert.ErrorIs(t, err, realprotocol.ErrNextEpochNotSetup)
+			phase, err := state.AtBlockID(block4.ID()).Phase()
+			require.NoError(t, err)
+			assert.Equal(t, flow.EpochPhaseStaking, phase)
+		})
+	})
+
+	// epoch emergency fallback is fork-local: an invalid service event incorporated in
+	// one fork must not allow a recover event to be applied in a conflicting fork
+	//
+	//          /-- B2(R1) <- B3(S1) <- B4       (invalid setup event)
+	// ROOT <- B1
+	//          \-- B5(R1') <- B6(S1') <- B7    (recover event)
+	t.Run("recover event in fork without epoch fallback - should be ignored", func(t *testing.T) {
+
+		rootSnapshot := unittest.RootSnapshotFixture(participants)
+		util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.MutableState) {
+			head, err := rootSnapshot.Head()
+			require.NoError(t, err)
+			result, _, err := rootSnapshot.SealedResult()
+			require.NoError(t, err)
+			epoch1Setup := result.ServiceEvents[0].Event.(*flow.EpochSetup)
+
+			block1 := unittest.BlockWithParentFixture(head)
+			block1.SetPayload(flow.EmptyPayload())
+			err = state.Extend(context.Background(), block1)
+			require.NoError(t, err)
+			err = state.Finalize(context.Background(), block1.ID())
+			require.NoError(t, err)
+
+			// extendFork seals the given service events, emitted in the execution of
+			// block 1, in a new fork and returns the first block they are applied to
+			extendFork := func(serviceEvents ...flow.ServiceEvent) *flow.Block {
+				receipt, seal := unittest.ReceiptAndSealForBlock(block1)
+				receipt.ExecutionResult.ServiceEvents = serviceEvents
+				seal.ResultID = receipt.ExecutionResult.ID()
+
+				withReceipt := unittest.BlockWithParentFixture(block1.Header)
+				withReceipt.SetPayload(unittest.PayloadFixture(unittest.WithReceipts(receipt)))
+				err := state.Extend(context.Background(), withReceipt)
+				require.NoError(t, err)
+
+				withSeal := unittest.BlockWithParentFixture(withReceipt.Header)
+				withSeal.SetPayload(flow.Payload{
+					Seals: []*flow.Seal{seal},
+				})
+				err = state.Extend(context.Background(), withSeal)
+				require.NoError(t, err)
+
+				child := unittest.BlockWithParentFixture(withSeal.Header)
+				child.SetPayload(flow.EmptyPayload())
+				err = state.Extend(context.Background(), child)
+				require.NoError(t, err)
+				return child
+			}
+
+			// the first fork incorporates an invalid setup event with a non-contiguous first view
+			invalidSetup := unittest.EpochSetupFixture(
+				unittest.WithParticipants(participants),
+				unittest.SetupWithCounter(epoch1Setup.Counter+1),
+				unittest.WithFirstView(epoch1Setup.FinalView+10),
+				unittest.WithFinalView(epoch1Setup.FinalView+1000),
+			)
+			block4 := extendFork(invalidSetup.ServiceEvent())
+			assertEpochEmergencyFallbackTriggered(t, db)
+
+			// the second fork incorporates a valid recover event
+			epochRecover := unittest.EpochRecoverFixture(
+				unittest.WithParticipants(participants),
+				unittest.SetupWithCounter(epoch1Setup.Counter+1),
+				unittest.WithFirstView(epoch1Setup.FinalView+1),
+				unittest.WithFinalView(epoch1Setup.FinalView+1000),
+			)
+			block7 := extendFork(epochRecover.ServiceEvent())
+
+			// neither fork should have set up the next epoch
+			for _, block := range []*flow.Block{block4, block7} {
+				_, err = state.AtBlockID(block.ID()).Epochs().Next().Counter()
+				assert.ErrorIs(t, err, realprotocol.ErrNextEpochNotSetup)
+				phase, err := state.AtBlockID(block.ID()).Phase()
+				require.NoError(t, err)
+				assert.Equal(t, flow.EpochPhaseStaking, phase)
+			}
+		})
+	})
+}
+
 func TestExtendInvalidSealsInBlock(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		tracer := trace.NewNoopTracer()
 		headers, _, seals, index, payloads, blocks, setups, commits, statuses, results := storeutil.StorageLayer(t, db)
@@ -1494,7 +1826,7 @@ func TestExtendInvalidSealsInBlock(t *testing.T) {
 
 func TestHeaderExtendValid(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
+	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.FollowerState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 		_, seal, err := rootSnapshot.SealedResult()
@@ -1514,7 +1846,7 @@ func TestHeaderExtendValid(t *testing.T) {
 
 func TestHeaderExtendMissingParent(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
+	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.FollowerState) {
 		extend := unittest.BlockFixture()
 		extend.Payload.Guarantees = nil
 		extend.Payload.Seals = nil
@@ -1537,7 +1869,7 @@ func TestHeaderExtendMissingParent(t *testing.T) {
 
 func TestHeaderExtendHeightTooSmall(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
+	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.FollowerState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
@@ -1565,7 +1897,7 @@ func TestHeaderExtendHeightTooSmall(t *testing.T) {
 
 func TestHeaderExtendHeightTooLarge(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
+	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.FollowerState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
@@ -1581,7 +1913,7 @@ func TestHeaderExtendHeightTooLarge(t *testing.T) {
 
 func TestHeaderExtendBlockNotConnected(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
+	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.FollowerState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
@@ -1614,7 +1946,7 @@ func TestHeaderExtendHighestSeal(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 	head, err := rootSnapshot.Head()
 	require.NoError(t, err)
-	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
+	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.FollowerState) {
 		// create block2 and block3
 		block2 := unittest.BlockWithParentFixture(head)
 		block2.SetPayload(flow.EmptyPayload())
@@ -1657,7 +1989,7 @@ func TestMakeValid(t *testing.T) {
 		rootSnapshot := unittest.RootSnapshotFixture(participants)
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
-		util.RunWithFullProtocolStateAndConsumer(t, rootSnapshot, consumer, func(db *badger.DB, state *protocol.MutableState) {
+		util.RunWithFullProtocolStateAndConsumer(t, rootSnapshot, consumer, func(db kv.DB, state *protocol.MutableState) {
 			// create block2 and block3
 			block2 := unittest.BlockWithParentFixture(head)
 			block2.SetPayload(flow.EmptyPayload())
@@ -1692,7 +2024,7 @@ func TestMakeValid(t *testing.T) {
 // If block B is finalized and contains a seal for block A, then A is the last sealed block
 func TestSealed(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
-	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
+	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *protocol.FollowerState) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
@@ -1736,7 +2068,7 @@ func TestSealed(t *testing.T) {
 func TestCacheAtomicity(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 	util.RunWithFollowerProtocolStateAndHeaders(t, rootSnapshot,
-		func(db *badger.DB, state *protocol.FollowerState, headers storage.Headers, index storage.Index) {
+		func(db kv.DB, state *protocol.FollowerState, headers storage.Headers, index storage.Index) {
 			head, err := rootSnapshot.Head()
 			require.NoError(t, err)
 
@@ -1770,7 +2102,7 @@ func TestCacheAtomicity(t *testing.T) {
 
 // TestHeaderInvalidTimestamp tests that extending header with invalid timestamp results in sentinel error
 func TestHeaderInvalidTimestamp(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithBadgerKV(t, func(db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		tracer := trace.NewNoopTracer()
 		headers, _, seals, index, payloads, blocks, setups, commits, statuses, results := storeutil.StorageLayer(t, db)
@@ -1805,7 +2137,7 @@ func TestHeaderInvalidTimestamp(t *testing.T) {
 	})
 }
 
-func assertEpochEmergencyFallbackTriggered(t *testing.T, db *badger.DB) {
+func assertEpochEmergencyFallbackTriggered(t *testing.T, db kv.DB) {
 	var triggered bool
 	err := db.View(operation.CheckEpochEmergencyFallbackTriggered(&triggered))
 	require.NoError(t, err)
@@ -1,9 +1,11 @@
 package badger
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 )
 
@@ -44,6 +46,22 @@ func (p *Params) ProtocolVersion() (uint, error) {
 	return version, nil
 }
 
+func (p *Params) SealingParameters() (flow.SealingParameters, error) {
+
+	var params flow.SealingParameters
+	err := p.state.db.View(operation.RetrieveSealingParameters(&params))
+	if errors.Is(err, storage.ErrNotFound) {
+		// the state was bootstrapped before sealing parameters were part of the protocol state,
+		// the protocol defaults apply so that all nodes agree on the sealing parameters
+		return flow.DefaultSealingParameters(), nil
+	}
+	if err != nil {
+		return flow.SealingParameters{}, fmt.Errorf("could not get sealing parameters: %w", err)
+	}
+
+	return params, nil
+}
+
 func (p *Params) Root() (*flow.Header, error) {
 
 	// retrieve the root height
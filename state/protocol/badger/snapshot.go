@@ -457,6 +457,10 @@ func (s *Snapshot) Params() protocol.GlobalParams {
 	return s.state.Params()
 }
 
+func (s *Snapshot) SealingParameters() (flow.SealingParameters, error) {
+	return protocol.ResolveSealingParameters(s.Epochs().Current(), s.Params())
+}
+
 // EpochQuery encapsulates querying epochs w.r.t. a snapshot.
 type EpochQuery struct {
 	snap *Snapshot
@@ -9,7 +9,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,6 +19,7 @@ import (
 	"github.com/onflow/flow-go/state/protocol/inmem"
 	"github.com/onflow/flow-go/state/protocol/seed"
 	"github.com/onflow/flow-go/state/protocol/util"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -32,7 +32,7 @@ func TestHead(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 	head, err := rootSnapshot.Head()
 	require.NoError(t, err)
-	util.RunWithBootstrapState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.State) {
+	util.RunWithBootstrapState(t, rootSnapshot, func(db kv.DB, state *bprotocol.State) {
 
 		t.Run("works with block number", func(t *testing.T) {
 			retrieved, err := state.AtHeight(head.Height).Head()
@@ -70,7 +70,7 @@ func TestSnapshot_Params(t *testing.T) {
 	rootHeader, err := rootSnapshot.Head()
 	require.NoError(t, err)
 
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		// build some non-root blocks
 		head := rootHeader
 		const nBlocks = 10
@@ -118,7 +118,7 @@ func TestSnapshot_Descendants(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 	head, err := rootSnapshot.Head()
 	require.NoError(t, err)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		var expectedBlocks []flow.Identifier
 		for i := 5; i > 3; i-- {
 			for _, block := range unittest.ChainFixtureFrom(i, head) {
@@ -143,7 +143,7 @@ func TestSnapshot_ValidDescendants(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 	head, err := rootSnapshot.Head()
 	require.NoError(t, err)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		var expectedBlocks []flow.Identifier
 		for i := 5; i > 3; i-- {
 			fork := unittest.ChainFixtureFrom(i, head)
@@ -168,7 +168,7 @@ func TestSnapshot_ValidDescendants(t *testing.T) {
 func TestIdentities(t *testing.T) {
 	identities := unittest.IdentityListFixture(5, unittest.WithAllRoles())
 	rootSnapshot := unittest.RootSnapshotFixture(identities)
-	util.RunWithBootstrapState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.State) {
+	util.RunWithBootstrapState(t, rootSnapshot, func(db kv.DB, state *bprotocol.State) {
 
 		t.Run("no filter", func(t *testing.T) {
 			actual, err := state.Final().Identities(filter.Any)
@@ -219,7 +219,7 @@ func TestClusters(t *testing.T) {
 	rootSnapshot, err := inmem.SnapshotFromBootstrapState(root, result, seal, qc)
 	require.NoError(t, err)
 
-	util.RunWithBootstrapState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.State) {
+	util.RunWithBootstrapState(t, rootSnapshot, func(db kv.DB, state *bprotocol.State) {
 		expectedClusters, err := flow.NewClusterList(setup.Assignments, collectors)
 		require.NoError(t, err)
 		actualClusters, err := state.Final().Epochs().Current().Clustering()
@@ -248,7 +248,7 @@ func TestSealingSegment(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("root sealing segment", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			expected, err := rootSnapshot.SealingSegment()
 			require.NoError(t, err)
 			actual, err := state.AtBlockID(head.ID()).SealingSegment()
@@ -267,7 +267,7 @@ func TestSealingSegment(t *testing.T) {
 	// ROOT <- B1 <- B2(S1)
 	// Expected sealing segment: [B1, B2]
 	t.Run("non-root", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			// build a block to seal
 			block1 := unittest.BlockWithParentFixture(head)
 			buildBlock(t, state, block1)
@@ -297,7 +297,7 @@ func TestSealingSegment(t *testing.T) {
 	// ROOT <- B1 <- .... <- BN(S1)
 	// Expected sealing segment: [B1, ..., BN]
 	t.Run("long sealing segment", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 
 			// build a block to seal
 			block1 := unittest.BlockWithParentFixture(head)
@@ -338,7 +338,7 @@ func TestSealingSegment(t *testing.T) {
 	// ROOT <- B1 <- B2(R1) <- B3 <- B4(R2, S1) <- B5 <- B6(S2)
 	// Expected sealing segment: [B2, B3, B4]
 	t.Run("overlapping sealing segment", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 
 			block1 := unittest.BlockWithParentFixture(head)
 			buildBlock(t, state, block1)
@@ -384,7 +384,7 @@ func TestSealingSegment(t *testing.T) {
 	// ROOT -> B1(Result_A, Receipt_A_1) -> B2(Result_B, Receipt_B, Receipt_A_2) -> B3(Receipt_C, Result_C) -> B4 -> B5(Seal_C)
 	// the segment for B5 should be `[B2,B3,B4,B5] + [Result_A]`
 	t.Run("sealing segment with 4 blocks and 1 execution result decoupled", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			// simulate scenario where execution result is missing from block payload
 			// SealingSegment() should get result from results db and store it on ExecutionReceipts
 			// field on SealingSegment
@@ -436,7 +436,7 @@ func TestSealingSegment(t *testing.T) {
 	// block3 also references ResultB, so it should exist in the segment execution results as well.
 	// root -> B1[Result_A, Receipt_A_1] -> B2[Result_B, Receipt_B, Receipt_A_2] -> B3[Receipt_B_2, Receipt_for_seal, Receipt_A_3] -> B4 -> B5 (Seal_B2)
 	t.Run("sealing segment with 4 blocks and 2 execution result decoupled", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			// simulate scenario where execution result is missing from block payload
 			// SealingSegment() should get result from results db and store it on ExecutionReceipts
 			// field on SealingSegment
@@ -495,7 +495,7 @@ func TestSealingSegment(t *testing.T) {
 	// ROOT <- B1 <- B2(R1) <- B3 <- B4(S1) <- B5
 	// Expected sealing segment: [B1, B2, B3, B4, B5]
 	t.Run("sealing segment where highest block in segment does not seal lowest", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			// build a block to seal
 			block1 := unittest.BlockWithParentFixture(head)
 			buildBlock(t, state, block1)
@@ -538,7 +538,7 @@ func TestLatestSealedResult(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(identities)
 
 	t.Run("root snapshot", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			gotResult, gotSeal, err := state.Final().SealedResult()
 			require.NoError(t, err)
 			expectedResult, expectedSeal, err := rootSnapshot.SealedResult()
@@ -553,7 +553,7 @@ func TestLatestSealedResult(t *testing.T) {
 		head, err := rootSnapshot.Head()
 		require.NoError(t, err)
 
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			block1 := unittest.BlockWithParentFixture(head)
 			err = state.Extend(context.Background(), block1)
 			require.NoError(t, err)
@@ -617,7 +617,7 @@ func TestQuorumCertificate(t *testing.T) {
 
 	// should not be able to get QC or random beacon seed from a block with no children
 	t.Run("no children", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 
 			// create a block to query
 			block1 := unittest.BlockWithParentFixture(head)
@@ -636,7 +636,7 @@ func TestQuorumCertificate(t *testing.T) {
 	// should not be able to get random beacon seed from a block with only invalid
 	// or unvalidated children
 	t.Run("un-validated child", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 
 			// create a block to query
 			block1 := unittest.BlockWithParentFixture(head)
@@ -660,7 +660,7 @@ func TestQuorumCertificate(t *testing.T) {
 
 	// should be able to get QC and random beacon seed from root block
 	t.Run("root block", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 			// since we bootstrap with a root snapshot, this will be the root block
 			_, err := state.AtBlockID(head.ID()).QuorumCertificate()
 			assert.NoError(t, err)
@@ -672,7 +672,7 @@ func TestQuorumCertificate(t *testing.T) {
 
 	// should be able to get QC and random beacon seed from a block with a valid child
 	t.Run("valid child", func(t *testing.T) {
-		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+		util.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 
 			// add a block so we aren't testing against root
 			block1 := unittest.BlockWithParentFixture(head)
@@ -712,7 +712,7 @@ func TestSnapshot_EpochQuery(t *testing.T) {
 	result, _, err := rootSnapshot.SealedResult()
 	require.NoError(t, err)
 
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 		epoch1Counter := result.ServiceEvents[0].Event.(*flow.EpochSetup).Counter
 		epoch2Counter := epoch1Counter + 1
 
@@ -803,7 +803,7 @@ func TestSnapshot_EpochFirstView(t *testing.T) {
 	result, _, err := rootSnapshot.SealedResult()
 	require.NoError(t, err)
 
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 
 		epochBuilder := unittest.NewEpochBuilder(t, state)
 		// build epoch 1 (prepare epoch 2)
@@ -894,7 +894,7 @@ func TestSnapshot_CrossEpochIdentities(t *testing.T) {
 	epoch3Identities := unittest.IdentityListFixture(10, unittest.WithAllRoles())
 
 	rootSnapshot := unittest.RootSnapshotFixture(epoch1Identities)
-	util.RunWithFullProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.MutableState) {
+	util.RunWithFullProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.MutableState) {
 
 		epochBuilder := unittest.NewEpochBuilder(t, state)
 		// build epoch 1 (prepare epoch 2)
@@ -1017,7 +1017,7 @@ func TestSnapshot_PostSporkIdentities(t *testing.T) {
 	rootSnapshot, err := inmem.SnapshotFromBootstrapState(root, result, seal, qc)
 	require.NoError(t, err)
 
-	util.RunWithBootstrapState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.State) {
+	util.RunWithBootstrapState(t, rootSnapshot, func(db kv.DB, state *bprotocol.State) {
 		actual, err := state.Final().Identities(filter.Any)
 		require.Nil(t, err)
 		assert.ElementsMatch(t, expected, actual)
@@ -6,20 +6,20 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/state/protocol/inmem"
 	"github.com/onflow/flow-go/state/protocol/invalid"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type State struct {
 	metrics module.ComplianceMetrics
-	db      *badger.DB
+	db      kv.DB
 	headers storage.Headers
 	blocks  storage.Blocks
 	results storage.ExecutionResults
@@ -51,7 +51,7 @@ func SkipNetworkAddressValidation(conf *BootstrapConfig) {
 
 func Bootstrap(
 	metrics module.ComplianceMetrics,
-	db *badger.DB,
+	db kv.DB,
 	headers storage.Headers,
 	seals storage.Seals,
 	results storage.ExecutionResults,
@@ -227,8 +227,8 @@ func (state *State) bootstrapSealingSegment(segment *flow.SealingSegment, head *
 
 // bootstrapStatePointers instantiates special pointers used to by the protocol
 // state to keep track of special block heights and views.
-func (state *State) bootstrapStatePointers(root protocol.Snapshot) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func (state *State) bootstrapStatePointers(root protocol.Snapshot) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		segment, err := root.SealingSegment()
 		if err != nil {
 			return fmt.Errorf("could not get sealing segment: %w", err)
@@ -402,8 +402,8 @@ func (state *State) bootstrapEpoch(root protocol.Snapshot, verifyNetworkAddress
 
 // bootstrapSporkInfo bootstraps the protocol state with information about the
 // spork which is used to disambiguate Flow networks.
-func (state *State) bootstrapSporkInfo(root protocol.Snapshot) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func (state *State) bootstrapSporkInfo(root protocol.Snapshot) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		params := root.Params()
 
 		sporkID, err := params.SporkID()
@@ -424,13 +424,40 @@ func (state *State) bootstrapSporkInfo(root protocol.Snapshot) func(*badger.Txn)
 			return fmt.Errorf("could not insert protocol version: %w", err)
 		}
 
+		// root snapshots created before sealing parameters were part of the protocol
+		// state don't specify them, in which case flow.DefaultSealingParameters apply
+		if !specifiesSealingParameters(root) {
+			return nil
+		}
+		sealingParams, err := params.SealingParameters()
+		if err != nil {
+			return fmt.Errorf("could not get sealing parameters: %w", err)
+		}
+		err = operation.InsertSealingParameters(sealingParams)(tx)
+		if err != nil {
+			return fmt.Errorf("could not insert sealing parameters: %w", err)
+		}
+
 		return nil
 	}
 }
 
+// specifiesSealingParameters returns false if the root snapshot is a serialized snapshot which
+// was encoded before sealing parameters were part of the protocol state.
+func specifiesSealingParameters(root protocol.Snapshot) bool {
+	switch snapshot := root.(type) {
+	case *inmem.Snapshot:
+		return snapshot.Encodable().Params.SealingParameters != nil
+	case inmem.Snapshot:
+		return snapshot.Encodable().Params.SealingParameters != nil
+	default:
+		return true
+	}
+}
+
 func OpenState(
 	metrics module.ComplianceMetrics,
-	db *badger.DB,
+	db kv.DB,
 	headers storage.Headers,
 	seals storage.Seals,
 	results storage.ExecutionResults,
@@ -503,7 +530,7 @@ func (state *State) AtBlockID(blockID flow.Identifier) protocol.Snapshot {
 // is expected to contain a an already bootstrapped state or not
 func newState(
 	metrics module.ComplianceMetrics,
-	db *badger.DB,
+	db kv.DB,
 	headers storage.Headers,
 	seals storage.Seals,
 	results storage.ExecutionResults,
@@ -532,7 +559,7 @@ func newState(
 }
 
 // IsBootstrapped returns whether or not the database contains a bootstrapped state
-func IsBootstrapped(db *badger.DB) (bool, error) {
+func IsBootstrapped(db kv.DB) (bool, error) {
 	var finalized uint64
 	err := db.View(operation.RetrieveFinalizedHeight(&finalized))
 	if errors.Is(err, storage.ErrNotFound) {
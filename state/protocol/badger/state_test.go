@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,6 +19,8 @@ import (
 	"github.com/onflow/flow-go/state/protocol/util"
 	protoutil "github.com/onflow/flow-go/state/protocol/util"
 	storagebadger "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 	storutil "github.com/onflow/flow-go/storage/util"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -34,7 +35,7 @@ func TestBootstrapAndOpen(t *testing.T) {
 		block.Header.ParentID = unittest.IdentifierFixture()
 	})
 
-	protoutil.RunWithBootstrapState(t, rootSnapshot, func(db *badger.DB, _ *bprotocol.State) {
+	protoutil.RunWithBootstrapState(t, rootSnapshot, func(db kv.DB, _ *bprotocol.State) {
 
 		// expect the final view metric to be set to current epoch's final view
 		epoch := rootSnapshot.Epochs().Current()
@@ -96,7 +97,7 @@ func TestBootstrapAndOpen_EpochCommitted(t *testing.T) {
 		}
 	})
 
-	protoutil.RunWithBootstrapState(t, committedPhaseSnapshot, func(db *badger.DB, _ *bprotocol.State) {
+	protoutil.RunWithBootstrapState(t, committedPhaseSnapshot, func(db kv.DB, _ *bprotocol.State) {
 
 		complianceMetrics := new(mock.ComplianceMetrics)
 
@@ -394,13 +395,52 @@ func TestBootstrap_SealMismatch(t *testing.T) {
 	})
 }
 
+// TestBootstrap_FallbackSealingParameters verifies that states bootstrapped from root snapshots
+// which don't specify sealing parameters use the protocol's default sealing parameters, while
+// sealing parameters specified in the root snapshot take precedence.
+func TestBootstrap_FallbackSealingParameters(t *testing.T) {
+	participants := unittest.CompleteIdentitySet()
+
+	t.Run("root snapshot without sealing parameters", func(t *testing.T) {
+		enc := unittest.RootSnapshotFixture(participants).Encodable()
+		enc.Params.SealingParameters = nil
+		rootSnapshot := inmem.SnapshotFromEncodable(enc)
+
+		bootstrap(t, rootSnapshot, func(state *bprotocol.State, err error) {
+			require.NoError(t, err)
+
+			params, err := state.Params().SealingParameters()
+			require.NoError(t, err)
+			assert.Equal(t, flow.DefaultSealingParameters(), params)
+
+			params, err = state.Final().SealingParameters()
+			require.NoError(t, err)
+			assert.Equal(t, flow.DefaultSealingParameters(), params)
+		})
+	})
+
+	t.Run("root snapshot with sealing parameters", func(t *testing.T) {
+		rootSnapshot := unittest.RootSnapshotFixture(participants)
+		expected, err := rootSnapshot.Params().SealingParameters()
+		require.NoError(t, err)
+
+		bootstrap(t, rootSnapshot, func(state *bprotocol.State, err error) {
+			require.NoError(t, err)
+
+			params, err := state.Final().SealingParameters()
+			require.NoError(t, err)
+			assert.Equal(t, expected, params)
+		})
+	})
+}
+
 // bootstraps protocol state with the given snapshot and invokes the callback
 // with the result of the constructor
 func bootstrap(t *testing.T, rootSnapshot protocol.Snapshot, f func(*bprotocol.State, error)) {
 	metrics := metrics.NewNoopCollector()
 	dir := unittest.TempDir(t)
 	defer os.RemoveAll(dir)
-	db := unittest.BadgerDB(t, dir)
+	db := badgerkv.New(unittest.BadgerDB(t, dir))
 	defer db.Close()
 	headers, _, seals, _, _, blocks, setups, commits, statuses, results := storutil.StorageLayer(t, db)
 	state, err := bprotocol.Bootstrap(metrics, db, headers, seals, results, blocks, setups, commits, statuses, rootSnapshot)
@@ -415,7 +455,7 @@ func bootstrap(t *testing.T, rootSnapshot protocol.Snapshot, f func(*bprotocol.S
 // from non-root states.
 func snapshotAfter(t *testing.T, rootSnapshot protocol.Snapshot, f func(*bprotocol.FollowerState) protocol.Snapshot) protocol.Snapshot {
 	var after protocol.Snapshot
-	protoutil.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *bprotocol.FollowerState) {
+	protoutil.RunWithFollowerProtocolState(t, rootSnapshot, func(db kv.DB, state *bprotocol.FollowerState) {
 		snap := f(state)
 		var err error
 		after, err = inmem.FromSnapshot(snap)
@@ -469,7 +509,7 @@ func assertSealingSegmentBlocksQueryableAfterBootstrap(t *testing.T, snapshot pr
 
 // BenchmarkFinal benchmarks retrieving the latest finalized block from storage.
 func BenchmarkFinal(b *testing.B) {
-	util.RunWithBootstrapState(b, unittest.RootSnapshotFixture(unittest.CompleteIdentitySet()), func(db *badger.DB, state *bprotocol.State) {
+	util.RunWithBootstrapState(b, unittest.RootSnapshotFixture(unittest.CompleteIdentitySet()), func(db kv.DB, state *bprotocol.State) {
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
 			header, err := state.Final().Head()
@@ -481,7 +521,7 @@ func BenchmarkFinal(b *testing.B) {
 
 // BenchmarkFinal benchmarks retrieving the block by height from storage.
 func BenchmarkByHeight(b *testing.B) {
-	util.RunWithBootstrapState(b, unittest.RootSnapshotFixture(unittest.CompleteIdentitySet()), func(db *badger.DB, state *bprotocol.State) {
+	util.RunWithBootstrapState(b, unittest.RootSnapshotFixture(unittest.CompleteIdentitySet()), func(db kv.DB, state *bprotocol.State) {
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
 			header, err := state.AtHeight(0).Head()
@@ -139,6 +139,14 @@ func verifyEpochSetup(setup *flow.EpochSetup, verifyNetworkAddress bool) error {
 		return fmt.Errorf("invalid cluster assignments: %w", err)
 	}
 
+	// the sealing parameters, if specified, need to be consistent
+	if setup.SealingParameters != nil {
+		err = setup.SealingParameters.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid sealing parameters: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -172,6 +180,57 @@ func isValidExtendingEpochCommit(extendingCommit *flow.EpochCommit, extendingSet
 	return nil
 }
 
+// isValidEpochRecover checks whether an epoch recover service event being added
+// to the state is valid. The recovery epoch replaces the next epoch, hence it
+// must not have been committed yet. As the current epoch may already have been
+// extended beyond its final view by epoch emergency fallback, the recovery epoch
+// must start after both the final view of the current epoch and the view of the
+// block applying the event, so that all views before that remain in the current epoch.
+func isValidEpochRecover(epochRecover *flow.EpochRecover, activeSetup *flow.EpochSetup, status *flow.EpochStatus, view uint64) error {
+
+	// The next epoch must not have been committed yet, which also prevents
+	// multiple recover events for the same epoch.
+	if status.NextEpoch.CommitID != flow.ZeroID {
+		return protocol.NewInvalidServiceEventError("next epoch already committed: %x", status.NextEpoch.CommitID)
+	}
+
+	setup := &epochRecover.EpochSetup
+	commit := &epochRecover.EpochCommit
+
+	// The recovery epoch should have the counter increased by one.
+	if setup.Counter != activeSetup.Counter+1 {
+		return protocol.NewInvalidServiceEventError("recovery epoch has invalid counter (%d => %d)", activeSetup.Counter, setup.Counter)
+	}
+
+	// The recovery epoch must start after the current epoch and after the applying block.
+	if setup.FirstView <= activeSetup.FinalView {
+		return protocol.NewInvalidServiceEventError(
+			"recovery epoch first view must be after current epoch final view (%d <= %d)",
+			setup.FirstView,
+			activeSetup.FinalView,
+		)
+	}
+	if setup.FirstView <= view {
+		return protocol.NewInvalidServiceEventError(
+			"recovery epoch first view must be after the view of the applying block (%d <= %d)",
+			setup.FirstView,
+			view,
+		)
+	}
+
+	// Finally, the setup and commit must contain all necessary information.
+	err := isValidEpochSetup(setup)
+	if err != nil {
+		return protocol.NewInvalidServiceEventError("invalid recovery epoch setup: %w", err)
+	}
+	err = isValidEpochCommit(commit, setup)
+	if err != nil {
+		return protocol.NewInvalidServiceEventError("invalid recovery epoch commit: %w", err)
+	}
+
+	return nil
+}
+
 // isValidEpochCommit checks whether an epoch commit service event is intrinsically valid.
 func isValidEpochCommit(commit *flow.EpochCommit, setup *flow.EpochSetup) error {
 
@@ -268,6 +327,16 @@ func IsValidRootSnapshot(snap protocol.Snapshot, verifyResultID bool) error {
 		return fmt.Errorf("final view of epoch less than first block view")
 	}
 
+	// the sealing parameters must be consistent
+	sealingParams, err := snap.Params().SealingParameters()
+	if err != nil {
+		return fmt.Errorf("could not get sealing parameters: %w", err)
+	}
+	err = sealingParams.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid sealing parameters: %w", err)
+	}
+
 	return nil
 }
 
@@ -54,6 +54,19 @@ func TestEpochSetupValidity(t *testing.T) {
 		err := isValidEpochSetup(setup)
 		require.Error(t, err)
 	})
+
+	t.Run("inconsistent sealing parameters", func(t *testing.T) {
+		_, result, _ := unittest.BootstrapFixture(participants)
+		setup := result.ServiceEvents[0].Event.(*flow.EpochSetup)
+		// require more approvals for verifying seals than for constructing them
+		setup.SealingParameters = &flow.SealingParameters{
+			RequiredApprovalsForSealConstruction: 1,
+			RequiredApprovalsForSealVerification: 2,
+		}
+
+		err := isValidEpochSetup(setup)
+		require.Error(t, err)
+	})
 }
 
 func TestBootstrapInvalidEpochCommit(t *testing.T) {
@@ -79,4 +79,9 @@ type Epoch interface {
 
 	// DKG returns the result of the distributed key generation procedure.
 	DKG() (DKG, error)
+
+	// SealingParameters returns the sealing parameters specified in the
+	// EpochSetup event for this epoch, or nil if the EpochSetup event does
+	// not specify them, in which case the global sealing parameters apply.
+	SealingParameters() (*flow.SealingParameters, error)
 }
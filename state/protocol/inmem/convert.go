@@ -105,6 +105,11 @@ func FromParams(from protocol.GlobalParams) (*Params, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not get protocol version: %w", err)
 	}
+	sealingParams, err := from.SealingParameters()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sealing parameters: %w", err)
+	}
+	params.SealingParameters = &sealingParams
 
 	return &Params{params}, nil
 }
@@ -138,6 +143,10 @@ func FromEpoch(from protocol.Epoch) (*Epoch, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not get random source: %w", err)
 	}
+	epoch.SealingParameters, err = from.SealingParameters()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sealing parameters: %w", err)
+	}
 	epoch.DKGPhase1FinalView, epoch.DKGPhase2FinalView, epoch.DKGPhase3FinalView, err = protocol.DKGPhaseViews(from)
 	if err != nil {
 		return nil, fmt.Errorf("could not get dkg final views")
@@ -221,19 +230,25 @@ func ClusterFromEncodable(enc EncodableCluster) (*Cluster, error) {
 // root bootstrap state. This is used to bootstrap the protocol state for
 // genesis or post-spork states.
 func SnapshotFromBootstrapState(root *flow.Block, result *flow.ExecutionResult, seal *flow.Seal, qc *flow.QuorumCertificate) (*Snapshot, error) {
-	return SnapshotFromBootstrapStateWithProtocolVersion(root, result, seal, qc, flow.DefaultProtocolVersion)
+	return SnapshotFromBootstrapStateWithParams(root, result, seal, qc, flow.DefaultProtocolVersion, flow.DefaultSealingParameters())
 }
 
-// SnapshotFromBootstrapStateWithProtocolVersion is SnapshotFromBootstrapState
-// with a caller-specified protocol version.
-func SnapshotFromBootstrapStateWithProtocolVersion(
+// SnapshotFromBootstrapStateWithParams is SnapshotFromBootstrapState
+// with a caller-specified protocol version and sealing parameters.
+func SnapshotFromBootstrapStateWithParams(
 	root *flow.Block,
 	result *flow.ExecutionResult,
 	seal *flow.Seal,
 	qc *flow.QuorumCertificate,
 	version uint,
+	sealingParams flow.SealingParameters,
 ) (*Snapshot, error) {
 
+	err := sealingParams.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sealing parameters: %w", err)
+	}
+
 	setup, ok := result.ServiceEvents[0].Event.(*flow.EpochSetup)
 	if !ok {
 		return nil, fmt.Errorf("invalid setup event type (%T)", result.ServiceEvents[0].Event)
@@ -252,9 +267,10 @@ func SnapshotFromBootstrapStateWithProtocolVersion(
 	}
 
 	params := EncodableParams{
-		ChainID:         root.Header.ChainID, // chain ID must match the root block
-		SporkID:         root.ID(),           // use root block ID as the unique spork identifier
-		ProtocolVersion: version,             // major software version for this spork
+		ChainID:           root.Header.ChainID, // chain ID must match the root block
+		SporkID:           root.ID(),           // use root block ID as the unique spork identifier
+		ProtocolVersion:   version,             // major software version for this spork
+		SealingParameters: &sealingParams,      // sealing parameters for this spork
 	}
 
 	snap := SnapshotFromEncodable(EncodableSnapshot{
@@ -44,6 +44,10 @@ func (e Epoch) DKG() (protocol.DKG, error) {
 	return nil, protocol.ErrEpochNotCommitted
 }
 
+func (e Epoch) SealingParameters() (*flow.SealingParameters, error) {
+	return e.enc.SealingParameters, nil
+}
+
 func (e Epoch) Cluster(i uint) (protocol.Cluster, error) {
 	if e.enc.Clusters != nil {
 		if i >= uint(len(e.enc.Clusters)) {
@@ -131,6 +135,10 @@ func (es *setupEpoch) RandomSource() ([]byte, error) {
 	return es.setupEvent.RandomSource, nil
 }
 
+func (es *setupEpoch) SealingParameters() (*flow.SealingParameters, error) {
+	return es.setupEvent.SealingParameters, nil
+}
+
 // committedEpoch is an implementation of protocol.Epoch backed by an EpochSetup
 // and EpochCommit service event. This is used for converting service events to
 // inmem.Epoch.
@@ -19,3 +19,13 @@ func (p Params) SporkID() (flow.Identifier, error) {
 func (p Params) ProtocolVersion() (uint, error) {
 	return p.enc.ProtocolVersion, nil
 }
+
+// SealingParameters returns the sealing parameters of the snapshot. Snapshots
+// encoded before sealing parameters were part of the protocol state don't
+// specify them, in which case the default sealing parameters apply.
+func (p Params) SealingParameters() (flow.SealingParameters, error) {
+	if p.enc.SealingParameters == nil {
+		return flow.DefaultSealingParameters(), nil
+	}
+	return *p.enc.SealingParameters, nil
+}
@@ -79,6 +79,10 @@ func (s Snapshot) Params() protocol.GlobalParams {
 	return Params{s.enc.Params}
 }
 
+func (s Snapshot) SealingParameters() (flow.SealingParameters, error) {
+	return protocol.ResolveSealingParameters(s.Epochs().Current(), s.Params())
+}
+
 func (s Snapshot) Encodable() EncodableSnapshot {
 	return s.enc
 }
@@ -20,3 +20,7 @@ func (p *Params) SporkID() (flow.Identifier, error) {
 func (p *Params) ProtocolVersion() (uint, error) {
 	return 0, p.err
 }
+
+func (p *Params) SealingParameters() (flow.SealingParameters, error) {
+	return flow.SealingParameters{}, p.err
+}
@@ -62,3 +62,7 @@ func (u *Snapshot) RandomSource() ([]byte, error) {
 func (u *Snapshot) Params() protocol.GlobalParams {
 	return &Params{u.err}
 }
+
+func (u *Snapshot) SealingParameters() (flow.SealingParameters, error) {
+	return flow.SealingParameters{}, u.err
+}
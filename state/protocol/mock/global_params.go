@@ -54,6 +54,27 @@ func (_m *GlobalParams) ProtocolVersion() (uint, error) {
 	return r0, r1
 }
 
+// SealingParameters provides a mock function with given fields:
+func (_m *GlobalParams) SealingParameters() (flow.SealingParameters, error) {
+	ret := _m.Called()
+
+	var r0 flow.SealingParameters
+	if rf, ok := ret.Get(0).(func() flow.SealingParameters); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(flow.SealingParameters)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SporkID provides a mock function with given fields:
 func (_m *GlobalParams) SporkID() (flow.Identifier, error) {
 	ret := _m.Called()
@@ -260,6 +260,27 @@ func (_m *Snapshot) SealedResult() (*flow.ExecutionResult, *flow.Seal, error) {
 	return r0, r1, r2
 }
 
+// SealingParameters provides a mock function with given fields:
+func (_m *Snapshot) SealingParameters() (flow.SealingParameters, error) {
+	ret := _m.Called()
+
+	var r0 flow.SealingParameters
+	if rf, ok := ret.Get(0).(func() flow.SealingParameters); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(flow.SealingParameters)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SealingSegment provides a mock function with given fields:
 func (_m *Snapshot) SealingSegment() (*flow.SealingSegment, error) {
 	ret := _m.Called()
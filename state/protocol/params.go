@@ -45,4 +45,9 @@ type GlobalParams interface {
 	// ProtocolVersion returns the protocol version, the major software version
 	// of the protocol software.
 	ProtocolVersion() (uint, error)
+
+	// SealingParameters returns the sealing parameters specified in the root
+	// protocol state snapshot. They apply to all epochs of the spork, unless
+	// replaced by the EpochSetup event for an epoch.
+	SealingParameters() (flow.SealingParameters, error)
 }
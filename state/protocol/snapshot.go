@@ -102,4 +102,9 @@ type Snapshot interface {
 
 	// Params returns global parameters of the state this snapshot is taken from.
 	Params() GlobalParams
+
+	// SealingParameters returns the sealing parameters in effect as of the Head
+	// block: the sealing parameters of the current epoch if they were specified
+	// in its EpochSetup event, otherwise the global sealing parameters.
+	SealingParameters() (flow.SealingParameters, error)
 }
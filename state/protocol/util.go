@@ -69,3 +69,21 @@ func IsSporkRootSnapshot(snapshot Snapshot) (bool, error) {
 	}
 	return true, nil
 }
+
+// ResolveSealingParameters returns the sealing parameters in effect for the given
+// epoch: the sealing parameters specified in the epoch's EpochSetup event, or the
+// given global sealing parameters if the EpochSetup event does not specify any.
+func ResolveSealingParameters(epoch Epoch, params GlobalParams) (flow.SealingParameters, error) {
+	epochParams, err := epoch.SealingParameters()
+	if err != nil {
+		return flow.SealingParameters{}, fmt.Errorf("could not get epoch sealing parameters: %w", err)
+	}
+	if epochParams != nil {
+		return *epochParams, nil
+	}
+	globalParams, err := params.SealingParameters()
+	if err != nil {
+		return flow.SealingParameters{}, fmt.Errorf("could not get global sealing parameters: %w", err)
+	}
+	return globalParams, nil
+}
@@ -0,0 +1,16 @@
+package storage
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// AccountTransactions represents a persistent index of the sealed transactions involving each
+// account, maintained by Access nodes to serve account transaction history queries.
+type AccountTransactions interface {
+	// Store indexes the given account transactions. Storing the same entries again is a no-op.
+	Store(txs []flow.AccountTransaction) error
+
+	// ByAddress returns at most limit transactions involving the given account, ordered by
+	// position, starting at the given position (inclusive) up to and including the end height.
+	ByAddress(address flow.Address, from flow.TransactionPosition, endHeight uint64, limit uint) ([]flow.AccountTransaction, error)
+}
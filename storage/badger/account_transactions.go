@@ -0,0 +1,55 @@
+package badger
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// AccountTransactions implements the account transaction index of Access nodes.
+type AccountTransactions struct {
+	db kv.DB
+}
+
+func NewAccountTransactions(db kv.DB) *AccountTransactions {
+	return &AccountTransactions{
+		db: db,
+	}
+}
+
+// Store indexes the given account transactions. As all writes are blind, storing the same
+// entries twice is a no-op.
+func (a *AccountTransactions) Store(txs []flow.AccountTransaction) error {
+	batch := NewBatch(a.db)
+	writer := batch.GetWriter()
+
+	for _, tx := range txs {
+		err := operation.BatchIndexAccountTransaction(tx)(writer)
+		if err != nil {
+			return fmt.Errorf("could not index account transaction: %w", err)
+		}
+	}
+
+	err := batch.Flush()
+	if err != nil {
+		return fmt.Errorf("could not flush account transaction batch: %w", err)
+	}
+	return nil
+}
+
+// ByAddress returns at most limit transactions involving the given account, ordered by position,
+// starting at the given position (inclusive) up to and including the end height.
+func (a *AccountTransactions) ByAddress(address flow.Address, from flow.TransactionPosition, endHeight uint64, limit uint) ([]flow.AccountTransaction, error) {
+	if from.Height > endHeight || limit == 0 {
+		return nil, nil
+	}
+
+	var txs []flow.AccountTransaction
+	err := a.db.View(operation.LookupAccountTransactions(address, from, endHeight, limit, &txs))
+	if err != nil {
+		return nil, fmt.Errorf("could not lookup account transactions: %w", err)
+	}
+	return txs, nil
+}
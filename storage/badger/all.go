@@ -1,13 +1,12 @@
 package badger
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func InitAll(metrics module.CacheMetrics, db *badger.DB) *storage.All {
+func InitAll(metrics module.CacheMetrics, db kv.DB) *storage.All {
 	headers := NewHeaders(metrics, db)
 	guarantees := NewGuarantees(metrics, db, DefaultCacheSize)
 	seals := NewSeals(metrics, db)
@@ -26,6 +25,7 @@ func InitAll(metrics module.CacheMetrics, db *badger.DB) *storage.All {
 	collections := NewCollections(db, transactions)
 	events := NewEvents(metrics, db)
 	chunkDataPacks := NewChunkDataPacks(metrics, db, collections, 1000)
+	slashingEvidence := NewSlashingEvidence(db)
 
 	return &storage.All{
 		Headers:            headers,
@@ -45,5 +45,6 @@ func InitAll(metrics module.CacheMetrics, db *badger.DB) *storage.All {
 		TransactionResults: transactionResults,
 		Collections:        collections,
 		Events:             events,
+		SlashingEvidence:   slashingEvidence,
 	}
 }
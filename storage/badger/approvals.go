@@ -4,33 +4,32 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // ResultApprovals implements persistent storage for result approvals.
 type ResultApprovals struct {
-	db    *badger.DB
+	db    kv.DB
 	cache *Cache
 }
 
-func NewResultApprovals(collector module.CacheMetrics, db *badger.DB) *ResultApprovals {
+func NewResultApprovals(collector module.CacheMetrics, db kv.DB) *ResultApprovals {
 
 	store := func(key interface{}, val interface{}) func(*transaction.Tx) error {
 		approval := val.(*flow.ResultApproval)
 		return transaction.WithTx(operation.SkipDuplicates(operation.InsertResultApproval(approval)))
 	}
 
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		approvalID := key.(flow.Identifier)
 		var approval flow.ResultApproval
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := operation.RetrieveResultApproval(approvalID, &approval)(tx)
 			return &approval, err
 		}
@@ -51,8 +50,8 @@ func (r *ResultApprovals) store(approval *flow.ResultApproval) func(*transaction
 	return r.cache.PutTx(approval.ID(), approval)
 }
 
-func (r *ResultApprovals) byID(approvalID flow.Identifier) func(*badger.Txn) (*flow.ResultApproval, error) {
-	return func(tx *badger.Txn) (*flow.ResultApproval, error) {
+func (r *ResultApprovals) byID(approvalID flow.Identifier) func(kv.Transaction) (*flow.ResultApproval, error) {
+	return func(tx kv.Transaction) (*flow.ResultApproval, error) {
 		val, err := r.cache.Get(approvalID)(tx)
 		if err != nil {
 			return nil, err
@@ -61,8 +60,8 @@ func (r *ResultApprovals) byID(approvalID flow.Identifier) func(*badger.Txn) (*f
 	}
 }
 
-func (r *ResultApprovals) byChunk(resultID flow.Identifier, chunkIndex uint64) func(*badger.Txn) (*flow.ResultApproval, error) {
-	return func(tx *badger.Txn) (*flow.ResultApproval, error) {
+func (r *ResultApprovals) byChunk(resultID flow.Identifier, chunkIndex uint64) func(kv.Transaction) (*flow.ResultApproval, error) {
+	return func(tx kv.Transaction) (*flow.ResultApproval, error) {
 		var approvalID flow.Identifier
 		err := operation.LookupResultApproval(resultID, chunkIndex, &approvalID)(tx)
 		if err != nil {
@@ -72,8 +71,8 @@ func (r *ResultApprovals) byChunk(resultID flow.Identifier, chunkIndex uint64) f
 	}
 }
 
-func (r *ResultApprovals) index(resultID flow.Identifier, chunkIndex uint64, approvalID flow.Identifier) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func (r *ResultApprovals) index(resultID flow.Identifier, chunkIndex uint64, approvalID flow.Identifier) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		err := operation.IndexResultApproval(resultID, chunkIndex, approvalID)(tx)
 		if err == nil {
 			return nil
@@ -1,15 +1,15 @@
 package badger
 
 import (
-	"github.com/dgraph-io/badger/v2"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type Batch struct {
-	writer    *badger.WriteBatch
+	writer    kv.WriteBatch
 	callbacks []func()
 }
 
-func NewBatch(db *badger.DB) *Batch {
+func NewBatch(db kv.DB) *Batch {
 	batch := db.NewWriteBatch()
 	return &Batch{
 		writer:    batch,
@@ -17,7 +17,7 @@ func NewBatch(db *badger.DB) *Batch {
 	}
 }
 
-func (b *Batch) GetWriter() *badger.WriteBatch {
+func (b *Batch) GetWriter() kv.Writer {
 	return b.writer
 }
 
@@ -29,7 +29,7 @@ func (b *Batch) OnSucceed(callback func()) {
 	b.callbacks = append(b.callbacks, callback)
 }
 
-// Flush will call the badger Batch's Flush method, in
+// Flush will call the underlying write batch's Flush method, in
 // addition, it will call the callbacks added by
 // OnSucceed
 func (b *Batch) Flush() error {
@@ -4,12 +4,12 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 func withLimit(limit uint) func(*Cache) {
@@ -40,7 +40,7 @@ func noopStore(key interface{}, val interface{}) func(*transaction.Tx) error {
 	}
 }
 
-type retrieveFunc func(key interface{}) func(*badger.Txn) (interface{}, error)
+type retrieveFunc func(key interface{}) func(kv.Transaction) (interface{}, error)
 
 func withRetrieve(retrieve retrieveFunc) func(*Cache) {
 	return func(c *Cache) {
@@ -48,8 +48,8 @@ func withRetrieve(retrieve retrieveFunc) func(*Cache) {
 	}
 }
 
-func noRetrieve(key interface{}) func(*badger.Txn) (interface{}, error) {
-	return func(tx *badger.Txn) (interface{}, error) {
+func noRetrieve(key interface{}) func(kv.Transaction) (interface{}, error) {
+	return func(tx kv.Transaction) (interface{}, error) {
 		return nil, fmt.Errorf("no retrieve function for cache get available")
 	}
 }
@@ -81,8 +81,8 @@ func newCache(collector module.CacheMetrics, resourceName string, options ...fun
 
 // Get will try to retrieve the resource from cache first, and then from the
 // injected
-func (c *Cache) Get(key interface{}) func(*badger.Txn) (interface{}, error) {
-	return func(tx *badger.Txn) (interface{}, error) {
+func (c *Cache) Get(key interface{}) func(kv.Transaction) (interface{}, error) {
+	return func(tx kv.Transaction) (interface{}, error) {
 
 		// check if we have it in the cache
 		resource, cached := c.cache.Get(key)
@@ -3,8 +3,6 @@ package badger
 import (
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
@@ -12,26 +10,27 @@ import (
 	badgermodel "github.com/onflow/flow-go/storage/badger/model"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type ChunkDataPacks struct {
-	db             *badger.DB
+	db             kv.DB
 	collections    storage.Collections
 	byChunkIDCache *Cache
 }
 
-func NewChunkDataPacks(collector module.CacheMetrics, db *badger.DB, collections storage.Collections, byChunkIDCacheSize uint) *ChunkDataPacks {
+func NewChunkDataPacks(collector module.CacheMetrics, db kv.DB, collections storage.Collections, byChunkIDCacheSize uint) *ChunkDataPacks {
 
 	store := func(key interface{}, val interface{}) func(*transaction.Tx) error {
 		chdp := val.(*badgermodel.StoredChunkDataPack)
 		return transaction.WithTx(operation.SkipDuplicates(operation.InsertChunkDataPack(chdp)))
 	}
 
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		chunkID := key.(flow.Identifier)
 
 		var c badgermodel.StoredChunkDataPack
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := operation.RetrieveChunkDataPack(chunkID, &c)(tx)
 			return &c, err
 		}
@@ -115,8 +114,8 @@ func (ch *ChunkDataPacks) byChunkID(chunkID flow.Identifier) (*badgermodel.Store
 	return schdp, nil
 }
 
-func (ch *ChunkDataPacks) retrieveCHDP(chunkID flow.Identifier) func(*badger.Txn) (*badgermodel.StoredChunkDataPack, error) {
-	return func(tx *badger.Txn) (*badgermodel.StoredChunkDataPack, error) {
+func (ch *ChunkDataPacks) retrieveCHDP(chunkID flow.Identifier) func(kv.Transaction) (*badgermodel.StoredChunkDataPack, error) {
+	return func(tx kv.Transaction) (*badgermodel.StoredChunkDataPack, error) {
 		val, err := ch.byChunkIDCache.Get(chunkID)(tx)
 		if err != nil {
 			return nil, err
@@ -6,12 +6,11 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 
 	"github.com/stretchr/testify/assert"
@@ -21,7 +20,7 @@ import (
 // TestChunkDataPacks_Store evaluates correct storage and retrieval of chunk data packs in the storage.
 // It also evaluates that re-inserting is idempotent.
 func TestChunkDataPacks_Store(t *testing.T) {
-	WithChunkDataPacks(t, 100, func(t *testing.T, chunkDataPacks []*flow.ChunkDataPack, chunkDataPackStore *badgerstorage.ChunkDataPacks, _ *badger.DB) {
+	WithChunkDataPacks(t, 100, func(t *testing.T, chunkDataPacks []*flow.ChunkDataPack, chunkDataPackStore *badgerstorage.ChunkDataPacks, _ kv.DB) {
 		wg := sync.WaitGroup{}
 		wg.Add(len(chunkDataPacks))
 		for _, chunkDataPack := range chunkDataPacks {
@@ -45,7 +44,7 @@ func TestChunkDataPacks_Store(t *testing.T) {
 
 // TestChunkDataPack_BatchStore evaluates correct batch storage and retrieval of chunk data packs in the storage.
 func TestChunkDataPacks_BatchStore(t *testing.T) {
-	WithChunkDataPacks(t, 100, func(t *testing.T, chunkDataPacks []*flow.ChunkDataPack, chunkDataPackStore *badgerstorage.ChunkDataPacks, db *badger.DB) {
+	WithChunkDataPacks(t, 100, func(t *testing.T, chunkDataPacks []*flow.ChunkDataPack, chunkDataPackStore *badgerstorage.ChunkDataPacks, db kv.DB) {
 		batch := badgerstorage.NewBatch(db)
 
 		wg := sync.WaitGroup{}
@@ -68,7 +67,7 @@ func TestChunkDataPacks_BatchStore(t *testing.T) {
 
 // TestChunkDataPacks_MissingItem evaluates querying a missing item returns a storage.ErrNotFound error.
 func TestChunkDataPacks_MissingItem(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		transactions := badgerstorage.NewTransactions(&metrics.NoopCollector{}, db)
 		collections := badgerstorage.NewCollections(db, transactions)
 		store := badgerstorage.NewChunkDataPacks(&metrics.NoopCollector{}, db, collections, 1)
@@ -81,8 +80,8 @@ func TestChunkDataPacks_MissingItem(t *testing.T) {
 
 // WithChunkDataPacks is a test helper that generates specified number of chunk data packs, store them using the storeFunc, and
 // then evaluates whether they are successfully retrieved from storage.
-func WithChunkDataPacks(t *testing.T, chunks int, storeFunc func(*testing.T, []*flow.ChunkDataPack, *badgerstorage.ChunkDataPacks, *badger.DB)) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+func WithChunkDataPacks(t *testing.T, chunks int, storeFunc func(*testing.T, []*flow.ChunkDataPack, *badgerstorage.ChunkDataPacks, kv.DB)) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		transactions := badgerstorage.NewTransactions(&metrics.NoopCollector{}, db)
 		collections := badgerstorage.NewCollections(db, transactions)
 		// keep the cache size at 1 to make sure that entries are written and read from storage itself.
@@ -4,25 +4,24 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/chunks"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // ChunksQueue stores a queue of chunk locators that assigned to me to verify.
 // Job consumers can read the locators as job from the queue by index.
 // Chunk locators stored in this queue are unique.
 type ChunksQueue struct {
-	db *badger.DB
+	db kv.DB
 }
 
 const JobQueueChunksQueue = "JobQueueChunksQueue"
 
 // NewChunkQueue will initialize the underlying badger database of chunk locator queue.
-func NewChunkQueue(db *badger.DB) *ChunksQueue {
+func NewChunkQueue(db kv.DB) *ChunksQueue {
 	return &ChunksQueue{
 		db: db,
 	}
@@ -49,7 +48,7 @@ func (q *ChunksQueue) Init(defaultIndex uint64) (bool, error) {
 // A true will be returned, if the locator was new.
 // A false will be returned, if the locator was duplicate.
 func (q *ChunksQueue) StoreChunkLocator(locator *chunks.Locator) (bool, error) {
-	err := operation.RetryOnConflict(q.db.Update, func(tx *badger.Txn) error {
+	err := operation.RetryOnConflict(q.db.Update, func(tx kv.Transaction) error {
 		// make sure the chunk locator is unique
 		err := operation.InsertChunkLocator(locator)(tx)
 		if err != nil {
@@ -24,6 +24,7 @@ type Cleaner struct {
 
 // NewCleaner returns a cleaner that runs the badger value log garbage collection once every `frequency` calls
 // if a frequency of zero is passed in, we will not run the GC at all
+// if no badger database is passed in (the database uses another backend), the GC is disabled as well
 func NewCleaner(log zerolog.Logger, db *badger.DB, metrics module.CleanerMetrics, frequency int) *Cleaner {
 	// NOTE: we run garbage collection frequently at points in our business
 	// logic where we are likely to have a small breather in activity; it thus
@@ -35,7 +36,7 @@ func NewCleaner(log zerolog.Logger, db *badger.DB, metrics module.CleanerMetrics
 		metrics: metrics,
 		ratio:   0.2,
 		freq:    frequency,
-		enabled: frequency > 0, // Disable if passed in 0 as frequency
+		enabled: frequency > 0 && db != nil, // Disable if passed in 0 as frequency or no badger database
 	}
 	// we don't want the entire network to run GC at the same time, so
 	// distribute evenly over time
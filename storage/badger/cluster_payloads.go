@@ -1,8 +1,6 @@
 package badger
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/cluster"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
@@ -10,16 +8,17 @@ import (
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/procedure"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // ClusterPayloads implements storage of block payloads for collection node
 // cluster consensus.
 type ClusterPayloads struct {
-	db    *badger.DB
+	db    kv.DB
 	cache *Cache
 }
 
-func NewClusterPayloads(cacheMetrics module.CacheMetrics, db *badger.DB) *ClusterPayloads {
+func NewClusterPayloads(cacheMetrics module.CacheMetrics, db kv.DB) *ClusterPayloads {
 
 	store := func(key interface{}, val interface{}) func(*transaction.Tx) error {
 		blockID := key.(flow.Identifier)
@@ -27,10 +26,10 @@ func NewClusterPayloads(cacheMetrics module.CacheMetrics, db *badger.DB) *Cluste
 		return transaction.WithTx(procedure.InsertClusterPayload(blockID, payload))
 	}
 
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		blockID := key.(flow.Identifier)
 		var payload cluster.Payload
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := procedure.RetrieveClusterPayload(blockID, &payload)(tx)
 			return &payload, err
 		}
@@ -50,8 +49,8 @@ func NewClusterPayloads(cacheMetrics module.CacheMetrics, db *badger.DB) *Cluste
 func (cp *ClusterPayloads) storeTx(blockID flow.Identifier, payload *cluster.Payload) func(*transaction.Tx) error {
 	return cp.cache.PutTx(blockID, payload)
 }
-func (cp *ClusterPayloads) retrieveTx(blockID flow.Identifier) func(*badger.Txn) (*cluster.Payload, error) {
-	return func(tx *badger.Txn) (*cluster.Payload, error) {
+func (cp *ClusterPayloads) retrieveTx(blockID flow.Identifier) func(kv.Transaction) (*cluster.Payload, error) {
+	return func(tx kv.Transaction) (*cluster.Payload, error) {
 		val, err := cp.cache.Get(blockID)(tx)
 		if err != nil {
 			return nil, err
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -13,10 +12,11 @@ import (
 	"github.com/onflow/flow-go/utils/unittest"
 
 	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 func TestStoreRetrieveClusterPayload(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := badgerstorage.NewClusterPayloads(metrics, db)
 
@@ -39,7 +39,7 @@ func TestStoreRetrieveClusterPayload(t *testing.T) {
 }
 
 func TestClusterPayloadRetrieveWithoutStore(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := badgerstorage.NewClusterPayloads(metrics, db)
 
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -13,11 +12,12 @@ import (
 	"github.com/onflow/flow-go/utils/unittest"
 
 	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // TestCommitsStoreAndRetrieve tests that a commit can be stored, retrieved and attempted to be stored again without an error
 func TestCommitsStoreAndRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := badgerstorage.NewCommits(metrics, db)
 
@@ -1,22 +1,21 @@
 package badger
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type Commits struct {
-	db    *badger.DB
+	db    kv.DB
 	cache *Cache
 }
 
-func NewCommits(collector module.CacheMetrics, db *badger.DB) *Commits {
+func NewCommits(collector module.CacheMetrics, db kv.DB) *Commits {
 
 	store := func(key interface{}, val interface{}) func(*transaction.Tx) error {
 		blockID := key.(flow.Identifier)
@@ -24,10 +23,10 @@ func NewCommits(collector module.CacheMetrics, db *badger.DB) *Commits {
 		return transaction.WithTx(operation.SkipDuplicates(operation.IndexStateCommitment(blockID, commit)))
 	}
 
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		blockID := key.(flow.Identifier)
 		var commit flow.StateCommitment
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := operation.LookupStateCommitment(blockID, &commit)(tx)
 			return commit, err
 		}
@@ -49,8 +48,8 @@ func (c *Commits) storeTx(blockID flow.Identifier, commit flow.StateCommitment)
 	return c.cache.PutTx(blockID, commit)
 }
 
-func (c *Commits) retrieveTx(blockID flow.Identifier) func(tx *badger.Txn) (flow.StateCommitment, error) {
-	return func(tx *badger.Txn) (flow.StateCommitment, error) {
+func (c *Commits) retrieveTx(blockID flow.Identifier) func(tx kv.Transaction) (flow.StateCommitment, error) {
+	return func(tx kv.Transaction) (flow.StateCommitment, error) {
 		val, err := c.cache.Get(blockID)(tx)
 		if err != nil {
 			return flow.DummyStateCommitment, err
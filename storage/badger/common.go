@@ -4,14 +4,13 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 func handleError(err error, t interface{}) error {
 	if err != nil {
-		if errors.Is(err, badger.ErrKeyNotFound) {
+		if errors.Is(err, kv.ErrNotFound) {
 			return storage.ErrNotFound
 		}
 
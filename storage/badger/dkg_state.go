@@ -1,28 +1,29 @@
 package badger
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/model/encodable"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // DKGState stores state information about in-progress and completed DKGs, including
 // computed keys. Must be instantiated using secrets database.
 type DKGState struct {
-	db       *badger.DB
+	db       kv.DB
 	keyCache *Cache
 }
 
 // NewDKGState returns the DKGState implementation backed by Badger DB.
-func NewDKGState(collector module.CacheMetrics, db *badger.DB) (*DKGState, error) {
+func NewDKGState(collector module.CacheMetrics, db kv.DB) (*DKGState, error) {
 	err := operation.EnsureSecretDB(db)
 	if err != nil {
 		return nil, fmt.Errorf("cannot instantiate dkg state storage in non-secret db: %w", err)
@@ -34,10 +35,10 @@ func NewDKGState(collector module.CacheMetrics, db *badger.DB) (*DKGState, error
 		return transaction.WithTx(operation.InsertMyBeaconPrivateKey(epochCounter, info))
 	}
 
-	retrieveKey := func(key interface{}) func(*badger.Txn) (interface{}, error) {
+	retrieveKey := func(key interface{}) func(kv.Transaction) (interface{}, error) {
 		epochCounter := key.(uint64)
 		var info encodable.RandomBeaconPrivKey
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := operation.RetrieveMyBeaconPrivateKey(epochCounter, &info)(tx)
 			return &info, err
 		}
@@ -61,8 +62,8 @@ func (ds *DKGState) storeKeyTx(epochCounter uint64, key *encodable.RandomBeaconP
 	return ds.keyCache.PutTx(epochCounter, key)
 }
 
-func (ds *DKGState) retrieveKeyTx(epochCounter uint64) func(tx *badger.Txn) (*encodable.RandomBeaconPrivKey, error) {
-	return func(tx *badger.Txn) (*encodable.RandomBeaconPrivKey, error) {
+func (ds *DKGState) retrieveKeyTx(epochCounter uint64) func(tx kv.Transaction) (*encodable.RandomBeaconPrivKey, error) {
+	return func(tx kv.Transaction) (*encodable.RandomBeaconPrivKey, error) {
 		val, err := ds.keyCache.Get(epochCounter)(tx)
 		if err != nil {
 			return nil, err
@@ -123,6 +124,28 @@ func (ds *DKGState) GetDKGEndState(epochCounter uint64) (flow.DKGEndState, error
 	return endState, err
 }
 
+// StoreDKGTranscriptSummary stores the DKG transcript summary for the epoch,
+// replacing any summary stored previously.
+func (ds *DKGState) StoreDKGTranscriptSummary(epochCounter uint64, summary *flow.DKGTranscriptSummary) error {
+	return ds.db.Update(func(tx kv.Transaction) error {
+		err := operation.UpdateDKGTranscriptSummary(epochCounter, summary)(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			return operation.InsertDKGTranscriptSummary(epochCounter, summary)(tx)
+		}
+		return err
+	})
+}
+
+// RetrieveDKGTranscriptSummary retrieves the DKG transcript summary for the epoch.
+func (ds *DKGState) RetrieveDKGTranscriptSummary(epochCounter uint64) (*flow.DKGTranscriptSummary, error) {
+	var summary flow.DKGTranscriptSummary
+	err := ds.db.View(operation.RetrieveDKGTranscriptSummary(epochCounter, &summary))
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
 // SafeBeaconPrivateKeys is the safe beacon key storage backed by Badger DB.
 type SafeBeaconPrivateKeys struct {
 	state *DKGState
@@ -141,7 +164,7 @@ func NewSafeBeaconPrivateKeys(state *DKGState) *SafeBeaconPrivateKeys {
 // * (nil, false, nil) if the key has been marked invalid (by SetDKGEnded)
 // * (nil, false, error) for any other condition, or exception
 func (keys *SafeBeaconPrivateKeys) RetrieveMyBeaconPrivateKey(epochCounter uint64) (key crypto.PrivateKey, safe bool, err error) {
-	err = keys.state.db.View(func(txn *badger.Txn) error {
+	err = keys.state.db.View(func(txn kv.Transaction) error {
 
 		// retrieve the end state, error on any storage error (including not found)
 		var endState flow.DKGEndState
@@ -14,13 +14,15 @@ import (
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestDKGState_DKGStarted(t *testing.T) {
 	unittest.RunWithTypedBadgerDB(t, bstorage.InitSecret, func(db *badger.DB) {
 		metrics := metrics.NewNoopCollector()
-		store, err := bstorage.NewDKGState(metrics, db)
+		store, err := bstorage.NewDKGState(metrics, badgerkv.New(db))
 		require.NoError(t, err)
 
 		epochCounter := rand.Uint64()
@@ -50,7 +52,7 @@ func TestDKGState_DKGStarted(t *testing.T) {
 func TestDKGState_BeaconKeys(t *testing.T) {
 	unittest.RunWithTypedBadgerDB(t, bstorage.InitSecret, func(db *badger.DB) {
 		metrics := metrics.NewNoopCollector()
-		store, err := bstorage.NewDKGState(metrics, db)
+		store, err := bstorage.NewDKGState(metrics, badgerkv.New(db))
 		require.NoError(t, err)
 
 		rand.Seed(time.Now().UnixNano())
@@ -93,7 +95,7 @@ func TestDKGState_BeaconKeys(t *testing.T) {
 func TestDKGState_EndState(t *testing.T) {
 	unittest.RunWithTypedBadgerDB(t, bstorage.InitSecret, func(db *badger.DB) {
 		metrics := metrics.NewNoopCollector()
-		store, err := bstorage.NewDKGState(metrics, db)
+		store, err := bstorage.NewDKGState(metrics, badgerkv.New(db))
 		require.NoError(t, err)
 
 		rand.Seed(time.Now().UnixNano())
@@ -113,10 +115,55 @@ func TestDKGState_EndState(t *testing.T) {
 	})
 }
 
+func TestDKGState_TranscriptSummary(t *testing.T) {
+	unittest.RunWithTypedBadgerDB(t, bstorage.InitSecret, func(db *badger.DB) {
+		metrics := metrics.NewNoopCollector()
+		store, err := bstorage.NewDKGState(metrics, badgerkv.New(db))
+		require.NoError(t, err)
+
+		rand.Seed(time.Now().UnixNano())
+		epochCounter := rand.Uint64()
+		summary := unittest.DKGTranscriptSummaryFixture(unittest.IdentityListFixture(4))
+
+		t.Run("should error if retrieving non-existent summary", func(t *testing.T) {
+			_, err := store.RetrieveDKGTranscriptSummary(epochCounter)
+			assert.True(t, errors.Is(err, storage.ErrNotFound))
+		})
+
+		t.Run("should be able to store and read a summary", func(t *testing.T) {
+			err := store.StoreDKGTranscriptSummary(epochCounter, summary)
+			require.NoError(t, err)
+
+			actual, err := store.RetrieveDKGTranscriptSummary(epochCounter)
+			require.NoError(t, err)
+			assertDKGTranscriptSummaryEqual(t, summary, actual)
+		})
+
+		t.Run("should be able to replace a summary", func(t *testing.T) {
+			summary.PhaseTransitions = append(summary.PhaseTransitions, flow.DKGPhaseTransition{Phase: "Phase2", Time: time.Now().UTC()})
+			err := store.StoreDKGTranscriptSummary(epochCounter, summary)
+			require.NoError(t, err)
+
+			actual, err := store.RetrieveDKGTranscriptSummary(epochCounter)
+			require.NoError(t, err)
+			assertDKGTranscriptSummaryEqual(t, summary, actual)
+		})
+	})
+}
+
+// assertDKGTranscriptSummaryEqual asserts that the summaries are equal, ignoring
+// the locations of the phase transition times, which are decoded as local times.
+func assertDKGTranscriptSummaryEqual(t *testing.T, expected, actual *flow.DKGTranscriptSummary) {
+	for i := range actual.PhaseTransitions {
+		actual.PhaseTransitions[i].Time = actual.PhaseTransitions[i].Time.UTC()
+	}
+	assert.Equal(t, expected, actual)
+}
+
 func TestSafeBeaconPrivateKeys(t *testing.T) {
 	unittest.RunWithTypedBadgerDB(t, bstorage.InitSecret, func(db *badger.DB) {
 		metrics := metrics.NewNoopCollector()
-		dkgState, err := bstorage.NewDKGState(metrics, db)
+		dkgState, err := bstorage.NewDKGState(metrics, badgerkv.New(db))
 		require.NoError(t, err)
 		safeKeys := bstorage.NewSafeBeaconPrivateKeys(dkgState)
 
@@ -182,7 +229,7 @@ func TestSafeBeaconPrivateKeys(t *testing.T) {
 // TestSecretDBRequirement tests that the DKGState constructor will return an
 // error if instantiated using a database not marked with the correct type.
 func TestSecretDBRequirement(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		_, err := bstorage.NewDKGState(metrics, db)
 		require.Error(t, err)
@@ -0,0 +1,111 @@
+package badger
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dgraph-io/badger/v2"
+)
+
+// Reencrypt changes the encryption key of the badger database in the given
+// directory. oldKey is the current key, nil if the database is not encrypted;
+// newKey is the new key, nil to decrypt the database. The database must not
+// be open.
+//
+// If both keys are set, only the master key is rotated: badger encrypts data
+// with data keys, which are themselves encrypted with the master key. Enabling
+// or disabling encryption requires copying all data into a new database, which
+// then replaces the existing one.
+func Reencrypt(dir string, oldKey, newKey []byte) error {
+	if len(oldKey) == 0 && len(newKey) == 0 {
+		return nil
+	}
+	if bytes.Equal(oldKey, newKey) {
+		return nil
+	}
+	if len(oldKey) > 0 && len(newKey) > 0 {
+		return rotateMasterKey(dir, oldKey, newKey)
+	}
+	return copyWithKey(dir, oldKey, newKey)
+}
+
+// rotateMasterKey re-encrypts the data keys in the key registry with the new key.
+func rotateMasterKey(dir string, oldKey, newKey []byte) error {
+
+	registry, err := badger.OpenKeyRegistry(badger.KeyRegistryOptions{
+		Dir:           dir,
+		ReadOnly:      true,
+		EncryptionKey: oldKey,
+	})
+	if err != nil {
+		return fmt.Errorf("could not open key registry: %w", err)
+	}
+	defer registry.Close()
+
+	err = badger.WriteKeyRegistry(registry, badger.KeyRegistryOptions{
+		Dir:           dir,
+		EncryptionKey: newKey,
+	})
+	if err != nil {
+		return fmt.Errorf("could not write key registry: %w", err)
+	}
+	return nil
+}
+
+// copyWithKey copies all data into a new database encrypted with the new key,
+// and replaces the existing database with it.
+func copyWithKey(dir string, oldKey, newKey []byte) error {
+
+	tmpDir := dir + ".reencrypt"
+	err := os.RemoveAll(tmpDir)
+	if err != nil {
+		return fmt.Errorf("could not remove temporary directory: %w", err)
+	}
+
+	src, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil).WithEncryptionKey(oldKey))
+	if err != nil {
+		return fmt.Errorf("could not open database: %w", err)
+	}
+	dst, err := badger.Open(badger.DefaultOptions(tmpDir).WithLogger(nil).WithEncryptionKey(newKey))
+	if err != nil {
+		_ = src.Close()
+		return fmt.Errorf("could not open temporary database: %w", err)
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		_, err := src.Backup(writer, 0)
+		_ = writer.CloseWithError(err)
+	}()
+	err = dst.Load(reader, 256)
+	_ = reader.CloseWithError(err)
+
+	srcErr := src.Close()
+	dstErr := dst.Close()
+	if err != nil {
+		return fmt.Errorf("could not copy database: %w", err)
+	}
+	if srcErr != nil {
+		return fmt.Errorf("could not close database: %w", srcErr)
+	}
+	if dstErr != nil {
+		return fmt.Errorf("could not close temporary database: %w", dstErr)
+	}
+
+	oldDir := dir + ".old"
+	err = os.Rename(dir, oldDir)
+	if err != nil {
+		return fmt.Errorf("could not move database: %w", err)
+	}
+	err = os.Rename(tmpDir, dir)
+	if err != nil {
+		return fmt.Errorf("could not replace database (original database at %s): %w", oldDir, err)
+	}
+	err = os.RemoveAll(oldDir)
+	if err != nil {
+		return fmt.Errorf("could not remove original database at %s: %w", oldDir, err)
+	}
+	return nil
+}
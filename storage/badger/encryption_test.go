@@ -0,0 +1,86 @@
+package badger_test
+
+import (
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestReencrypt checks that a database can be encrypted, that its key can be
+// rotated, and that it can be decrypted again, without losing data.
+func TestReencrypt(t *testing.T) {
+	unittest.RunWithTempDir(t, func(tmp string) {
+		dir := filepath.Join(tmp, "db")
+
+		key1 := make([]byte, 32)
+		_, err := rand.Read(key1)
+		require.NoError(t, err)
+		key2 := make([]byte, 32)
+		_, err = rand.Read(key2)
+		require.NoError(t, err)
+
+		open := func(key []byte) (*badgerkv.DB, error) {
+			db, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil).WithEncryptionKey(key))
+			if err != nil {
+				return nil, err
+			}
+			return badgerkv.New(db), nil
+		}
+
+		header := unittest.BlockHeaderFixture()
+		db, err := open(nil)
+		require.NoError(t, err)
+		require.NoError(t, db.Update(operation.InsertPublicDBMarker))
+		require.NoError(t, db.Update(operation.InsertHeader(header.ID(), &header)))
+		require.NoError(t, db.Close())
+
+		// checkContent opens the database with the given key and checks that
+		// the data is still there
+		checkContent := func(t *testing.T, key []byte) {
+			db, err := open(key)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			require.NoError(t, operation.EnsurePublicDB(db))
+			var retrieved flow.Header
+			require.NoError(t, db.View(operation.RetrieveHeader(header.ID(), &retrieved)))
+			assert.Equal(t, header.ID(), retrieved.ID())
+		}
+
+		t.Run("encrypt", func(t *testing.T) {
+			require.NoError(t, bstorage.Reencrypt(dir, nil, key1))
+			checkContent(t, key1)
+
+			_, err := open(nil)
+			assert.Error(t, err)
+		})
+
+		t.Run("rotate key", func(t *testing.T) {
+			require.NoError(t, bstorage.Reencrypt(dir, key1, key2))
+			checkContent(t, key2)
+
+			_, err := open(key1)
+			assert.Error(t, err)
+		})
+
+		t.Run("decrypt", func(t *testing.T) {
+			require.NoError(t, bstorage.Reencrypt(dir, key2, nil))
+			checkContent(t, nil)
+
+			_, err := open(key2)
+			assert.Error(t, err)
+		})
+	})
+}
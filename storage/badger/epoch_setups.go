@@ -1,22 +1,21 @@
 package badger
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type EpochSetups struct {
-	db    *badger.DB
+	db    kv.DB
 	cache *Cache
 }
 
 // NewEpochSetups instantiates a new EpochSetups storage.
-func NewEpochSetups(collector module.CacheMetrics, db *badger.DB) *EpochSetups {
+func NewEpochSetups(collector module.CacheMetrics, db kv.DB) *EpochSetups {
 
 	store := func(key interface{}, val interface{}) func(*transaction.Tx) error {
 		id := key.(flow.Identifier)
@@ -24,10 +23,10 @@ func NewEpochSetups(collector module.CacheMetrics, db *badger.DB) *EpochSetups {
 		return transaction.WithTx(operation.SkipDuplicates(operation.InsertEpochSetup(id, setup)))
 	}
 
-	retrieve := func(key interface{}) func(*badger.Txn) (interface{}, error) {
+	retrieve := func(key interface{}) func(kv.Transaction) (interface{}, error) {
 		id := key.(flow.Identifier)
 		var setup flow.EpochSetup
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := operation.RetrieveEpochSetup(id, &setup)(tx)
 			return &setup, err
 		}
@@ -48,8 +47,8 @@ func (es *EpochSetups) StoreTx(setup *flow.EpochSetup) func(tx *transaction.Tx)
 	return es.cache.PutTx(setup.ID(), setup)
 }
 
-func (es *EpochSetups) retrieveTx(setupID flow.Identifier) func(tx *badger.Txn) (*flow.EpochSetup, error) {
-	return func(tx *badger.Txn) (*flow.EpochSetup, error) {
+func (es *EpochSetups) retrieveTx(setupID flow.Identifier) func(tx kv.Transaction) (*flow.EpochSetup, error) {
+	return func(tx kv.Transaction) (*flow.EpochSetup, error) {
 		val, err := es.cache.Get(setupID)(tx)
 		if err != nil {
 			return nil, err
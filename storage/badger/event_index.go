@@ -0,0 +1,134 @@
+package badger
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// EventIndex implements the secondary event indexes of Access nodes. Events are stored alongside
+// the index entries, so that the index can be queried without contacting execution nodes.
+type EventIndex struct {
+	db kv.DB
+}
+
+func NewEventIndex(db kv.DB) *EventIndex {
+	return &EventIndex{
+		db: db,
+	}
+}
+
+// Store persists the events of the given sealed block and indexes them by event type and by the
+// address of the emitting contract. As all writes are blind, storing the same block twice is a no-op.
+func (e *EventIndex) Store(header *flow.Header, events []flow.Event) error {
+	blockID := header.ID()
+	batch := NewBatch(e.db)
+	writer := batch.GetWriter()
+
+	for _, event := range events {
+		err := operation.BatchInsertEvent(blockID, event)(writer)
+		if err != nil {
+			return fmt.Errorf("could not insert event: %w", err)
+		}
+
+		locator := flow.EventLocator{
+			Type:             event.Type,
+			BlockID:          blockID,
+			Height:           header.Height,
+			TransactionID:    event.TransactionID,
+			TransactionIndex: event.TransactionIndex,
+			EventIndex:       event.EventIndex,
+		}
+		err = operation.BatchIndexEventByType(locator)(writer)
+		if err != nil {
+			return fmt.Errorf("could not index event by type: %w", err)
+		}
+
+		address, ok := event.Type.Address()
+		if !ok {
+			continue
+		}
+		err = operation.BatchIndexEventByAddress(address, locator)(writer)
+		if err != nil {
+			return fmt.Errorf("could not index event by address: %w", err)
+		}
+	}
+
+	err := batch.Flush()
+	if err != nil {
+		return fmt.Errorf("could not flush event index batch: %w", err)
+	}
+	return nil
+}
+
+// ByTypes returns at most limit locators of events matching any of the given event types, ordered
+// by position, starting at the given position (inclusive) up to and including the end height.
+// Wildcard types are served from the address index if they name an account, and from a traversal
+// of the type index otherwise.
+func (e *EventIndex) ByTypes(types []flow.EventType, from flow.EventPosition, endHeight uint64, limit uint) ([]flow.EventLocator, error) {
+	if from.Height > endHeight || limit == 0 {
+		return nil, nil
+	}
+
+	var locators []flow.EventLocator
+	err := e.db.View(func(tx kv.Transaction) error {
+		for _, eventType := range types {
+			if !flow.IsWildcardEventType(eventType) {
+				err := operation.LookupEventLocatorsByType(eventType, from, endHeight, limit, &locators)(tx)
+				if err != nil {
+					return fmt.Errorf("could not lookup events of type %s: %w", eventType, err)
+				}
+				continue
+			}
+
+			prefix := flow.EventTypePrefix(eventType)
+			address, ok := eventType.Address()
+			if ok {
+				err := operation.LookupEventLocatorsByAddress(address, prefix, from, endHeight, limit, &locators)(tx)
+				if err != nil {
+					return fmt.Errorf("could not lookup events of address %s: %w", address, err)
+				}
+				continue
+			}
+
+			err := operation.LookupEventLocatorsByTypePrefix(prefix, from, endHeight, limit, &locators)(tx)
+			if err != nil {
+				return fmt.Errorf("could not lookup events with type prefix %s: %w", prefix, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// merge the results of the individual lookups, removing events matched by several types
+	sort.Slice(locators, func(i, j int) bool {
+		return locators[i].Position().Less(locators[j].Position())
+	})
+	merged := make([]flow.EventLocator, 0, len(locators))
+	for i, locator := range locators {
+		if i > 0 && locator.Position() == locators[i-1].Position() {
+			continue
+		}
+		merged = append(merged, locator)
+	}
+	if uint(len(merged)) > limit {
+		merged = merged[:limit]
+	}
+
+	return merged, nil
+}
+
+// ByLocator returns the event pointed to by the given locator.
+func (e *EventIndex) ByLocator(locator flow.EventLocator) (*flow.Event, error) {
+	var event flow.Event
+	err := e.db.View(operation.RetrieveEvent(locator.BlockID, locator.TransactionID, locator.TransactionIndex, locator.EventIndex, &event))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve event: %w", err)
+	}
+	return &event, nil
+}
@@ -0,0 +1,80 @@
+package badger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestEventIndexStoreAndQuery(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+		index := badgerstorage.NewEventIndex(db)
+
+		address := unittest.AddressFixture()
+		deposit := flow.EventType("A." + address.Hex() + ".FlowToken.TokensDeposited")
+		withdraw := flow.EventType("A." + address.Hex() + ".FlowToken.TokensWithdrawn")
+
+		var headers []*flow.Header
+		for height := uint64(1); height <= 5; height++ {
+			header := unittest.BlockHeaderFixture()
+			header.Height = height
+			headers = append(headers, &header)
+
+			txID := unittest.IdentifierFixture()
+			events := []flow.Event{
+				unittest.EventFixture(deposit, 0, 0, txID, 0),
+				unittest.EventFixture(withdraw, 0, 1, txID, 0),
+				unittest.EventFixture(flow.EventAccountCreated, 0, 2, txID, 0),
+			}
+			require.NoError(t, index.Store(&header, events))
+			// storing the same block twice must not fail
+			require.NoError(t, index.Store(&header, events))
+		}
+
+		t.Run("exact and wildcard types are merged without duplicates", func(t *testing.T) {
+			locators, err := index.ByTypes(
+				[]flow.EventType{deposit, flow.EventType("A." + address.Hex() + ".*")},
+				flow.EventPosition{Height: 2},
+				3,
+				100,
+			)
+			require.NoError(t, err)
+			require.Len(t, locators, 4)
+			for i := 1; i < len(locators); i++ {
+				require.True(t, locators[i-1].Position().Less(locators[i].Position()))
+			}
+			require.Equal(t, headers[1].ID(), locators[0].BlockID)
+		})
+
+		t.Run("pagination", func(t *testing.T) {
+			var all []flow.EventLocator
+			from := flow.EventPosition{Height: 1}
+			for {
+				page, err := index.ByTypes([]flow.EventType{deposit, flow.EventAccountCreated}, from, 5, 3)
+				require.NoError(t, err)
+				if len(page) == 0 {
+					break
+				}
+				all = append(all, page...)
+				from = page[len(page)-1].Position().Next()
+			}
+			require.Len(t, all, 10)
+		})
+
+		t.Run("retrieve by locator", func(t *testing.T) {
+			locators, err := index.ByTypes([]flow.EventType{"flow.*"}, flow.EventPosition{Height: 5}, 5, 1)
+			require.NoError(t, err)
+			require.Len(t, locators, 1)
+
+			event, err := index.ByLocator(locators[0])
+			require.NoError(t, err)
+			require.Equal(t, flow.EventAccountCreated, event.Type)
+			require.Equal(t, locators[0].TransactionID, event.TransactionID)
+		})
+	})
+}
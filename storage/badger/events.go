@@ -3,25 +3,24 @@ package badger
 import (
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type Events struct {
-	db    *badger.DB
+	db    kv.DB
 	cache *Cache
 }
 
-func NewEvents(collector module.CacheMetrics, db *badger.DB) *Events {
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+func NewEvents(collector module.CacheMetrics, db kv.DB) *Events {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		blockID := key.(flow.Identifier)
 		var events []flow.Event
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := operation.LookupEventsByBlockID(blockID, &events)(tx)
 			return events, handleError(err, flow.Event{})
 		}
@@ -125,15 +124,15 @@ func (e *Events) ByBlockIDEventType(blockID flow.Identifier, eventType flow.Even
 }
 
 type ServiceEvents struct {
-	db    *badger.DB
+	db    kv.DB
 	cache *Cache
 }
 
-func NewServiceEvents(collector module.CacheMetrics, db *badger.DB) *ServiceEvents {
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+func NewServiceEvents(collector module.CacheMetrics, db kv.DB) *ServiceEvents {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		blockID := key.(flow.Identifier)
 		var events []flow.Event
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			err := operation.LookupServiceEventsByBlockID(blockID, &events)(tx)
 			return events, handleError(err, flow.Event{})
 		}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/onflow/flow-go/storage/badger/operation"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/module/metrics"
@@ -14,10 +13,11 @@ import (
 	"github.com/onflow/flow-go/utils/unittest"
 
 	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 func TestHeaderStoreRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		headers := badgerstorage.NewHeaders(metrics, db)
 
@@ -39,7 +39,7 @@ func TestHeaderStoreRetrieve(t *testing.T) {
 }
 
 func TestHeaderRetrieveWithoutStore(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		headers := badgerstorage.NewHeaders(metrics, db)
 
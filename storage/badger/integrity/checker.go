@@ -0,0 +1,582 @@
+// Package integrity checks the consistency of the protocol database.
+package integrity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// Severity is the severity of a problem found in the protocol database.
+type Severity int
+
+const (
+	// SeverityWarning is a problem with a derived index, which can be rebuilt
+	// from the primary data.
+	SeverityWarning Severity = iota + 1
+	// SeverityError is missing or inconsistent primary data of a block.
+	SeverityError
+	// SeverityCritical is a problem which prevents checking the rest of the
+	// database, like a missing finalized height.
+	SeverityCritical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// Problem is an inconsistency found in the protocol database.
+type Problem struct {
+	Severity Severity
+	Height   uint64
+	BlockID  flow.Identifier // zero if the problem doesn't concern a block
+	Message  string
+	// Rebuilt is set if the problem was fixed by rebuilding a derived index.
+	Rebuilt bool
+}
+
+// Report is the result of checking the protocol database.
+type Report struct {
+	RootHeight      uint64
+	FinalizedHeight uint64
+	SealedHeight    uint64
+	Blocks          int // number of finalized blocks checked
+	Problems        []*Problem
+}
+
+// Count returns the number of problems of the given severity, which were not
+// rebuilt.
+func (r *Report) Count(severity Severity) int {
+	count := 0
+	for _, problem := range r.Problems {
+		if problem.Severity == severity && !problem.Rebuilt {
+			count++
+		}
+	}
+	return count
+}
+
+// checker walks the finalized chain of the protocol database. Each block is
+// read in its own read-only transaction; rebuilds of derived indexes found
+// while reading a block are applied in a separate transaction afterwards.
+type checker struct {
+	log     zerolog.Logger
+	db      kv.DB
+	rebuild bool
+	report  *Report
+	pending []rebuild
+
+	// counters of the epoch setup and commit events checked so far, by ID
+	setups  map[flow.Identifier]uint64
+	commits map[flow.Identifier]uint64
+}
+
+type rebuild struct {
+	problem *Problem
+	op      func(kv.Transaction) error
+}
+
+// Check checks the consistency of the protocol database: that the finalized
+// and sealed heights are consistent, that the height index matches the parent
+// links of the finalized blocks down to the root block, that the payloads,
+// seals and results referenced by each finalized block resolve, and that the
+// epoch statuses and the setup and commit events they reference are
+// consistent. If rebuild is set, problems with derived indexes are fixed.
+// An error is only returned if the database could not be read or written;
+// inconsistencies are listed in the report.
+func Check(log zerolog.Logger, db kv.DB, rebuild bool) (*Report, error) {
+	c := &checker{
+		log:     log.With().Str("component", "integrity_checker").Logger(),
+		db:      db,
+		rebuild: rebuild,
+		report:  &Report{},
+		setups:  make(map[flow.Identifier]uint64),
+		commits: make(map[flow.Identifier]uint64),
+	}
+
+	err := c.check()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.report, nil
+}
+
+func (c *checker) check() error {
+
+	var root, final, sealed uint64
+	var finalID flow.Identifier
+	ok := true
+	err := c.db.View(func(tx kv.Transaction) error {
+		ok = c.read(tx, operation.RetrieveRootHeight(&root), SeverityCritical, 0, flow.ZeroID, "root height") && ok
+		ok = c.read(tx, operation.RetrieveFinalizedHeight(&final), SeverityCritical, 0, flow.ZeroID, "finalized height") && ok
+		ok = c.read(tx, operation.RetrieveSealedHeight(&sealed), SeverityCritical, 0, flow.ZeroID, "sealed height") && ok
+		if !ok {
+			return nil
+		}
+		ok = c.read(tx, operation.LookupBlockHeight(final, &finalID), SeverityCritical, final, flow.ZeroID, "finalized block height index")
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not read heights: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	c.report.RootHeight = root
+	c.report.FinalizedHeight = final
+	c.report.SealedHeight = sealed
+
+	if final < root || sealed > final {
+		c.add(SeverityCritical, 0, flow.ZeroID, "inconsistent heights: root %d, finalized %d, sealed %d", root, final, sealed)
+		return nil
+	}
+
+	err = c.checkSealedHeight(finalID, sealed)
+	if err != nil {
+		return err
+	}
+
+	err = c.checkDanglingHeights(final)
+	if err != nil {
+		return err
+	}
+
+	// walk the finalized chain from the finalized block back to the root block,
+	// following the parent links
+	var child *block
+	blockID := finalID
+	for height := final; ; height-- {
+		var current *block
+		err = c.db.View(func(tx kv.Transaction) error {
+			current = c.checkBlock(tx, height, blockID, height == root, child)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not check block at height %d: %w", height, err)
+		}
+		err = c.applyRebuilds()
+		if err != nil {
+			return err
+		}
+
+		c.report.Blocks++
+		if c.report.Blocks%100_000 == 0 {
+			c.log.Info().Uint64("height", height).Int("blocks", c.report.Blocks).Msg("checked blocks")
+		}
+
+		if height == root {
+			return nil
+		}
+		if current.parentID == flow.ZeroID {
+			c.add(SeverityCritical, height, blockID, "finalized chain is broken, could not find parent of block")
+			return nil
+		}
+
+		child = current
+		blockID = current.parentID
+	}
+}
+
+// block is the state of a checked block needed to check its parent.
+type block struct {
+	height   uint64
+	id       flow.Identifier
+	parentID flow.Identifier
+	status   *flow.EpochStatus
+}
+
+// checkBlock checks the finalized block at the given height, and returns the
+// state needed to check its parent. If the header of the block is missing,
+// the parent is looked up in the height index instead.
+func (c *checker) checkBlock(tx kv.Transaction, height uint64, blockID flow.Identifier, isRoot bool, child *block) *block {
+
+	current := &block{
+		height: height,
+		id:     blockID,
+	}
+
+	// the height index of the finalized block was used to find it
+	if child != nil {
+		var indexedID flow.Identifier
+		err := operation.LookupBlockHeight(height, &indexedID)(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			c.addRebuild(operation.IndexBlockHeight(height, blockID), SeverityWarning, height, blockID,
+				"finalized block is not indexed by height")
+		} else if err != nil {
+			c.add(SeverityWarning, height, blockID, "could not read height index: %v", err)
+		} else if indexedID != blockID {
+			c.addRebuild(operation.ReindexBlockHeight(height, blockID), SeverityWarning, height, blockID,
+				"height index references block %x instead of the finalized block", indexedID)
+		}
+	}
+
+	var header flow.Header
+	if !c.read(tx, operation.RetrieveHeader(blockID, &header), SeverityError, height, blockID, "header") {
+		if !isRoot {
+			var parentID flow.Identifier
+			err := operation.LookupBlockHeight(height-1, &parentID)(tx)
+			if err == nil {
+				current.parentID = parentID
+			}
+		}
+		return current
+	}
+	if header.Height != height {
+		c.add(SeverityError, height, blockID, "header has height %d", header.Height)
+	}
+
+	// the root block has no parent in the database
+	if !isRoot {
+		current.parentID = header.ParentID
+		c.checkChildren(tx, header.ParentID, height, blockID)
+	}
+
+	c.checkPayload(tx, &header)
+
+	var sealID flow.Identifier
+	c.read(tx, operation.LookupBlockSeal(blockID, &sealID), SeverityError, height, blockID, "latest seal index")
+
+	var status flow.EpochStatus
+	if c.read(tx, operation.RetrieveEpochStatus(blockID, &status), SeverityError, height, blockID, "epoch status") {
+		if c.checkEpochStatus(tx, height, blockID, &status) {
+			current.status = &status
+			if child != nil && child.status != nil {
+				c.checkEpochTransition(&status, child)
+			}
+		}
+	}
+
+	return current
+}
+
+// checkSealedHeight checks that the latest seal as of the finalized block seals
+// the finalized block at the sealed height.
+func (c *checker) checkSealedHeight(finalID flow.Identifier, sealed uint64) error {
+	return c.db.View(func(tx kv.Transaction) error {
+		var sealID flow.Identifier
+		if !c.read(tx, operation.LookupBlockSeal(finalID, &sealID), SeverityError, c.report.FinalizedHeight, finalID, "latest seal index") {
+			return nil
+		}
+		var seal flow.Seal
+		if !c.read(tx, operation.RetrieveSeal(sealID, &seal), SeverityError, c.report.FinalizedHeight, finalID, "latest seal") {
+			return nil
+		}
+		var sealedHeader flow.Header
+		if !c.read(tx, operation.RetrieveHeader(seal.BlockID, &sealedHeader), SeverityError, sealed, seal.BlockID, "sealed header") {
+			return nil
+		}
+		if sealedHeader.Height != sealed {
+			c.add(SeverityError, sealed, seal.BlockID, "latest sealed block has height %d", sealedHeader.Height)
+			return nil
+		}
+		var indexedID flow.Identifier
+		err := operation.LookupBlockHeight(sealed, &indexedID)(tx)
+		if err == nil && indexedID != seal.BlockID {
+			c.add(SeverityError, sealed, seal.BlockID, "latest sealed block is not finalized, height index references block %x", indexedID)
+		}
+		return nil
+	})
+}
+
+// checkDanglingHeights checks that no blocks are indexed above the finalized
+// height.
+func (c *checker) checkDanglingHeights(final uint64) error {
+	for height := final + 1; ; height++ {
+		var blockID flow.Identifier
+		err := c.db.View(operation.LookupBlockHeight(height, &blockID))
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read height index at height %d: %w", height, err)
+		}
+		c.addRebuild(operation.RemoveBlockHeight(height), SeverityWarning, height, blockID,
+			"block is indexed above the finalized height")
+		err = c.applyRebuilds()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// checkChildren checks that the block is indexed as a child of its parent.
+func (c *checker) checkChildren(tx kv.Transaction, parentID flow.Identifier, height uint64, blockID flow.Identifier) {
+	var childrenIDs []flow.Identifier
+	err := operation.RetrieveBlockChildren(parentID, &childrenIDs)(tx)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.addRebuild(operation.InsertBlockChildren(parentID, []flow.Identifier{blockID}), SeverityWarning, height, blockID,
+			"parent has no children index")
+		return
+	}
+	if err != nil {
+		c.add(SeverityWarning, height, blockID, "could not read children index of parent: %v", err)
+		return
+	}
+	for _, childID := range childrenIDs {
+		if childID == blockID {
+			return
+		}
+	}
+	c.addRebuild(operation.UpdateBlockChildren(parentID, append(childrenIDs, blockID)), SeverityWarning, height, blockID,
+		"block is missing in the children index of its parent")
+}
+
+// checkPayload checks that the payload entities of the block resolve, and
+// that they match the payload hash of the header.
+func (c *checker) checkPayload(tx kv.Transaction, header *flow.Header) {
+	height := header.Height
+	blockID := header.ID()
+
+	var guaranteeIDs, sealIDs, receiptIDs, resultIDs []flow.Identifier
+	ok := c.read(tx, operation.LookupPayloadGuarantees(blockID, &guaranteeIDs), SeverityError, height, blockID, "payload guarantees index")
+	ok = c.read(tx, operation.LookupPayloadSeals(blockID, &sealIDs), SeverityError, height, blockID, "payload seals index") && ok
+	ok = c.read(tx, operation.LookupPayloadReceipts(blockID, &receiptIDs), SeverityError, height, blockID, "payload receipts index") && ok
+	ok = c.read(tx, operation.LookupPayloadResults(blockID, &resultIDs), SeverityError, height, blockID, "payload results index") && ok
+
+	if ok {
+		payloadHash := flow.ConcatSum(
+			flow.MerkleRoot(guaranteeIDs...),
+			flow.MerkleRoot(sealIDs...),
+			flow.MerkleRoot(receiptIDs...),
+			flow.MerkleRoot(resultIDs...),
+		)
+		if payloadHash != header.PayloadHash {
+			c.add(SeverityError, height, blockID, "payload indexes don't match the payload hash of the header")
+		}
+	}
+
+	for _, guaranteeID := range guaranteeIDs {
+		var guarantee flow.CollectionGuarantee
+		if c.read(tx, operation.RetrieveGuarantee(guaranteeID, &guarantee), SeverityError, height, blockID, "guarantee "+guaranteeID.String()) &&
+			guarantee.ID() != guaranteeID {
+			c.add(SeverityError, height, blockID, "guarantee %x is stored with ID %x", guarantee.ID(), guaranteeID)
+		}
+	}
+
+	for _, sealID := range sealIDs {
+		var seal flow.Seal
+		if !c.read(tx, operation.RetrieveSeal(sealID, &seal), SeverityError, height, blockID, "seal "+sealID.String()) {
+			continue
+		}
+		if seal.ID() != sealID {
+			c.add(SeverityError, height, blockID, "seal %x is stored with ID %x", seal.ID(), sealID)
+			continue
+		}
+		c.checkSeal(tx, height, blockID, &seal)
+	}
+
+	for _, receiptID := range receiptIDs {
+		var meta flow.ExecutionReceiptMeta
+		if c.read(tx, operation.RetrieveExecutionReceiptMeta(receiptID, &meta), SeverityError, height, blockID, "receipt "+receiptID.String()) &&
+			meta.ID() != receiptID {
+			c.add(SeverityError, height, blockID, "receipt %x is stored with ID %x", meta.ID(), receiptID)
+		}
+	}
+
+	for _, resultID := range resultIDs {
+		var result flow.ExecutionResult
+		if c.read(tx, operation.RetrieveExecutionResult(resultID, &result), SeverityError, height, blockID, "result "+resultID.String()) &&
+			result.ID() != resultID {
+			c.add(SeverityError, height, blockID, "result %x is stored with ID %x", result.ID(), resultID)
+		}
+	}
+}
+
+// checkSeal checks that the sealed block and the sealed result of a seal
+// included in the block resolve, and that the result is indexed for the
+// sealed block.
+func (c *checker) checkSeal(tx kv.Transaction, height uint64, blockID flow.Identifier, seal *flow.Seal) {
+
+	var sealedHeader flow.Header
+	c.read(tx, operation.RetrieveHeader(seal.BlockID, &sealedHeader), SeverityError, height, blockID, "sealed block "+seal.BlockID.String())
+
+	var result flow.ExecutionResult
+	if c.read(tx, operation.RetrieveExecutionResult(seal.ResultID, &result), SeverityError, height, blockID, "sealed result "+seal.ResultID.String()) &&
+		result.BlockID != seal.BlockID {
+		c.add(SeverityError, height, blockID, "sealed result %x is for block %x instead of sealed block %x", seal.ResultID, result.BlockID, seal.BlockID)
+	}
+
+	var indexedID flow.Identifier
+	err := operation.LookupExecutionResult(seal.BlockID, &indexedID)(tx)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.addRebuild(operation.IndexExecutionResult(seal.BlockID, seal.ResultID), SeverityWarning, height, blockID,
+			"sealed result %x is not indexed for sealed block %x", seal.ResultID, seal.BlockID)
+	} else if err != nil {
+		c.add(SeverityWarning, height, blockID, "could not read result index of sealed block %x: %v", seal.BlockID, err)
+	} else if indexedID != seal.ResultID {
+		c.add(SeverityError, height, blockID, "result %x is indexed for sealed block %x instead of sealed result %x", indexedID, seal.BlockID, seal.ResultID)
+	}
+}
+
+// checkEpochStatus checks that the epoch status is well-formed, and that the
+// epoch setup and commit events it references resolve and have consistent
+// counters. It returns false if the status can't be used to check the epoch
+// transition to the child block.
+func (c *checker) checkEpochStatus(tx kv.Transaction, height uint64, blockID flow.Identifier, status *flow.EpochStatus) bool {
+
+	err := status.Check()
+	if err != nil {
+		c.add(SeverityError, height, blockID, "invalid epoch status: %v", err)
+		return false
+	}
+
+	previous, okPrevious := c.epochCounter(tx, height, blockID, "previous", status.PreviousEpoch)
+	current, okCurrent := c.epochCounter(tx, height, blockID, "current", status.CurrentEpoch)
+	next, okNext := c.epochCounter(tx, height, blockID, "next", status.NextEpoch)
+	if !okPrevious || !okCurrent || !okNext {
+		return false
+	}
+
+	if status.PreviousEpoch.SetupID != flow.ZeroID && previous+1 != current {
+		c.add(SeverityError, height, blockID, "previous epoch counter %d doesn't precede current epoch counter %d", previous, current)
+	}
+	if status.NextEpoch.SetupID != flow.ZeroID && current+1 != next {
+		c.add(SeverityError, height, blockID, "next epoch counter %d doesn't follow current epoch counter %d", next, current)
+	}
+
+	return true
+}
+
+// epochCounter returns the counter of the epoch with the given setup and
+// commit events, which are checked to resolve and to have the same counter.
+// Events with a zero ID are not set and are skipped.
+func (c *checker) epochCounter(tx kv.Transaction, height uint64, blockID flow.Identifier, epoch string, events flow.EventIDs) (uint64, bool) {
+
+	if events.SetupID == flow.ZeroID {
+		return 0, true
+	}
+
+	setupCounter, ok := c.setups[events.SetupID]
+	if !ok {
+		var setup flow.EpochSetup
+		if !c.read(tx, operation.RetrieveEpochSetup(events.SetupID, &setup), SeverityError, height, blockID, epoch+" epoch setup") {
+			return 0, false
+		}
+		setupCounter = setup.Counter
+		c.setups[events.SetupID] = setupCounter
+	}
+
+	if events.CommitID == flow.ZeroID {
+		return setupCounter, true
+	}
+
+	commitCounter, ok := c.commits[events.CommitID]
+	if !ok {
+		var commit flow.EpochCommit
+		if !c.read(tx, operation.RetrieveEpochCommit(events.CommitID, &commit), SeverityError, height, blockID, epoch+" epoch commit") {
+			return 0, false
+		}
+		commitCounter = commit.Counter
+		c.commits[events.CommitID] = commitCounter
+	}
+
+	if commitCounter != setupCounter {
+		c.add(SeverityError, height, blockID, "%s epoch commit counter %d doesn't match setup counter %d", epoch, commitCounter, setupCounter)
+		return 0, false
+	}
+
+	return setupCounter, true
+}
+
+// checkEpochTransition checks that the epoch status of the child block follows
+// from the epoch status of its parent: either the child is in the same epoch,
+// and keeps the events of the previous and next epochs of its parent, or the
+// child is in the next epoch of its parent.
+func (c *checker) checkEpochTransition(parent *flow.EpochStatus, child *block) {
+
+	status := child.status
+	if status.CurrentEpoch == parent.CurrentEpoch {
+		if status.PreviousEpoch != parent.PreviousEpoch {
+			c.add(SeverityError, child.height, child.id, "previous epoch changed within the current epoch")
+		}
+		if parent.NextEpoch.SetupID != flow.ZeroID && status.NextEpoch.SetupID != parent.NextEpoch.SetupID {
+			c.add(SeverityError, child.height, child.id, "next epoch setup changed within the current epoch")
+		}
+		if parent.NextEpoch.CommitID != flow.ZeroID && status.NextEpoch.CommitID != parent.NextEpoch.CommitID {
+			c.add(SeverityError, child.height, child.id, "next epoch commit changed within the current epoch")
+		}
+		return
+	}
+
+	if status.CurrentEpoch != parent.NextEpoch || status.PreviousEpoch != parent.CurrentEpoch {
+		c.add(SeverityError, child.height, child.id, "current epoch is not the next epoch of the parent")
+	}
+}
+
+// read runs the given retrieval operation, and adds a problem with the given
+// severity if the entity is missing or can't be decoded.
+func (c *checker) read(tx kv.Transaction, op func(kv.Transaction) error, severity Severity, height uint64, blockID flow.Identifier, what string) bool {
+	err := op(tx)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.add(severity, height, blockID, "missing %s", what)
+		return false
+	}
+	if err != nil {
+		c.add(severity, height, blockID, "could not read %s: %v", what, err)
+		return false
+	}
+	return true
+}
+
+func (c *checker) add(severity Severity, height uint64, blockID flow.Identifier, msg string, args ...interface{}) *Problem {
+	problem := &Problem{
+		Severity: severity,
+		Height:   height,
+		BlockID:  blockID,
+		Message:  fmt.Sprintf(msg, args...),
+	}
+	c.report.Problems = append(c.report.Problems, problem)
+	return problem
+}
+
+// addRebuild adds a problem which is fixed by running the given operation when
+// rebuilding derived indexes.
+func (c *checker) addRebuild(op func(kv.Transaction) error, severity Severity, height uint64, blockID flow.Identifier, msg string, args ...interface{}) {
+	problem := c.add(severity, height, blockID, msg, args...)
+	if c.rebuild {
+		c.pending = append(c.pending, rebuild{problem: problem, op: op})
+	}
+}
+
+// applyRebuilds applies the pending rebuilds in a single transaction.
+func (c *checker) applyRebuilds() error {
+	if len(c.pending) == 0 {
+		return nil
+	}
+
+	err := c.db.Update(func(tx kv.Transaction) error {
+		for _, rebuild := range c.pending {
+			err := rebuild.op(tx)
+			if err != nil {
+				return fmt.Errorf("could not rebuild index for block %x at height %d: %w", rebuild.problem.BlockID, rebuild.problem.Height, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, rebuild := range c.pending {
+		rebuild.problem.Rebuilt = true
+	}
+	c.pending = nil
+	return nil
+}
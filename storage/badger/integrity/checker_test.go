@@ -0,0 +1,306 @@
+package integrity_test
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/integrity"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+const rootHeight = 10
+
+// chain is a protocol database with a root block at height 10, which is sealed
+// by the root seal, and finalized blocks up to height 13. The block at height
+// 12 seals the block at height 11.
+type chain struct {
+	headers []*flow.Header // by height, starting at the root height
+	status  *flow.EpochStatus
+}
+
+func (c *chain) id(height uint64) flow.Identifier {
+	return c.headers[height-rootHeight].ID()
+}
+
+// bootstrapChain writes a consistent chain to the database, except for the
+// entities for which omit returns true.
+func bootstrapChain(t *testing.T, db kv.DB, omit func(height uint64, entity string) bool) *chain {
+	c := &chain{}
+
+	setup := &flow.EpochSetup{Counter: 1, FinalView: 1000}
+	commit := unittest.EpochCommitFixture(unittest.CommitWithCounter(1))
+	c.status = &flow.EpochStatus{
+		CurrentEpoch: flow.EventIDs{SetupID: setup.ID(), CommitID: commit.ID()},
+	}
+
+	err := db.Update(func(tx kv.Transaction) error {
+		insert := func(height uint64, entity string, op func(kv.Transaction) error) {
+			if omit == nil || !omit(height, entity) {
+				require.NoError(t, op(tx))
+			}
+		}
+
+		insert(rootHeight, "setup", operation.InsertEpochSetup(setup.ID(), setup))
+		insert(rootHeight, "commit", operation.InsertEpochCommit(commit.ID(), commit))
+
+		parentID := unittest.IdentifierFixture()
+		var latestSeal *flow.Seal
+		for height := uint64(rootHeight); height <= rootHeight+3; height++ {
+			payload := flow.EmptyPayload()
+			var result *flow.ExecutionResult
+			if height == rootHeight+2 {
+				result = &flow.ExecutionResult{BlockID: parentID, PreviousResultID: unittest.IdentifierFixture()}
+				latestSeal = &flow.Seal{BlockID: parentID, ResultID: result.ID()}
+				payload.Seals = []*flow.Seal{latestSeal}
+			}
+
+			header := &flow.Header{
+				ChainID:     flow.Emulator,
+				ParentID:    parentID,
+				Height:      height,
+				View:        height,
+				PayloadHash: payload.Hash(),
+			}
+			blockID := header.ID()
+
+			// the root seal seals the root block
+			if height == rootHeight {
+				result = &flow.ExecutionResult{BlockID: blockID, PreviousResultID: unittest.IdentifierFixture()}
+				latestSeal = &flow.Seal{BlockID: blockID, ResultID: result.ID()}
+			}
+			if result != nil {
+				insert(height, "result", operation.InsertExecutionResult(result))
+				insert(height, "result index", operation.IndexExecutionResult(result.BlockID, result.ID()))
+				insert(height, "seal", operation.InsertSeal(latestSeal.ID(), latestSeal))
+			}
+
+			insert(height, "header", operation.InsertHeader(blockID, header))
+			insert(height, "height index", operation.IndexBlockHeight(height, blockID))
+			insert(height, "guarantees", operation.IndexPayloadGuarantees(blockID, nil))
+			insert(height, "seals", operation.IndexPayloadSeals(blockID, flow.GetIDs(payload.Seals)))
+			insert(height, "receipts", operation.IndexPayloadReceipts(blockID, nil))
+			insert(height, "results", operation.IndexPayloadResults(blockID, nil))
+			insert(height, "latest seal", operation.IndexBlockSeal(blockID, latestSeal.ID()))
+			insert(height, "status", operation.InsertEpochStatus(blockID, c.status))
+			if height > rootHeight {
+				insert(height, "children", operation.InsertBlockChildren(parentID, []flow.Identifier{blockID}))
+			}
+
+			c.headers = append(c.headers, header)
+			parentID = blockID
+		}
+
+		insert(rootHeight, "root height", operation.InsertRootHeight(rootHeight))
+		insert(rootHeight, "finalized height", operation.InsertFinalizedHeight(rootHeight+3))
+		insert(rootHeight, "sealed height", operation.InsertSealedHeight(rootHeight+1))
+		return nil
+	})
+	require.NoError(t, err)
+
+	return c
+}
+
+// omit returns a function omitting the given entity at the given height.
+func omit(height uint64, entity string) func(uint64, string) bool {
+	return func(h uint64, e string) bool {
+		return h == height && e == entity
+	}
+}
+
+func check(t *testing.T, db kv.DB, rebuild bool) *integrity.Report {
+	report, err := integrity.Check(zerolog.Nop(), db, rebuild)
+	require.NoError(t, err)
+	return report
+}
+
+func TestCheck(t *testing.T) {
+
+	t.Run("consistent database", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			bootstrapChain(t, db, nil)
+
+			report := check(t, db, false)
+			assert.Empty(t, report.Problems)
+			assert.Equal(t, uint64(rootHeight), report.RootHeight)
+			assert.Equal(t, uint64(rootHeight+3), report.FinalizedHeight)
+			assert.Equal(t, uint64(rootHeight+1), report.SealedHeight)
+			assert.Equal(t, 4, report.Blocks)
+		})
+	})
+
+	t.Run("empty database", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			report := check(t, db, false)
+			assert.Equal(t, 3, report.Count(integrity.SeverityCritical))
+			assert.Equal(t, 0, report.Blocks)
+		})
+	})
+
+	t.Run("sealed height above finalized height", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			bootstrapChain(t, db, nil)
+			require.NoError(t, db.Update(operation.UpdateSealedHeight(rootHeight+4)))
+
+			report := check(t, db, false)
+			require.Len(t, report.Problems, 1)
+			assert.Equal(t, integrity.SeverityCritical, report.Problems[0].Severity)
+			assert.Equal(t, 0, report.Blocks)
+		})
+	})
+
+	t.Run("sealed height doesn't match latest seal", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			bootstrapChain(t, db, nil)
+			require.NoError(t, db.Update(operation.UpdateSealedHeight(rootHeight+2)))
+
+			report := check(t, db, false)
+			require.Len(t, report.Problems, 1)
+			assert.Equal(t, integrity.SeverityError, report.Problems[0].Severity)
+			assert.Equal(t, 4, report.Blocks)
+		})
+	})
+
+	t.Run("missing seal", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			c := bootstrapChain(t, db, omit(rootHeight+2, "seal"))
+
+			// the latest seal as of the finalized block, and the seal in the
+			// payload of the block at height 12 are missing
+			report := check(t, db, true)
+			require.Len(t, report.Problems, 2)
+			assert.Equal(t, 2, report.Count(integrity.SeverityError))
+			assert.Equal(t, uint64(rootHeight+2), report.Problems[1].Height)
+			assert.Equal(t, c.id(rootHeight+2), report.Problems[1].BlockID)
+		})
+	})
+
+	t.Run("payload hash mismatch", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			c := bootstrapChain(t, db, omit(rootHeight+3, "guarantees"))
+			blockID := c.id(rootHeight + 3)
+			require.NoError(t, db.Update(operation.IndexPayloadGuarantees(blockID, []flow.Identifier{unittest.IdentifierFixture()})))
+
+			// the payload hash doesn't match, and the guarantee is missing
+			report := check(t, db, false)
+			require.Len(t, report.Problems, 2)
+			assert.Equal(t, 2, report.Count(integrity.SeverityError))
+		})
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			bootstrapChain(t, db, omit(rootHeight+2, "header"))
+
+			// the parent is found using the height index
+			report := check(t, db, false)
+			require.Len(t, report.Problems, 1)
+			assert.Equal(t, integrity.SeverityError, report.Problems[0].Severity)
+			assert.Equal(t, 4, report.Blocks)
+		})
+	})
+
+	t.Run("rebuild height index", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			c := bootstrapChain(t, db, omit(rootHeight+1, "height index"))
+			require.NoError(t, db.Update(operation.ReindexBlockHeight(rootHeight+2, unittest.IdentifierFixture())))
+			require.NoError(t, db.Update(operation.IndexBlockHeight(rootHeight+4, unittest.IdentifierFixture())))
+
+			report := check(t, db, false)
+			assert.Len(t, report.Problems, 3)
+			assert.Equal(t, 3, report.Count(integrity.SeverityWarning))
+
+			report = check(t, db, true)
+			assert.Len(t, report.Problems, 3)
+			assert.Equal(t, 0, report.Count(integrity.SeverityWarning))
+
+			report = check(t, db, false)
+			assert.Empty(t, report.Problems)
+
+			for height := uint64(rootHeight); height <= rootHeight+3; height++ {
+				var blockID flow.Identifier
+				require.NoError(t, db.View(operation.LookupBlockHeight(height, &blockID)))
+				assert.Equal(t, c.id(height), blockID)
+			}
+			var blockID flow.Identifier
+			err := db.View(operation.LookupBlockHeight(rootHeight+4, &blockID))
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+		})
+	})
+
+	t.Run("rebuild result and children indexes", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			omitResult := omit(rootHeight+2, "result index")
+			omitChildren := omit(rootHeight+3, "children")
+			bootstrapChain(t, db, func(height uint64, entity string) bool {
+				return omitResult(height, entity) || omitChildren(height, entity)
+			})
+
+			report := check(t, db, true)
+			assert.Len(t, report.Problems, 2)
+			assert.Equal(t, 0, report.Count(integrity.SeverityWarning))
+
+			report = check(t, db, false)
+			assert.Empty(t, report.Problems)
+		})
+	})
+
+	t.Run("inconsistent epoch counters", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			c := bootstrapChain(t, db, omit(rootHeight+2, "status"))
+
+			// the commit of the current epoch of the block at height 12 has a
+			// different counter than its setup
+			setup := &flow.EpochSetup{Counter: 2, FinalView: 2000}
+			commit := unittest.EpochCommitFixture(unittest.CommitWithCounter(3))
+			status := &flow.EpochStatus{
+				PreviousEpoch: c.status.CurrentEpoch,
+				CurrentEpoch:  flow.EventIDs{SetupID: setup.ID(), CommitID: commit.ID()},
+			}
+			require.NoError(t, db.Update(func(tx kv.Transaction) error {
+				require.NoError(t, operation.InsertEpochSetup(setup.ID(), setup)(tx))
+				require.NoError(t, operation.InsertEpochCommit(commit.ID(), commit)(tx))
+				return operation.InsertEpochStatus(c.id(rootHeight+2), status)(tx)
+			}))
+
+			// the transitions from and to an inconsistent epoch status aren't
+			// checked
+			report := check(t, db, false)
+			require.Len(t, report.Problems, 1)
+			assert.Equal(t, integrity.SeverityError, report.Problems[0].Severity)
+			assert.Equal(t, uint64(rootHeight+2), report.Problems[0].Height)
+		})
+	})
+
+	t.Run("inconsistent epoch transition", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			c := bootstrapChain(t, db, omit(rootHeight+2, "status"))
+
+			// the block at height 12 switches to an epoch which wasn't set up
+			// by its parent, and its child switches back
+			setup := &flow.EpochSetup{Counter: 2, FinalView: 2000}
+			commit := unittest.EpochCommitFixture(unittest.CommitWithCounter(2))
+			status := &flow.EpochStatus{
+				PreviousEpoch: c.status.CurrentEpoch,
+				CurrentEpoch:  flow.EventIDs{SetupID: setup.ID(), CommitID: commit.ID()},
+			}
+			require.NoError(t, db.Update(func(tx kv.Transaction) error {
+				require.NoError(t, operation.InsertEpochSetup(setup.ID(), setup)(tx))
+				require.NoError(t, operation.InsertEpochCommit(commit.ID(), commit)(tx))
+				return operation.InsertEpochStatus(c.id(rootHeight+2), status)(tx)
+			}))
+
+			report := check(t, db, false)
+			require.Len(t, report.Problems, 2)
+			assert.Equal(t, 2, report.Count(integrity.SeverityError))
+			assert.Equal(t, uint64(rootHeight+3), report.Problems[0].Height)
+			assert.Equal(t, uint64(rootHeight+2), report.Problems[1].Height)
+		})
+	})
+}
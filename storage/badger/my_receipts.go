@@ -4,14 +4,13 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // MyExecutionReceipts holds and indexes Execution Receipts.
@@ -20,13 +19,13 @@ import (
 // of an individual Execution Node.
 type MyExecutionReceipts struct {
 	genericReceipts *ExecutionReceipts
-	db              *badger.DB
+	db              kv.DB
 	cache           *Cache
 }
 
 // NewMyExecutionReceipts creates instance of MyExecutionReceipts which is a wrapper wrapper around badger.ExecutionReceipts
 // It's useful for execution nodes to keep track of produced execution receipts.
-func NewMyExecutionReceipts(collector module.CacheMetrics, db *badger.DB, receipts *ExecutionReceipts) *MyExecutionReceipts {
+func NewMyExecutionReceipts(collector module.CacheMetrics, db kv.DB, receipts *ExecutionReceipts) *MyExecutionReceipts {
 	store := func(key interface{}, val interface{}) func(*transaction.Tx) error {
 		receipt := val.(*flow.ExecutionReceipt)
 		// assemble DB operations to store receipt (no execution)
@@ -34,7 +33,7 @@ func NewMyExecutionReceipts(collector module.CacheMetrics, db *badger.DB, receip
 		// assemble DB operations to index receipt as one of my own (no execution)
 		blockID := receipt.ExecutionResult.BlockID
 		receiptID := receipt.ID()
-		indexOwnReceiptOps := transaction.WithTx(func(tx *badger.Txn) error {
+		indexOwnReceiptOps := transaction.WithTx(func(tx kv.Transaction) error {
 			err := operation.IndexOwnExecutionReceipt(blockID, receiptID)(tx)
 			// check if we are storing same receipt
 			if errors.Is(err, storage.ErrAlreadyExists) {
@@ -68,10 +67,10 @@ func NewMyExecutionReceipts(collector module.CacheMetrics, db *badger.DB, receip
 		}
 	}
 
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		blockID := key.(flow.Identifier)
 
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 			var receiptID flow.Identifier
 			err := operation.LookupOwnExecutionReceipt(blockID, &receiptID)(tx)
 			if err != nil {
@@ -101,9 +100,9 @@ func (m *MyExecutionReceipts) storeMyReceipt(receipt *flow.ExecutionReceipt) fun
 }
 
 // storeMyReceipt assembles the operations to retrieve my receipt for the given block ID.
-func (m *MyExecutionReceipts) myReceipt(blockID flow.Identifier) func(*badger.Txn) (*flow.ExecutionReceipt, error) {
+func (m *MyExecutionReceipts) myReceipt(blockID flow.Identifier) func(kv.Transaction) (*flow.ExecutionReceipt, error) {
 	retrievalOps := m.cache.Get(blockID) // assemble DB operations to retrieve receipt (no execution)
-	return func(tx *badger.Txn) (*flow.ExecutionReceipt, error) {
+	return func(tx kv.Transaction) (*flow.ExecutionReceipt, error) {
 		val, err := retrievalOps(tx) // execute operations to retrieve receipt
 		if err != nil {
 			return nil, err
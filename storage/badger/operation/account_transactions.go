@@ -0,0 +1,35 @@
+package operation
+
+import (
+	"math"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// BatchIndexAccountTransaction indexes the transaction by the involved account and its position.
+func BatchIndexAccountTransaction(tx flow.AccountTransaction) func(batch kv.Writer) error {
+	key := makePrefix(codeIndexAccountTransaction, tx.Address, tx.Height, tx.TransactionIndex)
+	return batchInsert(key, tx)
+}
+
+// LookupAccountTransactions retrieves at most limit transactions involving the given account, starting at
+// the given position (inclusive) up to and including the end height, ordered by position.
+func LookupAccountTransactions(address flow.Address, from flow.TransactionPosition, endHeight uint64, limit uint, txs *[]flow.AccountTransaction) func(kv.Transaction) error {
+	start := makePrefix(codeIndexAccountTransaction, address, from.Height, from.TransactionIndex)
+	end := makePrefix(codeIndexAccountTransaction, address, endHeight, uint32(math.MaxUint32))
+	return iterate(start, end, func() (checkFunc, createFunc, handleFunc) {
+		check := func(key []byte) bool {
+			return uint(len(*txs)) < limit
+		}
+		var val flow.AccountTransaction
+		create := func() interface{} {
+			return &val
+		}
+		handle := func() error {
+			*txs = append(*txs, val)
+			return nil
+		}
+		return check, create, handle
+	})
+}
@@ -0,0 +1,62 @@
+package operation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestAccountTransactionIndex(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+		address := unittest.AddressFixture()
+		other := unittest.RandomAddressFixture()
+
+		var expected []flow.AccountTransaction
+		batch := db.NewWriteBatch()
+		for height := uint64(1); height <= 3; height++ {
+			for index := uint32(0); index < 2; index++ {
+				tx := flow.AccountTransaction{
+					Address:          address,
+					BlockID:          unittest.IdentifierFixture(),
+					Height:           height,
+					TransactionID:    unittest.IdentifierFixture(),
+					TransactionIndex: index,
+					Roles:            flow.TransactionRolePayer,
+				}
+				expected = append(expected, tx)
+				require.NoError(t, BatchIndexAccountTransaction(tx)(batch))
+
+				tx.Address = other
+				require.NoError(t, BatchIndexAccountTransaction(tx)(batch))
+			}
+		}
+		require.NoError(t, batch.Flush())
+
+		t.Run("all transactions of an account", func(t *testing.T) {
+			var txs []flow.AccountTransaction
+			err := db.View(LookupAccountTransactions(address, flow.TransactionPosition{}, 3, 100, &txs))
+			require.NoError(t, err)
+			assert.Equal(t, expected, txs)
+		})
+
+		t.Run("from position with limit", func(t *testing.T) {
+			var txs []flow.AccountTransaction
+			from := flow.TransactionPosition{Height: 1, TransactionIndex: 1}
+			err := db.View(LookupAccountTransactions(address, from, 3, 2, &txs))
+			require.NoError(t, err)
+			assert.Equal(t, expected[1:3], txs)
+		})
+
+		t.Run("up to end height", func(t *testing.T) {
+			var txs []flow.AccountTransaction
+			err := db.View(LookupAccountTransactions(address, flow.TransactionPosition{Height: 2}, 2, 100, &txs))
+			require.NoError(t, err)
+			assert.Equal(t, expected[2:4], txs)
+		})
+	})
+}
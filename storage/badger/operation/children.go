@@ -1,22 +1,21 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // InsertBlockChildren insert an index to lookup the direct child of a block by its ID
-func InsertBlockChildren(blockID flow.Identifier, childrenIDs []flow.Identifier) func(*badger.Txn) error {
+func InsertBlockChildren(blockID flow.Identifier, childrenIDs []flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeBlockChildren, blockID), childrenIDs)
 }
 
 // UpdateBlockChildren updates the children for a block.
-func UpdateBlockChildren(blockID flow.Identifier, childrenIDs []flow.Identifier) func(*badger.Txn) error {
+func UpdateBlockChildren(blockID flow.Identifier, childrenIDs []flow.Identifier) func(kv.Transaction) error {
 	return update(makePrefix(codeBlockChildren, blockID), childrenIDs)
 }
 
 // RetrieveBlockChildren the child block ID by parent block ID
-func RetrieveBlockChildren(blockID flow.Identifier, childrenIDs *[]flow.Identifier) func(*badger.Txn) error {
+func RetrieveBlockChildren(blockID flow.Identifier, childrenIDs *[]flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeBlockChildren, blockID), childrenIDs)
 }
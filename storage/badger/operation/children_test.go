@@ -3,16 +3,16 @@ package operation
 import (
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestBlockChildrenIndexUpdateLookup(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		blockID := unittest.IdentifierFixture()
 		childrenIDs := unittest.IdentifierListFixture(8)
 		var retrievedIDs []flow.Identifier
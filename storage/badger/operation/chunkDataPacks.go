@@ -1,28 +1,27 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	badgermodel "github.com/onflow/flow-go/storage/badger/model"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // InsertChunkDataPack inserts a chunk data pack keyed by chunk ID.
-func InsertChunkDataPack(c *badgermodel.StoredChunkDataPack) func(*badger.Txn) error {
+func InsertChunkDataPack(c *badgermodel.StoredChunkDataPack) func(kv.Transaction) error {
 	return insert(makePrefix(codeChunkDataPack, c.ChunkID), c)
 }
 
 // BatchInsertChunkDataPack inserts a chunk data pack keyed by chunk ID into a batch
-func BatchInsertChunkDataPack(c *badgermodel.StoredChunkDataPack) func(batch *badger.WriteBatch) error {
+func BatchInsertChunkDataPack(c *badgermodel.StoredChunkDataPack) func(batch kv.Writer) error {
 	return batchInsert(makePrefix(codeChunkDataPack, c.ChunkID), c)
 }
 
 // RetrieveChunkDataPack retrieves a chunk data pack by chunk ID.
-func RetrieveChunkDataPack(chunkID flow.Identifier, c *badgermodel.StoredChunkDataPack) func(*badger.Txn) error {
+func RetrieveChunkDataPack(chunkID flow.Identifier, c *badgermodel.StoredChunkDataPack) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeChunkDataPack, chunkID), c)
 }
 
 // RemoveChunkDataPack removes the chunk data pack with the given chunk ID.
-func RemoveChunkDataPack(chunkID flow.Identifier) func(*badger.Txn) error {
+func RemoveChunkDataPack(chunkID flow.Identifier) func(kv.Transaction) error {
 	return remove(makePrefix(codeChunkDataPack, chunkID))
 }
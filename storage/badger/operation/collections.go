@@ -3,44 +3,43 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // NOTE: These insert light collections, which only contain references
 // to the constituent transactions. They do not modify transactions contained
 // by the collections.
 
-func InsertCollection(collection *flow.LightCollection) func(*badger.Txn) error {
+func InsertCollection(collection *flow.LightCollection) func(kv.Transaction) error {
 	return insert(makePrefix(codeCollection, collection.ID()), collection)
 }
 
-func RetrieveCollection(collID flow.Identifier, collection *flow.LightCollection) func(*badger.Txn) error {
+func RetrieveCollection(collID flow.Identifier, collection *flow.LightCollection) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeCollection, collID), collection)
 }
 
-func RemoveCollection(collID flow.Identifier) func(*badger.Txn) error {
+func RemoveCollection(collID flow.Identifier) func(kv.Transaction) error {
 	return remove(makePrefix(codeCollection, collID))
 }
 
 // IndexCollectionPayload indexes the transactions within the collection payload
 // of a cluster block.
-func IndexCollectionPayload(blockID flow.Identifier, txIDs []flow.Identifier) func(*badger.Txn) error {
+func IndexCollectionPayload(blockID flow.Identifier, txIDs []flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeIndexCollection, blockID), txIDs)
 }
 
 // LookupCollection looks up the collection for a given cluster payload.
-func LookupCollectionPayload(blockID flow.Identifier, txIDs *[]flow.Identifier) func(*badger.Txn) error {
+func LookupCollectionPayload(blockID flow.Identifier, txIDs *[]flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeIndexCollection, blockID), txIDs)
 }
 
 // IndexCollectionByTransaction inserts a collection id keyed by a transaction id
-func IndexCollectionByTransaction(txID flow.Identifier, collectionID flow.Identifier) func(*badger.Txn) error {
+func IndexCollectionByTransaction(txID flow.Identifier, collectionID flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeIndexCollectionByTransaction, txID), collectionID)
 }
 
 // LookupCollectionID retrieves a collection id by transaction id
-func RetrieveCollectionID(txID flow.Identifier, collectionID *flow.Identifier) func(*badger.Txn) error {
+func RetrieveCollectionID(txID flow.Identifier, collectionID *flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeIndexCollectionByTransaction, txID), collectionID)
 }
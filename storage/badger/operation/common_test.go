@@ -11,13 +11,13 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v4"
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -51,7 +51,7 @@ func (a UnencodeableEntity) UnmarshalMsgpack(b []byte) error {
 }
 
 func TestInsertValid(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
@@ -60,10 +60,11 @@ func TestInsertValid(t *testing.T) {
 		require.NoError(t, err)
 
 		var act []byte
-		_ = db.View(func(tx *badger.Txn) error {
-			item, err := tx.Get(key)
-			require.NoError(t, err)
-			act, err = item.ValueCopy(nil)
+		_ = db.View(func(tx kv.Transaction) error {
+			err := tx.Get(key, func(val []byte) error {
+				act = append([]byte(nil), val...)
+				return nil
+			})
 			require.NoError(t, err)
 			return nil
 		})
@@ -73,7 +74,7 @@ func TestInsertValid(t *testing.T) {
 }
 
 func TestInsertDuplicate(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
@@ -91,10 +92,11 @@ func TestInsertDuplicate(t *testing.T) {
 
 		// ensure old value did not update
 		var act []byte
-		_ = db.View(func(tx *badger.Txn) error {
-			item, err := tx.Get(key)
-			require.NoError(t, err)
-			act, err = item.ValueCopy(nil)
+		_ = db.View(func(tx kv.Transaction) error {
+			err := tx.Get(key, func(val []byte) error {
+				act = append([]byte(nil), val...)
+				return nil
+			})
 			require.NoError(t, err)
 			return nil
 		})
@@ -104,7 +106,7 @@ func TestInsertDuplicate(t *testing.T) {
 }
 
 func TestInsertEncodingError(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 
@@ -115,12 +117,12 @@ func TestInsertEncodingError(t *testing.T) {
 }
 
 func TestUpdateValid(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
 
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			err := tx.Set(key, []byte{})
 			require.NoError(t, err)
 			return nil
@@ -130,10 +132,11 @@ func TestUpdateValid(t *testing.T) {
 		require.NoError(t, err)
 
 		var act []byte
-		_ = db.View(func(tx *badger.Txn) error {
-			item, err := tx.Get(key)
-			require.NoError(t, err)
-			act, err = item.ValueCopy(nil)
+		_ = db.View(func(tx kv.Transaction) error {
+			err := tx.Get(key, func(val []byte) error {
+				act = append([]byte(nil), val...)
+				return nil
+			})
 			require.NoError(t, err)
 			return nil
 		})
@@ -143,7 +146,7 @@ func TestUpdateValid(t *testing.T) {
 }
 
 func TestUpdateMissing(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 
@@ -151,21 +154,21 @@ func TestUpdateMissing(t *testing.T) {
 		require.Equal(t, storage.ErrNotFound, err)
 
 		// ensure nothing was written
-		_ = db.View(func(tx *badger.Txn) error {
-			_, err := tx.Get(key)
-			require.Equal(t, badger.ErrKeyNotFound, err)
+		_ = db.View(func(tx kv.Transaction) error {
+			err := tx.Get(key, noValue)
+			require.Equal(t, kv.ErrNotFound, err)
 			return nil
 		})
 	})
 }
 
 func TestUpdateEncodingError(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
 
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			err := tx.Set(key, val)
 			require.NoError(t, err)
 			return nil
@@ -176,10 +179,11 @@ func TestUpdateEncodingError(t *testing.T) {
 
 		// ensure value did not change
 		var act []byte
-		_ = db.View(func(tx *badger.Txn) error {
-			item, err := tx.Get(key)
-			require.NoError(t, err)
-			act, err = item.ValueCopy(nil)
+		_ = db.View(func(tx kv.Transaction) error {
+			err := tx.Get(key, func(val []byte) error {
+				act = append([]byte(nil), val...)
+				return nil
+			})
 			require.NoError(t, err)
 			return nil
 		})
@@ -189,12 +193,12 @@ func TestUpdateEncodingError(t *testing.T) {
 }
 
 func TestRetrieveValid(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
 
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			err := tx.Set(key, val)
 			require.NoError(t, err)
 			return nil
@@ -209,7 +213,7 @@ func TestRetrieveValid(t *testing.T) {
 }
 
 func TestRetrieveMissing(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		key := []byte{0x01, 0x02, 0x03}
 
 		var act Entity
@@ -219,12 +223,12 @@ func TestRetrieveMissing(t *testing.T) {
 }
 
 func TestRetrieveUnencodeable(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
 
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			err := tx.Set(key, val)
 			require.NoError(t, err)
 			return nil
@@ -263,12 +267,12 @@ func TestLookup(t *testing.T) {
 }
 
 func TestIterate(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		keys := [][]byte{{0x00}, {0x12}, {0xf0}, {0xff}}
 		vals := []bool{false, false, true, true}
 		expected := []bool{false, true}
 
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			for i, key := range keys {
 				enc, err := msgpack.Marshal(vals[i])
 				require.NoError(t, err)
@@ -302,12 +306,12 @@ func TestIterate(t *testing.T) {
 }
 
 func TestTraverse(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		keys := [][]byte{{0x42, 0x00}, {0xff}, {0x42, 0x56}, {0x00}, {0x42, 0xff}}
 		vals := []bool{false, false, true, false, true}
 		expected := []bool{false, true}
 
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			for i, key := range keys {
 				enc, err := msgpack.Marshal(vals[i])
 				require.NoError(t, err)
@@ -341,25 +345,25 @@ func TestTraverse(t *testing.T) {
 }
 
 func TestRemove(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
 
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			err := tx.Set(key, val)
 			require.NoError(t, err)
 			return nil
 		})
 
 		t.Run("should be able to remove", func(t *testing.T) {
-			_ = db.Update(func(txn *badger.Txn) error {
+			_ = db.Update(func(txn kv.Transaction) error {
 				err := remove(key)(txn)
 				assert.NoError(t, err)
 
-				_, err = txn.Get(key)
+				err = txn.Get(key, noValue)
 				assert.Error(t, err)
-				assert.IsType(t, badger.ErrKeyNotFound, err)
+				assert.IsType(t, kv.ErrNotFound, err)
 
 				return nil
 			})
@@ -367,7 +371,7 @@ func TestRemove(t *testing.T) {
 
 		t.Run("should error when removing non-existant value", func(t *testing.T) {
 			nonexistantKey := append(key, 0x01)
-			_ = db.Update(func(txn *badger.Txn) error {
+			_ = db.Update(func(txn kv.Transaction) error {
 				err := remove(nonexistantKey)(txn)
 				assert.Error(t, err)
 				return nil
@@ -411,10 +415,10 @@ func TestIterateBoundaries(t *testing.T) {
 	// keys within the expected range
 	keysInRange := keys[1:11]
 
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		// insert the keys into the database
-		_ = db.Update(func(tx *badger.Txn) error {
+		_ = db.Update(func(tx kv.Transaction) error {
 			for _, key := range keys {
 				err := tx.Set(key, []byte{0x00})
 				if err != nil {
@@ -428,7 +432,8 @@ func TestIterateBoundaries(t *testing.T) {
 		var found [][]byte
 		iteration := func() (checkFunc, createFunc, handleFunc) {
 			check := func(key []byte) bool {
-				found = append(found, key)
+				// keys are only valid until the iterator moves
+				found = append(found, append([]byte(nil), key...))
 				return false
 			}
 			create := func() interface{} {
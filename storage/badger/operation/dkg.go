@@ -3,11 +3,10 @@ package operation
 import (
 	"errors"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/encodable"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // InsertMyBeaconPrivateKey stores the random beacon private key for the given epoch.
@@ -15,7 +14,7 @@ import (
 // CAUTION: This method stores confidential information and should only be
 // used in the context of the secrets database. This is enforced in the above
 // layer (see storage.DKGState).
-func InsertMyBeaconPrivateKey(epochCounter uint64, info *encodable.RandomBeaconPrivKey) func(*badger.Txn) error {
+func InsertMyBeaconPrivateKey(epochCounter uint64, info *encodable.RandomBeaconPrivKey) func(kv.Transaction) error {
 	return insert(makePrefix(codeBeaconPrivateKey, epochCounter), info)
 }
 
@@ -24,20 +23,20 @@ func InsertMyBeaconPrivateKey(epochCounter uint64, info *encodable.RandomBeaconP
 // CAUTION: This method stores confidential information and should only be
 // used in the context of the secrets database. This is enforced in the above
 // layer (see storage.DKGState).
-func RetrieveMyBeaconPrivateKey(epochCounter uint64, info *encodable.RandomBeaconPrivKey) func(*badger.Txn) error {
+func RetrieveMyBeaconPrivateKey(epochCounter uint64, info *encodable.RandomBeaconPrivKey) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeBeaconPrivateKey, epochCounter), info)
 }
 
 // InsertDKGStartedForEpoch stores a flag indicating that the DKG has been started for
 // the given epoch.
-func InsertDKGStartedForEpoch(epochCounter uint64) func(*badger.Txn) error {
+func InsertDKGStartedForEpoch(epochCounter uint64) func(kv.Transaction) error {
 	return insert(makePrefix(codeDKGStarted, epochCounter), true)
 }
 
 // RetrieveDKGStartedForEpoch retrieves the DKG started flag for the given epoch.
 // If no flag is set, started is set to false.
-func RetrieveDKGStartedForEpoch(epochCounter uint64, started *bool) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func RetrieveDKGStartedForEpoch(epochCounter uint64, started *bool) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		err := retrieve(makePrefix(codeDKGStarted, epochCounter), started)(tx)
 		if errors.Is(err, storage.ErrNotFound) {
 			// flag not set - therefore DKG not started
@@ -53,11 +52,26 @@ func RetrieveDKGStartedForEpoch(epochCounter uint64, started *bool) func(*badger
 }
 
 // InsertDKGEndStateForEpoch stores the DKG end state for the epoch.
-func InsertDKGEndStateForEpoch(epochCounter uint64, endState flow.DKGEndState) func(*badger.Txn) error {
+func InsertDKGEndStateForEpoch(epochCounter uint64, endState flow.DKGEndState) func(kv.Transaction) error {
 	return insert(makePrefix(codeDKGEnded, epochCounter), endState)
 }
 
 // RetrieveDKGEndStateForEpoch retrieves the DKG end state for the epoch.
-func RetrieveDKGEndStateForEpoch(epochCounter uint64, endState *flow.DKGEndState) func(*badger.Txn) error {
+func RetrieveDKGEndStateForEpoch(epochCounter uint64, endState *flow.DKGEndState) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeDKGEnded, epochCounter), endState)
 }
+
+// InsertDKGTranscriptSummary stores the DKG transcript summary for the epoch.
+func InsertDKGTranscriptSummary(epochCounter uint64, summary *flow.DKGTranscriptSummary) func(kv.Transaction) error {
+	return insert(makePrefix(codeDKGTranscript, epochCounter), summary)
+}
+
+// UpdateDKGTranscriptSummary updates the DKG transcript summary for the epoch.
+func UpdateDKGTranscriptSummary(epochCounter uint64, summary *flow.DKGTranscriptSummary) func(kv.Transaction) error {
+	return update(makePrefix(codeDKGTranscript, epochCounter), summary)
+}
+
+// RetrieveDKGTranscriptSummary retrieves the DKG transcript summary for the epoch.
+func RetrieveDKGTranscriptSummary(epochCounter uint64, summary *flow.DKGTranscriptSummary) func(kv.Transaction) error {
+	return retrieve(makePrefix(codeDKGTranscript, epochCounter), summary)
+}
@@ -4,18 +4,18 @@ import (
 	"math/rand"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/onflow/flow-go/model/encodable"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 // TestInsertMyDKGPrivateInfo_StoreRetrieve tests writing and reading private DKG info.
 func TestMyBeaconPrivateKey_StoreRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		t.Run("should return error not found when not stored", func(t *testing.T) {
 			var stored encodable.RandomBeaconPrivKey
@@ -48,7 +48,7 @@ func TestMyBeaconPrivateKey_StoreRetrieve(t *testing.T) {
 func TestDKGStartedForEpoch(t *testing.T) {
 
 	t.Run("reading when unset should return false", func(t *testing.T) {
-		unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 			var started bool
 			err := db.View(RetrieveDKGStartedForEpoch(1, &started))
 			assert.NoError(t, err)
@@ -57,7 +57,7 @@ func TestDKGStartedForEpoch(t *testing.T) {
 	})
 
 	t.Run("should be able to set flag to true", func(t *testing.T) {
-		unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 			epochCounter := rand.Uint64()
 
 			// set the flag, ensure no error
@@ -79,7 +79,7 @@ func TestDKGStartedForEpoch(t *testing.T) {
 }
 
 func TestDKGEndStateForEpoch(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		epochCounter := rand.Uint64()
 
 		// should be able to write end state
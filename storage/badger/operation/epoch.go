@@ -3,33 +3,32 @@ package operation
 import (
 	"errors"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func InsertEpochSetup(eventID flow.Identifier, event *flow.EpochSetup) func(*badger.Txn) error {
+func InsertEpochSetup(eventID flow.Identifier, event *flow.EpochSetup) func(kv.Transaction) error {
 	return insert(makePrefix(codeEpochSetup, eventID), event)
 }
 
-func RetrieveEpochSetup(eventID flow.Identifier, event *flow.EpochSetup) func(*badger.Txn) error {
+func RetrieveEpochSetup(eventID flow.Identifier, event *flow.EpochSetup) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeEpochSetup, eventID), event)
 }
 
-func InsertEpochCommit(eventID flow.Identifier, event *flow.EpochCommit) func(*badger.Txn) error {
+func InsertEpochCommit(eventID flow.Identifier, event *flow.EpochCommit) func(kv.Transaction) error {
 	return insert(makePrefix(codeEpochCommit, eventID), event)
 }
 
-func RetrieveEpochCommit(eventID flow.Identifier, event *flow.EpochCommit) func(*badger.Txn) error {
+func RetrieveEpochCommit(eventID flow.Identifier, event *flow.EpochCommit) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeEpochCommit, eventID), event)
 }
 
-func InsertEpochStatus(blockID flow.Identifier, status *flow.EpochStatus) func(*badger.Txn) error {
+func InsertEpochStatus(blockID flow.Identifier, status *flow.EpochStatus) func(kv.Transaction) error {
 	return insert(makePrefix(codeBlockEpochStatus, blockID), status)
 }
 
-func RetrieveEpochStatus(blockID flow.Identifier, status *flow.EpochStatus) func(*badger.Txn) error {
+func RetrieveEpochStatus(blockID flow.Identifier, status *flow.EpochStatus) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeBlockEpochStatus, blockID), status)
 }
 
@@ -41,21 +40,37 @@ func RetrieveEpochStatus(blockID flow.Identifier, status *flow.EpochStatus) func
 // 2. The block where an invalid service event is being applied to the state.
 //
 // Calling this function multiple times is a no-op and returns no expected errors.
-func SetEpochEmergencyFallbackTriggered(blockID flow.Identifier) func(txn *badger.Txn) error {
+func SetEpochEmergencyFallbackTriggered(blockID flow.Identifier) func(txn kv.Transaction) error {
 	return SkipDuplicates(insert(makePrefix(codeEpochEmergencyFallbackTriggered), blockID))
 }
 
+// UnsetEpochEmergencyFallbackTriggered removes the flag indicating that epoch
+// emergency fallback has been triggered. This happens when the network resumes
+// normal epoch transitions, by entering an epoch injected by an EpochRecover
+// service event.
+//
+// Calling this function when the flag is not set is a no-op and returns no expected errors.
+func UnsetEpochEmergencyFallbackTriggered() func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
+		err := remove(makePrefix(codeEpochEmergencyFallbackTriggered))(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+}
+
 // RetrieveEpochEmergencyFallbackTriggeredBlockID gets the block ID where epoch
 // emergency was triggered.
-func RetrieveEpochEmergencyFallbackTriggeredBlockID(blockID *flow.Identifier) func(*badger.Txn) error {
+func RetrieveEpochEmergencyFallbackTriggeredBlockID(blockID *flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeEpochEmergencyFallbackTriggered), blockID)
 }
 
 // CheckEpochEmergencyFallbackTriggered retrieves the value of the flag
 // indicating whether epoch emergency fallback has been triggered. If the key
 // is not set, this results in triggered being set to false.
-func CheckEpochEmergencyFallbackTriggered(triggered *bool) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func CheckEpochEmergencyFallbackTriggered(triggered *bool) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		var blockID flow.Identifier
 		err := RetrieveEpochEmergencyFallbackTriggeredBlockID(&blockID)(tx)
 		if errors.Is(err, storage.ErrNotFound) {
@@ -3,10 +3,10 @@ package operation
 import (
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -16,7 +16,7 @@ func TestEpochEmergencyFallback(t *testing.T) {
 	blockID := unittest.IdentifierFixture()
 
 	t.Run("reading when unset should return false", func(t *testing.T) {
-		unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 			var triggered bool
 			err := db.View(CheckEpochEmergencyFallbackTriggered(&triggered))
 			assert.NoError(t, err)
@@ -24,7 +24,7 @@ func TestEpochEmergencyFallback(t *testing.T) {
 		})
 	})
 	t.Run("should be able to set flag to true", func(t *testing.T) {
-		unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 			// set the flag, ensure no error
 			err := db.Update(SetEpochEmergencyFallbackTriggered(blockID))
 			assert.NoError(t, err)
@@ -43,7 +43,7 @@ func TestEpochEmergencyFallback(t *testing.T) {
 		})
 	})
 	t.Run("setting flag multiple time should have no additional effect", func(t *testing.T) {
-		unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 			// set the flag, ensure no error
 			err := db.Update(SetEpochEmergencyFallbackTriggered(blockID))
 			assert.NoError(t, err)
@@ -65,4 +65,23 @@ func TestEpochEmergencyFallback(t *testing.T) {
 			assert.Equal(t, blockID, storedBlockID)
 		})
 	})
+	t.Run("should be able to unset flag", func(t *testing.T) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+			// unsetting an unset flag should have no effect
+			err := db.Update(UnsetEpochEmergencyFallbackTriggered())
+			assert.NoError(t, err)
+
+			// set the flag, then unset it
+			err = db.Update(SetEpochEmergencyFallbackTriggered(blockID))
+			assert.NoError(t, err)
+			err = db.Update(UnsetEpochEmergencyFallbackTriggered())
+			assert.NoError(t, err)
+
+			// read the flag, should be false
+			var triggered bool
+			err = db.View(CheckEpochEmergencyFallbackTriggered(&triggered))
+			assert.NoError(t, err)
+			assert.False(t, triggered)
+		})
+	})
 }
@@ -3,51 +3,256 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/vmihailenco/msgpack/v4"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 func eventPrefix(prefix byte, blockID flow.Identifier, event flow.Event) []byte {
 	return makePrefix(prefix, blockID, event.TransactionID, event.TransactionIndex, event.EventIndex)
 }
 
-func InsertEvent(blockID flow.Identifier, event flow.Event) func(*badger.Txn) error {
+func InsertEvent(blockID flow.Identifier, event flow.Event) func(kv.Transaction) error {
 	return insert(eventPrefix(codeEvent, blockID, event), event)
 }
 
-func BatchInsertEvent(blockID flow.Identifier, event flow.Event) func(batch *badger.WriteBatch) error {
+func BatchInsertEvent(blockID flow.Identifier, event flow.Event) func(batch kv.Writer) error {
 	return batchInsert(eventPrefix(codeEvent, blockID, event), event)
 }
 
-func InsertServiceEvent(blockID flow.Identifier, event flow.Event) func(*badger.Txn) error {
+func InsertServiceEvent(blockID flow.Identifier, event flow.Event) func(kv.Transaction) error {
 	return insert(eventPrefix(codeServiceEvent, blockID, event), event)
 }
 
-func BatchInsertServiceEvent(blockID flow.Identifier, event flow.Event) func(batch *badger.WriteBatch) error {
+func BatchInsertServiceEvent(blockID flow.Identifier, event flow.Event) func(batch kv.Writer) error {
 	return batchInsert(eventPrefix(codeServiceEvent, blockID, event), event)
 }
 
-func RetrieveEvents(blockID flow.Identifier, transactionID flow.Identifier, events *[]flow.Event) func(*badger.Txn) error {
+// RetrieveEvent retrieves a single event of the given block by its transaction and event index.
+func RetrieveEvent(blockID flow.Identifier, transactionID flow.Identifier, txIndex uint32, eventIndex uint32, event *flow.Event) func(kv.Transaction) error {
+	return retrieve(makePrefix(codeEvent, blockID, transactionID, txIndex, eventIndex), event)
+}
+
+func RetrieveEvents(blockID flow.Identifier, transactionID flow.Identifier, events *[]flow.Event) func(kv.Transaction) error {
 	iterationFunc := eventIterationFunc(events)
 	return traverse(makePrefix(codeEvent, blockID, transactionID), iterationFunc)
 }
 
-func LookupEventsByBlockID(blockID flow.Identifier, events *[]flow.Event) func(*badger.Txn) error {
+func LookupEventsByBlockID(blockID flow.Identifier, events *[]flow.Event) func(kv.Transaction) error {
 	iterationFunc := eventIterationFunc(events)
 	return traverse(makePrefix(codeEvent, blockID), iterationFunc)
 }
 
-func LookupServiceEventsByBlockID(blockID flow.Identifier, events *[]flow.Event) func(*badger.Txn) error {
+func LookupServiceEventsByBlockID(blockID flow.Identifier, events *[]flow.Event) func(kv.Transaction) error {
 	iterationFunc := eventIterationFunc(events)
 	return traverse(makePrefix(codeServiceEvent, blockID), iterationFunc)
 }
 
-func LookupEventsByBlockIDEventType(blockID flow.Identifier, eventType flow.EventType, events *[]flow.Event) func(*badger.Txn) error {
+func LookupEventsByBlockIDEventType(blockID flow.Identifier, eventType flow.EventType, events *[]flow.Event) func(kv.Transaction) error {
 	iterationFunc := eventFilterIterationFunc(events, eventType)
 	return traverse(makePrefix(codeEvent, blockID), iterationFunc)
 }
 
+// eventTypeIndexPrefix returns the key prefix for all entries of the event type index with the given
+// type. The type is terminated by a zero byte, so that no event type is a key prefix of another one.
+func eventTypeIndexPrefix(eventType flow.EventType, keys ...interface{}) []byte {
+	return makePrefix(codeIndexEventByType, append([]interface{}{string(eventType), uint8(0)}, keys...)...)
+}
+
+// BatchIndexEventByType indexes the located event by its type and position.
+func BatchIndexEventByType(locator flow.EventLocator) func(batch kv.Writer) error {
+	key := eventTypeIndexPrefix(locator.Type, locator.Height, locator.TransactionIndex, locator.EventIndex)
+	return batchInsert(key, locator)
+}
+
+// BatchIndexEventByAddress indexes the located event by the address of the emitting contract and its position.
+func BatchIndexEventByAddress(address flow.Address, locator flow.EventLocator) func(batch kv.Writer) error {
+	key := makePrefix(codeIndexEventByAddress, address, locator.Height, locator.TransactionIndex, locator.EventIndex)
+	return batchInsert(key, locator)
+}
+
+// LookupEventLocatorsByType retrieves at most limit locators of events with the given exact type,
+// starting at the given position (inclusive) up to and including the end height, ordered by position.
+// The end key covers all positions at the end height, so that it never sorts before the start key,
+// which would turn the iteration into a reverse iteration.
+func LookupEventLocatorsByType(eventType flow.EventType, from flow.EventPosition, endHeight uint64, limit uint, locators *[]flow.EventLocator) func(kv.Transaction) error {
+	start := eventTypeIndexPrefix(eventType, from.Height, from.TransactionIndex, from.EventIndex)
+	end := eventTypeIndexPrefix(eventType, endHeight, uint32(math.MaxUint32), uint32(math.MaxUint32))
+	return iterate(start, end, eventLocatorIterationFunc(locators, limit, nil))
+}
+
+// LookupEventLocatorsByAddress retrieves at most limit locators of events emitted by contracts of the given
+// address, whose type starts with the given prefix, starting at the given position (inclusive) up to and
+// including the end height, ordered by position.
+func LookupEventLocatorsByAddress(address flow.Address, typePrefix string, from flow.EventPosition, endHeight uint64, limit uint, locators *[]flow.EventLocator) func(kv.Transaction) error {
+	start := makePrefix(codeIndexEventByAddress, address, from.Height, from.TransactionIndex, from.EventIndex)
+	end := makePrefix(codeIndexEventByAddress, address, endHeight, uint32(math.MaxUint32), uint32(math.MaxUint32))
+	match := func(locator *flow.EventLocator) bool {
+		return len(locator.Type) >= len(typePrefix) && string(locator.Type[:len(typePrefix)]) == typePrefix
+	}
+	return iterate(start, end, eventLocatorIterationFunc(locators, limit, match))
+}
+
+// LookupEventLocatorsByTypePrefix retrieves at most limit locators of events whose type starts with the given
+// prefix, starting at the given position (inclusive) up to and including the end height, ordered by position.
+// As the type index is ordered by type first, the entries of each matching type are iterated in parallel and
+// merged by position, so that the lookup stops once the limit is reached. It should only be used for prefixes
+// which can not be served by the address index, as it has to seek to each matching type.
+func LookupEventLocatorsByTypePrefix(typePrefix string, from flow.EventPosition, endHeight uint64, limit uint, locators *[]flow.EventLocator) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
+		if limit == 0 {
+			return nil
+		}
+
+		eventTypes := eventTypesWithPrefix(tx, typePrefix)
+
+		// open one cursor per matching type, positioned at the start position
+		cursors := make(eventTypeCursors, 0, len(eventTypes))
+		defer func() {
+			for _, cursor := range cursors {
+				cursor.it.Close()
+			}
+		}()
+		for _, eventType := range eventTypes {
+			cursor := &eventTypeCursor{
+				prefix: eventTypeIndexPrefix(eventType),
+			}
+			cursor.it = tx.NewIterator(kv.IteratorOptions{Prefix: cursor.prefix})
+			cursor.it.Seek(eventTypeIndexPrefix(eventType, from.Height, from.TransactionIndex, from.EventIndex))
+			if !cursor.valid(endHeight) {
+				cursor.it.Close()
+				continue
+			}
+			cursors = append(cursors, cursor)
+		}
+		heap.Init(&cursors)
+
+		// repeatedly take the entry with the lowest position among all types
+		for found := uint(0); found < limit && len(cursors) > 0; found++ {
+			cursor := cursors[0]
+
+			var locator flow.EventLocator
+			err := cursor.it.Value(func(val []byte) error {
+				return msgpack.Unmarshal(val, &locator)
+			})
+			if err != nil {
+				return fmt.Errorf("could not decode event locator: %w", err)
+			}
+			*locators = append(*locators, locator)
+
+			cursor.it.Next()
+			if cursor.valid(endHeight) {
+				heap.Fix(&cursors, 0)
+				continue
+			}
+			cursor.it.Close()
+			heap.Pop(&cursors)
+		}
+
+		return nil
+	}
+}
+
+// eventTypesWithPrefix returns the event types in the type index which start with the given prefix. It only
+// reads the first entry of each type and then seeks past all remaining entries of the type.
+func eventTypesWithPrefix(tx kv.Transaction, typePrefix string) []flow.EventType {
+	prefix := makePrefix(codeIndexEventByType, typePrefix)
+	it := tx.NewIterator(kv.IteratorOptions{Prefix: prefix})
+	defer it.Close()
+
+	var eventTypes []flow.EventType
+	for it.Seek(prefix); it.ValidForPrefix(prefix); {
+		// the type is terminated by a zero byte, followed by the position
+		key := it.Key()
+		end := bytes.IndexByte(key[1:], 0)
+		if end < 0 {
+			break
+		}
+		eventType := flow.EventType(key[1 : 1+end])
+		eventTypes = append(eventTypes, eventType)
+
+		// a terminating byte of one sorts after all entries of the type
+		it.Seek(makePrefix(codeIndexEventByType, string(eventType), uint8(1)))
+	}
+	return eventTypes
+}
+
+// eventTypeCursor iterates over the type index entries of a single event type in position order.
+type eventTypeCursor struct {
+	prefix   []byte
+	it       kv.Iterator
+	position flow.EventPosition // position of the current entry
+}
+
+// valid returns whether the cursor is positioned at an entry up to the end height, and updates its position.
+func (c *eventTypeCursor) valid(endHeight uint64) bool {
+	if !c.it.ValidForPrefix(c.prefix) {
+		return false
+	}
+	c.position = eventPositionFromKey(c.it.Key())
+	return c.position.Height <= endHeight
+}
+
+// eventTypeCursors implements heap.Interface, ordering the cursors by the position of their current entry.
+type eventTypeCursors []*eventTypeCursor
+
+func (c eventTypeCursors) Len() int           { return len(c) }
+func (c eventTypeCursors) Less(i, j int) bool { return c[i].position.Less(c[j].position) }
+func (c eventTypeCursors) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+func (c *eventTypeCursors) Push(x interface{}) {
+	*c = append(*c, x.(*eventTypeCursor))
+}
+
+func (c *eventTypeCursors) Pop() interface{} {
+	old := *c
+	n := len(old)
+	cursor := old[n-1]
+	*c = old[:n-1]
+	return cursor
+}
+
+// eventPositionFromKey decodes the event position from the trailing bytes of an event index key.
+func eventPositionFromKey(key []byte) flow.EventPosition {
+	suffix := key[len(key)-16:]
+	return flow.EventPosition{
+		Height:           binary.BigEndian.Uint64(suffix[0:8]),
+		TransactionIndex: binary.BigEndian.Uint32(suffix[8:12]),
+		EventIndex:       binary.BigEndian.Uint32(suffix[12:16]),
+	}
+}
+
+// eventLocatorIterationFunc returns an iteration function which collects at most limit event locators
+// accepted by the optional match function. Once the limit is reached, all remaining keys are skipped.
+func eventLocatorIterationFunc(locators *[]flow.EventLocator, limit uint, match func(*flow.EventLocator) bool) func() (checkFunc, createFunc, handleFunc) {
+	found := uint(0)
+	return func() (checkFunc, createFunc, handleFunc) {
+		check := func(key []byte) bool {
+			return found < limit
+		}
+		var val flow.EventLocator
+		create := func() interface{} {
+			return &val
+		}
+		handle := func() error {
+			if match != nil && !match(&val) {
+				return nil
+			}
+			*locators = append(*locators, val)
+			found++
+			return nil
+		}
+		return check, create, handle
+	}
+}
+
 // eventIterationFunc returns an in iteration function which returns all events found during traversal or iteration
 func eventIterationFunc(events *[]flow.Event) func() (checkFunc, createFunc, handleFunc) {
 	return func() (checkFunc, createFunc, handleFunc) {
@@ -5,17 +5,17 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 // TestRetrieveEventByBlockIDTxID tests event insertion, event retrieval by block id, block id and transaction id,
 // and block id and event type
 func TestRetrieveEventByBlockIDTxID(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		// create block ids, transaction ids and event types slices
 		blockIDs := []flow.Identifier{flow.HashToID([]byte{0x01}), flow.HashToID([]byte{0x02})}
@@ -135,3 +135,103 @@ func sortEvent(events []flow.Event) {
 
 	})
 }
+
+// TestEventIndex tests indexing event locators by type and contract address, and looking them up with pagination.
+func TestEventIndex(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+		address := unittest.AddressFixture()
+		deposit := flow.EventType("A." + address.Hex() + ".FlowToken.TokensDeposited")
+		withdraw := flow.EventType("A." + address.Hex() + ".FlowToken.TokensWithdrawn")
+		other := flow.EventType("A.0000000000000001.Other.Event")
+
+		var locators []flow.EventLocator
+		batch := db.NewWriteBatch()
+		for height := uint64(10); height < 20; height++ {
+			for i, eventType := range []flow.EventType{deposit, withdraw, other, flow.EventAccountCreated} {
+				locator := flow.EventLocator{
+					Type:             eventType,
+					BlockID:          unittest.IdentifierFixture(),
+					Height:           height,
+					TransactionID:    unittest.IdentifierFixture(),
+					TransactionIndex: 0,
+					EventIndex:       uint32(i),
+				}
+				locators = append(locators, locator)
+				require.NoError(t, BatchIndexEventByType(locator)(batch))
+				if contract, ok := eventType.Address(); ok {
+					require.NoError(t, BatchIndexEventByAddress(contract, locator)(batch))
+				}
+			}
+		}
+		require.NoError(t, batch.Flush())
+
+		filter := func(match func(flow.EventLocator) bool) []flow.EventLocator {
+			var filtered []flow.EventLocator
+			for _, locator := range locators {
+				if match(locator) {
+					filtered = append(filtered, locator)
+				}
+			}
+			return filtered
+		}
+
+		t.Run("lookup by exact type", func(t *testing.T) {
+			var actual []flow.EventLocator
+			err := db.View(LookupEventLocatorsByType(deposit, flow.EventPosition{Height: 12}, 15, 100, &actual))
+			require.NoError(t, err)
+			expected := filter(func(l flow.EventLocator) bool {
+				return l.Type == deposit && l.Height >= 12 && l.Height <= 15
+			})
+			require.Equal(t, expected, actual)
+		})
+
+		t.Run("lookup by exact type with limit", func(t *testing.T) {
+			var actual []flow.EventLocator
+			err := db.View(LookupEventLocatorsByType(deposit, flow.EventPosition{Height: 10}, 19, 3, &actual))
+			require.NoError(t, err)
+			require.Len(t, actual, 3)
+			require.Equal(t, uint64(12), actual[2].Height)
+		})
+
+		t.Run("lookup by address and type prefix", func(t *testing.T) {
+			var actual []flow.EventLocator
+			err := db.View(LookupEventLocatorsByAddress(address, "A."+address.Hex()+".FlowToken.", flow.EventPosition{Height: 10, EventIndex: 1}, 11, 100, &actual))
+			require.NoError(t, err)
+			expected := filter(func(l flow.EventLocator) bool {
+				return (l.Type == deposit || l.Type == withdraw) && l.Height <= 11 && !l.Position().Less(flow.EventPosition{Height: 10, EventIndex: 1})
+			})
+			require.Equal(t, expected, actual)
+		})
+
+		t.Run("lookup by type prefix", func(t *testing.T) {
+			var actual []flow.EventLocator
+			err := db.View(LookupEventLocatorsByTypePrefix("flow.", flow.EventPosition{Height: 10}, 19, 4, &actual))
+			require.NoError(t, err)
+			expected := filter(func(l flow.EventLocator) bool {
+				return l.Type == flow.EventAccountCreated
+			})
+			require.Equal(t, expected[:4], actual)
+		})
+
+		t.Run("lookup by type prefix merges types by position", func(t *testing.T) {
+			from := flow.EventPosition{Height: 11, EventIndex: 1}
+			var actual []flow.EventLocator
+			err := db.View(LookupEventLocatorsByTypePrefix("A.", from, 19, 5, &actual))
+			require.NoError(t, err)
+			expected := filter(func(l flow.EventLocator) bool {
+				return l.Type != flow.EventAccountCreated && !l.Position().Less(from)
+			})
+			require.Equal(t, expected[:5], actual)
+		})
+
+		t.Run("lookup by type prefix stops at end height", func(t *testing.T) {
+			var actual []flow.EventLocator
+			err := db.View(LookupEventLocatorsByTypePrefix("A.", flow.EventPosition{Height: 18}, 18, 100, &actual))
+			require.NoError(t, err)
+			expected := filter(func(l flow.EventLocator) bool {
+				return l.Type != flow.EventAccountCreated && l.Height == 18
+			})
+			require.Equal(t, expected, actual)
+		})
+	})
+}
@@ -1,23 +1,22 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func InsertGuarantee(collID flow.Identifier, guarantee *flow.CollectionGuarantee) func(*badger.Txn) error {
+func InsertGuarantee(collID flow.Identifier, guarantee *flow.CollectionGuarantee) func(kv.Transaction) error {
 	return insert(makePrefix(codeGuarantee, collID), guarantee)
 }
 
-func RetrieveGuarantee(collID flow.Identifier, guarantee *flow.CollectionGuarantee) func(*badger.Txn) error {
+func RetrieveGuarantee(collID flow.Identifier, guarantee *flow.CollectionGuarantee) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeGuarantee, collID), guarantee)
 }
 
-func IndexPayloadGuarantees(blockID flow.Identifier, guarIDs []flow.Identifier) func(*badger.Txn) error {
+func IndexPayloadGuarantees(blockID flow.Identifier, guarIDs []flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codePayloadGuarantees, blockID), guarIDs)
 }
 
-func LookupPayloadGuarantees(blockID flow.Identifier, guarIDs *[]flow.Identifier) func(*badger.Txn) error {
+func LookupPayloadGuarantees(blockID flow.Identifier, guarIDs *[]flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codePayloadGuarantees, blockID), guarIDs)
 }
@@ -3,79 +3,90 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func InsertHeader(headerID flow.Identifier, header *flow.Header) func(*badger.Txn) error {
+func InsertHeader(headerID flow.Identifier, header *flow.Header) func(kv.Transaction) error {
 	return insert(makePrefix(codeHeader, headerID), header)
 }
 
-func RetrieveHeader(blockID flow.Identifier, header *flow.Header) func(*badger.Txn) error {
+func RetrieveHeader(blockID flow.Identifier, header *flow.Header) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeHeader, blockID), header)
 }
 
 // IndexBlockHeight indexes the height of a block. It should only be called on
 // finalized blocks.
-func IndexBlockHeight(height uint64, blockID flow.Identifier) func(*badger.Txn) error {
+func IndexBlockHeight(height uint64, blockID flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeHeightToBlock, height), blockID)
 }
 
+// ReindexBlockHeight updates the block indexed at the given height. It is
+// used to repair the height index of finalized blocks.
+func ReindexBlockHeight(height uint64, blockID flow.Identifier) func(kv.Transaction) error {
+	return update(makePrefix(codeHeightToBlock, height), blockID)
+}
+
+// RemoveBlockHeight removes the block indexed at the given height. It is used
+// to remove index entries above the finalized height.
+func RemoveBlockHeight(height uint64) func(kv.Transaction) error {
+	return remove(makePrefix(codeHeightToBlock, height))
+}
+
 // LookupBlockHeight retrieves finalized blocks by height.
-func LookupBlockHeight(height uint64, blockID *flow.Identifier) func(*badger.Txn) error {
+func LookupBlockHeight(height uint64, blockID *flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeHeightToBlock, height), blockID)
 }
 
 // InsertBlockValidity marks a block as valid or invalid, defined by the consensus algorithm.
-func InsertBlockValidity(blockID flow.Identifier, valid bool) func(*badger.Txn) error {
+func InsertBlockValidity(blockID flow.Identifier, valid bool) func(kv.Transaction) error {
 	return insert(makePrefix(codeBlockValidity, blockID), valid)
 }
 
 // RetrieveBlockValidity returns a block's validity wrt the consensus algorithm.
-func RetrieveBlockValidity(blockID flow.Identifier, valid *bool) func(*badger.Txn) error {
+func RetrieveBlockValidity(blockID flow.Identifier, valid *bool) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeBlockValidity, blockID), valid)
 }
 
-func InsertExecutedBlock(blockID flow.Identifier) func(*badger.Txn) error {
+func InsertExecutedBlock(blockID flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeExecutedBlock), blockID)
 }
 
-func UpdateExecutedBlock(blockID flow.Identifier) func(*badger.Txn) error {
+func UpdateExecutedBlock(blockID flow.Identifier) func(kv.Transaction) error {
 	return update(makePrefix(codeExecutedBlock), blockID)
 }
 
-func RetrieveExecutedBlock(blockID *flow.Identifier) func(*badger.Txn) error {
+func RetrieveExecutedBlock(blockID *flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeExecutedBlock), blockID)
 }
 
 // IndexCollectionBlock indexes a block by a collection within that block.
-func IndexCollectionBlock(collID flow.Identifier, blockID flow.Identifier) func(*badger.Txn) error {
+func IndexCollectionBlock(collID flow.Identifier, blockID flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeCollectionBlock, collID), blockID)
 }
 
-func IndexBlockIDByChunkID(chunkID, blockID flow.Identifier) func(*badger.Txn) error {
+func IndexBlockIDByChunkID(chunkID, blockID flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeIndexBlockByChunkID, chunkID), blockID)
 }
 
 // BatchIndexBlockByChunkID indexes blockID by chunkID into a batch
-func BatchIndexBlockByChunkID(blockID, chunkID flow.Identifier) func(batch *badger.WriteBatch) error {
+func BatchIndexBlockByChunkID(blockID, chunkID flow.Identifier) func(batch kv.Writer) error {
 	return batchInsert(makePrefix(codeIndexBlockByChunkID, chunkID), blockID)
 }
 
 // LookupCollectionBlock looks up a block by a collection within that block.
-func LookupCollectionBlock(collID flow.Identifier, blockID *flow.Identifier) func(*badger.Txn) error {
+func LookupCollectionBlock(collID flow.Identifier, blockID *flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeCollectionBlock, collID), blockID)
 }
 
 // LookupBlockIDByChunkID looks up a block by a collection within that block.
-func LookupBlockIDByChunkID(chunkID flow.Identifier, blockID *flow.Identifier) func(*badger.Txn) error {
+func LookupBlockIDByChunkID(chunkID flow.Identifier, blockID *flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeIndexBlockByChunkID, chunkID), blockID)
 }
 
 // FindHeaders iterates through all headers, calling `filter` on each, and adding
 // them to the `found` slice if `filter` returned true
-func FindHeaders(filter func(header *flow.Header) bool, found *[]flow.Header) func(*badger.Txn) error {
+func FindHeaders(filter func(header *flow.Header) bool, found *[]flow.Header) func(kv.Transaction) error {
 	return traverse(makePrefix(codeHeader), func() (checkFunc, createFunc, handleFunc) {
 		check := func(key []byte) bool {
 			return true
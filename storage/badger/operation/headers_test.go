@@ -6,17 +6,18 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestHeaderInsertCheckRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		expected := flow.Header{
 			View:               1337,
 			Timestamp:          time.Now().UTC(),
@@ -41,7 +42,7 @@ func TestHeaderInsertCheckRetrieve(t *testing.T) {
 }
 
 func TestHeaderIDIndexByCollectionID(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		headerID := unittest.IdentifierFixture()
 		collectionID := unittest.IdentifierFixture()
@@ -57,7 +58,7 @@ func TestHeaderIDIndexByCollectionID(t *testing.T) {
 }
 
 func TestBlockHeightIndexLookup(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		height := uint64(1337)
 		expected := flow.Identifier{0x01, 0x02, 0x03}
@@ -72,3 +73,29 @@ func TestBlockHeightIndexLookup(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 }
+
+func TestBlockHeightReindexRemove(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+
+		height := uint64(1337)
+		first := flow.Identifier{0x01, 0x02, 0x03}
+		second := flow.Identifier{0x04, 0x05, 0x06}
+
+		err := db.Update(IndexBlockHeight(height, first))
+		require.Nil(t, err)
+
+		err = db.Update(ReindexBlockHeight(height, second))
+		require.Nil(t, err)
+
+		var actual flow.Identifier
+		err = db.View(LookupBlockHeight(height, &actual))
+		require.Nil(t, err)
+		assert.Equal(t, second, actual)
+
+		err = db.Update(RemoveBlockHeight(height))
+		require.Nil(t, err)
+
+		err = db.View(LookupBlockHeight(height, &actual))
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
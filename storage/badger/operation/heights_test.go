@@ -5,15 +5,15 @@ package operation
 import (
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestFinalizedInsertUpdateRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		height := uint64(1337)
 
 		err := db.Update(InsertFinalizedHeight(height))
@@ -37,7 +37,7 @@ func TestFinalizedInsertUpdateRetrieve(t *testing.T) {
 }
 
 func TestSealedInsertUpdateRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		height := uint64(1337)
 
 		err := db.Update(InsertSealedHeight(height))
@@ -61,7 +61,7 @@ func TestSealedInsertUpdateRetrieve(t *testing.T) {
 }
 
 func TestLastCompleteBlockHeightInsertUpdateRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		height := uint64(1337)
 
 		err := db.Update(InsertLastCompleteBlockHeight(height))
@@ -4,9 +4,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // marker used to denote a database type
@@ -26,19 +25,19 @@ const (
 	dbMarkerSecret
 )
 
-func InsertPublicDBMarker(txn *badger.Txn) error {
+func InsertPublicDBMarker(txn kv.Transaction) error {
 	return insertDBTypeMarker(dbMarkerPublic)(txn)
 }
 
-func InsertSecretDBMarker(txn *badger.Txn) error {
+func InsertSecretDBMarker(txn kv.Transaction) error {
 	return insertDBTypeMarker(dbMarkerSecret)(txn)
 }
 
-func EnsurePublicDB(db *badger.DB) error {
+func EnsurePublicDB(db kv.DB) error {
 	return ensureDBWithType(db, dbMarkerPublic)
 }
 
-func EnsureSecretDB(db *badger.DB) error {
+func EnsureSecretDB(db kv.DB) error {
 	return ensureDBWithType(db, dbMarkerSecret)
 }
 
@@ -46,8 +45,8 @@ func EnsureSecretDB(db *badger.DB) error {
 // already exists in the database, this function will return an error if the
 // marker does not match the argument, or return nil if it matches.
 //
-func insertDBTypeMarker(marker dbTypeMarker) func(*badger.Txn) error {
-	return func(txn *badger.Txn) error {
+func insertDBTypeMarker(marker dbTypeMarker) func(kv.Transaction) error {
+	return func(txn kv.Transaction) error {
 		var storedMarker dbTypeMarker
 		err := retrieveDBType(&storedMarker)(txn)
 		if err != nil && !errors.Is(err, storage.ErrNotFound) {
@@ -73,7 +72,7 @@ func insertDBTypeMarker(marker dbTypeMarker) func(*badger.Txn) error {
 // given database type marker. If the given database has not been initialized
 // with any marker, or with a different marker than expected, returns an error.
 //
-func ensureDBWithType(db *badger.DB, expectedMarker dbTypeMarker) error {
+func ensureDBWithType(db kv.DB, expectedMarker dbTypeMarker) error {
 	var actualMarker dbTypeMarker
 	err := db.View(retrieveDBType(&actualMarker))
 	if err != nil {
@@ -85,6 +84,6 @@ func ensureDBWithType(db *badger.DB, expectedMarker dbTypeMarker) error {
 	return nil
 }
 
-func retrieveDBType(marker *dbTypeMarker) func(*badger.Txn) error {
+func retrieveDBType(marker *dbTypeMarker) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeDBType), marker)
 }
@@ -3,14 +3,13 @@ package operation
 import (
 	"github.com/onflow/flow-go/engine/execution/state/delta"
 	"github.com/onflow/flow-go/model/flow"
-
-	"github.com/dgraph-io/badger/v2"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func InsertExecutionStateInteractions(blockID flow.Identifier, interactions []*delta.Snapshot) func(*badger.Txn) error {
+func InsertExecutionStateInteractions(blockID flow.Identifier, interactions []*delta.Snapshot) func(kv.Transaction) error {
 	return insert(makePrefix(codeExecutionStateInteractions, blockID), interactions)
 }
 
-func RetrieveExecutionStateInteractions(blockID flow.Identifier, interactions *[]*delta.Snapshot) func(*badger.Txn) error {
+func RetrieveExecutionStateInteractions(blockID flow.Identifier, interactions *[]*delta.Snapshot) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeExecutionStateInteractions, blockID), interactions)
 }
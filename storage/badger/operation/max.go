@@ -5,9 +5,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
-	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // maxKey is the biggest allowed key size in badger
@@ -24,25 +22,24 @@ func init() {
 
 // InitMax retrieves the maximum key length to have it internally in the
 // package after restarting.
-func InitMax(tx *badger.Txn) error {
+func InitMax(tx kv.Transaction) error {
 	key := makePrefix(codeMax)
-	item, err := tx.Get(key)
-	if errors.Is(err, badger.ErrKeyNotFound) { // just keep zero value as default
+	err := tx.Get(key, func(val []byte) error {
+		max = binary.LittleEndian.Uint32(val)
+		return nil
+	})
+	if errors.Is(err, kv.ErrNotFound) { // just keep zero value as default
 		max = 0
 		return nil
 	}
 	if err != nil {
 		return fmt.Errorf("could not get max: %w", err)
 	}
-	_ = item.Value(func(val []byte) error {
-		max = binary.LittleEndian.Uint32(val)
-		return nil
-	})
 	return nil
 }
 
 // SetMax sets the value for the maximum key length used for efficient iteration.
-func SetMax(tx storage.Transaction) error {
+func SetMax(tx kv.Writer) error {
 	key := makePrefix(codeMax)
 	val := make([]byte, 4)
 	binary.LittleEndian.PutUint32(val, max)
@@ -3,15 +3,14 @@ package operation
 import (
 	"errors"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func SkipDuplicates(op func(*badger.Txn) error) func(tx *badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func SkipDuplicates(op func(kv.Transaction) error) func(tx kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		err := op(tx)
 		if errors.Is(err, storage.ErrAlreadyExists) {
 			metrics.GetStorageCollector().SkipDuplicate()
@@ -21,10 +20,10 @@ func SkipDuplicates(op func(*badger.Txn) error) func(tx *badger.Txn) error {
 	}
 }
 
-func RetryOnConflict(action func(func(*badger.Txn) error) error, op func(tx *badger.Txn) error) error {
+func RetryOnConflict(action func(func(kv.Transaction) error) error, op func(tx kv.Transaction) error) error {
 	for {
 		err := action(op)
-		if errors.Is(err, badger.ErrConflict) {
+		if errors.Is(err, kv.ErrConflict) {
 			metrics.GetStorageCollector().RetryOnConflict()
 			continue
 		}
@@ -32,10 +31,10 @@ func RetryOnConflict(action func(func(*badger.Txn) error) error, op func(tx *bad
 	}
 }
 
-func RetryOnConflictTx(db *badger.DB, action func(*badger.DB, func(*transaction.Tx) error) error, op func(*transaction.Tx) error) error {
+func RetryOnConflictTx(db kv.DB, action func(kv.DB, func(*transaction.Tx) error) error, op func(*transaction.Tx) error) error {
 	for {
 		err := action(db, op)
-		if errors.Is(err, badger.ErrConflict) {
+		if errors.Is(err, kv.ErrConflict) {
 			metrics.GetStorageCollector().RetryOnConflict()
 			continue
 		}
@@ -7,16 +7,16 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v4"
 
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestSkipDuplicates(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		e := Entity{ID: 1337}
 		key := []byte{0x01, 0x02, 0x03}
 		val, _ := msgpack.Marshal(e)
@@ -33,10 +33,11 @@ func TestSkipDuplicates(t *testing.T) {
 
 		// ensure old value is still used
 		var act []byte
-		_ = db.View(func(tx *badger.Txn) error {
-			item, err := tx.Get(key)
-			require.NoError(t, err)
-			act, err = item.ValueCopy(nil)
+		_ = db.View(func(tx kv.Transaction) error {
+			err := tx.Get(key, func(val []byte) error {
+				act = append([]byte(nil), val...)
+				return nil
+			})
 			require.NoError(t, err)
 			return nil
 		})
@@ -46,9 +47,9 @@ func TestSkipDuplicates(t *testing.T) {
 }
 
 func TestRetryOnConflict(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		t.Run("good op", func(t *testing.T) {
-			goodOp := func(*badger.Txn) error {
+			goodOp := func(kv.Transaction) error {
 				return nil
 			}
 			err := RetryOnConflict(db.Update, goodOp)
@@ -57,12 +58,12 @@ func TestRetryOnConflict(t *testing.T) {
 
 		t.Run("conflict op should be retried", func(t *testing.T) {
 			n := 0
-			conflictOp := func(*badger.Txn) error {
+			conflictOp := func(kv.Transaction) error {
 				n++
 				if n > 3 {
 					return nil
 				}
-				return badger.ErrConflict
+				return kv.ErrConflict
 			}
 			err := RetryOnConflict(db.Update, conflictOp)
 			require.NoError(t, err)
@@ -70,12 +71,12 @@ func TestRetryOnConflict(t *testing.T) {
 
 		t.Run("wrapped conflict op should be retried", func(t *testing.T) {
 			n := 0
-			conflictOp := func(*badger.Txn) error {
+			conflictOp := func(kv.Transaction) error {
 				n++
 				if n > 3 {
 					return nil
 				}
-				return fmt.Errorf("wrap error: %w", badger.ErrConflict)
+				return fmt.Errorf("wrap error: %w", kv.ErrConflict)
 			}
 			err := RetryOnConflict(db.Update, conflictOp)
 			require.NoError(t, err)
@@ -83,7 +84,7 @@ func TestRetryOnConflict(t *testing.T) {
 
 		t.Run("other error should be returned", func(t *testing.T) {
 			otherError := errors.New("other error")
-			failOp := func(*badger.Txn) error {
+			failOp := func(kv.Transaction) error {
 				return otherError
 			}
 
@@ -23,6 +23,11 @@ const (
 	codeRootQuorumCertificate = 12
 	codeSporkID               = 13
 	codeProtocolVersion       = 14
+	codeSealingParameters     = 15
+
+	// codes for certificates with special meaning
+	codeNewestQC = 16 // newest QC known to the hotstuff pacemaker
+	codeNewestTC = 17 // newest TC known to the hotstuff pacemaker
 
 	// code for heights with special meaning
 	codeFinalizedHeight         = 20 // latest finalized block height
@@ -69,12 +74,23 @@ const (
 	codeBeaconPrivateKey = 63 // BeaconPrivateKey, keyed by epoch counter
 	codeDKGStarted       = 64 // flag that the DKG for an epoch has been started
 	codeDKGEnded         = 65 // flag that the DKG for an epoch has ended (stores end state)
+	codeDKGTranscript    = 66 // summary of the DKG transcript for an epoch, keyed by epoch counter
+	codeRootQCVote       = 67 // state of this node's vote for its cluster's root QC, keyed by epoch counter
 
 	// job queue consumers and producers
 	codeJobConsumerProcessed = 70
 	codeJobQueue             = 71
 	codeJobQueuePointer      = 72
 
+	// codes for secondary indexes maintained by Access nodes
+	codeIndexEventByType        = 80 // index mapping event type and position to event locator
+	codeIndexEventByAddress     = 81 // index mapping contract address and position to event locator
+	codeIndexAccountTransaction = 82 // index mapping account address and position to account transaction
+
+	// codes for evidence of slashable protocol violations
+	codeSlashingEvidence                = 90 // slashing evidence, keyed by ID
+	codeIndexSlashingEvidenceByOffender = 91 // index mapping offender ID to slashing evidence IDs
+
 	// legacy codes (should be cleaned up)
 	codeChunkDataPack                = 100
 	codeCommit                       = 101
@@ -121,6 +137,8 @@ func b(v interface{}) []byte {
 		return []byte{byte(i)}
 	case flow.Identifier:
 		return i[:]
+	case flow.Address:
+		return i[:]
 	case flow.ChainID:
 		return []byte(i)
 	default:
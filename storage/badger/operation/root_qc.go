@@ -1,9 +1,8 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // InsertRootQuorumCertificate inserts the root quorum certificate for the
@@ -13,14 +12,14 @@ import (
 // Only the root quorum certificate must be explicitly stored in this way!
 // All other quorum certificates are implicitly included in the child of
 // block they certify in the ParentSigs field.
-func InsertRootQuorumCertificate(qc *flow.QuorumCertificate) func(txn *badger.Txn) error {
-	return func(txn *badger.Txn) error {
+func InsertRootQuorumCertificate(qc *flow.QuorumCertificate) func(txn kv.Transaction) error {
+	return func(txn kv.Transaction) error {
 		return insert(makePrefix(codeRootQuorumCertificate), qc)(txn)
 	}
 }
 
-func RetrieveRootQuorumCertificate(qc *flow.QuorumCertificate) func(txn *badger.Txn) error {
-	return func(txn *badger.Txn) error {
+func RetrieveRootQuorumCertificate(qc *flow.QuorumCertificate) func(txn kv.Transaction) error {
+	return func(txn kv.Transaction) error {
 		return retrieve(makePrefix(codeRootQuorumCertificate), qc)(txn)
 	}
 }
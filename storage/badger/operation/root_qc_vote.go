@@ -0,0 +1,21 @@
+package operation
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// InsertRootQCVoteState stores the state of this node's root QC vote for the epoch.
+func InsertRootQCVoteState(epochCounter uint64, state *flow.RootQCVoteState) func(kv.Transaction) error {
+	return insert(makePrefix(codeRootQCVote, epochCounter), state)
+}
+
+// UpdateRootQCVoteState updates the state of this node's root QC vote for the epoch.
+func UpdateRootQCVoteState(epochCounter uint64, state *flow.RootQCVoteState) func(kv.Transaction) error {
+	return update(makePrefix(codeRootQCVote, epochCounter), state)
+}
+
+// RetrieveRootQCVoteState retrieves the state of this node's root QC vote for the epoch.
+func RetrieveRootQCVoteState(epochCounter uint64, state *flow.RootQCVoteState) func(kv.Transaction) error {
+	return retrieve(makePrefix(codeRootQCVote, epochCounter), state)
+}
@@ -5,16 +5,16 @@ package operation
 import (
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestSealInsertCheckRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		expected := unittest.Seal.Fixture()
 
 		err := db.Update(InsertSeal(expected.ID(), expected))
@@ -29,7 +29,7 @@ func TestSealInsertCheckRetrieve(t *testing.T) {
 }
 
 func TestSealIndexAndLookup(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		seal1 := unittest.Seal.Fixture()
 		seal2 := unittest.Seal.Fixture()
 
@@ -39,7 +39,7 @@ func TestSealIndexAndLookup(t *testing.T) {
 
 		expected := flow.GetIDs(seals)
 
-		err := db.Update(func(tx *badger.Txn) error {
+		err := db.Update(func(tx kv.Transaction) error {
 			for _, seal := range seals {
 				if err := InsertSeal(seal.ID(), seal)(tx); err != nil {
 					return err
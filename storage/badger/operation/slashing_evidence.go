@@ -0,0 +1,45 @@
+package operation
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// InsertSlashingEvidence inserts the slashing evidence keyed by its ID. As the ID only depends on
+// the offence, it errors with storage.ErrAlreadyExists if evidence for the same offence was inserted before.
+func InsertSlashingEvidence(evidence *flow.SlashingEvidence) func(kv.Transaction) error {
+	return insert(makePrefix(codeSlashingEvidence, evidence.ID()), evidence)
+}
+
+// RetrieveSlashingEvidence retrieves the slashing evidence with the given ID.
+func RetrieveSlashingEvidence(evidenceID flow.Identifier, evidence *flow.SlashingEvidence) func(kv.Transaction) error {
+	return retrieve(makePrefix(codeSlashingEvidence, evidenceID), evidence)
+}
+
+// IndexSlashingEvidenceByOffender indexes the slashing evidence by the ID of the offender.
+func IndexSlashingEvidenceByOffender(offenderID flow.Identifier, evidenceID flow.Identifier) func(kv.Transaction) error {
+	return insert(makePrefix(codeIndexSlashingEvidenceByOffender, offenderID, evidenceID), evidenceID)
+}
+
+// LookupSlashingEvidenceByOffender retrieves the IDs of all slashing evidence against the given offender.
+func LookupSlashingEvidenceByOffender(offenderID flow.Identifier, evidenceIDs *[]flow.Identifier) func(kv.Transaction) error {
+	return traverse(makePrefix(codeIndexSlashingEvidenceByOffender, offenderID), lookup(evidenceIDs))
+}
+
+// FindSlashingEvidence retrieves all slashing evidence.
+func FindSlashingEvidence(evidence *[]*flow.SlashingEvidence) func(kv.Transaction) error {
+	return traverse(makePrefix(codeSlashingEvidence), func() (checkFunc, createFunc, handleFunc) {
+		check := func(key []byte) bool {
+			return true
+		}
+		var val flow.SlashingEvidence
+		create := func() interface{} {
+			return &val
+		}
+		handle := func() error {
+			*evidence = append(*evidence, &val)
+			return nil
+		}
+		return check, create, handle
+	})
+}
@@ -1,20 +1,19 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // InsertSporkID inserts the spork ID for the present spork. A single database
 // and protocol state instance spans at most one spork, so this is inserted
 // exactly once, when bootstrapping the state.
-func InsertSporkID(sporkID flow.Identifier) func(*badger.Txn) error {
+func InsertSporkID(sporkID flow.Identifier) func(kv.Transaction) error {
 	return insert(makePrefix(codeSporkID), sporkID)
 }
 
 // RetrieveSporkID retrieves the spork ID for the present spork.
-func RetrieveSporkID(sporkID *flow.Identifier) func(*badger.Txn) error {
+func RetrieveSporkID(sporkID *flow.Identifier) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeSporkID), sporkID)
 }
 
@@ -22,11 +21,24 @@ func RetrieveSporkID(sporkID *flow.Identifier) func(*badger.Txn) error {
 // A single database and protocol state instance spans at most one spork, and
 // a spork has exactly one protocol version for its duration, so this is
 // inserted exactly once, when bootstrapping the state.
-func InsertProtocolVersion(version uint) func(*badger.Txn) error {
+func InsertProtocolVersion(version uint) func(kv.Transaction) error {
 	return insert(makePrefix(codeProtocolVersion), version)
 }
 
 // RetrieveProtocolVersion retrieves the protocol version for the present spork.
-func RetrieveProtocolVersion(version *uint) func(*badger.Txn) error {
+func RetrieveProtocolVersion(version *uint) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeProtocolVersion), version)
 }
+
+// InsertSealingParameters inserts the sealing parameters for the present spork,
+// as specified in the root snapshot. Epochs may replace the sealing parameters
+// through their EpochSetup event, which is stored separately. This is inserted
+// exactly once, when bootstrapping the state.
+func InsertSealingParameters(params flow.SealingParameters) func(kv.Transaction) error {
+	return insert(makePrefix(codeSealingParameters), params)
+}
+
+// RetrieveSealingParameters retrieves the sealing parameters for the present spork.
+func RetrieveSealingParameters(params *flow.SealingParameters) func(kv.Transaction) error {
+	return retrieve(makePrefix(codeSealingParameters), params)
+}
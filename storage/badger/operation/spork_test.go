@@ -4,16 +4,16 @@ import (
 	"math/rand"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestSporkID_InsertRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		sporkID := unittest.IdentifierFixture()
 
 		err := db.Update(InsertSporkID(sporkID))
@@ -28,7 +28,7 @@ func TestSporkID_InsertRetrieve(t *testing.T) {
 }
 
 func TestProtocolVersion_InsertRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		version := uint(rand.Uint32())
 
 		err := db.Update(InsertProtocolVersion(version))
@@ -41,3 +41,22 @@ func TestProtocolVersion_InsertRetrieve(t *testing.T) {
 		assert.Equal(t, version, actual)
 	})
 }
+
+func TestSealingParameters_InsertRetrieve(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+		params := flow.SealingParameters{
+			RequiredApprovalsForSealConstruction: 2,
+			RequiredApprovalsForSealVerification: 1,
+			EmergencySealingActive:               true,
+			EmergencySealingThreshold:            50,
+		}
+		err := db.Update(InsertSealingParameters(params))
+		require.NoError(t, err)
+
+		var actual flow.SealingParameters
+		err = db.View(RetrieveSealingParameters(&actual))
+		require.NoError(t, err)
+
+		assert.Equal(t, params, actual)
+	})
+}
@@ -3,31 +3,30 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func InsertTransactionResult(blockID flow.Identifier, transactionResult *flow.TransactionResult) func(*badger.Txn) error {
+func InsertTransactionResult(blockID flow.Identifier, transactionResult *flow.TransactionResult) func(kv.Transaction) error {
 	return insert(makePrefix(codeTransactionResult, blockID, transactionResult.TransactionID), transactionResult)
 }
 
-func BatchInsertTransactionResult(blockID flow.Identifier, transactionResult *flow.TransactionResult) func(batch *badger.WriteBatch) error {
+func BatchInsertTransactionResult(blockID flow.Identifier, transactionResult *flow.TransactionResult) func(batch kv.Writer) error {
 	return batchInsert(makePrefix(codeTransactionResult, blockID, transactionResult.TransactionID), transactionResult)
 }
 
-func BatchIndexTransactionResult(blockID flow.Identifier, txIndex uint32, transactionResult *flow.TransactionResult) func(batch *badger.WriteBatch) error {
+func BatchIndexTransactionResult(blockID flow.Identifier, txIndex uint32, transactionResult *flow.TransactionResult) func(batch kv.Writer) error {
 	return batchInsert(makePrefix(codeTransactionResultIndex, blockID, txIndex), transactionResult)
 }
 
-func RetrieveTransactionResult(blockID flow.Identifier, transactionID flow.Identifier, transactionResult *flow.TransactionResult) func(*badger.Txn) error {
+func RetrieveTransactionResult(blockID flow.Identifier, transactionID flow.Identifier, transactionResult *flow.TransactionResult) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeTransactionResult, blockID, transactionID), transactionResult)
 }
-func RetrieveTransactionResultByIndex(blockID flow.Identifier, txIndex uint32, transactionResult *flow.TransactionResult) func(*badger.Txn) error {
+func RetrieveTransactionResultByIndex(blockID flow.Identifier, txIndex uint32, transactionResult *flow.TransactionResult) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeTransactionResultIndex, blockID, txIndex), transactionResult)
 }
 
-func LookupTransactionResultsByBlockID(blockID flow.Identifier, txResults *[]flow.TransactionResult) func(*badger.Txn) error {
+func LookupTransactionResultsByBlockID(blockID flow.Identifier, txResults *[]flow.TransactionResult) func(kv.Transaction) error {
 
 	txErrIterFunc := func() (checkFunc, createFunc, handleFunc) {
 		check := func(_ []byte) bool {
@@ -50,7 +49,7 @@ func LookupTransactionResultsByBlockID(blockID flow.Identifier, txResults *[]flo
 // LookupTransactionResultsByBlockIDUsingIndex retrieves all tx results for a block, but using
 // tx_index index. This correctly handles cases of duplicate transactions within block, and should
 // eventually replace uses of LookupTransactionResultsByBlockID
-func LookupTransactionResultsByBlockIDUsingIndex(blockID flow.Identifier, txResults *[]flow.TransactionResult) func(*badger.Txn) error {
+func LookupTransactionResultsByBlockIDUsingIndex(blockID flow.Identifier, txResults *[]flow.TransactionResult) func(kv.Transaction) error {
 
 	txErrIterFunc := func() (checkFunc, createFunc, handleFunc) {
 		check := func(_ []byte) bool {
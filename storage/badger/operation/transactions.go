@@ -1,17 +1,16 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // InsertTransaction inserts a transaction keyed by transaction fingerprint.
-func InsertTransaction(txID flow.Identifier, tx *flow.TransactionBody) func(*badger.Txn) error {
+func InsertTransaction(txID flow.Identifier, tx *flow.TransactionBody) func(kv.Transaction) error {
 	return insert(makePrefix(codeTransaction, txID), tx)
 }
 
 // RetrieveTransaction retrieves a transaction by fingerprint.
-func RetrieveTransaction(txID flow.Identifier, tx *flow.TransactionBody) func(*badger.Txn) error {
+func RetrieveTransaction(txID flow.Identifier, tx *flow.TransactionBody) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeTransaction, txID), tx)
 }
@@ -1,37 +1,66 @@
 package operation
 
 import (
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // InsertStartedView inserts a view into the database.
-func InsertStartedView(chainID flow.ChainID, view uint64) func(*badger.Txn) error {
+func InsertStartedView(chainID flow.ChainID, view uint64) func(kv.Transaction) error {
 	return insert(makePrefix(codeStartedView, chainID), view)
 }
 
 // UpdateStartedView updates the view in the database.
-func UpdateStartedView(chainID flow.ChainID, view uint64) func(*badger.Txn) error {
+func UpdateStartedView(chainID flow.ChainID, view uint64) func(kv.Transaction) error {
 	return update(makePrefix(codeStartedView, chainID), view)
 }
 
 // RetrieveStartedView retrieves a view from the database.
-func RetrieveStartedView(chainID flow.ChainID, view *uint64) func(*badger.Txn) error {
+func RetrieveStartedView(chainID flow.ChainID, view *uint64) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeStartedView, chainID), view)
 }
 
 // InsertVotedView inserts a view into the database.
-func InsertVotedView(chainID flow.ChainID, view uint64) func(*badger.Txn) error {
+func InsertVotedView(chainID flow.ChainID, view uint64) func(kv.Transaction) error {
 	return insert(makePrefix(codeVotedView, chainID), view)
 }
 
 // UpdateVotedView updates the view in the database.
-func UpdateVotedView(chainID flow.ChainID, view uint64) func(*badger.Txn) error {
+func UpdateVotedView(chainID flow.ChainID, view uint64) func(kv.Transaction) error {
 	return update(makePrefix(codeVotedView, chainID), view)
 }
 
 // RetrieveVotedView retrieves a view from the database.
-func RetrieveVotedView(chainID flow.ChainID, view *uint64) func(*badger.Txn) error {
+func RetrieveVotedView(chainID flow.ChainID, view *uint64) func(kv.Transaction) error {
 	return retrieve(makePrefix(codeVotedView, chainID), view)
 }
+
+// InsertNewestQC inserts the newest QC known to hotstuff into the database.
+func InsertNewestQC(chainID flow.ChainID, qc *flow.QuorumCertificate) func(kv.Transaction) error {
+	return insert(makePrefix(codeNewestQC, chainID), qc)
+}
+
+// UpdateNewestQC updates the newest QC known to hotstuff in the database.
+func UpdateNewestQC(chainID flow.ChainID, qc *flow.QuorumCertificate) func(kv.Transaction) error {
+	return update(makePrefix(codeNewestQC, chainID), qc)
+}
+
+// RetrieveNewestQC retrieves the newest QC known to hotstuff from the database.
+func RetrieveNewestQC(chainID flow.ChainID, qc *flow.QuorumCertificate) func(kv.Transaction) error {
+	return retrieve(makePrefix(codeNewestQC, chainID), qc)
+}
+
+// InsertNewestTC inserts the newest TC known to hotstuff into the database.
+func InsertNewestTC(chainID flow.ChainID, tc *flow.TimeoutCertificate) func(kv.Transaction) error {
+	return insert(makePrefix(codeNewestTC, chainID), tc)
+}
+
+// UpdateNewestTC updates the newest TC known to hotstuff in the database.
+func UpdateNewestTC(chainID flow.ChainID, tc *flow.TimeoutCertificate) func(kv.Transaction) error {
+	return update(makePrefix(codeNewestTC, chainID), tc)
+}
+
+// RetrieveNewestTC retrieves the newest TC known to hotstuff from the database.
+func RetrieveNewestTC(chainID flow.ChainID, tc *flow.TimeoutCertificate) func(kv.Transaction) error {
+	return retrieve(makePrefix(codeNewestTC, chainID), tc)
+}
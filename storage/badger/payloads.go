@@ -4,16 +4,15 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
 	"github.com/onflow/flow-go/storage/badger/transaction"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type Payloads struct {
-	db         *badger.DB
+	db         kv.DB
 	index      *Index
 	guarantees *Guarantees
 	seals      *Seals
@@ -21,7 +20,7 @@ type Payloads struct {
 	results    *ExecutionResults
 }
 
-func NewPayloads(db *badger.DB, index *Index, guarantees *Guarantees, seals *Seals, receipts *ExecutionReceipts,
+func NewPayloads(db kv.DB, index *Index, guarantees *Guarantees, seals *Seals, receipts *ExecutionReceipts,
 	results *ExecutionResults) *Payloads {
 
 	p := &Payloads{
@@ -95,8 +94,8 @@ func (p *Payloads) storeTx(blockID flow.Identifier, payload *flow.Payload) func(
 	}
 }
 
-func (p *Payloads) retrieveTx(blockID flow.Identifier) func(tx *badger.Txn) (*flow.Payload, error) {
-	return func(tx *badger.Txn) (*flow.Payload, error) {
+func (p *Payloads) retrieveTx(blockID flow.Identifier) func(tx kv.Transaction) (*flow.Payload, error) {
+	return func(tx kv.Transaction) (*flow.Payload, error) {
 
 		// retrieve the index
 		idx, err := p.index.retrieveTx(blockID)(tx)
@@ -4,18 +4,18 @@ import (
 	"errors"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/procedure"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 // after indexing a block by its parent, it should be able to retrieve the child block by the parentID
 func TestIndexAndLookupChild(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		parentID := unittest.IdentifierFixture()
 		childID := unittest.IdentifierFixture()
@@ -37,7 +37,7 @@ func TestIndexAndLookupChild(t *testing.T) {
 // no effect, retrieving the child of the parent block will return the first block that
 // was indexed.
 func TestIndexTwiceAndRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		parentID := unittest.IdentifierFixture()
 		child1ID := unittest.IdentifierFixture()
@@ -61,7 +61,7 @@ func TestIndexTwiceAndRetrieve(t *testing.T) {
 
 // if parent is zero, then we don't index it
 func TestIndexZeroParent(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		childID := unittest.IdentifierFixture()
 
@@ -77,7 +77,7 @@ func TestIndexZeroParent(t *testing.T) {
 
 // lookup block children will only return direct childrens
 func TestDirectChildren(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 
 		b1 := unittest.IdentifierFixture()
 		b2 := unittest.IdentifierFixture()
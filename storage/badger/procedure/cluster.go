@@ -3,19 +3,18 @@ package procedure
 import (
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/cluster"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 // This file implements storage functions for blocks in cluster consensus.
 
 // InsertClusterBlock inserts a cluster consensus block, updating all
 // associated indexes.
-func InsertClusterBlock(block *cluster.Block) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func InsertClusterBlock(block *cluster.Block) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 
 		// check payload integrity
 		if block.Header.PayloadHash != block.Payload.Hash() {
@@ -45,8 +44,8 @@ func InsertClusterBlock(block *cluster.Block) func(*badger.Txn) error {
 }
 
 // RetrieveClusterBlock retrieves a cluster consensus block by block ID.
-func RetrieveClusterBlock(blockID flow.Identifier, block *cluster.Block) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func RetrieveClusterBlock(blockID flow.Identifier, block *cluster.Block) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 
 		// retrieve the block header
 		var header flow.Header
@@ -74,8 +73,8 @@ func RetrieveClusterBlock(blockID flow.Identifier, block *cluster.Block) func(*b
 
 // RetrieveLatestFinalizedClusterHeader retrieves the latest finalized for the
 // given cluster chain ID.
-func RetrieveLatestFinalizedClusterHeader(chainID flow.ChainID, final *flow.Header) func(tx *badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func RetrieveLatestFinalizedClusterHeader(chainID flow.ChainID, final *flow.Header) func(tx kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		var boundary uint64
 		err := operation.RetrieveClusterFinalizedHeight(chainID, &boundary)(tx)
 		if err != nil {
@@ -98,8 +97,8 @@ func RetrieveLatestFinalizedClusterHeader(chainID flow.ChainID, final *flow.Head
 }
 
 // FinalizeClusterBlock finalizes a block in cluster consensus.
-func FinalizeClusterBlock(blockID flow.Identifier) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func FinalizeClusterBlock(blockID flow.Identifier) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 
 		// retrieve the header to check the parent
 		var header flow.Header
@@ -153,8 +152,8 @@ func FinalizeClusterBlock(blockID flow.Identifier) func(*badger.Txn) error {
 
 // InsertClusterPayload inserts the payload for a cluster block. It inserts
 // both the collection and all constituent transactions, allowing duplicates.
-func InsertClusterPayload(blockID flow.Identifier, payload *cluster.Payload) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func InsertClusterPayload(blockID flow.Identifier, payload *cluster.Payload) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 
 		// cluster payloads only contain a single collection, allow duplicates,
 		// because it is valid for two competing forks to have the same payload.
@@ -190,8 +189,8 @@ func InsertClusterPayload(blockID flow.Identifier, payload *cluster.Payload) fun
 }
 
 // RetrieveClusterPayload retrieves a cluster consensus block payload by block ID.
-func RetrieveClusterPayload(blockID flow.Identifier, payload *cluster.Payload) func(*badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func RetrieveClusterPayload(blockID flow.Identifier, payload *cluster.Payload) func(kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 
 		// lookup the reference block ID
 		var refID flow.Identifier
@@ -3,14 +3,13 @@ package procedure
 import (
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
-func InsertIndex(blockID flow.Identifier, index *flow.Index) func(tx *badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func InsertIndex(blockID flow.Identifier, index *flow.Index) func(tx kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		err := operation.IndexPayloadGuarantees(blockID, index.CollectionIDs)(tx)
 		if err != nil {
 			return fmt.Errorf("could not store guarantee index: %w", err)
@@ -31,8 +30,8 @@ func InsertIndex(blockID flow.Identifier, index *flow.Index) func(tx *badger.Txn
 	}
 }
 
-func RetrieveIndex(blockID flow.Identifier, index *flow.Index) func(tx *badger.Txn) error {
-	return func(tx *badger.Txn) error {
+func RetrieveIndex(blockID flow.Identifier, index *flow.Index) func(tx kv.Transaction) error {
+	return func(tx kv.Transaction) error {
 		var collIDs []flow.Identifier
 		err := operation.LookupPayloadGuarantees(blockID, &collIDs)(tx)
 		if err != nil {
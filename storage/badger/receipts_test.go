@@ -3,18 +3,18 @@ package badger_test
 import (
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/metrics"
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestExecutionReceiptsStorage(t *testing.T) {
 	withStore := func(t *testing.T, f func(store *bstorage.ExecutionReceipts)) {
-		unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 			metrics := metrics.NewNoopCollector()
 			results := bstorage.NewExecutionResults(metrics, db)
 			store := bstorage.NewExecutionReceipts(metrics, db, results, bstorage.DefaultCacheSize)
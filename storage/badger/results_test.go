@@ -4,17 +4,17 @@ import (
 	"errors"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
 func TestResultStoreAndRetrieve(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := bstorage.NewExecutionResults(metrics, db)
 
@@ -34,7 +34,7 @@ func TestResultStoreAndRetrieve(t *testing.T) {
 }
 
 func TestResultStoreTwice(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := bstorage.NewExecutionResults(metrics, db)
 
@@ -55,7 +55,7 @@ func TestResultStoreTwice(t *testing.T) {
 }
 
 func TestResultStoreTwoDifferentResultsShouldFail(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := bstorage.NewExecutionResults(metrics, db)
 
@@ -81,7 +81,7 @@ func TestResultStoreTwoDifferentResultsShouldFail(t *testing.T) {
 }
 
 func TestResultStoreForceIndexOverridesMapping(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := bstorage.NewExecutionResults(metrics, db)
 
@@ -0,0 +1,45 @@
+package badger
+
+import (
+	"errors"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// RootQCVotes stores the state of this node's votes for the root QC of its
+// cluster, backed by Badger DB.
+type RootQCVotes struct {
+	db kv.DB
+}
+
+var _ storage.RootQCVotes = (*RootQCVotes)(nil)
+
+// NewRootQCVotes returns the RootQCVotes implementation backed by Badger DB.
+func NewRootQCVotes(db kv.DB) *RootQCVotes {
+	return &RootQCVotes{db: db}
+}
+
+// Store stores the root QC vote state for the epoch given by the state's
+// epoch counter, replacing any state stored previously.
+func (v *RootQCVotes) Store(state *flow.RootQCVoteState) error {
+	return v.db.Update(func(tx kv.Transaction) error {
+		err := operation.UpdateRootQCVoteState(state.EpochCounter, state)(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			return operation.InsertRootQCVoteState(state.EpochCounter, state)(tx)
+		}
+		return err
+	})
+}
+
+// ByEpochCounter retrieves the root QC vote state for the given epoch.
+func (v *RootQCVotes) ByEpochCounter(epochCounter uint64) (*flow.RootQCVoteState, error) {
+	var state flow.RootQCVoteState
+	err := v.db.View(operation.RetrieveRootQCVoteState(epochCounter, &state))
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
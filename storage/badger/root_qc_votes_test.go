@@ -0,0 +1,59 @@
+package badger_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestRootQCVotes(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+		store := bstorage.NewRootQCVotes(db)
+
+		rand.Seed(time.Now().UnixNano())
+		epochCounter := rand.Uint64()
+		state := unittest.RootQCVoteStateFixture(epochCounter, unittest.IdentifierListFixture(3))
+
+		t.Run("should error if retrieving non-existent state", func(t *testing.T) {
+			_, err := store.ByEpochCounter(epochCounter)
+			assert.True(t, errors.Is(err, storage.ErrNotFound))
+		})
+
+		t.Run("should be able to store and read a state", func(t *testing.T) {
+			err := store.Store(state)
+			require.NoError(t, err)
+
+			actual, err := store.ByEpochCounter(epochCounter)
+			require.NoError(t, err)
+			assertRootQCVoteStateEqual(t, state, actual)
+		})
+
+		t.Run("should be able to replace a state", func(t *testing.T) {
+			state.Status = flow.RootQCVoteStatusMissed
+			state.Attempts++
+			state.LastError = "no longer in setup phase"
+			err := store.Store(state)
+			require.NoError(t, err)
+
+			actual, err := store.ByEpochCounter(epochCounter)
+			require.NoError(t, err)
+			assertRootQCVoteStateEqual(t, state, actual)
+		})
+	})
+}
+
+func assertRootQCVoteStateEqual(t *testing.T, expected, actual *flow.RootQCVoteState) {
+	actual.LastAttempt = actual.LastAttempt.UTC()
+	actual.LastChecked = actual.LastChecked.UTC()
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,81 @@
+package badger
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// SlashingEvidence implements persistent storage for evidence of slashable protocol violations.
+type SlashingEvidence struct {
+	db kv.DB
+}
+
+func NewSlashingEvidence(db kv.DB) *SlashingEvidence {
+	return &SlashingEvidence{
+		db: db,
+	}
+}
+
+// Store persists the slashing evidence and indexes it by offender. It returns
+// storage.ErrAlreadyExists if evidence for the same offence has been stored before.
+func (s *SlashingEvidence) Store(evidence *flow.SlashingEvidence) error {
+	return operation.RetryOnConflict(s.db.Update, func(tx kv.Transaction) error {
+		err := operation.InsertSlashingEvidence(evidence)(tx)
+		if err != nil {
+			return fmt.Errorf("could not insert slashing evidence: %w", err)
+		}
+		err = operation.IndexSlashingEvidenceByOffender(evidence.OffenderID, evidence.ID())(tx)
+		if err != nil {
+			return fmt.Errorf("could not index slashing evidence by offender: %w", err)
+		}
+		return nil
+	})
+}
+
+// ByID returns the slashing evidence with the given ID.
+func (s *SlashingEvidence) ByID(evidenceID flow.Identifier) (*flow.SlashingEvidence, error) {
+	var evidence flow.SlashingEvidence
+	err := s.db.View(operation.RetrieveSlashingEvidence(evidenceID, &evidence))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve slashing evidence: %w", err)
+	}
+	return &evidence, nil
+}
+
+// ByOffender returns all slashing evidence against the given node.
+func (s *SlashingEvidence) ByOffender(offenderID flow.Identifier) ([]*flow.SlashingEvidence, error) {
+	var all []*flow.SlashingEvidence
+	err := s.db.View(func(tx kv.Transaction) error {
+		var evidenceIDs []flow.Identifier
+		err := operation.LookupSlashingEvidenceByOffender(offenderID, &evidenceIDs)(tx)
+		if err != nil {
+			return fmt.Errorf("could not lookup slashing evidence by offender: %w", err)
+		}
+		for _, evidenceID := range evidenceIDs {
+			var evidence flow.SlashingEvidence
+			err = operation.RetrieveSlashingEvidence(evidenceID, &evidence)(tx)
+			if err != nil {
+				return fmt.Errorf("could not retrieve slashing evidence %x: %w", evidenceID, err)
+			}
+			all = append(all, &evidence)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// All returns all stored slashing evidence.
+func (s *SlashingEvidence) All() ([]*flow.SlashingEvidence, error) {
+	var all []*flow.SlashingEvidence
+	err := s.db.View(operation.FindSlashingEvidence(&all))
+	if err != nil {
+		return nil, fmt.Errorf("could not find slashing evidence: %w", err)
+	}
+	return all, nil
+}
@@ -0,0 +1,83 @@
+package badger_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestSlashingEvidence(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+		store := bstorage.NewSlashingEvidence(db)
+
+		offender := unittest.IdentifierFixture()
+		other := unittest.IdentifierFixture()
+		doubleVote := unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender)
+		doubleProposal := unittest.DoubleProposalEvidenceFixture("cluster", offender)
+		doubleTimeout := unittest.DoubleTimeoutEvidenceFixture(flow.Emulator, other)
+
+		t.Run("should error if retrieving non-existent evidence", func(t *testing.T) {
+			_, err := store.ByID(doubleVote.ID())
+			assert.True(t, errors.Is(err, storage.ErrNotFound))
+		})
+
+		t.Run("should be able to store and read evidence", func(t *testing.T) {
+			for _, evidence := range []*flow.SlashingEvidence{doubleVote, doubleProposal, doubleTimeout} {
+				require.NoError(t, store.Store(evidence))
+			}
+
+			actual, err := store.ByID(doubleVote.ID())
+			require.NoError(t, err)
+			assert.Equal(t, doubleVote, actual)
+
+			actual, err = store.ByID(doubleProposal.ID())
+			require.NoError(t, err)
+			require.Len(t, actual.Proposals, 2)
+			assert.Equal(t, doubleProposal.Proposals[0].ID(), actual.Proposals[0].ID())
+			assert.Equal(t, doubleProposal.Proposals[1].ID(), actual.Proposals[1].ID())
+		})
+
+		t.Run("should deduplicate evidence for the same offence", func(t *testing.T) {
+			duplicate := *doubleVote
+			duplicate.Votes = unittest.DoubleVoteEvidenceFixture(flow.Emulator, offender).Votes
+			err := store.Store(&duplicate)
+			assert.True(t, errors.Is(err, storage.ErrAlreadyExists))
+
+			// the originally stored evidence is kept
+			actual, err := store.ByID(doubleVote.ID())
+			require.NoError(t, err)
+			assert.Equal(t, doubleVote.Votes, actual.Votes)
+		})
+
+		t.Run("should be able to read evidence by offender", func(t *testing.T) {
+			evidence, err := store.ByOffender(offender)
+			require.NoError(t, err)
+			require.Len(t, evidence, 2)
+			ids := flow.IdentifierList{evidence[0].ID(), evidence[1].ID()}
+			assert.ElementsMatch(t, flow.IdentifierList{doubleVote.ID(), doubleProposal.ID()}, ids)
+
+			evidence, err = store.ByOffender(unittest.IdentifierFixture())
+			require.NoError(t, err)
+			assert.Empty(t, evidence)
+		})
+
+		t.Run("should be able to read all evidence", func(t *testing.T) {
+			evidence, err := store.All()
+			require.NoError(t, err)
+			require.Len(t, evidence, 3)
+			ids := make(flow.IdentifierList, 0, len(evidence))
+			for _, e := range evidence {
+				ids = append(ids, e.ID())
+			}
+			assert.ElementsMatch(t, flow.IdentifierList{doubleVote.ID(), doubleProposal.ID(), doubleTimeout.ID()}, ids)
+		})
+	})
+}
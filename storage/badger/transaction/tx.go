@@ -1,11 +1,11 @@
 package transaction
 
 import (
-	dbbadger "github.com/dgraph-io/badger/v2"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type Tx struct {
-	DBTxn     *dbbadger.Txn
+	DBTxn     kv.Transaction
 	callbacks []func()
 }
 
@@ -17,10 +17,10 @@ func (b *Tx) OnSucceed(callback func()) {
 	b.callbacks = append(b.callbacks, callback)
 }
 
-// Update creates a badger transaction, passing it to a chain of functions,
+// Update creates a database transaction, passing it to a chain of functions,
 // if all succeed. Useful to use callback to update cache in order to ensure data
-// in badgerDB and cache are consistent.
-func Update(db *dbbadger.DB, f func(*Tx) error) error {
+// in the database and cache are consistent.
+func Update(db kv.DB, f func(*Tx) error) error {
 	dbTxn := db.NewTransaction(true)
 	defer dbTxn.Discard()
 
@@ -42,7 +42,7 @@ func Update(db *dbbadger.DB, f func(*Tx) error) error {
 }
 
 // WithTx is useful when transaction is used without adding callback.
-func WithTx(f func(*dbbadger.Txn) error) func(*Tx) error {
+func WithTx(f func(kv.Transaction) error) func(*Tx) error {
 	return func(tx *Tx) error {
 		return f(tx.DBTxn)
 	}
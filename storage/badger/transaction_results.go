@@ -5,17 +5,16 @@ import (
 	"encoding/hex"
 	"fmt"
 
-	"github.com/dgraph-io/badger/v2"
-
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 type TransactionResults struct {
-	db         *badger.DB
+	db         kv.DB
 	cache      *Cache
 	indexCache *Cache
 	blockCache *Cache
@@ -82,10 +81,10 @@ func KeyToBlockID(key string) (flow.Identifier, error) {
 	return blockID, err
 }
 
-func NewTransactionResults(collector module.CacheMetrics, db *badger.DB, transactionResultsCacheSize uint) *TransactionResults {
-	retrieve := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+func NewTransactionResults(collector module.CacheMetrics, db kv.DB, transactionResultsCacheSize uint) *TransactionResults {
+	retrieve := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		var txResult flow.TransactionResult
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 
 			blockID, txID, err := KeyToBlockIDTransactionID(key.(string))
 			if err != nil {
@@ -99,9 +98,9 @@ func NewTransactionResults(collector module.CacheMetrics, db *badger.DB, transac
 			return txResult, nil
 		}
 	}
-	retrieveIndex := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+	retrieveIndex := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		var txResult flow.TransactionResult
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 
 			blockID, txIndex, err := KeyToBlockIDIndex(key.(string))
 			if err != nil {
@@ -115,9 +114,9 @@ func NewTransactionResults(collector module.CacheMetrics, db *badger.DB, transac
 			return txResult, nil
 		}
 	}
-	retrieveForBlock := func(key interface{}) func(tx *badger.Txn) (interface{}, error) {
+	retrieveForBlock := func(key interface{}) func(tx kv.Transaction) (interface{}, error) {
 		var txResults []flow.TransactionResult
-		return func(tx *badger.Txn) (interface{}, error) {
+		return func(tx kv.Transaction) (interface{}, error) {
 
 			blockID, err := KeyToBlockID(key.(string))
 			if err != nil {
@@ -5,7 +5,6 @@ import (
 	mathRand "math/rand"
 	"testing"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/rand"
@@ -16,10 +15,11 @@ import (
 	"github.com/onflow/flow-go/utils/unittest"
 
 	bstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/kv"
 )
 
 func TestBatchStoringTransactionResults(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := bstorage.NewTransactionResults(metrics, db, 1000)
 
@@ -68,7 +68,7 @@ func TestBatchStoringTransactionResults(t *testing.T) {
 }
 
 func TestReadingNotStoreTransaction(t *testing.T) {
-	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
 		metrics := metrics.NewNoopCollector()
 		store := bstorage.NewTransactionResults(metrics, db, 1000)
 
@@ -35,6 +35,13 @@ type DKGState interface {
 	// canonical key vector and may not be valid for use in signing. Use SafeBeaconKeys
 	// to guarantee only keys safe for signing are returned
 	RetrieveMyBeaconPrivateKey(epochCounter uint64) (crypto.PrivateKey, error)
+
+	// StoreDKGTranscriptSummary stores the DKG transcript summary for the epoch,
+	// replacing any summary stored previously.
+	StoreDKGTranscriptSummary(epochCounter uint64, summary *flow.DKGTranscriptSummary) error
+
+	// RetrieveDKGTranscriptSummary retrieves the DKG transcript summary for the epoch.
+	RetrieveDKGTranscriptSummary(epochCounter uint64) (*flow.DKGTranscriptSummary, error)
 }
 
 // SafeBeaconKeys is a safe way to access beacon keys.
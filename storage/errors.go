@@ -3,9 +3,9 @@ package storage
 import "errors"
 
 var (
-	// Note: there is another not found error: badger.ErrKeyNotFound. The difference between
-	// badger.ErrKeyNotFound and storage.ErrNotFound is that:
-	// badger.ErrKeyNotFound is the error returned by the badger API.
+	// Note: there is another not found error: kv.ErrNotFound. The difference between
+	// kv.ErrNotFound and storage.ErrNotFound is that:
+	// kv.ErrNotFound is the error returned by the key-value database API.
 	// Modules in storage/badger and storage/badger/operation package both
 	// return storage.ErrNotFound for not found error
 	ErrNotFound = errors.New("key not found")
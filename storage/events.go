@@ -28,3 +28,19 @@ type ServiceEvents interface {
 	// ByBlockID returns the events for the given block ID
 	ByBlockID(blockID flow.Identifier) ([]flow.Event, error)
 }
+
+// EventIndex represents persistent secondary indexes over the events of sealed blocks,
+// maintained by Access nodes to serve filtered event queries without an external indexer.
+type EventIndex interface {
+	// Store persists the events of the given sealed block and indexes them by event type
+	// and by the address of the emitting contract. Storing the same block again is a no-op.
+	Store(header *flow.Header, events []flow.Event) error
+
+	// ByTypes returns at most limit locators of events matching any of the given event types,
+	// ordered by position, starting at the given position (inclusive) up to and including the end
+	// height. Event types ending in flow.EventTypeWildcard are matched as prefixes.
+	ByTypes(types []flow.EventType, from flow.EventPosition, endHeight uint64, limit uint) ([]flow.EventLocator, error)
+
+	// ByLocator returns the event pointed to by the given locator.
+	ByLocator(locator flow.EventLocator) (*flow.Event, error)
+}
@@ -0,0 +1,149 @@
+// Package badgerkv implements the key-value database of the storage layer on
+// top of badger.
+package badgerkv
+
+import (
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// DB is a kv.DB backed by a badger database.
+type DB struct {
+	db *badger.DB
+}
+
+var _ kv.DB = (*DB)(nil)
+
+// New returns a kv.DB using the given badger database. Closing it closes the
+// badger database.
+func New(db *badger.DB) *DB {
+	return &DB{db: db}
+}
+
+// Badger returns the underlying badger database, for badger specific
+// maintenance such as value log garbage collection and backups.
+func (d *DB) Badger() *badger.DB {
+	return d.db
+}
+
+func (d *DB) View(fn func(kv.Transaction) error) error {
+	return d.db.View(func(txn *badger.Txn) error {
+		return fn(&transaction{txn: txn})
+	})
+}
+
+func (d *DB) Update(fn func(kv.Transaction) error) error {
+	return convertError(d.db.Update(func(txn *badger.Txn) error {
+		return fn(&transaction{txn: txn})
+	}))
+}
+
+func (d *DB) NewTransaction(update bool) kv.Transaction {
+	return &transaction{txn: d.db.NewTransaction(update)}
+}
+
+func (d *DB) NewWriteBatch() kv.WriteBatch {
+	return &writeBatch{batch: d.db.NewWriteBatch()}
+}
+
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+type transaction struct {
+	txn *badger.Txn
+}
+
+func (t *transaction) Get(key []byte, fn func(val []byte) error) error {
+	item, err := t.txn.Get(key)
+	if err != nil {
+		return convertError(err)
+	}
+	return item.Value(fn)
+}
+
+func (t *transaction) NewIterator(options kv.IteratorOptions) kv.Iterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = options.Prefix
+	opts.Reverse = options.Reverse
+	return &iterator{it: t.txn.NewIterator(opts)}
+}
+
+func (t *transaction) Set(key, val []byte) error {
+	return convertError(t.txn.Set(key, val))
+}
+
+func (t *transaction) Delete(key []byte) error {
+	return convertError(t.txn.Delete(key))
+}
+
+func (t *transaction) Commit() error {
+	return convertError(t.txn.Commit())
+}
+
+func (t *transaction) Discard() {
+	t.txn.Discard()
+}
+
+type iterator struct {
+	it *badger.Iterator
+}
+
+func (i *iterator) Seek(key []byte) {
+	i.it.Seek(key)
+}
+
+func (i *iterator) Valid() bool {
+	return i.it.Valid()
+}
+
+func (i *iterator) ValidForPrefix(prefix []byte) bool {
+	return i.it.ValidForPrefix(prefix)
+}
+
+func (i *iterator) Next() {
+	i.it.Next()
+}
+
+func (i *iterator) Key() []byte {
+	return i.it.Item().Key()
+}
+
+func (i *iterator) Value(fn func(val []byte) error) error {
+	return i.it.Item().Value(fn)
+}
+
+func (i *iterator) Close() {
+	i.it.Close()
+}
+
+type writeBatch struct {
+	batch *badger.WriteBatch
+}
+
+func (b *writeBatch) Set(key, val []byte) error {
+	return b.batch.Set(key, val)
+}
+
+func (b *writeBatch) Delete(key []byte) error {
+	return b.batch.Delete(key)
+}
+
+func (b *writeBatch) Flush() error {
+	return b.batch.Flush()
+}
+
+// convertError translates the errors returned by badger into the errors of
+// the kv package. Errors returned by transaction functions are passed through.
+func convertError(err error) error {
+	switch err {
+	case badger.ErrKeyNotFound:
+		return kv.ErrNotFound
+	case badger.ErrConflict:
+		return kv.ErrConflict
+	case badger.ErrReadOnlyTxn:
+		return kv.ErrReadOnly
+	}
+	return err
+}
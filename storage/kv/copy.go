@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"fmt"
+)
+
+// Copy copies all keys of the source database into the destination database,
+// which is typically empty, and returns the number of copied keys. The keys are
+// written in write batches of at most batchSize keys, the source database is
+// read from a single read-only transaction.
+func Copy(dst, src DB, batchSize int) (int, error) {
+
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("invalid batch size: %d", batchSize)
+	}
+
+	count := 0
+	err := src.View(func(tx Transaction) error {
+		it := tx.NewIterator(IteratorOptions{})
+		defer it.Close()
+
+		batch := dst.NewWriteBatch()
+		pending := 0
+		for it.Seek(nil); it.Valid(); it.Next() {
+			// keys and values are only valid until the iterator moves, while
+			// write batches can hold on to them until they are flushed
+			key := append([]byte(nil), it.Key()...)
+			var val []byte
+			err := it.Value(func(v []byte) error {
+				val = append([]byte{}, v...)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("could not read value of key %x: %w", key, err)
+			}
+
+			err = batch.Set(key, val)
+			if err != nil {
+				return fmt.Errorf("could not write key %x: %w", key, err)
+			}
+			count++
+			pending++
+
+			if pending == batchSize {
+				err = batch.Flush()
+				if err != nil {
+					return fmt.Errorf("could not flush write batch: %w", err)
+				}
+				batch = dst.NewWriteBatch()
+				pending = 0
+			}
+		}
+
+		err := batch.Flush()
+		if err != nil {
+			return fmt.Errorf("could not flush write batch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,67 @@
+package kv_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/pebblekv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestCopy(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, src kv.DB) {
+		unittest.RunWithDBs(t, func(t *testing.T, dst kv.DB) {
+
+			// more keys than fit in a batch, and a number of keys which is
+			// not a multiple of the batch size
+			expected := make(map[string]string)
+			err := src.Update(func(tx kv.Transaction) error {
+				for i := 0; i < 1050; i++ {
+					key := fmt.Sprintf("key-%04d", i)
+					val := fmt.Sprintf("value-%d", i)
+					expected[key] = val
+					err := tx.Set([]byte(key), []byte(val))
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			require.NoError(t, err)
+
+			count, err := kv.Copy(dst, src, 100)
+			require.NoError(t, err)
+			assert.Equal(t, len(expected), count)
+
+			actual := make(map[string]string)
+			err = dst.View(func(tx kv.Transaction) error {
+				it := tx.NewIterator(kv.IteratorOptions{})
+				defer it.Close()
+				for it.Seek(nil); it.Valid(); it.Next() {
+					key := string(it.Key())
+					err := it.Value(func(val []byte) error {
+						actual[key] = string(val)
+						return nil
+					})
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		})
+	})
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		unittest.RunWithPebbleDB(t, func(db *pebblekv.DB) {
+			_, err := kv.Copy(db, db, 0)
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,127 @@
+// Package kv defines the key-value database the storage layer is written
+// against, so that the storage layer can run on different database backends.
+//
+// The interfaces follow the transactional model of badger: the badgerkv
+// package implements them on top of badger, the pebblekv package on top of
+// pebble.
+package kv
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNotFound is returned when reading a key that doesn't exist. The
+	// storage layer translates it into storage.ErrNotFound.
+	ErrNotFound = errors.New("key not found")
+
+	// ErrConflict is returned when committing a read-write transaction which
+	// conflicts with another transaction committed in the meantime. The
+	// transaction can be retried.
+	ErrConflict = errors.New("transaction conflict")
+
+	// ErrReadOnly is returned when writing in a read-only transaction.
+	ErrReadOnly = errors.New("read-only transaction")
+)
+
+// Reader reads keys from the database.
+type Reader interface {
+	// Get calls fn with the value of the given key, or returns ErrNotFound if
+	// the key doesn't exist. The value is only valid within fn.
+	Get(key []byte, fn func(val []byte) error) error
+
+	// NewIterator returns an iterator over the keys of the database, which
+	// must be closed after use.
+	NewIterator(options IteratorOptions) Iterator
+}
+
+// Writer writes keys to the database.
+type Writer interface {
+	// Set sets the value of the given key.
+	Set(key, val []byte) error
+
+	// Delete removes the given key, deleting a key that doesn't exist is a no-op.
+	Delete(key []byte) error
+}
+
+// Transaction reads and writes keys atomically. Reads see a consistent
+// snapshot of the database, including the writes of the transaction itself.
+type Transaction interface {
+	Reader
+	Writer
+
+	// Commit commits the writes of a read-write transaction. It can fail with
+	// ErrConflict.
+	Commit() error
+
+	// Discard releases the transaction, discarding writes that haven't been
+	// committed. It can be called after Commit, and must always be called.
+	Discard()
+}
+
+// IteratorOptions configures an Iterator.
+type IteratorOptions struct {
+	// Prefix limits the iteration to keys with the given prefix, if not empty.
+	Prefix []byte
+	// Reverse iterates over keys in descending order.
+	Reverse bool
+}
+
+// Iterator iterates over the keys of the database in lexicographic order
+// (descending for reverse iterators).
+type Iterator interface {
+	// Seek moves to the first key greater than or equal to the given key, or
+	// for reverse iterators, to the last key less than or equal to it.
+	Seek(key []byte)
+
+	// Valid returns whether the iterator is positioned at a key.
+	Valid() bool
+
+	// ValidForPrefix returns whether the iterator is positioned at a key with
+	// the given prefix.
+	ValidForPrefix(prefix []byte) bool
+
+	// Next moves to the next key.
+	Next()
+
+	// Key returns the current key, which is only valid until the iterator moves.
+	Key() []byte
+
+	// Value calls fn with the value of the current key, which is only valid
+	// within fn.
+	Value(fn func(val []byte) error) error
+
+	// Close releases the iterator.
+	Close()
+}
+
+// WriteBatch writes keys in batches, without the overhead of transactions.
+// Writes are not atomic: if Flush fails, part of them can be written.
+type WriteBatch interface {
+	Writer
+
+	// Flush writes all pending writes to the database.
+	Flush() error
+}
+
+// DB is a key-value database.
+type DB interface {
+	// View runs fn in a read-only transaction.
+	View(fn func(Transaction) error) error
+
+	// Update runs fn in a read-write transaction, and commits the transaction
+	// if fn succeeds. It can fail with ErrConflict. Transactions don't block
+	// each other, so updates can be nested, in which case the outer update
+	// fails with ErrConflict if it read a key written by the nested one.
+	Update(fn func(Transaction) error) error
+
+	// NewTransaction starts a transaction, which is read-write if update is
+	// true. The transaction must be discarded after use.
+	NewTransaction(update bool) Transaction
+
+	// NewWriteBatch starts a write batch.
+	NewWriteBatch() WriteBatch
+
+	// Close closes the database.
+	Close() error
+}
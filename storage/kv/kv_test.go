@@ -0,0 +1,69 @@
+package kv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestNestedUpdate checks that updates can be nested in updates and views on every backend,
+// and that the outer update conflicts with a nested update writing a key it read.
+func TestNestedUpdate(t *testing.T) {
+	unittest.RunWithDBs(t, func(t *testing.T, db kv.DB) {
+		set := func(key, val string) func(kv.Transaction) error {
+			return func(tx kv.Transaction) error {
+				return tx.Set([]byte(key), []byte(val))
+			}
+		}
+		get := func(tx kv.Transaction, key string) string {
+			var val string
+			err := tx.Get([]byte(key), func(v []byte) error {
+				val = string(v)
+				return nil
+			})
+			require.NoError(t, err)
+			return val
+		}
+		require.NoError(t, db.Update(set("a", "1")))
+
+		unittest.RequireReturnsBefore(t, func() {
+			// the nested update writes keys the outer update didn't read
+			err := db.Update(func(tx kv.Transaction) error {
+				require.Equal(t, "1", get(tx, "a"))
+				err := db.Update(set("b", "2"))
+				require.NoError(t, err)
+				return tx.Set([]byte("c"), []byte("3"))
+			})
+			require.NoError(t, err)
+
+			// the nested update writes a key the outer update read
+			err = db.Update(func(tx kv.Transaction) error {
+				require.Equal(t, "1", get(tx, "a"))
+				err := db.Update(set("a", "4"))
+				require.NoError(t, err)
+				return tx.Set([]byte("d"), []byte("5"))
+			})
+			require.ErrorIs(t, err, kv.ErrConflict)
+
+			// an update nested in a view
+			err = db.View(func(tx kv.Transaction) error {
+				return db.Update(set("e", "6"))
+			})
+			require.NoError(t, err)
+		}, 5*time.Second, "nested updates should not block")
+
+		err := db.View(func(tx kv.Transaction) error {
+			assert.Equal(t, "4", get(tx, "a"))
+			assert.Equal(t, "2", get(tx, "b"))
+			assert.Equal(t, "3", get(tx, "c"))
+			assert.Equal(t, "6", get(tx, "e"))
+			return tx.Get([]byte("d"), func([]byte) error { return nil })
+		})
+		require.ErrorIs(t, err, kv.ErrNotFound)
+	})
+}
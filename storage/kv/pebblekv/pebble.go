@@ -0,0 +1,347 @@
+// Package pebblekv implements the key-value database of the storage layer on
+// top of pebble.
+package pebblekv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/onflow/flow-go/storage/kv"
+)
+
+// DB is a kv.DB backed by a pebble database.
+//
+// Pebble has no transactions with conflict detection, so read-write
+// transactions are indexed batches with optimistic conflict detection like
+// badger's: committing a read-write transaction fails with kv.ErrConflict if
+// another transaction committed a write to a key it read since it started.
+// Transactions never block each other, so read-write transactions can be
+// nested like on badger. Read-only transactions read from a snapshot.
+//
+// All writes are synced to disk before they are committed, like with badger's
+// default options.
+type DB struct {
+	db *pebble.DB
+
+	mu        sync.Mutex
+	seq       uint64         // number of committed read-write transactions with writes
+	active    map[uint64]int // number of pending read-write transactions by start sequence
+	committed []commit       // commits which pending read-write transactions can conflict with
+}
+
+// commit records the keys written by a read-write transaction.
+type commit struct {
+	seq  uint64
+	keys map[string]struct{}
+}
+
+var _ kv.DB = (*DB)(nil)
+
+// New returns a kv.DB using the given pebble database. Closing it closes the
+// pebble database.
+func New(db *pebble.DB) *DB {
+	return &DB{
+		db:     db,
+		active: make(map[uint64]int),
+	}
+}
+
+// Open opens the pebble database in the given directory, creating it if it
+// doesn't exist.
+func Open(dir string) (*DB, error) {
+	db, err := pebble.Open(dir, &pebble.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("could not open pebble database: %w", err)
+	}
+	return New(db), nil
+}
+
+// Pebble returns the underlying pebble database.
+func (d *DB) Pebble() *pebble.DB {
+	return d.db
+}
+
+func (d *DB) View(fn func(kv.Transaction) error) error {
+	txn := d.NewTransaction(false)
+	defer txn.Discard()
+	return fn(txn)
+}
+
+func (d *DB) Update(fn func(kv.Transaction) error) error {
+	txn := d.NewTransaction(true)
+	defer txn.Discard()
+	err := fn(txn)
+	if err != nil {
+		return err
+	}
+	return txn.Commit()
+}
+
+func (d *DB) NewTransaction(update bool) kv.Transaction {
+	if !update {
+		snapshot := d.db.NewSnapshot()
+		return &transaction{db: d, reader: snapshot, closer: snapshot}
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.active[d.seq]++
+	batch := d.db.NewIndexedBatch()
+	return &transaction{
+		db:     d,
+		reader: batch,
+		closer: batch,
+		batch:  batch,
+		start:  d.seq,
+		reads:  make(map[string]struct{}),
+		writes: make(map[string]struct{}),
+	}
+}
+
+// commit commits the transaction's batch, unless it read a key written by a
+// transaction committed since it started.
+func (d *DB) commit(t *transaction) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, c := range d.committed {
+		if c.seq <= t.start {
+			continue
+		}
+		for key := range t.reads {
+			if _, ok := c.keys[key]; ok {
+				return kv.ErrConflict
+			}
+		}
+	}
+
+	err := t.batch.Commit(pebble.Sync)
+	if err != nil {
+		return err
+	}
+	if len(t.writes) == 0 {
+		return nil
+	}
+	d.seq++
+	// only transactions started before this commit can conflict with it, the
+	// committing transaction itself is still counted as pending
+	if len(d.active) > 1 || d.active[t.start] > 1 {
+		d.committed = append(d.committed, commit{seq: d.seq, keys: t.writes})
+	}
+	return nil
+}
+
+// release unregisters a pending read-write transaction, and drops the commits
+// which no pending transaction can conflict with anymore.
+func (d *DB) release(t *transaction) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.active[t.start]--
+	if d.active[t.start] == 0 {
+		delete(d.active, t.start)
+	}
+	if len(d.active) == 0 {
+		d.committed = nil
+		return
+	}
+	oldest := d.seq
+	for start := range d.active {
+		if start < oldest {
+			oldest = start
+		}
+	}
+	i := 0
+	for i < len(d.committed) && d.committed[i].seq <= oldest {
+		i++
+	}
+	d.committed = d.committed[i:]
+}
+
+func (d *DB) NewWriteBatch() kv.WriteBatch {
+	return &writeBatch{batch: d.db.NewBatch()}
+}
+
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// reader is implemented by pebble snapshots and indexed batches.
+type reader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(o *pebble.IterOptions) *pebble.Iterator
+}
+
+type transaction struct {
+	db        *DB
+	reader    reader
+	closer    io.Closer
+	batch     *pebble.Batch // nil for read-only transactions
+	discarded bool
+
+	// conflict detection of read-write transactions
+	start  uint64              // sequence of the last commit before the transaction started
+	reads  map[string]struct{} // keys read by the transaction
+	writes map[string]struct{} // keys written by the transaction
+}
+
+// read records that the transaction read the given key.
+func (t *transaction) read(key []byte) {
+	if t.batch != nil {
+		t.reads[string(key)] = struct{}{}
+	}
+}
+
+func (t *transaction) Get(key []byte, fn func(val []byte) error) error {
+	t.read(key)
+	val, closer, err := t.reader.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return kv.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+	return fn(val)
+}
+
+func (t *transaction) NewIterator(options kv.IteratorOptions) kv.Iterator {
+	opts := &pebble.IterOptions{}
+	if len(options.Prefix) > 0 {
+		opts.LowerBound = options.Prefix
+		opts.UpperBound = prefixUpperBound(options.Prefix)
+	}
+	return &iterator{txn: t, it: t.reader.NewIter(opts), reverse: options.Reverse}
+}
+
+func (t *transaction) Set(key, val []byte) error {
+	if t.batch == nil {
+		return kv.ErrReadOnly
+	}
+	t.writes[string(key)] = struct{}{}
+	return t.batch.Set(key, val, nil)
+}
+
+func (t *transaction) Delete(key []byte) error {
+	if t.batch == nil {
+		return kv.ErrReadOnly
+	}
+	t.writes[string(key)] = struct{}{}
+	return t.batch.Delete(key, nil)
+}
+
+func (t *transaction) Commit() error {
+	if t.discarded {
+		return fmt.Errorf("transaction was discarded")
+	}
+	defer t.Discard()
+	if t.batch == nil {
+		return nil
+	}
+	return t.db.commit(t)
+}
+
+func (t *transaction) Discard() {
+	if t.discarded {
+		return
+	}
+	t.discarded = true
+	_ = t.closer.Close()
+	if t.batch != nil {
+		t.db.release(t)
+	}
+}
+
+type iterator struct {
+	txn     *transaction
+	it      *pebble.Iterator
+	reverse bool
+}
+
+func (i *iterator) Seek(key []byte) {
+	if !i.reverse {
+		i.it.SeekGE(key)
+		return
+	}
+	// the smallest key greater than the given key is the key with a zero
+	// byte appended, so seek to the last key less than that
+	seek := make([]byte, len(key)+1)
+	copy(seek, key)
+	i.it.SeekLT(seek)
+}
+
+func (i *iterator) Valid() bool {
+	return i.it.Valid()
+}
+
+func (i *iterator) ValidForPrefix(prefix []byte) bool {
+	return i.it.Valid() && bytes.HasPrefix(i.it.Key(), prefix)
+}
+
+func (i *iterator) Next() {
+	if i.reverse {
+		i.it.Prev()
+		return
+	}
+	i.it.Next()
+}
+
+func (i *iterator) Key() []byte {
+	i.txn.read(i.it.Key())
+	return i.it.Key()
+}
+
+func (i *iterator) Value(fn func(val []byte) error) error {
+	i.txn.read(i.it.Key())
+	return fn(i.it.Value())
+}
+
+func (i *iterator) Close() {
+	_ = i.it.Close()
+}
+
+// writeBatch is safe for concurrent use, like badger's write batches.
+type writeBatch struct {
+	mu    sync.Mutex
+	batch *pebble.Batch
+}
+
+func (b *writeBatch) Set(key, val []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.batch.Set(key, val, nil)
+}
+
+func (b *writeBatch) Delete(key []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.batch.Delete(key, nil)
+}
+
+func (b *writeBatch) Flush() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	err := b.batch.Commit(pebble.Sync)
+	if err != nil {
+		return err
+	}
+	return b.batch.Close()
+}
+
+// prefixUpperBound returns the smallest key greater than all keys with the
+// given prefix, or nil if there is none.
+func prefixUpperBound(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
@@ -3,7 +3,7 @@
 package mock
 
 import (
-	badger "github.com/dgraph-io/badger/v2"
+	kv "github.com/onflow/flow-go/storage/kv"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -27,15 +27,15 @@ func (_m *BatchStorage) Flush() error {
 }
 
 // GetWriter provides a mock function with given fields:
-func (_m *BatchStorage) GetWriter() *badger.WriteBatch {
+func (_m *BatchStorage) GetWriter() kv.Writer {
 	ret := _m.Called()
 
-	var r0 *badger.WriteBatch
-	if rf, ok := ret.Get(0).(func() *badger.WriteBatch); ok {
+	var r0 kv.Writer
+	if rf, ok := ret.Get(0).(func() kv.Writer); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*badger.WriteBatch)
+			r0 = ret.Get(0).(kv.Writer)
 		}
 	}
 
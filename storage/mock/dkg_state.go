@@ -70,6 +70,29 @@ func (_m *DKGState) InsertMyBeaconPrivateKey(epochCounter uint64, key crypto.Pri
 	return r0
 }
 
+// RetrieveDKGTranscriptSummary provides a mock function with given fields: epochCounter
+func (_m *DKGState) RetrieveDKGTranscriptSummary(epochCounter uint64) (*flow.DKGTranscriptSummary, error) {
+	ret := _m.Called(epochCounter)
+
+	var r0 *flow.DKGTranscriptSummary
+	if rf, ok := ret.Get(0).(func(uint64) *flow.DKGTranscriptSummary); ok {
+		r0 = rf(epochCounter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.DKGTranscriptSummary)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(epochCounter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveMyBeaconPrivateKey provides a mock function with given fields: epochCounter
 func (_m *DKGState) RetrieveMyBeaconPrivateKey(epochCounter uint64) (crypto.PrivateKey, error) {
 	ret := _m.Called(epochCounter)
@@ -120,3 +143,17 @@ func (_m *DKGState) SetDKGStarted(epochCounter uint64) error {
 
 	return r0
 }
+
+// StoreDKGTranscriptSummary provides a mock function with given fields: epochCounter, summary
+func (_m *DKGState) StoreDKGTranscriptSummary(epochCounter uint64, summary *flow.DKGTranscriptSummary) error {
+	ret := _m.Called(epochCounter, summary)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *flow.DKGTranscriptSummary) error); ok {
+		r0 = rf(epochCounter, summary)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -3,8 +3,8 @@
 package mock
 
 import (
-	badger "github.com/dgraph-io/badger/v2"
 	flow "github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/kv"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,15 +38,15 @@ func (_m *EpochStates) ByBlockID(_a0 flow.Identifier) (*flow.EpochStatus, error)
 }
 
 // StoreTx provides a mock function with given fields: blockID, state
-func (_m *EpochStates) StoreTx(blockID flow.Identifier, state *flow.EpochStatus) func(*badger.Txn) error {
+func (_m *EpochStates) StoreTx(blockID flow.Identifier, state *flow.EpochStatus) func(kv.Transaction) error {
 	ret := _m.Called(blockID, state)
 
-	var r0 func(*badger.Txn) error
-	if rf, ok := ret.Get(0).(func(flow.Identifier, *flow.EpochStatus) func(*badger.Txn) error); ok {
+	var r0 func(kv.Transaction) error
+	if rf, ok := ret.Get(0).(func(flow.Identifier, *flow.EpochStatus) func(kv.Transaction) error); ok {
 		r0 = rf(blockID, state)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(func(*badger.Txn) error)
+			r0 = ret.Get(0).(func(kv.Transaction) error)
 		}
 	}
 
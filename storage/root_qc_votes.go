@@ -0,0 +1,17 @@
+package storage
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// RootQCVotes is the storage interface for the state of this node's votes for
+// the root QC of its cluster, maintained by collection nodes.
+type RootQCVotes interface {
+
+	// Store stores the root QC vote state for the epoch given by the state's
+	// epoch counter, replacing any state stored previously.
+	Store(state *flow.RootQCVoteState) error
+
+	// ByEpochCounter retrieves the root QC vote state for the given epoch.
+	ByEpochCounter(epochCounter uint64) (*flow.RootQCVoteState, error)
+}
@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// SlashingEvidence represents persistent storage for evidence of slashable protocol violations.
+// Evidence is deduplicated by offence: only the first evidence for an offence is stored.
+type SlashingEvidence interface {
+	// Store persists the slashing evidence. It returns storage.ErrAlreadyExists if evidence for
+	// the same offence has been stored before.
+	Store(evidence *flow.SlashingEvidence) error
+
+	// ByID returns the slashing evidence with the given ID.
+	// It returns storage.ErrNotFound if no such evidence is known.
+	ByID(evidenceID flow.Identifier) (*flow.SlashingEvidence, error)
+
+	// ByOffender returns all slashing evidence against the given node.
+	ByOffender(offenderID flow.Identifier) ([]*flow.SlashingEvidence, error)
+
+	// All returns all stored slashing evidence.
+	All() ([]*flow.SlashingEvidence, error)
+}
@@ -36,9 +36,10 @@ type BaseChainSuite struct {
 	Blocks                map[flow.Identifier]*flow.Block
 
 	// PROTOCOL STATE
-	State          *protocol.State
-	SealedSnapshot *protocol.Snapshot
-	FinalSnapshot  *protocol.Snapshot
+	State             *protocol.State
+	SealedSnapshot    *protocol.Snapshot
+	FinalSnapshot     *protocol.Snapshot
+	SealingParameters flow.SealingParameters // sealing parameters in effect for all blocks
 
 	// MEMPOOLS and STORAGE which are injected into Matching Engine
 	// mock storage.ExecutionReceipts: backed by in-memory map PersistedReceipts
@@ -107,6 +108,7 @@ func (bc *BaseChainSuite) SetupChain() {
 
 	// ~~~~~~~~~~~~~~~~~~~~~~~~ SETUP PROTOCOL STATE ~~~~~~~~~~~~~~~~~~~~~~~~ //
 	bc.State = &protocol.State{}
+	bc.SealingParameters = flow.DefaultSealingParameters()
 
 	// define the protocol state snapshot of the latest finalized block
 	bc.State.On("Final").Return(
@@ -188,7 +190,14 @@ func (bc *BaseChainSuite) SetupChain() {
 			if !found {
 				return StateSnapshotForUnknownBlock()
 			}
-			return StateSnapshotForKnownBlock(block.Header, bc.Identities)
+			snapshot := StateSnapshotForKnownBlock(block.Header, bc.Identities)
+			snapshot.On("SealingParameters").Return(
+				func() flow.SealingParameters {
+					return bc.SealingParameters
+				},
+				nil,
+			)
+			return snapshot
 		},
 	)
 
@@ -1739,6 +1739,53 @@ func DKGParticipantLookup(participants flow.IdentityList) map[flow.Identifier]fl
 	return lookup
 }
 
+// DKGTranscriptSummaryFixture returns a DKG transcript summary for the given
+// DKG committee, where the first participant missed its broadcast and was
+// disqualified.
+func DKGTranscriptSummaryFixture(committee flow.IdentityList) *flow.DKGTranscriptSummary {
+	summary := &flow.DKGTranscriptSummary{
+		DKGInstanceID: fmt.Sprintf("dkg-%d", rand.Uint64()),
+		MyIndex:       len(committee) - 1,
+		PhaseTransitions: []flow.DKGPhaseTransition{
+			{Phase: "Phase1", Time: time.Now().UTC()},
+		},
+		BroadcastsSent:  1,
+		ResultSubmitted: true,
+		ResultHash:      IdentifierFixture(),
+	}
+	for i, node := range committee {
+		participant := flow.DKGParticipantSummary{
+			Index:                   i,
+			NodeID:                  node.NodeID,
+			BroadcastsReceived:      1,
+			PrivateMessagesReceived: 1,
+		}
+		if i == 0 {
+			participant.BroadcastsReceived = 0
+			participant.Complaints = []string{"missing broadcast"}
+			participant.Disqualified = true
+			participant.DisqualificationReason = "missing broadcast"
+		}
+		summary.Participants = append(summary.Participants, participant)
+	}
+	return summary
+}
+
+// RootQCVoteStateFixture returns a root QC vote state for the given epoch and
+// cluster members, of which all but the last have voted.
+func RootQCVoteStateFixture(epochCounter uint64, members flow.IdentifierList) *flow.RootQCVoteState {
+	return &flow.RootQCVoteState{
+		EpochCounter:   epochCounter,
+		ClusterIndex:   0,
+		Status:         flow.RootQCVoteStatusVoted,
+		Attempts:       2,
+		LastAttempt:    time.Now().UTC(),
+		ClusterMembers: members,
+		ClusterVoted:   members[:len(members)-1],
+		LastChecked:    time.Now().UTC(),
+	}
+}
+
 func CommitWithCounter(counter uint64) func(*flow.EpochCommit) {
 	return func(commit *flow.EpochCommit) {
 		commit.Counter = counter
@@ -1758,6 +1805,22 @@ func EpochCommitFixture(opts ...func(*flow.EpochCommit)) *flow.EpochCommit {
 	return commit
 }
 
+// EpochRecoverFixture creates a valid EpochRecover with a setup and a matching
+// commit for the recovery epoch. The setup properties can be overwritten with
+// optional parameter functions.
+func EpochRecoverFixture(opts ...func(setup *flow.EpochSetup)) *flow.EpochRecover {
+	setup := EpochSetupFixture(opts...)
+	commit := EpochCommitFixture(
+		CommitWithCounter(setup.Counter),
+		WithClusterQCsFromAssignments(setup.Assignments),
+		WithDKGFromParticipants(setup.Participants),
+	)
+	return &flow.EpochRecover{
+		EpochSetup:  *setup,
+		EpochCommit: *commit,
+	}
+}
+
 // BootstrapFixture generates all the artifacts necessary to bootstrap the
 // protocol state.
 func BootstrapFixture(participants flow.IdentityList, opts ...func(*flow.Block)) (*flow.Block, *flow.ExecutionResult, *flow.Seal) {
@@ -1959,3 +2022,64 @@ func MachineAccountFixture(t *testing.T) (bootstrap.NodeMachineAccountInfo, *sdk
 	}
 	return info, acct
 }
+
+// DoubleProposalEvidenceFixture returns well-formed evidence that the given node proposed two
+// different blocks for the same view on the given chain.
+func DoubleProposalEvidenceFixture(chainID flow.ChainID, offenderID flow.Identifier) *flow.SlashingEvidence {
+	first := BlockHeaderFixtureOnChain(chainID)
+	first.ProposerID = offenderID
+	second := first
+	second.PayloadHash = IdentifierFixture()
+	second.ProposerSigData = SignatureFixture()
+	return &flow.SlashingEvidence{
+		Type:       flow.SlashingEvidenceDoubleProposal,
+		ChainID:    chainID,
+		View:       first.View,
+		OffenderID: offenderID,
+		Proposals:  []*flow.Header{&first, &second},
+	}
+}
+
+// DoubleVoteEvidenceFixture returns well-formed evidence that the given node voted for two
+// different blocks in the same view on the given chain.
+func DoubleVoteEvidenceFixture(chainID flow.ChainID, offenderID flow.Identifier) *flow.SlashingEvidence {
+	view := uint64(rand.Uint32())
+	votes := make([]*flow.SlashingVote, 0, 2)
+	for i := 0; i < 2; i++ {
+		votes = append(votes, &flow.SlashingVote{
+			View:     view,
+			BlockID:  IdentifierFixture(),
+			SignerID: offenderID,
+			SigData:  SignatureFixture(),
+		})
+	}
+	return &flow.SlashingEvidence{
+		Type:       flow.SlashingEvidenceDoubleVote,
+		ChainID:    chainID,
+		View:       view,
+		OffenderID: offenderID,
+		Votes:      votes,
+	}
+}
+
+// DoubleTimeoutEvidenceFixture returns well-formed evidence that the given node signed two
+// different timeouts for the same view on the given chain.
+func DoubleTimeoutEvidenceFixture(chainID flow.ChainID, offenderID flow.Identifier) *flow.SlashingEvidence {
+	view := 10 + uint64(rand.Uint32())
+	timeouts := make([]*flow.SlashingTimeout, 0, 2)
+	for i := uint64(1); i <= 2; i++ {
+		timeouts = append(timeouts, &flow.SlashingTimeout{
+			View:     view,
+			NewestQC: QuorumCertificateFixture(func(qc *flow.QuorumCertificate) { qc.View = view - i }),
+			SignerID: offenderID,
+			SigData:  SignatureFixture(),
+		})
+	}
+	return &flow.SlashingEvidence{
+		Type:       flow.SlashingEvidenceDoubleTimeout,
+		ChainID:    chainID,
+		View:       view,
+		OffenderID: offenderID,
+		Timeouts:   timeouts,
+	}
+}
@@ -19,6 +19,9 @@ import (
 
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/util"
+	"github.com/onflow/flow-go/storage/kv"
+	"github.com/onflow/flow-go/storage/kv/badgerkv"
+	"github.com/onflow/flow-go/storage/kv/pebblekv"
 )
 
 type SkipReason int
@@ -341,6 +344,14 @@ func RunWithBadgerDB(t testing.TB, f func(*badger.DB)) {
 	})
 }
 
+// RunWithBadgerKV is like RunWithBadgerDB, but passes the database to f
+// through the key-value interface used by the storage layer.
+func RunWithBadgerKV(t testing.TB, f func(kv.DB)) {
+	RunWithBadgerDB(t, func(db *badger.DB) {
+		f(badgerkv.New(db))
+	})
+}
+
 // RunWithTypedBadgerDB creates a Badger DB that is passed to f and closed
 // after f returns. The extra create parameter allows passing in a database
 // constructor function which instantiates a database with a particular type
@@ -356,10 +367,41 @@ func RunWithTypedBadgerDB(t testing.TB, create func(badger.Options) (*badger.DB,
 	})
 }
 
-func TempBadgerDB(t testing.TB) (*badger.DB, string) {
+func TempBadgerDB(t testing.TB) (kv.DB, string) {
 	dir := TempDir(t)
 	db := BadgerDB(t, dir)
-	return db, dir
+	return badgerkv.New(db), dir
+}
+
+func PebbleDB(t testing.TB, dir string) *pebblekv.DB {
+	db, err := pebblekv.Open(dir)
+	require.NoError(t, err)
+	return db
+}
+
+func RunWithPebbleDB(t testing.TB, f func(*pebblekv.DB)) {
+	RunWithTempDir(t, func(dir string) {
+		db := PebbleDB(t, dir)
+		defer func() {
+			assert.NoError(t, db.Close())
+		}()
+		f(db)
+	})
+}
+
+// RunWithDBs runs f as a subtest once for each database backend of the
+// storage layer, with a new database that is closed after f returns.
+func RunWithDBs(t *testing.T, f func(t *testing.T, db kv.DB)) {
+	t.Run("badger", func(t *testing.T) {
+		RunWithBadgerKV(t, func(db kv.DB) {
+			f(t, db)
+		})
+	})
+	t.Run("pebble", func(t *testing.T) {
+		RunWithPebbleDB(t, func(db *pebblekv.DB) {
+			f(t, db)
+		})
+	})
 }
 
 func Concurrently(n int, f func(int)) {